@@ -17,12 +17,16 @@ import asyncio
 import logging
 import math
 import os
+from datetime import datetime, timezone
 
 import numpy as np
 import yaml
 
 from benchmarks.profiler.utils.aiperf import benchmark_decode, benchmark_prefill
-from benchmarks.profiler.utils.config import generate_dgd_config_with_planner
+from benchmarks.profiler.utils.config import (
+    generate_dgd_config_with_planner,
+    update_scheduling,
+)
 from benchmarks.profiler.utils.config_modifiers import CONFIG_MODIFIERS
 from benchmarks.profiler.utils.estimate_perf import AIConfiguratorPerfEstimator
 from benchmarks.profiler.utils.plot import (
@@ -62,9 +66,25 @@ console_handler.setFormatter(formatter)
 logger.addHandler(console_handler)
 
 
+def record_profiling_window(windows: list[dict], config: str, start: datetime):
+    """Record the time window a config was benchmarked in.
+
+    The operator uses these windows to query GPU utilization for each tested config.
+    """
+    windows.append(
+        {
+            "config": config,
+            "start": start.isoformat(),
+            "end": datetime.now(timezone.utc).isoformat(),
+        }
+    )
+
+
 async def run_profile(args):
     # List to track all created deployment clients for cleanup in case of failure
     deployment_clients = []
+    # Time windows of each benchmarked config, used to look up GPU utilization
+    profiling_windows: list[dict] = []
 
     # Inherit aic_backend from backend if not explicitly set
     if not args.aic_backend:
@@ -96,6 +116,11 @@ async def run_profile(args):
         if args.dgd_image:
             config = config_modifier.update_image(config, args.dgd_image)
             logger.info(f"Using DGD image: {args.dgd_image}")
+        if args.node_selector or args.tolerations:
+            config = update_scheduling(config, args.node_selector, args.tolerations)
+            logger.info(
+                f"Using node selector {args.node_selector} and {len(args.tolerations)} toleration(s)"
+            )
 
         if args.is_moe_model:
             # For MoE models, use range with stride of num_gpus_per_node
@@ -240,6 +265,7 @@ async def run_profile(args):
                 logger.info("Waiting for deployment to be ready...")
                 await client.wait_for_deployment_ready()
                 logger.info("Deployment is ready")
+                window_start = datetime.now(timezone.utc)
 
                 logger.info("Getting deployment logs...")
                 await client.get_deployment_logs()
@@ -260,6 +286,9 @@ async def run_profile(args):
                 if aiperf_result is not None:
                     ttft = aiperf_result["time_to_first_token"]["avg"]
 
+                record_profiling_window(
+                    profiling_windows, f"prefill_{num_gpus}gpus", window_start
+                )
                 logger.info("Cleaning up deployment...")
                 await client.delete_deployment()
                 deployment_clients.remove(client)
@@ -376,6 +405,7 @@ async def run_profile(args):
                 logger.info("Waiting for deployment to be ready...")
                 await client.wait_for_deployment_ready()
                 logger.info("Deployment is ready")
+                window_start = datetime.now(timezone.utc)
 
                 logger.info("Getting deployment logs...")
                 await client.get_deployment_logs()
@@ -458,6 +488,9 @@ async def run_profile(args):
                 )
 
             if not args.dry_run and not args.use_ai_configurator:
+                record_profiling_window(
+                    profiling_windows, f"decode_{num_gpus}gpus", window_start
+                )
                 logger.info("Cleaning up deployment...")
                 await client.delete_deployment()
                 deployment_clients.remove(client)
@@ -735,6 +768,11 @@ async def run_profile(args):
         with open(f"{args.output_dir}/config_with_planner.yaml", "w") as f:
             yaml.dump(config, f)
 
+        # save benchmark time windows for GPU utilization lookups
+        if profiling_windows:
+            with open(f"{args.output_dir}/profiling_windows.yaml", "w") as f:
+                yaml.dump(profiling_windows, f)
+
     except Exception as e:
         logger.error(f"Profile job failed with error: {e}")
         raise
@@ -381,6 +381,40 @@ def update_image(config: dict, image: str) -> dict:
     return cfg.model_dump()
 
 
+def update_scheduling(
+    config: dict, node_selector: dict, tolerations: list[dict]
+) -> dict:
+    """Add a node selector and tolerations to all DGD services.
+
+    Used to pin profiling deployments to nodes reserved (and tainted) by the operator.
+
+    Args:
+        config: Configuration dictionary
+        node_selector: Node selector labels to add to every service
+        tolerations: Tolerations to add to every service
+
+    Returns:
+        Updated configuration dictionary
+    """
+    cfg = Config.model_validate(config)
+
+    for service_name, service_config in cfg.spec.services.items():
+        if service_config.extraPodSpec is None:
+            service_config.extraPodSpec = PodSpec()
+        pod_spec = service_config.extraPodSpec.model_dump(exclude_none=True)
+        if node_selector:
+            pod_spec["nodeSelector"] = {
+                **pod_spec.get("nodeSelector", {}),
+                **node_selector,
+            }
+        if tolerations:
+            pod_spec["tolerations"] = pod_spec.get("tolerations", []) + tolerations
+        service_config.extraPodSpec = PodSpec.model_validate(pod_spec)
+        logger.debug(f"Updated scheduling for {service_name}")
+
+    return cfg.model_dump()
+
+
 class ConfigModifierProtocol(Protocol):
     @classmethod
     def convert_config(
@@ -66,6 +66,8 @@ def create_profiler_parser() -> argparse.Namespace:
             namespace: String (kubernetes namespace, default: dynamo-sla-profiler)
             service_name: String (service name, default: "")
             model: String (model to serve, can be HF model name or local model path)
+            node_selector: Dict (node selector applied to all profiling DGD services, default: {})
+            tolerations: List (tolerations applied to all profiling DGD services, default: [])
         engine:
             backend: String (backend type, currently support [vllm, sglang, trtllm], default: vllm)
             config: String (path to the DynamoGraphDeployment config file, default: "")
@@ -75,6 +77,7 @@ def create_profiler_parser() -> argparse.Namespace:
             min_num_gpus_per_engine: Int (minimum number of GPUs per engine, default: 0)
             max_num_gpus_per_engine: Int (maximum number of GPUs per engine, default: 0)
             num_gpus_per_node: Int (number of GPUs per node for MoE models - this will be the granularity when searching for the best TEP/DEP size, default: 0)
+            cpu_only: Boolean (skip cluster GPU discovery so the profiler can run without GPUs, requires use_ai_configurator or dry_run, default: False)
         sweep:
             skip_existing_results: Boolean (skip TP sizes that already have results in the output directory, default: False)
             force_rerun: Boolean (force re-running all tests even if results already exist (overrides --skip-existing-results), default: False)
@@ -128,6 +131,19 @@ def create_profiler_parser() -> argparse.Namespace:
         help="Container image to use for DGD components (frontend, planner, workers). Overrides images in config file.",
     )
 
+    parser.add_argument(
+        "--node-selector",
+        type=yaml.safe_load,
+        default=config.get("deployment", {}).get("node_selector", {}),
+        help="Node selector (YAML/JSON object) applied to all profiling DGD services, e.g. to target reserved profiling nodes.",
+    )
+    parser.add_argument(
+        "--tolerations",
+        type=yaml.safe_load,
+        default=config.get("deployment", {}).get("tolerations", []),
+        help="Tolerations (YAML/JSON list) applied to all profiling DGD services, e.g. to tolerate the profiling node taint.",
+    )
+
     # CLI arguments with config-aware defaults (using nested .get() for cleaner code)
     parser.add_argument(
         "--namespace",
@@ -248,6 +264,12 @@ def create_profiler_parser() -> argparse.Namespace:
         default=config.get("hardware", {}).get("num_gpus_per_node", 0),
         help="Number of GPUs per node for MoE models - this will be the granularity when searching for the best TEP/DEP size",
     )
+    parser.add_argument(
+        "--cpu-only",
+        action="store_true",
+        default=config.get("hardware", {}).get("cpu_only", False),
+        help="Skip cluster GPU discovery and use the configured hardware (or a single GPU) as the search space. Requires --use-ai-configurator or --dry-run.",
+    )
 
     # Dynamically add all planner arguments from planner_argparse.py
     add_planner_arguments_to_parser(parser, prefix="planner-")
@@ -304,6 +326,8 @@ def create_profiler_parser() -> argparse.Namespace:
     # Either --model or --config (or both) must be provided
     if not args.model and not args.config:
         parser.error("--model or --config is required (provide at least one)")
+    if args.cpu_only and not (args.use_ai_configurator or args.dry_run):
+        parser.error("--cpu-only requires --use-ai-configurator or --dry-run")
 
     auto_generate_search_space(args)
 
@@ -55,6 +55,16 @@ def auto_generate_search_space(args: argparse.Namespace) -> None:
             yaml.dump(config, f)
         args.config = config_fn
 
+    # without GPUs there is nothing to discover, so use the configured search space
+    if args.cpu_only:
+        logger.info("CPU-only mode, skipping cluster GPU discovery")
+        args.min_num_gpus_per_engine = args.min_num_gpus_per_engine or 1
+        args.max_num_gpus_per_engine = (
+            args.max_num_gpus_per_engine or args.min_num_gpus_per_engine
+        )
+        args.num_gpus_per_node = args.num_gpus_per_node or args.max_num_gpus_per_engine
+        return
+
     # now determine the search space
     if args.model is not None:
         model_info = get_model_info(args.model)
@@ -0,0 +1,132 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AdminActionType is an administrative action applied to DynamoGraphDeploymentRequests in bulk.
+// +kubebuilder:validation:Enum=Pause;Resume;RetryFailed;Reprofile
+type AdminActionType string
+
+const (
+	// AdminActionPause stops the operator from reconciling the selected DGDRs.
+	AdminActionPause AdminActionType = "Pause"
+	// AdminActionResume resumes reconciliation of the selected DGDRs.
+	AdminActionResume AdminActionType = "Resume"
+	// AdminActionRetryFailed restarts the selected DGDRs that are in the Failed state.
+	AdminActionRetryFailed AdminActionType = "RetryFailed"
+	// AdminActionReprofile re-runs profiling for the selected DGDRs that finished profiling.
+	AdminActionReprofile AdminActionType = "Reprofile"
+)
+
+// AdminActionPhase is the progress of a DynamoAdminAction.
+type AdminActionPhase string
+
+const (
+	// AdminActionPhaseRunning indicates the action is being applied.
+	AdminActionPhaseRunning AdminActionPhase = "Running"
+	// AdminActionPhaseCompleted indicates the action was applied to all selected DGDRs.
+	AdminActionPhaseCompleted AdminActionPhase = "Completed"
+)
+
+// DynamoAdminActionSpec describes an administrative action and the DGDRs it applies to.
+// The spec cannot be changed once created; create a new action instead.
+// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="DynamoAdminAction spec is immutable"
+type DynamoAdminActionSpec struct {
+	// Action is the action to apply to the selected DGDRs.
+	Action AdminActionType `json:"action"`
+
+	// LabelSelector selects the DynamoGraphDeploymentRequests in the namespace of the action.
+	// An empty selector selects all DGDRs in the namespace.
+	// +kubebuilder:validation:Optional
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
+
+	// BatchSize is the maximum number of DGDRs the action is applied to per batch.
+	// +kubebuilder:default=5
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Optional
+	BatchSize int32 `json:"batchSize,omitempty"`
+
+	// BatchInterval is the delay between batches, e.g. "10s".
+	// +kubebuilder:default="10s"
+	// +kubebuilder:validation:Optional
+	BatchInterval *metav1.Duration `json:"batchInterval,omitempty"`
+}
+
+// DynamoAdminActionStatus reports the progress of a DynamoAdminAction.
+type DynamoAdminActionStatus struct {
+	// Phase is the progress of the action.
+	// +kubebuilder:validation:Optional
+	Phase AdminActionPhase `json:"phase,omitempty"`
+
+	// Targets lists the DGDRs selected when the action started.
+	// DGDRs created later are not affected.
+	// +kubebuilder:validation:Optional
+	Targets []string `json:"targets,omitempty"`
+
+	// Applied lists the DGDRs the action was applied to.
+	// +kubebuilder:validation:Optional
+	Applied []string `json:"applied,omitempty"`
+
+	// Skipped lists the DGDRs the action did not apply to, e.g. RetryFailed on a DGDR that is
+	// not Failed, or a DGDR deleted before its batch ran.
+	// +kubebuilder:validation:Optional
+	Skipped []string `json:"skipped,omitempty"`
+
+	// StartTime is when the action started.
+	// +kubebuilder:validation:Optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// CompletionTime is when the action was applied to all selected DGDRs.
+	// +kubebuilder:validation:Optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
+// DynamoAdminAction applies an administrative action (pause, resume, retry failed, re-profile) to the
+// DynamoGraphDeploymentRequests in its namespace that match a label selector. DGDRs are processed in
+// rate-limited batches. Annotating a namespace with nvidia.com/dgdr-admin-action=<action> creates an
+// action for all DGDRs in that namespace.
+//
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=daa
+// +kubebuilder:printcolumn:name="Action",type=string,JSONPath=`.spec.action`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+type DynamoAdminAction struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DynamoAdminActionSpec   `json:"spec,omitempty"`
+	Status DynamoAdminActionStatus `json:"status,omitempty"`
+}
+
+// DynamoAdminActionList contains a list of DynamoAdminAction resources.
+//
+// +kubebuilder:object:root=true
+type DynamoAdminActionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DynamoAdminAction `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DynamoAdminAction{}, &DynamoAdminActionList{})
+}
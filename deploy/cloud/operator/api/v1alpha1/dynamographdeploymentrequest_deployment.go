@@ -0,0 +1,126 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// States of a DynamoGraphDeploymentRequest, reported in status.state
+const (
+	DGDRStatePending           = "Pending"
+	DGDRStateProfiling         = "Profiling"
+	DGDRStateAwaitingApproval  = "AwaitingApproval"
+	DGDRStateDeploying         = "Deploying"
+	DGDRStateReady             = "Ready"
+	DGDRStateDegraded          = "Degraded"
+	DGDRStateDeploymentDeleted = "DeploymentDeleted"
+	DGDRStateFailed            = "Failed"
+)
+
+const (
+	// Labels linking a DynamoGraphDeployment to the DynamoGraphDeploymentRequest it was generated for
+	LabelDGDRName      = "dgdr.nvidia.com/name"
+	LabelDGDRNamespace = "dgdr.nvidia.com/namespace"
+	LabelDGDRUID       = "dgdr.nvidia.com/uid"
+
+	// LabelManagedBy is set to LabelValueDynamoOperator on the objects the operator manages
+	LabelManagedBy           = "nvidia.com/managed-by"
+	LabelValueDynamoOperator = "dynamo-operator"
+
+	// AnnotationDGDRApproved approves the generated spec of a DGDR awaiting approval while set to "true"
+	AnnotationDGDRApproved = "nvidia.com/dgdr-approved"
+)
+
+// DeploymentNameAndNamespace returns the name and namespace of the DGD created from the generated
+// deployment, taking deploymentOverrides into account
+func (s *DynamoGraphDeploymentRequest) DeploymentNameAndNamespace(generated *DynamoGraphDeployment) (string, string) {
+	name := generated.Name
+	namespace := s.Namespace
+	if s.Spec.DeploymentOverrides != nil {
+		if s.Spec.DeploymentOverrides.Name != "" {
+			name = s.Spec.DeploymentOverrides.Name
+		}
+		if s.Spec.DeploymentOverrides.Namespace != "" {
+			namespace = s.Spec.DeploymentOverrides.Namespace
+		}
+	}
+	return name, namespace
+}
+
+// BuildDeployment returns the DGD the operator applies for the generated deployment of the DGDR:
+// the generated spec with the name, namespace, labels and annotations it is applied with
+func (s *DynamoGraphDeploymentRequest) BuildDeployment() (*DynamoGraphDeployment, error) {
+	if s.Status.GeneratedDeployment == nil {
+		return nil, fmt.Errorf("generatedDeployment is not set")
+	}
+
+	generated := &DynamoGraphDeployment{}
+	// RawExtension can have either Object (already decoded) or Raw (JSON bytes)
+	if s.Status.GeneratedDeployment.Object != nil {
+		var ok bool
+		generated, ok = s.Status.GeneratedDeployment.Object.(*DynamoGraphDeployment)
+		if !ok {
+			return nil, fmt.Errorf("generatedDeployment.Object is not a DynamoGraphDeployment")
+		}
+	} else if s.Status.GeneratedDeployment.Raw != nil {
+		if err := yaml.Unmarshal(s.Status.GeneratedDeployment.Raw, generated); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal generated deployment: %w", err)
+		}
+	} else {
+		return nil, fmt.Errorf("generatedDeployment has neither Object nor Raw set")
+	}
+
+	name, namespace := s.DeploymentNameAndNamespace(generated)
+
+	// The managed labels override those of the generated DGD, the custom labels of the overrides
+	// override both
+	labels := make(map[string]string)
+	for k, v := range generated.Labels {
+		labels[k] = v
+	}
+	labels[LabelDGDRName] = s.Name
+	labels[LabelDGDRNamespace] = s.Namespace
+	labels[LabelDGDRUID] = string(s.UID)
+	labels[LabelManagedBy] = LabelValueDynamoOperator
+	annotations := make(map[string]string)
+	for k, v := range generated.Annotations {
+		annotations[k] = v
+	}
+	if s.Spec.DeploymentOverrides != nil {
+		for k, v := range s.Spec.DeploymentOverrides.Labels {
+			labels[k] = v
+		}
+		for k, v := range s.Spec.DeploymentOverrides.Annotations {
+			annotations[k] = v
+		}
+	}
+
+	return &DynamoGraphDeployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   namespace,
+			Labels:      labels,
+			Annotations: annotations,
+		},
+		Spec: generated.Spec,
+	}, nil
+}
@@ -24,9 +24,14 @@ a high-level, SLA-driven interface for deploying machine learning models on Dyna
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	dynamoCommon "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/dynamo/common"
 )
 
 // EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
@@ -44,12 +49,92 @@ type ConfigMapKeySelector struct {
 	Key string `json:"key,omitempty"`
 }
 
+// SecretKeySelector selects a specific key from a Secret.
+// Used to reference external configuration data that embeds endpoints or credentials.
+type SecretKeySelector struct {
+	// Name of the Secret containing the desired data.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Key in the Secret to select. If not specified, defaults to "disagg.yaml".
+	// +kubebuilder:default=disagg.yaml
+	Key string `json:"key,omitempty"`
+}
+
+// ModelRegistryProvider is the kind of model registry a modelRef points to.
+// +kubebuilder:validation:Enum=huggingface;ngc;mlflow
+type ModelRegistryProvider string
+
+const (
+	// ModelRegistryHuggingFace resolves Hugging Face Hub repositories
+	ModelRegistryHuggingFace ModelRegistryProvider = "huggingface"
+	// ModelRegistryNGC resolves NGC model catalog entries, including NIM models
+	ModelRegistryNGC ModelRegistryProvider = "ngc"
+	// ModelRegistryMLflow resolves registered models of an MLflow tracking server
+	ModelRegistryMLflow ModelRegistryProvider = "mlflow"
+)
+
+// ModelRefSpec references a model registry entry.
+// +kubebuilder:validation:XValidation:rule="self.provider != 'mlflow' || has(self.url)",message="url is required for the mlflow provider"
+type ModelRefSpec struct {
+	// Provider is the kind of registry the entry belongs to.
+	// +kubebuilder:validation:Required
+	Provider ModelRegistryProvider `json:"provider"`
+
+	// Name of the registry entry: the repository for huggingface (e.g. "Qwen/Qwen3-0.6B"),
+	// "<org>/[<team>/]<model>" for ngc, the registered model name for mlflow.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Version of the entry: a branch, tag or commit for huggingface (default main), a version for
+	// ngc (default the latest), a version number or alias for mlflow (default the latest).
+	// +kubebuilder:validation:Optional
+	Version string `json:"version,omitempty"`
+
+	// URL of the registry API. Defaults to the public endpoint of huggingface and ngc; required for mlflow.
+	// +kubebuilder:validation:Optional
+	URL string `json:"url,omitempty"`
+
+	// CredentialsSecretRef selects the Secret key holding the token the registry is called with.
+	// +kubebuilder:validation:Optional
+	CredentialsSecretRef *SecretKeySelector `json:"credentialsSecretRef,omitempty"`
+}
+
+// ResolvedModelStatus records the model a modelRef resolved to.
+type ResolvedModelStatus struct {
+	// Provider is the registry the model was resolved from.
+	Provider ModelRegistryProvider `json:"provider"`
+
+	// Model is the model name or URI the deployment loads.
+	Model string `json:"model"`
+
+	// Revision is the immutable revision of the entry, e.g. the commit of a Hugging Face repository.
+	// +kubebuilder:validation:Optional
+	Revision string `json:"revision,omitempty"`
+
+	// Parameters is the number of model parameters reported by the registry.
+	// +kubebuilder:validation:Optional
+	Parameters int64 `json:"parameters,omitempty"`
+
+	// Size is the storage size of the model weights reported by the registry, which at least that
+	// much GPU memory is required to serve.
+	// +kubebuilder:validation:Optional
+	Size *resource.Quantity `json:"size,omitempty"`
+
+	// ResolvedTime is when the entry was resolved.
+	ResolvedTime metav1.Time `json:"resolvedTime"`
+}
+
 // ProfilingConfigSpec defines configuration for the profiling process.
 // This structure maps directly to the profile_sla.py config format.
 // See benchmarks/profiler/utils/profiler_argparse.py for the complete schema.
+// +kubebuilder:validation:XValidation:rule="!(has(self.configMapRef) && has(self.secretRef))",message="configMapRef and secretRef are mutually exclusive"
 type ProfilingConfigSpec struct {
 	// Config is the profiling configuration as arbitrary JSON/YAML. This will be passed directly to the profiler.
 	// The profiler will validate the configuration and report any errors.
+	// String values of keys the operator does not know to be safe are redacted when the config is
+	// echoed into events, logs and status. Keep credentials in a Secret referenced by secretRef.
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:pruning:PreserveUnknownFields
 	// +kubebuilder:validation:Type=object
@@ -61,15 +146,460 @@ type ProfilingConfigSpec struct {
 	// +kubebuilder:validation:Optional
 	ConfigMapRef *ConfigMapKeySelector `json:"configMapRef,omitempty"`
 
+	// SecretRef is an optional reference to a Secret containing the DynamoGraphDeployment
+	// base config file (disagg.yaml), for base configs that embed endpoints or credentials.
+	// It is validated and mounted like configMapRef. Mutually exclusive with configMapRef.
+	// +kubebuilder:validation:Optional
+	SecretRef *SecretKeySelector `json:"secretRef,omitempty"`
+
 	// ProfilerImage specifies the container image to use for profiling jobs.
 	// This image contains the profiler code and dependencies needed for SLA-based profiling.
 	// Example: "nvcr.io/nvidia/ai-dynamo/vllm-runtime:0.6.1"
 	// +kubebuilder:validation:Required
 	ProfilerImage string `json:"profilerImage"`
+
+	// NodeReservation reserves dedicated nodes for online profiling so that production pods cannot
+	// land on them mid-benchmark and skew measurements. Reserved nodes are released automatically
+	// when profiling completes or fails, or when the DGDR is deleted.
+	// Ignored for AI Configurator profiling. Requires a cluster-wide operator installation.
+	// +kubebuilder:validation:Optional
+	NodeReservation *NodeReservationSpec `json:"nodeReservation,omitempty"`
+
+	// RecordUtilization queries the cluster Prometheus (configured with --prometheus-endpoint) for
+	// DCGM GPU utilization, memory and NVLink metrics over each tested configuration's time window
+	// and reports them in status.profiling.utilization for capacity planning.
+	// Only the pods of the profiling deployments are counted, which requires kube-state-metrics
+	// to export their dgdr.nvidia.com/name and dgdr.nvidia.com/namespace labels.
+	// Only applies to online profiling.
+	// +kubebuilder:validation:Optional
+	RecordUtilization bool `json:"recordUtilization,omitempty"`
+
+	// CPUOnly runs the profiling job without requesting GPUs, e.g. to smoke-test the DGDR flow on
+	// kind clusters in CI. The profiler skips cluster GPU discovery and requires profilingMode aic
+	// or sweep.dry_run in config. GPU-specific features such as
+	// nodeReservation and recordUtilization are ignored in this mode.
+	// +kubebuilder:validation:Optional
+	CPUOnly bool `json:"cpuOnly,omitempty"`
+
+	// ResultTransport selects how the profiling job delivers its results to the operator.
+	// ConfigMap stores them in the dgdr-output-<name> ConfigMap. Secret stores them in a Secret
+	// of the same name, for results that embed tokens. PVC leaves them on the shared dynamo-pvc volume,
+	// which must also be mounted into the operator (--results-pvc-path). HTTP posts the generated
+	// deployments to the operator's authenticated results endpoint, which stores them in a Secret
+	// of the same name and completes profiling without waiting for the job.
+	// The sweep checkpoint (sweep-state.json) the profiler saves in its output directory is
+	// delivered the same way while profiling runs, so retried profiling job pods resume the sweep.
+	// +kubebuilder:default=ConfigMap
+	// +kubebuilder:validation:Optional
+	ResultTransport ResultTransport `json:"resultTransport,omitempty"`
+
+	// ResultEncoding selects how the ConfigMap and Secret result transports store the results.
+	// Plain stores every result file under its own key. Gzip compresses every file and splits its
+	// base64 encoding into chunks stored under "<file>.gz.<index>" keys, which the operator
+	// reassembles, so that the results of large multi-service graphs fit the size limits of
+	// Kubernetes objects. Auto compresses the results only if they exceed 128KiB.
+	// Ignored by the PVC and HTTP result transports.
+	// +kubebuilder:default=Auto
+	// +kubebuilder:validation:Optional
+	ResultEncoding ResultEncoding `json:"resultEncoding,omitempty"`
+
+	// OutputKey is the file in the profiler's output directory that holds the generated
+	// DynamoGraphDeployment. Its format is YAML, JSON or TOML, detected from the extension or,
+	// for other names, from the content. The file may hold several YAML documents, a JSON array
+	// or a List, as long as exactly one object is a DynamoGraphDeployment.
+	// Defaults to config_with_planner.yaml. Ignored for backend auto.
+	// +kubebuilder:validation:Optional
+	OutputKey string `json:"outputKey,omitempty"`
+
+	// ArtifactsPVC keeps everything the profiler writes to its output directory, such as the raw
+	// benchmark CSVs and plots, on a persistent volume claim for later analysis. The location is
+	// recorded in status.artifacts. Annotate the DGDR with nvidia.com/dgdr-browse-artifacts: "true"
+	// to start a pod mounting the artifacts for kubectl exec and kubectl cp.
+	// +kubebuilder:validation:Optional
+	ArtifactsPVC *ArtifactsPVCSpec `json:"artifactsPVC,omitempty"`
+
+	// NetworkIsolation restricts the network access of the profiling job pods, as benchmark images
+	// carry general-purpose shell tooling. Restricted creates a NetworkPolicy while profiling runs
+	// that allows only DNS, the Kubernetes API server, HTTPS to addresses outside the private ranges
+	// clusters use (model hubs such as huggingface.co) and, for online profiling, the frontends of
+	// the benchmark deployments in the DGDR namespace. Other in-cluster services are blocked.
+	// Requires a CNI that enforces NetworkPolicies and a cluster-wide operator installation.
+	// Not supported with resultTransport HTTP.
+	// +kubebuilder:validation:Optional
+	NetworkIsolation NetworkIsolationProfile `json:"networkIsolation,omitempty"`
+
+	// Catalog controls whether the catalog of pre-profiled configurations installed with the
+	// operator is consulted before profiling. With Use (the default), a DGDR whose model, GPU
+	// system (sweep.aic_system), backend, ISL and OSL match a catalog entry that meets its TTFT and
+	// ITL targets gets the entry's deployment as its generated spec without running a profiling
+	// job, and reports a CatalogHit condition. Ignore always profiles.
+	// +kubebuilder:validation:Optional
+	Catalog CatalogPolicy `json:"catalog,omitempty"`
+}
+
+// CatalogPolicy is whether pre-profiled catalog configurations may replace profiling.
+// +kubebuilder:validation:Enum=Use;Ignore
+type CatalogPolicy string
+
+const (
+	// CatalogPolicyUse skips profiling when a catalog entry meets the SLA.
+	CatalogPolicyUse CatalogPolicy = "Use"
+	// CatalogPolicyIgnore always profiles.
+	CatalogPolicyIgnore CatalogPolicy = "Ignore"
+)
+
+// NetworkIsolationProfile is the network access of profiling job pods.
+// +kubebuilder:validation:Enum=None;Restricted
+type NetworkIsolationProfile string
+
+const (
+	// NetworkIsolationNone leaves the network access of profiling job pods unrestricted.
+	NetworkIsolationNone NetworkIsolationProfile = "None"
+	// NetworkIsolationRestricted limits profiling job pods to model hubs and the API server.
+	NetworkIsolationRestricted NetworkIsolationProfile = "Restricted"
+)
+
+// ArtifactsPVCSpec selects the claim profiling artifacts are kept on and how long they are kept.
+type ArtifactsPVCSpec struct {
+	// ClaimName is an existing claim in the DGDR namespace. If empty, the operator provisions
+	// the claim dgdr-artifacts-<name>, which is deleted with the DGDR.
+	// +kubebuilder:validation:Optional
+	ClaimName string `json:"claimName,omitempty"`
+
+	// Size of the provisioned claim. Ignored with claimName.
+	// +kubebuilder:default="10Gi"
+	// +kubebuilder:validation:Optional
+	Size *resource.Quantity `json:"size,omitempty"`
+
+	// StorageClassName of the provisioned claim, the cluster default if empty. Ignored with claimName.
+	// +kubebuilder:validation:Optional
+	StorageClassName *string `json:"storageClassName,omitempty"`
+
+	// TTL is how long the artifacts are kept after profiling started before they are pruned.
+	// Defaults to the operator's --dgdr-artifacts-ttl. Zero keeps them until the claim is deleted.
+	// +kubebuilder:validation:Optional
+	TTL *metav1.Duration `json:"ttl,omitempty"`
+}
+
+// ResultTransport is how profiling results are delivered from the profiling job to the operator.
+// +kubebuilder:validation:Enum=ConfigMap;Secret;PVC;HTTP
+type ResultTransport string
+
+const (
+	// ResultTransportConfigMap stores the profiling results in a ConfigMap.
+	ResultTransportConfigMap ResultTransport = "ConfigMap"
+	// ResultTransportSecret stores the profiling results in a Secret.
+	ResultTransportSecret ResultTransport = "Secret"
+	// ResultTransportPVC leaves the profiling results on the shared profiling output volume.
+	ResultTransportPVC ResultTransport = "PVC"
+	// ResultTransportHTTP posts the profiling results to the operator's results endpoint.
+	ResultTransportHTTP ResultTransport = "HTTP"
+)
+
+// ResultEncoding is how profiling results are stored in the output ConfigMap or Secret.
+// +kubebuilder:validation:Enum=Auto;Plain;Gzip
+type ResultEncoding string
+
+const (
+	// ResultEncodingAuto compresses the profiling results only if they are large.
+	ResultEncodingAuto ResultEncoding = "Auto"
+	// ResultEncodingPlain stores every result file under its own key.
+	ResultEncodingPlain ResultEncoding = "Plain"
+	// ResultEncodingGzip stores every result file gzip compressed, in base64 encoded chunks.
+	ResultEncodingGzip ResultEncoding = "Gzip"
+)
+
+// SLASpec is the load and latency target of a generated deployment.
+// +kubebuilder:validation:XValidation:rule="!(has(self.tokenLatency) && has(self.batchLatencyMilliseconds))",message="tokenLatency and batchLatencyMilliseconds are mutually exclusive"
+type SLASpec struct {
+	// RequestsPerSecond is the peak request rate the deployment must serve within the latency targets.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	RequestsPerSecond *int32 `json:"requestsPerSecond,omitempty"`
+
+	// ConcurrentUsers is the peak number of requests in flight at the same time.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	ConcurrentUsers *int32 `json:"concurrentUsers,omitempty"`
+
+	// TokenLatency is the per-token latency target of workloadType llm. It replaces sla.ttft and
+	// sla.itl of profilingConfig.config.
+	// +kubebuilder:validation:Optional
+	TokenLatency *TokenLatencySpec `json:"tokenLatency,omitempty"`
+
+	// BatchLatencyMilliseconds is the latency target of a batch of requests for workloadType
+	// embedding and reranker. It replaces sla.batch_latency of profilingConfig.config.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=600000
+	BatchLatencyMilliseconds *int32 `json:"batchLatencyMilliseconds,omitempty"`
+
+	// MinTokensPerSecond is the output token throughput the deployment must sustain at least,
+	// across all requests, for workloadType llm. It is passed to the profiler as
+	// sla.min_tokens_per_second.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	MinTokensPerSecond *int32 `json:"minTokensPerSecond,omitempty"`
+}
+
+// TokenLatencySpec is the per-token latency target of a generative model, in milliseconds.
+// +kubebuilder:validation:XValidation:rule="self.ttftMilliseconds > self.itlMilliseconds",message="ttftMilliseconds must be greater than itlMilliseconds"
+type TokenLatencySpec struct {
+	// TTFTMilliseconds is the target time to first token.
+	// +kubebuilder:default=200
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=600000
+	TTFTMilliseconds int32 `json:"ttftMilliseconds,omitempty"`
+
+	// ITLMilliseconds is the target inter-token latency.
+	// +kubebuilder:default=20
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=60000
+	ITLMilliseconds int32 `json:"itlMilliseconds,omitempty"`
+
+	// Percentiles are tail latency targets on top of ttftMilliseconds and itlMilliseconds. They are
+	// passed to the profiler as sla.ttft_p50, sla.itl_p99 and so on. The percentile the profiler
+	// sized the deployment for is reported in status.sizedForPercentile.
+	// +kubebuilder:validation:Optional
+	Percentiles *LatencyPercentilesSpec `json:"percentiles,omitempty"`
+}
+
+// LatencyPercentilesSpec are percentile targets of the time to first token and inter-token latency.
+type LatencyPercentilesSpec struct {
+	// TTFT are percentile targets of the time to first token.
+	// +kubebuilder:validation:Optional
+	TTFT *PercentileTargets `json:"ttft,omitempty"`
+
+	// ITL are percentile targets of the inter-token latency.
+	// +kubebuilder:validation:Optional
+	ITL *PercentileTargets `json:"itl,omitempty"`
+}
+
+// PercentileTargets are latency targets at the 50th, 90th and 99th percentile, in milliseconds.
+// +kubebuilder:validation:XValidation:rule="!has(self.p50Milliseconds) || !has(self.p90Milliseconds) || self.p50Milliseconds <= self.p90Milliseconds",message="p50Milliseconds must not be greater than p90Milliseconds"
+// +kubebuilder:validation:XValidation:rule="!has(self.p90Milliseconds) || !has(self.p99Milliseconds) || self.p90Milliseconds <= self.p99Milliseconds",message="p90Milliseconds must not be greater than p99Milliseconds"
+// +kubebuilder:validation:XValidation:rule="!has(self.p50Milliseconds) || !has(self.p99Milliseconds) || self.p50Milliseconds <= self.p99Milliseconds",message="p50Milliseconds must not be greater than p99Milliseconds"
+type PercentileTargets struct {
+	// P50Milliseconds is the median latency target.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=600000
+	P50Milliseconds *int32 `json:"p50Milliseconds,omitempty"`
+
+	// P90Milliseconds is the 90th percentile latency target.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=600000
+	P90Milliseconds *int32 `json:"p90Milliseconds,omitempty"`
+
+	// P99Milliseconds is the 99th percentile latency target.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=600000
+	P99Milliseconds *int32 `json:"p99Milliseconds,omitempty"`
+}
+
+// WorkloadType is the kind of model served by a generated deployment.
+// +kubebuilder:validation:Enum=llm;embedding;reranker
+type WorkloadType string
+
+const (
+	// WorkloadTypeLLM is a generative model with per-token latency targets.
+	WorkloadTypeLLM WorkloadType = "llm"
+	// WorkloadTypeEmbedding is an embedding model with per-batch latency targets.
+	WorkloadTypeEmbedding WorkloadType = "embedding"
+	// WorkloadTypeReranker is a reranker model with per-batch latency targets.
+	WorkloadTypeReranker WorkloadType = "reranker"
+)
+
+// ProfilingMode is how the deployment of a DGDR is sized.
+// +kubebuilder:validation:Enum=online;aic;none
+type ProfilingMode string
+
+const (
+	// ProfilingModeOnline deploys and benchmarks candidate configurations on the cluster's GPUs.
+	ProfilingModeOnline ProfilingMode = "online"
+	// ProfilingModeAIC estimates candidate configurations with the AI Configurator, without GPUs.
+	ProfilingModeAIC ProfilingMode = "aic"
+	// ProfilingModeNone skips profiling and validates and applies spec.precomputedDeployment.
+	ProfilingModeNone ProfilingMode = "none"
+)
+
+// CleanupPolicy is which generated artifacts are deleted when their DGDR is deleted.
+// +kubebuilder:validation:Enum=DeleteAll;RetainDGD;RetainAll
+type CleanupPolicy string
+
+const (
+	// CleanupPolicyDeleteAll deletes the auto-created DGD and the profiling results.
+	CleanupPolicyDeleteAll CleanupPolicy = "DeleteAll"
+	// CleanupPolicyRetainDGD keeps the auto-created DGD serving and deletes the profiling results.
+	CleanupPolicyRetainDGD CleanupPolicy = "RetainDGD"
+	// CleanupPolicyRetainAll keeps the auto-created DGD and the profiling results.
+	CleanupPolicyRetainAll CleanupPolicy = "RetainAll"
+)
+
+// SecretPurpose is what a secret of spec.secrets holds, which decides how it is wired into the
+// generated DynamoGraphDeployment.
+// +kubebuilder:validation:Enum=hfToken;registry;s3
+type SecretPurpose string
+
+const (
+	// SecretPurposeHFToken is a Hugging Face token under the HF_TOKEN key. It becomes the
+	// envFromSecret of the services, and the profiling job and adapter downloads use it.
+	SecretPurposeHFToken SecretPurpose = "hfToken"
+	// SecretPurposeRegistry is an image pull secret added to the imagePullSecrets of the services.
+	SecretPurposeRegistry SecretPurpose = "registry"
+	// SecretPurposeS3 holds object storage credentials exposed to the main container of the
+	// services as environment variables, e.g. AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY.
+	SecretPurposeS3 SecretPurpose = "s3"
+)
+
+// DeploymentSecret is a Secret wired into the generated DynamoGraphDeployment.
+type DeploymentSecret struct {
+	// Purpose is what the secret holds.
+	Purpose SecretPurpose `json:"purpose"`
+
+	// Name is the name of the Secret in the namespace of the generated deployment.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Services are the services of the generated deployment the secret is wired into.
+	// All services when empty.
+	// +kubebuilder:validation:Optional
+	Services []string `json:"services,omitempty"`
+}
+
+// AcceleratorVendor is the vendor of the GPUs a model is profiled and deployed on.
+// +kubebuilder:validation:Enum=nvidia;amd
+type AcceleratorVendor string
+
+const (
+	// AcceleratorVendorNVIDIA requests NVIDIA GPUs as nvidia.com/gpu.
+	AcceleratorVendorNVIDIA AcceleratorVendor = "nvidia"
+	// AcceleratorVendorAMD requests AMD GPUs as amd.com/gpu.
+	AcceleratorVendorAMD AcceleratorVendor = "amd"
+)
+
+// HardwareSpec describes the accelerators a model is profiled and deployed on.
+type HardwareSpec struct {
+	// Vendor is the GPU vendor. It selects the extended resource GPUs are requested with and the
+	// backends and profiling modes that are available: trtllm and the AI Configurator only
+	// support NVIDIA GPUs.
+	// +kubebuilder:default=nvidia
+	// +kubebuilder:validation:Optional
+	Vendor AcceleratorVendor `json:"vendor,omitempty"`
+
+	// GPUResourceName overrides the extended resource GPUs are requested with by the profiling
+	// deployments and the generated deployment, e.g. a MIG profile such as nvidia.com/mig-3g.40gb.
+	// Defaults to nvidia.com/gpu or amd.com/gpu depending on the vendor.
+	// +kubebuilder:validation:Optional
+	GPUResourceName string `json:"gpuResourceName,omitempty"`
+}
+
+// FeaturesSpec enables serving features of the generated deployment.
+type FeaturesSpec struct {
+	// Disaggregation serves prefill and decode in separate workers.
+	// +kubebuilder:validation:Optional
+	Disaggregation *bool `json:"disaggregation,omitempty"`
+
+	// SpeculativeDecoding profiles the model with and without speculative decoding, and deploys
+	// it with speculation if the profiler measured a benefit. The outcome is reported in
+	// status.speculativeDecoding.
+	// +kubebuilder:validation:Optional
+	SpeculativeDecoding *SpeculativeDecodingSpec `json:"speculativeDecoding,omitempty"`
+
+	// OnUnsupported is what happens to a DGDR enabling a feature its backend is known not to
+	// support: Reject fails validation, Downgrade disables the feature with a warning and lists
+	// it in status.disabledFeatures.
+	// +kubebuilder:default=Reject
+	// +kubebuilder:validation:Optional
+	OnUnsupported UnsupportedFeaturePolicy `json:"onUnsupported,omitempty"`
+}
+
+// SpeculativeDecodingSpec configures speculative decoding.
+// +kubebuilder:validation:XValidation:rule="self.method == 'ngram' || has(self.draftModel)",message="draftModel is required unless method is ngram"
+// +kubebuilder:validation:XValidation:rule="self.method != 'ngram' || !has(self.draftModel)",message="draftModel is not used by method ngram"
+type SpeculativeDecodingSpec struct {
+	// Method is how tokens are speculated: "eagle" and "eagle3" use an EAGLE head trained for the
+	// model, "draft" a smaller model of the same family, and "ngram" looks tokens up in the prompt
+	// without a draft model.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=eagle;eagle3;draft;ngram
+	Method SpeculativeDecodingMethod `json:"method"`
+
+	// DraftModel is the Hugging Face name of the EAGLE head or draft model, e.g.
+	// yuhuili/EAGLE3-LLaMA3.1-Instruct-8B. It is downloaded by the workers like the model.
+	// +kubebuilder:validation:Optional
+	DraftModel string `json:"draftModel,omitempty"`
+
+	// NumSpeculativeTokens is how many tokens are speculated per step.
+	// +kubebuilder:default=3
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=16
+	// +kubebuilder:validation:Optional
+	NumSpeculativeTokens int32 `json:"numSpeculativeTokens,omitempty"`
+}
+
+// SpeculativeDecodingMethod is how speculative tokens are produced.
+type SpeculativeDecodingMethod string
+
+const (
+	// SpeculativeDecodingMethodEAGLE uses an EAGLE head.
+	SpeculativeDecodingMethodEAGLE SpeculativeDecodingMethod = "eagle"
+	// SpeculativeDecodingMethodEAGLE3 uses an EAGLE-3 head.
+	SpeculativeDecodingMethodEAGLE3 SpeculativeDecodingMethod = "eagle3"
+	// SpeculativeDecodingMethodDraft uses a smaller draft model.
+	SpeculativeDecodingMethodDraft SpeculativeDecodingMethod = "draft"
+	// SpeculativeDecodingMethodNgram looks speculative tokens up in the prompt.
+	SpeculativeDecodingMethodNgram SpeculativeDecodingMethod = "ngram"
+)
+
+// UnsupportedFeaturePolicy is what happens to features a backend does not support.
+// +kubebuilder:validation:Enum=Reject;Downgrade
+type UnsupportedFeaturePolicy string
+
+const (
+	// UnsupportedFeaturePolicyReject fails validation.
+	UnsupportedFeaturePolicyReject UnsupportedFeaturePolicy = "Reject"
+	// UnsupportedFeaturePolicyDowngrade disables the feature with a warning.
+	UnsupportedFeaturePolicyDowngrade UnsupportedFeaturePolicy = "Downgrade"
+)
+
+// NodeReservationSpec selects and reserves nodes for the duration of online profiling.
+type NodeReservationSpec struct {
+	// NodeSelector selects the candidate nodes, e.g. a dedicated profiling nodepool label.
+	// +kubebuilder:validation:Required
+	NodeSelector map[string]string `json:"nodeSelector"`
+
+	// NodeCount is the number of nodes to reserve.
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Optional
+	NodeCount int32 `json:"nodeCount,omitempty"`
+
+	// Taint controls whether reserved nodes are tainted with NoSchedule while profiling runs.
+	// If false, reserved nodes are only labeled and targeted by the profiling deployments.
+	// +kubebuilder:default=true
+	// +kubebuilder:validation:Optional
+	Taint *bool `json:"taint,omitempty"`
+}
+
+// SnapshotReference points to a DGDR snapshot previously exported by the operator.
+// Snapshots are written to a ConfigMap when a DGDR is annotated with nvidia.com/dgdr-export: "true".
+// The ConfigMap is owned by the exported DGDR, copy it before deleting the DGDR.
+type SnapshotReference struct {
+	// ConfigMapName is the name of the ConfigMap holding the snapshot, in the DGDR namespace.
+	// +kubebuilder:validation:Required
+	ConfigMapName string `json:"configMapName"`
+
+	// Key in the ConfigMap holding the snapshot. If not specified, defaults to "snapshot.yaml".
+	// +kubebuilder:default=snapshot.yaml
+	Key string `json:"key,omitempty"`
 }
 
 // DeploymentOverridesSpec allows users to customize metadata for auto-created DynamoGraphDeployments.
 // When autoApply is enabled, these overrides are applied to the generated DGD resource.
+// +kubebuilder:validation:XValidation:rule="!has(self.applyToExisting) || !self.applyToExisting || (has(self.name) && self.name != '')",message="applyToExisting requires name"
 type DeploymentOverridesSpec struct {
 	// Name is the desired name for the created DynamoGraphDeployment.
 	// If not specified, defaults to the DGDR name.
@@ -90,30 +620,212 @@ type DeploymentOverridesSpec struct {
 	// +kubebuilder:validation:Optional
 	Annotations map[string]string `json:"annotations,omitempty"`
 
+	// PodAnnotations are added to the pods of every service of the created DynamoGraphDeployment,
+	// e.g. sidecar.istio.io/inject or linkerd.io/inject to opt in or out of a service mesh. They
+	// win over the sidecar injection defaults of the operator and are never set on profiling jobs.
+	// Enabling the injection of more than one mesh is rejected.
+	// +kubebuilder:validation:Optional
+	PodAnnotations map[string]string `json:"podAnnotations,omitempty"`
+
 	// WorkersImage specifies the container image to use for DynamoGraphDeployment worker components.
 	// This image is used for both temporary DGDs created during online profiling and the final DGD.
 	// If omitted, the image from the base config file (e.g., disagg.yaml) is used.
 	// Example: "nvcr.io/nvidia/ai-dynamo/vllm-runtime:0.6.1"
 	// +kubebuilder:validation:Optional
 	WorkersImage string `json:"workersImage,omitempty"`
+
+	// ServiceAccountName maps service names of the generated DynamoGraphDeployment to the
+	// ServiceAccount their pods run as, e.g. to grant workers access to model secrets or cloud
+	// workload identity. Services that are not listed keep the default ServiceAccount.
+	// +kubebuilder:validation:Optional
+	ServiceAccountName map[string]string `json:"serviceAccountName,omitempty"`
+
+	// CreateServiceAccounts provisions the ServiceAccounts listed in serviceAccountName in the
+	// DynamoGraphDeployment namespace and binds them to the worker ClusterRole configured on the
+	// operator with --dgdr-worker-cluster-role-name. Existing ServiceAccounts are reused.
+	// +kubebuilder:validation:Optional
+	CreateServiceAccounts bool `json:"createServiceAccounts,omitempty"`
+
+	// CreatePodDisruptionBudgets creates a PodDisruptionBudget for every frontend and worker service
+	// of the created DynamoGraphDeployment. Each budget keeps the replicas the profiler sized the
+	// service to (or the autoscaling minimum) available, so voluntary disruptions such as node
+	// drains cannot take the deployment below its SLA. The budgets are owned by the DGDR.
+	// +kubebuilder:validation:Optional
+	CreatePodDisruptionBudgets bool `json:"createPodDisruptionBudgets,omitempty"`
+
+	// PinImageDigests resolves the image tags of the generated DynamoGraphDeployment to digests
+	// as soon as the deployment is generated, so that the created DynamoGraphDeployment runs the
+	// images that were profiled even if their tags move later. Registries are queried with the
+	// image pull secrets of each service and the docker config secrets of the target namespace.
+	// The resolved digests are reported in status.pinnedImages.
+	// +kubebuilder:validation:Optional
+	PinImageDigests bool `json:"pinImageDigests,omitempty"`
+
+	// Services maps service names of the generated DynamoGraphDeployment to typed overrides that
+	// are deep merged into the profiled service spec. Overrides that contradict the profiled
+	// requirements, such as fewer GPUs than the profiled parallelism needs, are still applied and
+	// reported in the OverridesApplied condition.
+	// +kubebuilder:validation:Optional
+	Services map[string]ServiceOverride `json:"services,omitempty"`
+
+	// Patches are applied in order to the generated DynamoGraphDeployment after every other
+	// override, for changes the typed fields do not cover. They must not change its apiVersion,
+	// kind, name or namespace. The fields they changed are reported in the PatchesApplied condition
+	// and the patched deployment in status.generatedDeployment, so they can be checked before the
+	// deployment is applied.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=32
+	Patches []DeploymentPatch `json:"patches,omitempty"`
+
+	// ApplyToExisting merges the generated spec into the existing DynamoGraphDeployment named by
+	// name instead of creating one. The merge is three-way: fields the generated spec stops setting
+	// are removed, fields changed on the DynamoGraphDeployment but not by a new generated spec are
+	// kept. The changed fields are reported in the AppliedToExisting condition. Requires autoApply.
+	// +kubebuilder:validation:Optional
+	ApplyToExisting bool `json:"applyToExisting,omitempty"`
+}
+
+// DeploymentPatchType is how a patch is applied to the generated DynamoGraphDeployment.
+// +kubebuilder:validation:Enum=JSON6902;StrategicMerge
+type DeploymentPatchType string
+
+const (
+	// DeploymentPatchTypeJSON6902 applies a list of RFC 6902 JSON patch operations.
+	DeploymentPatchTypeJSON6902 DeploymentPatchType = "JSON6902"
+	// DeploymentPatchTypeStrategicMerge merges a partial DynamoGraphDeployment. Maps such as
+	// spec.services are merged by key, lists by the merge keys of the Kubernetes types they hold
+	// (e.g. containers and env by name) and replaced otherwise.
+	DeploymentPatchTypeStrategicMerge DeploymentPatchType = "StrategicMerge"
+)
+
+// DeploymentPatch is a patch of the generated DynamoGraphDeployment.
+type DeploymentPatch struct {
+	// Type is how the patch is applied.
+	Type DeploymentPatchType `json:"type"`
+
+	// Patch is the patch document in YAML or JSON: a list of operations for JSON6902, a partial
+	// DynamoGraphDeployment for StrategicMerge.
+	// +kubebuilder:validation:MinLength=1
+	Patch string `json:"patch"`
+}
+
+// ServiceOverride customizes one service of the generated DynamoGraphDeployment.
+type ServiceOverride struct {
+	// Replicas replaces the replica count chosen by the profiler.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// Resources are merged into the profiled resources: every request or limit set here replaces
+	// the profiled value, the others are kept.
+	// +kubebuilder:validation:Optional
+	Resources *dynamoCommon.Resources `json:"resources,omitempty"`
+
+	// Env is merged into the service environment by variable name.
+	// +kubebuilder:validation:Optional
+	Env []corev1.EnvVar `json:"env,omitempty"`
+
+	// Image replaces the main container image of the service.
+	// +kubebuilder:validation:Optional
+	Image string `json:"image,omitempty"`
+
+	// ExtraArgs are appended to the main container arguments of the service.
+	// +kubebuilder:validation:Optional
+	ExtraArgs []string `json:"extraArgs,omitempty"`
+}
+
+// PrecomputedDeploymentSpec supplies a known-good DynamoGraphDeployment in place of profiling results.
+// Exactly one of deployment or configMapRef must be set.
+// +kubebuilder:validation:XValidation:rule="has(self.deployment) != has(self.configMapRef)",message="exactly one of deployment or configMapRef must be set"
+type PrecomputedDeploymentSpec struct {
+	// Deployment is the full DynamoGraphDeployment manifest.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:EmbeddedResource
+	Deployment *runtime.RawExtension `json:"deployment,omitempty"`
+
+	// ConfigMapRef references a ConfigMap key in the DGDR namespace holding the
+	// DynamoGraphDeployment manifest as YAML.
+	// +kubebuilder:validation:Optional
+	ConfigMapRef *ConfigMapKeySelector `json:"configMapRef,omitempty"`
 }
 
 // DynamoGraphDeploymentRequestSpec defines the desired state of a DynamoGraphDeploymentRequest.
 // This CRD serves as the primary interface for users to request model deployments with
 // specific performance constraints and resource requirements, enabling SLA-driven deployments.
+// +kubebuilder:validation:XValidation:rule="!(has(self.importFrom) && has(self.precomputedDeployment))",message="importFrom and precomputedDeployment are mutually exclusive"
+// +kubebuilder:validation:XValidation:rule="!has(self.sla) || !has(self.sla.tokenLatency) || !has(self.workloadType) || self.workloadType == 'llm'",message="sla.tokenLatency is only valid for workloadType llm"
+// +kubebuilder:validation:XValidation:rule="!has(self.sla) || !has(self.sla.batchLatencyMilliseconds) || (has(self.workloadType) && self.workloadType != 'llm')",message="sla.batchLatencyMilliseconds is only valid for workloadType embedding or reranker"
+// +kubebuilder:validation:XValidation:rule="!has(self.sla) || !has(self.sla.minTokensPerSecond) || !has(self.workloadType) || self.workloadType == 'llm'",message="sla.minTokensPerSecond is only valid for workloadType llm"
+// +kubebuilder:validation:XValidation:rule="!has(self.profilingMode) || (self.profilingMode == 'none') == has(self.precomputedDeployment)",message="profilingMode none requires precomputedDeployment, which is only valid with profilingMode none"
+// +kubebuilder:validation:XValidation:rule="(has(self.model) && self.model != '') != has(self.modelRef)",message="exactly one of model and modelRef must be set"
+// +kubebuilder:validation:XValidation:rule="!has(self.deploymentOverrides) || !has(self.deploymentOverrides.applyToExisting) || !self.deploymentOverrides.applyToExisting || self.autoApply",message="deploymentOverrides.applyToExisting requires autoApply"
+// +kubebuilder:validation:XValidation:rule="!has(self.updateStrategy) || self.updateStrategy != 'Recreate' || !has(self.deploymentOverrides) || !has(self.deploymentOverrides.applyToExisting) || !self.deploymentOverrides.applyToExisting",message="updateStrategy Recreate cannot be combined with deploymentOverrides.applyToExisting"
 type DynamoGraphDeploymentRequestSpec struct {
 	// Model specifies the model to deploy (e.g., "Qwen/Qwen3-0.6B", "meta-llama/Llama-3-70b").
 	// This is a high-level identifier for easy reference in kubectl output and logs.
 	// The controller automatically sets this value in profilingConfig.config.deployment.model.
-	// +kubebuilder:validation:Required
-	Model string `json:"model"`
+	// Exactly one of model and modelRef must be set.
+	// +kubebuilder:validation:Optional
+	Model string `json:"model,omitempty"`
+
+	// ModelRef points to a model registry entry the model to deploy is resolved from, instead of
+	// naming it in model. The resolved model name or URI, revision and resources are recorded in
+	// status.resolvedModel, and the revision is pinned for profiling.
+	// +kubebuilder:validation:Optional
+	ModelRef *ModelRefSpec `json:"modelRef,omitempty"`
 
 	// Backend specifies the inference backend to use.
 	// The controller automatically sets this value in profilingConfig.config.engine.backend.
+	// Use "auto" to have AI Configurator evaluate all candidate backends in one run and select
+	// the best one; the per-backend comparison is reported in status.backendComparison.
+	// "auto" requires profilingMode aic.
 	// +kubebuilder:validation:Required
-	// +kubebuilder:validation:Enum=vllm;sglang;trtllm
+	// +kubebuilder:validation:Enum=vllm;sglang;trtllm;auto
 	Backend string `json:"backend"`
 
+	// BackendPreference lists the candidate backends for backend "auto" in order of preference.
+	// The first backend in this list that meets the SLA is selected. If omitted, vllm, sglang and
+	// trtllm are evaluated and the one with the highest throughput per GPU is selected.
+	// Only valid when backend is "auto".
+	// +kubebuilder:validation:Optional
+	BackendPreference []CandidateBackend `json:"backendPreference,omitempty"`
+
+	// WorkloadType is the kind of model served by the graph. Generative "llm" graphs are profiled
+	// against sla.ttft and sla.itl. "embedding" and "reranker" graphs produce no tokens and are
+	// profiled against sla.batch_latency, the latency in milliseconds of a batch of requests; they
+	// do not support backend "auto" and are deployed without the SLA planner.
+	// +kubebuilder:default=llm
+	// +kubebuilder:validation:Optional
+	WorkloadType WorkloadType `json:"workloadType,omitempty"`
+
+	// Hardware describes the accelerators the model is profiled and deployed on. If omitted,
+	// NVIDIA GPUs are used.
+	// +kubebuilder:validation:Optional
+	Hardware *HardwareSpec `json:"hardware,omitempty"`
+
+	// Features enables serving features that not every backend supports. They are checked against
+	// the backend feature matrix of the operator's compatibility matrix before profiling starts,
+	// and passed to the profiler under profilingConfig.config.engine.
+	// +kubebuilder:validation:Optional
+	Features *FeaturesSpec `json:"features,omitempty"`
+
+	// SLA is the load the generated deployment must sustain and the latency it must serve it with.
+	// The controller passes it to the profiler as sla.requests_per_second, sla.concurrent_users,
+	// sla.ttft, sla.itl and sla.batch_latency, overwriting those of profilingConfig.config, and
+	// sizes the frontends of the generated deployment for the load.
+	// +kubebuilder:validation:Optional
+	SLA *SLASpec `json:"sla,omitempty"`
+
+	// ProfilingMode selects how the deployment is sized. "online" deploys and benchmarks candidate
+	// configurations on the cluster's GPUs, "aic" estimates them with the AI Configurator, and
+	// "none" skips profiling to validate and apply spec.precomputedDeployment. The controller sets
+	// sweep.use_ai_configurator of profilingConfig.config to match.
+	// If omitted, it is derived from the rest of the spec: none with precomputedDeployment, aic
+	// when sweep.use_ai_configurator is true, and online otherwise.
+	// +kubebuilder:validation:Optional
+	ProfilingMode ProfilingMode `json:"profilingMode,omitempty"`
+
 	// ProfilingConfig provides the complete configuration for the profiling job.
 	// This configuration is passed directly to the profiler.
 	// The structure matches the profile_sla config format exactly (see ProfilingConfigSpec for schema).
@@ -128,57 +840,914 @@ type DynamoGraphDeploymentRequestSpec struct {
 	// +kubebuilder:default=false
 	AutoApply bool `json:"autoApply,omitempty"`
 
+	// Approval holds autoApply back until a user signs off on the generated spec.
+	// +kubebuilder:validation:Optional
+	Approval *ApprovalSpec `json:"approval,omitempty"`
+
+	// MaxReprofiles is how many times profiling may be restarted with the retry or reprofile
+	// action. Further actions are refused with the ReprofileBudgetExhausted condition, so
+	// automation stuck in a loop cannot keep claiming profiling GPUs. Unset does not limit them.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	MaxReprofiles *int32 `json:"maxReprofiles,omitempty"`
+
+	// DeploymentReadyTimeoutSeconds is how long the auto-created DGD may take to become Ready.
+	// If it is not Ready by then, e.g. because its pods cannot be scheduled, the DGDR fails with
+	// a DeploymentTimeout failure reason and the scheduling diagnostics of its pending pods.
+	// Only applicable when AutoApply is true. No deadline is enforced when unset.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	DeploymentReadyTimeoutSeconds *int32 `json:"deploymentReadyTimeoutSeconds,omitempty"`
+
 	// DeploymentOverrides allows customizing metadata for the auto-created DGD.
 	// Only applicable when AutoApply is true.
 	// +kubebuilder:validation:Optional
 	DeploymentOverrides *DeploymentOverridesSpec `json:"deploymentOverrides,omitempty"`
-}
 
-// DeploymentStatus tracks the state of an auto-created DynamoGraphDeployment.
-// This status is populated when autoApply is enabled and a DGD is created.
-type DeploymentStatus struct {
-	// Name is the name of the created DynamoGraphDeployment.
-	Name string `json:"name,omitempty"`
+	// CleanupPolicy decides which generated artifacts are deleted with the DGDR. Retained
+	// artifacts are released from the DGDR, so a profiled deployment can be handed off and the
+	// request removed. applyToExisting DGDs predate the DGDR and are always retained.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=RetainDGD
+	CleanupPolicy CleanupPolicy `json:"cleanupPolicy,omitempty"`
 
-	// Namespace is the namespace of the created DynamoGraphDeployment.
-	Namespace string `json:"namespace,omitempty"`
+	// Secrets are wired into the services of the generated DynamoGraphDeployment by purpose, so
+	// generated specs need no manual edits to reach private models, registries or buckets.
+	// At most one secret may have the hfToken purpose.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=16
+	Secrets []DeploymentSecret `json:"secrets,omitempty"`
 
-	// State is the current state of the DynamoGraphDeployment.
-	// This value is mirrored from the DGD's status.state field.
-	State string `json:"state,omitempty"`
+	// MaintenanceWindow restricts when the auto-created DGD is created or changed. Outside the
+	// window the DGDR holds a WaitingForMaintenanceWindow condition and applies the deployment,
+	// re-profiled specs and redeployments once the next window opens.
+	// Only applicable when AutoApply is true. Changes are applied at any time when unset.
+	// +kubebuilder:validation:Optional
+	MaintenanceWindow *MaintenanceWindowSpec `json:"maintenanceWindow,omitempty"`
 
-	// Created indicates whether the DGD has been successfully created.
-	// Used to prevent recreation if the DGD is manually deleted by users.
-	Created bool `json:"created,omitempty"`
-}
+	// UpdateStrategy controls how a regenerated spec, e.g. after re-profiling, reaches the
+	// auto-created DGD. RollingUpdate (the default) patches the DGD in place, Recreate deletes it
+	// and creates it again with the new spec, and Manual leaves the DGD unchanged and raises an
+	// UpdatePending condition until the nvidia.com/dgdr-action annotation is set to apply-update.
+	// Recreate cannot be combined with deploymentOverrides.applyToExisting, whose DGD the DGDR does
+	// not own. Only applicable when AutoApply is true.
+	// +kubebuilder:validation:Optional
+	UpdateStrategy DGDUpdateStrategy `json:"updateStrategy,omitempty"`
 
-// DynamoGraphDeploymentRequestStatus represents the observed state of a DynamoGraphDeploymentRequest.
-// The controller updates this status as the DGDR progresses through its lifecycle.
-type DynamoGraphDeploymentRequestStatus struct {
-	// State is a high-level textual status of the deployment request lifecycle.
-	// Possible values: "", "Pending", "Profiling", "Deploying", "Ready", "DeploymentDeleted", "Failed"
-	// Empty string ("") represents the initial state before initialization.
-	State string `json:"state,omitempty"`
+	// GeneratedSpecValidity bounds how long a generated spec is trusted: hardware and software
+	// drift make old profiles stale. Expired specs are reported in the SpecStale condition.
+	// +kubebuilder:validation:Optional
+	GeneratedSpecValidity *GeneratedSpecValiditySpec `json:"generatedSpecValidity,omitempty"`
 
-	// Backend is extracted from profilingConfig.config.engine.backend for display purposes.
-	// This field is populated by the controller and shown in kubectl output.
+	// Output controls how the generated deployment is rendered.
 	// +kubebuilder:validation:Optional
-	Backend string `json:"backend,omitempty"`
+	Output *OutputSpec `json:"output,omitempty"`
 
-	// ObservedGeneration reflects the generation of the most recently observed spec.
-	// Used to detect spec changes and enforce immutability after profiling starts.
+	// ImportFrom rehydrates this DGDR from a snapshot exported on another cluster.
+	// When set, profiling is skipped: the generated deployment and profiling metadata are
+	// restored from the snapshot and the DGDR moves directly to Ready (or Deploying with autoApply).
+	// Spec fields left unset are taken from the snapshot, and the resulting spec is validated.
+	// +kubebuilder:validation:Optional
+	ImportFrom *SnapshotReference `json:"importFrom,omitempty"`
+
+	// PrecomputedDeployment provides a known-good DynamoGraphDeployment so profiling can be skipped.
+	// The DGDR then only validates the deployment, applies deploymentOverrides, optionally
+	// creates it (autoApply) and monitors it. profilingConfig.config is ignored.
+	// Requires profilingMode none, which is implied when profilingMode is omitted.
+	// +kubebuilder:validation:Optional
+	PrecomputedDeployment *PrecomputedDeploymentSpec `json:"precomputedDeployment,omitempty"`
+
+	// Adapters lists the LoRA adapters served alongside the base model. The profiler measures
+	// serving with all adapters loaded, and the generated DGD downloads the adapters into every
+	// worker and loads them at startup. Adapters are only supported by the sglang backend.
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=64
+	Adapters []AdapterSpec `json:"adapters,omitempty"`
+
+	// SupportBundle collects the diagnostics of a failed DGDR into a single compressed artifact,
+	// referenced in status.supportBundle, that can be attached to bug reports as is: the DGDR, the
+	// profiling job with its pods and events, the tail of the profiler log, the operator log lines
+	// about the DGDR and the GPU status of the nodes. No bundle is collected when unset.
+	// +kubebuilder:validation:Optional
+	SupportBundle *SupportBundleSpec `json:"supportBundle,omitempty"`
+
+	// Hooks are Jobs run before and after the profiling job, e.g. to warm a dataset cache PVC or
+	// to notify a capacity system. They are not run when profiling is skipped.
+	// +kubebuilder:validation:Optional
+	Hooks *ProfilingHooksSpec `json:"hooks,omitempty"`
+}
+
+// ProfilingHooksSpec lists the hooks run around the profiling job.
+type ProfilingHooksSpec struct {
+	// PreProfiling hooks run one after the other before the profiling job is created.
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=8
+	PreProfiling []ProfilingHook `json:"preProfiling,omitempty"`
+
+	// PostProfiling hooks run one after the other once the profiling job succeeded, before the
+	// deployment is generated from its results.
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=8
+	PostProfiling []ProfilingHook `json:"postProfiling,omitempty"`
+}
+
+// ProfilingHookFailurePolicy is how a failed hook affects the DGDR.
+// +kubebuilder:validation:Enum=Fail;Ignore
+type ProfilingHookFailurePolicy string
+
+const (
+	// ProfilingHookFailurePolicyFail fails the DGDR with failure reason HookFailed.
+	ProfilingHookFailurePolicyFail ProfilingHookFailurePolicy = "Fail"
+	// ProfilingHookFailurePolicyIgnore reports a warning and goes on with the next step.
+	ProfilingHookFailurePolicyIgnore ProfilingHookFailurePolicy = "Ignore"
+)
+
+// ProfilingHook is a Job run before or after the profiling job, given either as a single container
+// or as a full Job template. Its containers get the DGDR_NAME, DGDR_NAMESPACE and DGDR_HOOK_PHASE
+// environment variables. The operator creates the Job, so hooks may not set a service account,
+// host namespaces, hostPath volumes, host ports or privileged containers.
+// +kubebuilder:validation:XValidation:rule="has(self.container) != has(self.jobTemplate)",message="exactly one of container or jobTemplate must be set"
+type ProfilingHook struct {
+	// Name identifies the hook, it is part of the name of its Job.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=20
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name"`
+
+	// Container is the container (core/v1 Container) the hook Job runs.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:Type=object
+	Container *runtime.RawExtension `json:"container,omitempty"`
+
+	// JobTemplate is the template (batch/v1 JobTemplateSpec) of the hook Job, for hooks that need
+	// volumes or several containers.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:Type=object
+	JobTemplate *runtime.RawExtension `json:"jobTemplate,omitempty"`
+
+	// FailurePolicy is what a failed hook does to the DGDR. Fail (the default) fails it, Ignore
+	// reports a warning and goes on.
+	// +kubebuilder:validation:Optional
+	FailurePolicy ProfilingHookFailurePolicy `json:"failurePolicy,omitempty"`
+
+	// TimeoutSeconds limits how long the hook Job runs before it fails. Defaults to 3600. A Job
+	// template setting activeDeadlineSeconds keeps its own.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	TimeoutSeconds *int64 `json:"timeoutSeconds,omitempty"`
+}
+
+// ProfilingHookPhase is when a hook runs relative to the profiling job.
+type ProfilingHookPhase string
+
+const (
+	// ProfilingHookPhasePre hooks run before the profiling job is created.
+	ProfilingHookPhasePre ProfilingHookPhase = "PreProfiling"
+	// ProfilingHookPhasePost hooks run after the profiling job succeeded.
+	ProfilingHookPhasePost ProfilingHookPhase = "PostProfiling"
+)
+
+// ProfilingHookState is the state of the Job of a hook.
+type ProfilingHookState string
+
+const (
+	// ProfilingHookRunning means the hook Job has not finished yet.
+	ProfilingHookRunning ProfilingHookState = "Running"
+	// ProfilingHookSucceeded means the hook Job completed.
+	ProfilingHookSucceeded ProfilingHookState = "Succeeded"
+	// ProfilingHookFailed means the hook Job failed or exceeded its timeout.
+	ProfilingHookFailed ProfilingHookState = "Failed"
+)
+
+// ProfilingHookStatus is the outcome of a hook of the current profiling run.
+type ProfilingHookStatus struct {
+	// Name is the name of the hook.
+	Name string `json:"name"`
+
+	// Phase is PreProfiling or PostProfiling.
+	Phase ProfilingHookPhase `json:"phase"`
+
+	// JobName is the name of the hook Job.
+	JobName string `json:"jobName"`
+
+	// State is Running, Succeeded or Failed.
+	State ProfilingHookState `json:"state"`
+
+	// StartTime is when the hook Job was created.
+	StartTime metav1.Time `json:"startTime"`
+
+	// CompletionTime is when the hook Job finished.
+	// +kubebuilder:validation:Optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Message explains why the hook failed.
+	// +kubebuilder:validation:Optional
+	Message string `json:"message,omitempty"`
+}
+
+// OwnedResourceRole is what an object the operator created for a DGDR is used for.
+// +kubebuilder:validation:Enum=profiling-job;hook-job;output;dgd;generated;networkpolicy;pdb;podmonitor;artifacts-pvc;artifacts-browser;support-bundle;comparison;snapshot
+type OwnedResourceRole string
+
+const (
+	// OwnedResourceRoleProfilingJob is the Job of a profiling attempt.
+	OwnedResourceRoleProfilingJob OwnedResourceRole = "profiling-job"
+	// OwnedResourceRoleHookJob is the Job of a pre- or post-profiling hook.
+	OwnedResourceRoleHookJob OwnedResourceRole = "hook-job"
+	// OwnedResourceRoleOutput is the ConfigMap or Secret holding the profiling results.
+	OwnedResourceRoleOutput OwnedResourceRole = "output"
+	// OwnedResourceRoleDGD is the DynamoGraphDeployment created with autoApply.
+	OwnedResourceRoleDGD OwnedResourceRole = "dgd"
+	// OwnedResourceRoleGenerated is an additional resource generated alongside the DGD.
+	OwnedResourceRoleGenerated OwnedResourceRole = "generated"
+	// OwnedResourceRoleNetworkPolicy is the NetworkPolicy isolating the profiling job.
+	OwnedResourceRoleNetworkPolicy OwnedResourceRole = "networkpolicy"
+	// OwnedResourceRolePDB is a PodDisruptionBudget protecting a service of the DGD.
+	OwnedResourceRolePDB OwnedResourceRole = "pdb"
+	// OwnedResourceRolePodMonitor is a PodMonitor scraping a component of the DGD.
+	OwnedResourceRolePodMonitor OwnedResourceRole = "podmonitor"
+	// OwnedResourceRoleArtifactsPVC is the claim provisioned for the profiling artifacts.
+	OwnedResourceRoleArtifactsPVC OwnedResourceRole = "artifacts-pvc"
+	// OwnedResourceRoleArtifactsBrowser is the pod browsing the profiling artifacts.
+	OwnedResourceRoleArtifactsBrowser OwnedResourceRole = "artifacts-browser"
+	// OwnedResourceRoleSupportBundle is the ConfigMap holding the support bundle of a failed DGDR.
+	OwnedResourceRoleSupportBundle OwnedResourceRole = "support-bundle"
+	// OwnedResourceRoleComparison is the ConfigMap comparing the DGDR with another one.
+	OwnedResourceRoleComparison OwnedResourceRole = "comparison"
+	// OwnedResourceRoleSnapshot is the exported snapshot ConfigMap, which outlives the DGDR.
+	OwnedResourceRoleSnapshot OwnedResourceRole = "snapshot"
+)
+
+// OwnedResource references an object the operator created for the DGDR.
+type OwnedResource struct {
+	// APIVersion is the API version of the object, e.g. "batch/v1".
+	APIVersion string `json:"apiVersion"`
+
+	// Kind is the kind of the object, e.g. "Job".
+	Kind string `json:"kind"`
+
+	// Namespace is the namespace of the object.
+	Namespace string `json:"namespace"`
+
+	// Name is the name of the object.
+	Name string `json:"name"`
+
+	// UID is the UID of the object, telling it apart from a later object with the same name.
+	// +kubebuilder:validation:Optional
+	UID types.UID `json:"uid,omitempty"`
+
+	// Role is what the object is used for.
+	Role OwnedResourceRole `json:"role"`
+}
+
+// SupportBundleStorage is where the support bundle of a failed DGDR is written.
+// +kubebuilder:validation:Enum=ConfigMap;PVC
+type SupportBundleStorage string
+
+const (
+	// SupportBundleStorageConfigMap stores the bundle in a ConfigMap in the DGDR namespace.
+	SupportBundleStorageConfigMap SupportBundleStorage = "ConfigMap"
+	// SupportBundleStoragePVC stores the bundle on the shared profiling output volume.
+	SupportBundleStoragePVC SupportBundleStorage = "PVC"
+)
+
+// SupportBundleSpec configures the support bundle collected when the DGDR fails.
+type SupportBundleSpec struct {
+	// Storage is where the bundle is written. ConfigMap (the default) stores it in the
+	// dgdr-support-bundle-<name> ConfigMap, owned by the DGDR; logs are truncated to fit the 1MiB
+	// ConfigMap limit. PVC stores it on the shared profiling output volume, which the operator must
+	// mount, and keeps it after the DGDR is deleted.
+	// +kubebuilder:validation:Optional
+	Storage SupportBundleStorage `json:"storage,omitempty"`
+
+	// LogTailLines is how many lines from the end of the profiler log are collected. Defaults to 500.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10000
+	LogTailLines *int64 `json:"logTailLines,omitempty"`
+}
+
+// DGDUpdateStrategy is how a regenerated spec is applied to the auto-created DGD.
+// +kubebuilder:validation:Enum=RollingUpdate;Recreate;Manual
+type DGDUpdateStrategy string
+
+const (
+	// DGDUpdateStrategyRollingUpdate patches the DGD in place.
+	DGDUpdateStrategyRollingUpdate DGDUpdateStrategy = "RollingUpdate"
+	// DGDUpdateStrategyRecreate deletes the DGD and creates it with the regenerated spec.
+	DGDUpdateStrategyRecreate DGDUpdateStrategy = "Recreate"
+	// DGDUpdateStrategyManual only reports the pending update.
+	DGDUpdateStrategyManual DGDUpdateStrategy = "Manual"
+)
+
+// MaintenanceWindowSpec describes the recurring windows in which the auto-created DGD may change.
+type MaintenanceWindowSpec struct {
+	// Schedule is a cron expression (minute hour day-of-month month day-of-week) of the times
+	// the windows open, e.g. "0 2 * * 6" for Saturdays at 02:00.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Schedule string `json:"schedule"`
+
+	// Duration is how long each window stays open, e.g. "4h".
+	// +kubebuilder:validation:Required
+	Duration metav1.Duration `json:"duration"`
+
+	// TimeZone is the IANA time zone the schedule is evaluated in, e.g. "Europe/Berlin".
+	// Defaults to UTC.
+	// +kubebuilder:validation:Optional
+	TimeZone string `json:"timeZone,omitempty"`
+}
+
+// GeneratedSpecValiditySpec describes how long a generated spec stays valid.
+type GeneratedSpecValiditySpec struct {
+	// ValidFor is how long a generated spec stays valid after it was generated, e.g. "720h".
+	// Defaults to the operator's --dgdr-generated-spec-validity. Zero never expires the spec.
+	// +kubebuilder:validation:Optional
+	ValidFor *metav1.Duration `json:"validFor,omitempty"`
+
+	// BlockApply stops autoApply from creating, updating or redeploying the DGD from an expired
+	// spec until the DGDR is re-profiled with the nvidia.com/dgdr-action: reprofile annotation.
+	// The running DGD is left untouched.
+	// +kubebuilder:validation:Optional
+	BlockApply bool `json:"blockApply,omitempty"`
+}
+
+// AdapterSpec describes a LoRA adapter served on top of the base model.
+type AdapterSpec struct {
+	// Name is the adapter name clients use to select it, e.g. as the model in OpenAI requests.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9_.]*[a-z0-9])?$`
+	// +kubebuilder:validation:MaxLength=63
+	Name string `json:"name"`
+
+	// Source is the Hugging Face repository of the adapter, e.g. "org/adapter" or "hf://org/adapter".
+	// +kubebuilder:validation:Required
+	Source string `json:"source"`
+
+	// Revision is the branch, tag or commit of the adapter repository. Defaults to the main branch.
+	// +kubebuilder:validation:Optional
+	Revision string `json:"revision,omitempty"`
+}
+
+// OutputFormat is the format the generated deployment is rendered in.
+// +kubebuilder:validation:Enum=DynamoGraphDeployment;RawManifests
+type OutputFormat string
+
+const (
+	// OutputFormatDynamoGraphDeployment renders the generated deployment as a DynamoGraphDeployment.
+	OutputFormatDynamoGraphDeployment OutputFormat = "DynamoGraphDeployment"
+	// OutputFormatRawManifests additionally flattens the generated deployment into plain
+	// Deployments and Services for clusters that do not run the Dynamo operator.
+	OutputFormatRawManifests OutputFormat = "RawManifests"
+)
+
+// WorkloadFlavor is the kind of workload multinode services of the generated deployment run as.
+// +kubebuilder:validation:Enum=dgd;lws;grove
+type WorkloadFlavor string
+
+const (
+	// WorkloadFlavorDGD leaves the choice to the Dynamo operator. Raw manifests cannot express
+	// multinode services with it.
+	WorkloadFlavorDGD WorkloadFlavor = "dgd"
+	// WorkloadFlavorLWS runs multinode services as LeaderWorkerSets.
+	WorkloadFlavorLWS WorkloadFlavor = "lws"
+	// WorkloadFlavorGrove runs the deployment as a Grove PodCliqueSet.
+	WorkloadFlavorGrove WorkloadFlavor = "grove"
+)
+
+// OutputSpec controls how the generated deployment is rendered.
+type OutputSpec struct {
+	// Format is the output format of the generated deployment.
+	// With RawManifests, the flattened manifests are written to the profiling output ConfigMap
+	// under the raw_manifests.yaml key and referenced from status.renderedManifests.
+	// RawManifests cannot be combined with autoApply.
+	// +kubebuilder:default=DynamoGraphDeployment
+	// +kubebuilder:validation:Optional
+	Format OutputFormat `json:"format,omitempty"`
+
+	// WorkloadFlavor selects the workload kind multinode inference runs as, for clusters
+	// standardizing on LeaderWorkerSet or Grove. With format DynamoGraphDeployment, lws and grove pin
+	// the generated DGD to that orchestrator. With RawManifests, lws renders multinode services as
+	// LeaderWorkerSets next to the Deployments of the other services, and grove renders the whole
+	// deployment as a PodCliqueSet. The profiled topology is checked against the flavor when the
+	// deployment is generated, e.g. LeaderWorkerSet workers must request GPUs.
+	// +kubebuilder:default=dgd
+	// +kubebuilder:validation:Optional
+	WorkloadFlavor WorkloadFlavor `json:"workloadFlavor,omitempty"`
+}
+
+// DeploymentStatus tracks the state of an auto-created DynamoGraphDeployment.
+// This status is populated when autoApply is enabled and a DGD is created.
+type DeploymentStatus struct {
+	// Name is the name of the created DynamoGraphDeployment.
+	Name string `json:"name,omitempty"`
+
+	// Namespace is the namespace of the created DynamoGraphDeployment.
+	Namespace string `json:"namespace,omitempty"`
+
+	// State is the current state of the DynamoGraphDeployment.
+	// This value is mirrored from the DGD's status.state field.
+	State string `json:"state,omitempty"`
+
+	// Created indicates whether the DGD has been successfully created.
+	// Used to prevent recreation if the DGD is manually deleted by users.
+	Created bool `json:"created,omitempty"`
+
+	// DeployingSince is when the DGDR started waiting for the DGD to become Ready.
+	// The deploymentReadyTimeoutSeconds deadline is measured from this time.
+	// +kubebuilder:validation:Optional
+	DeployingSince *metav1.Time `json:"deployingSince,omitempty"`
+
+	// SchedulingDiagnostics describes why the pods of the DGD were still pending when the
+	// deploymentReadyTimeoutSeconds deadline passed.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=10
+	SchedulingDiagnostics []SchedulingDiagnostic `json:"schedulingDiagnostics,omitempty"`
+
+	// DegradedSince is when the DGD was first observed non-Ready while the DGDR is Degraded.
+	// +kubebuilder:validation:Optional
+	DegradedSince *metav1.Time `json:"degradedSince,omitempty"`
+
+	// DegradedObservations counts the consecutive non-Ready observations of the DGD while the DGDR is Degraded.
+	// +kubebuilder:validation:Optional
+	DegradedObservations int32 `json:"degradedObservations,omitempty"`
+}
+
+// EndpointStatus tells clients how to call the model served by the auto-created DGD.
+type EndpointStatus struct {
+	// URL is the external URL of the frontend, from its Ingress or VirtualService.
+	// Empty if the frontend is only reachable inside the cluster.
+	// +kubebuilder:validation:Optional
+	URL string `json:"url,omitempty"`
+
+	// InternalURL is the URL of the frontend Service inside the cluster.
+	InternalURL string `json:"internalURL,omitempty"`
+
+	// APIKeySecretRef references the Secret key holding the API key the frontend requires,
+	// if the frontend reads its *_API_KEY environment variable from a Secret.
+	// +kubebuilder:validation:Optional
+	APIKeySecretRef *SecretKeySelector `json:"apiKeySecretRef,omitempty"`
+}
+
+// SchedulingDiagnostic explains why a pod of the auto-created DGD is not running.
+type SchedulingDiagnostic struct {
+	// Pod is the name of the pending pod.
+	Pod string `json:"pod"`
+
+	// Reason is the machine-readable reason the pod is pending, e.g. Unschedulable or ImagePullBackOff.
+	Reason string `json:"reason,omitempty"`
+
+	// Message is the human-readable explanation, e.g. the scheduler's summary of why no node fits.
+	Message string `json:"message,omitempty"`
+}
+
+// ApprovalSpec gates applying the generated deployment on a manual sign-off.
+type ApprovalSpec struct {
+	// Required pauses autoApply in state AwaitingApproval once the spec is generated, until the
+	// nvidia.com/dgdr-approved: "true" annotation is set on the DGDR. Annotations set before the
+	// DGDR awaits approval are ignored. The user who set it is recorded in the audit log by the
+	// admission webhook, approvals are not attributed while the webhook or its audit log is disabled,
+	// which the ApproverNotRecorded warning reports. Requires autoApply.
+	// +kubebuilder:validation:Optional
+	Required bool `json:"required,omitempty"`
+}
+
+// CandidateBackend is a concrete backend that can be evaluated when backend is "auto".
+// +kubebuilder:validation:Enum=vllm;sglang;trtllm
+type CandidateBackend string
+
+// BackendEvaluation is the AI Configurator estimate for a single backend when backend is "auto".
+type BackendEvaluation struct {
+	// Backend is the evaluated backend.
+	Backend string `json:"backend"`
+
+	// Feasible indicates whether the backend meets the requested SLA.
+	Feasible bool `json:"feasible"`
+
+	// TTFT is the estimated time to first token in milliseconds.
+	// +kubebuilder:validation:Optional
+	TTFT string `json:"ttft,omitempty"`
+
+	// ITL is the estimated inter-token latency in milliseconds.
+	// +kubebuilder:validation:Optional
+	ITL string `json:"itl,omitempty"`
+
+	// ThroughputPerGPU is the estimated throughput in tokens/s per GPU.
+	// +kubebuilder:validation:Optional
+	ThroughputPerGPU string `json:"throughputPerGPU,omitempty"`
+
+	// Selected indicates whether this backend was used for the generated deployment.
+	Selected bool `json:"selected,omitempty"`
+}
+
+// SpeculativeDecodingStatus is the outcome of profiling with speculative decoding.
+type SpeculativeDecodingStatus struct {
+	// Applied indicates whether the workers of the generated deployment speculate.
+	Applied bool `json:"applied"`
+
+	// Measured indicates whether the profiler measured speculation. Speculation that was not
+	// measured is not applied.
+	Measured bool `json:"measured"`
+
+	// ITL and SpeculativeITL are the inter-token latencies in milliseconds measured without and
+	// with speculation.
+	// +kubebuilder:validation:Optional
+	ITL string `json:"itl,omitempty"`
+	// +kubebuilder:validation:Optional
+	SpeculativeITL string `json:"speculativeITL,omitempty"`
+
+	// ThroughputPerGPU and SpeculativeThroughputPerGPU are the throughputs in tokens/s per GPU
+	// measured without and with speculation.
+	// +kubebuilder:validation:Optional
+	ThroughputPerGPU string `json:"throughputPerGPU,omitempty"`
+	// +kubebuilder:validation:Optional
+	SpeculativeThroughputPerGPU string `json:"speculativeThroughputPerGPU,omitempty"`
+
+	// AcceptanceRate is the share of speculated tokens the model accepted.
+	// +kubebuilder:validation:Optional
+	AcceptanceRate string `json:"acceptanceRate,omitempty"`
+}
+
+// ProfilingStatus holds observations collected while profiling ran.
+type ProfilingStatus struct {
+	// Utilization holds GPU statistics per tested configuration.
+	// +kubebuilder:validation:Optional
+	Utilization []GPUUtilization `json:"utilization,omitempty"`
+
+	// FailedAttempts counts the profiling job pods that failed and were retried. Retries resume
+	// the sweep from the last checkpoint the profiler saved, if any.
+	// +kubebuilder:validation:Optional
+	FailedAttempts int32 `json:"failedAttempts,omitempty"`
+
+	// DifferentialFromAttempt is the attempt whose results the profiler re-sweeps around. It is set
+	// when a re-profile only changed the SLA of the profiling config, so the profiler narrows the
+	// sweep around the previous optimum instead of running it in full.
+	// +kubebuilder:validation:Optional
+	DifferentialFromAttempt int32 `json:"differentialFromAttempt,omitempty"`
+}
+
+// ArtifactsStatus records the location and retention of the profiling artifacts.
+type ArtifactsStatus struct {
+	// ClaimName is the claim holding the artifacts, in the DGDR namespace.
+	ClaimName string `json:"claimName"`
+
+	// Path is the directory of the artifacts on the claim.
+	Path string `json:"path"`
+
+	// CreatedTime is when profiling started writing the artifacts.
+	CreatedTime metav1.Time `json:"createdTime"`
+
+	// ExpiryTime is when the artifacts are pruned. Not set when they are kept indefinitely.
+	// +kubebuilder:validation:Optional
+	ExpiryTime *metav1.Time `json:"expiryTime,omitempty"`
+
+	// PrunedTime is when the artifacts were pruned.
+	// +kubebuilder:validation:Optional
+	PrunedTime *metav1.Time `json:"prunedTime,omitempty"`
+
+	// BrowserPod is the pod mounting the artifacts while the DGDR is annotated with
+	// nvidia.com/dgdr-browse-artifacts: "true".
+	// +kubebuilder:validation:Optional
+	BrowserPod string `json:"browserPod,omitempty"`
+}
+
+// SupportBundleStatus references the support bundle collected when the DGDR failed.
+type SupportBundleStatus struct {
+	// Reference locates the bundle, either configmap/<name> with the bundle under the
+	// support-bundle.tar.gz key, or pvc/dynamo-pvc/<path> of the bundle on the profiling output volume.
+	// Empty when the bundle could not be stored.
+	// +kubebuilder:validation:Optional
+	Reference string `json:"reference,omitempty"`
+
+	// CollectedTime is when the bundle was collected.
+	CollectedTime metav1.Time `json:"collectedTime"`
+
+	// Size is the size of the compressed bundle in bytes.
+	// +kubebuilder:validation:Optional
+	Size int64 `json:"size,omitempty"`
+
+	// Error is why the bundle could not be stored. Collection is not retried.
+	// +kubebuilder:validation:Optional
+	Error string `json:"error,omitempty"`
+
+	// Truncated is true when logs were shortened to fit the storage.
+	// +kubebuilder:validation:Optional
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// PlacementReport is the feasibility of placing the generated deployment onto the GPUs that are
+// free on the nodes, their allocatable GPUs minus those requested by running pods.
+type PlacementReport struct {
+	// Feasible indicates whether every replica of every GPU service fits the free GPUs now.
+	Feasible bool `json:"feasible"`
+
+	// Services reports the placement of each service requesting GPUs.
+	// +kubebuilder:validation:Optional
+	Services []ServicePlacement `json:"services,omitempty"`
+
+	// ObservedTime is when the free GPUs of the nodes were observed.
+	// +kubebuilder:validation:Optional
+	ObservedTime metav1.Time `json:"observedTime,omitempty"`
+}
+
+// ServicePlacement is the placement of the replicas of one service onto the free GPUs.
+type ServicePlacement struct {
+	// Service is the DynamoGraphDeployment service.
+	Service string `json:"service"`
+
+	// Replicas is the number of replicas of the service.
+	Replicas int32 `json:"replicas"`
+
+	// GPUsPerNode is the number of GPUs each replica requests on each of its nodes.
+	GPUsPerNode int64 `json:"gpusPerNode"`
+
+	// NodesPerReplica is the number of nodes each replica spans, above 1 for multinode services.
+	// +kubebuilder:validation:Optional
+	NodesPerReplica int32 `json:"nodesPerReplica,omitempty"`
+
+	// FittingReplicas is how many of the replicas fit the free GPUs now.
+	FittingReplicas int32 `json:"fittingReplicas"`
+
+	// Message is the placement advice, e.g. "8x1-GPU fits now" or
+	// "1x8-GPU requires draining node gpu-3".
+	Message string `json:"message"`
+}
+
+// GPUUtilization holds GPU statistics aggregated over the time window of one tested configuration.
+type GPUUtilization struct {
+	// Config identifies the tested configuration, e.g. "prefill_tp2".
+	Config string `json:"config"`
+
+	// Start and End delimit the time window the statistics were aggregated over.
+	Start metav1.Time `json:"start"`
+	End   metav1.Time `json:"end"`
+
+	// GPUUtilizationPercent is the average GPU utilization across profiling GPUs.
+	// +kubebuilder:validation:Optional
+	GPUUtilizationPercent string `json:"gpuUtilizationPercent,omitempty"`
+
+	// GPUMemoryUsedMiB is the peak framebuffer memory used across profiling GPUs.
+	// +kubebuilder:validation:Optional
+	GPUMemoryUsedMiB string `json:"gpuMemoryUsedMiB,omitempty"`
+
+	// NVLinkBytesPerSecond is the average NVLink bandwidth (transmit plus receive) across profiling GPUs.
+	// +kubebuilder:validation:Optional
+	NVLinkBytesPerSecond string `json:"nvlinkBytesPerSecond,omitempty"`
+}
+
+// StatusWarning describes a non-fatal adjustment the controller made to a request.
+type StatusWarning struct {
+	// Type is a machine-readable identifier of the warning, e.g. "SLAOverwritten".
+	Type string `json:"type"`
+
+	// Message is a human-readable description of the adjustment.
+	Message string `json:"message"`
+
+	// LastObservedTime is when the controller last made the adjustment.
+	// +kubebuilder:validation:Optional
+	LastObservedTime metav1.Time `json:"lastObservedTime,omitempty"`
+}
+
+// PinnedImage records the digest an image tag of the generated deployment was resolved to.
+type PinnedImage struct {
+	// Service is the DynamoGraphDeployment service running the image.
+	Service string `json:"service"`
+
+	// Container is the name of the container running the image.
+	// Empty for the main container of a service when it is not named.
+	// +kubebuilder:validation:Optional
+	Container string `json:"container,omitempty"`
+
+	// Image is the image reference from the generated deployment.
+	Image string `json:"image"`
+
+	// Digest is the content digest the image reference was resolved to, e.g. "sha256:...".
+	Digest string `json:"digest"`
+}
+
+// ProfilingAttemptOutcome is how a profiling attempt ended.
+// +kubebuilder:validation:Enum=Succeeded;Failed;Superseded
+type ProfilingAttemptOutcome string
+
+const (
+	// ProfilingAttemptSucceeded indicates the profiling job of the attempt completed.
+	ProfilingAttemptSucceeded ProfilingAttemptOutcome = "Succeeded"
+	// ProfilingAttemptFailed indicates the profiling job of the attempt failed.
+	ProfilingAttemptFailed ProfilingAttemptOutcome = "Failed"
+	// ProfilingAttemptSuperseded indicates the attempt was abandoned by a retry or reprofile action.
+	ProfilingAttemptSuperseded ProfilingAttemptOutcome = "Superseded"
+)
+
+// ProfilingAttempt records one profiling job run for the DGDR.
+type ProfilingAttempt struct {
+	// Attempt is the 1-based number of the attempt.
+	Attempt int32 `json:"attempt"`
+
+	// JobName is the name of the profiling job of the attempt. Later attempts are suffixed
+	// with "-a<attempt>" so that the jobs of earlier attempts can be kept for post-mortem.
+	JobName string `json:"jobName"`
+
+	// StartTime is when the profiling job of the attempt was created.
+	StartTime metav1.Time `json:"startTime"`
+
+	// Outcome is how the attempt ended. Empty while the attempt is running.
+	// +kubebuilder:validation:Optional
+	Outcome ProfilingAttemptOutcome `json:"outcome,omitempty"`
+
+	// CompletionTime is when the attempt ended.
+	// +kubebuilder:validation:Optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// InputsHash is the SHA-256 of the profiler config of the attempt without its SLA. A re-profile
+	// with the same inputs and a different SLA re-sweeps around the attempt's results.
+	// +kubebuilder:validation:Optional
+	InputsHash string `json:"inputsHash,omitempty"`
+
+	// SLA is the SLA section of the profiler config of the attempt.
+	// +kubebuilder:validation:Optional
+	SLA *apiextensionsv1.JSON `json:"sla,omitempty"`
+}
+
+// ProfilingProvenance records the toolchain that generated a deployment so that a generated spec
+// can be traced to the exact images and operator build that produced it.
+type ProfilingProvenance struct {
+	// ProfilerImage is the profiler image reference used by the profiling job.
+	// +kubebuilder:validation:Optional
+	ProfilerImage string `json:"profilerImage,omitempty"`
+
+	// ProfilerImageDigest is the content digest of the profiler image the profiling pod ran, e.g. "sha256:...",
+	// from the image ID of its container status. Empty when no profiling pod reported it.
+	// +kubebuilder:validation:Optional
+	ProfilerImageDigest string `json:"profilerImageDigest,omitempty"`
+
+	// SidecarImage is the image reference of the profiling job sidecar delivering the results.
+	// +kubebuilder:validation:Optional
+	SidecarImage string `json:"sidecarImage,omitempty"`
+
+	// SidecarImageDigest is the content digest of the sidecar image the profiling pod ran.
+	// Empty when no profiling pod reported it.
+	// +kubebuilder:validation:Optional
+	SidecarImageDigest string `json:"sidecarImageDigest,omitempty"`
+
+	// OperatorVersion is the version of the operator that created the profiling job.
+	OperatorVersion string `json:"operatorVersion"`
+
+	// OperatorCommit is the source revision the operator was built from, when known.
+	// +kubebuilder:validation:Optional
+	OperatorCommit string `json:"operatorCommit,omitempty"`
+
+	// RecordedTime is when the provenance was recorded.
+	RecordedTime metav1.Time `json:"recordedTime"`
+}
+
+// FailureReason is a machine-readable classification of why a DGDR entered the Failed state.
+// Automation should branch on this value rather than parsing condition messages.
+// +kubebuilder:validation:Enum=ValidationError;ImageNotConfigured;JobSchedulingFailed;ProfilerCrash;ResultsMissing;SpecParseError;DGDCreateForbidden;ImageResolutionFailed;DeploymentTimeout;ImageNotAllowed;UnsupportedByProfiler;ModelResolutionFailed;InsufficientGPUMemory;ImageArchMismatch;HookFailed;DGDConflict
+type FailureReason string
+
+const (
+	// FailureReasonValidationError indicates the spec failed validation.
+	FailureReasonValidationError FailureReason = "ValidationError"
+	// FailureReasonImageNotConfigured indicates no profiler image was configured.
+	FailureReasonImageNotConfigured FailureReason = "ImageNotConfigured"
+	// FailureReasonJobSchedulingFailed indicates the profiling job could not be created.
+	FailureReasonJobSchedulingFailed FailureReason = "JobSchedulingFailed"
+	// FailureReasonProfilerCrash indicates the profiling job ran but failed.
+	FailureReasonProfilerCrash FailureReason = "ProfilerCrash"
+	// FailureReasonResultsMissing indicates the profiling output could not be found.
+	FailureReasonResultsMissing FailureReason = "ResultsMissing"
+	// FailureReasonSpecParseError indicates the profiling output could not be parsed into a DGD.
+	FailureReasonSpecParseError FailureReason = "SpecParseError"
+	// FailureReasonDGDCreateForbidden indicates the operator was not allowed to create the DGD.
+	FailureReasonDGDCreateForbidden FailureReason = "DGDCreateForbidden"
+	// FailureReasonDeploymentTimeout indicates the auto-created DGD did not become Ready within deploymentReadyTimeoutSeconds.
+	FailureReasonDeploymentTimeout FailureReason = "DeploymentTimeout"
+	// FailureReasonImageNotAllowed indicates an image is outside the registries allowed in the DGDR namespace.
+	FailureReasonImageNotAllowed FailureReason = "ImageNotAllowed"
+	// FailureReasonImageResolutionFailed indicates an image of the generated DGD could not be pinned to a digest.
+	FailureReasonImageResolutionFailed FailureReason = "ImageResolutionFailed"
+	// FailureReasonUnsupportedByProfiler indicates the profiler image does not support the requested spec.
+	FailureReasonUnsupportedByProfiler FailureReason = "UnsupportedByProfiler"
+	// FailureReasonModelResolutionFailed indicates spec.modelRef could not be resolved from its registry.
+	FailureReasonModelResolutionFailed FailureReason = "ModelResolutionFailed"
+	// FailureReasonInsufficientGPUMemory indicates the model does not fit the GPUs an engine may use.
+	FailureReasonInsufficientGPUMemory FailureReason = "InsufficientGPUMemory"
+	// FailureReasonImageArchMismatch indicates an image is not built for the CPU architecture of the target nodes.
+	FailureReasonImageArchMismatch FailureReason = "ImageArchMismatch"
+	// FailureReasonHookFailed indicates a pre- or post-profiling hook with failurePolicy Fail failed.
+	FailureReasonHookFailed FailureReason = "HookFailed"
+	// FailureReasonDGDConflict indicates a DGD of the same name exists that the DGDR does not manage.
+	FailureReasonDGDConflict FailureReason = "DGDConflict"
+)
+
+// DynamoGraphDeploymentRequestStatus represents the observed state of a DynamoGraphDeploymentRequest.
+// The controller updates this status as the DGDR progresses through its lifecycle.
+type DynamoGraphDeploymentRequestStatus struct {
+	// State is a high-level textual status of the deployment request lifecycle.
+	// Possible values: "", "Pending", "Profiling", "Deploying", "Ready", "Degraded", "DeploymentDeleted", "Failed"
+	// Empty string ("") represents the initial state before initialization.
+	State string `json:"state,omitempty"`
+
+	// FailureReason classifies the failure when State is "Failed".
+	// It is empty in all other states.
+	// +kubebuilder:validation:Optional
+	FailureReason FailureReason `json:"failureReason,omitempty"`
+
+	// Backend is extracted from profilingConfig.config.engine.backend for display purposes.
+	// This field is populated by the controller and shown in kubectl output.
+	// When spec.backend is "auto", it is set to the selected backend once profiling completes.
+	// +kubebuilder:validation:Optional
+	Backend string `json:"backend,omitempty"`
+
+	// ProfilingMode is the profiling mode of the DGDR, from spec.profilingMode or derived from the
+	// rest of the spec when it is omitted.
+	// +kubebuilder:validation:Optional
+	ProfilingMode ProfilingMode `json:"profilingMode,omitempty"`
+
+	// BackendComparison reports the per-backend estimates when spec.backend is "auto".
+	// +kubebuilder:validation:Optional
+	BackendComparison []BackendEvaluation `json:"backendComparison,omitempty"`
+
+	// SpeculativeDecoding reports the cost and benefit of spec.features.speculativeDecoding
+	// measured by the profiler, and whether the generated deployment uses it.
+	// +kubebuilder:validation:Optional
+	SpeculativeDecoding *SpeculativeDecodingStatus `json:"speculativeDecoding,omitempty"`
+
+	// ObservedGeneration reflects the generation of the most recently observed spec.
+	// Used to detect spec changes and enforce immutability after profiling starts.
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 
 	// Conditions contains the latest observed conditions of the deployment request.
-	// Standard condition types include: Validation, Profiling, SpecGenerated, DeploymentReady.
+	// Standard condition types include: Validation, Profiling, ProfilingSkipped, SpecGenerated, DeploymentReady,
+	// DeploymentDegraded.
 	// Conditions are merged by type on patch updates.
 	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
 
-	// ProfilingResults contains a reference to the ConfigMap holding profiling data.
-	// Format: "configmap/<name>"
+	// Warnings lists non-fatal adjustments the controller made to the request, such as defaulted
+	// or overwritten values, that users should be aware of. There is at most one warning per type.
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=type
+	Warnings []StatusWarning `json:"warnings,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+
+	// DisabledFeatures lists the features of spec.features that were disabled because the backend
+	// is known not to support them, with spec.features.onUnsupported Downgrade.
+	// +kubebuilder:validation:Optional
+	DisabledFeatures []string `json:"disabledFeatures,omitempty"`
+
+	// PinnedImages lists the digests the images of the generated deployment were pinned to
+	// when deploymentOverrides.pinImageDigests is set.
+	// +kubebuilder:validation:Optional
+	PinnedImages []PinnedImage `json:"pinnedImages,omitempty"`
+
+	// Attempts lists the profiling job runs of the DGDR, oldest first. The last attempt is the current one.
+	// +kubebuilder:validation:Optional
+	Attempts []ProfilingAttempt `json:"attempts,omitempty"`
+
+	// EstimatedCompletionTime is when the running profiling job is expected to finish, from the
+	// median duration of recent successful profiling runs of similar DGDRs (same model size
+	// bucket, backend and profiling mode). Unset while there are too few such runs.
+	// +kubebuilder:validation:Optional
+	EstimatedCompletionTime *metav1.Time `json:"estimatedCompletionTime,omitempty"`
+
+	// Reprofiles counts the times profiling was restarted with the retry or reprofile action.
+	// +kubebuilder:validation:Optional
+	Reprofiles int32 `json:"reprofiles,omitempty"`
+
+	// LastReprofileTime is when profiling was last restarted with the retry or reprofile action.
+	// +kubebuilder:validation:Optional
+	LastReprofileTime *metav1.Time `json:"lastReprofileTime,omitempty"`
+
+	// Provenance records the profiler and sidecar images, resolved to digests, and the operator
+	// build that generated the deployment.
+	// +kubebuilder:validation:Optional
+	Provenance *ProfilingProvenance `json:"provenance,omitempty"`
+
+	// ProfilingResults contains a reference to where the profiling data is stored, depending on
+	// spec.profilingConfig.resultTransport.
+	// Format: "configmap/<name>", "secret/<name>" or "pvc/dynamo-pvc/<path>"
 	// +kubebuilder:validation:Optional
 	ProfilingResults string `json:"profilingResults,omitempty"`
 
+	// ProfilingResultsChecksum is the checksum of the profiling results the generated deployment was
+	// rendered from, as annotated on the output ConfigMap or Secret by the profiling job. Results with
+	// the same checksum are not parsed again.
+	// +kubebuilder:validation:Optional
+	ProfilingResultsChecksum string `json:"profilingResultsChecksum,omitempty"`
+
+	// ProfilingResultsEncoding is how the profiling results the generated deployment was rendered
+	// from were stored in the output ConfigMap or Secret, Plain or Gzip.
+	// +kubebuilder:validation:Optional
+	ProfilingResultsEncoding ResultEncoding `json:"profilingResultsEncoding,omitempty"`
+
 	// GeneratedDeployment contains the full generated DynamoGraphDeployment specification
 	// including metadata, based on profiling results. Users can extract this to create
 	// a DGD manually, or it's used automatically when autoApply is true.
@@ -188,10 +1757,76 @@ type DynamoGraphDeploymentRequestStatus struct {
 	// +kubebuilder:validation:EmbeddedResource
 	GeneratedDeployment *runtime.RawExtension `json:"generatedDeployment,omitempty"`
 
+	// GeneratedSpecExpiry is when the generated deployment stops being valid, per
+	// spec.generatedSpecValidity or the operator default. Unset if it never expires.
+	// +kubebuilder:validation:Optional
+	GeneratedSpecExpiry *metav1.Time `json:"generatedSpecExpiry,omitempty"`
+
+	// SizedForPercentile is the latency percentile the generated deployment was sized for, e.g.
+	// "p99", as reported by the profiler. Unset when the deployment was sized for the latency
+	// targets without percentiles, or the profiler did not report it.
+	// +kubebuilder:validation:Optional
+	SizedForPercentile string `json:"sizedForPercentile,omitempty"`
+
+	// Placement reports whether the generated deployment fits the GPUs that are free on the nodes
+	// at the time the spec was generated, and which nodes would have to be drained otherwise.
+	// +kubebuilder:validation:Optional
+	Placement *PlacementReport `json:"placement,omitempty"`
+
+	// GeneratedResources holds the ConfigMaps, Secrets and Services the profiler emitted beside
+	// the generated deployment. They are created in the deployment namespace before the
+	// DynamoGraphDeployment when autoApply is true, and are owned by it. Secrets are placeholders:
+	// their data is never stored here and they are only created when missing.
+	// +kubebuilder:validation:Optional
+	GeneratedResources []runtime.RawExtension `json:"generatedResources,omitempty"`
+
+	// Profiling holds observations collected while profiling ran.
+	// +kubebuilder:validation:Optional
+	Profiling *ProfilingStatus `json:"profiling,omitempty"`
+
+	// Artifacts records where the profiling artifacts are kept when spec.profilingConfig.artifactsPVC is set.
+	// +kubebuilder:validation:Optional
+	Artifacts *ArtifactsStatus `json:"artifacts,omitempty"`
+
+	// SupportBundle references the diagnostics collected when the DGDR failed, per
+	// spec.supportBundle. It is cleared when the DGDR is profiled again.
+	// +kubebuilder:validation:Optional
+	SupportBundle *SupportBundleStatus `json:"supportBundle,omitempty"`
+
+	// Hooks records the hooks of the current profiling run, in the order they ran. It is cleared
+	// when the DGDR is profiled again.
+	// +kubebuilder:validation:Optional
+	Hooks []ProfilingHookStatus `json:"hooks,omitempty"`
+
+	// ReservedNodes lists the nodes currently reserved for online profiling.
+	// +kubebuilder:validation:Optional
+	ReservedNodes []string `json:"reservedNodes,omitempty"`
+
+	// RenderedManifests references the plain Kubernetes manifests rendered when
+	// spec.output.format is RawManifests. They are stored with the profiling results.
+	// Format: see profilingResults
+	// +kubebuilder:validation:Optional
+	RenderedManifests string `json:"renderedManifests,omitempty"`
+
+	// ResolvedModel is the model spec.modelRef resolved to. It is resolved again when the DGDR is
+	// re-profiled.
+	// +kubebuilder:validation:Optional
+	ResolvedModel *ResolvedModelStatus `json:"resolvedModel,omitempty"`
+
 	// Deployment tracks the auto-created DGD when AutoApply is true.
 	// Contains name, namespace, state, and creation status of the managed DGD.
 	// +kubebuilder:validation:Optional
 	Deployment *DeploymentStatus `json:"deployment,omitempty"`
+
+	// Endpoint tells clients how to call the deployed model, resolved from the frontend of the
+	// auto-created DGD once it is Ready.
+	// +kubebuilder:validation:Optional
+	Endpoint *EndpointStatus `json:"endpoint,omitempty"`
+
+	// OwnedResources lists the objects the operator created for the DGDR and that still exist,
+	// for cleanup tools and UIs to show its full footprint.
+	// +kubebuilder:validation:Optional
+	OwnedResources []OwnedResource `json:"ownedResources,omitempty"`
 }
 
 // DynamoGraphDeploymentRequest is the Schema for the dynamographdeploymentrequests API.
@@ -201,9 +1836,12 @@ type DynamoGraphDeploymentRequestStatus struct {
 // Lifecycle:
 //  1. Initial → Pending: Validates spec and prepares for profiling
 //  2. Pending → Profiling: Creates and runs profiling job (online or AIC)
+//     With profilingMode none, Pending → Ready/Deploying directly (ProfilingSkipped)
 //  3. Profiling → Ready/Deploying: Generates DGD spec after profiling completes
 //  4. Deploying → Ready: When autoApply=true, monitors DGD until Ready
 //  5. Ready: Terminal state when DGD is operational or spec is available
+//     Ready → Degraded: When the DGD stops being Ready. Degraded → Ready if it recovers, or
+//     Degraded → Deploying if it stays non-Ready for the operator's grace period and observation count
 //  6. DeploymentDeleted: Terminal state when auto-created DGD is manually deleted
 //
 // The spec becomes immutable once profiling starts. Users must delete and recreate
@@ -214,6 +1852,7 @@ type DynamoGraphDeploymentRequestStatus struct {
 // +kubebuilder:resource:shortName=dgdr
 // +kubebuilder:printcolumn:name="Model",type=string,JSONPath=`.spec.model`
 // +kubebuilder:printcolumn:name="Backend",type=string,JSONPath=`.status.backend`
+// +kubebuilder:printcolumn:name="Mode",type=string,JSONPath=`.status.profilingMode`,priority=1
 // +kubebuilder:printcolumn:name="State",type=string,JSONPath=`.status.state`
 // +kubebuilder:printcolumn:name="DGD-State",type=string,JSONPath=`.status.deployment.state`
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
@@ -0,0 +1,198 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// DGDRSetPhase is the progress of a DynamoGraphDeploymentRequestSet.
+type DGDRSetPhase string
+
+const (
+	// DGDRSetPhaseProgressing indicates child DGDRs are still being created, profiled or deployed.
+	DGDRSetPhaseProgressing DGDRSetPhase = "Progressing"
+	// DGDRSetPhaseReady indicates all child DGDRs are Ready.
+	DGDRSetPhaseReady DGDRSetPhase = "Ready"
+	// DGDRSetPhaseFailed indicates all child DGDRs settled and at least one of them Failed.
+	DGDRSetPhaseFailed DGDRSetPhase = "Failed"
+)
+
+// DGDRSetTemplate is the DynamoGraphDeploymentRequest every child of the set is stamped out from.
+type DGDRSetTemplate struct {
+	// Labels are added to every child DGDR.
+	// +kubebuilder:validation:Optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Annotations are added to every child DGDR.
+	// +kubebuilder:validation:Optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// Spec is the DynamoGraphDeploymentRequest spec shared by the children. The parameters of
+	// each child are merged into it, the result is validated when the child is created.
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:Type=object
+	Spec runtime.RawExtension `json:"spec"`
+}
+
+// DGDRSetParameters are the values one child DGDR of the set is stamped out with.
+type DGDRSetParameters struct {
+	// Name identifies the child within the set, it is named <set name>-<name> and created in
+	// the namespace of the set.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name"`
+
+	// Model replaces spec.model of the template.
+	// +kubebuilder:validation:Optional
+	Model string `json:"model,omitempty"`
+
+	// SLA replaces spec.sla of the template.
+	// +kubebuilder:validation:Optional
+	SLA *SLASpec `json:"sla,omitempty"`
+
+	// Labels are added to the child on top of the template labels.
+	// +kubebuilder:validation:Optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Overrides is a JSON merge patch applied to the template spec for this child, for fields
+	// without a dedicated parameter.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:Type=object
+	Overrides *runtime.RawExtension `json:"overrides,omitempty"`
+}
+
+// DynamoGraphDeploymentRequestSetSpec stamps out child DGDRs from a template and a list of parameters.
+type DynamoGraphDeploymentRequestSetSpec struct {
+	// Template is the DynamoGraphDeploymentRequest the children are stamped out from. Children are
+	// not updated when the template changes, DGDR specs are immutable once processed.
+	Template DGDRSetTemplate `json:"template"`
+
+	// Parameters lists the children of the set. Children whose parameters are removed are deleted.
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=256
+	Parameters []DGDRSetParameters `json:"parameters"`
+
+	// MaxInProgress is the concurrency budget of the set: how many children may be pending,
+	// profiling or deploying at the same time. Further children are created as others become
+	// Ready or Failed. Children of all sets together are also held to the budget of the
+	// operator, so onboarding many models does not claim all profiling GPUs at once. Unset
+	// creates children as the budget of the operator allows.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	MaxInProgress *int32 `json:"maxInProgress,omitempty"`
+}
+
+// DGDRSetChildStatus is the state of one child DGDR of the set.
+type DGDRSetChildStatus struct {
+	// Name is the name of the parameters the child was stamped out with.
+	Name string `json:"name"`
+
+	// DGDR is the namespace/name of the child DGDR, unset until it is created.
+	// +kubebuilder:validation:Optional
+	DGDR string `json:"dgdr,omitempty"`
+
+	// State is the state of the child DGDR, Waiting while the concurrency budget holds it back.
+	// +kubebuilder:validation:Optional
+	State string `json:"state,omitempty"`
+
+	// Message explains why the child could not be created.
+	// +kubebuilder:validation:Optional
+	Message string `json:"message,omitempty"`
+}
+
+// DynamoGraphDeploymentRequestSetStatus reports the aggregate progress of the children.
+type DynamoGraphDeploymentRequestSetStatus struct {
+	// Phase is the aggregate progress of the children.
+	// +kubebuilder:validation:Optional
+	Phase DGDRSetPhase `json:"phase,omitempty"`
+
+	// ObservedGeneration is the generation of the set the status reflects.
+	// +kubebuilder:validation:Optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Total is the number of children of the set.
+	// +kubebuilder:validation:Optional
+	Total int32 `json:"total,omitempty"`
+
+	// Ready is the number of Ready children.
+	// +kubebuilder:validation:Optional
+	Ready int32 `json:"ready,omitempty"`
+
+	// Failed is the number of Failed children, including those that could not be created.
+	// +kubebuilder:validation:Optional
+	Failed int32 `json:"failed,omitempty"`
+
+	// InProgress is the number of children pending, profiling or deploying.
+	// +kubebuilder:validation:Optional
+	InProgress int32 `json:"inProgress,omitempty"`
+
+	// Waiting is the number of children not created yet because of the concurrency budget.
+	// +kubebuilder:validation:Optional
+	Waiting int32 `json:"waiting,omitempty"`
+
+	// Children lists the state of every child.
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=name
+	Children []DGDRSetChildStatus `json:"children,omitempty"`
+
+	// Conditions contains the latest observed conditions of the set.
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// DynamoGraphDeploymentRequestSet stamps out DynamoGraphDeploymentRequests from a template and a
+// list of parameter tuples, e.g. to onboard dozens of models at once, and reports how many of them
+// are Ready or Failed.
+//
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=dgdrset
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Ready",type=integer,JSONPath=`.status.ready`
+// +kubebuilder:printcolumn:name="Failed",type=integer,JSONPath=`.status.failed`
+// +kubebuilder:printcolumn:name="Total",type=integer,JSONPath=`.status.total`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+type DynamoGraphDeploymentRequestSet struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DynamoGraphDeploymentRequestSetSpec   `json:"spec,omitempty"`
+	Status DynamoGraphDeploymentRequestSetStatus `json:"status,omitempty"`
+}
+
+// DynamoGraphDeploymentRequestSetList contains a list of DynamoGraphDeploymentRequestSet resources.
+//
+// +kubebuilder:object:root=true
+type DynamoGraphDeploymentRequestSetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DynamoGraphDeploymentRequestSet `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DynamoGraphDeploymentRequestSet{}, &DynamoGraphDeploymentRequestSetList{})
+}
@@ -0,0 +1,92 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DynamoProfilerCapabilitiesSpec identifies the profiler image the capabilities are discovered for.
+type DynamoProfilerCapabilitiesSpec struct {
+	// Image is the profiler image reference, as set in profilingConfig.profilerImage of DGDRs.
+	// +kubebuilder:validation:Required
+	Image string `json:"image"`
+}
+
+// DynamoProfilerCapabilitiesStatus holds the capabilities the profiler image declares in its labels.
+type DynamoProfilerCapabilitiesStatus struct {
+	// Discovered reports whether the image declares its capabilities. DGDRs using an image that
+	// does not, or that could not be inspected, are not checked against its capabilities.
+	// +kubebuilder:validation:Optional
+	Discovered bool `json:"discovered,omitempty"`
+
+	// ArgsSchemaVersion is the version of the profiler config schema the image accepts.
+	// +kubebuilder:validation:Optional
+	ArgsSchemaVersion int32 `json:"argsSchemaVersion,omitempty"`
+
+	// Backends lists the inference backends the image can profile.
+	// +kubebuilder:validation:Optional
+	Backends []string `json:"backends,omitempty"`
+
+	// SweepFeatures lists the optional profiler features the image supports, such as
+	// ai-configurator, backend-auto, batch-latency, adapters, cpu-only and load-target.
+	// +kubebuilder:validation:Optional
+	SweepFeatures []string `json:"sweepFeatures,omitempty"`
+
+	// LastProbeTime is when the image was last inspected.
+	// +kubebuilder:validation:Optional
+	LastProbeTime *metav1.Time `json:"lastProbeTime,omitempty"`
+
+	// Message explains why the capabilities are not discovered, e.g. a registry error.
+	// +kubebuilder:validation:Optional
+	Message string `json:"message,omitempty"`
+}
+
+// DynamoProfilerCapabilities caches the capabilities of a profiler image in the namespace of the
+// DynamoGraphDeploymentRequests using it. The operator creates one per image, refreshes it
+// periodically, and validates DGDRs against it to reject spec combinations the profiler does
+// not support before a profiling job is started.
+//
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=dpc
+// +kubebuilder:printcolumn:name="Image",type=string,JSONPath=`.spec.image`
+// +kubebuilder:printcolumn:name="Discovered",type=boolean,JSONPath=`.status.discovered`
+// +kubebuilder:printcolumn:name="Schema",type=integer,JSONPath=`.status.argsSchemaVersion`
+// +kubebuilder:printcolumn:name="Backends",type=string,JSONPath=`.status.backends`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+type DynamoProfilerCapabilities struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DynamoProfilerCapabilitiesSpec   `json:"spec,omitempty"`
+	Status DynamoProfilerCapabilitiesStatus `json:"status,omitempty"`
+}
+
+// DynamoProfilerCapabilitiesList contains a list of DynamoProfilerCapabilities resources.
+//
+// +kubebuilder:object:root=true
+type DynamoProfilerCapabilitiesList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DynamoProfilerCapabilities `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DynamoProfilerCapabilities{}, &DynamoProfilerCapabilitiesList{})
+}
@@ -0,0 +1,138 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1alpha1
+
+import (
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ProfilingRunResult is the outcome of a profiling run.
+// +kubebuilder:validation:Enum=Succeeded;Failed
+type ProfilingRunResult string
+
+const (
+	// ProfilingRunSucceeded indicates the profiling job completed successfully.
+	ProfilingRunSucceeded ProfilingRunResult = "Succeeded"
+	// ProfilingRunFailed indicates the profiling job failed.
+	ProfilingRunFailed ProfilingRunResult = "Failed"
+)
+
+// DynamoProfilingRunSpec is the audit record of one profiling job.
+// It is written once by the operator when the job finishes and cannot be modified afterwards.
+// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="DynamoProfilingRun records are immutable"
+type DynamoProfilingRunSpec struct {
+	// DGDRName is the name of the DynamoGraphDeploymentRequest that started the run.
+	DGDRName string `json:"dgdrName"`
+
+	// DGDRUID is the UID of the DynamoGraphDeploymentRequest that started the run, which
+	// distinguishes runs of DGDRs recreated under the same name.
+	DGDRUID string `json:"dgdrUID"`
+
+	// JobName is the name of the profiling Job.
+	JobName string `json:"jobName"`
+
+	// Inputs is the DynamoGraphDeploymentRequest spec the run was started with.
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Inputs *apiextensionsv1.JSON `json:"inputs,omitempty"`
+
+	// Containers lists the images the profiling pods ran, resolved to digests.
+	// +kubebuilder:validation:Optional
+	Containers []ProfilingRunContainer `json:"containers,omitempty"`
+
+	// Placement lists the nodes the profiling pods were scheduled on.
+	// +kubebuilder:validation:Optional
+	Placement []ProfilingRunPlacement `json:"placement,omitempty"`
+
+	// StartTime is when the profiling job started.
+	// +kubebuilder:validation:Optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// CompletionTime is when the profiling job finished.
+	// +kubebuilder:validation:Optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Duration is the wall-clock duration of the profiling job, e.g. "1h2m3s".
+	// +kubebuilder:validation:Optional
+	Duration string `json:"duration,omitempty"`
+
+	// Result is the outcome of the profiling job.
+	Result ProfilingRunResult `json:"result"`
+
+	// OutputChecksum is the SHA-256 of the profiling output ConfigMap data, prefixed with "sha256:".
+	// Empty if the run produced no output.
+	// +kubebuilder:validation:Optional
+	OutputChecksum string `json:"outputChecksum,omitempty"`
+}
+
+// ProfilingRunContainer records the image a profiling container ran.
+type ProfilingRunContainer struct {
+	// Name is the container name.
+	Name string `json:"name"`
+
+	// Image is the image reference from the pod spec.
+	Image string `json:"image"`
+
+	// ImageID is the image reference resolved by the container runtime, including its digest.
+	// +kubebuilder:validation:Optional
+	ImageID string `json:"imageID,omitempty"`
+}
+
+// ProfilingRunPlacement records where a profiling pod ran.
+type ProfilingRunPlacement struct {
+	// Pod is the pod name.
+	Pod string `json:"pod"`
+
+	// Node is the node the pod was scheduled on.
+	// +kubebuilder:validation:Optional
+	Node string `json:"node,omitempty"`
+
+	// GPUs is the number of GPUs requested by the pod.
+	// +kubebuilder:validation:Optional
+	GPUs int64 `json:"gpus,omitempty"`
+}
+
+// DynamoProfilingRun is an immutable audit record of a profiling run started by a
+// DynamoGraphDeploymentRequest. It is not owned by the DGDR and outlives it; the operator
+// deletes records older than its configured retention.
+//
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:shortName=dpr
+// +kubebuilder:printcolumn:name="DGDR",type=string,JSONPath=`.spec.dgdrName`
+// +kubebuilder:printcolumn:name="Result",type=string,JSONPath=`.spec.result`
+// +kubebuilder:printcolumn:name="Duration",type=string,JSONPath=`.spec.duration`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+type DynamoProfilingRun struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec DynamoProfilingRunSpec `json:"spec,omitempty"`
+}
+
+// DynamoProfilingRunList contains a list of DynamoProfilingRun resources.
+//
+// +kubebuilder:object:root=true
+type DynamoProfilingRunList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DynamoProfilingRun `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DynamoProfilingRun{}, &DynamoProfilingRunList{})
+}
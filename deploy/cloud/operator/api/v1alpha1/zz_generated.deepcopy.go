@@ -40,12 +40,96 @@ package v1alpha1
 import (
 	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/dynamo/common"
 	"k8s.io/api/autoscaling/v2"
-	"k8s.io/api/core/v1"
+	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdapterSpec) DeepCopyInto(out *AdapterSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdapterSpec.
+func (in *AdapterSpec) DeepCopy() *AdapterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AdapterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApprovalSpec) DeepCopyInto(out *ApprovalSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovalSpec.
+func (in *ApprovalSpec) DeepCopy() *ApprovalSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ApprovalSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArtifactsPVCSpec) DeepCopyInto(out *ArtifactsPVCSpec) {
+	*out = *in
+	if in.Size != nil {
+		in, out := &in.Size, &out.Size
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.StorageClassName != nil {
+		in, out := &in.StorageClassName, &out.StorageClassName
+		*out = new(string)
+		**out = **in
+	}
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArtifactsPVCSpec.
+func (in *ArtifactsPVCSpec) DeepCopy() *ArtifactsPVCSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ArtifactsPVCSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArtifactsStatus) DeepCopyInto(out *ArtifactsStatus) {
+	*out = *in
+	in.CreatedTime.DeepCopyInto(&out.CreatedTime)
+	if in.ExpiryTime != nil {
+		in, out := &in.ExpiryTime, &out.ExpiryTime
+		*out = (*in).DeepCopy()
+	}
+	if in.PrunedTime != nil {
+		in, out := &in.PrunedTime, &out.PrunedTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArtifactsStatus.
+func (in *ArtifactsStatus) DeepCopy() *ArtifactsStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ArtifactsStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Autoscaling) DeepCopyInto(out *Autoscaling) {
 	*out = *in
@@ -73,6 +157,21 @@ func (in *Autoscaling) DeepCopy() *Autoscaling {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackendEvaluation) DeepCopyInto(out *BackendEvaluation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendEvaluation.
+func (in *BackendEvaluation) DeepCopy() *BackendEvaluation {
+	if in == nil {
+		return nil
+	}
+	out := new(BackendEvaluation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BaseCRD) DeepCopyInto(out *BaseCRD) {
 	*out = *in
@@ -98,7 +197,7 @@ func (in *BaseStatus) DeepCopyInto(out *BaseStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = make([]metav1.Condition, len(*in))
+		*out = make([]v1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -130,6 +229,83 @@ func (in *ConfigMapKeySelector) DeepCopy() *ConfigMapKeySelector {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DGDRSetChildStatus) DeepCopyInto(out *DGDRSetChildStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DGDRSetChildStatus.
+func (in *DGDRSetChildStatus) DeepCopy() *DGDRSetChildStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DGDRSetChildStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DGDRSetParameters) DeepCopyInto(out *DGDRSetParameters) {
+	*out = *in
+	if in.SLA != nil {
+		in, out := &in.SLA, &out.SLA
+		*out = new(SLASpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Overrides != nil {
+		in, out := &in.Overrides, &out.Overrides
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DGDRSetParameters.
+func (in *DGDRSetParameters) DeepCopy() *DGDRSetParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DGDRSetParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DGDRSetTemplate) DeepCopyInto(out *DGDRSetTemplate) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DGDRSetTemplate.
+func (in *DGDRSetTemplate) DeepCopy() *DGDRSetTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(DGDRSetTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DeploymentOverridesSpec) DeepCopyInto(out *DeploymentOverridesSpec) {
 	*out = *in
@@ -147,6 +323,32 @@ func (in *DeploymentOverridesSpec) DeepCopyInto(out *DeploymentOverridesSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.PodAnnotations != nil {
+		in, out := &in.PodAnnotations, &out.PodAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ServiceAccountName != nil {
+		in, out := &in.ServiceAccountName, &out.ServiceAccountName
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Services != nil {
+		in, out := &in.Services, &out.Services
+		*out = make(map[string]ServiceOverride, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.Patches != nil {
+		in, out := &in.Patches, &out.Patches
+		*out = make([]DeploymentPatch, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentOverridesSpec.
@@ -159,9 +361,57 @@ func (in *DeploymentOverridesSpec) DeepCopy() *DeploymentOverridesSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentPatch) DeepCopyInto(out *DeploymentPatch) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentPatch.
+func (in *DeploymentPatch) DeepCopy() *DeploymentPatch {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentPatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentSecret) DeepCopyInto(out *DeploymentSecret) {
+	*out = *in
+	if in.Services != nil {
+		in, out := &in.Services, &out.Services
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentSecret.
+func (in *DeploymentSecret) DeepCopy() *DeploymentSecret {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentSecret)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DeploymentStatus) DeepCopyInto(out *DeploymentStatus) {
 	*out = *in
+	if in.DeployingSince != nil {
+		in, out := &in.DeployingSince, &out.DeployingSince
+		*out = (*in).DeepCopy()
+	}
+	if in.SchedulingDiagnostics != nil {
+		in, out := &in.SchedulingDiagnostics, &out.SchedulingDiagnostics
+		*out = make([]SchedulingDiagnostic, len(*in))
+		copy(*out, *in)
+	}
+	if in.DegradedSince != nil {
+		in, out := &in.DegradedSince, &out.DegradedSince
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentStatus.
@@ -174,6 +424,128 @@ func (in *DeploymentStatus) DeepCopy() *DeploymentStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DynamoAdminAction) DeepCopyInto(out *DynamoAdminAction) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DynamoAdminAction.
+func (in *DynamoAdminAction) DeepCopy() *DynamoAdminAction {
+	if in == nil {
+		return nil
+	}
+	out := new(DynamoAdminAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DynamoAdminAction) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DynamoAdminActionList) DeepCopyInto(out *DynamoAdminActionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DynamoAdminAction, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DynamoAdminActionList.
+func (in *DynamoAdminActionList) DeepCopy() *DynamoAdminActionList {
+	if in == nil {
+		return nil
+	}
+	out := new(DynamoAdminActionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DynamoAdminActionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DynamoAdminActionSpec) DeepCopyInto(out *DynamoAdminActionSpec) {
+	*out = *in
+	if in.LabelSelector != nil {
+		in, out := &in.LabelSelector, &out.LabelSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BatchInterval != nil {
+		in, out := &in.BatchInterval, &out.BatchInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DynamoAdminActionSpec.
+func (in *DynamoAdminActionSpec) DeepCopy() *DynamoAdminActionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DynamoAdminActionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DynamoAdminActionStatus) DeepCopyInto(out *DynamoAdminActionStatus) {
+	*out = *in
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Applied != nil {
+		in, out := &in.Applied, &out.Applied
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Skipped != nil {
+		in, out := &in.Skipped, &out.Skipped
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DynamoAdminActionStatus.
+func (in *DynamoAdminActionStatus) DeepCopy() *DynamoAdminActionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DynamoAdminActionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DynamoComponentDeployment) DeepCopyInto(out *DynamoComponentDeployment) {
 	*out = *in
@@ -267,7 +639,7 @@ func (in *DynamoComponentDeploymentSharedSpec) DeepCopyInto(out *DynamoComponent
 	}
 	if in.Envs != nil {
 		in, out := &in.Envs, &out.Envs
-		*out = make([]v1.EnvVar, len(*in))
+		*out = make([]corev1.EnvVar, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -304,12 +676,12 @@ func (in *DynamoComponentDeploymentSharedSpec) DeepCopyInto(out *DynamoComponent
 	}
 	if in.LivenessProbe != nil {
 		in, out := &in.LivenessProbe, &out.LivenessProbe
-		*out = new(v1.Probe)
+		*out = new(corev1.Probe)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.ReadinessProbe != nil {
 		in, out := &in.ReadinessProbe, &out.ReadinessProbe
-		*out = new(v1.Probe)
+		*out = new(corev1.Probe)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Replicas != nil {
@@ -355,7 +727,7 @@ func (in *DynamoComponentDeploymentStatus) DeepCopyInto(out *DynamoComponentDepl
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = make([]metav1.Condition, len(*in))
+		*out = make([]v1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -498,14 +870,215 @@ func (in *DynamoGraphDeploymentRequestList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DynamoGraphDeploymentRequestSpec) DeepCopyInto(out *DynamoGraphDeploymentRequestSpec) {
+func (in *DynamoGraphDeploymentRequestSet) DeepCopyInto(out *DynamoGraphDeploymentRequestSet) {
 	*out = *in
-	in.ProfilingConfig.DeepCopyInto(&out.ProfilingConfig)
-	if in.DeploymentOverrides != nil {
-		in, out := &in.DeploymentOverrides, &out.DeploymentOverrides
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DynamoGraphDeploymentRequestSet.
+func (in *DynamoGraphDeploymentRequestSet) DeepCopy() *DynamoGraphDeploymentRequestSet {
+	if in == nil {
+		return nil
+	}
+	out := new(DynamoGraphDeploymentRequestSet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DynamoGraphDeploymentRequestSet) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DynamoGraphDeploymentRequestSetList) DeepCopyInto(out *DynamoGraphDeploymentRequestSetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DynamoGraphDeploymentRequestSet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DynamoGraphDeploymentRequestSetList.
+func (in *DynamoGraphDeploymentRequestSetList) DeepCopy() *DynamoGraphDeploymentRequestSetList {
+	if in == nil {
+		return nil
+	}
+	out := new(DynamoGraphDeploymentRequestSetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DynamoGraphDeploymentRequestSetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DynamoGraphDeploymentRequestSetSpec) DeepCopyInto(out *DynamoGraphDeploymentRequestSetSpec) {
+	*out = *in
+	in.Template.DeepCopyInto(&out.Template)
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]DGDRSetParameters, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MaxInProgress != nil {
+		in, out := &in.MaxInProgress, &out.MaxInProgress
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DynamoGraphDeploymentRequestSetSpec.
+func (in *DynamoGraphDeploymentRequestSetSpec) DeepCopy() *DynamoGraphDeploymentRequestSetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DynamoGraphDeploymentRequestSetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DynamoGraphDeploymentRequestSetStatus) DeepCopyInto(out *DynamoGraphDeploymentRequestSetStatus) {
+	*out = *in
+	if in.Children != nil {
+		in, out := &in.Children, &out.Children
+		*out = make([]DGDRSetChildStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DynamoGraphDeploymentRequestSetStatus.
+func (in *DynamoGraphDeploymentRequestSetStatus) DeepCopy() *DynamoGraphDeploymentRequestSetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DynamoGraphDeploymentRequestSetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DynamoGraphDeploymentRequestSpec) DeepCopyInto(out *DynamoGraphDeploymentRequestSpec) {
+	*out = *in
+	if in.ModelRef != nil {
+		in, out := &in.ModelRef, &out.ModelRef
+		*out = new(ModelRefSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BackendPreference != nil {
+		in, out := &in.BackendPreference, &out.BackendPreference
+		*out = make([]CandidateBackend, len(*in))
+		copy(*out, *in)
+	}
+	if in.Hardware != nil {
+		in, out := &in.Hardware, &out.Hardware
+		*out = new(HardwareSpec)
+		**out = **in
+	}
+	if in.Features != nil {
+		in, out := &in.Features, &out.Features
+		*out = new(FeaturesSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SLA != nil {
+		in, out := &in.SLA, &out.SLA
+		*out = new(SLASpec)
+		(*in).DeepCopyInto(*out)
+	}
+	in.ProfilingConfig.DeepCopyInto(&out.ProfilingConfig)
+	if in.Approval != nil {
+		in, out := &in.Approval, &out.Approval
+		*out = new(ApprovalSpec)
+		**out = **in
+	}
+	if in.MaxReprofiles != nil {
+		in, out := &in.MaxReprofiles, &out.MaxReprofiles
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DeploymentReadyTimeoutSeconds != nil {
+		in, out := &in.DeploymentReadyTimeoutSeconds, &out.DeploymentReadyTimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DeploymentOverrides != nil {
+		in, out := &in.DeploymentOverrides, &out.DeploymentOverrides
 		*out = new(DeploymentOverridesSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Secrets != nil {
+		in, out := &in.Secrets, &out.Secrets
+		*out = make([]DeploymentSecret, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MaintenanceWindow != nil {
+		in, out := &in.MaintenanceWindow, &out.MaintenanceWindow
+		*out = new(MaintenanceWindowSpec)
+		**out = **in
+	}
+	if in.GeneratedSpecValidity != nil {
+		in, out := &in.GeneratedSpecValidity, &out.GeneratedSpecValidity
+		*out = new(GeneratedSpecValiditySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Output != nil {
+		in, out := &in.Output, &out.Output
+		*out = new(OutputSpec)
+		**out = **in
+	}
+	if in.ImportFrom != nil {
+		in, out := &in.ImportFrom, &out.ImportFrom
+		*out = new(SnapshotReference)
+		**out = **in
+	}
+	if in.PrecomputedDeployment != nil {
+		in, out := &in.PrecomputedDeployment, &out.PrecomputedDeployment
+		*out = new(PrecomputedDeploymentSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Adapters != nil {
+		in, out := &in.Adapters, &out.Adapters
+		*out = make([]AdapterSpec, len(*in))
+		copy(*out, *in)
+	}
+	if in.SupportBundle != nil {
+		in, out := &in.SupportBundle, &out.SupportBundle
+		*out = new(SupportBundleSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Hooks != nil {
+		in, out := &in.Hooks, &out.Hooks
+		*out = new(ProfilingHooksSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DynamoGraphDeploymentRequestSpec.
@@ -521,22 +1094,127 @@ func (in *DynamoGraphDeploymentRequestSpec) DeepCopy() *DynamoGraphDeploymentReq
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DynamoGraphDeploymentRequestStatus) DeepCopyInto(out *DynamoGraphDeploymentRequestStatus) {
 	*out = *in
+	if in.BackendComparison != nil {
+		in, out := &in.BackendComparison, &out.BackendComparison
+		*out = make([]BackendEvaluation, len(*in))
+		copy(*out, *in)
+	}
+	if in.SpeculativeDecoding != nil {
+		in, out := &in.SpeculativeDecoding, &out.SpeculativeDecoding
+		*out = new(SpeculativeDecodingStatus)
+		**out = **in
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = make([]metav1.Condition, len(*in))
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Warnings != nil {
+		in, out := &in.Warnings, &out.Warnings
+		*out = make([]StatusWarning, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DisabledFeatures != nil {
+		in, out := &in.DisabledFeatures, &out.DisabledFeatures
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PinnedImages != nil {
+		in, out := &in.PinnedImages, &out.PinnedImages
+		*out = make([]PinnedImage, len(*in))
+		copy(*out, *in)
+	}
+	if in.Attempts != nil {
+		in, out := &in.Attempts, &out.Attempts
+		*out = make([]ProfilingAttempt, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.EstimatedCompletionTime != nil {
+		in, out := &in.EstimatedCompletionTime, &out.EstimatedCompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastReprofileTime != nil {
+		in, out := &in.LastReprofileTime, &out.LastReprofileTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Provenance != nil {
+		in, out := &in.Provenance, &out.Provenance
+		*out = new(ProfilingProvenance)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.GeneratedDeployment != nil {
 		in, out := &in.GeneratedDeployment, &out.GeneratedDeployment
 		*out = new(runtime.RawExtension)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.GeneratedSpecExpiry != nil {
+		in, out := &in.GeneratedSpecExpiry, &out.GeneratedSpecExpiry
+		*out = (*in).DeepCopy()
+	}
+	if in.Placement != nil {
+		in, out := &in.Placement, &out.Placement
+		*out = new(PlacementReport)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GeneratedResources != nil {
+		in, out := &in.GeneratedResources, &out.GeneratedResources
+		*out = make([]runtime.RawExtension, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Profiling != nil {
+		in, out := &in.Profiling, &out.Profiling
+		*out = new(ProfilingStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Artifacts != nil {
+		in, out := &in.Artifacts, &out.Artifacts
+		*out = new(ArtifactsStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SupportBundle != nil {
+		in, out := &in.SupportBundle, &out.SupportBundle
+		*out = new(SupportBundleStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Hooks != nil {
+		in, out := &in.Hooks, &out.Hooks
+		*out = make([]ProfilingHookStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ReservedNodes != nil {
+		in, out := &in.ReservedNodes, &out.ReservedNodes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ResolvedModel != nil {
+		in, out := &in.ResolvedModel, &out.ResolvedModel
+		*out = new(ResolvedModelStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Deployment != nil {
 		in, out := &in.Deployment, &out.Deployment
 		*out = new(DeploymentStatus)
-		**out = **in
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(EndpointStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OwnedResources != nil {
+		in, out := &in.OwnedResources, &out.OwnedResources
+		*out = make([]OwnedResource, len(*in))
+		copy(*out, *in)
 	}
 }
 
@@ -578,7 +1256,7 @@ func (in *DynamoGraphDeploymentSpec) DeepCopyInto(out *DynamoGraphDeploymentSpec
 	}
 	if in.Envs != nil {
 		in, out := &in.Envs, &out.Envs
-		*out = make([]v1.EnvVar, len(*in))
+		*out = make([]corev1.EnvVar, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -600,7 +1278,7 @@ func (in *DynamoGraphDeploymentStatus) DeepCopyInto(out *DynamoGraphDeploymentSt
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = make([]metav1.Condition, len(*in))
+		*out = make([]v1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -618,136 +1296,971 @@ func (in *DynamoGraphDeploymentStatus) DeepCopy() *DynamoGraphDeploymentStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IngressSpec) DeepCopyInto(out *IngressSpec) {
+func (in *DynamoProfilerCapabilities) DeepCopyInto(out *DynamoProfilerCapabilities) {
 	*out = *in
-	if in.VirtualServiceGateway != nil {
-		in, out := &in.VirtualServiceGateway, &out.VirtualServiceGateway
-		*out = new(string)
-		**out = **in
-	}
-	if in.HostPrefix != nil {
-		in, out := &in.HostPrefix, &out.HostPrefix
-		*out = new(string)
-		**out = **in
-	}
-	if in.Annotations != nil {
-		in, out := &in.Annotations, &out.Annotations
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	if in.Labels != nil {
-		in, out := &in.Labels, &out.Labels
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	if in.TLS != nil {
-		in, out := &in.TLS, &out.TLS
-		*out = new(IngressTLSSpec)
-		**out = **in
-	}
-	if in.HostSuffix != nil {
-		in, out := &in.HostSuffix, &out.HostSuffix
-		*out = new(string)
-		**out = **in
-	}
-	if in.IngressControllerClassName != nil {
-		in, out := &in.IngressControllerClassName, &out.IngressControllerClassName
-		*out = new(string)
-		**out = **in
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressSpec.
-func (in *IngressSpec) DeepCopy() *IngressSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DynamoProfilerCapabilities.
+func (in *DynamoProfilerCapabilities) DeepCopy() *DynamoProfilerCapabilities {
 	if in == nil {
 		return nil
 	}
-	out := new(IngressSpec)
+	out := new(DynamoProfilerCapabilities)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DynamoProfilerCapabilities) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IngressTLSSpec) DeepCopyInto(out *IngressTLSSpec) {
+func (in *DynamoProfilerCapabilitiesList) DeepCopyInto(out *DynamoProfilerCapabilitiesList) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DynamoProfilerCapabilities, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressTLSSpec.
-func (in *IngressTLSSpec) DeepCopy() *IngressTLSSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DynamoProfilerCapabilitiesList.
+func (in *DynamoProfilerCapabilitiesList) DeepCopy() *DynamoProfilerCapabilitiesList {
 	if in == nil {
 		return nil
 	}
-	out := new(IngressTLSSpec)
+	out := new(DynamoProfilerCapabilitiesList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DynamoProfilerCapabilitiesList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MultinodeSpec) DeepCopyInto(out *MultinodeSpec) {
+func (in *DynamoProfilerCapabilitiesSpec) DeepCopyInto(out *DynamoProfilerCapabilitiesSpec) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultinodeSpec.
-func (in *MultinodeSpec) DeepCopy() *MultinodeSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DynamoProfilerCapabilitiesSpec.
+func (in *DynamoProfilerCapabilitiesSpec) DeepCopy() *DynamoProfilerCapabilitiesSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(MultinodeSpec)
+	out := new(DynamoProfilerCapabilitiesSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PVC) DeepCopyInto(out *PVC) {
+func (in *DynamoProfilerCapabilitiesStatus) DeepCopyInto(out *DynamoProfilerCapabilitiesStatus) {
 	*out = *in
-	if in.Create != nil {
-		in, out := &in.Create, &out.Create
-		*out = new(bool)
-		**out = **in
+	if in.Backends != nil {
+		in, out := &in.Backends, &out.Backends
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SweepFeatures != nil {
+		in, out := &in.SweepFeatures, &out.SweepFeatures
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastProbeTime != nil {
+		in, out := &in.LastProbeTime, &out.LastProbeTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DynamoProfilerCapabilitiesStatus.
+func (in *DynamoProfilerCapabilitiesStatus) DeepCopy() *DynamoProfilerCapabilitiesStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DynamoProfilerCapabilitiesStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DynamoProfilingRun) DeepCopyInto(out *DynamoProfilingRun) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DynamoProfilingRun.
+func (in *DynamoProfilingRun) DeepCopy() *DynamoProfilingRun {
+	if in == nil {
+		return nil
+	}
+	out := new(DynamoProfilingRun)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DynamoProfilingRun) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DynamoProfilingRunList) DeepCopyInto(out *DynamoProfilingRunList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DynamoProfilingRun, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DynamoProfilingRunList.
+func (in *DynamoProfilingRunList) DeepCopy() *DynamoProfilingRunList {
+	if in == nil {
+		return nil
+	}
+	out := new(DynamoProfilingRunList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DynamoProfilingRunList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DynamoProfilingRunSpec) DeepCopyInto(out *DynamoProfilingRunSpec) {
+	*out = *in
+	if in.Inputs != nil {
+		in, out := &in.Inputs, &out.Inputs
+		*out = new(apiextensionsv1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Containers != nil {
+		in, out := &in.Containers, &out.Containers
+		*out = make([]ProfilingRunContainer, len(*in))
+		copy(*out, *in)
+	}
+	if in.Placement != nil {
+		in, out := &in.Placement, &out.Placement
+		*out = make([]ProfilingRunPlacement, len(*in))
+		copy(*out, *in)
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DynamoProfilingRunSpec.
+func (in *DynamoProfilingRunSpec) DeepCopy() *DynamoProfilingRunSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DynamoProfilingRunSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EndpointStatus) DeepCopyInto(out *EndpointStatus) {
+	*out = *in
+	if in.APIKeySecretRef != nil {
+		in, out := &in.APIKeySecretRef, &out.APIKeySecretRef
+		*out = new(SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointStatus.
+func (in *EndpointStatus) DeepCopy() *EndpointStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EndpointStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FeaturesSpec) DeepCopyInto(out *FeaturesSpec) {
+	*out = *in
+	if in.Disaggregation != nil {
+		in, out := &in.Disaggregation, &out.Disaggregation
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SpeculativeDecoding != nil {
+		in, out := &in.SpeculativeDecoding, &out.SpeculativeDecoding
+		*out = new(SpeculativeDecodingSpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FeaturesSpec.
+func (in *FeaturesSpec) DeepCopy() *FeaturesSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FeaturesSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GPUUtilization) DeepCopyInto(out *GPUUtilization) {
+	*out = *in
+	in.Start.DeepCopyInto(&out.Start)
+	in.End.DeepCopyInto(&out.End)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GPUUtilization.
+func (in *GPUUtilization) DeepCopy() *GPUUtilization {
+	if in == nil {
+		return nil
+	}
+	out := new(GPUUtilization)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GeneratedSpecValiditySpec) DeepCopyInto(out *GeneratedSpecValiditySpec) {
+	*out = *in
+	if in.ValidFor != nil {
+		in, out := &in.ValidFor, &out.ValidFor
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GeneratedSpecValiditySpec.
+func (in *GeneratedSpecValiditySpec) DeepCopy() *GeneratedSpecValiditySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GeneratedSpecValiditySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HardwareSpec) DeepCopyInto(out *HardwareSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HardwareSpec.
+func (in *HardwareSpec) DeepCopy() *HardwareSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HardwareSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressSpec) DeepCopyInto(out *IngressSpec) {
+	*out = *in
+	if in.VirtualServiceGateway != nil {
+		in, out := &in.VirtualServiceGateway, &out.VirtualServiceGateway
+		*out = new(string)
+		**out = **in
+	}
+	if in.HostPrefix != nil {
+		in, out := &in.HostPrefix, &out.HostPrefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(IngressTLSSpec)
+		**out = **in
+	}
+	if in.HostSuffix != nil {
+		in, out := &in.HostSuffix, &out.HostSuffix
+		*out = new(string)
+		**out = **in
+	}
+	if in.IngressControllerClassName != nil {
+		in, out := &in.IngressControllerClassName, &out.IngressControllerClassName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressSpec.
+func (in *IngressSpec) DeepCopy() *IngressSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressTLSSpec) DeepCopyInto(out *IngressTLSSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressTLSSpec.
+func (in *IngressTLSSpec) DeepCopy() *IngressTLSSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressTLSSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LatencyPercentilesSpec) DeepCopyInto(out *LatencyPercentilesSpec) {
+	*out = *in
+	if in.TTFT != nil {
+		in, out := &in.TTFT, &out.TTFT
+		*out = new(PercentileTargets)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ITL != nil {
+		in, out := &in.ITL, &out.ITL
+		*out = new(PercentileTargets)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LatencyPercentilesSpec.
+func (in *LatencyPercentilesSpec) DeepCopy() *LatencyPercentilesSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LatencyPercentilesSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindowSpec) DeepCopyInto(out *MaintenanceWindowSpec) {
+	*out = *in
+	out.Duration = in.Duration
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindowSpec.
+func (in *MaintenanceWindowSpec) DeepCopy() *MaintenanceWindowSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindowSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelRefSpec) DeepCopyInto(out *ModelRefSpec) {
+	*out = *in
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelRefSpec.
+func (in *ModelRefSpec) DeepCopy() *ModelRefSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelRefSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MultinodeSpec) DeepCopyInto(out *MultinodeSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultinodeSpec.
+func (in *MultinodeSpec) DeepCopy() *MultinodeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MultinodeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeReservationSpec) DeepCopyInto(out *NodeReservationSpec) {
+	*out = *in
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Taint != nil {
+		in, out := &in.Taint, &out.Taint
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeReservationSpec.
+func (in *NodeReservationSpec) DeepCopy() *NodeReservationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeReservationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OutputSpec) DeepCopyInto(out *OutputSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutputSpec.
+func (in *OutputSpec) DeepCopy() *OutputSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OutputSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OwnedResource) DeepCopyInto(out *OwnedResource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OwnedResource.
+func (in *OwnedResource) DeepCopy() *OwnedResource {
+	if in == nil {
+		return nil
+	}
+	out := new(OwnedResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PVC) DeepCopyInto(out *PVC) {
+	*out = *in
+	if in.Create != nil {
+		in, out := &in.Create, &out.Create
+		*out = new(bool)
+		**out = **in
 	}
 	if in.Name != nil {
 		in, out := &in.Name, &out.Name
 		*out = new(string)
 		**out = **in
 	}
-	out.Size = in.Size.DeepCopy()
+	out.Size = in.Size.DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PVC.
+func (in *PVC) DeepCopy() *PVC {
+	if in == nil {
+		return nil
+	}
+	out := new(PVC)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PercentileTargets) DeepCopyInto(out *PercentileTargets) {
+	*out = *in
+	if in.P50Milliseconds != nil {
+		in, out := &in.P50Milliseconds, &out.P50Milliseconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.P90Milliseconds != nil {
+		in, out := &in.P90Milliseconds, &out.P90Milliseconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.P99Milliseconds != nil {
+		in, out := &in.P99Milliseconds, &out.P99Milliseconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PercentileTargets.
+func (in *PercentileTargets) DeepCopy() *PercentileTargets {
+	if in == nil {
+		return nil
+	}
+	out := new(PercentileTargets)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PinnedImage) DeepCopyInto(out *PinnedImage) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PinnedImage.
+func (in *PinnedImage) DeepCopy() *PinnedImage {
+	if in == nil {
+		return nil
+	}
+	out := new(PinnedImage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementReport) DeepCopyInto(out *PlacementReport) {
+	*out = *in
+	if in.Services != nil {
+		in, out := &in.Services, &out.Services
+		*out = make([]ServicePlacement, len(*in))
+		copy(*out, *in)
+	}
+	in.ObservedTime.DeepCopyInto(&out.ObservedTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementReport.
+func (in *PlacementReport) DeepCopy() *PlacementReport {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementReport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrecomputedDeploymentSpec) DeepCopyInto(out *PrecomputedDeploymentSpec) {
+	*out = *in
+	if in.Deployment != nil {
+		in, out := &in.Deployment, &out.Deployment
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConfigMapRef != nil {
+		in, out := &in.ConfigMapRef, &out.ConfigMapRef
+		*out = new(ConfigMapKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrecomputedDeploymentSpec.
+func (in *PrecomputedDeploymentSpec) DeepCopy() *PrecomputedDeploymentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PrecomputedDeploymentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProfilingAttempt) DeepCopyInto(out *ProfilingAttempt) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.SLA != nil {
+		in, out := &in.SLA, &out.SLA
+		*out = new(apiextensionsv1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProfilingAttempt.
+func (in *ProfilingAttempt) DeepCopy() *ProfilingAttempt {
+	if in == nil {
+		return nil
+	}
+	out := new(ProfilingAttempt)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProfilingConfigSpec) DeepCopyInto(out *ProfilingConfigSpec) {
+	*out = *in
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(apiextensionsv1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConfigMapRef != nil {
+		in, out := &in.ConfigMapRef, &out.ConfigMapRef
+		*out = new(ConfigMapKeySelector)
+		**out = **in
+	}
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(SecretKeySelector)
+		**out = **in
+	}
+	if in.NodeReservation != nil {
+		in, out := &in.NodeReservation, &out.NodeReservation
+		*out = new(NodeReservationSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ArtifactsPVC != nil {
+		in, out := &in.ArtifactsPVC, &out.ArtifactsPVC
+		*out = new(ArtifactsPVCSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProfilingConfigSpec.
+func (in *ProfilingConfigSpec) DeepCopy() *ProfilingConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProfilingConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProfilingHook) DeepCopyInto(out *ProfilingHook) {
+	*out = *in
+	if in.Container != nil {
+		in, out := &in.Container, &out.Container
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.JobTemplate != nil {
+		in, out := &in.JobTemplate, &out.JobTemplate
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int64)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PVC.
-func (in *PVC) DeepCopy() *PVC {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProfilingHook.
+func (in *ProfilingHook) DeepCopy() *ProfilingHook {
 	if in == nil {
 		return nil
 	}
-	out := new(PVC)
+	out := new(ProfilingHook)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProfilingConfigSpec) DeepCopyInto(out *ProfilingConfigSpec) {
+func (in *ProfilingHookStatus) DeepCopyInto(out *ProfilingHookStatus) {
 	*out = *in
-	if in.Config != nil {
-		in, out := &in.Config, &out.Config
-		*out = new(apiextensionsv1.JSON)
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProfilingHookStatus.
+func (in *ProfilingHookStatus) DeepCopy() *ProfilingHookStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProfilingHookStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProfilingHooksSpec) DeepCopyInto(out *ProfilingHooksSpec) {
+	*out = *in
+	if in.PreProfiling != nil {
+		in, out := &in.PreProfiling, &out.PreProfiling
+		*out = make([]ProfilingHook, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PostProfiling != nil {
+		in, out := &in.PostProfiling, &out.PostProfiling
+		*out = make([]ProfilingHook, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProfilingHooksSpec.
+func (in *ProfilingHooksSpec) DeepCopy() *ProfilingHooksSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProfilingHooksSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProfilingProvenance) DeepCopyInto(out *ProfilingProvenance) {
+	*out = *in
+	in.RecordedTime.DeepCopyInto(&out.RecordedTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProfilingProvenance.
+func (in *ProfilingProvenance) DeepCopy() *ProfilingProvenance {
+	if in == nil {
+		return nil
+	}
+	out := new(ProfilingProvenance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProfilingRunContainer) DeepCopyInto(out *ProfilingRunContainer) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProfilingRunContainer.
+func (in *ProfilingRunContainer) DeepCopy() *ProfilingRunContainer {
+	if in == nil {
+		return nil
+	}
+	out := new(ProfilingRunContainer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProfilingRunPlacement) DeepCopyInto(out *ProfilingRunPlacement) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProfilingRunPlacement.
+func (in *ProfilingRunPlacement) DeepCopy() *ProfilingRunPlacement {
+	if in == nil {
+		return nil
+	}
+	out := new(ProfilingRunPlacement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProfilingStatus) DeepCopyInto(out *ProfilingStatus) {
+	*out = *in
+	if in.Utilization != nil {
+		in, out := &in.Utilization, &out.Utilization
+		*out = make([]GPUUtilization, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProfilingStatus.
+func (in *ProfilingStatus) DeepCopy() *ProfilingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProfilingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResolvedModelStatus) DeepCopyInto(out *ResolvedModelStatus) {
+	*out = *in
+	if in.Size != nil {
+		in, out := &in.Size, &out.Size
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	in.ResolvedTime.DeepCopyInto(&out.ResolvedTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResolvedModelStatus.
+func (in *ResolvedModelStatus) DeepCopy() *ResolvedModelStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ResolvedModelStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SLASpec) DeepCopyInto(out *SLASpec) {
+	*out = *in
+	if in.RequestsPerSecond != nil {
+		in, out := &in.RequestsPerSecond, &out.RequestsPerSecond
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ConcurrentUsers != nil {
+		in, out := &in.ConcurrentUsers, &out.ConcurrentUsers
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TokenLatency != nil {
+		in, out := &in.TokenLatency, &out.TokenLatency
+		*out = new(TokenLatencySpec)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.ConfigMapRef != nil {
-		in, out := &in.ConfigMapRef, &out.ConfigMapRef
-		*out = new(ConfigMapKeySelector)
+	if in.BatchLatencyMilliseconds != nil {
+		in, out := &in.BatchLatencyMilliseconds, &out.BatchLatencyMilliseconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MinTokensPerSecond != nil {
+		in, out := &in.MinTokensPerSecond, &out.MinTokensPerSecond
+		*out = new(int32)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProfilingConfigSpec.
-func (in *ProfilingConfigSpec) DeepCopy() *ProfilingConfigSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SLASpec.
+func (in *SLASpec) DeepCopy() *SLASpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ProfilingConfigSpec)
+	out := new(SLASpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulingDiagnostic) DeepCopyInto(out *SchedulingDiagnostic) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulingDiagnostic.
+func (in *SchedulingDiagnostic) DeepCopy() *SchedulingDiagnostic {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulingDiagnostic)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretKeySelector) DeepCopyInto(out *SecretKeySelector) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretKeySelector.
+func (in *SecretKeySelector) DeepCopy() *SecretKeySelector {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretKeySelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceOverride) DeepCopyInto(out *ServiceOverride) {
+	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(common.Resources)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]corev1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ExtraArgs != nil {
+		in, out := &in.ExtraArgs, &out.ExtraArgs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceOverride.
+func (in *ServiceOverride) DeepCopy() *ServiceOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServicePlacement) DeepCopyInto(out *ServicePlacement) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServicePlacement.
+func (in *ServicePlacement) DeepCopy() *ServicePlacement {
+	if in == nil {
+		return nil
+	}
+	out := new(ServicePlacement)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -768,6 +2281,123 @@ func (in *SharedMemorySpec) DeepCopy() *SharedMemorySpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotReference) DeepCopyInto(out *SnapshotReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotReference.
+func (in *SnapshotReference) DeepCopy() *SnapshotReference {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpeculativeDecodingSpec) DeepCopyInto(out *SpeculativeDecodingSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpeculativeDecodingSpec.
+func (in *SpeculativeDecodingSpec) DeepCopy() *SpeculativeDecodingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SpeculativeDecodingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpeculativeDecodingStatus) DeepCopyInto(out *SpeculativeDecodingStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpeculativeDecodingStatus.
+func (in *SpeculativeDecodingStatus) DeepCopy() *SpeculativeDecodingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SpeculativeDecodingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StatusWarning) DeepCopyInto(out *StatusWarning) {
+	*out = *in
+	in.LastObservedTime.DeepCopyInto(&out.LastObservedTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StatusWarning.
+func (in *StatusWarning) DeepCopy() *StatusWarning {
+	if in == nil {
+		return nil
+	}
+	out := new(StatusWarning)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SupportBundleSpec) DeepCopyInto(out *SupportBundleSpec) {
+	*out = *in
+	if in.LogTailLines != nil {
+		in, out := &in.LogTailLines, &out.LogTailLines
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SupportBundleSpec.
+func (in *SupportBundleSpec) DeepCopy() *SupportBundleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SupportBundleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SupportBundleStatus) DeepCopyInto(out *SupportBundleStatus) {
+	*out = *in
+	in.CollectedTime.DeepCopyInto(&out.CollectedTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SupportBundleStatus.
+func (in *SupportBundleStatus) DeepCopy() *SupportBundleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SupportBundleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TokenLatencySpec) DeepCopyInto(out *TokenLatencySpec) {
+	*out = *in
+	if in.Percentiles != nil {
+		in, out := &in.Percentiles, &out.Percentiles
+		*out = new(LatencyPercentilesSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenLatencySpec.
+func (in *TokenLatencySpec) DeepCopy() *TokenLatencySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TokenLatencySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VolumeMount) DeepCopyInto(out *VolumeMount) {
 	*out = *in
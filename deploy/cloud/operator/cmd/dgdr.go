@@ -0,0 +1,311 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"crypto/tls"
+	"fmt"
+	"os"
+	"time"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/audit"
+	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/controller"
+	commonController "github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/controller_common"
+	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/rbac"
+	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/registry"
+	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/secrets"
+	webhookv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/webhook/v1alpha1"
+)
+
+// dgdrOptions configures the DGDR subsystem from the flags of the operator
+type dgdrOptions struct {
+	// Enabled is false with --enable-dgdr=false
+	Enabled bool
+
+	Config                              commonController.Config
+	RBACManager                         *rbac.Manager
+	DockerSecretRetriever               *secrets.DockerSecretIndexer
+	RestrictedNamespace                 string
+	LeaderElectionNamespace             string
+	OperatorNamespace                   string
+	EnableWebhooks                      bool
+	PrometheusEndpoint                  string
+	ProfilingRunRetention               time.Duration
+	DegradedGracePeriod                 time.Duration
+	StuckPodGracePeriod                 time.Duration
+	JobTerminationTimeout               time.Duration
+	GeneratedSpecValidity               time.Duration
+	DegradedObservations                int
+	ProfilerCapabilitiesRefreshInterval time.Duration
+	ValidateImageArchitectures          bool
+	ProfilerMode                        string
+	PlaceholderTemplates                map[string]string
+	ImageAllowlist                      *controller.ImageAllowlist
+	CompatibilityMatrixURL              string
+	CompatibilityMatrixRefreshInterval  time.Duration
+	CompatibilityMatrixNamespace        string
+	CompatibilityMatrix                 *controller.CompatibilityMatrixStore
+	CatalogURL                          string
+	CatalogRefreshInterval              time.Duration
+	Catalog                             *controller.ProfiledCatalogStore
+	PodSecurityProfile                  controller.PodSecurityProfile
+	ServiceMesh                         controller.ServiceMesh
+	AuditSink                           audit.Sink
+	FaultInjector                       *controller.FaultInjector
+	RuntimeImages                       map[string]string
+	PodMonitorEndpoints                 map[string]controller.PodMonitorEndpoint
+	ArtifactsTTL                        time.Duration
+	AttemptRetention                    int
+	SetMaxInProgress                    int
+	NamespaceSelector                   labels.Selector
+	OperatorInstance                    string
+	ShardCount                          int
+	ShardAssignment                     string
+	ProfilingHistoryNamespace           string
+	OrphanPolicy                        controller.OrphanPolicy
+	OrphanReportNamespace               string
+	TLSOpts                             []func(*tls.Config)
+	ResultsPVCPath                      string
+	ResultsBindAddress                  string
+	ResultsEndpoint                     string
+	ResultsCert                         tls.Certificate
+	ResultsCA                           []byte
+	Estimator                           controller.Estimator
+	EstimateBindAddress                 string
+	EstimateCert                        tls.Certificate
+	StatusBindAddress                   string
+	StatusCert                          tls.Certificate
+}
+
+// setupDGDR registers the controllers, webhooks, endpoints and background tasks of the DGDR
+// subsystem with the manager. Nothing is registered when it is disabled, the operator then only
+// reconciles DynamoGraphDeployments.
+func setupDGDR(mgr ctrl.Manager, opts dgdrOptions) error {
+	if !opts.Enabled {
+		setupLog.Info("DGDR subsystem disabled, only DynamoGraphDeployments are reconciled")
+		return nil
+	}
+
+	var err error
+	var metricsQuerier controller.MetricsQuerier
+	if opts.PrometheusEndpoint != "" {
+		metricsQuerier, err = controller.NewPrometheusQuerier(opts.PrometheusEndpoint)
+		if err != nil {
+			return fmt.Errorf("unable to create Prometheus querier: %w", err)
+		}
+	}
+	dgdrReconciler := &controller.DynamoGraphDeploymentRequestReconciler{
+		Client:                mgr.GetClient(),
+		APIReader:             mgr.GetAPIReader(),
+		Recorder:              controller.NewRedactingRecorder(mgr.GetEventRecorderFor("dynamographdeploymentrequest")),
+		Config:                opts.Config,
+		RBACManager:           opts.RBACManager,
+		MetricsQuerier:        metricsQuerier,
+		ImageResolver:         registry.NewResolver(),
+		ModelRegistry:         &controller.HTTPModelRegistry{},
+		DockerSecretRetriever: opts.DockerSecretRetriever,
+		DegradedGracePeriod:   opts.DegradedGracePeriod,
+		StuckPodGracePeriod:   opts.StuckPodGracePeriod,
+		JobTerminationTimeout: opts.JobTerminationTimeout,
+		GeneratedSpecValidity: opts.GeneratedSpecValidity,
+		DegradedObservations:  int32(opts.DegradedObservations),
+		ProfilerMode:          opts.ProfilerMode,
+		PlaceholderTemplates:  opts.PlaceholderTemplates,
+		ImageAllowlist:        opts.ImageAllowlist,
+		CompatibilityMatrix:   opts.CompatibilityMatrix,
+		Catalog:               opts.Catalog,
+		PodSecurityProfile:    opts.PodSecurityProfile,
+		ServiceMesh:           opts.ServiceMesh,
+		AuditSink:             opts.AuditSink,
+		ApprovalAudited:       opts.EnableWebhooks && opts.AuditSink != nil,
+		FaultInjector:         opts.FaultInjector,
+		RuntimeImages:         opts.RuntimeImages,
+		PodMonitorEndpoints:   opts.PodMonitorEndpoints,
+		ArtifactsTTL:          opts.ArtifactsTTL,
+		AttemptRetention:      int32(opts.AttemptRetention),
+		NamespaceSelector:     opts.NamespaceSelector,
+		OperatorInstance:      opts.OperatorInstance,
+		ProfilingDurations:    controller.NewProfilingDurationHistory(mgr.GetClient(), opts.ProfilingHistoryNamespace),
+		ResultsPVCPath:        opts.ResultsPVCPath,
+		ResultsEndpoint:       opts.ResultsEndpoint,
+		ResultsCA:             opts.ResultsCA,
+	}
+	if opts.ProfilerCapabilitiesRefreshInterval > 0 {
+		dgdrReconciler.ProfilerInspector = registry.NewResolver()
+		dgdrReconciler.ProfilerCapabilitiesRefreshInterval = opts.ProfilerCapabilitiesRefreshInterval
+	}
+	if opts.ValidateImageArchitectures {
+		dgdrReconciler.ArchitectureInspector = registry.NewResolver()
+	}
+	if logClient, err := kubernetes.NewForConfig(mgr.GetConfig()); err != nil {
+		setupLog.Error(err, "unable to create the pod log client, support bundles are collected without logs")
+	} else {
+		dgdrReconciler.LogReader = controller.NewPodLogReader(logClient)
+	}
+	if opts.OperatorNamespace != "" {
+		// The pod name is its hostname
+		if hostname, err := os.Hostname(); err == nil {
+			dgdrReconciler.OperatorPod = types.NamespacedName{Namespace: opts.OperatorNamespace, Name: hostname}
+		}
+	}
+	if opts.ShardCount > 0 {
+		// The pod name identifies the replica, it is its hostname
+		identity, err := os.Hostname()
+		if err != nil {
+			return fmt.Errorf("unable to determine the replica identity for DGDR sharding: %w", err)
+		}
+		sharder, err := controller.NewSharder(mgr.GetClient(), mgr.GetAPIReader(), opts.ShardCount, opts.ShardAssignment, identity)
+		if err != nil {
+			return fmt.Errorf("invalid DGDR sharding configuration: %w", err)
+		}
+		sharder.LeaseNamespace = opts.LeaderElectionNamespace
+		if err = mgr.Add(sharder); err != nil {
+			return fmt.Errorf("unable to add DGDR sharder: %w", err)
+		}
+		dgdrReconciler.Sharder = sharder
+		setupLog.Info("DGDRs are sharded across operator replicas", "shards", opts.ShardCount, "assignment", opts.ShardAssignment, "shard", sharder.Shard())
+	}
+	if err = dgdrReconciler.SetupWithManager(mgr); err != nil {
+		return fmt.Errorf("unable to create controller DynamoGraphDeploymentRequest: %w", err)
+	}
+	if err = (&controller.DynamoAdminActionReconciler{
+		Client:   mgr.GetClient(),
+		Recorder: mgr.GetEventRecorderFor("dynamoadminaction"),
+	}).SetupWithManager(mgr); err != nil {
+		return fmt.Errorf("unable to create controller DynamoAdminAction: %w", err)
+	}
+	if err = (&controller.DynamoGraphDeploymentRequestSetReconciler{
+		Client:        mgr.GetClient(),
+		Recorder:      mgr.GetEventRecorderFor("dynamographdeploymentrequestset"),
+		MaxInProgress: opts.SetMaxInProgress,
+	}).SetupWithManager(mgr); err != nil {
+		return fmt.Errorf("unable to create controller DynamoGraphDeploymentRequestSet: %w", err)
+	}
+	// Namespace annotations can only be watched with cluster-wide access
+	if opts.RestrictedNamespace == "" {
+		if err = (&controller.NamespaceAdminActionReconciler{
+			Client:   mgr.GetClient(),
+			Recorder: mgr.GetEventRecorderFor("namespaceadminaction"),
+		}).SetupWithManager(mgr); err != nil {
+			return fmt.Errorf("unable to create controller NamespaceAdminAction: %w", err)
+		}
+	}
+	if err = (&controller.DynamoProfilingRunReconciler{
+		Client:    mgr.GetClient(),
+		Retention: opts.ProfilingRunRetention,
+	}).SetupWithManager(mgr); err != nil {
+		return fmt.Errorf("unable to create controller DynamoProfilingRun: %w", err)
+	}
+	if opts.EnableWebhooks {
+		if err = webhookv1alpha1.SetupDynamoGraphDeploymentRequestWebhookWithManager(mgr, opts.ImageAllowlist, opts.AuditSink); err != nil {
+			return fmt.Errorf("unable to create webhook DynamoGraphDeploymentRequest: %w", err)
+		}
+	}
+	if err = mgr.Add(&controller.CompatibilityMatrixRefresher{
+		Store:    opts.CompatibilityMatrix,
+		URL:      opts.CompatibilityMatrixURL,
+		Interval: opts.CompatibilityMatrixRefreshInterval,
+	}); err != nil {
+		return fmt.Errorf("unable to add compatibility matrix refresher: %w", err)
+	}
+	if opts.CompatibilityMatrixNamespace != "" {
+		if err = mgr.Add(&controller.CompatibilityMatrixPublisher{
+			Client:    mgr.GetClient(),
+			Store:     opts.CompatibilityMatrix,
+			Namespace: opts.CompatibilityMatrixNamespace,
+		}); err != nil {
+			return fmt.Errorf("unable to add compatibility matrix publisher: %w", err)
+		}
+	}
+	if opts.CatalogURL != "" {
+		if err = mgr.Add(&controller.ProfiledCatalogRefresher{
+			Store:    opts.Catalog,
+			URL:      opts.CatalogURL,
+			Interval: opts.CatalogRefreshInterval,
+		}); err != nil {
+			return fmt.Errorf("unable to add profiled catalog refresher: %w", err)
+		}
+	}
+	if err = mgr.Add(&controller.OrphanScanner{
+		Client:          mgr.GetClient(),
+		APIReader:       mgr.GetAPIReader(),
+		Policy:          opts.OrphanPolicy,
+		WatchNamespace:  opts.RestrictedNamespace,
+		ReportNamespace: opts.OrphanReportNamespace,
+	}); err != nil {
+		return fmt.Errorf("unable to add orphan scanner: %w", err)
+	}
+	if err = mgr.Add(&controller.ArtifactsPruner{
+		Client:             mgr.GetClient(),
+		Recorder:           mgr.GetEventRecorderFor("dgdr-artifacts"),
+		WatchNamespace:     opts.RestrictedNamespace,
+		PodSecurityProfile: opts.PodSecurityProfile,
+	}); err != nil {
+		return fmt.Errorf("unable to add artifacts pruner: %w", err)
+	}
+	if opts.ResultsEndpoint != "" {
+		resultsTLSConfig := &tls.Config{Certificates: []tls.Certificate{opts.ResultsCert}}
+		for _, opt := range opts.TLSOpts {
+			opt(resultsTLSConfig)
+		}
+		if err = mgr.Add(&controller.ResultsServer{
+			Client:      mgr.GetClient(),
+			Recorder:    controller.NewRedactingRecorder(mgr.GetEventRecorderFor("dgdr-results")),
+			BindAddress: opts.ResultsBindAddress,
+			TLSConfig:   resultsTLSConfig,
+		}); err != nil {
+			return fmt.Errorf("unable to add profiling results endpoint: %w", err)
+		}
+	}
+	if opts.Estimator != nil {
+		estimateTLSConfig := &tls.Config{Certificates: []tls.Certificate{opts.EstimateCert}}
+		for _, opt := range opts.TLSOpts {
+			opt(estimateTLSConfig)
+		}
+		if err = mgr.Add(&controller.EstimateServer{
+			Client:      mgr.GetClient(),
+			Reconciler:  dgdrReconciler,
+			Estimator:   opts.Estimator,
+			BindAddress: opts.EstimateBindAddress,
+			TLSConfig:   estimateTLSConfig,
+		}); err != nil {
+			return fmt.Errorf("unable to add estimate endpoint: %w", err)
+		}
+	}
+	if opts.StatusBindAddress != "0" {
+		statusTLSConfig := &tls.Config{Certificates: []tls.Certificate{opts.StatusCert}}
+		for _, opt := range opts.TLSOpts {
+			opt(statusTLSConfig)
+		}
+		if err = mgr.Add(&controller.StatusServer{
+			Client:           mgr.GetClient(),
+			OperatorInstance: opts.OperatorInstance,
+			BindAddress:      opts.StatusBindAddress,
+			TLSConfig:        statusTLSConfig,
+		}); err != nil {
+			return fmt.Errorf("unable to add status endpoint: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,115 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+
+	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/controller"
+	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/rbac"
+	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/secrets"
+)
+
+// recordingManager records the runnables added to the manager
+type recordingManager struct {
+	ctrl.Manager
+	added []manager.Runnable
+}
+
+func (m *recordingManager) Add(runnable manager.Runnable) error {
+	m.added = append(m.added, runnable)
+	return m.Manager.Add(runnable)
+}
+
+// newTestManager returns a manager, not started, of a test API server with the CRDs of the operator
+func newTestManager(t *testing.T) *recordingManager {
+	testEnv := &envtest.Environment{
+		CRDDirectoryPaths: []string{filepath.Join("..", "config", "crd", "bases")},
+		BinaryAssetsDirectory: filepath.Join("..", "bin", "k8s",
+			fmt.Sprintf("1.29.0-%s-%s", runtime.GOOS, runtime.GOARCH)),
+	}
+	cfg, err := testEnv.Start()
+	if err != nil {
+		t.Fatalf("failed to start the test API server: %v", err)
+	}
+	t.Cleanup(func() { _ = testEnv.Stop() })
+
+	mgr, err := ctrl.NewManager(cfg, ctrl.Options{
+		Scheme:                 scheme,
+		Metrics:                metricsserver.Options{BindAddress: "0"},
+		HealthProbeBindAddress: "0",
+	})
+	if err != nil {
+		t.Fatalf("failed to create the manager: %v", err)
+	}
+	return &recordingManager{Manager: mgr}
+}
+
+func TestSetupDGDR(t *testing.T) {
+	mgr := newTestManager(t)
+	compatibilityMatrix, err := controller.NewCompatibilityMatrixStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts := dgdrOptions{
+		RBACManager:                  rbac.NewManager(mgr.GetClient()),
+		DockerSecretRetriever:        secrets.NewDockerSecretIndexer(mgr.GetClient()),
+		ProfilerMode:                 controller.ProfilerModeJob,
+		CompatibilityMatrix:          compatibilityMatrix,
+		StatusBindAddress:            "0",
+		CompatibilityMatrixNamespace: "default",
+	}
+
+	// Disabled, nothing of the DGDR subsystem runs
+	if err := setupDGDR(mgr, opts); err != nil {
+		t.Fatalf("setupDGDR disabled: %v", err)
+	}
+	if len(mgr.added) > 0 {
+		t.Fatalf("setupDGDR disabled added %d runnables, expected none", len(mgr.added))
+	}
+
+	opts.Enabled = true
+	if err := setupDGDR(mgr, opts); err != nil {
+		t.Fatalf("setupDGDR: %v", err)
+	}
+	added := map[string]int{}
+	for _, runnable := range mgr.added {
+		added[fmt.Sprintf("%T", runnable)]++
+	}
+	for _, runnable := range []string{
+		"*controller.CompatibilityMatrixRefresher",
+		"*controller.CompatibilityMatrixPublisher",
+		"*controller.OrphanScanner",
+		"*controller.ArtifactsPruner",
+	} {
+		if added[runnable] != 1 {
+			t.Errorf("setupDGDR added %d %s, expected 1", added[runnable], runnable)
+		}
+	}
+	if controllers := added["*controller.Controller[sigs.k8s.io/controller-runtime/pkg/reconcile.Request]"]; controllers == 0 {
+		t.Errorf("setupDGDR added no controllers")
+	}
+}
@@ -26,6 +26,8 @@ import (
 	"net/url"
 	"os"
 	"time"
+	// Embed the time zone database for the maintenance windows of DGDRs, distroless images may lack it
+	_ "time/tzdata"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
@@ -42,10 +44,14 @@ import (
 	k8sCache "k8s.io/client-go/tools/cache"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
@@ -56,6 +62,7 @@ import (
 
 	grovev1alpha1 "github.com/NVIDIA/grove/operator/api/core/v1alpha1"
 	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/audit"
 	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/consts"
 	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/controller"
 	commonController "github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/controller_common"
@@ -63,6 +70,7 @@ import (
 	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/rbac"
 	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/secret"
 	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/secrets"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	istioclientsetscheme "istio.io/client-go/pkg/clientset/versioned/scheme"
 	//+kubebuilder:scaffold:imports
 )
@@ -114,6 +122,8 @@ func init() {
 	utilruntime.Must(apiextensionsv1.AddToScheme(scheme))
 
 	utilruntime.Must(istioclientsetscheme.AddToScheme(scheme))
+
+	utilruntime.Must(monitoringv1.AddToScheme(scheme))
 	//+kubebuilder:scaffold:scheme
 }
 
@@ -141,6 +151,52 @@ func main() {
 	var mpiRunSecretNamespace string
 	var plannerClusterRoleName string
 	var dgdrProfilingClusterRoleName string
+	var dgdrProfilingRoleAggregationLabel string
+	var dgdrWorkerClusterRoleName string
+	var profilingRunRetention time.Duration
+	var dgdrDegradedGracePeriod time.Duration
+	var dgdrStuckPodGracePeriod time.Duration
+	var dgdrJobTerminationTimeout time.Duration
+	var dgdrGeneratedSpecValidity time.Duration
+	var profilerCapabilitiesRefreshInterval time.Duration
+	var validateImageArchitectures bool
+	var dgdrDegradedObservations int
+	var profilerMode string
+	var placeholderTemplatesDir string
+	var imageAllowlistFile string
+	var compatibilityMatrixURL string
+	var compatibilityMatrixRefreshInterval time.Duration
+	var compatibilityMatrixNamespace string
+	var catalogURL string
+	var catalogRefreshInterval time.Duration
+	var resultsPVCPath string
+	var resultsBindAddress string
+	var resultsEndpoint string
+	var resultsCertDir string
+	var estimateBindAddress string
+	var estimateCertDir string
+	var estimateAICURL string
+	var statusBindAddress string
+	var statusCertDir string
+	var orphanPolicyFlag string
+	var orphanReportNamespace string
+	var profilingHistoryNamespace string
+	var operatorNamespace string
+	var podSecurityProfileFlag string
+	var serviceMeshFlag string
+	var faultInjectionFlag string
+	var runtimeImagesFlag string
+	var podMonitorEndpointsFlag string
+	var auditSinkTarget string
+	var dgdrArtifactsTTL time.Duration
+	var dgdrAttemptRetention int
+	var dgdrSetMaxInProgress int
+	var dgdrNamespaceSelector string
+	var dgdrOperatorInstance string
+	var dgdrShardCount int
+	var dgdrShardAssignmentFlag string
+	var enableWebhooks bool
+	var enableDGDR bool
 	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
 	flag.BoolVar(&enableLeaderElection, "leader-elect", false,
@@ -183,6 +239,113 @@ func main() {
 		"Name of the ClusterRole for planner (cluster-wide mode only)")
 	flag.StringVar(&dgdrProfilingClusterRoleName, "dgdr-profiling-cluster-role-name", "",
 		"Name of the ClusterRole for DGDR profiling jobs (cluster-wide mode only)")
+	flag.StringVar(&dgdrProfilingRoleAggregationLabel, "dgdr-profiling-role-aggregation-label", "",
+		"Label key aggregating the profiling job ClusterRole per namespace (cluster-wide mode only): profiling jobs are bound to "+
+			"<dgdr-profiling-cluster-role-name>-<namespace>, aggregated from the ClusterRoles labeled <label>=true and <label>=<namespace>. "+
+			"The fixed ClusterRole is bound if empty")
+	flag.StringVar(&dgdrWorkerClusterRoleName, "dgdr-worker-cluster-role-name", "",
+		"Name of the ClusterRole bound to ServiceAccounts provisioned for DGDR-generated deployments (optional)")
+	flag.DurationVar(&profilingRunRetention, "profiling-run-retention", 90*24*time.Hour,
+		"How long DynamoProfilingRun audit records are kept after creation (0 keeps them forever)")
+	flag.DurationVar(&dgdrDegradedGracePeriod, "dgdr-degraded-grace-period", controller.DefaultDegradedGracePeriod,
+		"How long a DGDR-managed DGD must stay non-Ready before the DGDR falls back from Degraded to Deploying")
+	flag.DurationVar(&dgdrStuckPodGracePeriod, "dgdr-stuck-pod-grace-period", controller.DefaultStuckPodGracePeriod,
+		"How long profiling pods may stay Terminating on cordoned, NotReady or deleted nodes before they are force deleted (0 disables the cleanup)")
+	flag.DurationVar(&dgdrJobTerminationTimeout, "dgdr-job-termination-timeout", controller.DefaultJobTerminationTimeout,
+		"How long a deleted DGDR is kept until the pods of its profiling job terminated and released their GPUs (0 deletes it without waiting)")
+	flag.DurationVar(&dgdrGeneratedSpecValidity, "dgdr-generated-spec-validity", controller.DefaultGeneratedSpecValidity,
+		"How long generated specs stay valid before DGDRs report them SpecStale, unless the DGDR sets spec.generatedSpecValidity.validFor (0 never expires them)")
+	flag.DurationVar(&profilerCapabilitiesRefreshInterval, "dgdr-profiler-capabilities-refresh-interval", controller.DefaultProfilerCapabilitiesRefreshInterval,
+		"How long the capabilities read from profiler image labels are used before the image is inspected again (0 disables validating DGDRs against them)")
+	flag.BoolVar(&validateImageArchitectures, "dgdr-validate-image-architectures", true,
+		"Fail DGDRs whose profiling or runtime images are not built for the CPU architecture of the target nodes")
+	flag.IntVar(&dgdrDegradedObservations, "dgdr-degraded-observations", controller.DefaultDegradedObservations,
+		"How many consecutive non-Ready observations of a DGDR-managed DGD are required before the DGDR falls back from Degraded to Deploying")
+	flag.StringVar(&profilerMode, "profiler-mode", controller.ProfilerModeJob,
+		"How DGDRs are profiled: \"job\" runs profiling jobs, \"mock\" synthesizes a deterministic generated deployment without running a job (development clusters without GPUs)")
+	flag.StringVar(&placeholderTemplatesDir, "placeholder-templates-dir", "",
+		"Directory of <backend>.yaml Go templates for the deployments generated with --profiler-mode=mock (optional, built-in templates are used otherwise)")
+	flag.StringVar(&imageAllowlistFile, "dgdr-image-allowlist-file", "",
+		"YAML file mapping namespaces to the registries the profiling and runtime images of their DGDRs may come from (optional, any image is allowed otherwise)")
+	flag.StringVar(&compatibilityMatrixURL, "dgdr-compatibility-matrix-url", "",
+		"URL of the YAML model/backend compatibility matrix DGDRs are validated against (optional, the matrix shipped with the operator is used otherwise)")
+	flag.DurationVar(&compatibilityMatrixRefreshInterval, "dgdr-compatibility-matrix-refresh-interval", controller.DefaultCompatibilityMatrixRefreshInterval,
+		"How often the compatibility matrix is refreshed from --dgdr-compatibility-matrix-url")
+	flag.StringVar(&compatibilityMatrixNamespace, "dgdr-compatibility-matrix-namespace", "",
+		"Namespace the current compatibility matrix is published in as the dgdr-compatibility-matrix ConfigMap (optional)")
+	flag.StringVar(&catalogURL, "dgdr-catalog-url", "",
+		"URL of the YAML catalog of pre-profiled configurations DGDRs that match one within their SLA use instead of profiling "+
+			"(optional, the catalog shipped with the operator is used otherwise)")
+	flag.DurationVar(&catalogRefreshInterval, "dgdr-catalog-refresh-interval", controller.DefaultCatalogRefreshInterval,
+		"How often the catalog is refreshed from --dgdr-catalog-url")
+	flag.StringVar(&resultsPVCPath, "results-pvc-path", "",
+		"Path where the shared profiling output volume (dynamo-pvc) is mounted, enables profilingConfig.resultTransport PVC (optional)")
+	flag.StringVar(&resultsBindAddress, "results-bind-address", "0",
+		"The address the HTTPS profiling results endpoint binds to, e.g. :8444. Use 0 to disable it and profilingConfig.resultTransport HTTP")
+	flag.StringVar(&resultsEndpoint, "results-endpoint", "",
+		"The URL profiling jobs post their results to, e.g. https://<service>.<namespace>.svc:8444 (required with --results-bind-address)")
+	flag.StringVar(&resultsCertDir, "results-cert-dir", "",
+		"Directory holding tls.crt, tls.key and optionally ca.crt of the results endpoint. If empty, a self-signed certificate is shared between replicas through the dgdr-results-tls Secret in --operator-namespace")
+	flag.StringVar(&estimateBindAddress, "estimate-bind-address", "0",
+		"The address the HTTPS endpoint returning AI Configurator estimates for posted DGDRs binds to, e.g. :8445. Use 0 to disable it")
+	flag.StringVar(&estimateCertDir, "estimate-cert-dir", "",
+		"Directory holding tls.crt and tls.key of the estimate endpoint. A self-signed certificate for localhost is generated if empty")
+	flag.StringVar(&estimateAICURL, "estimate-aic-url", "",
+		"The URL of the AI Configurator service estimates are requested from (required with --estimate-bind-address unless --profiler-mode is mock)")
+	flag.StringVar(&statusBindAddress, "status-bind-address", "0",
+		"The address the HTTPS endpoint returning a JSON summary of the managed DGDRs and DGDs binds to, e.g. :8446. Use 0 to disable it")
+	flag.StringVar(&statusCertDir, "status-cert-dir", "",
+		"Directory holding tls.crt and tls.key of the status endpoint. A self-signed certificate for localhost is generated if empty")
+	flag.StringVar(&orphanPolicyFlag, "dgdr-orphan-policy", string(controller.OrphanPolicyOff),
+		"What the startup scan for profiling jobs, results and DGDs left without their DGDR (e.g. after an etcd restore) does: \"off\", \"report\", \"adopt\" re-links them to a recreated DGDR, \"delete\" also deletes those whose DGDR is gone (DGDs are only reported)")
+	flag.StringVar(&orphanReportNamespace, "dgdr-orphan-report-namespace", "",
+		"Namespace the orphan scan report is published in as the dgdr-orphan-report ConfigMap (optional)")
+	flag.StringVar(&profilingHistoryNamespace, "dgdr-profiling-history-namespace", "",
+		"Namespace the durations of recent profiling runs, which the completion of new profiling jobs is estimated from, are kept in "+
+			"as the dgdr-profiling-durations ConfigMap. They are only kept in memory if empty")
+	flag.StringVar(&operatorNamespace, "operator-namespace", "",
+		"Namespace the operator pod runs in. The log lines of the operator about a failed DGDR are only collected into its support bundle if set")
+	flag.StringVar(&podSecurityProfileFlag, "dgdr-pod-security-profile", string(controller.PodSecurityProfileNone),
+		"Security context applied to profiling job pods and DGDR-generated deployments where they set none: \"restricted\" passes the restricted Pod Security Standard (images must run as a non-root user), \"none\", the default, leaves it to the images")
+	flag.StringVar(&serviceMeshFlag, "dgdr-service-mesh", string(controller.ServiceMeshNone),
+		"Service mesh whose sidecar injection is enabled on the pods of DGDR-generated deployments and disabled on profiling job pods: \"none\", \"istio\" or \"linkerd\". DGDRs can override it with deploymentOverrides.podAnnotations")
+	flag.StringVar(&runtimeImagesFlag, "dgdr-runtime-images", "",
+		"Comma-separated backend=image pairs, e.g. vllm=nvcr.io/nvidia/ai-dynamo/vllm-runtime:0.6.1, replacing the images the profiler writes "+
+			"into generated deployments of that backend unless the DGDR sets deploymentOverrides.workersImage or a service image override")
+	flag.StringVar(&podMonitorEndpointsFlag, "dgdr-pod-monitor-endpoints", "",
+		"Comma-separated componentType=port:/path metrics endpoints of the PodMonitors generated for DGDR deployments applied with autoApply "+
+			"when the Prometheus Operator CRDs are installed, e.g. frontend=http:/metrics,worker=system:/metrics. "+
+			"Empty, the default, generates no PodMonitors as the PodMonitors of the Helm chart already scrape all Dynamo pods")
+	flag.StringVar(&faultInjectionFlag, "dgdr-fault-injection", "",
+		"For resilience testing only: comma-separated faults injected into DGDR processing, e.g. "+
+			"fail-job-creation=2,corrupt-output=1,delay-status-updates=5s. Never set in production")
+	flag.StringVar(&auditSinkTarget, "audit-log-sink", "",
+		"Where audit records of DGDR admissions, generated specs and applied DGDs are written, separate from the controller logs: "+
+			"a file path records are appended to as JSON lines, an http(s) URL records are POSTed to, or kafka+http(s)://<rest-proxy>/<topic>. No records are written if empty")
+	flag.StringVar(&dgdrNamespaceSelector, "dgdr-namespace-selector", "",
+		"Label selector namespaces must match before DGDRs in them are processed, e.g. dynamo.nvidia.com/enabled=true. DGDRs are processed in every namespace if empty")
+	flag.StringVar(&dgdrOperatorInstance, "dgdr-operator-instance", "",
+		"Name of this operator installation, e.g. <namespace>/<release>, under which it claims the DGDRs it manages so other installations "+
+			"watching the same namespaces leave them untouched and report a NotOwner condition. Removing the nvidia.com/dgdr-operator-instance "+
+			"annotation of a DGDR hands it over. Every watched DGDR is managed if empty")
+	flag.IntVar(&dgdrShardCount, "dgdr-shard-count", 0,
+		"Number of shards DGDRs are split into by the hash of their namespace/name, each processed by an active operator replica. "+
+			"0 processes every DGDR on the leader. Requires leader election, which keeps managing resources shared by all DGDRs")
+	flag.StringVar(&dgdrShardAssignmentFlag, "dgdr-shard-assignment", controller.ShardAssignmentLease,
+		"How a replica gets its shard: ordinal takes it from the ordinal suffix of the pod name (StatefulSets), "+
+			"lease claims a free shard with a Lease in the leader election namespace")
+	flag.DurationVar(&dgdrArtifactsTTL, "dgdr-artifacts-ttl", controller.DefaultArtifactsTTL,
+		"How long profiling artifacts kept with profilingConfig.artifactsPVC are retained when the DGDR sets no TTL. Use 0 to keep them indefinitely")
+	flag.IntVar(&dgdrAttemptRetention, "dgdr-attempt-retention", controller.DefaultAttemptRetention,
+		"How many profiling attempts of a DGDR keep their profiling and hook jobs for debugging; the jobs of older attempts are deleted. Use 0 to keep every attempt's jobs")
+	flag.IntVar(&dgdrSetMaxInProgress, "dgdr-set-max-in-progress", controller.DefaultDGDRSetMaxInProgress,
+		"How many DGDRs stamped out by all DynamoGraphDeploymentRequestSets may be pending, profiling or deploying at the same time. "+
+			"Use 0 to leave them to the maxInProgress of their sets")
+	flag.BoolVar(&enableWebhooks, "enable-webhooks", false,
+		"If set, register the admission webhooks (requires serving certificates and a webhook configuration)")
+	flag.BoolVar(&enableDGDR, "enable-dgdr", true,
+		"If set to false, DynamoGraphDeploymentRequests are not reconciled and none of the profiling controllers, "+
+			"endpoints or webhooks are started. DynamoGraphDeployments are reconciled either way")
 	opts := zap.Options{
 		Development: true,
 	}
@@ -203,6 +366,159 @@ func main() {
 		setupLog.Info("Model Express URL configured", "url", modelExpressURL)
 	}
 
+	orphanPolicy, err := controller.ParseOrphanPolicy(orphanPolicyFlag)
+	if err != nil {
+		setupLog.Error(err, "invalid dgdr-orphan-policy")
+		os.Exit(1)
+	}
+	podSecurityProfile, err := controller.ParsePodSecurityProfile(podSecurityProfileFlag)
+	if err != nil {
+		setupLog.Error(err, "invalid dgdr-pod-security-profile")
+		os.Exit(1)
+	}
+	serviceMesh, err := controller.ParseServiceMesh(serviceMeshFlag)
+	if err != nil {
+		setupLog.Error(err, "invalid dgdr-service-mesh")
+		os.Exit(1)
+	}
+	runtimeImages, err := controller.ParseRuntimeImages(runtimeImagesFlag)
+	if err != nil {
+		setupLog.Error(err, "invalid dgdr-runtime-images")
+		os.Exit(1)
+	}
+	podMonitorEndpoints, err := controller.ParsePodMonitorEndpoints(podMonitorEndpointsFlag)
+	if err != nil {
+		setupLog.Error(err, "invalid dgdr-pod-monitor-endpoints")
+		os.Exit(1)
+	}
+	faultInjector, err := controller.ParseFaultInjection(faultInjectionFlag)
+	if err != nil {
+		setupLog.Error(err, "invalid dgdr-fault-injection")
+		os.Exit(1)
+	}
+	if faultInjector != nil {
+		setupLog.Info("WARNING: injecting faults into DGDR processing, for resilience testing only", "faults", faultInjectionFlag)
+	}
+	var auditSink audit.Sink
+	if auditSinkTarget != "" {
+		if auditSink, err = audit.NewSink(auditSinkTarget); err != nil {
+			setupLog.Error(err, "invalid audit-log-sink")
+			os.Exit(1)
+		}
+		setupLog.Info("Writing audit records", "sink", auditSinkTarget)
+	}
+	var namespaceSelector labels.Selector
+	if dgdrNamespaceSelector != "" {
+		if restrictedNamespace != "" {
+			setupLog.Error(nil, "dgdr-namespace-selector is not supported with restrictedNamespace")
+			os.Exit(1)
+		}
+		if namespaceSelector, err = labels.Parse(dgdrNamespaceSelector); err != nil {
+			setupLog.Error(err, "invalid dgdr-namespace-selector")
+			os.Exit(1)
+		}
+		setupLog.Info("DGDRs are only processed in namespaces matching the selector", "selector", namespaceSelector.String())
+	}
+	dgdrShardAssignment, err := controller.ParseShardAssignment(dgdrShardAssignmentFlag)
+	if err != nil {
+		setupLog.Error(err, "invalid dgdr-shard-assignment")
+		os.Exit(1)
+	}
+	if dgdrShardCount < 0 {
+		setupLog.Error(nil, "dgdr-shard-count must not be negative", "shardCount", dgdrShardCount)
+		os.Exit(1)
+	}
+	if dgdrShardCount > 0 && (!enableLeaderElection || leaderElectionNamespace == "") {
+		setupLog.Error(nil, "dgdr-shard-count requires leader-elect and leader-election-namespace")
+		os.Exit(1)
+	}
+	if profilerMode != controller.ProfilerModeJob && profilerMode != controller.ProfilerModeMock {
+		setupLog.Error(nil, "profiler-mode must be job or mock", "profilerMode", profilerMode)
+		os.Exit(1)
+	}
+	if profilerMode == controller.ProfilerModeMock {
+		setupLog.Info("Profiler mode is mock, DGDRs get synthesized profiling results")
+	}
+	var placeholderTemplates map[string]string
+	if placeholderTemplatesDir != "" {
+		var err error
+		placeholderTemplates, err = controller.LoadPlaceholderTemplates(placeholderTemplatesDir)
+		if err != nil {
+			setupLog.Error(err, "unable to load placeholder templates", "dir", placeholderTemplatesDir)
+			os.Exit(1)
+		}
+	}
+	compatibilityMatrix, err := controller.NewCompatibilityMatrixStore()
+	if err != nil {
+		setupLog.Error(err, "unable to load the embedded compatibility matrix")
+		os.Exit(1)
+	}
+	catalog, err := controller.NewProfiledCatalogStore()
+	if err != nil {
+		setupLog.Error(err, "unable to load the embedded profiled catalog")
+		os.Exit(1)
+	}
+	var imageAllowlist *controller.ImageAllowlist
+	if imageAllowlistFile != "" {
+		var err error
+		imageAllowlist, err = controller.LoadImageAllowlist(imageAllowlistFile)
+		if err != nil {
+			setupLog.Error(err, "unable to load DGDR image allowlist", "file", imageAllowlistFile)
+			os.Exit(1)
+		}
+	}
+
+	var resultsCert tls.Certificate
+	var resultsCA []byte
+	if resultsBindAddress != "0" {
+		if resultsEndpoint == "" {
+			setupLog.Error(nil, "results-endpoint is required when the results endpoint is enabled")
+			os.Exit(1)
+		}
+		certClient, err := client.New(ctrl.GetConfigOrDie(), client.Options{Scheme: scheme})
+		if err != nil {
+			setupLog.Error(err, "unable to create a client for the results endpoint certificate")
+			os.Exit(1)
+		}
+		resultsCert, resultsCA, err = controller.LoadResultsServingCertificate(context.Background(), certClient, resultsCertDir, resultsEndpoint, operatorNamespace)
+		if err != nil {
+			setupLog.Error(err, "unable to load results endpoint certificate")
+			os.Exit(1)
+		}
+	} else {
+		resultsEndpoint = ""
+	}
+
+	var estimateCert tls.Certificate
+	var estimator controller.Estimator
+	if estimateBindAddress != "0" {
+		switch {
+		case profilerMode == controller.ProfilerModeMock:
+			estimator = controller.MockEstimator{}
+		case estimateAICURL != "":
+			estimator = &controller.AICEstimator{URL: estimateAICURL}
+		default:
+			setupLog.Error(nil, "estimate-aic-url is required when the estimate endpoint is enabled")
+			os.Exit(1)
+		}
+		var err error
+		estimateCert, err = controller.LoadEstimateServingCertificate(estimateCertDir, "localhost")
+		if err != nil {
+			setupLog.Error(err, "unable to load estimate endpoint certificate")
+			os.Exit(1)
+		}
+	}
+
+	var statusCert tls.Certificate
+	if statusBindAddress != "0" {
+		var err error
+		statusCert, err = controller.LoadStatusServingCertificate(statusCertDir, "localhost")
+		if err != nil {
+			setupLog.Error(err, "unable to load status endpoint certificate")
+			os.Exit(1)
+		}
+	}
+
 	if mpiRunSecretName == "" {
 		setupLog.Error(nil, "mpi-run-ssh-secret-name is required")
 		os.Exit(1)
@@ -241,6 +557,7 @@ func main() {
 		RBAC: commonController.RBACConfig{
 			PlannerClusterRoleName:       plannerClusterRoleName,
 			DGDRProfilingClusterRoleName: dgdrProfilingClusterRoleName,
+			DGDRWorkerClusterRoleName:    dgdrWorkerClusterRoleName,
 		},
 	}
 
@@ -291,6 +608,10 @@ func main() {
 		// after the manager stops then its usage might be unsafe.
 		// LeaderElectionReleaseOnCancel: true,
 	}
+	// Only the events of failed pod creations are read from the cache, by the DGDR controller
+	mgrOpts.Cache.ByObject = map[client.Object]cache.ByObject{
+		&corev1.Event{}: {Field: fields.OneTermEqualSelector("reason", controller.EventReasonFailedCreate)},
+	}
 	if restrictedNamespace != "" {
 		mgrOpts.Cache.DefaultNamespaces = map[string]cache.Config{
 			restrictedNamespace: {},
@@ -320,11 +641,18 @@ func main() {
 	kaiSchedulerEnabled := commonController.DetectKaiSchedulerAvailability(mainCtx, mgr)
 	ctrlConfig.KaiScheduler.Enabled = kaiSchedulerEnabled
 
+	setupLog.Info("Detecting Prometheus Operator availability...")
+	prometheusOperatorEnabled := commonController.DetectPrometheusOperatorAvailability(mainCtx, mgr)
+	if !prometheusOperatorEnabled {
+		podMonitorEndpoints = nil
+	}
+
 	setupLog.Info("Detected orchestrators availability",
 		"grove", groveEnabled,
 		"lws", lwsEnabled,
 		"volcano", volcanoEnabled,
 		"kai-scheduler", kaiSchedulerEnabled,
+		"prometheus-operator", prometheusOperatorEnabled,
 	)
 
 	// Create etcd client
@@ -452,6 +780,17 @@ func main() {
 
 	// Initialize RBAC manager for cross-namespace resource management
 	rbacManager := rbac.NewManager(mgr.GetClient())
+	if enableDGDR && dgdrProfilingClusterRoleName != "" {
+		rbacManager.RequireRules(dgdrProfilingClusterRoleName, controller.ProfilingJobRequiredRules)
+		if dgdrProfilingRoleAggregationLabel != "" {
+			if errs := validation.IsQualifiedName(dgdrProfilingRoleAggregationLabel); len(errs) > 0 {
+				setupLog.Error(nil, "invalid dgdr-profiling-role-aggregation-label", "errors", errs)
+				os.Exit(1)
+			}
+			rbacManager.AggregateClusterRole(dgdrProfilingClusterRoleName, dgdrProfilingRoleAggregationLabel)
+			setupLog.Info("Aggregating the profiling job ClusterRole per namespace", "label", dgdrProfilingRoleAggregationLabel)
+		}
+	}
 
 	if err = (&controller.DynamoGraphDeploymentReconciler{
 		Client:                mgr.GetClient(),
@@ -466,13 +805,63 @@ func main() {
 		os.Exit(1)
 	}
 
-	if err = (&controller.DynamoGraphDeploymentRequestReconciler{
-		Client:      mgr.GetClient(),
-		Recorder:    mgr.GetEventRecorderFor("dynamographdeploymentrequest"),
-		Config:      ctrlConfig,
-		RBACManager: rbacManager,
-	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "DynamoGraphDeploymentRequest")
+	if err = setupDGDR(mgr, dgdrOptions{
+		Enabled:                             enableDGDR,
+		Config:                              ctrlConfig,
+		RBACManager:                         rbacManager,
+		DockerSecretRetriever:               dockerSecretRetriever,
+		RestrictedNamespace:                 restrictedNamespace,
+		LeaderElectionNamespace:             leaderElectionNamespace,
+		OperatorNamespace:                   operatorNamespace,
+		EnableWebhooks:                      enableWebhooks,
+		PrometheusEndpoint:                  prometheusEndpoint,
+		ProfilingRunRetention:               profilingRunRetention,
+		DegradedGracePeriod:                 dgdrDegradedGracePeriod,
+		StuckPodGracePeriod:                 dgdrStuckPodGracePeriod,
+		JobTerminationTimeout:               dgdrJobTerminationTimeout,
+		GeneratedSpecValidity:               dgdrGeneratedSpecValidity,
+		DegradedObservations:                dgdrDegradedObservations,
+		ProfilerCapabilitiesRefreshInterval: profilerCapabilitiesRefreshInterval,
+		ValidateImageArchitectures:          validateImageArchitectures,
+		ProfilerMode:                        profilerMode,
+		PlaceholderTemplates:                placeholderTemplates,
+		ImageAllowlist:                      imageAllowlist,
+		CompatibilityMatrixURL:              compatibilityMatrixURL,
+		CompatibilityMatrixRefreshInterval:  compatibilityMatrixRefreshInterval,
+		CompatibilityMatrixNamespace:        compatibilityMatrixNamespace,
+		CompatibilityMatrix:                 compatibilityMatrix,
+		CatalogURL:                          catalogURL,
+		CatalogRefreshInterval:              catalogRefreshInterval,
+		Catalog:                             catalog,
+		PodSecurityProfile:                  podSecurityProfile,
+		ServiceMesh:                         serviceMesh,
+		AuditSink:                           auditSink,
+		FaultInjector:                       faultInjector,
+		RuntimeImages:                       runtimeImages,
+		PodMonitorEndpoints:                 podMonitorEndpoints,
+		ArtifactsTTL:                        dgdrArtifactsTTL,
+		AttemptRetention:                    dgdrAttemptRetention,
+		SetMaxInProgress:                    dgdrSetMaxInProgress,
+		NamespaceSelector:                   namespaceSelector,
+		OperatorInstance:                    dgdrOperatorInstance,
+		ShardCount:                          dgdrShardCount,
+		ShardAssignment:                     dgdrShardAssignment,
+		ProfilingHistoryNamespace:           profilingHistoryNamespace,
+		OrphanPolicy:                        orphanPolicy,
+		OrphanReportNamespace:               orphanReportNamespace,
+		TLSOpts:                             tlsOpts,
+		ResultsPVCPath:                      resultsPVCPath,
+		ResultsBindAddress:                  resultsBindAddress,
+		ResultsEndpoint:                     resultsEndpoint,
+		ResultsCert:                         resultsCert,
+		ResultsCA:                           resultsCA,
+		Estimator:                           estimator,
+		EstimateBindAddress:                 estimateBindAddress,
+		EstimateCert:                        estimateCert,
+		StatusBindAddress:                   statusBindAddress,
+		StatusCert:                          statusCert,
+	}); err != nil {
+		setupLog.Error(err, "unable to set up the DGDR subsystem")
 		os.Exit(1)
 	}
 	//+kubebuilder:scaffold:builder
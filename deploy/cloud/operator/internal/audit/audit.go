@@ -0,0 +1,284 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package audit writes structured records of the actions the operator takes on behalf of users to
+// a sink separate from the controller logs: a JSON lines file, an HTTP endpoint or a Kafka topic.
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// Action is what an audit record reports
+type Action string
+
+const (
+	// ActionRequestCreated and ActionRequestUpdated are recorded at admission, with the requesting user
+	ActionRequestCreated Action = "RequestCreated"
+	ActionRequestUpdated Action = "RequestUpdated"
+	// ActionRequestApproved is recorded at admission when a user approves the generated spec of a request
+	ActionRequestApproved Action = "RequestApproved"
+	// ActionSpecGenerated is recorded when the deployment spec of a request is generated
+	ActionSpecGenerated Action = "SpecGenerated"
+	// ActionDeploymentCreated and ActionDeploymentUpdated are recorded when the generated deployment is applied
+	ActionDeploymentCreated Action = "DeploymentCreated"
+	ActionDeploymentUpdated Action = "DeploymentUpdated"
+
+	// Sink schemes besides file paths and http(s) URLs: the Kafka REST proxy at the URL's host
+	// receives the records for the topic named by the URL's path
+	SchemeKafkaHTTP  = "kafka+http"
+	SchemeKafkaHTTPS = "kafka+https"
+
+	// kafkaContentType is the embedded JSON format of the Kafka REST proxy v2 API
+	kafkaContentType = "application/vnd.kafka.json.v2+json"
+
+	requestTimeout = 10 * time.Second
+
+	// DefaultQueueSize is how many records an AsyncSink holds before it drops them
+	DefaultQueueSize = 1024
+)
+
+// ObjectReference identifies the object of a record
+type ObjectReference struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	UID       string `json:"uid,omitempty"`
+}
+
+// User is the user that requested an action
+type User struct {
+	Username string   `json:"username"`
+	UID      string   `json:"uid,omitempty"`
+	Groups   []string `json:"groups,omitempty"`
+}
+
+// Record is one audited action
+type Record struct {
+	Time   time.Time `json:"time"`
+	Action Action    `json:"action"`
+	// Request is the object the action was taken for
+	Request ObjectReference `json:"request"`
+	// User is set for actions requested through the API server
+	User *User `json:"user,omitempty"`
+	// Object is the object the action created or changed, if not the request
+	Object *ObjectReference `json:"object,omitempty"`
+	// Spec is the spec of the request or the object after the action
+	Spec json.RawMessage `json:"spec,omitempty"`
+	// Diff is the change of the spec by an update
+	Diff    string `json:"diff,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// Sink receives audit records
+type Sink interface {
+	// Write delivers a record, returning once the sink accepted it
+	Write(ctx context.Context, record Record) error
+}
+
+// NewSink returns the sink for target: a file path (or file:// URL) records are appended to as JSON
+// lines, an http(s) URL records are POSTed to, or a kafka+http(s)://host/topic URL of a Kafka REST proxy
+func NewSink(target string) (Sink, error) {
+	if strings.HasPrefix(target, "/") {
+		return NewFileSink(target)
+	}
+	u, err := url.Parse(target)
+	if err != nil {
+		return nil, fmt.Errorf("invalid audit sink %q: %w", target, err)
+	}
+	switch u.Scheme {
+	case "file":
+		return NewFileSink(u.Path)
+	case "http", "https":
+		return &HTTPSink{URL: target}, nil
+	case SchemeKafkaHTTP, SchemeKafkaHTTPS:
+		topic := strings.Trim(u.Path, "/")
+		if topic == "" || strings.Contains(topic, "/") {
+			return nil, fmt.Errorf("audit sink %q must name one Kafka topic as its path", target)
+		}
+		proxy := url.URL{Scheme: strings.TrimPrefix(u.Scheme, "kafka+"), Host: u.Host, User: u.User}
+		return &KafkaSink{ProxyURL: proxy.String(), Topic: topic}, nil
+	default:
+		return nil, fmt.Errorf("unsupported audit sink %q, must be a file path or an http, https, %s or %s URL", target, SchemeKafkaHTTP, SchemeKafkaHTTPS)
+	}
+}
+
+// AsyncSink queues records and writes them to another sink in the background, so that callers
+// such as the admission webhook do not wait for a slow sink. It runs as a manager runnable on
+// every replica, and writes the records left in the queue before it stops.
+type AsyncSink struct {
+	sink  Sink
+	queue chan Record
+}
+
+// NewAsyncSink returns an AsyncSink writing to sink that holds up to size records
+func NewAsyncSink(sink Sink, size int) *AsyncSink {
+	return &AsyncSink{sink: sink, queue: make(chan Record, size)}
+}
+
+// Write queues the record without blocking, failing when the queue is full
+func (s *AsyncSink) Write(_ context.Context, record Record) error {
+	select {
+	case s.queue <- record:
+		return nil
+	default:
+		return fmt.Errorf("audit queue is full, dropped %s record", record.Action)
+	}
+}
+
+// Start writes the queued records until ctx is done, logging the records that fail
+func (s *AsyncSink) Start(ctx context.Context) error {
+	logger := log.FromContext(ctx).WithName("audit")
+	write := func(ctx context.Context, record Record) {
+		if err := s.sink.Write(ctx, record); err != nil {
+			logger.Error(err, "Failed to write audit record", "action", record.Action,
+				"namespace", record.Request.Namespace, "name", record.Request.Name)
+		}
+	}
+	for {
+		select {
+		case record := <-s.queue:
+			write(ctx, record)
+		case <-ctx.Done():
+			drainCtx := context.WithoutCancel(ctx)
+			for {
+				select {
+				case record := <-s.queue:
+					write(drainCtx, record)
+				default:
+					return nil
+				}
+			}
+		}
+	}
+}
+
+// NeedLeaderElection is false, every replica serves the webhook
+func (s *AsyncSink) NeedLeaderElection() bool {
+	return false
+}
+
+// FileSink appends records to a file as JSON lines
+type FileSink struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+// NewFileSink opens path for appending, creating it if needed
+func NewFileSink(path string) (*FileSink, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit log: %w", err)
+	}
+	return &FileSink{file: file}, nil
+}
+
+// Write appends the record and syncs it to disk
+func (s *FileSink) Write(_ context.Context, record Record) error {
+	line, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to marshal audit record: %w", err)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, err := s.file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to write audit record: %w", err)
+	}
+	return s.file.Sync()
+}
+
+// Close closes the file
+func (s *FileSink) Close() error {
+	return s.file.Close()
+}
+
+// HTTPSink POSTs each record as a JSON document
+type HTTPSink struct {
+	URL string
+	// HTTPClient sends the records, http.DefaultClient if nil
+	HTTPClient *http.Client
+}
+
+// Write POSTs the record and expects a 2xx answer
+func (s *HTTPSink) Write(ctx context.Context, record Record) error {
+	body, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to marshal audit record: %w", err)
+	}
+	return post(ctx, s.HTTPClient, s.URL, "application/json", body)
+}
+
+// KafkaSink produces records to a Kafka topic through a Kafka REST proxy (v2 API), keyed by the
+// UID of their request so that the records of one request stay ordered
+type KafkaSink struct {
+	ProxyURL string
+	Topic    string
+	// HTTPClient calls the proxy, http.DefaultClient if nil
+	HTTPClient *http.Client
+}
+
+// Write produces the record to the topic
+func (s *KafkaSink) Write(ctx context.Context, record Record) error {
+	type kafkaRecord struct {
+		Key   string `json:"key,omitempty"`
+		Value Record `json:"value"`
+	}
+	body, err := json.Marshal(struct {
+		Records []kafkaRecord `json:"records"`
+	}{Records: []kafkaRecord{{Key: record.Request.UID, Value: record}}})
+	if err != nil {
+		return fmt.Errorf("failed to marshal audit record: %w", err)
+	}
+	endpoint := strings.TrimSuffix(s.ProxyURL, "/") + "/topics/" + url.PathEscape(s.Topic)
+	return post(ctx, s.HTTPClient, endpoint, kafkaContentType, body)
+}
+
+// post sends body to endpoint and fails unless the answer is 2xx
+func post(ctx context.Context, httpClient *http.Client, endpoint, contentType string, body []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", contentType)
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send audit record: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode/100 != 2 {
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("audit sink answered %s: %s", resp.Status, strings.TrimSpace(string(message)))
+	}
+	return nil
+}
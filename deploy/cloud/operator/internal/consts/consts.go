@@ -45,6 +45,7 @@ const (
 	KubeLabelDynamoComponentPod = "nvidia.com/dynamo-component-pod"
 
 	KubeResourceGPUNvidia = "nvidia.com/gpu"
+	KubeResourceGPUAMD    = "amd.com/gpu"
 
 	DynamoDeploymentConfigEnvVar = "DYN_DEPLOYMENT_CONFIG"
 
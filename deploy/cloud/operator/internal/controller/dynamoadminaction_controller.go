@@ -0,0 +1,315 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+)
+
+const (
+	// AnnotationNamespaceAdminAction on a namespace creates a DynamoAdminAction for all DGDRs in it
+	AnnotationNamespaceAdminAction = "nvidia.com/dgdr-admin-action"
+
+	// AdminActionNamePrefix is the name prefix of DynamoAdminActions created from namespace annotations
+	AdminActionNamePrefix = "dgdr-admin-"
+
+	// Default batching of admin actions
+	DefaultAdminActionBatchSize     = 5
+	DefaultAdminActionBatchInterval = 10 * time.Second
+
+	// Event reasons
+	EventReasonAdminActionApplied   = "AdminActionApplied"
+	EventReasonAdminActionCompleted = "AdminActionCompleted"
+	EventReasonAdminActionCreated   = "AdminActionCreated"
+	EventReasonAdminActionInvalid   = "AdminActionInvalid"
+)
+
+// DynamoAdminActionReconciler applies DynamoAdminActions to the selected DGDRs in rate-limited batches.
+// DGDRs are only annotated here; the DGDR controller performs the actual pause, retry or re-profile.
+type DynamoAdminActionReconciler struct {
+	client.Client
+	Recorder record.EventRecorder
+}
+
+// +kubebuilder:rbac:groups=nvidia.com,resources=dynamoadminactions,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=nvidia.com,resources=dynamoadminactions/status,verbs=get;update;patch
+
+// Reconcile applies the next batch of the action and requeues until all selected DGDRs are processed
+func (r *DynamoAdminActionReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	action := &nvidiacomv1alpha1.DynamoAdminAction{}
+	if err := r.Get(ctx, req.NamespacedName, action); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	if action.Status.Phase == nvidiacomv1alpha1.AdminActionPhaseCompleted {
+		return ctrl.Result{}, nil
+	}
+
+	// Select the targets once so that the action has a fixed scope
+	if action.Status.Phase == "" {
+		targets, err := r.selectTargets(ctx, action)
+		if err != nil {
+			r.Recorder.Event(action, corev1.EventTypeWarning, EventReasonAdminActionInvalid, err.Error())
+			return ctrl.Result{}, err
+		}
+		now := metav1.Now()
+		action.Status.Phase = nvidiacomv1alpha1.AdminActionPhaseRunning
+		action.Status.Targets = targets
+		action.Status.StartTime = &now
+		logger.Info("Starting admin action", "action", action.Spec.Action, "targets", len(targets))
+	}
+
+	batchSize := int(action.Spec.BatchSize)
+	if batchSize < 1 {
+		batchSize = DefaultAdminActionBatchSize
+	}
+	processed := 0
+	for _, name := range action.Status.Targets {
+		if processed == batchSize {
+			break
+		}
+		if slices.Contains(action.Status.Applied, name) || slices.Contains(action.Status.Skipped, name) {
+			continue
+		}
+		processed++
+
+		applied, err := r.applyAction(ctx, action, name)
+		if err != nil {
+			// Record progress so far before retrying
+			if updateErr := r.Status().Update(ctx, action); updateErr != nil {
+				logger.Error(updateErr, "Failed to record admin action progress")
+			}
+			return ctrl.Result{}, err
+		}
+		if applied {
+			action.Status.Applied = append(action.Status.Applied, name)
+		} else {
+			action.Status.Skipped = append(action.Status.Skipped, name)
+		}
+	}
+
+	remaining := len(action.Status.Targets) - len(action.Status.Applied) - len(action.Status.Skipped)
+	if remaining > 0 {
+		if err := r.Status().Update(ctx, action); err != nil {
+			return ctrl.Result{}, err
+		}
+		interval := DefaultAdminActionBatchInterval
+		if action.Spec.BatchInterval != nil {
+			interval = action.Spec.BatchInterval.Duration
+		}
+		if interval <= 0 {
+			return ctrl.Result{Requeue: true}, nil
+		}
+		return ctrl.Result{RequeueAfter: interval}, nil
+	}
+
+	now := metav1.Now()
+	action.Status.Phase = nvidiacomv1alpha1.AdminActionPhaseCompleted
+	action.Status.CompletionTime = &now
+	logger.Info("Admin action completed", "action", action.Spec.Action,
+		"applied", len(action.Status.Applied), "skipped", len(action.Status.Skipped))
+	r.Recorder.Event(action, corev1.EventTypeNormal, EventReasonAdminActionCompleted,
+		fmt.Sprintf("%s applied to %d DGDRs, %d skipped", action.Spec.Action, len(action.Status.Applied), len(action.Status.Skipped)))
+	return ctrl.Result{}, r.Status().Update(ctx, action)
+}
+
+// selectTargets returns the sorted names of the DGDRs matching the action's label selector
+func (r *DynamoAdminActionReconciler) selectTargets(ctx context.Context, action *nvidiacomv1alpha1.DynamoAdminAction) ([]string, error) {
+	selector := labels.Everything()
+	if action.Spec.LabelSelector != nil {
+		var err error
+		if selector, err = metav1.LabelSelectorAsSelector(action.Spec.LabelSelector); err != nil {
+			return nil, fmt.Errorf("invalid labelSelector: %w", err)
+		}
+	}
+
+	dgdrs := &nvidiacomv1alpha1.DynamoGraphDeploymentRequestList{}
+	if err := r.List(ctx, dgdrs, client.InNamespace(action.Namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, fmt.Errorf("failed to list DGDRs: %w", err)
+	}
+	targets := make([]string, 0, len(dgdrs.Items))
+	for _, dgdr := range dgdrs.Items {
+		targets = append(targets, dgdr.Name)
+	}
+	sort.Strings(targets)
+	return targets, nil
+}
+
+// applyAction annotates a DGDR for the action. It returns false if the action does not apply to the DGDR.
+func (r *DynamoAdminActionReconciler) applyAction(ctx context.Context, action *nvidiacomv1alpha1.DynamoAdminAction, name string) (bool, error) {
+	dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{}
+	if err := r.Get(ctx, types.NamespacedName{Name: name, Namespace: action.Namespace}, dgdr); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	patch := client.MergeFrom(dgdr.DeepCopy())
+	if dgdr.Annotations == nil {
+		dgdr.Annotations = map[string]string{}
+	}
+	switch action.Spec.Action {
+	case nvidiacomv1alpha1.AdminActionPause:
+		dgdr.Annotations[AnnotationPaused] = "true"
+	case nvidiacomv1alpha1.AdminActionResume:
+		if !isPaused(dgdr) {
+			return false, nil
+		}
+		delete(dgdr.Annotations, AnnotationPaused)
+	case nvidiacomv1alpha1.AdminActionRetryFailed:
+		if !canRetry(dgdr.Status.State) {
+			return false, nil
+		}
+		dgdr.Annotations[AnnotationAction] = ActionRetry
+	case nvidiacomv1alpha1.AdminActionReprofile:
+		if !canReprofile(dgdr.Status.State) {
+			return false, nil
+		}
+		dgdr.Annotations[AnnotationAction] = ActionReprofile
+	default:
+		return false, fmt.Errorf("unknown admin action %q", action.Spec.Action)
+	}
+
+	if err := r.Patch(ctx, dgdr, patch); err != nil {
+		return false, client.IgnoreNotFound(err)
+	}
+	r.Recorder.Event(dgdr, corev1.EventTypeNormal, EventReasonAdminActionApplied,
+		fmt.Sprintf("%s requested by DynamoAdminAction %s", action.Spec.Action, action.Name))
+	return true, nil
+}
+
+// SetupWithManager sets up the controller with the Manager
+func (r *DynamoAdminActionReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&nvidiacomv1alpha1.DynamoAdminAction{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Named("dynamoadminaction").
+		Complete(r)
+}
+
+// NamespaceAdminActionReconciler turns the admin action annotation of a namespace into a
+// DynamoAdminAction for all DGDRs in that namespace, then removes the annotation
+type NamespaceAdminActionReconciler struct {
+	client.Client
+	Recorder record.EventRecorder
+}
+
+// +kubebuilder:rbac:groups=core,resources=namespaces,verbs=get;list;watch;update;patch
+
+// Reconcile creates the DynamoAdminAction requested by the namespace annotation
+func (r *NamespaceAdminActionReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	namespace := &corev1.Namespace{}
+	if err := r.Get(ctx, req.NamespacedName, namespace); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	value, requested := namespace.Annotations[AnnotationNamespaceAdminAction]
+	if !requested {
+		return ctrl.Result{}, nil
+	}
+
+	actionType, valid := parseAdminAction(value)
+	if valid {
+		action := &nvidiacomv1alpha1.DynamoAdminAction{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      namespaceAdminActionName(namespace, actionType),
+				Namespace: namespace.Name,
+				Labels:    map[string]string{LabelManagedBy: LabelValueDynamoOperator},
+			},
+			Spec: nvidiacomv1alpha1.DynamoAdminActionSpec{
+				Action:        actionType,
+				BatchSize:     DefaultAdminActionBatchSize,
+				BatchInterval: &metav1.Duration{Duration: DefaultAdminActionBatchInterval},
+			},
+		}
+		// The action already exists if removing the annotation failed after it was created
+		if err := r.Create(ctx, action); err == nil {
+			logger.Info("Created admin action from namespace annotation", "action", action.Name)
+			r.Recorder.Event(namespace, corev1.EventTypeNormal, EventReasonAdminActionCreated,
+				fmt.Sprintf("Created DynamoAdminAction %s", action.Name))
+		} else if !apierrors.IsAlreadyExists(err) {
+			return ctrl.Result{}, err
+		}
+	} else {
+		r.Recorder.Event(namespace, corev1.EventTypeWarning, EventReasonAdminActionInvalid,
+			fmt.Sprintf("Unknown admin action %q", value))
+	}
+
+	// Remove the annotation so the action is created once
+	patch := client.MergeFrom(namespace.DeepCopy())
+	delete(namespace.Annotations, AnnotationNamespaceAdminAction)
+	return ctrl.Result{}, r.Patch(ctx, namespace, patch)
+}
+
+// namespaceAdminActionName returns the name of the DynamoAdminAction created for the annotation of a
+// namespace. It is derived from the namespace's resource version, so that it stays the same until
+// the annotation is removed and a later annotation creates a new action.
+func namespaceAdminActionName(namespace *corev1.Namespace, action nvidiacomv1alpha1.AdminActionType) string {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(string(namespace.UID) + "/" + namespace.ResourceVersion))
+	return fmt.Sprintf("%s%s-%08x", AdminActionNamePrefix, strings.ToLower(string(action)), h.Sum32())
+}
+
+// parseAdminAction matches an annotation value to an admin action, ignoring case
+func parseAdminAction(value string) (nvidiacomv1alpha1.AdminActionType, bool) {
+	for _, action := range []nvidiacomv1alpha1.AdminActionType{
+		nvidiacomv1alpha1.AdminActionPause,
+		nvidiacomv1alpha1.AdminActionResume,
+		nvidiacomv1alpha1.AdminActionRetryFailed,
+		nvidiacomv1alpha1.AdminActionReprofile,
+	} {
+		if strings.EqualFold(value, string(action)) {
+			return action, true
+		}
+	}
+	return "", false
+}
+
+// SetupWithManager sets up the controller with the Manager, watching only annotated namespaces
+func (r *NamespaceAdminActionReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	annotated := predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		_, requested := obj.GetAnnotations()[AnnotationNamespaceAdminAction]
+		return requested
+	})
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&corev1.Namespace{}, builder.WithPredicates(annotated)).
+		Named("namespaceadminaction").
+		Complete(r)
+}
@@ -0,0 +1,233 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var _ = Describe("DGDR Administrative Actions", func() {
+	var reconciler *DynamoGraphDeploymentRequestReconciler
+
+	BeforeEach(func() {
+		reconciler = &DynamoGraphDeploymentRequestReconciler{
+			Client:      k8sClient,
+			Recorder:    record.NewFakeRecorder(100),
+			RBACManager: &MockRBACManager{},
+		}
+	})
+
+	// createDGDR creates a DGDR with the given labels and annotations and moves it to state
+	createDGDR := func(ctx context.Context, name, state string, labels, annotations map[string]string) *nvidiacomv1alpha1.DynamoGraphDeploymentRequest {
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: defaultNamespace, Labels: labels, Annotations: annotations},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model:   "test-model",
+				Backend: "vllm",
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					ProfilerImage: "test-profiler:latest",
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		if state != StateEmpty {
+			dgdr.Status.State = state
+			dgdr.Status.ObservedGeneration = dgdr.Generation
+			if state == StateFailed {
+				dgdr.Status.FailureReason = nvidiacomv1alpha1.FailureReasonProfilerCrash
+			}
+			Expect(k8sClient.Status().Update(ctx, dgdr)).Should(Succeed())
+		}
+		return dgdr
+	}
+
+	get := func(ctx context.Context, name string) *nvidiacomv1alpha1.DynamoGraphDeploymentRequest {
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: name, Namespace: defaultNamespace}, dgdr)).Should(Succeed())
+		return dgdr
+	}
+
+	reconcileDGDR := func(ctx context.Context, name string) {
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: name, Namespace: defaultNamespace}})
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	It("Should not reconcile a paused DGDR until it is resumed", func() {
+		ctx := context.Background()
+		dgdr := createDGDR(ctx, "test-dgdr-paused", StateEmpty, nil, map[string]string{AnnotationPaused: "true"})
+		defer func() { _ = k8sClient.Delete(ctx, dgdr) }()
+
+		reconcileDGDR(ctx, dgdr.Name)
+		updated := get(ctx, dgdr.Name)
+		Expect(updated.Status.State).Should(Equal(StateEmpty))
+		Expect(meta.IsStatusConditionTrue(updated.Status.Conditions, ConditionTypePaused)).To(BeTrue())
+
+		delete(updated.Annotations, AnnotationPaused)
+		Expect(k8sClient.Update(ctx, updated)).Should(Succeed())
+		reconcileDGDR(ctx, dgdr.Name)
+		updated = get(ctx, dgdr.Name)
+		Expect(meta.IsStatusConditionFalse(updated.Status.Conditions, ConditionTypePaused)).To(BeTrue())
+	})
+
+	It("Should restart a failed DGDR on retry", func() {
+		ctx := context.Background()
+		dgdr := createDGDR(ctx, "test-dgdr-retry", StateFailed, nil, map[string]string{AnnotationAction: ActionRetry})
+		defer func() { _ = k8sClient.Delete(ctx, dgdr) }()
+
+		job := &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{Name: GetProfilingJobName(dgdr), Namespace: defaultNamespace},
+			Spec: batchv1.JobSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers:    []corev1.Container{{Name: "test", Image: "test"}},
+						RestartPolicy: corev1.RestartPolicyNever,
+					},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, job)).Should(Succeed())
+
+		reconcileDGDR(ctx, dgdr.Name)
+		updated := get(ctx, dgdr.Name)
+		Expect(updated.Status.State).Should(Equal(StateEmpty))
+		Expect(updated.Status.FailureReason).Should(BeEmpty())
+		Expect(updated.Annotations).ShouldNot(HaveKey(AnnotationAction))
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: job.Name, Namespace: defaultNamespace}, &batchv1.Job{})).ShouldNot(Succeed())
+	})
+
+	It("Should reject re-profiling while profiling is in progress", func() {
+		ctx := context.Background()
+		dgdr := createDGDR(ctx, "test-dgdr-reprofile-busy", StateProfiling, nil, map[string]string{AnnotationAction: ActionReprofile})
+		defer func() { _ = k8sClient.Delete(ctx, dgdr) }()
+
+		// handleAction alone, the profiling state handler needs a job
+		Expect(reconciler.handleAction(ctx, get(ctx, dgdr.Name))).Should(BeTrue())
+		updated := get(ctx, dgdr.Name)
+		Expect(updated.Status.State).Should(Equal(StateProfiling))
+		Expect(updated.Annotations).ShouldNot(HaveKey(AnnotationAction))
+	})
+
+	It("Should apply a DynamoAdminAction to the selected DGDRs in batches", func() {
+		ctx := context.Background()
+		selected := map[string]string{"team": "admin-test"}
+		failed := createDGDR(ctx, "test-dgdr-admin-failed", StateFailed, selected, nil)
+		defer func() { _ = k8sClient.Delete(ctx, failed) }()
+		ready := createDGDR(ctx, "test-dgdr-admin-ready", StateReady, selected, nil)
+		defer func() { _ = k8sClient.Delete(ctx, ready) }()
+		other := createDGDR(ctx, "test-dgdr-admin-other", StateFailed, nil, nil)
+		defer func() { _ = k8sClient.Delete(ctx, other) }()
+
+		action := &nvidiacomv1alpha1.DynamoAdminAction{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-admin-retry", Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoAdminActionSpec{
+				Action:        nvidiacomv1alpha1.AdminActionRetryFailed,
+				LabelSelector: &metav1.LabelSelector{MatchLabels: selected},
+				BatchSize:     1,
+			},
+		}
+		Expect(k8sClient.Create(ctx, action)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, action) }()
+		Expect(action.Spec.BatchInterval).ShouldNot(BeNil())
+		Expect(action.Spec.BatchInterval.Duration).Should(Equal(DefaultAdminActionBatchInterval))
+
+		adminReconciler := &DynamoAdminActionReconciler{Client: k8sClient, Recorder: record.NewFakeRecorder(100)}
+		request := reconcile.Request{NamespacedName: types.NamespacedName{Name: action.Name, Namespace: defaultNamespace}}
+
+		result, err := adminReconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).Should(Equal(DefaultAdminActionBatchInterval))
+		Expect(k8sClient.Get(ctx, request.NamespacedName, action)).Should(Succeed())
+		Expect(action.Status.Phase).Should(Equal(nvidiacomv1alpha1.AdminActionPhaseRunning))
+		Expect(action.Status.Targets).Should(Equal([]string{failed.Name, ready.Name}))
+		Expect(action.Status.Applied).Should(Equal([]string{failed.Name}))
+
+		_, err = adminReconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(k8sClient.Get(ctx, request.NamespacedName, action)).Should(Succeed())
+		Expect(action.Status.Phase).Should(Equal(nvidiacomv1alpha1.AdminActionPhaseCompleted))
+		Expect(action.Status.Skipped).Should(Equal([]string{ready.Name}))
+
+		Expect(get(ctx, failed.Name).Annotations).Should(HaveKeyWithValue(AnnotationAction, ActionRetry))
+		Expect(get(ctx, ready.Name).Annotations).ShouldNot(HaveKey(AnnotationAction))
+		Expect(get(ctx, other.Name).Annotations).ShouldNot(HaveKey(AnnotationAction))
+	})
+
+	It("Should create a DynamoAdminAction from a namespace annotation", func() {
+		ctx := context.Background()
+		namespace := &corev1.Namespace{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: defaultNamespace}, namespace)).Should(Succeed())
+		namespace.Annotations = map[string]string{AnnotationNamespaceAdminAction: "pause"}
+		Expect(k8sClient.Update(ctx, namespace)).Should(Succeed())
+
+		namespaceReconciler := &NamespaceAdminActionReconciler{Client: k8sClient, Recorder: record.NewFakeRecorder(100)}
+		_, err := namespaceReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: defaultNamespace}})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: defaultNamespace}, namespace)).Should(Succeed())
+		Expect(namespace.Annotations).ShouldNot(HaveKey(AnnotationNamespaceAdminAction))
+
+		actions := &nvidiacomv1alpha1.DynamoAdminActionList{}
+		Expect(k8sClient.List(ctx, actions, client.InNamespace(defaultNamespace), client.MatchingLabels{LabelManagedBy: LabelValueDynamoOperator})).Should(Succeed())
+		Expect(actions.Items).Should(HaveLen(1))
+		Expect(actions.Items[0].Spec.Action).Should(Equal(nvidiacomv1alpha1.AdminActionPause))
+		Expect(actions.Items[0].Spec.LabelSelector).Should(BeNil())
+		Expect(k8sClient.Delete(ctx, &actions.Items[0])).Should(Succeed())
+	})
+
+	It("Should not create the action twice when removing the namespace annotation failed", func() {
+		ctx := context.Background()
+		namespace := &corev1.Namespace{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: defaultNamespace}, namespace)).Should(Succeed())
+		namespace.Annotations = map[string]string{AnnotationNamespaceAdminAction: "pause"}
+		Expect(k8sClient.Update(ctx, namespace)).Should(Succeed())
+
+		// The action created by the attempt whose annotation patch failed
+		existing := &nvidiacomv1alpha1.DynamoAdminAction{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      namespaceAdminActionName(namespace, nvidiacomv1alpha1.AdminActionPause),
+				Namespace: defaultNamespace,
+				Labels:    map[string]string{LabelManagedBy: LabelValueDynamoOperator},
+			},
+			Spec: nvidiacomv1alpha1.DynamoAdminActionSpec{Action: nvidiacomv1alpha1.AdminActionPause},
+		}
+		Expect(k8sClient.Create(ctx, existing)).Should(Succeed())
+		DeferCleanup(func() { _ = k8sClient.Delete(ctx, existing) })
+
+		namespaceReconciler := &NamespaceAdminActionReconciler{Client: k8sClient, Recorder: record.NewFakeRecorder(100)}
+		_, err := namespaceReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: defaultNamespace}})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: defaultNamespace}, namespace)).Should(Succeed())
+		Expect(namespace.Annotations).ShouldNot(HaveKey(AnnotationNamespaceAdminAction))
+		actions := &nvidiacomv1alpha1.DynamoAdminActionList{}
+		Expect(k8sClient.List(ctx, actions, client.InNamespace(defaultNamespace), client.MatchingLabels{LabelManagedBy: LabelValueDynamoOperator})).Should(Succeed())
+		Expect(actions.Items).Should(HaveLen(1))
+	})
+})
@@ -0,0 +1,215 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"fmt"
+	"path"
+	"slices"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	dynamoCommon "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/dynamo/common"
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	commonconsts "github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/consts"
+)
+
+const (
+	// AdapterSourcePrefixHF is the optional scheme of Hugging Face adapter sources
+	AdapterSourcePrefixHF = "hf://"
+
+	// Adapter download and mount configuration of the generated DGD workers
+	VolumeNameLoRAAdapters         = "lora-adapters"
+	LoRAAdaptersPath               = "/opt/dynamo/lora"
+	ContainerNameAdapterDownloader = "lora-adapter-downloader"
+
+	// SGLangLoRAPathsFlag loads the adapters into SGLang workers at startup, as name=path pairs
+	SGLangLoRAPathsFlag = "--lora-paths"
+
+	// Validation messages
+	ValidationErrorAdapterSource  = "adapters[%s].source %q must be a Hugging Face repository such as org/adapter or hf://org/adapter"
+	ValidationErrorAdapterBackend = "spec.adapters is not supported by backend %s, its workers cannot load adapters at startup"
+)
+
+// adapterRepository returns the Hugging Face repository of an adapter source, or "" if it is not one
+func adapterRepository(source string) string {
+	repo := strings.TrimPrefix(source, AdapterSourcePrefixHF)
+	parts := strings.Split(repo, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return ""
+	}
+	return repo
+}
+
+// adapterPath returns where the adapter is downloaded to in the worker pods
+func adapterPath(adapter nvidiacomv1alpha1.AdapterSpec) string {
+	return path.Join(LoRAAdaptersPath, adapter.Name)
+}
+
+// validateAdapters validates the adapter sources and, unless it is selected by profiling, the backend
+func validateAdapters(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	for _, adapter := range dgdr.Spec.Adapters {
+		if adapterRepository(adapter.Source) == "" {
+			return fmt.Errorf(ValidationErrorAdapterSource, adapter.Name, adapter.Source)
+		}
+	}
+	if len(dgdr.Spec.Adapters) > 0 && dgdr.Spec.Backend != "" && dgdr.Spec.Backend != BackendAuto {
+		if _, err := adapterArgs(dgdr, dgdr.Spec.Backend); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// adapterArgs returns the worker arguments that load the adapters on a backend. Only SGLang loads
+// adapters from local paths at startup, the other backends are rejected.
+func adapterArgs(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, backend string) ([]string, error) {
+	if backend != BackendSGLang {
+		return nil, fmt.Errorf(ValidationErrorAdapterBackend, backend)
+	}
+	args := []string{SGLangLoRAPathsFlag}
+	for _, adapter := range dgdr.Spec.Adapters {
+		args = append(args, fmt.Sprintf("%s=%s", adapter.Name, adapterPath(adapter)))
+	}
+	return args, nil
+}
+
+// shellQuote quotes a value for use as a single word in a shell script
+func shellQuote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
+
+// adaptersProfilingConfig returns the adapters in the format of the profiler's deployment.adapters
+func adaptersProfilingConfig(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) []interface{} {
+	adapters := make([]interface{}, 0, len(dgdr.Spec.Adapters))
+	for _, adapter := range dgdr.Spec.Adapters {
+		config := map[string]interface{}{
+			"name":   adapter.Name,
+			"source": adapterRepository(adapter.Source),
+		}
+		if adapter.Revision != "" {
+			config["revision"] = adapter.Revision
+		}
+		adapters = append(adapters, config)
+	}
+	return adapters
+}
+
+// adapterDownloadScript returns the shell script of the init container that downloads the adapters
+func adapterDownloadScript(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) string {
+	commands := []string{"set -e"}
+	for _, adapter := range dgdr.Spec.Adapters {
+		command := fmt.Sprintf("huggingface-cli download %s --local-dir %s",
+			shellQuote(adapterRepository(adapter.Source)), shellQuote(adapterPath(adapter)))
+		if adapter.Revision != "" {
+			command += " --revision " + shellQuote(adapter.Revision)
+		}
+		commands = append(commands, command)
+	}
+	return strings.Join(commands, "\n")
+}
+
+// applyAdapters adds the adapter download and mount configuration to every worker of the generated DGD,
+// and the arguments that load the adapters to their main container.
+// The adapters are downloaded by an init container running the worker image, which ships huggingface-cli.
+func applyAdapters(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, dgd *nvidiacomv1alpha1.DynamoGraphDeployment) error {
+	if len(dgdr.Spec.Adapters) == 0 {
+		return nil
+	}
+
+	args, err := adapterArgs(dgdr, generatedBackend(dgdr))
+	if err != nil {
+		return withFailureReason(nvidiacomv1alpha1.FailureReasonValidationError, err)
+	}
+	script := adapterDownloadScript(dgdr)
+
+	// Sort for a deterministic error on the first service without an image
+	services := make([]string, 0, len(dgd.Spec.Services))
+	for name := range dgd.Spec.Services {
+		services = append(services, name)
+	}
+	sort.Strings(services)
+
+	workers := 0
+	for _, name := range services {
+		spec := dgd.Spec.Services[name]
+		if spec == nil || spec.ComponentType != commonconsts.ComponentTypeWorker {
+			continue
+		}
+		workers++
+
+		image := ""
+		if spec.ExtraPodSpec != nil && spec.ExtraPodSpec.MainContainer != nil {
+			image = spec.ExtraPodSpec.MainContainer.Image
+		}
+		if image == "" && dgdr.Spec.DeploymentOverrides != nil {
+			image = dgdr.Spec.DeploymentOverrides.WorkersImage
+		}
+		if image == "" {
+			return withFailureReason(nvidiacomv1alpha1.FailureReasonValidationError,
+				fmt.Errorf("service %q has no image to download adapters with, set deploymentOverrides.workersImage", name))
+		}
+
+		if spec.ExtraPodSpec == nil {
+			spec.ExtraPodSpec = &dynamoCommon.ExtraPodSpec{}
+		}
+		if spec.ExtraPodSpec.PodSpec == nil {
+			spec.ExtraPodSpec.PodSpec = &corev1.PodSpec{}
+		}
+		if spec.ExtraPodSpec.MainContainer == nil {
+			spec.ExtraPodSpec.MainContainer = &corev1.Container{}
+		}
+
+		mount := corev1.VolumeMount{Name: VolumeNameLoRAAdapters, MountPath: LoRAAdaptersPath}
+		podSpec := spec.ExtraPodSpec.PodSpec
+		podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+			Name:         VolumeNameLoRAAdapters,
+			VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+		})
+		podSpec.InitContainers = append(podSpec.InitContainers, corev1.Container{
+			Name:    ContainerNameAdapterDownloader,
+			Image:   image,
+			Command: []string{"/bin/sh", "-c"},
+			Args:    []string{script},
+			Env: []corev1.EnvVar{{
+				Name: "HUGGING_FACE_HUB_TOKEN",
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: hfTokenSecretName(dgdr)},
+						Key:                  HFTokenSecretKey,
+					},
+				},
+			}},
+			VolumeMounts: []corev1.VolumeMount{mount},
+		})
+
+		mount.ReadOnly = true
+		spec.ExtraPodSpec.MainContainer.VolumeMounts = append(spec.ExtraPodSpec.MainContainer.VolumeMounts, mount)
+		if !slices.Contains(spec.ExtraPodSpec.MainContainer.Args, SGLangLoRAPathsFlag) {
+			spec.ExtraPodSpec.MainContainer.Args = append(spec.ExtraPodSpec.MainContainer.Args, args...)
+		}
+	}
+
+	if workers == 0 {
+		return withFailureReason(nvidiacomv1alpha1.FailureReasonValidationError,
+			fmt.Errorf("adapters require a %s service in the generated deployment", commonconsts.ComponentTypeWorker))
+	}
+	return nil
+}
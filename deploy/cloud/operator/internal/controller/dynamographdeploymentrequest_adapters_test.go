@@ -0,0 +1,160 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"fmt"
+
+	dynamoCommon "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/dynamo/common"
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	commonconsts "github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/consts"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+)
+
+var _ = Describe("DGDR LoRA Adapters", func() {
+	newDGDR := func() *nvidiacomv1alpha1.DynamoGraphDeploymentRequest {
+		return &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Backend: BackendSGLang,
+				Adapters: []nvidiacomv1alpha1.AdapterSpec{
+					{Name: "sql", Source: "hf://org/sql-lora"},
+					{Name: "chat", Source: "org/chat-lora", Revision: "v2"},
+				},
+			},
+		}
+	}
+
+	newWorker := func(image string) *nvidiacomv1alpha1.DynamoComponentDeploymentSharedSpec {
+		worker := &nvidiacomv1alpha1.DynamoComponentDeploymentSharedSpec{}
+		worker.ComponentType = commonconsts.ComponentTypeWorker
+		if image != "" {
+			worker.ExtraPodSpec = &dynamoCommon.ExtraPodSpec{MainContainer: &corev1.Container{Image: image}}
+		}
+		return worker
+	}
+
+	It("Should validate adapter sources", func() {
+		Expect(validateAdapters(newDGDR())).Should(Succeed())
+
+		dgdr := newDGDR()
+		dgdr.Spec.Adapters[1].Source = "s3://bucket/chat-lora"
+		err := validateAdapters(dgdr)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("adapters[chat]"))
+
+		dgdr = newDGDR()
+		dgdr.Spec.Backend = BackendVLLM
+		Expect(validateAdapters(dgdr)).To(MatchError(fmt.Sprintf(ValidationErrorAdapterBackend, BackendVLLM)))
+
+		// The backend selected by profiling is checked when the deployment is generated
+		dgdr.Spec.Backend = BackendAuto
+		Expect(validateAdapters(dgdr)).Should(Succeed())
+	})
+
+	It("Should pass the adapters to the profiler", func() {
+		Expect(adaptersProfilingConfig(newDGDR())).To(Equal([]interface{}{
+			map[string]interface{}{"name": "sql", "source": "org/sql-lora"},
+			map[string]interface{}{"name": "chat", "source": "org/chat-lora", "revision": "v2"},
+		}))
+	})
+
+	It("Should add adapter download and mount configuration to the workers", func() {
+		dgd := &nvidiacomv1alpha1.DynamoGraphDeployment{
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentSpec{
+				Services: map[string]*nvidiacomv1alpha1.DynamoComponentDeploymentSharedSpec{
+					"Frontend":         {},
+					"VllmDecodeWorker": newWorker("nvcr.io/nvidia/vllm-runtime:1.0"),
+				},
+			},
+		}
+		dgd.Spec.Services["Frontend"].ComponentType = commonconsts.ComponentTypeFrontend
+
+		Expect(applyAdapters(newDGDR(), dgd)).Should(Succeed())
+		Expect(dgd.Spec.Services["Frontend"].ExtraPodSpec).To(BeNil())
+
+		worker := dgd.Spec.Services["VllmDecodeWorker"]
+		Expect(worker.ExtraPodSpec.MainContainer.Args).To(Equal([]string{
+			SGLangLoRAPathsFlag, "sql=/opt/dynamo/lora/sql", "chat=/opt/dynamo/lora/chat",
+		}))
+		Expect(worker.ExtraPodSpec.MainContainer.VolumeMounts).To(ContainElement(corev1.VolumeMount{
+			Name: VolumeNameLoRAAdapters, MountPath: LoRAAdaptersPath, ReadOnly: true,
+		}))
+		Expect(worker.ExtraPodSpec.PodSpec.Volumes).To(HaveLen(1))
+		Expect(worker.ExtraPodSpec.PodSpec.InitContainers).To(HaveLen(1))
+
+		downloader := worker.ExtraPodSpec.PodSpec.InitContainers[0]
+		Expect(downloader.Name).To(Equal(ContainerNameAdapterDownloader))
+		Expect(downloader.Image).To(Equal("nvcr.io/nvidia/vllm-runtime:1.0"))
+		Expect(downloader.Args[0]).To(ContainSubstring("huggingface-cli download 'org/sql-lora' --local-dir '/opt/dynamo/lora/sql'\n"))
+		Expect(downloader.Args[0]).To(ContainSubstring("huggingface-cli download 'org/chat-lora' --local-dir '/opt/dynamo/lora/chat' --revision 'v2'"))
+	})
+
+	It("Should quote the adapter source and revision in the download script", func() {
+		dgdr := newDGDR()
+		dgdr.Spec.Adapters = []nvidiacomv1alpha1.AdapterSpec{{Name: "sql", Source: "org/sql-lora", Revision: "main; rm -rf / #'"}}
+		Expect(adapterDownloadScript(dgdr)).To(HaveSuffix(`--revision 'main; rm -rf / #'\'''`))
+	})
+
+	It("Should reject adapters on a backend selected by profiling that cannot load them", func() {
+		dgdr := newDGDR()
+		dgdr.Spec.Backend = BackendAuto
+		dgdr.Status.Backend = BackendTRTLLM
+		dgd := &nvidiacomv1alpha1.DynamoGraphDeployment{
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentSpec{
+				Services: map[string]*nvidiacomv1alpha1.DynamoComponentDeploymentSharedSpec{
+					"TRTLLMWorker": newWorker("nvcr.io/nvidia/trtllm-runtime:1.0"),
+				},
+			},
+		}
+		err := applyAdapters(dgdr, dgd)
+		Expect(err).To(MatchError(fmt.Sprintf(ValidationErrorAdapterBackend, BackendTRTLLM)))
+		Expect(failureReasonFromError(err, nvidiacomv1alpha1.FailureReasonSpecParseError)).To(Equal(nvidiacomv1alpha1.FailureReasonValidationError))
+	})
+
+	It("Should fall back to the workers image override", func() {
+		dgd := &nvidiacomv1alpha1.DynamoGraphDeployment{
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentSpec{
+				Services: map[string]*nvidiacomv1alpha1.DynamoComponentDeploymentSharedSpec{
+					"VllmDecodeWorker": newWorker(""),
+				},
+			},
+		}
+
+		dgdr := newDGDR()
+		err := applyAdapters(dgdr, dgd.DeepCopy())
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(`"VllmDecodeWorker"`))
+
+		dgdr.Spec.DeploymentOverrides = &nvidiacomv1alpha1.DeploymentOverridesSpec{WorkersImage: "custom-worker:1.0"}
+		Expect(applyAdapters(dgdr, dgd)).Should(Succeed())
+		Expect(dgd.Spec.Services["VllmDecodeWorker"].ExtraPodSpec.PodSpec.InitContainers[0].Image).To(Equal("custom-worker:1.0"))
+	})
+
+	It("Should reject adapters without a worker service", func() {
+		dgd := &nvidiacomv1alpha1.DynamoGraphDeployment{
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentSpec{
+				Services: map[string]*nvidiacomv1alpha1.DynamoComponentDeploymentSharedSpec{"Frontend": {}},
+			},
+		}
+		err := applyAdapters(newDGDR(), dgd)
+		Expect(err).To(HaveOccurred())
+		Expect(failureReasonFromError(err, nvidiacomv1alpha1.FailureReasonSpecParseError)).To(Equal(nvidiacomv1alpha1.FailureReasonValidationError))
+	})
+})
@@ -0,0 +1,267 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+)
+
+const (
+	// AnnotationPaused stops reconciliation of a DGDR while set to "true"
+	AnnotationPaused = "nvidia.com/dgdr-paused"
+
+	// AnnotationAction requests a one-shot action on a DGDR; it is removed once processed
+	AnnotationAction = "nvidia.com/dgdr-action"
+
+	// Actions accepted in AnnotationAction
+	ActionRetry       = "retry"
+	ActionReprofile   = "reprofile"
+	ActionApplyUpdate = "apply-update"
+
+	// actionRetryInterval is how often an action waits for the previous profiling job to be deleted
+	actionRetryInterval = 5 * time.Second
+
+	// ConditionTypePaused is True while reconciliation of the DGDR is paused
+	ConditionTypePaused = "Paused"
+
+	// Event reasons
+	EventReasonPaused         = "Paused"
+	EventReasonResumed        = "Resumed"
+	EventReasonActionApplied  = "ActionApplied"
+	EventReasonActionRejected = "ActionRejected"
+
+	// Messages
+	MessageReconciliationPaused  = "Reconciliation paused by the " + AnnotationPaused + " annotation"
+	MessageReconciliationResumed = "Reconciliation resumed"
+)
+
+// isPaused reports whether reconciliation of the DGDR is paused
+func isPaused(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) bool {
+	return dgdr.Annotations[AnnotationPaused] == "true"
+}
+
+// canRetry reports whether a DGDR in the given state can be retried
+func canRetry(state string) bool {
+	return state == StateFailed
+}
+
+// canReprofile reports whether a DGDR in the given state can be re-profiled. Profiling must not be
+// in progress and the generated deployment must not be in the middle of being applied.
+func canReprofile(state string) bool {
+	switch state {
+	case StateAwaitingApproval, StateReady, StateDegraded, StateFailed, StateDeploymentDeleted:
+		return true
+	}
+	return false
+}
+
+// handlePause keeps the Paused condition in sync with the pause annotation.
+// It returns true if reconciliation should stop.
+func (r *DynamoGraphDeploymentRequestReconciler) handlePause(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) (bool, error) {
+	paused := isPaused(dgdr)
+	wasPaused := meta.IsStatusConditionTrue(dgdr.Status.Conditions, ConditionTypePaused)
+	if paused == wasPaused {
+		return paused, nil
+	}
+
+	condition := metav1.Condition{
+		Type:               ConditionTypePaused,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: dgdr.Generation,
+		Reason:             EventReasonResumed,
+		Message:            MessageReconciliationResumed,
+	}
+	if paused {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = EventReasonPaused
+		condition.Message = MessageReconciliationPaused
+	}
+	log.FromContext(ctx).Info("Updating pause state", "paused", paused)
+	r.Recorder.Event(dgdr, corev1.EventTypeNormal, condition.Reason, condition.Message)
+	meta.SetStatusCondition(&dgdr.Status.Conditions, condition)
+	return paused, r.updateStatus(ctx, dgdr)
+}
+
+// handleAction processes the one-shot action annotation. It returns true if an action was
+// processed, in which case the DGDR is requeued to continue from its new state.
+func (r *DynamoGraphDeploymentRequestReconciler) handleAction(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) (bool, error) {
+	action, requested := dgdr.Annotations[AnnotationAction]
+	if !requested {
+		return false, nil
+	}
+	logger := log.FromContext(ctx)
+
+	var rejection string
+	switch action {
+	case ActionRetry:
+		if !canRetry(dgdr.Status.State) {
+			rejection = fmt.Sprintf("retry requires state %s, DGDR is %s", StateFailed, dgdr.Status.State)
+		}
+	case ActionReprofile:
+		if !canReprofile(dgdr.Status.State) {
+			rejection = fmt.Sprintf("reprofile is not allowed in state %s", dgdr.Status.State)
+		}
+	case ActionApplyUpdate:
+		if !meta.IsStatusConditionTrue(dgdr.Status.Conditions, ConditionTypeUpdatePending) {
+			rejection = "apply-update requires a pending update of the deployment"
+		}
+	default:
+		rejection = fmt.Sprintf("unknown action %q, expected %s, %s or %s", action, ActionRetry, ActionReprofile, ActionApplyUpdate)
+	}
+	if rejection == "" && action != ActionApplyUpdate && isReprofileBudgetExhausted(dgdr) {
+		rejection = setReprofileBudgetExhausted(dgdr, action)
+		if err := r.updateStatus(ctx, dgdr); err != nil {
+			return false, err
+		}
+	}
+
+	if rejection != "" {
+		logger.Info("Rejecting action", "action", action, "reason", rejection)
+		r.Recorder.Event(dgdr, corev1.EventTypeWarning, EventReasonActionRejected, rejection)
+	} else if action == ActionApplyUpdate {
+		logger.Info("Applying action", "action", action, "state", dgdr.Status.State)
+		if err := r.applyPendingUpdate(ctx, dgdr); err != nil {
+			return false, err
+		}
+		r.Recorder.Event(dgdr, corev1.EventTypeNormal, EventReasonActionApplied,
+			fmt.Sprintf("Applied %s action, rolling out the regenerated spec", action))
+	} else {
+		logger.Info("Applying action", "action", action, "state", dgdr.Status.State)
+		reset, err := r.resetForProfiling(ctx, dgdr)
+		if err != nil {
+			return false, err
+		}
+		if !reset {
+			// Keep the annotation until the previous profiling job is gone
+			return true, nil
+		}
+		r.Recorder.Event(dgdr, corev1.EventTypeNormal, EventReasonActionApplied,
+			fmt.Sprintf("Applied %s action, profiling restarts", action))
+	}
+
+	// Remove the annotation so the action runs once
+	patch := client.MergeFrom(dgdr.DeepCopy())
+	delete(dgdr.Annotations, AnnotationAction)
+	if err := r.Patch(ctx, dgdr, patch); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// resetForProfiling removes the artifacts of the previous profiling run and returns the DGDR to
+// its initial state so that it is validated and profiled again. A DGD that was already created
+// is kept and monitored again; it is only recreated if it was deleted.
+// If only the SLA changed since the last successful attempt, its results are kept for the profiler
+// to re-sweep around.
+// It returns false without changing the status while the previous profiling job is still being deleted.
+func (r *DynamoGraphDeploymentRequestReconciler) resetForProfiling(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) (bool, error) {
+	if err := r.releaseProfilingNodes(ctx, dgdr); err != nil {
+		return false, err
+	}
+
+	// The next attempt runs under a new job name; a running job is stopped first
+	if superseded, err := r.supersedeProfilingAttempt(ctx, dgdr); !superseded || err != nil {
+		return false, err
+	}
+
+	// The results of the attempt to re-sweep around are replaced when the profiling job is created
+	differentialFrom := differentialBaseAttempt(dgdr)
+	if differentialFrom == 0 {
+		if err := r.resultTransport(dgdr).Delete(ctx, dgdr); err != nil {
+			return false, fmt.Errorf("failed to delete profiling output: %w", err)
+		}
+		untrackOwnedResources(dgdr, nvidiacomv1alpha1.OwnedResourceRoleOutput)
+	}
+
+	if dgdr.Status.State == StateDeploymentDeleted {
+		dgdr.Status.Deployment = nil
+		dgdr.Status.Endpoint = nil
+	}
+	if dgdr.Status.Deployment != nil {
+		clearDegradation(dgdr)
+	}
+	dgdr.Status.State = StateEmpty
+	dgdr.Status.FailureReason = ""
+	dgdr.Status.ObservedGeneration = 0
+	dgdr.Status.GeneratedDeployment = nil
+	dgdr.Status.GeneratedSpecExpiry = nil
+	dgdr.Status.SizedForPercentile = ""
+	dgdr.Status.Placement = nil
+	dgdr.Status.ProfilingResults = ""
+	dgdr.Status.ProfilingResultsChecksum = ""
+	dgdr.Status.ProfilingResultsEncoding = ""
+	dgdr.Status.RenderedManifests = ""
+	dgdr.Status.Profiling = nil
+	dgdr.Status.SupportBundle = nil
+	dgdr.Status.Hooks = nil
+	// Re-profiling picks up the current revision of spec.modelRef
+	dgdr.Status.ResolvedModel = nil
+	if differentialFrom > 0 {
+		dgdr.Status.Profiling = &nvidiacomv1alpha1.ProfilingStatus{DifferentialFromAttempt: differentialFrom}
+		r.Recorder.Event(dgdr, corev1.EventTypeNormal, EventReasonDifferentialProfiling,
+			fmt.Sprintf(MessageDifferentialProfiling, differentialFrom))
+	}
+	dgdr.Status.BackendComparison = nil
+	dgdr.Status.SpeculativeDecoding = nil
+	dgdr.Status.PinnedImages = nil
+	dgdr.Status.Provenance = nil
+	for _, conditionType := range []string{
+		ConditionTypeValidation, ConditionTypeProfiling, ConditionTypeProfilingSkipped,
+		ConditionTypeSpecGenerated, ConditionTypeDeploymentDegraded, ConditionTypeSpecStale,
+		ConditionTypePreviewAvailable, ConditionTypeApproved, ConditionTypeProfilingHooks,
+	} {
+		meta.RemoveStatusCondition(&dgdr.Status.Conditions, conditionType)
+	}
+	recordReprofile(dgdr)
+	return true, r.updateStatus(ctx, dgdr)
+}
+
+// handlePauseAndActions runs before the state machine. It returns a non-nil result when
+// reconciliation should stop there.
+func (r *DynamoGraphDeploymentRequestReconciler) handlePauseAndActions(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) (*ctrl.Result, error) {
+	paused, err := r.handlePause(ctx, dgdr)
+	if err != nil {
+		return &ctrl.Result{}, err
+	}
+	if paused {
+		return &ctrl.Result{}, nil
+	}
+
+	processed, err := r.handleAction(ctx, dgdr)
+	if err != nil {
+		return &ctrl.Result{}, err
+	}
+	if processed {
+		if _, pending := dgdr.Annotations[AnnotationAction]; pending {
+			return &ctrl.Result{RequeueAfter: actionRetryInterval}, nil
+		}
+		return &ctrl.Result{Requeue: true}, nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,212 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"sort"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/jsonmergepatch"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+)
+
+const (
+	// ConditionTypeAppliedToExisting reports the fields the generated spec changed on an existing DGD
+	ConditionTypeAppliedToExisting = "AppliedToExisting"
+
+	// Condition reasons of the AppliedToExisting condition
+	ReasonFieldsMerged             = "FieldsMerged"
+	ReasonNoFieldsChanged          = "NoFieldsChanged"
+	ReasonTargetDeploymentNotFound = "TargetDeploymentNotFound"
+
+	// AnnotationLastAppliedSpec holds the generated spec last merged into an existing DGD, the
+	// original of the next three-way merge
+	AnnotationLastAppliedSpec = "nvidia.com/dgdr-last-applied-spec"
+
+	// maxReportedFields caps the changed fields listed in the AppliedToExisting condition
+	maxReportedFields = 20
+
+	// Messages
+	MessageFieldsMerged             = "Merged %d fields of the generated spec into DynamoGraphDeployment %s: %s"
+	MessageNoFieldsChanged          = "DynamoGraphDeployment %s already matches the generated spec"
+	MessageTargetDeploymentNotFound = "DynamoGraphDeployment %s/%s to apply the generated spec to does not exist"
+)
+
+// existingDeploymentLabels link the DGD merged into with applyToExisting to its DGDR, so that changes
+// of the DGD reach the DGDR. They are the only labels set on the DGD, and are removed with the DGDR.
+var existingDeploymentLabels = []string{LabelDGDRName, LabelDGDRNamespace, LabelDGDRUID}
+
+// errTargetDeploymentNotFound is returned while the DGD named for applyToExisting does not exist
+var errTargetDeploymentNotFound = errors.New("target deployment not found")
+
+// isApplyToExisting reports whether the generated spec is merged into an existing DGD
+func isApplyToExisting(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) bool {
+	return dgdr.Spec.DeploymentOverrides != nil && dgdr.Spec.DeploymentOverrides.ApplyToExisting
+}
+
+// mergeIntoExisting merges the generated deployment into the existing DGD live names with a
+// three-way JSON merge: the original is the spec last merged by the DGDR, so fields the generated
+// spec stopped setting are removed while fields edited on the DGD since are kept unless the new
+// generated spec changes them too. The merged fields are reported in the AppliedToExisting
+// condition. Of the labels of the generated deployment only existingDeploymentLabels are set,
+// the DGD stays the user's. It returns the spec live had before the merge if it was changed.
+func (r *DynamoGraphDeploymentRequestReconciler) mergeIntoExisting(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, live, dgd *nvidiacomv1alpha1.DynamoGraphDeployment, hash string) (controllerutil.OperationResult, *nvidiacomv1alpha1.DynamoGraphDeploymentSpec, error) {
+	logger := log.FromContext(ctx)
+	if err := r.Get(ctx, client.ObjectKeyFromObject(live), live); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return controllerutil.OperationResultNone, nil, err
+		}
+		message := fmt.Sprintf(MessageTargetDeploymentNotFound, live.Namespace, live.Name)
+		meta.SetStatusCondition(&dgdr.Status.Conditions, metav1.Condition{
+			Type:               ConditionTypeAppliedToExisting,
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: dgdr.Generation,
+			Reason:             ReasonTargetDeploymentNotFound,
+			Message:            message,
+		})
+		if updateErr := r.updateStatus(ctx, dgdr); updateErr != nil {
+			logger.Error(updateErr, "Failed to record the missing target deployment in status")
+		}
+		return controllerutil.OperationResultNone, nil, fmt.Errorf("%w: %s", errTargetDeploymentNotFound, message)
+	}
+	if live.Annotations[AnnotationGeneratedSpecHash] == hash {
+		return controllerutil.OperationResultNone, nil, nil
+	}
+
+	modified, err := json.Marshal(dgd.Spec)
+	if err != nil {
+		return controllerutil.OperationResultNone, nil, fmt.Errorf("failed to marshal generated spec: %w", err)
+	}
+	current, err := json.Marshal(live.Spec)
+	if err != nil {
+		return controllerutil.OperationResultNone, nil, fmt.Errorf("failed to marshal spec of %s: %w", live.Name, err)
+	}
+	specPatch, err := jsonmergepatch.CreateThreeWayJSONMergePatch([]byte(live.Annotations[AnnotationLastAppliedSpec]), modified, current)
+	if err != nil {
+		return controllerutil.OperationResultNone, nil, fmt.Errorf("failed to merge generated spec into %s: %w", live.Name, err)
+	}
+	changes := map[string]interface{}{}
+	if err := json.Unmarshal(specPatch, &changes); err != nil {
+		return controllerutil.OperationResultNone, nil, fmt.Errorf("failed to read merge patch: %w", err)
+	}
+
+	annotations := maps.Clone(dgd.Annotations)
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[AnnotationGeneratedSpecHash] = hash
+	annotations[AnnotationLastAppliedSpec] = string(modified)
+	labels := map[string]string{}
+	for _, key := range existingDeploymentLabels {
+		labels[key] = dgd.Labels[key]
+	}
+	patch := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			// Fail on concurrent edits rather than merging against a stale spec
+			"resourceVersion": live.ResourceVersion,
+			"labels":          labels,
+			"annotations":     annotations,
+		},
+	}
+	if len(changes) > 0 {
+		patch["spec"] = changes
+	}
+	data, err := json.Marshal(patch)
+	if err != nil {
+		return controllerutil.OperationResultNone, nil, fmt.Errorf("failed to marshal merge patch: %w", err)
+	}
+	previous := live.Spec.DeepCopy()
+	if err := r.Patch(ctx, live, client.RawPatch(types.MergePatchType, data)); err != nil {
+		return controllerutil.OperationResultNone, nil, err
+	}
+
+	fields := changedFields("spec", changes)
+	condition := metav1.Condition{
+		Type:               ConditionTypeAppliedToExisting,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: dgdr.Generation,
+		Reason:             ReasonNoFieldsChanged,
+		Message:            fmt.Sprintf(MessageNoFieldsChanged, live.Name),
+	}
+	if len(fields) > 0 {
+		reported := fields
+		if len(reported) > maxReportedFields {
+			reported = append(reported[:maxReportedFields:maxReportedFields], fmt.Sprintf("and %d more", len(fields)-maxReportedFields))
+		}
+		condition.Reason = ReasonFieldsMerged
+		condition.Message = fmt.Sprintf(MessageFieldsMerged, len(fields), live.Name, strings.Join(reported, ", "))
+	}
+	meta.SetStatusCondition(&dgdr.Status.Conditions, condition)
+	logger.Info("Merged generated spec into existing DynamoGraphDeployment", "name", live.Name, "fields", len(fields))
+
+	if len(fields) == 0 {
+		return controllerutil.OperationResultNone, nil, nil
+	}
+	return controllerutil.OperationResultUpdated, previous, nil
+}
+
+// releaseExistingDeployment removes the labels and annotations a deleted DGDR set on the DGD it merged
+// into with applyToExisting. The DGD and its merged spec are left to the user.
+func (r *DynamoGraphDeploymentRequestReconciler) releaseExistingDeployment(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	dgd := &nvidiacomv1alpha1.DynamoGraphDeployment{}
+	key := types.NamespacedName{Name: dgdr.Status.Deployment.Name, Namespace: dgdr.Status.Deployment.Namespace}
+	if err := r.Get(ctx, key, dgd); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	if dgd.Labels[LabelDGDRName] != dgdr.Name || dgd.Labels[LabelDGDRNamespace] != dgdr.Namespace {
+		return nil
+	}
+
+	for _, label := range existingDeploymentLabels {
+		delete(dgd.Labels, label)
+	}
+	delete(dgd.Annotations, AnnotationGeneratedSpecHash)
+	delete(dgd.Annotations, AnnotationLastAppliedSpec)
+	log.FromContext(ctx).Info("Releasing existing DynamoGraphDeployment", "name", dgd.Name, "namespace", dgd.Namespace)
+	if err := r.Update(ctx, dgd); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to release %s from its DGDR: %w", dgd.Name, err)
+	}
+	return nil
+}
+
+// changedFields returns the sorted paths of the fields set or removed by a JSON merge patch
+func changedFields(path string, patch map[string]interface{}) []string {
+	var fields []string
+	for key, value := range patch {
+		if nested, ok := value.(map[string]interface{}); ok && len(nested) > 0 {
+			fields = append(fields, changedFields(path+"."+key, nested)...)
+			continue
+		}
+		fields = append(fields, path+"."+key)
+	}
+	sort.Strings(fields)
+	return fields
+}
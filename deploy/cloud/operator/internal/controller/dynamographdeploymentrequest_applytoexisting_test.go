@@ -0,0 +1,152 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("DGDR Apply To Existing Deployment", func() {
+	var reconciler *DynamoGraphDeploymentRequestReconciler
+
+	BeforeEach(func() {
+		reconciler = &DynamoGraphDeploymentRequestReconciler{
+			Client:      k8sClient,
+			Recorder:    record.NewFakeRecorder(100),
+			RBACManager: &MockRBACManager{},
+		}
+	})
+
+	newDGDR := func(name, target string) *nvidiacomv1alpha1.DynamoGraphDeploymentRequest {
+		return &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model:   "test-model",
+				Backend: BackendVLLM,
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					ProfilerImage: "test-profiler:latest",
+					Config:        createTestConfig(map[string]interface{}{"sla": map[string]interface{}{"ttft": 100.0}}),
+				},
+				AutoApply:           true,
+				DeploymentOverrides: &nvidiacomv1alpha1.DeploymentOverridesSpec{Name: target, ApplyToExisting: true},
+			},
+		}
+	}
+
+	It("Should three-way merge the generated spec into the existing deployment", func() {
+		ctx := context.Background()
+		existing := &nvidiacomv1alpha1.DynamoGraphDeployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-dgd-existing", Namespace: defaultNamespace, Labels: map[string]string{"team": "serving"}},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentSpec{
+				Services: map[string]*nvidiacomv1alpha1.DynamoComponentDeploymentSharedSpec{
+					"Frontend": {Replicas: ptr.To(int32(1))},
+					"Custom":   {Replicas: ptr.To(int32(3))},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, existing)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, existing) }()
+
+		dgdr := newDGDR("test-dgdr-applytoexisting", existing.Name)
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, dgdr) }()
+		dgdr.Status.State = StateDeploying
+		dgdr.Status.GeneratedDeployment = &runtime.RawExtension{Raw: []byte(
+			`{"apiVersion":"nvidia.com/v1alpha1","kind":"DynamoGraphDeployment","metadata":{"name":"generated"},"spec":{"services":{"Frontend":{"replicas":2},"Worker":{"replicas":1}}}}`)}
+		Expect(k8sClient.Status().Update(ctx, dgdr)).Should(Succeed())
+
+		_, err := reconciler.createDGD(ctx, dgdr)
+		Expect(err).NotTo(HaveOccurred())
+
+		live := &nvidiacomv1alpha1.DynamoGraphDeployment{}
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(existing), live)).Should(Succeed())
+		Expect(*live.Spec.Services["Frontend"].Replicas).Should(Equal(int32(2)))
+		Expect(live.Spec.Services).Should(HaveKey("Worker"))
+		Expect(*live.Spec.Services["Custom"].Replicas).Should(Equal(int32(3)))
+		Expect(live.Labels).Should(HaveKeyWithValue("team", "serving"))
+		Expect(live.Labels).Should(HaveKeyWithValue(LabelDGDRName, dgdr.Name))
+		Expect(live.Labels).ShouldNot(HaveKey(LabelManagedBy))
+		Expect(live.Annotations).Should(HaveKey(AnnotationLastAppliedSpec))
+		condition := meta.FindStatusCondition(dgdr.Status.Conditions, ConditionTypeAppliedToExisting)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Reason).Should(Equal(ReasonFieldsMerged))
+		Expect(condition.Message).Should(ContainSubstring("spec.services.Frontend.replicas, spec.services.Worker"))
+		Expect(condition.Message).ShouldNot(ContainSubstring("Custom"))
+
+		// A re-profiled spec drops the Worker it added before, the manually edited Custom service is kept
+		live.Spec.Services["Custom"].Replicas = ptr.To(int32(4))
+		Expect(k8sClient.Update(ctx, live)).Should(Succeed())
+		dgdr.Status.GeneratedDeployment = &runtime.RawExtension{Raw: []byte(
+			`{"apiVersion":"nvidia.com/v1alpha1","kind":"DynamoGraphDeployment","metadata":{"name":"generated"},"spec":{"services":{"Frontend":{"replicas":3}}}}`)}
+		_, err = reconciler.createDGD(ctx, dgdr)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(existing), live)).Should(Succeed())
+		Expect(*live.Spec.Services["Frontend"].Replicas).Should(Equal(int32(3)))
+		Expect(live.Spec.Services).ShouldNot(HaveKey("Worker"))
+		Expect(*live.Spec.Services["Custom"].Replicas).Should(Equal(int32(4)))
+		condition = meta.FindStatusCondition(dgdr.Status.Conditions, ConditionTypeAppliedToExisting)
+		Expect(condition.Message).Should(ContainSubstring("Merged 2 fields"))
+
+		// Deleting the DGDR, even with DeleteAll, keeps the DGD and removes what the DGDR added to it
+		dgdr.Spec.CleanupPolicy = nvidiacomv1alpha1.CleanupPolicyDeleteAll
+		Expect(reconciler.cleanupDeployment(ctx, dgdr, true)).Should(Succeed())
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(existing), live)).Should(Succeed())
+		Expect(live.Labels).Should(Equal(map[string]string{"team": "serving"}))
+		Expect(live.Annotations).ShouldNot(HaveKey(AnnotationGeneratedSpecHash))
+		Expect(live.Annotations).ShouldNot(HaveKey(AnnotationLastAppliedSpec))
+		Expect(*live.Spec.Services["Frontend"].Replicas).Should(Equal(int32(3)))
+	})
+
+	It("Should wait for a missing target deployment", func() {
+		ctx := context.Background()
+		dgdr := newDGDR("test-dgdr-applytoexisting-missing", "test-dgd-missing")
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, dgdr) }()
+		dgdr.Status.State = StateDeploying
+		dgdr.Status.GeneratedDeployment = &runtime.RawExtension{Raw: []byte(
+			`{"apiVersion":"nvidia.com/v1alpha1","kind":"DynamoGraphDeployment","metadata":{"name":"generated"},"spec":{"services":{"Frontend":{"replicas":1}}}}`)}
+		Expect(k8sClient.Status().Update(ctx, dgdr)).Should(Succeed())
+
+		_, err := reconciler.createDGD(ctx, dgdr)
+		Expect(err).Should(MatchError(errTargetDeploymentNotFound))
+		condition := meta.FindStatusCondition(dgdr.Status.Conditions, ConditionTypeAppliedToExisting)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Reason).Should(Equal(ReasonTargetDeploymentNotFound))
+		Expect(k8sClient.Get(ctx, client.ObjectKey{Namespace: defaultNamespace, Name: "test-dgd-missing"}, &nvidiacomv1alpha1.DynamoGraphDeployment{})).ShouldNot(Succeed())
+	})
+
+	It("Should require a name and autoApply", func() {
+		ctx := context.Background()
+		dgdr := newDGDR("test-dgdr-applytoexisting-invalid", "")
+		Expect(k8sClient.Create(ctx, dgdr)).Should(MatchError(ContainSubstring("applyToExisting requires name")))
+		dgdr = newDGDR("test-dgdr-applytoexisting-invalid", "test-dgd-existing")
+		dgdr.Spec.AutoApply = false
+		Expect(k8sClient.Create(ctx, dgdr)).Should(MatchError(ContainSubstring("applyToExisting requires autoApply")))
+	})
+})
@@ -0,0 +1,164 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/audit"
+)
+
+const (
+	// StateAwaitingApproval holds autoApply back until the generated spec is approved
+	StateAwaitingApproval = nvidiacomv1alpha1.DGDRStateAwaitingApproval
+
+	// AnnotationApproved approves the generated spec of a DGDR awaiting approval while set to
+	// "true"; it is removed once the approval is consumed. Approvals set before the DGDR awaits
+	// approval are removed without approving anything, as they cannot have reviewed the spec.
+	AnnotationApproved = nvidiacomv1alpha1.AnnotationDGDRApproved
+
+	// ConditionTypeApproved reports whether the generated spec was approved for deployment
+	ConditionTypeApproved = "Approved"
+
+	// Condition reasons of the Approved condition
+	ReasonAwaitingApproval = "AwaitingApproval"
+	ReasonApproved         = "Approved"
+
+	// Event reasons
+	EventReasonAwaitingApproval = "AwaitingApproval"
+	EventReasonApproved         = "Approved"
+
+	// Messages
+	MessageAwaitingApproval          = "The generated spec awaits approval, review the PreviewAvailable condition and set the " + AnnotationApproved + ": \"true\" annotation to deploy it"
+	MessageApproved                  = "The generated spec was approved with the " + AnnotationApproved + " annotation, the approving user is recorded in the audit log of the admission webhook"
+	MessageApprovedUnattributed      = "The generated spec was approved with the " + AnnotationApproved + " annotation, the approving user is not recorded"
+	MessageApproverNotRecorded       = "The user approving the generated spec will not be recorded, it requires the admission webhook and its audit log to be enabled"
+	ValidationErrorApprovalAutoApply = "spec.approval.required requires autoApply"
+)
+
+// isApprovalRequired reports whether the generated spec of the DGDR must be approved before it is applied
+func isApprovalRequired(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) bool {
+	return dgdr.Spec.Approval != nil && dgdr.Spec.Approval.Required
+}
+
+// validateApproval checks that approval is only required of DGDRs that apply their spec
+func validateApproval(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	if isApprovalRequired(dgdr) && !dgdr.Spec.AutoApply {
+		return errors.New(ValidationErrorApprovalAutoApply)
+	}
+	return nil
+}
+
+// IsApprovalGranted reports whether an update of the DGDR from old sets the approval annotation
+// while the generated spec awaits approval
+func IsApprovalGranted(dgdr, old *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) bool {
+	return old.Status.State == StateAwaitingApproval &&
+		dgdr.Annotations[AnnotationApproved] == "true" && old.Annotations[AnnotationApproved] != "true"
+}
+
+// NewApprovalAuditRecord returns the audit record of the approval of the DGDR's generated spec,
+// with the deployment preview the user approved. The caller adds the requesting user.
+func NewApprovalAuditRecord(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) audit.Record {
+	record := audit.Record{Time: time.Now().UTC(), Action: audit.ActionRequestApproved, Request: requestReference(dgdr)}
+	if preview := meta.FindStatusCondition(dgdr.Status.Conditions, ConditionTypePreviewAvailable); preview != nil {
+		record.Message = preview.Message
+	}
+	return record
+}
+
+// autoApplyState returns the state autoApply continues in once the spec is generated
+func autoApplyState(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) string {
+	if isApprovalRequired(dgdr) {
+		return StateAwaitingApproval
+	}
+	return StateDeploying
+}
+
+// handleAwaitingApprovalState waits for the approval annotation, then consumes it and moves on to
+// Deploying. The controller cannot tell who set the annotation, only the admission webhook can
+// record the approving user in the audit log, so approvals are reported as unattributed otherwise.
+func (r *DynamoGraphDeploymentRequestReconciler) handleAwaitingApprovalState(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	condition := meta.FindStatusCondition(dgdr.Status.Conditions, ConditionTypeApproved)
+	if condition == nil || condition.Reason != ReasonAwaitingApproval {
+		// An approval set before the spec awaited approval did not review it
+		if _, exists := dgdr.Annotations[AnnotationApproved]; exists {
+			logger.Info("Ignoring approval set before the generated spec awaited approval")
+			if err := r.removeApproval(ctx, dgdr); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+		if r.ApprovalAudited {
+			clearWarning(dgdr, WarningApproverNotRecorded)
+		} else {
+			setWarning(dgdr, WarningApproverNotRecorded, MessageApproverNotRecorded)
+		}
+		logger.Info("Generated spec awaits approval")
+		r.Recorder.Event(dgdr, corev1.EventTypeNormal, EventReasonAwaitingApproval, MessageAwaitingApproval)
+		meta.SetStatusCondition(&dgdr.Status.Conditions, metav1.Condition{
+			Type:               ConditionTypeApproved,
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: dgdr.Generation,
+			Reason:             ReasonAwaitingApproval,
+			Message:            MessageAwaitingApproval,
+		})
+		return ctrl.Result{}, r.updateStatus(ctx, dgdr)
+	}
+	if dgdr.Annotations[AnnotationApproved] != "true" {
+		// Annotation changes trigger a reconcile
+		return ctrl.Result{}, nil
+	}
+
+	// Remove the annotation so it does not approve the spec of a later re-profiling
+	if err := r.removeApproval(ctx, dgdr); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	message := MessageApprovedUnattributed
+	if r.ApprovalAudited {
+		message = MessageApproved
+	}
+	logger.Info("Generated spec approved, transitioning to Deploying state")
+	r.Recorder.Event(dgdr, corev1.EventTypeNormal, EventReasonApproved, message)
+	meta.SetStatusCondition(&dgdr.Status.Conditions, metav1.Condition{
+		Type:               ConditionTypeApproved,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: dgdr.Generation,
+		Reason:             ReasonApproved,
+		Message:            message,
+	})
+	return r.updateStateAndRequeue(ctx, dgdr, StateDeploying, message)
+}
+
+// removeApproval removes the approval annotation from the DGDR
+func (r *DynamoGraphDeploymentRequestReconciler) removeApproval(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	patch := client.MergeFrom(dgdr.DeepCopy())
+	delete(dgdr.Annotations, AnnotationApproved)
+	return r.Patch(ctx, dgdr, patch)
+}
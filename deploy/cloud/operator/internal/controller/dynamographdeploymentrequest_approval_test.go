@@ -0,0 +1,128 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("DGDR Approval Gate", func() {
+	newDGDR := func(name string) *nvidiacomv1alpha1.DynamoGraphDeploymentRequest {
+		return &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model:   "test-model",
+				Backend: BackendVLLM,
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					ProfilerImage: "test-profiler:latest",
+					Config:        createTestConfig(map[string]interface{}{"sla": map[string]interface{}{"ttft": 100.0}}),
+				},
+				AutoApply: true,
+				Approval:  &nvidiacomv1alpha1.ApprovalSpec{Required: true},
+			},
+		}
+	}
+
+	It("Should only require approval of DGDRs that apply their spec", func() {
+		dgdr := newDGDR("test-dgdr-approval-validation")
+		Expect(validateApproval(dgdr)).Should(Succeed())
+		Expect(autoApplyState(dgdr)).Should(Equal(StateAwaitingApproval))
+
+		dgdr.Spec.AutoApply = false
+		Expect(validateApproval(dgdr)).Should(MatchError(ValidationErrorApprovalAutoApply))
+
+		dgdr.Spec.AutoApply = true
+		dgdr.Spec.Approval = nil
+		Expect(autoApplyState(dgdr)).Should(Equal(StateDeploying))
+	})
+
+	It("Should wait for the approval annotation and consume it", func() {
+		ctx := context.Background()
+		recorder := record.NewFakeRecorder(10)
+		reconciler := &DynamoGraphDeploymentRequestReconciler{Client: k8sClient, Recorder: recorder, RBACManager: &MockRBACManager{}}
+		dgdr := newDGDR("test-dgdr-approval")
+		dgdr.Annotations = map[string]string{AnnotationApproved: "true"}
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, dgdr) }()
+		dgdr.Status.State = StateAwaitingApproval
+		Expect(k8sClient.Status().Update(ctx, dgdr)).Should(Succeed())
+
+		_, err := reconciler.handleAwaitingApprovalState(ctx, dgdr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(dgdr), dgdr)).Should(Succeed())
+		Expect(dgdr.Status.State).Should(Equal(StateAwaitingApproval))
+		condition := meta.FindStatusCondition(dgdr.Status.Conditions, ConditionTypeApproved)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).Should(Equal(metav1.ConditionFalse))
+		Expect(condition.Reason).Should(Equal(ReasonAwaitingApproval))
+		Expect(recorder.Events).Should(Receive(ContainSubstring(EventReasonAwaitingApproval)))
+		// The approval set before the spec awaited approval is ignored
+		Expect(dgdr.Annotations).ShouldNot(HaveKey(AnnotationApproved))
+		// Without the admission webhook audit log the approver cannot be recorded
+		Expect(dgdr.Status.Warnings).Should(ContainElement(HaveField("Type", WarningApproverNotRecorded)))
+
+		// Waiting again does not repeat the event
+		_, err = reconciler.handleAwaitingApprovalState(ctx, dgdr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(recorder.Events).ShouldNot(Receive())
+
+		dgdr.Annotations = map[string]string{AnnotationApproved: "true"}
+		Expect(k8sClient.Update(ctx, dgdr)).Should(Succeed())
+		result, err := reconciler.handleAwaitingApprovalState(ctx, dgdr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Requeue).To(BeTrue())
+
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(dgdr), dgdr)).Should(Succeed())
+		Expect(dgdr.Status.State).Should(Equal(StateDeploying))
+		Expect(dgdr.Annotations).ShouldNot(HaveKey(AnnotationApproved))
+		Expect(meta.IsStatusConditionTrue(dgdr.Status.Conditions, ConditionTypeApproved)).To(BeTrue())
+		Expect(meta.FindStatusCondition(dgdr.Status.Conditions, ConditionTypeApproved).Message).Should(Equal(MessageApprovedUnattributed))
+		Expect(recorder.Events).Should(Receive(ContainSubstring(EventReasonApproved)))
+	})
+
+	It("Should only report the approver as recorded when the approval is audited", func() {
+		ctx := context.Background()
+		recorder := record.NewFakeRecorder(10)
+		reconciler := &DynamoGraphDeploymentRequestReconciler{Client: k8sClient, Recorder: recorder, RBACManager: &MockRBACManager{}, ApprovalAudited: true}
+		dgdr := newDGDR("test-dgdr-approval-audited")
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, dgdr) }()
+		dgdr.Status.State = StateAwaitingApproval
+		Expect(k8sClient.Status().Update(ctx, dgdr)).Should(Succeed())
+
+		_, err := reconciler.handleAwaitingApprovalState(ctx, dgdr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(dgdr), dgdr)).Should(Succeed())
+		Expect(dgdr.Status.Warnings).ShouldNot(ContainElement(HaveField("Type", WarningApproverNotRecorded)))
+
+		dgdr.Annotations = map[string]string{AnnotationApproved: "true"}
+		Expect(k8sClient.Update(ctx, dgdr)).Should(Succeed())
+		_, err = reconciler.handleAwaitingApprovalState(ctx, dgdr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(dgdr), dgdr)).Should(Succeed())
+		Expect(meta.FindStatusCondition(dgdr.Status.Conditions, ConditionTypeApproved).Message).Should(Equal(MessageApproved))
+	})
+})
@@ -0,0 +1,469 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+)
+
+const (
+	// AnnotationBrowseArtifacts starts a pod mounting the profiling artifacts while set to "true"
+	AnnotationBrowseArtifacts = "nvidia.com/dgdr-browse-artifacts"
+
+	// PVCArtifactsPrefix is the name prefix of artifacts claims provisioned by the operator
+	PVCArtifactsPrefix = "dgdr-artifacts-"
+	// PodArtifactsBrowserPrefix is the name prefix of artifacts browser pods
+	PodArtifactsBrowserPrefix = "dgdr-artifacts-browser-"
+	// JobArtifactsPrunePrefix is the name prefix of the jobs pruning expired artifacts
+	JobArtifactsPrunePrefix = "dgdr-artifacts-prune-"
+
+	// VolumeNameProfilingArtifacts is the volume of the artifacts claim
+	VolumeNameProfilingArtifacts = "profiling-artifacts"
+	// ProfilingArtifactsPath is where the artifacts claim is mounted
+	ProfilingArtifactsPath = "/artifacts"
+
+	// ContainerNameArtifactsBrowser is the container of the artifacts browser pod
+	ContainerNameArtifactsBrowser = "browser"
+	// ContainerNameArtifactsPruner is the container of the artifacts prune job
+	ContainerNameArtifactsPruner = "pruner"
+
+	// DefaultArtifactsSize is the size of provisioned artifacts claims
+	DefaultArtifactsSize = "10Gi"
+	// DefaultArtifactsTTL is how long artifacts are kept when neither the DGDR nor the operator sets a TTL
+	DefaultArtifactsTTL = 7 * 24 * time.Hour
+	// DefaultArtifactsPruneInterval is how often expired artifacts are looked for
+	DefaultArtifactsPruneInterval = 10 * time.Minute
+
+	// Event reasons
+	EventReasonArtifactsBrowserStarted = "ArtifactsBrowserStarted"
+	EventReasonArtifactsBrowserStopped = "ArtifactsBrowserStopped"
+	EventReasonArtifactsBrowserFailed  = "ArtifactsBrowserFailed"
+	EventReasonArtifactsPruned         = "ArtifactsPruned"
+
+	// Messages
+	MessageArtifactsPVCNotFound        = "artifacts PVC %s not found in namespace %s"
+	MessageArtifactsTTLNegative        = "profilingConfig.artifactsPVC.ttl must not be negative"
+	MessageArtifactsBrowserStarted     = "Browse the profiling artifacts with: kubectl exec -it -n %s %s -- ls %s"
+	MessageArtifactsBrowserStopped     = "Artifacts browser pod %s deleted"
+	MessageArtifactsBrowserUnavailable = "No profiling artifacts to browse"
+	MessageArtifactsPruned             = "Profiling artifacts %s pruned from PVC %s"
+)
+
+// hasArtifacts reports whether the profiling artifacts of the DGDR are kept on a claim
+func hasArtifacts(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) bool {
+	return dgdr.Spec.ProfilingConfig.ArtifactsPVC != nil
+}
+
+// getArtifactsClaimName returns the claim the artifacts of the DGDR are kept on
+func getArtifactsClaimName(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) string {
+	if claimName := dgdr.Spec.ProfilingConfig.ArtifactsPVC.ClaimName; claimName != "" {
+		return claimName
+	}
+	return PVCArtifactsPrefix + dgdr.Name
+}
+
+// getArtifactsPath returns the directory of the artifacts on the claim. The UID keeps a recreated
+// DGDR from mixing its artifacts with those of its predecessor on a shared claim.
+func getArtifactsPath(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) string {
+	return path.Join(dgdr.Name, string(dgdr.UID))
+}
+
+// getArtifactsDir returns where the artifacts directory is mounted in operator-created pods
+func getArtifactsDir(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) string {
+	if dgdr.Status.Artifacts != nil {
+		return path.Join(ProfilingArtifactsPath, dgdr.Status.Artifacts.Path)
+	}
+	return path.Join(ProfilingArtifactsPath, getArtifactsPath(dgdr))
+}
+
+// artifactsVolume returns the volume of the artifacts claim
+func artifactsVolume(claimName string, readOnly bool) corev1.Volume {
+	return corev1.Volume{
+		Name: VolumeNameProfilingArtifacts,
+		VolumeSource: corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+				ClaimName: claimName,
+				ReadOnly:  readOnly,
+			},
+		},
+	}
+}
+
+// validateArtifactsPVC checks that an existing artifacts claim is present and the TTL is valid
+func (r *DynamoGraphDeploymentRequestReconciler) validateArtifactsPVC(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	if !hasArtifacts(dgdr) {
+		return nil
+	}
+	spec := dgdr.Spec.ProfilingConfig.ArtifactsPVC
+	if spec.TTL != nil && spec.TTL.Duration < 0 {
+		return fmt.Errorf(MessageArtifactsTTLNegative)
+	}
+	if spec.ClaimName == "" {
+		return nil
+	}
+	pvc := &corev1.PersistentVolumeClaim{}
+	if err := r.Get(ctx, types.NamespacedName{Name: spec.ClaimName, Namespace: dgdr.Namespace}, pvc); err != nil {
+		if apierrors.IsNotFound(err) {
+			return fmt.Errorf(MessageArtifactsPVCNotFound, spec.ClaimName, dgdr.Namespace)
+		}
+		return err
+	}
+	return nil
+}
+
+// getArtifactsTTL returns how long the artifacts of the DGDR are kept, zero to keep them indefinitely
+func (r *DynamoGraphDeploymentRequestReconciler) getArtifactsTTL(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) time.Duration {
+	if ttl := dgdr.Spec.ProfilingConfig.ArtifactsPVC.TTL; ttl != nil {
+		return ttl.Duration
+	}
+	return r.ArtifactsTTL
+}
+
+// prepareArtifacts provisions the artifacts claim if needed and records the artifacts location in status
+// before the profiling job starts writing to it
+func (r *DynamoGraphDeploymentRequestReconciler) prepareArtifacts(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	if !hasArtifacts(dgdr) {
+		return nil
+	}
+	spec := dgdr.Spec.ProfilingConfig.ArtifactsPVC
+	claimName := getArtifactsClaimName(dgdr)
+
+	if spec.ClaimName == "" {
+		size := resource.MustParse(DefaultArtifactsSize)
+		if spec.Size != nil {
+			size = *spec.Size
+		}
+		pvc := &corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: claimName, Namespace: dgdr.Namespace}}
+		if _, err := controllerutil.CreateOrUpdate(ctx, r.Client, pvc, func() error {
+			pvc.Labels = map[string]string{
+				LabelDGDRName:  dgdr.Name,
+				LabelManagedBy: LabelValueDynamoOperator,
+			}
+			// The spec of a bound claim is immutable apart from its size
+			if pvc.CreationTimestamp.IsZero() {
+				pvc.Spec = corev1.PersistentVolumeClaimSpec{
+					AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+					StorageClassName: spec.StorageClassName,
+				}
+			}
+			pvc.Spec.Resources.Requests = corev1.ResourceList{corev1.ResourceStorage: size}
+			return controllerutil.SetControllerReference(dgdr, pvc, r.Client.Scheme())
+		}); err != nil {
+			return fmt.Errorf("failed to provision artifacts PVC %s: %w", claimName, err)
+		}
+		r.trackOwnedResource(ctx, dgdr, nvidiacomv1alpha1.OwnedResourceRoleArtifactsPVC, pvc)
+	}
+
+	// A re-profiled DGDR keeps writing to the same directory, its retention restarts once pruned
+	if dgdr.Status.Artifacts != nil && dgdr.Status.Artifacts.PrunedTime == nil {
+		return nil
+	}
+	now := metav1.Now()
+	artifacts := &nvidiacomv1alpha1.ArtifactsStatus{
+		ClaimName:   claimName,
+		Path:        getArtifactsPath(dgdr),
+		CreatedTime: now,
+	}
+	if ttl := r.getArtifactsTTL(dgdr); ttl > 0 {
+		artifacts.ExpiryTime = ptr.To(metav1.NewTime(now.Add(ttl)))
+	}
+	dgdr.Status.Artifacts = artifacts
+	log.FromContext(ctx).Info("Keeping profiling artifacts", "claim", claimName, "path", artifacts.Path)
+	return nil
+}
+
+// browseArtifactsRequested reports whether the DGDR asks for an artifacts browser pod
+func browseArtifactsRequested(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) bool {
+	return dgdr.Annotations[AnnotationBrowseArtifacts] == "true"
+}
+
+// getArtifactsBrowserPodName returns the name of the artifacts browser pod of the DGDR
+func getArtifactsBrowserPodName(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) string {
+	return PodArtifactsBrowserPrefix + dgdr.Name
+}
+
+// reconcileArtifactsBrowser runs a pod mounting the artifacts read-only while the DGDR is annotated for it,
+// and deletes it once the annotation is removed or the artifacts are pruned
+func (r *DynamoGraphDeploymentRequestReconciler) reconcileArtifactsBrowser(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	artifacts := dgdr.Status.Artifacts
+	if browseArtifactsRequested(dgdr) && artifacts != nil && artifacts.PrunedTime == nil {
+		return r.startArtifactsBrowser(ctx, dgdr)
+	}
+	if artifacts != nil && artifacts.BrowserPod != "" {
+		if err := r.stopArtifactsBrowser(ctx, dgdr); err != nil {
+			return err
+		}
+	}
+	if !browseArtifactsRequested(dgdr) {
+		return nil
+	}
+
+	// The artifacts location is recorded when profiling starts
+	if hasArtifacts(dgdr) && artifacts == nil && (dgdr.Status.State == StateEmpty || dgdr.Status.State == StatePending) {
+		return nil
+	}
+	// Retrying will not bring the artifacts back, drop the request
+	r.Recorder.Event(dgdr, corev1.EventTypeWarning, EventReasonArtifactsBrowserFailed, MessageArtifactsBrowserUnavailable)
+	delete(dgdr.Annotations, AnnotationBrowseArtifacts)
+	return r.Update(ctx, dgdr)
+}
+
+// startArtifactsBrowser creates the artifacts browser pod if it does not exist
+func (r *DynamoGraphDeploymentRequestReconciler) startArtifactsBrowser(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	podName := getArtifactsBrowserPodName(dgdr)
+	err := r.Get(ctx, types.NamespacedName{Name: podName, Namespace: dgdr.Namespace}, &corev1.Pod{})
+	if err == nil && dgdr.Status.Artifacts.BrowserPod == podName {
+		return nil
+	}
+	if apierrors.IsNotFound(err) {
+		pod := r.artifactsBrowserPod(dgdr)
+		if err := controllerutil.SetControllerReference(dgdr, pod, r.Client.Scheme()); err != nil {
+			return err
+		}
+		if err := r.Create(ctx, pod); err != nil && !apierrors.IsAlreadyExists(err) {
+			return fmt.Errorf("failed to create artifacts browser pod %s: %w", podName, err)
+		}
+		r.trackOwnedResource(ctx, dgdr, nvidiacomv1alpha1.OwnedResourceRoleArtifactsBrowser, pod)
+		r.Recorder.Event(dgdr, corev1.EventTypeNormal, EventReasonArtifactsBrowserStarted,
+			fmt.Sprintf(MessageArtifactsBrowserStarted, dgdr.Namespace, podName, getArtifactsDir(dgdr)))
+	} else if err != nil {
+		return err
+	}
+	dgdr.Status.Artifacts.BrowserPod = podName
+	return r.updateStatus(ctx, dgdr)
+}
+
+// stopArtifactsBrowser deletes the artifacts browser pod
+func (r *DynamoGraphDeploymentRequestReconciler) stopArtifactsBrowser(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: dgdr.Status.Artifacts.BrowserPod, Namespace: dgdr.Namespace}}
+	if err := r.Delete(ctx, pod); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete artifacts browser pod %s: %w", pod.Name, err)
+	}
+	r.Recorder.Event(dgdr, corev1.EventTypeNormal, EventReasonArtifactsBrowserStopped, fmt.Sprintf(MessageArtifactsBrowserStopped, pod.Name))
+	untrackOwnedResource(dgdr, "Pod", pod.Namespace, pod.Name)
+	dgdr.Status.Artifacts.BrowserPod = ""
+	return r.updateStatus(ctx, dgdr)
+}
+
+// artifactsBrowserPod builds the pod that mounts the artifacts of the DGDR read-only
+func (r *DynamoGraphDeploymentRequestReconciler) artifactsBrowserPod(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) *corev1.Pod {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      getArtifactsBrowserPodName(dgdr),
+			Namespace: dgdr.Namespace,
+			Labels: map[string]string{
+				LabelDGDRName:  dgdr.Name,
+				LabelManagedBy: LabelValueDynamoOperator,
+			},
+		},
+		Spec: corev1.PodSpec{
+			RestartPolicy: corev1.RestartPolicyAlways,
+			Containers: []corev1.Container{{
+				Name:       ContainerNameArtifactsBrowser,
+				Image:      SidecarImage,
+				Command:    []string{"sleep", "infinity"},
+				WorkingDir: getArtifactsDir(dgdr),
+				VolumeMounts: []corev1.VolumeMount{{
+					Name:      VolumeNameProfilingArtifacts,
+					MountPath: ProfilingArtifactsPath,
+					ReadOnly:  true,
+				}},
+			}},
+			Volumes: []corev1.Volume{artifactsVolume(dgdr.Status.Artifacts.ClaimName, true)},
+		},
+	}
+	r.applyJobPodSecurity(&pod.Spec)
+	return pod
+}
+
+// ArtifactsPruner periodically deletes profiling artifacts past their expiry time. The artifacts live on
+// claims the operator does not mount, so each directory is removed by a short-lived job in the DGDR namespace.
+type ArtifactsPruner struct {
+	Client client.Client
+
+	// Recorder reports pruned artifacts on their DGDR. Optional.
+	Recorder record.EventRecorder
+
+	// Interval is how often expired artifacts are looked for, DefaultArtifactsPruneInterval if zero
+	Interval time.Duration
+
+	// WatchNamespace restricts pruning to a namespace, all namespaces are pruned if empty
+	WatchNamespace string
+
+	// PodSecurityProfile is applied to the prune job pods
+	PodSecurityProfile PodSecurityProfile
+}
+
+// NeedLeaderElection prunes from a single replica
+func (p *ArtifactsPruner) NeedLeaderElection() bool {
+	return true
+}
+
+// Start prunes expired artifacts until ctx is cancelled
+func (p *ArtifactsPruner) Start(ctx context.Context) error {
+	interval := p.Interval
+	if interval == 0 {
+		interval = DefaultArtifactsPruneInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		// Failures are retried on the next tick
+		if err := p.Prune(ctx); err != nil {
+			log.FromContext(ctx).Error(err, "Failed to prune profiling artifacts")
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Prune starts prune jobs for expired artifacts and records the artifacts of finished jobs as pruned
+func (p *ArtifactsPruner) Prune(ctx context.Context) error {
+	dgdrs := &nvidiacomv1alpha1.DynamoGraphDeploymentRequestList{}
+	var opts []client.ListOption
+	if p.WatchNamespace != "" {
+		opts = append(opts, client.InNamespace(p.WatchNamespace))
+	}
+	if err := p.Client.List(ctx, dgdrs, opts...); err != nil {
+		return fmt.Errorf("failed to list DGDRs: %w", err)
+	}
+	now := time.Now()
+	for i := range dgdrs.Items {
+		dgdr := &dgdrs.Items[i]
+		artifacts := dgdr.Status.Artifacts
+		// Artifacts of a running profiling job are still being written
+		if artifacts == nil || artifacts.PrunedTime != nil || artifacts.ExpiryTime == nil ||
+			now.Before(artifacts.ExpiryTime.Time) || dgdr.Status.State == StateProfiling {
+			continue
+		}
+		if err := p.pruneArtifacts(ctx, dgdr); err != nil {
+			log.FromContext(ctx).Error(err, "Failed to prune profiling artifacts", "name", dgdr.Name, "namespace", dgdr.Namespace)
+		}
+	}
+	return nil
+}
+
+// getArtifactsPruneJobName returns the name of the job pruning the artifacts of the DGDR
+func getArtifactsPruneJobName(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) string {
+	return JobArtifactsPrunePrefix + dgdr.Name
+}
+
+// pruneArtifacts advances the pruning of the artifacts of the DGDR by one step: it starts the prune
+// job, or records its outcome once it finished
+func (p *ArtifactsPruner) pruneArtifacts(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	logger := log.FromContext(ctx)
+	job := &batchv1.Job{}
+	err := p.Client.Get(ctx, types.NamespacedName{Name: getArtifactsPruneJobName(dgdr), Namespace: dgdr.Namespace}, job)
+	if apierrors.IsNotFound(err) {
+		job = p.pruneJob(dgdr)
+		if err := controllerutil.SetControllerReference(dgdr, job, p.Client.Scheme()); err != nil {
+			return err
+		}
+		logger.Info("Pruning expired profiling artifacts", "name", dgdr.Name, "namespace", dgdr.Namespace,
+			"claim", dgdr.Status.Artifacts.ClaimName, "path", dgdr.Status.Artifacts.Path)
+		return p.Client.Create(ctx, job)
+	}
+	if err != nil {
+		return err
+	}
+
+	succeeded := false
+	for _, condition := range job.Status.Conditions {
+		if condition.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch condition.Type {
+		case batchv1.JobComplete:
+			succeeded = true
+		case batchv1.JobFailed:
+			// Start over on the next tick
+			logger.Info("Artifacts prune job failed, retrying", "job", job.Name, "message", condition.Message)
+			return client.IgnoreNotFound(p.Client.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground)))
+		}
+	}
+	if !succeeded {
+		return nil
+	}
+
+	patch := client.MergeFrom(dgdr.DeepCopy())
+	dgdr.Status.Artifacts.PrunedTime = ptr.To(metav1.Now())
+	if err := p.Client.Status().Patch(ctx, dgdr, patch); err != nil {
+		return fmt.Errorf("failed to record pruned artifacts: %w", err)
+	}
+	if p.Recorder != nil {
+		p.Recorder.Event(dgdr, corev1.EventTypeNormal, EventReasonArtifactsPruned,
+			fmt.Sprintf(MessageArtifactsPruned, dgdr.Status.Artifacts.Path, dgdr.Status.Artifacts.ClaimName))
+	}
+	return client.IgnoreNotFound(p.Client.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground)))
+}
+
+// pruneJob builds the job removing the artifacts directory of the DGDR from its claim
+func (p *ArtifactsPruner) pruneJob(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) *batchv1.Job {
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      getArtifactsPruneJobName(dgdr),
+			Namespace: dgdr.Namespace,
+			Labels: map[string]string{
+				LabelDGDRName:  dgdr.Name,
+				LabelManagedBy: LabelValueDynamoOperator,
+			},
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: ptr.To(int32(2)),
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					RestartPolicy: corev1.RestartPolicyNever,
+					Containers: []corev1.Container{{
+						Name:    ContainerNameArtifactsPruner,
+						Image:   SidecarImage,
+						Command: []string{"rm", "-rf", getArtifactsDir(dgdr)},
+						VolumeMounts: []corev1.VolumeMount{{
+							Name:      VolumeNameProfilingArtifacts,
+							MountPath: ProfilingArtifactsPath,
+						}},
+					}},
+					Volumes: []corev1.Volume{artifactsVolume(dgdr.Status.Artifacts.ClaimName, false)},
+				},
+			},
+		},
+	}
+	if p.PodSecurityProfile == PodSecurityProfileRestricted {
+		hardenPodSpec(&job.Spec.Template.Spec, nil)
+	}
+	return job
+}
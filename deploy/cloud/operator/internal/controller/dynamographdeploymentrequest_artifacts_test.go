@@ -0,0 +1,219 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+)
+
+var _ = Describe("DGDR Profiling Artifacts", func() {
+	var reconciler *DynamoGraphDeploymentRequestReconciler
+
+	BeforeEach(func() {
+		reconciler = &DynamoGraphDeploymentRequestReconciler{
+			Client:       k8sClient,
+			Recorder:     record.NewFakeRecorder(100),
+			RBACManager:  &MockRBACManager{},
+			ArtifactsTTL: DefaultArtifactsTTL,
+		}
+	})
+
+	newDGDR := func(name string, artifacts *nvidiacomv1alpha1.ArtifactsPVCSpec) *nvidiacomv1alpha1.DynamoGraphDeploymentRequest {
+		return &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model:   "Qwen/Qwen3-0.6B",
+				Backend: BackendVLLM,
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					ProfilerImage: "test-profiler:latest",
+					Config: createTestConfig(map[string]interface{}{
+						"sla":   map[string]interface{}{"ttft": 200.0, "itl": 20.0},
+						"sweep": map[string]interface{}{"use_ai_configurator": true},
+					}),
+					ArtifactsPVC: artifacts,
+				},
+			},
+		}
+	}
+
+	It("Should provision the artifacts claim and mount it into the profiling job", func() {
+		ctx := context.Background()
+		dgdr := newDGDR("test-dgdr-artifacts", &nvidiacomv1alpha1.ArtifactsPVCSpec{Size: ptr.To(resource.MustParse("1Gi"))})
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, dgdr) }()
+
+		Expect(reconciler.validateArtifactsPVC(ctx, dgdr)).Should(Succeed())
+		Expect(reconciler.prepareArtifacts(ctx, dgdr)).Should(Succeed())
+		Expect(dgdr.Status.Artifacts).NotTo(BeNil())
+		Expect(dgdr.Status.Artifacts.ClaimName).Should(Equal("dgdr-artifacts-test-dgdr-artifacts"))
+		Expect(dgdr.Status.Artifacts.Path).Should(Equal("test-dgdr-artifacts/" + string(dgdr.UID)))
+		Expect(dgdr.Status.Artifacts.ExpiryTime.Sub(dgdr.Status.Artifacts.CreatedTime.Time)).Should(Equal(DefaultArtifactsTTL))
+
+		pvc := &corev1.PersistentVolumeClaim{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: dgdr.Status.Artifacts.ClaimName, Namespace: defaultNamespace}, pvc)).Should(Succeed())
+		Expect(pvc.Spec.Resources.Requests.Storage().String()).Should(Equal("1Gi"))
+		Expect(pvc.OwnerReferences).Should(ContainElement(HaveField("UID", dgdr.UID)))
+		defer func() { _ = k8sClient.Delete(ctx, pvc) }()
+
+		Expect(reconciler.createProfilingJob(ctx, dgdr)).Should(Succeed())
+		job := &batchv1.Job{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: GetProfilingJobName(dgdr), Namespace: defaultNamespace}, job)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, job) }()
+		Expect(job.Spec.Template.Spec.Volumes).Should(ContainElement(HaveField("Name", VolumeNameProfilingArtifacts)))
+		sidecar := job.Spec.Template.Spec.Containers[1]
+		Expect(sidecar.VolumeMounts).Should(ContainElement(corev1.VolumeMount{Name: VolumeNameProfilingArtifacts, MountPath: ProfilingArtifactsPath}))
+		Expect(sidecar.Args[0]).Should(ContainSubstring("cp -R /data/. /artifacts/test-dgdr-artifacts/" + string(dgdr.UID) + "/"))
+	})
+
+	It("Should require an existing claim to be present and keep artifacts without a TTL", func() {
+		ctx := context.Background()
+		dgdr := newDGDR("test-dgdr-artifacts-existing", &nvidiacomv1alpha1.ArtifactsPVCSpec{
+			ClaimName: "shared-artifacts",
+			TTL:       &metav1.Duration{},
+		})
+		Expect(reconciler.validateArtifactsPVC(ctx, dgdr)).To(MatchError(ContainSubstring("artifacts PVC shared-artifacts not found")))
+
+		pvc := &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "shared-artifacts", Namespace: defaultNamespace},
+			Spec: corev1.PersistentVolumeClaimSpec{
+				AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany},
+				Resources: corev1.VolumeResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("1Gi")},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, pvc)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, pvc) }()
+
+		Expect(reconciler.validateArtifactsPVC(ctx, dgdr)).Should(Succeed())
+		Expect(reconciler.prepareArtifacts(ctx, dgdr)).Should(Succeed())
+		Expect(dgdr.Status.Artifacts.ClaimName).Should(Equal("shared-artifacts"))
+		Expect(dgdr.Status.Artifacts.ExpiryTime).Should(BeNil())
+
+		dgdr.Spec.ProfilingConfig.ArtifactsPVC.TTL = &metav1.Duration{Duration: -time.Hour}
+		Expect(reconciler.validateArtifactsPVC(ctx, dgdr)).To(MatchError(MessageArtifactsTTLNegative))
+	})
+
+	It("Should run the artifacts browser pod while annotated", func() {
+		ctx := context.Background()
+		dgdr := newDGDR("test-dgdr-artifacts-browse", &nvidiacomv1alpha1.ArtifactsPVCSpec{})
+		dgdr.Annotations = map[string]string{AnnotationBrowseArtifacts: "true"}
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, dgdr) }()
+
+		dgdr.Status.State = StateReady
+		dgdr.Status.Artifacts = &nvidiacomv1alpha1.ArtifactsStatus{
+			ClaimName:   "dgdr-artifacts-test-dgdr-artifacts-browse",
+			Path:        getArtifactsPath(dgdr),
+			CreatedTime: metav1.Now(),
+		}
+		Expect(k8sClient.Status().Update(ctx, dgdr)).Should(Succeed())
+
+		Expect(reconciler.reconcileArtifactsBrowser(ctx, dgdr)).Should(Succeed())
+		Expect(dgdr.Status.Artifacts.BrowserPod).Should(Equal("dgdr-artifacts-browser-test-dgdr-artifacts-browse"))
+		pod := &corev1.Pod{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: dgdr.Status.Artifacts.BrowserPod, Namespace: defaultNamespace}, pod)).Should(Succeed())
+		Expect(pod.Spec.Volumes[0].PersistentVolumeClaim.ReadOnly).To(BeTrue())
+		Expect(pod.Spec.Containers[0].WorkingDir).Should(Equal(getArtifactsDir(dgdr)))
+
+		// Reconciling again keeps the pod
+		Expect(reconciler.reconcileArtifactsBrowser(ctx, dgdr)).Should(Succeed())
+
+		delete(dgdr.Annotations, AnnotationBrowseArtifacts)
+		Expect(k8sClient.Update(ctx, dgdr)).Should(Succeed())
+		Expect(reconciler.reconcileArtifactsBrowser(ctx, dgdr)).Should(Succeed())
+		Expect(dgdr.Status.Artifacts.BrowserPod).Should(BeEmpty())
+		err := k8sClient.Get(ctx, types.NamespacedName{Name: pod.Name, Namespace: defaultNamespace}, &corev1.Pod{})
+		if err == nil {
+			// Without a kubelet the pod may linger until its grace period expires
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: pod.Name, Namespace: defaultNamespace}, pod)).Should(Succeed())
+			Expect(pod.DeletionTimestamp).NotTo(BeNil())
+		} else {
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		}
+	})
+
+	It("Should drop a browse request when there are no artifacts", func() {
+		ctx := context.Background()
+		dgdr := newDGDR("test-dgdr-artifacts-none", nil)
+		dgdr.Annotations = map[string]string{AnnotationBrowseArtifacts: "true"}
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, dgdr) }()
+
+		Expect(reconciler.reconcileArtifactsBrowser(ctx, dgdr)).Should(Succeed())
+		updated := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: dgdr.Name, Namespace: defaultNamespace}, updated)).Should(Succeed())
+		Expect(updated.Annotations).NotTo(HaveKey(AnnotationBrowseArtifacts))
+	})
+
+	It("Should prune expired artifacts with a job", func() {
+		ctx := context.Background()
+		recorder := record.NewFakeRecorder(10)
+		pruner := &ArtifactsPruner{Client: k8sClient, Recorder: recorder, WatchNamespace: defaultNamespace}
+
+		expired := newDGDR("test-dgdr-artifacts-expired", &nvidiacomv1alpha1.ArtifactsPVCSpec{})
+		fresh := newDGDR("test-dgdr-artifacts-fresh", &nvidiacomv1alpha1.ArtifactsPVCSpec{})
+		for dgdr, expiry := range map[*nvidiacomv1alpha1.DynamoGraphDeploymentRequest]time.Duration{expired: -time.Minute, fresh: time.Hour} {
+			Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+			defer func() { _ = k8sClient.Delete(ctx, dgdr) }()
+			dgdr.Status.State = StateReady
+			dgdr.Status.Artifacts = &nvidiacomv1alpha1.ArtifactsStatus{
+				ClaimName:   getArtifactsClaimName(dgdr),
+				Path:        getArtifactsPath(dgdr),
+				CreatedTime: metav1.Now(),
+				ExpiryTime:  ptr.To(metav1.NewTime(time.Now().Add(expiry))),
+			}
+			Expect(k8sClient.Status().Update(ctx, dgdr)).Should(Succeed())
+		}
+
+		Expect(pruner.Prune(ctx)).Should(Succeed())
+		job := &batchv1.Job{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: "dgdr-artifacts-prune-test-dgdr-artifacts-expired", Namespace: defaultNamespace}, job)).Should(Succeed())
+		Expect(job.Spec.Template.Spec.Containers[0].Command).Should(Equal([]string{"rm", "-rf", getArtifactsDir(expired)}))
+		err := k8sClient.Get(ctx, types.NamespacedName{Name: "dgdr-artifacts-prune-test-dgdr-artifacts-fresh", Namespace: defaultNamespace}, &batchv1.Job{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+		// Nothing is recorded until the job completed
+		Expect(pruner.Prune(ctx)).Should(Succeed())
+		updated := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: expired.Name, Namespace: defaultNamespace}, updated)).Should(Succeed())
+		Expect(updated.Status.Artifacts.PrunedTime).Should(BeNil())
+
+		job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}}
+		Expect(k8sClient.Status().Update(ctx, job)).Should(Succeed())
+		Expect(pruner.Prune(ctx)).Should(Succeed())
+
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: expired.Name, Namespace: defaultNamespace}, updated)).Should(Succeed())
+		Expect(updated.Status.Artifacts.PrunedTime).NotTo(BeNil())
+		Expect(recorder.Events).Should(Receive(ContainSubstring(EventReasonArtifactsPruned)))
+		_ = k8sClient.Delete(ctx, job)
+	})
+})
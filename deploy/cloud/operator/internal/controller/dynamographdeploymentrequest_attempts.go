@@ -0,0 +1,198 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+)
+
+const (
+	// SupersededJobTTL is how long the finished profiling jobs of superseded attempts are kept for
+	// post-mortem before the TTL controller deletes them
+	SupersededJobTTL = 24 * time.Hour
+
+	// DefaultAttemptRetention is how many profiling attempts of a DGDR keep their jobs by default
+	DefaultAttemptRetention = 3
+)
+
+// profilingJobNameForAttempt returns the job name of a profiling attempt. The first attempt keeps
+// the unsuffixed name; later ones are suffixed with "-a<attempt>", trimming the DGDR name so the
+// result stays a valid DNS-1123 label.
+func profilingJobNameForAttempt(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, attempt int32) string {
+	name := fmt.Sprintf("profile-%s", dgdr.Name)
+	if attempt <= 1 {
+		return name
+	}
+	suffix := fmt.Sprintf("-a%d", attempt)
+	if len(name)+len(suffix) > validation.DNS1123LabelMaxLength {
+		name = strings.TrimRight(name[:validation.DNS1123LabelMaxLength-len(suffix)], "-")
+	}
+	return name + suffix
+}
+
+// currentProfilingAttempt returns the attempt whose job is running, or nil if the last attempt ended
+func currentProfilingAttempt(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) *nvidiacomv1alpha1.ProfilingAttempt {
+	if n := len(dgdr.Status.Attempts); n > 0 && dgdr.Status.Attempts[n-1].Outcome == "" {
+		return &dgdr.Status.Attempts[n-1]
+	}
+	return nil
+}
+
+// startProfilingAttempt records a new attempt unless the last one is still running, in which case
+// its job is reused. The status is persisted by the caller's next status update.
+func startProfilingAttempt(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) *nvidiacomv1alpha1.ProfilingAttempt {
+	if attempt := currentProfilingAttempt(dgdr); attempt != nil {
+		return attempt
+	}
+	// A new run renders its results afresh even if they match the previous run's
+	dgdr.Status.ProfilingResultsChecksum = ""
+	dgdr.Status.ProfilingResultsEncoding = ""
+	number := int32(len(dgdr.Status.Attempts)) + 1
+	dgdr.Status.Attempts = append(dgdr.Status.Attempts, nvidiacomv1alpha1.ProfilingAttempt{
+		Attempt:   number,
+		JobName:   profilingJobNameForAttempt(dgdr, number),
+		StartTime: metav1.Now(),
+	})
+	return &dgdr.Status.Attempts[len(dgdr.Status.Attempts)-1]
+}
+
+// finishProfilingAttempt records the outcome of the running attempt, if any, which is no longer
+// expected to complete
+func finishProfilingAttempt(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, outcome nvidiacomv1alpha1.ProfilingAttemptOutcome) {
+	dgdr.Status.EstimatedCompletionTime = nil
+	if attempt := currentProfilingAttempt(dgdr); attempt != nil {
+		attempt.Outcome = outcome
+		attempt.CompletionTime = ptr.To(metav1.Now())
+	}
+}
+
+// supersedeProfilingAttempt retires the job of the last attempt before profiling restarts.
+// A running job is deleted so that it cannot deliver results into the new run, and the attempt is
+// marked Superseded once it is gone. A finished job is kept for post-mortem and expires after
+// SupersededJobTTL. It returns false while a running job is still being deleted.
+func (r *DynamoGraphDeploymentRequestReconciler) supersedeProfilingAttempt(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) (bool, error) {
+	job := &batchv1.Job{}
+	err := r.Get(ctx, types.NamespacedName{Name: GetProfilingJobName(dgdr), Namespace: dgdr.Namespace}, job)
+	if apierrors.IsNotFound(err) {
+		untrackOwnedResource(dgdr, "Job", dgdr.Namespace, GetProfilingJobName(dgdr))
+		finishProfilingAttempt(dgdr, nvidiacomv1alpha1.ProfilingAttemptSuperseded)
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if !isJobFinished(job) {
+		if job.DeletionTimestamp.IsZero() {
+			if err := r.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil && !apierrors.IsNotFound(err) {
+				return false, fmt.Errorf("failed to delete profiling job: %w", err)
+			}
+		}
+		if err := r.Get(ctx, types.NamespacedName{Name: job.Name, Namespace: job.Namespace}, job); !apierrors.IsNotFound(err) {
+			return false, client.IgnoreNotFound(err)
+		}
+		untrackOwnedResource(dgdr, "Job", job.Namespace, job.Name)
+		finishProfilingAttempt(dgdr, nvidiacomv1alpha1.ProfilingAttemptSuperseded)
+		return true, nil
+	}
+
+	if job.Spec.TTLSecondsAfterFinished == nil {
+		patch := client.MergeFrom(job.DeepCopy())
+		job.Spec.TTLSecondsAfterFinished = ptr.To(int32(SupersededJobTTL.Seconds()))
+		if err := r.Patch(ctx, job, patch); err != nil && !apierrors.IsNotFound(err) {
+			return false, fmt.Errorf("failed to set the TTL of profiling job %s: %w", job.Name, err)
+		}
+		log.FromContext(ctx).Info("Keeping profiling job of superseded attempt", "job", job.Name, "ttl", SupersededJobTTL)
+	}
+	finishProfilingAttempt(dgdr, nvidiacomv1alpha1.ProfilingAttemptSuperseded)
+	return true, nil
+}
+
+// pruneProfilingAttempts deletes the profiling and hook jobs of the attempts before the last
+// AttemptRetention ones, so that DGDRs re-profiled often do not pile up jobs in their namespace.
+// The current attempt is always among the retained ones.
+func (r *DynamoGraphDeploymentRequestReconciler) pruneProfilingAttempts(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	n := len(dgdr.Status.Attempts)
+	if r.AttemptRetention <= 0 || n <= int(r.AttemptRetention) {
+		return nil
+	}
+	oldestRetained := dgdr.Status.Attempts[n-int(r.AttemptRetention)].Attempt
+
+	jobs := &batchv1.JobList{}
+	if err := r.List(ctx, jobs, client.InNamespace(dgdr.Namespace), client.MatchingLabels{LabelDGDR: dgdr.Name}); err != nil {
+		return fmt.Errorf("failed to list the jobs of DGDR %s: %w", dgdr.Name, err)
+	}
+	for i := range jobs.Items {
+		job := &jobs.Items[i]
+		attempt, ok := jobAttempt(dgdr, job)
+		if !ok || attempt >= oldestRetained || !job.DeletionTimestamp.IsZero() || !metav1.IsControlledBy(job, dgdr) {
+			continue
+		}
+		if err := r.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete job %s of profiling attempt %d: %w", job.Name, attempt, err)
+		}
+		untrackOwnedResource(dgdr, "Job", job.Namespace, job.Name)
+		log.FromContext(ctx).Info("Deleted job of expired profiling attempt", "job", job.Name, "attempt", attempt)
+	}
+	return nil
+}
+
+// jobAttempt returns the profiling attempt a profiling or hook job of the DGDR belongs to
+func jobAttempt(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, job *batchv1.Job) (int32, bool) {
+	if job.Labels[LabelApp] == LabelValueProfilingHook {
+		i := strings.LastIndex(job.Name, "-a")
+		if i < 0 {
+			return 0, false
+		}
+		attempt, err := strconv.ParseInt(job.Name[i+len("-a"):], 10, 32)
+		return int32(attempt), err == nil
+	}
+	for _, attempt := range dgdr.Status.Attempts {
+		if attempt.JobName == job.Name {
+			return attempt.Attempt, true
+		}
+	}
+	return 0, false
+}
+
+// isJobFinished reports whether the job completed or failed
+func isJobFinished(job *batchv1.Job) bool {
+	for _, condition := range job.Status.Conditions {
+		if (condition.Type == batchv1.JobComplete || condition.Type == batchv1.JobFailed) &&
+			condition.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,195 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"strings"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+var _ = Describe("DGDR Profiling Attempts", func() {
+	var reconciler *DynamoGraphDeploymentRequestReconciler
+
+	BeforeEach(func() {
+		reconciler = &DynamoGraphDeploymentRequestReconciler{
+			Client:      k8sClient,
+			Recorder:    record.NewFakeRecorder(100),
+			RBACManager: &MockRBACManager{},
+		}
+	})
+
+	newDGDR := func(name string) *nvidiacomv1alpha1.DynamoGraphDeploymentRequest {
+		return &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model:   "Qwen/Qwen3-0.6B",
+				Backend: BackendVLLM,
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					ProfilerImage: "test-profiler:latest",
+					Config: createTestConfig(map[string]interface{}{
+						"sla":   map[string]interface{}{"ttft": 200.0, "itl": 20.0},
+						"sweep": map[string]interface{}{"use_ai_configurator": true},
+					}),
+				},
+			},
+		}
+	}
+
+	It("Should suffix the job names of later attempts", func() {
+		dgdr := newDGDR("test-dgdr")
+		Expect(profilingJobNameForAttempt(dgdr, 1)).Should(Equal("profile-test-dgdr"))
+		Expect(profilingJobNameForAttempt(dgdr, 2)).Should(Equal("profile-test-dgdr-a2"))
+
+		// Long names are trimmed to fit the suffix, without leaving a dash before it
+		long := newDGDR(strings.Repeat("a", 50) + "-bbbb")
+		Expect(profilingJobNameForAttempt(long, 12)).Should(Equal("profile-" + strings.Repeat("a", 50) + "-a12"))
+	})
+
+	It("Should run a retry under a new job and keep the failed one", func() {
+		ctx := context.Background()
+		dgdr := newDGDR("test-dgdr-attempts")
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, dgdr) }()
+
+		Expect(reconciler.createProfilingJob(ctx, dgdr)).Should(Succeed())
+		Expect(dgdr.Status.Attempts).Should(HaveLen(1))
+		Expect(dgdr.Status.Attempts[0].JobName).Should(Equal("profile-test-dgdr-attempts"))
+
+		// Creating the job again before the attempt ends reuses it
+		Expect(reconciler.createProfilingJob(ctx, dgdr)).Should(Succeed())
+		Expect(dgdr.Status.Attempts).Should(HaveLen(1))
+
+		first := &batchv1.Job{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: "profile-test-dgdr-attempts", Namespace: defaultNamespace}, first)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, first) }()
+		first.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: corev1.ConditionTrue}}
+		Expect(k8sClient.Status().Update(ctx, first)).Should(Succeed())
+		finishProfilingAttempt(dgdr, nvidiacomv1alpha1.ProfilingAttemptFailed)
+		dgdr.Status.State = StateFailed
+		Expect(k8sClient.Status().Update(ctx, dgdr)).Should(Succeed())
+
+		reset, err := reconciler.resetForProfiling(ctx, dgdr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(reset).To(BeTrue())
+		Expect(dgdr.Status.Attempts[0].Outcome).Should(Equal(nvidiacomv1alpha1.ProfilingAttemptFailed))
+
+		// The failed job is kept until its TTL expires
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: first.Name, Namespace: defaultNamespace}, first)).Should(Succeed())
+		Expect(first.Spec.TTLSecondsAfterFinished).NotTo(BeNil())
+		Expect(*first.Spec.TTLSecondsAfterFinished).Should(Equal(int32(SupersededJobTTL.Seconds())))
+
+		Expect(reconciler.createProfilingJob(ctx, dgdr)).Should(Succeed())
+		Expect(dgdr.Status.Attempts).Should(HaveLen(2))
+		Expect(GetProfilingJobName(dgdr)).Should(Equal("profile-test-dgdr-attempts-a2"))
+		second := &batchv1.Job{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: GetProfilingJobName(dgdr), Namespace: defaultNamespace}, second)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, second) }()
+	})
+
+	It("Should stop a running attempt before profiling restarts", func() {
+		ctx := context.Background()
+		dgdr := newDGDR("test-dgdr-attempts-running")
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, dgdr) }()
+
+		Expect(reconciler.createProfilingJob(ctx, dgdr)).Should(Succeed())
+		dgdr.Status.State = StateProfiling
+		Expect(k8sClient.Status().Update(ctx, dgdr)).Should(Succeed())
+
+		superseded, err := reconciler.supersedeProfilingAttempt(ctx, dgdr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(superseded).To(BeTrue())
+		Expect(dgdr.Status.Attempts[0].Outcome).Should(Equal(nvidiacomv1alpha1.ProfilingAttemptSuperseded))
+		Expect(dgdr.Status.Attempts[0].CompletionTime).NotTo(BeNil())
+
+		err = k8sClient.Get(ctx, types.NamespacedName{Name: GetProfilingJobName(dgdr), Namespace: defaultNamespace}, &batchv1.Job{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("Should delete the jobs of attempts beyond the retention", func() {
+		ctx := context.Background()
+		reconciler.AttemptRetention = 2
+		dgdr := newDGDR("test-dgdr-attempts-retention")
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		DeferCleanup(func() {
+			_ = k8sClient.DeleteAllOf(ctx, &batchv1.Job{}, client.InNamespace(defaultNamespace),
+				client.MatchingLabels{LabelDGDR: dgdr.Name}, client.PropagationPolicy(metav1.DeletePropagationBackground))
+			_ = k8sClient.Delete(ctx, dgdr)
+		})
+
+		hookJob := func(name string, controlled bool) *batchv1.Job {
+			job := &batchv1.Job{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: defaultNamespace,
+					Labels:    map[string]string{LabelApp: LabelValueProfilingHook, LabelDGDR: dgdr.Name},
+				},
+				Spec: batchv1.JobSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							RestartPolicy: corev1.RestartPolicyNever,
+							Containers:    []corev1.Container{{Name: ContainerNameHook, Image: "busybox:1.36"}},
+						},
+					},
+				},
+			}
+			if controlled {
+				Expect(controllerutil.SetControllerReference(dgdr, job, k8sClient.Scheme())).Should(Succeed())
+			}
+			Expect(k8sClient.Create(ctx, job)).Should(Succeed())
+			return job
+		}
+		firstHook := hookJob("hook-test-dgdr-attempts-retention-pre-warm-a1", true)
+		secondHook := hookJob("hook-test-dgdr-attempts-retention-pre-warm-a2", true)
+		foreign := hookJob("hook-test-dgdr-attempts-retention-pre-other-a1", false)
+
+		exists := func(name string) bool {
+			err := k8sClient.Get(ctx, types.NamespacedName{Name: name, Namespace: defaultNamespace}, &batchv1.Job{})
+			if apierrors.IsNotFound(err) {
+				return false
+			}
+			Expect(err).NotTo(HaveOccurred())
+			return true
+		}
+
+		for attempt := 1; attempt <= 3; attempt++ {
+			Expect(reconciler.createProfilingJob(ctx, dgdr)).Should(Succeed())
+			Expect(exists("profile-test-dgdr-attempts-retention")).Should(Equal(attempt <= 2))
+			Expect(exists(firstHook.Name)).Should(Equal(attempt <= 2))
+			finishProfilingAttempt(dgdr, nvidiacomv1alpha1.ProfilingAttemptFailed)
+		}
+		Expect(dgdr.Status.Attempts).Should(HaveLen(3))
+		Expect(exists("profile-test-dgdr-attempts-retention-a2")).To(BeTrue())
+		Expect(exists("profile-test-dgdr-attempts-retention-a3")).To(BeTrue())
+		Expect(exists(secondHook.Name)).To(BeTrue())
+		// Jobs the DGDR does not control are left alone
+		Expect(exists(foreign.Name)).To(BeTrue())
+	})
+})
@@ -0,0 +1,174 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+)
+
+const (
+	// Event reasons
+	EventReasonProfilingRunRecorded     = "ProfilingRunRecorded"
+	EventReasonProfilingRunRecordFailed = "ProfilingRunRecordFailed"
+
+	// profilingRunUIDSuffixLength is the number of job UID characters appended to record names
+	profilingRunUIDSuffixLength = 8
+)
+
+// getProfilingRunName returns the name of the audit record for a profiling job. The job UID
+// suffix keeps records of earlier runs of a recreated DGDR.
+func getProfilingRunName(job *batchv1.Job) string {
+	uid := string(job.UID)
+	if len(uid) > profilingRunUIDSuffixLength {
+		uid = uid[:profilingRunUIDSuffixLength]
+	}
+	return fmt.Sprintf("%s-%s", job.Name, uid)
+}
+
+// outputChecksum returns the SHA-256 of the profiling results in key order
+func outputChecksum(results map[string]string) string {
+	keys := make([]string, 0, len(results))
+	for key := range results {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	hash := sha256.New()
+	for _, key := range keys {
+		hash.Write([]byte(key))
+		hash.Write([]byte{0})
+		hash.Write([]byte(results[key]))
+		hash.Write([]byte{0})
+	}
+	return "sha256:" + hex.EncodeToString(hash.Sum(nil))
+}
+
+// recordProfilingRun creates the immutable DynamoProfilingRun audit record for the finished
+// profiling job. Records are not owned by the DGDR so they survive its deletion.
+func (r *DynamoGraphDeploymentRequestReconciler) recordProfilingRun(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, result nvidiacomv1alpha1.ProfilingRunResult) error {
+	logger := log.FromContext(ctx)
+
+	job := &batchv1.Job{}
+	if err := r.Get(ctx, types.NamespacedName{Name: GetProfilingJobName(dgdr), Namespace: dgdr.Namespace}, job); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to get profiling job: %w", err)
+	}
+
+	// The record outlives the DGDR, so it never keeps profiling config values that are not known to be safe
+	spec, err := redactedSpec(dgdr)
+	if err != nil {
+		return err
+	}
+	inputs, err := json.Marshal(spec)
+	if err != nil {
+		return fmt.Errorf("failed to marshal DGDR spec: %w", err)
+	}
+
+	run := &nvidiacomv1alpha1.DynamoProfilingRun{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      getProfilingRunName(job),
+			Namespace: dgdr.Namespace,
+			Labels: map[string]string{
+				LabelDGDRName:      dgdr.Name,
+				LabelDGDRNamespace: dgdr.Namespace,
+				LabelManagedBy:     LabelValueDynamoOperator,
+			},
+		},
+		Spec: nvidiacomv1alpha1.DynamoProfilingRunSpec{
+			DGDRName:       dgdr.Name,
+			DGDRUID:        string(dgdr.UID),
+			JobName:        job.Name,
+			Inputs:         &apiextensionsv1.JSON{Raw: inputs},
+			StartTime:      job.Status.StartTime,
+			CompletionTime: job.Status.CompletionTime,
+			Result:         result,
+		},
+	}
+	if job.Status.StartTime != nil {
+		end := metav1.Now()
+		if job.Status.CompletionTime != nil {
+			end = *job.Status.CompletionTime
+		}
+		run.Spec.Duration = end.Sub(job.Status.StartTime.Time).Round(time.Second).String()
+	}
+
+	pods := &corev1.PodList{}
+	if err := r.List(ctx, pods, client.InNamespace(dgdr.Namespace), client.MatchingLabels{"job-name": job.Name}); err != nil {
+		return fmt.Errorf("failed to list profiling pods: %w", err)
+	}
+	for _, pod := range pods.Items {
+		imageIDs := map[string]string{}
+		for _, status := range pod.Status.ContainerStatuses {
+			imageIDs[status.Name] = status.ImageID
+		}
+		var gpus int64
+		for _, container := range pod.Spec.Containers {
+			run.Spec.Containers = append(run.Spec.Containers, nvidiacomv1alpha1.ProfilingRunContainer{
+				Name:    container.Name,
+				Image:   container.Image,
+				ImageID: imageIDs[container.Name],
+			})
+			if quantity, ok := container.Resources.Limits[gpuResourceName(dgdr)]; ok {
+				gpus += quantity.Value()
+			}
+		}
+		run.Spec.Placement = append(run.Spec.Placement, nvidiacomv1alpha1.ProfilingRunPlacement{
+			Pod:  pod.Name,
+			Node: pod.Spec.NodeName,
+			GPUs: gpus,
+		})
+	}
+
+	results, err := r.resultTransport(dgdr).Fetch(ctx, dgdr)
+	if err == nil {
+		run.Spec.OutputChecksum = outputChecksum(results)
+	} else if !isResultsMissing(err) {
+		return err
+	}
+
+	if err := r.Create(ctx, run); err != nil {
+		if apierrors.IsAlreadyExists(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to create profiling run record: %w", err)
+	}
+
+	logger.Info("Recorded profiling run", "profilingRun", run.Name, "result", result)
+	r.Recorder.Event(dgdr, corev1.EventTypeNormal, EventReasonProfilingRunRecorded,
+		fmt.Sprintf("Recorded profiling run %s", run.Name))
+	return nil
+}
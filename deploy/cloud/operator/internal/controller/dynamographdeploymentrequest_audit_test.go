@@ -0,0 +1,171 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/consts"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+var _ = Describe("DGDR Profiling Run Audit Records", func() {
+	var reconciler *DynamoGraphDeploymentRequestReconciler
+
+	BeforeEach(func() {
+		reconciler = &DynamoGraphDeploymentRequestReconciler{
+			Client:      k8sClient,
+			Recorder:    record.NewFakeRecorder(100),
+			RBACManager: &MockRBACManager{},
+		}
+	})
+
+	It("Should record inputs, images, placement and output checksum of a finished run", func() {
+		ctx := context.Background()
+
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-dgdr-audit", Namespace: defaultNamespace, UID: "dgdr-uid"},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model:   "test-model",
+				Backend: "vllm",
+			},
+		}
+
+		podSpec := corev1.PodSpec{
+			RestartPolicy: corev1.RestartPolicyNever,
+			Containers: []corev1.Container{{
+				Name:  ContainerNameProfiler,
+				Image: "test-profiler:latest",
+				Resources: corev1.ResourceRequirements{
+					Limits: corev1.ResourceList{consts.KubeResourceGPUNvidia: resource.MustParse("2")},
+				},
+			}},
+		}
+		job := &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{Name: GetProfilingJobName(dgdr), Namespace: defaultNamespace},
+			Spec: batchv1.JobSpec{
+				Template: corev1.PodTemplateSpec{Spec: podSpec},
+			},
+		}
+		Expect(k8sClient.Create(ctx, job)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, job) }()
+
+		start := metav1.NewTime(time.Now().Add(-90 * time.Second).Truncate(time.Second))
+		end := metav1.NewTime(start.Add(75 * time.Second))
+		job.Status.StartTime = &start
+		job.Status.CompletionTime = &end
+		job.Status.Succeeded = 1
+		job.Status.Conditions = []batchv1.JobCondition{
+			{Type: batchv1.JobSuccessCriteriaMet, Status: corev1.ConditionTrue},
+			{Type: batchv1.JobComplete, Status: corev1.ConditionTrue},
+		}
+		Expect(k8sClient.Status().Update(ctx, job)).Should(Succeed())
+
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      job.Name + "-abcde",
+				Namespace: defaultNamespace,
+				Labels:    map[string]string{"job-name": job.Name},
+			},
+			Spec: *podSpec.DeepCopy(),
+		}
+		pod.Spec.NodeName = "gpu-node-1"
+		Expect(k8sClient.Create(ctx, pod)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, pod) }()
+		pod.Status.ContainerStatuses = []corev1.ContainerStatus{{
+			Name:    ContainerNameProfiler,
+			Image:   "test-profiler:latest",
+			ImageID: "docker.io/library/test-profiler@sha256:0123",
+		}}
+		Expect(k8sClient.Status().Update(ctx, pod)).Should(Succeed())
+
+		cm := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: GetOutputConfigMapName(dgdr), Namespace: defaultNamespace},
+			Data:       map[string]string{ProfilingOutputFile: "kind: DynamoGraphDeployment"},
+		}
+		Expect(k8sClient.Create(ctx, cm)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, cm) }()
+
+		Expect(reconciler.recordProfilingRun(ctx, dgdr, nvidiacomv1alpha1.ProfilingRunSucceeded)).Should(Succeed())
+		// Recording again is a no-op
+		Expect(reconciler.recordProfilingRun(ctx, dgdr, nvidiacomv1alpha1.ProfilingRunSucceeded)).Should(Succeed())
+
+		run := &nvidiacomv1alpha1.DynamoProfilingRun{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: getProfilingRunName(job), Namespace: defaultNamespace}, run)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, run) }()
+
+		Expect(run.Labels).Should(HaveKeyWithValue(LabelDGDRName, dgdr.Name))
+		Expect(run.OwnerReferences).Should(BeEmpty())
+		Expect(run.Spec.DGDRUID).Should(Equal("dgdr-uid"))
+		Expect(run.Spec.Result).Should(Equal(nvidiacomv1alpha1.ProfilingRunSucceeded))
+		Expect(run.Spec.Duration).Should(Equal("1m15s"))
+		Expect(string(run.Spec.Inputs.Raw)).Should(ContainSubstring(`"model":"test-model"`))
+		Expect(run.Spec.Containers).Should(ConsistOf(nvidiacomv1alpha1.ProfilingRunContainer{
+			Name:    ContainerNameProfiler,
+			Image:   "test-profiler:latest",
+			ImageID: "docker.io/library/test-profiler@sha256:0123",
+		}))
+		Expect(run.Spec.Placement).Should(ConsistOf(nvidiacomv1alpha1.ProfilingRunPlacement{
+			Pod:  pod.Name,
+			Node: "gpu-node-1",
+			GPUs: 2,
+		}))
+		Expect(run.Spec.OutputChecksum).Should(Equal(outputChecksum(cm.Data)))
+
+		// Records are immutable
+		run.Spec.Result = nvidiacomv1alpha1.ProfilingRunFailed
+		Expect(k8sClient.Update(ctx, run)).ShouldNot(Succeed())
+	})
+
+	It("Should delete records past retention", func() {
+		ctx := context.Background()
+
+		run := &nvidiacomv1alpha1.DynamoProfilingRun{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-profiling-run-retention", Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoProfilingRunSpec{
+				DGDRName: "test-dgdr",
+				DGDRUID:  "uid",
+				JobName:  "profile-test-dgdr",
+				Result:   nvidiacomv1alpha1.ProfilingRunFailed,
+			},
+		}
+		Expect(k8sClient.Create(ctx, run)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, run) }()
+		request := ctrl.Request{NamespacedName: types.NamespacedName{Name: run.Name, Namespace: defaultNamespace}}
+
+		retained := &DynamoProfilingRunReconciler{Client: k8sClient, Retention: time.Hour}
+		result, err := retained.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).Should(BeNumerically(">", 59*time.Minute))
+
+		expired := &DynamoProfilingRunReconciler{Client: k8sClient, Retention: time.Nanosecond}
+		_, err = expired.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(k8sClient.Get(ctx, request.NamespacedName, &nvidiacomv1alpha1.DynamoProfilingRun{})).ShouldNot(Succeed())
+	})
+})
@@ -0,0 +1,143 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/audit"
+)
+
+const (
+	// Event reasons
+	EventReasonAuditRecordFailed = "AuditRecordFailed"
+
+	// Messages
+	MessageAuditRecordFailed = "Failed to write %s audit record: %v"
+)
+
+// requestReference returns the audit reference of a DGDR
+func requestReference(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) audit.ObjectReference {
+	return audit.ObjectReference{
+		Kind:      "DynamoGraphDeploymentRequest",
+		Namespace: dgdr.Namespace,
+		Name:      dgdr.Name,
+		UID:       string(dgdr.UID),
+	}
+}
+
+// auditSpec marshals a spec for an audit record, redacting the DGDR's profiling config values
+func auditSpec(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, spec interface{}) (json.RawMessage, error) {
+	raw, err := json.Marshal(spec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal audited spec: %w", err)
+	}
+	return json.RawMessage(redactMessage(dgdr, string(raw))), nil
+}
+
+// NewRequestAuditRecord returns the audit record of an admitted DGDR create, or of an update if old
+// is set, with the redacted spec and its diff to the old spec. The admission webhook adds the user.
+func NewRequestAuditRecord(dgdr, old *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) (audit.Record, error) {
+	record := audit.Record{Time: time.Now().UTC(), Action: audit.ActionRequestCreated, Request: requestReference(dgdr)}
+	spec, err := redactedSpec(dgdr)
+	if err != nil {
+		return record, err
+	}
+	if record.Spec, err = auditSpec(dgdr, spec); err != nil {
+		return record, err
+	}
+	if old != nil {
+		record.Action = audit.ActionRequestUpdated
+		oldSpec, err := redactedSpec(old)
+		if err != nil {
+			return record, err
+		}
+		record.Diff = redactMessage(old, redactMessage(dgdr, cmp.Diff(oldSpec, spec)))
+	}
+	return record, nil
+}
+
+// writeAuditRecord sends a record of an action taken for the DGDR to the audit sink, if one is
+// configured. Failures are reported in the logs and events but do not fail the action, which has
+// already been taken.
+func (r *DynamoGraphDeploymentRequestReconciler) writeAuditRecord(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, record audit.Record) {
+	if r.AuditSink == nil {
+		return
+	}
+	record.Time = time.Now().UTC()
+	record.Request = requestReference(dgdr)
+	if err := r.AuditSink.Write(ctx, record); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to write audit record", "action", record.Action)
+		r.Recorder.Event(dgdr, corev1.EventTypeWarning, EventReasonAuditRecordFailed, fmt.Sprintf(MessageAuditRecordFailed, record.Action, err))
+	}
+}
+
+// auditSpecGenerated records the deployment generated for the DGDR
+func (r *DynamoGraphDeploymentRequestReconciler) auditSpecGenerated(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, dgd *nvidiacomv1alpha1.DynamoGraphDeployment) {
+	if r.AuditSink == nil {
+		return
+	}
+	spec, err := auditSpec(dgdr, dgd.Spec)
+	if err != nil {
+		log.FromContext(ctx).Error(err, "Failed to build audit record", "action", audit.ActionSpecGenerated)
+		return
+	}
+	r.writeAuditRecord(ctx, dgdr, audit.Record{
+		Action:  audit.ActionSpecGenerated,
+		Object:  deploymentReference(dgd),
+		Spec:    spec,
+		Message: dgdr.Status.ProfilingResults,
+	})
+}
+
+// auditDeploymentApplied records the creation of the DGDR's deployment, or its update from the
+// previous spec
+func (r *DynamoGraphDeploymentRequestReconciler) auditDeploymentApplied(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, dgd *nvidiacomv1alpha1.DynamoGraphDeployment, previous *nvidiacomv1alpha1.DynamoGraphDeploymentSpec) {
+	if r.AuditSink == nil {
+		return
+	}
+	spec, err := auditSpec(dgdr, dgd.Spec)
+	if err != nil {
+		log.FromContext(ctx).Error(err, "Failed to build audit record", "action", audit.ActionDeploymentCreated)
+		return
+	}
+	record := audit.Record{Action: audit.ActionDeploymentCreated, Object: deploymentReference(dgd), Spec: spec}
+	if previous != nil {
+		record.Action = audit.ActionDeploymentUpdated
+		record.Diff = redactMessage(dgdr, cmp.Diff(*previous, dgd.Spec))
+	}
+	r.writeAuditRecord(ctx, dgdr, record)
+}
+
+// deploymentReference returns the audit reference of a DGD
+func deploymentReference(dgd *nvidiacomv1alpha1.DynamoGraphDeployment) *audit.ObjectReference {
+	return &audit.ObjectReference{
+		Kind:      "DynamoGraphDeployment",
+		Namespace: dgd.Namespace,
+		Name:      dgd.Name,
+		UID:       string(dgd.UID),
+	}
+}
@@ -0,0 +1,155 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/audit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+)
+
+// recordingAuditSink collects the audit records written to it, or fails with err
+type recordingAuditSink struct {
+	records []audit.Record
+	err     error
+}
+
+func (s *recordingAuditSink) Write(_ context.Context, record audit.Record) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.records = append(s.records, record)
+	return nil
+}
+
+var _ = Describe("DGDR Audit Log", func() {
+	var (
+		reconciler *DynamoGraphDeploymentRequestReconciler
+		recorder   *record.FakeRecorder
+		sink       *recordingAuditSink
+	)
+
+	BeforeEach(func() {
+		recorder = record.NewFakeRecorder(100)
+		sink = &recordingAuditSink{}
+		reconciler = &DynamoGraphDeploymentRequestReconciler{
+			Client:      k8sClient,
+			Recorder:    recorder,
+			RBACManager: &MockRBACManager{},
+			AuditSink:   sink,
+		}
+	})
+
+	It("Should record the generated spec and the applied deployment", func() {
+		ctx := context.Background()
+		generated := func(replicas int) *runtime.RawExtension {
+			return &runtime.RawExtension{Raw: []byte(fmt.Sprintf(
+				`{"apiVersion":"nvidia.com/v1alpha1","kind":"DynamoGraphDeployment","metadata":{"name":"test-dgd-auditlog"},"spec":{"services":{"Frontend":{"replicas":%d}}}}`,
+				replicas))}
+		}
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-dgdr-auditlog", Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model:   "test-model",
+				Backend: BackendVLLM,
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					ProfilerImage: "test-profiler:latest",
+					Config:        createTestConfig(map[string]interface{}{"sla": map[string]interface{}{"ttft": 100.0}}),
+				},
+				AutoApply: true,
+			},
+		}
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, dgdr) }()
+		defer func() {
+			_ = k8sClient.Delete(ctx, &nvidiacomv1alpha1.DynamoGraphDeployment{ObjectMeta: metav1.ObjectMeta{Name: "test-dgd-auditlog", Namespace: defaultNamespace}})
+		}()
+
+		dgdr.Status.State = StateDeploying
+		dgdr.Status.GeneratedDeployment = generated(1)
+		Expect(k8sClient.Status().Update(ctx, dgdr)).Should(Succeed())
+		dgd, err := dgdr.BuildDeployment()
+		Expect(err).NotTo(HaveOccurred())
+		reconciler.auditSpecGenerated(ctx, dgdr, dgd)
+
+		_, err = reconciler.createDGD(ctx, dgdr)
+		Expect(err).NotTo(HaveOccurred())
+		// Reapplying the unchanged spec is not an action
+		_, err = reconciler.createDGD(ctx, dgdr)
+		Expect(err).NotTo(HaveOccurred())
+		dgdr.Status.GeneratedDeployment = generated(2)
+		_, err = reconciler.createDGD(ctx, dgdr)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(sink.records).Should(HaveLen(3))
+		for _, r := range sink.records {
+			Expect(r.Request).Should(Equal(audit.ObjectReference{
+				Kind: "DynamoGraphDeploymentRequest", Namespace: defaultNamespace, Name: dgdr.Name, UID: string(dgdr.UID),
+			}))
+			Expect(r.Object.Name).Should(Equal("test-dgd-auditlog"))
+			Expect(r.Time.IsZero()).Should(BeFalse())
+		}
+		Expect(sink.records[0].Action).Should(Equal(audit.ActionSpecGenerated))
+		Expect(string(sink.records[0].Spec)).Should(ContainSubstring(`"replicas":1`))
+		Expect(sink.records[1].Action).Should(Equal(audit.ActionDeploymentCreated))
+		Expect(sink.records[1].Object.UID).ShouldNot(BeEmpty())
+		Expect(sink.records[1].Diff).Should(BeEmpty())
+		Expect(sink.records[2].Action).Should(Equal(audit.ActionDeploymentUpdated))
+		Expect(sink.records[2].Diff).Should(ContainSubstring("1"))
+		Expect(sink.records[2].Diff).Should(ContainSubstring("2"))
+	})
+
+	It("Should redact the profiling config in request records", func() {
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-dgdr-auditlog-redact", Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model: "test-model",
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					Config: createTestConfig(map[string]interface{}{"deployment": map[string]interface{}{"hf_token": "hf_secret"}}),
+				},
+			},
+		}
+		old := dgdr.DeepCopy()
+		old.Spec.Model = "old-model"
+
+		r, err := NewRequestAuditRecord(dgdr, old)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(r.Action).Should(Equal(audit.ActionRequestUpdated))
+		Expect(string(r.Spec)).ShouldNot(ContainSubstring("hf_secret"))
+		Expect(string(r.Spec)).Should(ContainSubstring("redacted"))
+		Expect(r.Diff).Should(ContainSubstring("old-model"))
+		Expect(r.Diff).ShouldNot(ContainSubstring("hf_secret"))
+	})
+
+	It("Should report failed records without failing the action", func() {
+		sink.err = errors.New("sink unavailable")
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-dgdr-auditlog-failed", Namespace: defaultNamespace},
+		}
+		reconciler.writeAuditRecord(context.Background(), dgdr, audit.Record{Action: audit.ActionSpecGenerated})
+		Expect(recorder.Events).Should(Receive(ContainSubstring(EventReasonAuditRecordFailed)))
+	})
+})
@@ -0,0 +1,166 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"sigs.k8s.io/yaml"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+)
+
+const (
+	// BackendAuto lets AI Configurator evaluate all candidate backends and select the best one
+	BackendAuto = "auto"
+
+	// ProfilingComparisonFile is the profiler output holding the per-backend comparison
+	ProfilingComparisonFile = "backend_comparison.yaml"
+
+	// Event reasons
+	EventReasonBackendSelected = "BackendSelected"
+
+	// Validation messages
+	ValidationErrorAutoRequiresAIC        = "spec.backend auto requires spec.profilingMode aic"
+	ValidationErrorPreferenceRequiresAuto = "spec.backendPreference is only valid when spec.backend is auto"
+)
+
+// defaultCandidateBackends are evaluated for backend auto when no preference is given
+var defaultCandidateBackends = []string{BackendVLLM, BackendSGLang, BackendTRTLLM}
+
+// backendResult is a single entry of the profiler's backend comparison output
+type backendResult struct {
+	Backend          string  `json:"backend"`
+	Feasible         bool    `json:"feasible"`
+	TTFT             float64 `json:"ttft"`
+	ITL              float64 `json:"itl"`
+	ThroughputPerGPU float64 `json:"throughput_per_gpu"`
+}
+
+// candidateBackends returns the backends to evaluate for backend auto, in preference order
+func candidateBackends(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) []string {
+	if len(dgdr.Spec.BackendPreference) == 0 {
+		return defaultCandidateBackends
+	}
+	candidates := make([]string, 0, len(dgdr.Spec.BackendPreference))
+	for _, backend := range dgdr.Spec.BackendPreference {
+		candidates = append(candidates, string(backend))
+	}
+	return candidates
+}
+
+// getBackendOutputFile returns the profiler output file holding the generated DGD for a backend
+func getBackendOutputFile(backend string) string {
+	return fmt.Sprintf("config_with_planner_%s.yaml", backend)
+}
+
+// validateBackendSelection validates the backend auto and backendPreference combination
+func validateBackendSelection(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	if dgdr.Spec.Backend != BackendAuto {
+		if len(dgdr.Spec.BackendPreference) > 0 {
+			return errors.New(ValidationErrorPreferenceRequiresAuto)
+		}
+		return nil
+	}
+	if isOnlineProfiling(dgdr) {
+		return errors.New(ValidationErrorAutoRequiresAIC)
+	}
+	return nil
+}
+
+// selectBackend picks a backend from the comparison. With an explicit preference the first
+// feasible backend in preference order wins; otherwise the feasible backend with the highest
+// throughput per GPU wins. If no backend meets the SLA, all results are considered.
+func selectBackend(results []backendResult, preference []string) (string, error) {
+	if len(results) == 0 {
+		return "", errors.New("backend comparison is empty")
+	}
+
+	candidates := make([]backendResult, 0, len(results))
+	for _, result := range results {
+		if result.Feasible {
+			candidates = append(candidates, result)
+		}
+	}
+	if len(candidates) == 0 {
+		candidates = results
+	}
+
+	for _, backend := range preference {
+		for _, result := range candidates {
+			if result.Backend == backend {
+				return backend, nil
+			}
+		}
+	}
+
+	best := candidates[0]
+	for _, result := range candidates[1:] {
+		if result.ThroughputPerGPU > best.ThroughputPerGPU {
+			best = result
+		}
+	}
+	return best.Backend, nil
+}
+
+// applyBackendComparison parses the backend comparison from the profiling results,
+// records it in status, selects a backend and returns the results key of its generated DGD
+func applyBackendComparison(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, results map[string]string) (string, error) {
+	content, exists := results[ProfilingComparisonFile]
+	if !exists {
+		return "", withFailureReason(nvidiacomv1alpha1.FailureReasonResultsMissing,
+			fmt.Errorf("key %s not found in profiling results", ProfilingComparisonFile))
+	}
+
+	var evaluations []backendResult
+	if err := yaml.Unmarshal([]byte(content), &evaluations); err != nil {
+		return "", withFailureReason(nvidiacomv1alpha1.FailureReasonSpecParseError,
+			fmt.Errorf("failed to parse %s: %w", ProfilingComparisonFile, err))
+	}
+
+	var preference []string
+	if len(dgdr.Spec.BackendPreference) > 0 {
+		preference = candidateBackends(dgdr)
+	}
+	selected, err := selectBackend(evaluations, preference)
+	if err != nil {
+		return "", withFailureReason(nvidiacomv1alpha1.FailureReasonResultsMissing, err)
+	}
+
+	dgdr.Status.BackendComparison = make([]nvidiacomv1alpha1.BackendEvaluation, 0, len(evaluations))
+	for _, result := range evaluations {
+		dgdr.Status.BackendComparison = append(dgdr.Status.BackendComparison, nvidiacomv1alpha1.BackendEvaluation{
+			Backend:          result.Backend,
+			Feasible:         result.Feasible,
+			TTFT:             strconv.FormatFloat(result.TTFT, 'f', -1, 64),
+			ITL:              strconv.FormatFloat(result.ITL, 'f', -1, 64),
+			ThroughputPerGPU: strconv.FormatFloat(result.ThroughputPerGPU, 'f', -1, 64),
+			Selected:         result.Backend == selected,
+		})
+	}
+	dgdr.Status.Backend = selected
+
+	outputKey := getBackendOutputFile(selected)
+	if _, exists := results[outputKey]; !exists {
+		return "", withFailureReason(nvidiacomv1alpha1.FailureReasonResultsMissing,
+			fmt.Errorf("key %s not found in profiling results", outputKey))
+	}
+	return outputKey, nil
+}
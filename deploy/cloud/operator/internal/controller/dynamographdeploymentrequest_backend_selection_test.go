@@ -0,0 +1,113 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("DGDR Backend Auto Selection", func() {
+	results := []backendResult{
+		{Backend: BackendVLLM, Feasible: true, TTFT: 120, ITL: 10, ThroughputPerGPU: 900},
+		{Backend: BackendSGLang, Feasible: true, TTFT: 110, ITL: 9, ThroughputPerGPU: 1100},
+		{Backend: BackendTRTLLM, Feasible: false, TTFT: 300, ITL: 20, ThroughputPerGPU: 1500},
+	}
+
+	It("Should select the feasible backend with the highest throughput without a preference", func() {
+		selected, err := selectBackend(results, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(selected).Should(Equal(BackendSGLang))
+	})
+
+	It("Should select the first feasible backend in preference order", func() {
+		selected, err := selectBackend(results, []string{BackendTRTLLM, BackendVLLM, BackendSGLang})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(selected).Should(Equal(BackendVLLM))
+	})
+
+	It("Should fall back to all results when no backend is feasible", func() {
+		infeasible := []backendResult{
+			{Backend: BackendVLLM, ThroughputPerGPU: 900},
+			{Backend: BackendTRTLLM, ThroughputPerGPU: 1500},
+		}
+		selected, err := selectBackend(infeasible, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(selected).Should(Equal(BackendTRTLLM))
+	})
+
+	It("Should reject backendPreference without backend auto", func() {
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Backend:           BackendVLLM,
+				BackendPreference: []nvidiacomv1alpha1.CandidateBackend{BackendVLLM},
+			},
+		}
+		Expect(validateBackendSelection(dgdr)).To(MatchError(ValidationErrorPreferenceRequiresAuto))
+	})
+
+	It("Should reject backend auto with online profiling", func() {
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Backend: BackendAuto,
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					Config: createTestConfig(map[string]interface{}{
+						"sweep": map[string]interface{}{"use_ai_configurator": false},
+					}),
+				},
+			},
+		}
+		Expect(validateBackendSelection(dgdr)).To(MatchError(ValidationErrorAutoRequiresAIC))
+	})
+
+	It("Should record the comparison and return the selected backend's output key", func() {
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{Backend: BackendAuto},
+		}
+		cm := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "dgdr-output-auto"},
+			Data: map[string]string{
+				ProfilingComparisonFile: `
+- backend: vllm
+  feasible: true
+  ttft: 120
+  itl: 10
+  throughput_per_gpu: 900
+- backend: sglang
+  feasible: true
+  ttft: 110.5
+  itl: 9
+  throughput_per_gpu: 1100
+`,
+				getBackendOutputFile(BackendSGLang): "apiVersion: nvidia.com/v1alpha1\nkind: DynamoGraphDeployment\n",
+			},
+		}
+
+		outputKey, err := applyBackendComparison(dgdr, cm.Data)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(outputKey).Should(Equal("config_with_planner_sglang.yaml"))
+		Expect(dgdr.Status.Backend).Should(Equal(BackendSGLang))
+		Expect(dgdr.Status.BackendComparison).Should(HaveLen(2))
+		Expect(dgdr.Status.BackendComparison[1].Selected).Should(BeTrue())
+		Expect(dgdr.Status.BackendComparison[1].TTFT).Should(Equal("110.5"))
+		Expect(dgdr.Status.BackendComparison[0].Selected).Should(BeFalse())
+	})
+})
@@ -0,0 +1,342 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/yaml"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+)
+
+// embeddedProfiledCatalog is the catalog of pre-profiled configurations shipped with the operator
+//
+//go:embed data/profiled_catalog.yaml
+var embeddedProfiledCatalog []byte
+
+const (
+	// ConditionTypeCatalogHit is set when a catalog entry replaces profiling
+	ConditionTypeCatalogHit = "CatalogHit"
+
+	// Condition reasons
+	ReasonCatalogHit = "CatalogHit"
+
+	// DefaultCatalogRefreshInterval is how often the catalog is refreshed from its URL
+	DefaultCatalogRefreshInterval = 24 * time.Hour
+
+	// maxProfiledCatalogBytes bounds the size of a downloaded catalog
+	maxProfiledCatalogBytes = 16 << 20
+
+	// Messages
+	MessageCatalogHit = "Profiling skipped, catalog entry %s meets the SLA with TTFT %gms and ITL %gms"
+)
+
+// ProfiledCatalog lists deployments profiled ahead of time, which DGDRs whose SLA they meet use
+// instead of running a profiling job
+type ProfiledCatalog struct {
+	// Entries are matched in order, the first match applies
+	Entries []CatalogEntry `json:"entries"`
+}
+
+// CatalogEntry is a deployment profiled for a model on a GPU system with a backend
+type CatalogEntry struct {
+	// Name identifies the entry in conditions and events
+	Name string `json:"name"`
+
+	// Model is the model name, matched case-insensitively against spec.model
+	Model string `json:"model"`
+
+	// System is the sweep.aic_system name of the GPU type the entry was profiled on
+	System string `json:"system"`
+
+	// Backend is the backend of the deployment
+	Backend string `json:"backend"`
+
+	// ISL and OSL are the input and output sequence lengths the entry was profiled at
+	ISL float64 `json:"isl"`
+	OSL float64 `json:"osl"`
+
+	// TTFT and ITL are the latencies in milliseconds the deployment achieved
+	TTFT float64 `json:"ttft"`
+	ITL  float64 `json:"itl"`
+
+	// Deployment is the profiled DynamoGraphDeployment
+	Deployment runtime.RawExtension `json:"deployment"`
+}
+
+// ParseProfiledCatalog parses and validates a YAML catalog
+func ParseProfiledCatalog(data []byte) (*ProfiledCatalog, error) {
+	catalog := &ProfiledCatalog{}
+	if err := yaml.UnmarshalStrict(data, catalog); err != nil {
+		return nil, fmt.Errorf("failed to parse profiled catalog: %w", err)
+	}
+	names := map[string]bool{}
+	for i, entry := range catalog.Entries {
+		if entry.Name == "" || entry.Model == "" || entry.System == "" || entry.Backend == "" {
+			return nil, fmt.Errorf("profiled catalog entry %d must set name, model, system and backend", i)
+		}
+		if names[entry.Name] {
+			return nil, fmt.Errorf("duplicate profiled catalog entry %s", entry.Name)
+		}
+		names[entry.Name] = true
+		if entry.ISL <= 0 || entry.OSL <= 0 || entry.TTFT <= 0 || entry.ITL <= 0 {
+			return nil, fmt.Errorf("profiled catalog entry %s must set positive isl, osl, ttft and itl", entry.Name)
+		}
+		if len(entry.Deployment.Raw) == 0 {
+			return nil, fmt.Errorf("profiled catalog entry %s has no deployment", entry.Name)
+		}
+	}
+	return catalog, nil
+}
+
+// catalogRequest is what a DGDR asks of a catalog entry
+type catalogRequest struct {
+	model, system, backend string
+	isl, osl, ttft, itl    float64
+}
+
+// lookup returns the first entry that meets the request, nil if none does
+func (c *ProfiledCatalog) lookup(request catalogRequest) *CatalogEntry {
+	for i := range c.Entries {
+		entry := &c.Entries[i]
+		if strings.EqualFold(entry.Model, request.model) && entry.System == request.system && entry.Backend == request.backend &&
+			request.isl <= entry.ISL && request.osl <= entry.OSL && entry.TTFT <= request.ttft && entry.ITL <= request.itl {
+			return entry
+		}
+	}
+	return nil
+}
+
+// ProfiledCatalogStore holds the current catalog, which is refreshed concurrently with validation
+type ProfiledCatalogStore struct {
+	mu      sync.RWMutex
+	catalog *ProfiledCatalog
+}
+
+// NewProfiledCatalogStore returns a store holding the catalog shipped with the operator
+func NewProfiledCatalogStore() (*ProfiledCatalogStore, error) {
+	catalog, err := ParseProfiledCatalog(embeddedProfiledCatalog)
+	if err != nil {
+		return nil, err
+	}
+	return &ProfiledCatalogStore{catalog: catalog}, nil
+}
+
+// Get returns the current catalog
+func (s *ProfiledCatalogStore) Get() *ProfiledCatalog {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.catalog
+}
+
+// set replaces the current catalog
+func (s *ProfiledCatalogStore) set(catalog *ProfiledCatalog) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.catalog = catalog
+}
+
+// ProfiledCatalogRefresher periodically refreshes the catalog from a URL
+type ProfiledCatalogRefresher struct {
+	Store *ProfiledCatalogStore
+
+	// URL serves the catalog as YAML. The current catalog is kept if it is unreachable.
+	URL string
+
+	// Interval is how often the catalog is refreshed, DefaultCatalogRefreshInterval if zero
+	Interval time.Duration
+
+	// HTTPClient fetches the catalog, http.DefaultClient if nil
+	HTTPClient *http.Client
+}
+
+// NeedLeaderElection refreshes the catalog on every replica, as each validates the DGDRs of its shards
+func (c *ProfiledCatalogRefresher) NeedLeaderElection() bool {
+	return false
+}
+
+// Start refreshes the catalog until ctx is cancelled
+func (c *ProfiledCatalogRefresher) Start(ctx context.Context) error {
+	interval := c.Interval
+	if interval == 0 {
+		interval = DefaultCatalogRefreshInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		// A stale catalog is better than none, failures are retried on the next tick
+		if err := c.Refresh(ctx); err != nil {
+			log.FromContext(ctx).Error(err, "Failed to refresh the profiled catalog", "url", c.URL)
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Refresh downloads the catalog from the URL
+func (c *ProfiledCatalogRefresher) Refresh(ctx context.Context) error {
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.URL, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to download profiled catalog: %s", resp.Status)
+	}
+	raw, err := io.ReadAll(io.LimitReader(resp.Body, maxProfiledCatalogBytes+1))
+	if err != nil {
+		return err
+	}
+	if len(raw) > maxProfiledCatalogBytes {
+		return fmt.Errorf("profiled catalog exceeds %d bytes", maxProfiledCatalogBytes)
+	}
+	catalog, err := ParseProfiledCatalog(raw)
+	if err != nil {
+		return err
+	}
+	c.Store.set(catalog)
+	return nil
+}
+
+// catalogRequestOf returns what the DGDR asks of a catalog entry, false if it cannot use one: it
+// opted out, lets the operator pick the backend, is not a generative model, needs raw manifests
+// written by the profiler, or its profiling config does not name the GPU system and every SLA value
+func catalogRequestOf(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) (catalogRequest, bool) {
+	request := catalogRequest{model: modelName(dgdr), backend: dgdr.Spec.Backend}
+	if dgdr.Spec.ProfilingConfig.Catalog == nvidiacomv1alpha1.CatalogPolicyIgnore || dgdr.Spec.Backend == BackendAuto ||
+		!isGenerative(dgdr) || isCPUOnly(dgdr) || getOutputFormat(dgdr) == nvidiacomv1alpha1.OutputFormatRawManifests ||
+		dgdr.Spec.ProfilingConfig.Config == nil {
+		return request, false
+	}
+	config := parseProfilingConfig(dgdr)
+	if config == nil {
+		return request, false
+	}
+	sweep, _ := config["sweep"].(map[string]interface{})
+	request.system, _ = sweep["aic_system"].(string)
+	sla := map[string]interface{}{}
+	if configured, ok := config["sla"].(map[string]interface{}); ok {
+		sla = configured
+	}
+	for key, value := range slaConfig(dgdr) {
+		sla[key] = float64(value)
+	}
+	var ok [4]bool
+	request.isl, ok[0] = sla["isl"].(float64)
+	request.osl, ok[1] = sla["osl"].(float64)
+	request.ttft, ok[2] = sla[SLAKeyTTFT].(float64)
+	request.itl, ok[3] = sla[SLAKeyITL].(float64)
+	return request, request.system != "" && ok == [4]bool{true, true, true, true}
+}
+
+// matchCatalog looks the validated DGDR up in the catalog. On a hit, the entry's deployment is
+// rendered with the DGDR's overrides as its generated spec and the CatalogHit condition is set,
+// so the DGDR skips profiling.
+func (r *DynamoGraphDeploymentRequestReconciler) matchCatalog(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) (bool, error) {
+	meta.RemoveStatusCondition(&dgdr.Status.Conditions, ConditionTypeCatalogHit)
+	if r.Catalog == nil {
+		return false, nil
+	}
+	request, ok := catalogRequestOf(dgdr)
+	if !ok {
+		return false, nil
+	}
+	entry := r.Catalog.Get().lookup(request)
+	if entry == nil {
+		return false, nil
+	}
+
+	source := fmt.Sprintf("catalog entry %s", entry.Name)
+	dgd, err := r.renderGeneratedDeployment(ctx, dgdr, source, entry.Deployment.Raw)
+	if err != nil {
+		return true, err
+	}
+	if dgd.Kind != "DynamoGraphDeployment" || len(dgd.Spec.Services) == 0 {
+		return true, withFailureReason(nvidiacomv1alpha1.FailureReasonSpecParseError,
+			fmt.Errorf("%s is not a DynamoGraphDeployment with services", source))
+	}
+
+	log.FromContext(ctx).Info("DGDR matches a profiled catalog entry", "entry", entry.Name)
+	dgdr.Status.GeneratedDeployment = &runtime.RawExtension{Object: dgd}
+	meta.SetStatusCondition(&dgdr.Status.Conditions, metav1.Condition{
+		Type:               ConditionTypeCatalogHit,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: dgdr.Generation,
+		Reason:             ReasonCatalogHit,
+		Message:            fmt.Sprintf(MessageCatalogHit, entry.Name, entry.TTFT, entry.ITL),
+	})
+	return true, nil
+}
+
+// handleCatalogHit moves a DGDR whose generated spec came from the catalog straight to Ready (or
+// Deploying with autoApply), without a profiling job
+func (r *DynamoGraphDeploymentRequestReconciler) handleCatalogHit(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) (ctrl.Result, error) {
+	log.FromContext(ctx).Info("Skipping profiling, using profiled catalog entry", "name", dgdr.Name)
+
+	message := meta.FindStatusCondition(dgdr.Status.Conditions, ConditionTypeCatalogHit).Message
+	// The generated spec was stored by matchCatalog and is read back from the status
+	dgd, ok := dgdr.Status.GeneratedDeployment.Object.(*nvidiacomv1alpha1.DynamoGraphDeployment)
+	if !ok {
+		dgd = &nvidiacomv1alpha1.DynamoGraphDeployment{}
+		if err := yaml.Unmarshal(dgdr.Status.GeneratedDeployment.Raw, dgd); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to unmarshal generated deployment: %w", err)
+		}
+	}
+	r.auditSpecGenerated(ctx, dgdr, dgd)
+	r.setGeneratedSpecExpiry(dgdr)
+	r.setDeploymentPreview(ctx, dgdr)
+	r.setPlacementReport(ctx, dgdr)
+	meta.SetStatusCondition(&dgdr.Status.Conditions, metav1.Condition{
+		Type:               ConditionTypeProfilingSkipped,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: dgdr.Generation,
+		Reason:             ReasonCatalogHit,
+		Message:            message,
+	})
+	r.Recorder.Event(dgdr, corev1.EventTypeNormal, ReasonCatalogHit, message)
+
+	if dgdr.Spec.AutoApply {
+		return r.updateStateWithCondition(ctx, dgdr, autoApplyState(dgdr), ConditionTypeSpecGenerated, metav1.ConditionTrue, ReasonCatalogHit, message)
+	}
+	return r.updateStateWithCondition(ctx, dgdr, StateReady, ConditionTypeSpecGenerated, metav1.ConditionTrue, ReasonCatalogHit, MessageSpecAvailable)
+}
@@ -0,0 +1,197 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const testProfiledCatalog = `
+entries:
+  - name: qwen3-32b-h200-vllm-agg
+    model: Qwen/Qwen3-32B
+    system: h200_sxm
+    backend: vllm
+    isl: 3000
+    osl: 150
+    ttft: 180
+    itl: 15
+    deployment:
+      apiVersion: nvidia.com/v1alpha1
+      kind: DynamoGraphDeployment
+      metadata:
+        name: qwen3-32b-catalog
+      spec:
+        services:
+          Frontend:
+            componentType: frontend
+          VllmWorker:
+            componentType: worker
+            replicas: 2
+`
+
+var _ = Describe("DGDR Profiled Catalog", func() {
+	var reconciler *DynamoGraphDeploymentRequestReconciler
+
+	BeforeEach(func() {
+		catalog, err := ParseProfiledCatalog([]byte(testProfiledCatalog))
+		Expect(err).NotTo(HaveOccurred())
+		store, err := NewProfiledCatalogStore()
+		Expect(err).NotTo(HaveOccurred())
+		store.set(catalog)
+		reconciler = &DynamoGraphDeploymentRequestReconciler{
+			Client:      k8sClient,
+			Recorder:    record.NewFakeRecorder(100),
+			RBACManager: &MockRBACManager{},
+			Catalog:     store,
+		}
+	})
+
+	newDGDR := func(name string, sla map[string]interface{}) *nvidiacomv1alpha1.DynamoGraphDeploymentRequest {
+		return &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model:   "qwen/qwen3-32b",
+				Backend: BackendVLLM,
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					ProfilerImage: "test-profiler:latest",
+					Config: createTestConfig(map[string]interface{}{
+						"sla":   sla,
+						"sweep": map[string]interface{}{"aic_system": "h200_sxm"},
+					}),
+				},
+			},
+		}
+	}
+
+	It("Should match entries that meet the SLA of the DGDR", func() {
+		lookup := func(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) *CatalogEntry {
+			request, ok := catalogRequestOf(dgdr)
+			if !ok {
+				return nil
+			}
+			return reconciler.Catalog.Get().lookup(request)
+		}
+
+		Expect(lookup(newDGDR("hit", map[string]interface{}{"isl": 2000, "osl": 150, "ttft": 200, "itl": 20}))).NotTo(BeNil())
+
+		// Targets tighter than what the entry achieved, or longer sequences than it was profiled at
+		Expect(lookup(newDGDR("tight", map[string]interface{}{"isl": 2000, "osl": 150, "ttft": 100, "itl": 20}))).To(BeNil())
+		Expect(lookup(newDGDR("long", map[string]interface{}{"isl": 8000, "osl": 150, "ttft": 200, "itl": 20}))).To(BeNil())
+		Expect(lookup(newDGDR("partial", map[string]interface{}{"ttft": 200, "itl": 20}))).To(BeNil())
+
+		dgdr := newDGDR("sglang", map[string]interface{}{"isl": 2000, "osl": 150, "ttft": 200, "itl": 20})
+		dgdr.Spec.Backend = BackendSGLang
+		Expect(lookup(dgdr)).To(BeNil())
+
+		// spec.sla overrides the SLA of the profiling config
+		dgdr = newDGDR("spec-sla", map[string]interface{}{"isl": 2000, "osl": 150, "ttft": 200, "itl": 20})
+		dgdr.Spec.SLA = &nvidiacomv1alpha1.SLASpec{TokenLatency: &nvidiacomv1alpha1.TokenLatencySpec{TTFTMilliseconds: 100}}
+		Expect(lookup(dgdr)).To(BeNil())
+
+		dgdr = newDGDR("ignore", map[string]interface{}{"isl": 2000, "osl": 150, "ttft": 200, "itl": 20})
+		dgdr.Spec.ProfilingConfig.Catalog = nvidiacomv1alpha1.CatalogPolicyIgnore
+		Expect(lookup(dgdr)).To(BeNil())
+	})
+
+	It("Should skip profiling and become Ready on a catalog hit", func() {
+		ctx := context.Background()
+		dgdr := newDGDR("test-dgdr-catalog-hit", map[string]interface{}{"isl": 3000, "osl": 150, "ttft": 200, "itl": 20})
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, dgdr) }()
+
+		request := reconcile.Request{NamespacedName: types.NamespacedName{Name: dgdr.Name, Namespace: defaultNamespace}}
+		for range 2 {
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+		}
+		updated := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{}
+		Expect(k8sClient.Get(ctx, request.NamespacedName, updated)).Should(Succeed())
+		Expect(updated.Status.State).Should(Equal(StateReady))
+		Expect(string(updated.Status.GeneratedDeployment.Raw)).Should(ContainSubstring("qwen3-32b-catalog"))
+
+		condition := meta.FindStatusCondition(updated.Status.Conditions, ConditionTypeCatalogHit)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).Should(Equal(metav1.ConditionTrue))
+		Expect(condition.Message).Should(Equal("Profiling skipped, catalog entry qwen3-32b-h200-vllm-agg meets the SLA with TTFT 180ms and ITL 15ms"))
+		skipped := meta.FindStatusCondition(updated.Status.Conditions, ConditionTypeProfilingSkipped)
+		Expect(skipped).NotTo(BeNil())
+		Expect(skipped.Reason).Should(Equal(ReasonCatalogHit))
+
+		// No profiling job is created
+		job := &batchv1.Job{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: GetProfilingJobName(dgdr), Namespace: defaultNamespace}, job)).ShouldNot(Succeed())
+	})
+
+	It("Should validate the whole spec before matching the catalog", func() {
+		ctx := context.Background()
+		dgdr := newDGDR("test-dgdr-catalog-invalid", map[string]interface{}{"isl": 3000, "osl": 150, "ttft": 200, "itl": 20})
+		dgdr.Spec.ProfilingConfig.SecretRef = &nvidiacomv1alpha1.SecretKeySelector{Name: "missing-secret", Key: "disagg.yaml"}
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, dgdr) }()
+
+		request := reconcile.Request{NamespacedName: types.NamespacedName{Name: dgdr.Name, Namespace: defaultNamespace}}
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		updated := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{}
+		Expect(k8sClient.Get(ctx, request.NamespacedName, updated)).Should(Succeed())
+		Expect(updated.Status.State).Should(Equal(StateFailed))
+		Expect(updated.Status.GeneratedDeployment).Should(BeNil())
+		Expect(meta.FindStatusCondition(updated.Status.Conditions, ConditionTypeCatalogHit)).Should(BeNil())
+	})
+
+	It("Should reject invalid catalogs and refresh from the catalog URL", func() {
+		_, err := ParseProfiledCatalog([]byte("entries:\n  - name: x\n    model: m\n    system: h100_sxm\n    backend: vllm\n    isl: 1\n    osl: 1\n    ttft: 1\n    itl: 1\n"))
+		Expect(err).To(MatchError(ContainSubstring("has no deployment")))
+		_, err = ParseProfiledCatalog([]byte("entries:\n  - name: x\n    model: m\n"))
+		Expect(err).To(MatchError(ContainSubstring("must set name, model, system and backend")))
+
+		store, err := NewProfiledCatalogStore()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(store.Get().Entries).Should(BeEmpty())
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			if req.URL.Path != "/catalog.yaml" {
+				http.NotFound(w, req)
+				return
+			}
+			_, _ = w.Write([]byte(testProfiledCatalog))
+		}))
+		defer server.Close()
+		refresher := &ProfiledCatalogRefresher{Store: store, URL: server.URL + "/catalog.yaml"}
+		Expect(refresher.Refresh(context.Background())).Should(Succeed())
+		Expect(store.Get().Entries).Should(HaveLen(1))
+
+		// An unreachable catalog keeps the current one
+		refresher.URL = server.URL + "/missing.yaml"
+		Expect(refresher.Refresh(context.Background())).ShouldNot(Succeed())
+		Expect(store.Get().Entries).Should(HaveLen(1))
+	})
+})
@@ -0,0 +1,154 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+)
+
+// Checkpoint contract: the profiler periodically saves the state of its sweep as
+// ProfilingCheckpointFile in its output directory. The output copier sidecar delivers every new
+// version with the result transport. Each profiling job pod starts with the last delivered
+// checkpoint restored to ProfilingCheckpointPath, and the profiler is started with the resume_from
+// config key naming it only if one was restored.
+const (
+	ProfilingCheckpointFile = "sweep-state.json"
+
+	// CheckpointStagingDir is where the output copier sidecar stages the checkpoint before delivering it
+	CheckpointStagingDir = "/tmp/checkpoint"
+
+	// Checkpoint restored for the profiler
+	ContainerNameCheckpointRestorer = "checkpoint-restorer"
+	VolumeNameProfilingCheckpoint   = "profiling-checkpoint"
+	ProfilingCheckpointPath         = "/checkpoint"
+	ConfigKeyResumeFrom             = "resume_from"
+
+	// Event and condition reasons
+	EventReasonResumedFromCheckpoint = "ResumedFromCheckpoint"
+	EventReasonProfilingRestarted    = "ProfilingRestarted"
+
+	// Messages
+	MessageResumedFromCheckpoint = "Profiling job pod failed %d time(s), resumed from checkpoint"
+	MessageProfilingRestarted    = "Profiling job pod failed %d time(s), restarted without a checkpoint"
+)
+
+// checkpointRestorerContainer returns the init container that restores the last delivered checkpoint,
+// so a retried profiling job pod resumes the sweep instead of restarting it. A differential
+// re-profile also gets the results of the attempt it re-sweeps around.
+func checkpointRestorerContainer(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, transport ResultTransport) corev1.Container {
+	file := path.Join(ProfilingCheckpointPath, ProfilingCheckpointFile)
+	script := fmt.Sprintf(`%s
+if [ -s %[2]s ]; then
+  echo "Restored the sweep checkpoint of a previous attempt"
+else
+  rm -f %[2]s
+  echo "No sweep checkpoint to resume from"
+fi
+`, transport.RestoreScript(dgdr, ProfilingCheckpointFile), file)
+	if isDifferentialProfiling(dgdr) {
+		previous := path.Join(ProfilingCheckpointPath, PreviousResultsFile)
+		script += fmt.Sprintf(`%s
+if [ -s %[2]s ]; then
+  echo "Restored the results of profiling attempt %[3]d"
+else
+  rm -f %[2]s
+  echo "No previous results to re-sweep around"
+fi
+`, transport.RestoreScript(dgdr, PreviousResultsFile), previous, dgdr.Status.Profiling.DifferentialFromAttempt)
+	}
+
+	return corev1.Container{
+		Name:    ContainerNameCheckpointRestorer,
+		Image:   SidecarImage,
+		Command: []string{"/bin/sh", "-c"},
+		Args:    []string{script},
+		VolumeMounts: []corev1.VolumeMount{
+			{Name: VolumeNameProfilingOutput, MountPath: ProfilingOutputPath, ReadOnly: true},
+			{Name: VolumeNameProfilingCheckpoint, MountPath: ProfilingCheckpointPath},
+		},
+	}
+}
+
+// resumeFromCheckpointCommand wraps the profiler command, started with the args
+// --profile-config <yaml>, so that resume_from is added to the config only if the init container
+// restored a checkpoint
+func resumeFromCheckpointCommand(command []string) []string {
+	file := path.Join(ProfilingCheckpointPath, ProfilingCheckpointFile)
+	script := fmt.Sprintf(`if [ -s %[1]s ]; then
+  set -- "$1" "$2
+%[2]s: %[1]s"
+fi
+exec %[3]s "$@"`, file, ConfigKeyResumeFrom, strings.Join(command, " "))
+	return []string{"/bin/sh", "-c", script, ContainerNameProfiler}
+}
+
+// observeProfilingRetries surfaces profiling job pods that failed and were retried by the job, and
+// whether the retry resumed the sweep from a checkpoint
+func (r *DynamoGraphDeploymentRequestReconciler) observeProfilingRetries(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	job := &batchv1.Job{}
+	if err := r.Get(ctx, types.NamespacedName{Name: GetProfilingJobName(dgdr), Namespace: dgdr.Namespace}, job); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	observed := int32(0)
+	if dgdr.Status.Profiling != nil {
+		observed = dgdr.Status.Profiling.FailedAttempts
+	}
+	if job.Status.Failed <= observed {
+		return nil
+	}
+
+	_, err := r.resultTransport(dgdr).FetchCheckpoint(ctx, dgdr)
+	if err != nil && !isResultsMissing(err) {
+		return err
+	}
+	reason, message := EventReasonResumedFromCheckpoint, fmt.Sprintf(MessageResumedFromCheckpoint, job.Status.Failed)
+	if err != nil {
+		reason, message = EventReasonProfilingRestarted, fmt.Sprintf(MessageProfilingRestarted, job.Status.Failed)
+	}
+	log.FromContext(ctx).Info("Profiling job pod failed and was retried", "job", job.Name, "failed", job.Status.Failed, "reason", reason)
+
+	if dgdr.Status.Profiling == nil {
+		dgdr.Status.Profiling = &nvidiacomv1alpha1.ProfilingStatus{}
+	}
+	dgdr.Status.Profiling.FailedAttempts = job.Status.Failed
+	meta.SetStatusCondition(&dgdr.Status.Conditions, metav1.Condition{
+		Type:               ConditionTypeProfiling,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: dgdr.Generation,
+		Reason:             reason,
+		Message:            message,
+	})
+	if err := r.updateStatus(ctx, dgdr); err != nil {
+		return err
+	}
+	r.Recorder.Event(dgdr, corev1.EventTypeWarning, reason, message)
+	return nil
+}
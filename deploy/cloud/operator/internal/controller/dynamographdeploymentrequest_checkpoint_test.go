@@ -0,0 +1,182 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+)
+
+var _ = Describe("DGDR Profiling Checkpoints", func() {
+	var reconciler *DynamoGraphDeploymentRequestReconciler
+
+	BeforeEach(func() {
+		reconciler = &DynamoGraphDeploymentRequestReconciler{
+			Client:      k8sClient,
+			Recorder:    record.NewFakeRecorder(100),
+			RBACManager: &MockRBACManager{},
+		}
+	})
+
+	newDGDR := func(name string, transport nvidiacomv1alpha1.ResultTransport) *nvidiacomv1alpha1.DynamoGraphDeploymentRequest {
+		return &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model:   "Qwen/Qwen3-0.6B",
+				Backend: BackendVLLM,
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					ProfilerImage: "test-profiler:latest",
+					Config: createTestConfig(map[string]interface{}{
+						"sla": map[string]interface{}{"ttft": 200.0, "itl": 20.0},
+					}),
+					ResultTransport: transport,
+				},
+			},
+		}
+	}
+
+	checkpoint := `{"completed": ["prefill_tp1", "prefill_tp2"]}`
+
+	It("Should keep the checkpoint apart from the results of every transport", func() {
+		ctx := context.Background()
+		reconciler.ResultsPVCPath = GinkgoT().TempDir()
+		reconciler.ResultsEndpoint = "https://dynamo-operator-results.dynamo-system.svc:8444"
+
+		for _, transport := range []nvidiacomv1alpha1.ResultTransport{
+			nvidiacomv1alpha1.ResultTransportConfigMap,
+			nvidiacomv1alpha1.ResultTransportSecret,
+			nvidiacomv1alpha1.ResultTransportPVC,
+			nvidiacomv1alpha1.ResultTransportHTTP,
+		} {
+			dgdr := newDGDR("test-dgdr-checkpoint-transport", transport)
+			results := reconciler.resultTransport(dgdr)
+
+			_, err := results.FetchCheckpoint(ctx, dgdr)
+			Expect(isResultsMissing(err)).To(BeTrue(), string(transport))
+
+			// Delivering a checkpoint never completes the results
+			Expect(results.CheckpointScript(dgdr)).ShouldNot(ContainSubstring(ResultsCompleteMarker))
+			Expect(results.Store(ctx, dgdr, map[string]string{ProfilingCheckpointFile: checkpoint})).Should(Succeed())
+
+			restored, err := results.FetchCheckpoint(ctx, dgdr)
+			Expect(err).NotTo(HaveOccurred(), string(transport))
+			Expect(restored).Should(Equal(checkpoint))
+			Expect(results.Delete(ctx, dgdr)).Should(Succeed())
+		}
+	})
+
+	It("Should restore the checkpoint in every profiling job pod", func() {
+		ctx := context.Background()
+		dgdr := newDGDR("test-dgdr-checkpoint-job", "")
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, dgdr) }()
+
+		Expect(reconciler.createProfilingJob(ctx, dgdr)).Should(Succeed())
+		job := &batchv1.Job{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: GetProfilingJobName(dgdr), Namespace: defaultNamespace}, job)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, job) }()
+
+		podSpec := job.Spec.Template.Spec
+		Expect(podSpec.InitContainers).Should(HaveLen(1))
+		restorer := podSpec.InitContainers[0]
+		Expect(restorer.Name).Should(Equal(ContainerNameCheckpointRestorer))
+		Expect(restorer.Args[0]).Should(ContainSubstring(`kubectl get configmap dgdr-output-test-dgdr-checkpoint-job -n default -o jsonpath='{.data.sweep-state\.json}'`))
+
+		// resume_from is only added once the init container restored a checkpoint
+		profiler := podSpec.Containers[0]
+		Expect(profiler.Args[1]).ShouldNot(ContainSubstring(ConfigKeyResumeFrom))
+		Expect(profiler.Command[:2]).Should(Equal([]string{"/bin/sh", "-c"}))
+		Expect(profiler.Command[2]).Should(ContainSubstring("if [ -s /checkpoint/sweep-state.json ]"))
+		Expect(profiler.Command[2]).Should(ContainSubstring("resume_from: /checkpoint/sweep-state.json"))
+		Expect(profiler.Command[2]).Should(ContainSubstring(`exec python -m benchmarks.profiler.profile_sla "$@"`))
+		Expect(profiler.VolumeMounts).Should(ContainElement(HaveField("MountPath", ProfilingCheckpointPath)))
+
+		sidecar := podSpec.Containers[1]
+		Expect(sidecar.Args[0]).Should(ContainSubstring("--from-file=" + CheckpointStagingDir))
+		Expect(sidecar.Args[0]).Should(ContainSubstring("exit 1"))
+	})
+
+	It("Should keep the resume_from set in the profiling config", func() {
+		ctx := context.Background()
+		dgdr := newDGDR("test-dgdr-checkpoint-explicit", "")
+		dgdr.Spec.ProfilingConfig.Config = createTestConfig(map[string]interface{}{
+			"sla":               map[string]interface{}{"ttft": 200.0, "itl": 20.0},
+			ConfigKeyResumeFrom: "/data/sweep-state.json",
+		})
+
+		job, err := reconciler.buildProfilingJob(ctx, dgdr, GetProfilingJobName(dgdr), reconciler.resultTransport(dgdr))
+		Expect(err).NotTo(HaveOccurred())
+		profiler := job.Spec.Template.Spec.Containers[0]
+		Expect(profiler.Command).Should(Equal([]string{"python", "-m", "benchmarks.profiler.profile_sla"}))
+		Expect(profiler.Args[1]).Should(ContainSubstring("resume_from: /data/sweep-state.json"))
+	})
+
+	It("Should surface retried profiling job pods in conditions", func() {
+		ctx := context.Background()
+		dgdr := newDGDR("test-dgdr-checkpoint-retry", "")
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, dgdr) }()
+		dgdr.Status.State = StateProfiling
+		Expect(k8sClient.Status().Update(ctx, dgdr)).Should(Succeed())
+
+		Expect(reconciler.createProfilingJob(ctx, dgdr)).Should(Succeed())
+		job := &batchv1.Job{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: GetProfilingJobName(dgdr), Namespace: defaultNamespace}, job)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, job) }()
+		defer func() {
+			_ = k8sClient.Delete(ctx, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: GetOutputConfigMapName(dgdr), Namespace: defaultNamespace}})
+		}()
+
+		failPod := func(failed int32) *nvidiacomv1alpha1.DynamoGraphDeploymentRequest {
+			job.Status.Failed = failed
+			Expect(k8sClient.Status().Update(ctx, job)).Should(Succeed())
+			Expect(reconciler.observeProfilingRetries(ctx, dgdr)).Should(Succeed())
+
+			updated := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: dgdr.Name, Namespace: defaultNamespace}, updated)).Should(Succeed())
+			return updated
+		}
+
+		// Without a checkpoint the retried pod restarts the sweep
+		updated := failPod(1)
+		Expect(updated.Status.Profiling.FailedAttempts).Should(Equal(int32(1)))
+		condition := meta.FindStatusCondition(updated.Status.Conditions, ConditionTypeProfiling)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Reason).Should(Equal(EventReasonProfilingRestarted))
+
+		Expect(reconciler.resultTransport(dgdr).Store(ctx, dgdr, map[string]string{ProfilingCheckpointFile: checkpoint})).Should(Succeed())
+		updated = failPod(2)
+		Expect(updated.Status.Profiling.FailedAttempts).Should(Equal(int32(2)))
+		condition = meta.FindStatusCondition(updated.Status.Conditions, ConditionTypeProfiling)
+		Expect(condition.Reason).Should(Equal(EventReasonResumedFromCheckpoint))
+		Expect(condition.Message).Should(ContainSubstring("resumed from checkpoint"))
+
+		// Failures already surfaced are not reported again
+		Expect(reconciler.observeProfilingRetries(ctx, dgdr)).Should(Succeed())
+		Expect(dgdr.Status.Profiling.FailedAttempts).Should(Equal(int32(2)))
+	})
+})
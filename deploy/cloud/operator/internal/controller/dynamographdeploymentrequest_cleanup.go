@@ -0,0 +1,118 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/metadata"
+)
+
+// getCleanupPolicy returns the cleanup policy of the DGDR, defaulting to RetainDGD
+func getCleanupPolicy(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) nvidiacomv1alpha1.CleanupPolicy {
+	if dgdr.Spec.CleanupPolicy == "" {
+		return nvidiacomv1alpha1.CleanupPolicyRetainDGD
+	}
+	return dgdr.Spec.CleanupPolicy
+}
+
+// cleanupGeneratedArtifacts deletes or releases the auto-created DGD with its PodMonitors and the
+// profiling results of a deleted DGDR as its cleanup policy says. Released artifacts lose the labels linking them to the
+// DGDR, so the orphan scanner leaves them alone and a new DGDR of the same name does not adopt them.
+func (r *DynamoGraphDeploymentRequestReconciler) cleanupGeneratedArtifacts(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	policy := getCleanupPolicy(dgdr)
+	if err := r.cleanupDeployment(ctx, dgdr, policy == nvidiacomv1alpha1.CleanupPolicyDeleteAll); err != nil {
+		return err
+	}
+	if err := r.cleanupPodMonitors(ctx, dgdr, policy == nvidiacomv1alpha1.CleanupPolicyDeleteAll); err != nil {
+		return err
+	}
+	if policy == nvidiacomv1alpha1.CleanupPolicyRetainAll {
+		return r.releaseResults(ctx, dgdr)
+	}
+	return r.resultTransport(dgdr).Delete(ctx, dgdr)
+}
+
+// cleanupDeployment deletes or releases the DGD the DGDR created. DGDs it merged into with
+// applyToExisting are always released, DGDs relabeled since are left untouched.
+func (r *DynamoGraphDeploymentRequestReconciler) cleanupDeployment(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, remove bool) error {
+	logger := log.FromContext(ctx)
+	if dgdr.Status.Deployment == nil || !dgdr.Status.Deployment.Created {
+		return nil
+	}
+	if isApplyToExisting(dgdr) {
+		return r.releaseExistingDeployment(ctx, dgdr)
+	}
+
+	dgd := &nvidiacomv1alpha1.DynamoGraphDeployment{}
+	key := types.NamespacedName{Name: dgdr.Status.Deployment.Name, Namespace: dgdr.Status.Deployment.Namespace}
+	if err := r.Get(ctx, key, dgd); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	if dgd.Labels[LabelDGDRName] != dgdr.Name || dgd.Labels[LabelDGDRNamespace] != dgdr.Namespace {
+		return nil
+	}
+
+	if remove {
+		logger.Info("Deleting DynamoGraphDeployment with its DGDR", "name", dgd.Name, "namespace", dgd.Namespace)
+		if err := r.Delete(ctx, dgd); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete DynamoGraphDeployment %s: %w", dgd.Name, err)
+		}
+		return nil
+	}
+	logger.Info("Retaining DynamoGraphDeployment", "name", dgd.Name, "namespace", dgd.Namespace)
+	return r.releaseFromDGDR(ctx, dgd, LabelDGDRName, LabelDGDRNamespace)
+}
+
+// releaseResults releases the ConfigMap or Secret holding the profiling results. Results on a PVC
+// or behind an HTTP endpoint are not linked to the DGDR by labels and are kept as they are.
+func (r *DynamoGraphDeploymentRequestReconciler) releaseResults(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	var results client.Object
+	switch getResultTransport(dgdr) {
+	case nvidiacomv1alpha1.ResultTransportConfigMap:
+		results = &corev1.ConfigMap{}
+	case nvidiacomv1alpha1.ResultTransportSecret:
+		results = &corev1.Secret{}
+	default:
+		return nil
+	}
+	if err := r.Get(ctx, types.NamespacedName{Name: GetOutputConfigMapName(dgdr), Namespace: dgdr.Namespace}, results); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	log.FromContext(ctx).Info("Retaining profiling results", "name", results.GetName())
+	return r.releaseFromDGDR(ctx, results, LabelDGDRName)
+}
+
+// releaseFromDGDR removes the labels linking obj to its DGDR
+func (r *DynamoGraphDeploymentRequestReconciler) releaseFromDGDR(ctx context.Context, obj client.Object, labels ...string) error {
+	if !metadata.Strip(obj, labels...) {
+		return nil
+	}
+	if err := r.Update(ctx, obj); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to release %s from its DGDR: %w", obj.GetName(), err)
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("DGDR Cleanup Policy", func() {
+	var reconciler *DynamoGraphDeploymentRequestReconciler
+
+	BeforeEach(func() {
+		reconciler = &DynamoGraphDeploymentRequestReconciler{Client: k8sClient, Recorder: record.NewFakeRecorder(10)}
+	})
+
+	// setup creates the DGD and the profiling results of a deleted DGDR with the given cleanup policy
+	setup := func(ctx context.Context, name string, policy nvidiacomv1alpha1.CleanupPolicy) (*nvidiacomv1alpha1.DynamoGraphDeploymentRequest, *nvidiacomv1alpha1.DynamoGraphDeployment, *corev1.ConfigMap) {
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: defaultNamespace},
+			Spec:       nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{CleanupPolicy: policy},
+			Status: nvidiacomv1alpha1.DynamoGraphDeploymentRequestStatus{
+				Deployment: &nvidiacomv1alpha1.DeploymentStatus{Name: name + "-dgd", Namespace: defaultNamespace, Created: true},
+			},
+		}
+		dgd := &nvidiacomv1alpha1.DynamoGraphDeployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name + "-dgd",
+				Namespace: defaultNamespace,
+				Labels: map[string]string{
+					LabelDGDRName:      name,
+					LabelDGDRNamespace: defaultNamespace,
+					LabelManagedBy:     LabelValueDynamoOperator,
+				},
+			},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentSpec{
+				Services: map[string]*nvidiacomv1alpha1.DynamoComponentDeploymentSharedSpec{"Frontend": {}},
+			},
+		}
+		Expect(k8sClient.Create(ctx, dgd)).Should(Succeed())
+		DeferCleanup(func() { _ = k8sClient.Delete(ctx, dgd) })
+		results := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: GetOutputConfigMapName(dgdr), Namespace: defaultNamespace, Labels: resultLabels(dgdr)},
+			Data:       map[string]string{ProfilingConfigFile: "{}"},
+		}
+		Expect(k8sClient.Create(ctx, results)).Should(Succeed())
+		DeferCleanup(func() { _ = k8sClient.Delete(ctx, results) })
+		return dgdr, dgd, results
+	}
+
+	exists := func(ctx context.Context, obj client.Object) bool {
+		err := k8sClient.Get(ctx, client.ObjectKeyFromObject(obj), obj)
+		if apierrors.IsNotFound(err) {
+			return false
+		}
+		Expect(err).NotTo(HaveOccurred())
+		return true
+	}
+
+	It("Should retain the DGD and delete the results by default", func() {
+		ctx := context.Background()
+		dgdr, dgd, results := setup(ctx, "test-dgdr-cleanup-default", "")
+
+		Expect(reconciler.cleanupGeneratedArtifacts(ctx, dgdr)).Should(Succeed())
+		Expect(exists(ctx, dgd)).To(BeTrue())
+		// The retained DGD is released so the orphan scanner does not report it
+		Expect(dgd.Labels).ShouldNot(HaveKey(LabelDGDRName))
+		Expect(dgd.Labels).ShouldNot(HaveKey(LabelDGDRNamespace))
+		Expect(dgd.Labels).Should(HaveKeyWithValue(LabelManagedBy, LabelValueDynamoOperator))
+		Expect(exists(ctx, results)).To(BeFalse())
+	})
+
+	It("Should delete the DGD and the results with DeleteAll", func() {
+		ctx := context.Background()
+		dgdr, dgd, results := setup(ctx, "test-dgdr-cleanup-delete", nvidiacomv1alpha1.CleanupPolicyDeleteAll)
+
+		Expect(reconciler.cleanupGeneratedArtifacts(ctx, dgdr)).Should(Succeed())
+		Expect(exists(ctx, dgd)).To(BeFalse())
+		Expect(exists(ctx, results)).To(BeFalse())
+	})
+
+	It("Should retain and release the DGD and the results with RetainAll", func() {
+		ctx := context.Background()
+		dgdr, dgd, results := setup(ctx, "test-dgdr-cleanup-retain", nvidiacomv1alpha1.CleanupPolicyRetainAll)
+
+		Expect(reconciler.cleanupGeneratedArtifacts(ctx, dgdr)).Should(Succeed())
+		Expect(exists(ctx, dgd)).To(BeTrue())
+		Expect(dgd.Labels).ShouldNot(HaveKey(LabelDGDRName))
+		Expect(exists(ctx, results)).To(BeTrue())
+		Expect(results.Labels).ShouldNot(HaveKey(LabelDGDRName))
+		Expect(results.Data).Should(HaveKey(ProfilingConfigFile))
+	})
+
+	It("Should not delete DGDs the DGDR did not create", func() {
+		ctx := context.Background()
+		dgdr, dgd, _ := setup(ctx, "test-dgdr-cleanup-existing", nvidiacomv1alpha1.CleanupPolicyDeleteAll)
+		dgdr.Spec.DeploymentOverrides = &nvidiacomv1alpha1.DeploymentOverridesSpec{ApplyToExisting: true}
+
+		Expect(reconciler.cleanupGeneratedArtifacts(ctx, dgdr)).Should(Succeed())
+		Expect(exists(ctx, dgd)).To(BeTrue())
+		Expect(dgd.Labels).ShouldNot(HaveKey(LabelDGDRName))
+
+		// A DGD relabeled to another DGDR is not this DGDR's to delete either
+		dgdr.Spec.DeploymentOverrides = nil
+		dgd.Labels[LabelDGDRName] = "other-dgdr"
+		Expect(k8sClient.Update(ctx, dgd)).Should(Succeed())
+		Expect(reconciler.cleanupGeneratedArtifacts(ctx, dgdr)).Should(Succeed())
+		Expect(exists(ctx, dgd)).To(BeTrue())
+	})
+})
@@ -0,0 +1,343 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/yaml"
+
+	dynamoCommon "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/dynamo/common"
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+)
+
+const (
+	// AnnotationCompareWith requests a one-shot comparison of the DGDR's profiling outcome against the
+	// DGDR it names in the same namespace, e.g. the request profiled before a driver upgrade
+	AnnotationCompareWith = "nvidia.com/dgdr-compare-with"
+
+	// ComparisonBaselineIndex indexes DGDRs by the DGDR their comparison annotation names
+	ComparisonBaselineIndex = "metadata.annotations.compareWith"
+
+	// ConfigMapComparisonPrefix is the name prefix of comparison ConfigMaps
+	ConfigMapComparisonPrefix = "dgdr-comparison-"
+
+	// ComparisonKey is the ConfigMap key holding the comparison
+	ComparisonKey = "comparison.yaml"
+
+	// Event reasons
+	EventReasonComparisonWritten = "ComparisonWritten"
+	EventReasonComparisonFailed  = "ComparisonFailed"
+
+	// Messages
+	MessageComparisonWritten          = "Comparison with %s written to ConfigMap %s"
+	MessageComparisonBaselineNotFound = "DGDR %s to compare with was not found in namespace %s"
+)
+
+// tensorParallelFlags are the backend arguments that set the tensor parallel size of a worker
+var tensorParallelFlags = []string{"--tensor-parallel-size", "--tp-size", "--tp"}
+
+// metricDelta is a value of the baseline and candidate DGDR and their relative change
+type metricDelta struct {
+	Baseline  string `json:"baseline,omitempty"`
+	Candidate string `json:"candidate,omitempty"`
+	// Change is the relative change from baseline to candidate, e.g. "+12.5%", when both are numbers
+	Change string `json:"change,omitempty"`
+}
+
+// comparisonSide identifies a compared DGDR
+type comparisonSide struct {
+	Name    string `json:"name"`
+	Backend string `json:"backend,omitempty"`
+	// ProfiledAt is when the generated deployment of the DGDR was produced
+	ProfiledAt *metav1.Time `json:"profiledAt,omitempty"`
+}
+
+// serviceComparison compares a service of the generated deployments
+type serviceComparison struct {
+	Service            string      `json:"service"`
+	Replicas           metricDelta `json:"replicas"`
+	GPUsPerReplica     metricDelta `json:"gpusPerReplica"`
+	TensorParallelSize metricDelta `json:"tensorParallelSize"`
+}
+
+// dgdrComparison is the structured before/after comparison of two profiled DGDRs
+type dgdrComparison struct {
+	Baseline   comparisonSide      `json:"baseline"`
+	Candidate  comparisonSide      `json:"candidate"`
+	ComparedAt metav1.Time         `json:"comparedAt"`
+	Services   []serviceComparison `json:"services"`
+	// TTFT, ITL and ThroughputPerGPU are the estimates of the selected backends, if reported
+	TTFT             *metricDelta `json:"ttft,omitempty"`
+	ITL              *metricDelta `json:"itl,omitempty"`
+	ThroughputPerGPU *metricDelta `json:"throughputPerGPU,omitempty"`
+}
+
+// getComparisonConfigMapName returns the ConfigMap name a DGDR comparison is written to
+func getComparisonConfigMapName(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) string {
+	return fmt.Sprintf("%s%s", ConfigMapComparisonPrefix, dgdr.Name)
+}
+
+// comparisonRequested reports whether the comparison annotation is set on the DGDR
+func comparisonRequested(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) bool {
+	return dgdr.Annotations[AnnotationCompareWith] != ""
+}
+
+// comparisonBaselineName returns the DGDR a DGDR requests a comparison with, for ComparisonBaselineIndex
+func comparisonBaselineName(obj client.Object) []string {
+	dgdr, ok := obj.(*nvidiacomv1alpha1.DynamoGraphDeploymentRequest)
+	if !ok || !comparisonRequested(dgdr) {
+		return nil
+	}
+	return []string{dgdr.Annotations[AnnotationCompareWith]}
+}
+
+// requestsForComparisonBaseline enqueues the DGDRs whose comparison with a DGDR was deferred until
+// it has a generated deployment
+func (r *DynamoGraphDeploymentRequestReconciler) requestsForComparisonBaseline(ctx context.Context, obj client.Object) []ctrl.Request {
+	baseline, ok := obj.(*nvidiacomv1alpha1.DynamoGraphDeploymentRequest)
+	if !ok || baseline.Status.GeneratedDeployment == nil {
+		return nil
+	}
+	dgdrs := &nvidiacomv1alpha1.DynamoGraphDeploymentRequestList{}
+	if err := r.List(ctx, dgdrs, client.InNamespace(baseline.Namespace),
+		client.MatchingFields{ComparisonBaselineIndex: baseline.Name}); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to list DGDRs comparing with DGDR", "baseline", baseline.Name)
+		return nil
+	}
+	requests := make([]ctrl.Request, 0, len(dgdrs.Items))
+	for _, dgdr := range dgdrs.Items {
+		requests = append(requests, ctrl.Request{NamespacedName: types.NamespacedName{Name: dgdr.Name, Namespace: dgdr.Namespace}})
+	}
+	return requests
+}
+
+// handleComparison writes the comparison of the DGDR against the DGDR named by the comparison
+// annotation to its comparison ConfigMap and clears the annotation. The comparison is deferred until
+// both DGDRs have a generated deployment: the DGDR is reconciled again when it generates its own, and
+// when the DGDR it compares with generates one through requestsForComparisonBaseline.
+func (r *DynamoGraphDeploymentRequestReconciler) handleComparison(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	logger := log.FromContext(ctx)
+	baselineName := dgdr.Annotations[AnnotationCompareWith]
+
+	baseline := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{}
+	err := r.Get(ctx, types.NamespacedName{Name: baselineName, Namespace: dgdr.Namespace}, baseline)
+	if apierrors.IsNotFound(err) {
+		// Retrying will not make the baseline appear, drop the request
+		r.Recorder.Event(dgdr, corev1.EventTypeWarning, EventReasonComparisonFailed,
+			fmt.Sprintf(MessageComparisonBaselineNotFound, baselineName, dgdr.Namespace))
+		return r.clearComparisonRequest(ctx, dgdr)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get DGDR %s to compare with: %w", baselineName, err)
+	}
+
+	if dgdr.Status.GeneratedDeployment == nil || baseline.Status.GeneratedDeployment == nil {
+		logger.Info("Comparison requested but a generated deployment is missing, deferring", "name", dgdr.Name, "baseline", baselineName)
+		return nil
+	}
+
+	comparison, err := compareDGDRs(baseline, dgdr)
+	if err != nil {
+		return err
+	}
+	content, err := yaml.Marshal(comparison)
+	if err != nil {
+		return fmt.Errorf("failed to marshal comparison: %w", err)
+	}
+
+	cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: getComparisonConfigMapName(dgdr), Namespace: dgdr.Namespace}}
+	if _, err := controllerutil.CreateOrUpdate(ctx, r.Client, cm, func() error {
+		cm.Labels = map[string]string{
+			LabelDGDRName:  dgdr.Name,
+			LabelManagedBy: LabelValueDynamoOperator,
+		}
+		cm.Data = map[string]string{ComparisonKey: string(content)}
+		return controllerutil.SetControllerReference(dgdr, cm, r.Client.Scheme())
+	}); err != nil {
+		return fmt.Errorf("failed to write comparison ConfigMap: %w", err)
+	}
+
+	if err := r.clearComparisonRequest(ctx, dgdr); err != nil {
+		return err
+	}
+
+	logger.Info("Wrote DGDR comparison", "configMap", cm.Name, "baseline", baselineName)
+	r.Recorder.Event(dgdr, corev1.EventTypeNormal, EventReasonComparisonWritten,
+		fmt.Sprintf(MessageComparisonWritten, baselineName, cm.Name))
+	r.trackOwnedResource(ctx, dgdr, nvidiacomv1alpha1.OwnedResourceRoleComparison, cm)
+	return r.updateStatus(ctx, dgdr)
+}
+
+// clearComparisonRequest removes the trigger so the comparison runs once per request
+func (r *DynamoGraphDeploymentRequestReconciler) clearComparisonRequest(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	delete(dgdr.Annotations, AnnotationCompareWith)
+	if err := r.Update(ctx, dgdr); err != nil {
+		return fmt.Errorf("failed to clear comparison annotation: %w", err)
+	}
+	return nil
+}
+
+// compareDGDRs compares the generated deployments and estimates of two profiled DGDRs
+func compareDGDRs(baseline, candidate *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) (*dgdrComparison, error) {
+	baselineDGD, err := baseline.BuildDeployment()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the generated deployment of %s: %w", baseline.Name, err)
+	}
+	candidateDGD, err := candidate.BuildDeployment()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the generated deployment of %s: %w", candidate.Name, err)
+	}
+
+	comparison := &dgdrComparison{
+		Baseline:   newComparisonSide(baseline),
+		Candidate:  newComparisonSide(candidate),
+		ComparedAt: metav1.Now(),
+	}
+
+	var services []string
+	for _, dgd := range []*nvidiacomv1alpha1.DynamoGraphDeployment{baselineDGD, candidateDGD} {
+		for service := range dgd.Spec.Services {
+			if !slices.Contains(services, service) {
+				services = append(services, service)
+			}
+		}
+	}
+	slices.Sort(services)
+	for _, service := range services {
+		before := describeService(baseline, baselineDGD.Spec.Services[service])
+		after := describeService(candidate, candidateDGD.Spec.Services[service])
+		comparison.Services = append(comparison.Services, serviceComparison{
+			Service:            service,
+			Replicas:           newMetricDelta(before.replicas, after.replicas),
+			GPUsPerReplica:     newMetricDelta(before.gpus, after.gpus),
+			TensorParallelSize: newMetricDelta(before.tensorParallelSize, after.tensorParallelSize),
+		})
+	}
+
+	before, after := selectedEvaluation(baseline), selectedEvaluation(candidate)
+	if before != nil || after != nil {
+		if before == nil {
+			before = &nvidiacomv1alpha1.BackendEvaluation{}
+		}
+		if after == nil {
+			after = &nvidiacomv1alpha1.BackendEvaluation{}
+		}
+		ttft := newMetricDelta(before.TTFT, after.TTFT)
+		itl := newMetricDelta(before.ITL, after.ITL)
+		throughput := newMetricDelta(before.ThroughputPerGPU, after.ThroughputPerGPU)
+		comparison.TTFT, comparison.ITL, comparison.ThroughputPerGPU = &ttft, &itl, &throughput
+	}
+	return comparison, nil
+}
+
+func newComparisonSide(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) comparisonSide {
+	side := comparisonSide{Name: dgdr.Name, Backend: dgdr.Status.Backend}
+	if side.Backend == "" {
+		side.Backend = dgdr.Spec.Backend
+	}
+	if condition := meta.FindStatusCondition(dgdr.Status.Conditions, ConditionTypeSpecGenerated); condition != nil {
+		side.ProfiledAt = condition.LastTransitionTime.DeepCopy()
+	}
+	return side
+}
+
+// serviceShape is the size of a generated service, values are empty when unknown
+type serviceShape struct {
+	replicas           string
+	gpus               string
+	tensorParallelSize string
+}
+
+// describeService returns the replicas, GPUs per replica and tensor parallel size of a generated service
+func describeService(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, spec *nvidiacomv1alpha1.DynamoComponentDeploymentSharedSpec) serviceShape {
+	var shape serviceShape
+	if spec == nil {
+		return shape
+	}
+	if spec.Replicas != nil {
+		shape.replicas = strconv.Itoa(int(*spec.Replicas))
+	}
+	if gpus := serviceGPUs(spec.Resources); gpus != nil {
+		shape.gpus = gpus.String()
+	} else if spec.Resources != nil {
+		// GPUs of other vendors are requested as their extended resource
+		name := string(gpuResourceName(dgdr))
+		for _, item := range []*dynamoCommon.ResourceItem{spec.Resources.Limits, spec.Resources.Requests} {
+			if item != nil && item.Custom[name] != "" {
+				shape.gpus = item.Custom[name]
+				break
+			}
+		}
+	}
+	if spec.ExtraPodSpec != nil && spec.ExtraPodSpec.MainContainer != nil {
+		shape.tensorParallelSize = tensorParallelSize(spec.ExtraPodSpec.MainContainer.Args)
+	}
+	return shape
+}
+
+// tensorParallelSize returns the tensor parallel size set by the worker arguments, which may be
+// separate arguments or a single shell command
+func tensorParallelSize(args []string) string {
+	tokens := strings.Fields(strings.Join(args, " "))
+	for i, token := range tokens {
+		for _, flag := range tensorParallelFlags {
+			if value, found := strings.CutPrefix(token, flag+"="); found {
+				return value
+			}
+			if token == flag && i+1 < len(tokens) {
+				return tokens[i+1]
+			}
+		}
+	}
+	return ""
+}
+
+// selectedEvaluation returns the estimates of the backend the deployment was generated for
+func selectedEvaluation(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) *nvidiacomv1alpha1.BackendEvaluation {
+	for i := range dgdr.Status.BackendComparison {
+		if dgdr.Status.BackendComparison[i].Selected {
+			return &dgdr.Status.BackendComparison[i]
+		}
+	}
+	return nil
+}
+
+func newMetricDelta(baseline, candidate string) metricDelta {
+	delta := metricDelta{Baseline: baseline, Candidate: candidate}
+	before, errBefore := strconv.ParseFloat(baseline, 64)
+	after, errAfter := strconv.ParseFloat(candidate, 64)
+	if errBefore != nil || errAfter != nil || before == 0 {
+		return delta
+	}
+	delta.Change = fmt.Sprintf("%+.1f%%", (after-before)/before*100)
+	return delta
+}
@@ -0,0 +1,154 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/yaml"
+)
+
+var _ = Describe("DGDR Comparison", func() {
+	var reconciler *DynamoGraphDeploymentRequestReconciler
+	var recorder *record.FakeRecorder
+
+	BeforeEach(func() {
+		recorder = record.NewFakeRecorder(100)
+		reconciler = &DynamoGraphDeploymentRequestReconciler{
+			Client:      k8sClient,
+			Recorder:    recorder,
+			RBACManager: &MockRBACManager{},
+		}
+	})
+
+	// createProfiled creates a DGDR whose decode worker was generated with the given size and estimates
+	createProfiled := func(ctx context.Context, name string, replicas, tp int, throughput string) *nvidiacomv1alpha1.DynamoGraphDeploymentRequest {
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model:   "Qwen/Qwen3-0.6B",
+				Backend: BackendAuto,
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					ProfilerImage: "test-profiler:latest",
+					Config:        createTestConfig(map[string]interface{}{"sweep": map[string]interface{}{"use_ai_configurator": true}}),
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		DeferCleanup(func() { _ = k8sClient.Delete(ctx, dgdr) })
+
+		dgdr.Status.State = StateReady
+		dgdr.Status.Backend = BackendVLLM
+		dgdr.Status.GeneratedDeployment = &runtime.RawExtension{Raw: []byte(fmt.Sprintf(
+			`{"apiVersion":"nvidia.com/v1alpha1","kind":"DynamoGraphDeployment","metadata":{"name":%q},"spec":{"services":{`+
+				`"Frontend":{"replicas":1},`+
+				`"VllmDecodeWorker":{"replicas":%d,"resources":{"limits":{"gpu":"%d"}},`+
+				`"extraPodSpec":{"mainContainer":{"args":["python3 -m dynamo.vllm --tensor-parallel-size %d"]}}}}}}`,
+			name, replicas, tp, tp))}
+		dgdr.Status.BackendComparison = []nvidiacomv1alpha1.BackendEvaluation{
+			{Backend: BackendSGLang, Feasible: true, TTFT: "90", ITL: "12", ThroughputPerGPU: "100"},
+			{Backend: BackendVLLM, Feasible: true, TTFT: "80", ITL: "10", ThroughputPerGPU: throughput, Selected: true},
+		}
+		Expect(k8sClient.Status().Update(ctx, dgdr)).Should(Succeed())
+		return dgdr
+	}
+
+	It("Should write the before/after deltas to a ConfigMap and clear the request", func() {
+		ctx := context.Background()
+		createProfiled(ctx, "test-dgdr-compare-before", 4, 1, "200")
+		after := createProfiled(ctx, "test-dgdr-compare-after", 2, 2, "250")
+
+		after.Annotations = map[string]string{AnnotationCompareWith: "test-dgdr-compare-before"}
+		Expect(k8sClient.Update(ctx, after)).Should(Succeed())
+		Expect(reconciler.handleComparison(ctx, after)).Should(Succeed())
+		Expect(recorder.Events).Should(Receive(ContainSubstring(EventReasonComparisonWritten)))
+
+		updated := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: after.Name, Namespace: defaultNamespace}, updated)).Should(Succeed())
+		Expect(updated.Annotations).NotTo(HaveKey(AnnotationCompareWith))
+
+		cm := &corev1.ConfigMap{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: getComparisonConfigMapName(after), Namespace: defaultNamespace}, cm)).Should(Succeed())
+		Expect(cm.OwnerReferences).Should(HaveLen(1))
+		comparison := &dgdrComparison{}
+		Expect(yaml.Unmarshal([]byte(cm.Data[ComparisonKey]), comparison)).Should(Succeed())
+
+		Expect(comparison.Baseline.Name).Should(Equal("test-dgdr-compare-before"))
+		Expect(comparison.Candidate.Backend).Should(Equal(BackendVLLM))
+		Expect(comparison.Services).Should(HaveLen(2))
+		Expect(comparison.Services[0].Service).Should(Equal("Frontend"))
+		Expect(comparison.Services[1]).Should(Equal(serviceComparison{
+			Service:            "VllmDecodeWorker",
+			Replicas:           metricDelta{Baseline: "4", Candidate: "2", Change: "-50.0%"},
+			GPUsPerReplica:     metricDelta{Baseline: "1", Candidate: "2", Change: "+100.0%"},
+			TensorParallelSize: metricDelta{Baseline: "1", Candidate: "2", Change: "+100.0%"},
+		}))
+		Expect(*comparison.ThroughputPerGPU).Should(Equal(metricDelta{Baseline: "200", Candidate: "250", Change: "+25.0%"}))
+		Expect(*comparison.TTFT).Should(Equal(metricDelta{Baseline: "80", Candidate: "80", Change: "+0.0%"}))
+	})
+
+	It("Should drop the request when the baseline does not exist", func() {
+		ctx := context.Background()
+		dgdr := createProfiled(ctx, "test-dgdr-compare-missing", 1, 1, "100")
+		dgdr.Annotations = map[string]string{AnnotationCompareWith: "does-not-exist"}
+		Expect(k8sClient.Update(ctx, dgdr)).Should(Succeed())
+
+		Expect(reconciler.handleComparison(ctx, dgdr)).Should(Succeed())
+		Expect(recorder.Events).Should(Receive(ContainSubstring(EventReasonComparisonFailed)))
+		Expect(dgdr.Annotations).NotTo(HaveKey(AnnotationCompareWith))
+	})
+
+	It("Should compare deferred DGDRs again once the DGDR they compare with is profiled", func() {
+		ctx := context.Background()
+		newDGDR := func(name string, annotations map[string]string) *nvidiacomv1alpha1.DynamoGraphDeploymentRequest {
+			return &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: defaultNamespace, Annotations: annotations},
+			}
+		}
+		baseline := newDGDR("test-dgdr-compare-baseline", nil)
+		candidate := newDGDR("test-dgdr-compare-candidate", map[string]string{AnnotationCompareWith: baseline.Name})
+		other := newDGDR("test-dgdr-compare-other", map[string]string{AnnotationCompareWith: "other"})
+		reconciler.Client = fake.NewClientBuilder().WithScheme(k8sClient.Scheme()).
+			WithIndex(&nvidiacomv1alpha1.DynamoGraphDeploymentRequest{}, ComparisonBaselineIndex, comparisonBaselineName).
+			WithObjects(baseline, candidate, other).Build()
+
+		// The comparison stays deferred until the baseline has a generated deployment
+		Expect(reconciler.requestsForComparisonBaseline(ctx, baseline)).Should(BeEmpty())
+
+		baseline.Status.GeneratedDeployment = &runtime.RawExtension{Raw: []byte(`{}`)}
+		Expect(reconciler.requestsForComparisonBaseline(ctx, baseline)).Should(ConsistOf(
+			ctrl.Request{NamespacedName: types.NamespacedName{Name: candidate.Name, Namespace: defaultNamespace}}))
+	})
+
+	It("Should read the tensor parallel size from separate or joined arguments", func() {
+		Expect(tensorParallelSize([]string{"--model", "m", "--tensor-parallel-size", "4"})).Should(Equal("4"))
+		Expect(tensorParallelSize([]string{"python3 -m dynamo.sglang --tp-size=2"})).Should(Equal("2"))
+		Expect(tensorParallelSize([]string{"--model", "m"})).Should(BeEmpty())
+	})
+})
@@ -0,0 +1,380 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/yaml"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+)
+
+// embeddedCompatibilityMatrix is the compatibility matrix shipped with the operator
+//
+//go:embed data/compatibility_matrix.yaml
+var embeddedCompatibilityMatrix []byte
+
+const (
+	// CompatibilityMatrixConfigMapName is the ConfigMap the operator publishes its compatibility matrix in
+	CompatibilityMatrixConfigMapName = "dgdr-compatibility-matrix"
+	// CompatibilityMatrixKey is the key of the matrix in the ConfigMap
+	CompatibilityMatrixKey = "matrix.yaml"
+	// AnnotationCompatibilityMatrixSource records where the published matrix was loaded from
+	AnnotationCompatibilityMatrixSource = "nvidia.com/compatibility-matrix-source"
+
+	// CompatibilityMatrixSourceEmbedded is the source of the matrix shipped with the operator
+	CompatibilityMatrixSourceEmbedded = "embedded"
+
+	// DefaultCompatibilityMatrixRefreshInterval is how often the matrix is refreshed from its URL
+	DefaultCompatibilityMatrixRefreshInterval = 24 * time.Hour
+
+	// compatibilityMatrixPublishRetryInterval is how soon a failed publication of the matrix is retried
+	compatibilityMatrixPublishRetryInterval = time.Minute
+
+	// maxCompatibilityMatrixBytes bounds the size of a downloaded matrix
+	maxCompatibilityMatrixBytes = 1 << 20
+
+	// Backend support levels of the compatibility matrix
+	BackendSupported    = "supported"
+	BackendExperimental = "experimental"
+	BackendUnsupported  = "unsupported"
+
+	// Validation messages
+	ValidationErrorIncompatibleBackend   = "model %s (%s) is known not to run on backend %s"
+	ValidationErrorNoCompatibleCandidate = "model %s (%s) is known not to run on any of the candidate backends %s"
+	MessageExperimentalBackend           = "support of model %s (%s) on backend %s is experimental"
+	MessageUnsupportedCandidates         = "model %s (%s) is known not to run on candidate backends %s, exclude them with spec.backendPreference"
+)
+
+// CompatibilityMatrix lists known-good and known-impossible combinations of models, backends and
+// GPU memory, so DGDRs that cannot succeed fail before profiling instead of hours into it
+type CompatibilityMatrix struct {
+	// Systems maps the sweep.aic_system names of GPU types to their memory per GPU in GiB
+	Systems map[string]float64 `json:"systems,omitempty"`
+
+	// Models are matched against spec.model in order, the first match applies
+	Models []CompatibilityEntry `json:"models"`
+
+	// Features maps the features of spec.features to the support level of each backend. Backends
+	// that are not listed are not checked.
+	Features map[string]map[string]string `json:"features,omitempty"`
+}
+
+// CompatibilityEntry is the compatibility of the models of an architecture
+type CompatibilityEntry struct {
+	// Architecture is the model architecture, reported in messages
+	Architecture string `json:"architecture"`
+
+	// Models are case-insensitive glob patterns of the model names of the entry
+	Models []string `json:"models"`
+
+	// MinGPUMemoryGiB is the GPU memory a single engine needs to hold the model, unchecked if zero
+	MinGPUMemoryGiB float64 `json:"minGPUMemoryGiB,omitempty"`
+
+	// Backends maps backends to their support level. Backends that are not listed are not checked.
+	Backends map[string]string `json:"backends,omitempty"`
+}
+
+// ParseCompatibilityMatrix parses and validates a YAML compatibility matrix
+func ParseCompatibilityMatrix(data []byte) (*CompatibilityMatrix, error) {
+	matrix := &CompatibilityMatrix{}
+	if err := yaml.UnmarshalStrict(data, matrix); err != nil {
+		return nil, fmt.Errorf("failed to parse compatibility matrix: %w", err)
+	}
+	for i, entry := range matrix.Models {
+		if len(entry.Models) == 0 {
+			return nil, fmt.Errorf("compatibility matrix entry %d matches no models", i)
+		}
+		for _, pattern := range entry.Models {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return nil, fmt.Errorf("invalid model pattern %q of compatibility matrix entry %d: %w", pattern, i, err)
+			}
+		}
+		for backend, support := range entry.Backends {
+			if support != BackendSupported && support != BackendExperimental && support != BackendUnsupported {
+				return nil, fmt.Errorf("invalid support %q of backend %s in compatibility matrix entry %d", support, backend, i)
+			}
+		}
+	}
+	for feature, backends := range matrix.Features {
+		for backend, support := range backends {
+			if support != BackendSupported && support != BackendExperimental && support != BackendUnsupported {
+				return nil, fmt.Errorf("invalid support %q of backend %s for feature %s in compatibility matrix", support, backend, feature)
+			}
+		}
+	}
+	return matrix, nil
+}
+
+// lookup returns the entry of a model, nil if the model is not in the matrix
+func (m *CompatibilityMatrix) lookup(model string) *CompatibilityEntry {
+	model = strings.ToLower(model)
+	for i := range m.Models {
+		for _, pattern := range m.Models[i].Models {
+			if matched, _ := path.Match(strings.ToLower(pattern), model); matched {
+				return &m.Models[i]
+			}
+		}
+	}
+	return nil
+}
+
+// CompatibilityMatrixStore holds the current compatibility matrix, which is refreshed concurrently
+// with validation
+type CompatibilityMatrixStore struct {
+	mu     sync.RWMutex
+	matrix *CompatibilityMatrix
+	raw    []byte
+	source string
+	// changed is closed by the next change of the matrix
+	changed chan struct{}
+}
+
+// NewCompatibilityMatrixStore returns a store holding the matrix shipped with the operator
+func NewCompatibilityMatrixStore() (*CompatibilityMatrixStore, error) {
+	matrix, err := ParseCompatibilityMatrix(embeddedCompatibilityMatrix)
+	if err != nil {
+		return nil, err
+	}
+	return &CompatibilityMatrixStore{matrix: matrix, raw: embeddedCompatibilityMatrix, source: CompatibilityMatrixSourceEmbedded}, nil
+}
+
+// Get returns the current matrix
+func (s *CompatibilityMatrixStore) Get() *CompatibilityMatrix {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.matrix
+}
+
+// set replaces the current matrix
+func (s *CompatibilityMatrixStore) set(matrix *CompatibilityMatrix, raw []byte, source string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.matrix, s.raw, s.source = matrix, raw, source
+	if s.changed != nil {
+		close(s.changed)
+		s.changed = nil
+	}
+}
+
+// updated returns a channel that is closed when the matrix changes next
+func (s *CompatibilityMatrixStore) updated() <-chan struct{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.changed == nil {
+		s.changed = make(chan struct{})
+	}
+	return s.changed
+}
+
+// snapshot returns the current matrix document and its source
+func (s *CompatibilityMatrixStore) snapshot() ([]byte, string) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.raw, s.source
+}
+
+// CompatibilityMatrixRefresher periodically refreshes the compatibility matrix from a URL. Every
+// replica validates DGDRs against its own store, so every replica refreshes it.
+type CompatibilityMatrixRefresher struct {
+	Store *CompatibilityMatrixStore
+
+	// URL serves the matrix as YAML. The embedded matrix is kept if empty or unreachable.
+	URL string
+
+	// Interval is how often the matrix is refreshed, DefaultCompatibilityMatrixRefreshInterval if zero
+	Interval time.Duration
+
+	// HTTPClient fetches the matrix, http.DefaultClient if nil
+	HTTPClient *http.Client
+}
+
+// NeedLeaderElection is false, the matrix is read by every replica
+func (c *CompatibilityMatrixRefresher) NeedLeaderElection() bool {
+	return false
+}
+
+// Start refreshes the matrix until ctx is cancelled
+func (c *CompatibilityMatrixRefresher) Start(ctx context.Context) error {
+	if c.URL == "" {
+		return nil
+	}
+	interval := c.Interval
+	if interval == 0 {
+		interval = DefaultCompatibilityMatrixRefreshInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		// A stale matrix is better than none, failures are retried on the next tick
+		if err := c.Refresh(ctx); err != nil {
+			log.FromContext(ctx).Error(err, "Failed to refresh the compatibility matrix", "url", c.URL)
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Refresh downloads the matrix from the URL, keeping the current matrix if the download fails
+func (c *CompatibilityMatrixRefresher) Refresh(ctx context.Context) error {
+	raw, err := c.fetch(ctx)
+	if err != nil {
+		return err
+	}
+	matrix, err := ParseCompatibilityMatrix(raw)
+	if err != nil {
+		return err
+	}
+	c.Store.set(matrix, raw, c.URL)
+	return nil
+}
+
+func (c *CompatibilityMatrixRefresher) fetch(ctx context.Context) ([]byte, error) {
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to download compatibility matrix: %s", resp.Status)
+	}
+	raw, err := io.ReadAll(io.LimitReader(resp.Body, maxCompatibilityMatrixBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(raw) > maxCompatibilityMatrixBytes {
+		return nil, fmt.Errorf("compatibility matrix exceeds %d bytes", maxCompatibilityMatrixBytes)
+	}
+	return raw, nil
+}
+
+// CompatibilityMatrixPublisher publishes the current compatibility matrix in a ConfigMap whenever it
+// changes, so users can look up what the operator validates against
+type CompatibilityMatrixPublisher struct {
+	Client client.Client
+	Store  *CompatibilityMatrixStore
+
+	// Namespace is where the ConfigMap is published
+	Namespace string
+}
+
+// NeedLeaderElection publishes the ConfigMap from a single replica
+func (c *CompatibilityMatrixPublisher) NeedLeaderElection() bool {
+	return true
+}
+
+// Start publishes the matrix, and again on every change, until ctx is cancelled
+func (c *CompatibilityMatrixPublisher) Start(ctx context.Context) error {
+	for {
+		changed := c.Store.updated()
+		var retry <-chan time.Time
+		if err := c.publish(ctx); err != nil {
+			log.FromContext(ctx).Error(err, "Failed to publish the compatibility matrix", "namespace", c.Namespace)
+			retry = time.After(compatibilityMatrixPublishRetryInterval)
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-changed:
+		case <-retry:
+		}
+	}
+}
+
+func (c *CompatibilityMatrixPublisher) publish(ctx context.Context) error {
+	raw, source := c.Store.snapshot()
+	cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: CompatibilityMatrixConfigMapName, Namespace: c.Namespace}}
+	_, err := controllerutil.CreateOrUpdate(ctx, c.Client, cm, func() error {
+		if cm.Labels == nil {
+			cm.Labels = map[string]string{}
+		}
+		cm.Labels[LabelManagedBy] = LabelValueDynamoOperator
+		if cm.Annotations == nil {
+			cm.Annotations = map[string]string{}
+		}
+		cm.Annotations[AnnotationCompatibilityMatrixSource] = source
+		cm.Data = map[string]string{CompatibilityMatrixKey: string(raw)}
+		return nil
+	})
+	return err
+}
+
+// validateCompatibility fails DGDRs whose model is known not to run on the requested backend, and
+// warns about experimental combinations. Whether the model fits the GPUs is checked by validateModelFit.
+func (r *DynamoGraphDeploymentRequestReconciler) validateCompatibility(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	if r.CompatibilityMatrix == nil {
+		return nil
+	}
+	matrix := r.CompatibilityMatrix.Get()
+	entry := matrix.lookup(modelName(dgdr))
+	if entry == nil {
+		return nil
+	}
+
+	if dgdr.Spec.Backend == BackendAuto {
+		var unsupported []string
+		candidates := candidateBackends(dgdr)
+		for _, backend := range candidates {
+			if entry.Backends[backend] == BackendUnsupported {
+				unsupported = append(unsupported, backend)
+			}
+		}
+		if len(unsupported) == len(candidates) {
+			return fmt.Errorf(ValidationErrorNoCompatibleCandidate, modelName(dgdr), entry.Architecture, strings.Join(candidates, ", "))
+		}
+		if len(unsupported) > 0 {
+			setWarning(dgdr, WarningIncompatibleBackend,
+				fmt.Sprintf(MessageUnsupportedCandidates, modelName(dgdr), entry.Architecture, strings.Join(unsupported, ", ")))
+		}
+	} else {
+		switch entry.Backends[dgdr.Spec.Backend] {
+		case BackendUnsupported:
+			return fmt.Errorf(ValidationErrorIncompatibleBackend, modelName(dgdr), entry.Architecture, dgdr.Spec.Backend)
+		case BackendExperimental:
+			setWarning(dgdr, WarningIncompatibleBackend,
+				fmt.Sprintf(MessageExperimentalBackend, modelName(dgdr), entry.Architecture, dgdr.Spec.Backend))
+		}
+	}
+
+	return nil
+}
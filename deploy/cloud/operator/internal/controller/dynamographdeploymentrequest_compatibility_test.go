@@ -0,0 +1,176 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+)
+
+var _ = Describe("DGDR Compatibility Matrix", func() {
+	const testMatrix = `
+systems:
+  h100_sxm: 80
+models:
+- architecture: DeepseekV3ForCausalLM
+  models: ["deepseek-ai/DeepSeek-R1*"]
+  minGPUMemoryGiB: 640
+  backends:
+    vllm: supported
+    sglang: experimental
+    trtllm: unsupported
+`
+	var reconciler *DynamoGraphDeploymentRequestReconciler
+
+	BeforeEach(func() {
+		store, err := NewCompatibilityMatrixStore()
+		Expect(err).NotTo(HaveOccurred())
+		matrix, err := ParseCompatibilityMatrix([]byte(testMatrix))
+		Expect(err).NotTo(HaveOccurred())
+		store.set(matrix, []byte(testMatrix), "test")
+
+		reconciler = &DynamoGraphDeploymentRequestReconciler{
+			Client:              k8sClient,
+			Recorder:            record.NewFakeRecorder(100),
+			RBACManager:         &MockRBACManager{},
+			CompatibilityMatrix: store,
+		}
+	})
+
+	newDGDR := func(model, backend string, maxGPUs float64) *nvidiacomv1alpha1.DynamoGraphDeploymentRequest {
+		return &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-dgdr-compatibility", Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model:   model,
+				Backend: backend,
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					ProfilerImage: "test-profiler:latest",
+					Config: createTestConfig(map[string]interface{}{
+						"hardware": map[string]interface{}{"max_num_gpus_per_engine": maxGPUs},
+						"sweep":    map[string]interface{}{"use_ai_configurator": true, "aic_system": "h100_sxm"},
+					}),
+				},
+			},
+		}
+	}
+
+	It("Should parse the embedded matrix", func() {
+		store, err := NewCompatibilityMatrixStore()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(store.Get().Models).NotTo(BeEmpty())
+		Expect(store.Get().lookup("Qwen/Qwen3-0.6B")).NotTo(BeNil())
+		Expect(store.Get().lookup("example/unknown-model")).To(BeNil())
+	})
+
+	It("Should reject invalid matrices", func() {
+		for _, matrix := range []string{
+			"models:\n- architecture: A\n",
+			"models:\n- architecture: A\n  models: [\"[\"]\n",
+			"models:\n- architecture: A\n  models: [a]\n  backends:\n    vllm: maybe\n",
+			"model: []\n",
+		} {
+			_, err := ParseCompatibilityMatrix([]byte(matrix))
+			Expect(err).To(HaveOccurred(), matrix)
+		}
+	})
+
+	It("Should fail combinations known to be impossible", func() {
+		Expect(reconciler.validateCompatibility(newDGDR("deepseek-ai/DeepSeek-R1", BackendVLLM, 8))).Should(Succeed())
+		Expect(reconciler.validateCompatibility(newDGDR("example/unknown-model", BackendTRTLLM, 1))).Should(Succeed())
+
+		err := reconciler.validateCompatibility(newDGDR("deepseek-ai/deepseek-r1-0528", BackendTRTLLM, 8))
+		Expect(err).To(MatchError(fmt.Sprintf(ValidationErrorIncompatibleBackend, "deepseek-ai/deepseek-r1-0528", "DeepseekV3ForCausalLM", BackendTRTLLM)))
+
+		err = reconciler.validateModelFit(newDGDR("deepseek-ai/DeepSeek-R1", BackendVLLM, 4))
+		Expect(err).To(MatchError(ContainSubstring("needs at least 640GiB of GPU memory per engine")))
+
+		auto := newDGDR("deepseek-ai/DeepSeek-R1", BackendAuto, 8)
+		auto.Spec.BackendPreference = []nvidiacomv1alpha1.CandidateBackend{BackendTRTLLM}
+		Expect(reconciler.validateCompatibility(auto)).To(MatchError(ContainSubstring("any of the candidate backends trtllm")))
+	})
+
+	It("Should warn about experimental and unsupported candidates", func() {
+		dgdr := newDGDR("deepseek-ai/DeepSeek-R1", BackendSGLang, 8)
+		Expect(reconciler.validateCompatibility(dgdr)).Should(Succeed())
+		Expect(dgdr.Status.Warnings).Should(ContainElement(HaveField("Type", WarningIncompatibleBackend)))
+
+		auto := newDGDR("deepseek-ai/DeepSeek-R1", BackendAuto, 8)
+		Expect(reconciler.validateCompatibility(auto)).Should(Succeed())
+		Expect(auto.Status.Warnings).Should(ContainElement(HaveField("Message", ContainSubstring("candidate backends trtllm"))))
+	})
+
+	It("Should refresh the matrix from its URL and publish it", func() {
+		ctx := context.Background()
+		refreshed := "models:\n- architecture: Test\n  models: [\"example/*\"]\n  backends:\n    vllm: unsupported\n"
+		served := refreshed
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(served))
+		}))
+		defer server.Close()
+
+		refresher := &CompatibilityMatrixRefresher{Store: reconciler.CompatibilityMatrix, URL: server.URL}
+		publisher := &CompatibilityMatrixPublisher{Client: k8sClient, Store: reconciler.CompatibilityMatrix, Namespace: defaultNamespace}
+		Expect(refresher.NeedLeaderElection()).To(BeFalse())
+		Expect(publisher.NeedLeaderElection()).To(BeTrue())
+
+		publishCtx, cancel := context.WithCancel(ctx)
+		done := make(chan error)
+		go func() { done <- publisher.Start(publishCtx) }()
+		defer func() {
+			cancel()
+			Expect(<-done).Should(Succeed())
+		}()
+		cm := &corev1.ConfigMap{}
+		key := types.NamespacedName{Name: CompatibilityMatrixConfigMapName, Namespace: defaultNamespace}
+		defer func() {
+			_ = k8sClient.Delete(ctx, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace}})
+		}()
+		Eventually(func() (map[string]string, error) {
+			err := k8sClient.Get(ctx, key, cm)
+			return cm.Annotations, err
+		}).Should(HaveKeyWithValue(AnnotationCompatibilityMatrixSource, "test"))
+
+		// The publisher follows the matrix the refresher downloads
+		Expect(refresher.Refresh(ctx)).Should(Succeed())
+		Expect(reconciler.validateCompatibility(newDGDR("example/model", BackendVLLM, 1))).To(HaveOccurred())
+		Eventually(func() (map[string]string, error) {
+			err := k8sClient.Get(ctx, key, cm)
+			return cm.Data, err
+		}).Should(HaveKeyWithValue(CompatibilityMatrixKey, refreshed))
+		Expect(cm.Annotations).Should(HaveKeyWithValue(AnnotationCompatibilityMatrixSource, server.URL))
+
+		// A broken download keeps the last good matrix
+		served = "models: [[["
+		Expect(refresher.Refresh(ctx)).To(HaveOccurred())
+		Expect(reconciler.validateCompatibility(newDGDR("example/model", BackendVLLM, 1))).To(HaveOccurred())
+		Consistently(func() (map[string]string, error) {
+			err := k8sClient.Get(ctx, key, cm)
+			return cm.Data, err
+		}, "200ms").Should(HaveKeyWithValue(CompatibilityMatrixKey, refreshed))
+	})
+})
@@ -0,0 +1,233 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+)
+
+const (
+	// ProfilingDurationsConfigMapName is the ConfigMap the profiling duration history is kept in
+	ProfilingDurationsConfigMapName = "dgdr-profiling-durations"
+	// ProfilingDurationsKey is the key of the history in the ConfigMap
+	ProfilingDurationsKey = "durations.json"
+
+	// profilingDurationWindow is how many recent durations are kept per bucket
+	profilingDurationWindow = 20
+	// profilingDurationMinSamples is how many durations a bucket needs before it is used for estimates
+	profilingDurationMinSamples = 3
+)
+
+// modelSizeBuckets are the upper bounds, in billions of parameters, of the model size buckets
+// profiling durations are grouped by
+var modelSizeBuckets = []struct {
+	maxB   float64
+	bucket string
+}{
+	{maxB: 10, bucket: "lt10b"},
+	{maxB: 40, bucket: "10b-40b"},
+	{maxB: 100, bucket: "40b-100b"},
+}
+
+// modelSizeBucket returns the size bucket of a model with the given parameter count, unknown if
+// its name gives none
+func modelSizeBucket(paramsB float64) string {
+	if paramsB <= 0 {
+		return "unknown"
+	}
+	for _, bucket := range modelSizeBuckets {
+		if paramsB < bucket.maxB {
+			return bucket.bucket
+		}
+	}
+	return "gt100b"
+}
+
+// profilingDurationKey returns the bucket of past profiling durations the DGDR is estimated from:
+// the size bucket of its model, its backend and its profiling mode
+func profilingDurationKey(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) string {
+	return fmt.Sprintf("%s/%s/%s", modelSizeBucket(modelParamsB(modelName(dgdr))), dgdr.Spec.Backend, getProfilingMode(dgdr))
+}
+
+// ProfilingDurationHistory keeps the durations of the most recent successful profiling runs per
+// bucket of similar DGDRs, to estimate when new profiling jobs complete. The history is kept in
+// memory and, if a namespace is set, in a ConfigMap so it survives operator restarts and is shared
+// by operator replicas.
+type ProfilingDurationHistory struct {
+	Client client.Client
+
+	// Namespace is where the history ConfigMap is kept, it is only kept in memory if empty
+	Namespace string
+
+	mu sync.Mutex
+	// durations are the recent durations per bucket in seconds, oldest first
+	durations map[string][]int64
+}
+
+// NewProfilingDurationHistory returns an empty history kept in the ConfigMap of the namespace
+func NewProfilingDurationHistory(c client.Client, namespace string) *ProfilingDurationHistory {
+	return &ProfilingDurationHistory{Client: c, Namespace: namespace, durations: map[string][]int64{}}
+}
+
+// Estimate returns the median of the recent durations of the bucket, false if it has too few. The
+// history is re-read for every estimate as other replicas record runs too, the history last read is
+// used if it cannot be.
+func (h *ProfilingDurationHistory) Estimate(ctx context.Context, key string) (time.Duration, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if durations, err := h.load(ctx); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to load the profiling duration history")
+	} else {
+		h.durations = durations
+	}
+	recent := h.durations[key]
+	if len(recent) < profilingDurationMinSamples {
+		return 0, false
+	}
+	sorted := slices.Clone(recent)
+	slices.Sort(sorted)
+	return time.Duration(sorted[len(sorted)/2]) * time.Second, true
+}
+
+// Record adds the duration of a successful profiling run to its bucket, dropping the oldest beyond
+// the window. The ConfigMap is re-read before it is written so replicas do not lose each other's runs.
+func (h *ProfilingDurationHistory) Record(ctx context.Context, key string, duration time.Duration) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	add := func(durations map[string][]int64) {
+		recent := append(durations[key], int64(duration.Round(time.Second).Seconds()))
+		if len(recent) > profilingDurationWindow {
+			recent = recent[len(recent)-profilingDurationWindow:]
+		}
+		durations[key] = recent
+	}
+	if h.Namespace == "" {
+		add(h.durations)
+		return nil
+	}
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cm := &corev1.ConfigMap{}
+		err := h.Client.Get(ctx, client.ObjectKey{Name: ProfilingDurationsConfigMapName, Namespace: h.Namespace}, cm)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to get the profiling duration history: %w", err)
+		}
+		durations, err := decodeProfilingDurations(cm)
+		if err != nil {
+			return err
+		}
+		add(durations)
+		content, err := json.Marshal(durations)
+		if err != nil {
+			return fmt.Errorf("failed to marshal the profiling duration history: %w", err)
+		}
+		if cm.Name == "" {
+			cm = &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      ProfilingDurationsConfigMapName,
+					Namespace: h.Namespace,
+					Labels:    map[string]string{LabelManagedBy: LabelValueDynamoOperator},
+				},
+				Data: map[string]string{ProfilingDurationsKey: string(content)},
+			}
+			err = h.Client.Create(ctx, cm)
+			if apierrors.IsAlreadyExists(err) {
+				// Created by another replica meanwhile, retried as a conflict
+				return apierrors.NewConflict(corev1.Resource("configmaps"), cm.Name, err)
+			}
+		} else {
+			cm.Data = map[string]string{ProfilingDurationsKey: string(content)}
+			err = h.Client.Update(ctx, cm)
+		}
+		if err != nil {
+			return err
+		}
+		h.durations = durations
+		return nil
+	})
+}
+
+// load reads the history from its ConfigMap
+func (h *ProfilingDurationHistory) load(ctx context.Context) (map[string][]int64, error) {
+	if h.Namespace == "" {
+		return h.durations, nil
+	}
+	cm := &corev1.ConfigMap{}
+	if err := h.Client.Get(ctx, client.ObjectKey{Name: ProfilingDurationsConfigMapName, Namespace: h.Namespace}, cm); err != nil {
+		if apierrors.IsNotFound(err) {
+			return map[string][]int64{}, nil
+		}
+		return nil, err
+	}
+	return decodeProfilingDurations(cm)
+}
+
+// decodeProfilingDurations returns the history held by the ConfigMap, empty if it has none
+func decodeProfilingDurations(cm *corev1.ConfigMap) (map[string][]int64, error) {
+	durations := map[string][]int64{}
+	content, ok := cm.Data[ProfilingDurationsKey]
+	if !ok {
+		return durations, nil
+	}
+	if err := json.Unmarshal([]byte(content), &durations); err != nil {
+		return nil, fmt.Errorf("failed to parse the profiling duration history: %w", err)
+	}
+	return durations, nil
+}
+
+// estimateProfilingCompletion sets status.estimatedCompletionTime of the DGDR whose profiling job
+// just started, if similar DGDRs were profiled before
+func (r *DynamoGraphDeploymentRequestReconciler) estimateProfilingCompletion(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) {
+	if r.ProfilingDurations == nil {
+		return
+	}
+	attempt := currentProfilingAttempt(dgdr)
+	if attempt == nil {
+		return
+	}
+	if estimate, ok := r.ProfilingDurations.Estimate(ctx, profilingDurationKey(dgdr)); ok {
+		dgdr.Status.EstimatedCompletionTime = ptr.To(metav1.NewTime(attempt.StartTime.Add(estimate)))
+	}
+}
+
+// recordProfilingDuration adds the duration of the DGDR's successful profiling attempt to the history
+func (r *DynamoGraphDeploymentRequestReconciler) recordProfilingDuration(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	if r.ProfilingDurations == nil || len(dgdr.Status.Attempts) == 0 {
+		return nil
+	}
+	attempt := dgdr.Status.Attempts[len(dgdr.Status.Attempts)-1]
+	if attempt.Outcome != nvidiacomv1alpha1.ProfilingAttemptSucceeded || attempt.CompletionTime == nil {
+		return nil
+	}
+	return r.ProfilingDurations.Record(ctx, profilingDurationKey(dgdr), attempt.CompletionTime.Sub(attempt.StartTime.Time))
+}
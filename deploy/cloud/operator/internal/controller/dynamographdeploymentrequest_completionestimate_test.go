@@ -0,0 +1,130 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("DGDR Profiling Completion Estimates", func() {
+	newDGDR := func(model string, useAIC bool) *nvidiacomv1alpha1.DynamoGraphDeploymentRequest {
+		return &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-dgdr-estimate", Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model:   model,
+				Backend: BackendVLLM,
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					Config: createTestConfig(map[string]interface{}{
+						"sweep": map[string]interface{}{"use_ai_configurator": useAIC},
+					}),
+				},
+			},
+		}
+	}
+
+	It("Should group DGDRs by model size, backend and profiling mode", func() {
+		Expect(profilingDurationKey(newDGDR("Qwen/Qwen3-0.6B", false))).Should(Equal("lt10b/vllm/online"))
+		Expect(profilingDurationKey(newDGDR("Qwen/Qwen3-32B", true))).Should(Equal("10b-40b/vllm/aic"))
+		Expect(profilingDurationKey(newDGDR("meta-llama/Llama-3.1-70B-Instruct", false))).Should(Equal("40b-100b/vllm/online"))
+		Expect(profilingDurationKey(newDGDR("meta-llama/Llama-3.1-405B", false))).Should(Equal("gt100b/vllm/online"))
+		Expect(profilingDurationKey(newDGDR("deepseek-ai/DeepSeek-R1", false))).Should(Equal("unknown/vllm/online"))
+	})
+
+	It("Should estimate the median of recent durations once a bucket has enough", func() {
+		ctx := context.Background()
+		history := NewProfilingDurationHistory(k8sClient, "")
+
+		Expect(history.Record(ctx, "lt10b/vllm/online", 30*time.Minute)).Should(Succeed())
+		Expect(history.Record(ctx, "lt10b/vllm/online", 50*time.Minute)).Should(Succeed())
+		_, ok := history.Estimate(ctx, "lt10b/vllm/online")
+		Expect(ok).To(BeFalse())
+
+		Expect(history.Record(ctx, "lt10b/vllm/online", 40*time.Minute)).Should(Succeed())
+		estimate, ok := history.Estimate(ctx, "lt10b/vllm/online")
+		Expect(ok).To(BeTrue())
+		Expect(estimate).Should(Equal(40 * time.Minute))
+		_, ok = history.Estimate(ctx, "lt10b/vllm/aic")
+		Expect(ok).To(BeFalse())
+
+		// Only the most recent runs count
+		for range profilingDurationWindow {
+			Expect(history.Record(ctx, "lt10b/vllm/online", 10*time.Minute)).Should(Succeed())
+		}
+		estimate, _ = history.Estimate(ctx, "lt10b/vllm/online")
+		Expect(estimate).Should(Equal(10 * time.Minute))
+	})
+
+	It("Should keep the history in a ConfigMap across operator restarts", func() {
+		ctx := context.Background()
+		history := NewProfilingDurationHistory(k8sClient, defaultNamespace)
+		for _, minutes := range []time.Duration{20, 25, 90} {
+			Expect(history.Record(ctx, "10b-40b/sglang/online", minutes*time.Minute)).Should(Succeed())
+		}
+		cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: ProfilingDurationsConfigMapName, Namespace: defaultNamespace}}
+		DeferCleanup(func() { _ = k8sClient.Delete(ctx, cm) })
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(cm), cm)).Should(Succeed())
+		Expect(cm.Data[ProfilingDurationsKey]).Should(MatchJSON(`{"10b-40b/sglang/online": [1200, 1500, 5400]}`))
+
+		restarted := NewProfilingDurationHistory(k8sClient, defaultNamespace)
+		estimate, ok := restarted.Estimate(ctx, "10b-40b/sglang/online")
+		Expect(ok).To(BeTrue())
+		Expect(estimate).Should(Equal(25 * time.Minute))
+
+		// Runs recorded by another replica are seen by the next estimate
+		for _, minutes := range []time.Duration{100, 110} {
+			Expect(history.Record(ctx, "10b-40b/sglang/online", minutes*time.Minute)).Should(Succeed())
+		}
+		estimate, ok = restarted.Estimate(ctx, "10b-40b/sglang/online")
+		Expect(ok).To(BeTrue())
+		Expect(estimate).Should(Equal(90 * time.Minute))
+	})
+
+	It("Should set the estimated completion of a started profiling job until it finishes", func() {
+		ctx := context.Background()
+		history := NewProfilingDurationHistory(k8sClient, "")
+		reconciler := &DynamoGraphDeploymentRequestReconciler{Client: k8sClient, ProfilingDurations: history}
+		dgdr := newDGDR("Qwen/Qwen3-0.6B", false)
+
+		attempt := startProfilingAttempt(dgdr)
+		reconciler.estimateProfilingCompletion(ctx, dgdr)
+		Expect(dgdr.Status.EstimatedCompletionTime).Should(BeNil())
+
+		for range profilingDurationMinSamples {
+			Expect(history.Record(ctx, profilingDurationKey(dgdr), time.Hour)).Should(Succeed())
+		}
+		reconciler.estimateProfilingCompletion(ctx, dgdr)
+		Expect(dgdr.Status.EstimatedCompletionTime).ShouldNot(BeNil())
+		Expect(dgdr.Status.EstimatedCompletionTime.Time).Should(Equal(attempt.StartTime.Add(time.Hour)))
+
+		finishProfilingAttempt(dgdr, nvidiacomv1alpha1.ProfilingAttemptSucceeded)
+		Expect(dgdr.Status.EstimatedCompletionTime).Should(BeNil())
+		dgdr.Status.Attempts[0].CompletionTime = ptr.To(metav1.NewTime(dgdr.Status.Attempts[0].StartTime.Add(2 * time.Hour)))
+		Expect(reconciler.recordProfilingDuration(ctx, dgdr)).Should(Succeed())
+		Expect(history.durations[profilingDurationKey(dgdr)]).Should(HaveLen(profilingDurationMinSamples + 1))
+		Expect(history.durations[profilingDurationKey(dgdr)]).Should(ContainElement(int64(7200)))
+	})
+})
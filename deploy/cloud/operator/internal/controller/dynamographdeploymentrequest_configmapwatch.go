@@ -0,0 +1,150 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/yaml"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+)
+
+const (
+	// ProfilingConfigMapIndex indexes DGDRs by the name of the ConfigMap their
+	// spec.profilingConfig.configMapRef references
+	ProfilingConfigMapIndex = "spec.profilingConfig.configMapRef.name"
+
+	// Condition reasons
+	ReasonProfilingConfigMapInvalid = "ProfilingConfigMapInvalid"
+
+	// Event reasons
+	EventReasonProfilingConfigMapFixed = "ProfilingConfigMapFixed"
+
+	// Messages
+	MessageProfilingConfigMapInvalid = "key %s of ConfigMap %s is not a YAML mapping: %v"
+	MessageProfilingConfigMapFixed   = "ConfigMap %s is valid now, validating the DGDR again"
+)
+
+// profilingConfigMapError marks validation errors of the ConfigMap referenced by
+// spec.profilingConfig.configMapRef, which are fixed by changing the ConfigMap
+type profilingConfigMapError struct {
+	err error
+}
+
+func (e *profilingConfigMapError) Error() string { return e.err.Error() }
+func (e *profilingConfigMapError) Unwrap() error { return e.err }
+
+// validationFailureReason returns the Validation condition reason of a spec validation error
+func validationFailureReason(err error) string {
+	var configMapErr *profilingConfigMapError
+	if errors.As(err, &configMapErr) {
+		return ReasonProfilingConfigMapInvalid
+	}
+	return EventReasonValidationFailed
+}
+
+// profilingConfigMapName returns the ConfigMap a DGDR references in spec.profilingConfig.configMapRef,
+// for ProfilingConfigMapIndex
+func profilingConfigMapName(obj client.Object) []string {
+	dgdr, ok := obj.(*nvidiacomv1alpha1.DynamoGraphDeploymentRequest)
+	if !ok || dgdr.Spec.ProfilingConfig.ConfigMapRef == nil {
+		return nil
+	}
+	return []string{dgdr.Spec.ProfilingConfig.ConfigMapRef.Name}
+}
+
+// requestsForConfigMap enqueues the DGDRs referencing a ConfigMap in spec.profilingConfig.configMapRef
+func (r *DynamoGraphDeploymentRequestReconciler) requestsForConfigMap(ctx context.Context, obj client.Object) []ctrl.Request {
+	dgdrs := &nvidiacomv1alpha1.DynamoGraphDeploymentRequestList{}
+	if err := r.List(ctx, dgdrs, client.InNamespace(obj.GetNamespace()),
+		client.MatchingFields{ProfilingConfigMapIndex: obj.GetName()}); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to list DGDRs referencing ConfigMap", "configMap", obj.GetName())
+		return nil
+	}
+	requests := make([]ctrl.Request, 0, len(dgdrs.Items))
+	for _, dgdr := range dgdrs.Items {
+		requests = append(requests, ctrl.Request{NamespacedName: types.NamespacedName{Name: dgdr.Name, Namespace: dgdr.Namespace}})
+	}
+	return requests
+}
+
+// validateProfilingConfigMap checks that the ConfigMap referenced by spec.profilingConfig.configMapRef
+// holds the DGD base config as YAML under its key
+func (r *DynamoGraphDeploymentRequestReconciler) validateProfilingConfigMap(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	ref := dgdr.Spec.ProfilingConfig.ConfigMapRef
+	if ref == nil {
+		return nil
+	}
+
+	cm := &corev1.ConfigMap{}
+	if err := r.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: dgdr.Namespace}, cm); err != nil {
+		if apierrors.IsNotFound(err) {
+			return &profilingConfigMapError{fmt.Errorf(MessageConfigMapNotFound, ref.Name, dgdr.Namespace)}
+		}
+		return err
+	}
+
+	key := ref.Key
+	if key == "" {
+		key = ProfilingConfigFile
+	}
+	content, exists := cm.Data[key]
+	if !exists {
+		return &profilingConfigMapError{fmt.Errorf(MessageConfigMapKeyNotFound, key, cm.Name)}
+	}
+	var config map[string]interface{}
+	if err := yaml.Unmarshal([]byte(content), &config); err != nil {
+		return &profilingConfigMapError{fmt.Errorf(MessageProfilingConfigMapInvalid, key, cm.Name, err)}
+	}
+	return nil
+}
+
+// revalidateProfilingConfigMap restarts a DGDR that failed validation because of the ConfigMap
+// referenced by spec.profilingConfig.configMapRef once the ConfigMap is fixed, so that it is
+// validated and profiled with its latest content. It reports whether the DGDR was restarted.
+func (r *DynamoGraphDeploymentRequestReconciler) revalidateProfilingConfigMap(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) (bool, error) {
+	condition := meta.FindStatusCondition(dgdr.Status.Conditions, ConditionTypeValidation)
+	if condition == nil || condition.Reason != ReasonProfilingConfigMapInvalid {
+		return false, nil
+	}
+	if err := r.validateProfilingConfigMap(ctx, dgdr); err != nil {
+		var configMapErr *profilingConfigMapError
+		if errors.As(err, &configMapErr) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	log.FromContext(ctx).Info("Profiling ConfigMap fixed, validating the DGDR again", "configMap", dgdr.Spec.ProfilingConfig.ConfigMapRef.Name)
+	r.Recorder.Event(dgdr, corev1.EventTypeNormal, EventReasonProfilingConfigMapFixed,
+		fmt.Sprintf(MessageProfilingConfigMapFixed, dgdr.Spec.ProfilingConfig.ConfigMapRef.Name))
+	dgdr.Status.State = StateEmpty
+	dgdr.Status.FailureReason = ""
+	meta.RemoveStatusCondition(&dgdr.Status.Conditions, ConditionTypeValidation)
+	return true, r.updateStatus(ctx, dgdr)
+}
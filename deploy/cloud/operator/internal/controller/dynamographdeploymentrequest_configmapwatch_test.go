@@ -0,0 +1,160 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("DGDR Profiling ConfigMap Watch", func() {
+	ctx := context.Background()
+	var reconciler *DynamoGraphDeploymentRequestReconciler
+	var recorder *record.FakeRecorder
+
+	BeforeEach(func() {
+		recorder = record.NewFakeRecorder(100)
+		reconciler = &DynamoGraphDeploymentRequestReconciler{
+			Client:      k8sClient,
+			Recorder:    recorder,
+			RBACManager: &MockRBACManager{},
+		}
+	})
+
+	newDGDR := func(name, configMap string) *nvidiacomv1alpha1.DynamoGraphDeploymentRequest {
+		return &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model:   "test-model",
+				Backend: BackendVLLM,
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					ProfilerImage: "test-profiler:latest",
+					Config:        createTestConfig(map[string]interface{}{"sla": map[string]interface{}{"ttft": 100.0}}),
+					ConfigMapRef:  &nvidiacomv1alpha1.ConfigMapKeySelector{Name: configMap},
+				},
+			},
+		}
+	}
+
+	getDGDR := func(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) *nvidiacomv1alpha1.DynamoGraphDeploymentRequest {
+		updated := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{}
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(dgdr), updated)).Should(Succeed())
+		return updated
+	}
+
+	It("Should enqueue the DGDRs referencing a ConfigMap", func() {
+		referencing := newDGDR("test-dgdr-cm-referencing", "base-config")
+		other := newDGDR("test-dgdr-cm-other", "other-config")
+		reconciler.Client = fake.NewClientBuilder().WithScheme(k8sClient.Scheme()).
+			WithIndex(&nvidiacomv1alpha1.DynamoGraphDeploymentRequest{}, ProfilingConfigMapIndex, profilingConfigMapName).
+			WithObjects(referencing, other).Build()
+
+		cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "base-config", Namespace: defaultNamespace}}
+		Expect(reconciler.requestsForConfigMap(ctx, cm)).Should(ConsistOf(
+			ctrl.Request{NamespacedName: types.NamespacedName{Name: referencing.Name, Namespace: defaultNamespace}}))
+	})
+
+	It("Should validate a failed DGDR again once its ConfigMap is fixed", func() {
+		dgdr := newDGDR("test-dgdr-cm-fixed", "test-dgdr-cm-fixed-config")
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		DeferCleanup(func() { _ = k8sClient.Delete(ctx, dgdr) })
+
+		cm := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-dgdr-cm-fixed-config", Namespace: defaultNamespace},
+			Data:       map[string]string{ProfilingConfigFile: "services: [Frontend"},
+		}
+		Expect(k8sClient.Create(ctx, cm)).Should(Succeed())
+		DeferCleanup(func() { _ = k8sClient.Delete(ctx, cm) })
+
+		_, err := reconciler.handleInitialState(ctx, dgdr)
+		Expect(err).NotTo(HaveOccurred())
+		dgdr = getDGDR(dgdr)
+		Expect(dgdr.Status.State).Should(Equal(StateFailed))
+		condition := meta.FindStatusCondition(dgdr.Status.Conditions, ConditionTypeValidation)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Reason).Should(Equal(ReasonProfilingConfigMapInvalid))
+		Expect(recorder.Events).Should(Receive(ContainSubstring(EventReasonValidationFailed)))
+
+		// Still broken
+		_, err = reconciler.handleFailedState(ctx, dgdr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(getDGDR(dgdr).Status.State).Should(Equal(StateFailed))
+
+		cm.Data[ProfilingConfigFile] = "services: [Frontend]"
+		Expect(k8sClient.Update(ctx, cm)).Should(Succeed())
+		result, err := reconciler.handleFailedState(ctx, dgdr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Requeue).To(BeTrue())
+		dgdr = getDGDR(dgdr)
+		Expect(dgdr.Status.State).Should(Equal(StateEmpty))
+		Expect(dgdr.Status.FailureReason).Should(BeEmpty())
+		Expect(meta.FindStatusCondition(dgdr.Status.Conditions, ConditionTypeValidation)).To(BeNil())
+		Expect(recorder.Events).Should(Receive(ContainSubstring(EventReasonProfilingConfigMapFixed)))
+
+		_, err = reconciler.handleInitialState(ctx, dgdr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(getDGDR(dgdr).Status.State).Should(Equal(StatePending))
+	})
+
+	It("Should not restart DGDRs that failed for other reasons", func() {
+		dgdr := newDGDR("test-dgdr-cm-other-failure", "test-dgdr-cm-other-failure-config")
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		DeferCleanup(func() { _ = k8sClient.Delete(ctx, dgdr) })
+		cm := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-dgdr-cm-other-failure-config", Namespace: defaultNamespace},
+			Data:       map[string]string{ProfilingConfigFile: "services: {}"},
+		}
+		Expect(k8sClient.Create(ctx, cm)).Should(Succeed())
+		DeferCleanup(func() { _ = k8sClient.Delete(ctx, cm) })
+
+		_, err := reconciler.updateStateToFailed(ctx, dgdr, nvidiacomv1alpha1.FailureReasonProfilerCrash,
+			ConditionTypeProfiling, "ProfilingFailed", "profiler crashed")
+		Expect(err).NotTo(HaveOccurred())
+		_, err = reconciler.handleFailedState(ctx, dgdr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(getDGDR(dgdr).Status.State).Should(Equal(StateFailed))
+	})
+
+	It("Should fail a Pending DGDR whose ConfigMap broke after validation", func() {
+		dgdr := newDGDR("test-dgdr-cm-pending", "test-dgdr-cm-pending-config")
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		DeferCleanup(func() { _ = k8sClient.Delete(ctx, dgdr) })
+		dgdr.Status.State = StatePending
+		Expect(k8sClient.Status().Update(ctx, dgdr)).Should(Succeed())
+
+		_, err := reconciler.handlePendingState(ctx, dgdr)
+		Expect(err).NotTo(HaveOccurred())
+		dgdr = getDGDR(dgdr)
+		Expect(dgdr.Status.State).Should(Equal(StateFailed))
+		Expect(dgdr.Status.FailureReason).Should(Equal(nvidiacomv1alpha1.FailureReasonValidationError))
+		condition := meta.FindStatusCondition(dgdr.Status.Conditions, ConditionTypeValidation)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Reason).Should(Equal(ReasonProfilingConfigMapInvalid))
+	})
+})
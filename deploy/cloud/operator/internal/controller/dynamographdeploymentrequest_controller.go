@@ -22,20 +22,26 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
 	"text/template"
+	"time"
 
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
-	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlcontroller "sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -43,18 +49,21 @@ import (
 	"sigs.k8s.io/yaml"
 
 	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/audit"
 	commonController "github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/controller_common"
+	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/metadata"
+	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/metrics"
 )
 
 const (
 	// State constants
 	StateEmpty             = ""
-	StatePending           = "Pending"
-	StateProfiling         = "Profiling"
-	StateDeploying         = "Deploying"
-	StateReady             = "Ready"
-	StateDeploymentDeleted = "DeploymentDeleted"
-	StateFailed            = "Failed"
+	StatePending           = nvidiacomv1alpha1.DGDRStatePending
+	StateProfiling         = nvidiacomv1alpha1.DGDRStateProfiling
+	StateDeploying         = nvidiacomv1alpha1.DGDRStateDeploying
+	StateReady             = nvidiacomv1alpha1.DGDRStateReady
+	StateDeploymentDeleted = nvidiacomv1alpha1.DGDRStateDeploymentDeleted
+	StateFailed            = nvidiacomv1alpha1.DGDRStateFailed
 
 	// Condition types
 	ConditionTypeValidation      = "Validation"
@@ -71,6 +80,8 @@ const (
 	EventReasonSpecGenerated        = "SpecGenerated"
 	EventReasonSpecChangeRejected   = "SpecChangeRejected"
 	EventReasonDeploymentCreated    = "DeploymentCreated"
+	EventReasonDeploymentUpdated    = "DeploymentUpdated"
+	EventReasonDeploymentUnchanged  = "DeploymentUnchanged"
 	EventReasonDeploymentReady      = "DeploymentReady"
 	EventReasonDeploymentDegraded   = "DeploymentDegraded"
 	EventReasonDeploymentDeleted    = "DeploymentDeleted"
@@ -78,14 +89,18 @@ const (
 	// Label keys
 	LabelApp           = "app"
 	LabelDGDR          = "dgdr"
-	LabelDGDRName      = "dgdr.nvidia.com/name"
-	LabelDGDRNamespace = "dgdr.nvidia.com/namespace"
-	LabelManagedBy     = "nvidia.com/managed-by"
+	LabelDGDRName      = nvidiacomv1alpha1.LabelDGDRName
+	LabelDGDRNamespace = nvidiacomv1alpha1.LabelDGDRNamespace
+	LabelDGDRUID       = nvidiacomv1alpha1.LabelDGDRUID
+	LabelManagedBy     = nvidiacomv1alpha1.LabelManagedBy
+
+	// AnnotationGeneratedSpecHash records the hash of the generated spec last applied to the DGD
+	AnnotationGeneratedSpecHash = "nvidia.com/dgdr-spec-hash"
 
 	// Label values
 	LabelValueDynamoProfiler = "dynamo-profiler"
 	LabelValueAICProfiler    = "aic-profiler"
-	LabelValueDynamoOperator = "dynamo-operator"
+	LabelValueDynamoOperator = nvidiacomv1alpha1.LabelValueDynamoOperator
 
 	// Job naming
 	JobNamePrefixOnline = "profile-online-"
@@ -129,9 +144,12 @@ const (
 	MessageSpecGenerated             = "DynamoGraphDeployment spec generated successfully"
 	MessageSpecAvailable             = "Generated spec is available in status.generatedDeployment"
 	MessageDeploymentCreated         = "DynamoGraphDeployment %s created successfully"
+	MessageDeploymentUpdated         = "DynamoGraphDeployment %s updated with the regenerated spec"
+	MessageDeploymentUnchanged       = "DynamoGraphDeployment %s already matches the generated spec"
 	MessageDeploymentReady           = "DynamoGraphDeployment %s is ready"
 	MessageDeploymentDegraded        = "DynamoGraphDeployment %s degraded from Ready to %s"
 	MessageDeploymentDeleted         = "DGD %s was deleted. DGDR will not recreate it. Delete this DGDR and create a new one to redeploy."
+	MessageDeploymentConflict        = "DynamoGraphDeployment %s/%s already exists and is not managed by this DGDR, delete it or choose another name with spec.deploymentOverrides.name"
 	MessageInvalidState              = "Invalid state"
 	MessageSpecChangeRejected        = "Cannot modify spec in state '%s'. DynamoGraphDeploymentRequest is immutable once profiling starts. Create a new resource with a different name instead."
 	MessageJobCreationFailed         = "JobCreationFailed"
@@ -142,6 +160,8 @@ const (
 	MessageProfilingCheckFailed      = "ProfilingCheckFailed"
 	MessageConfigMapNotFound         = "ConfigMap %s not found in namespace %s"
 	MessageConfigMapKeyNotFound      = "key %s not found in ConfigMap %s"
+	MessageSecretNotFound            = "Secret %s not found in namespace %s"
+	MessageSecretKeyNotFound         = "key %s not found in Secret %s"
 
 	// Validation messages
 	ValidationErrorModelRequired  = "model is required"
@@ -161,6 +181,25 @@ set -e
 set -o pipefail
 # Wait for the profiler container to complete, not just for the file to exist
 # This ensures we capture the final config, not intermediate results
+
+# Deliver every new version of the sweep checkpoint, so a retried pod resumes the sweep.
+# A checkpoint left on the volume by an earlier run is not delivered again.
+LAST_CHECKPOINT=$(cksum < {{.OutputPath}}/{{.CheckpointFile}} 2>/dev/null || echo "")
+deliver_checkpoint() {
+  [ -f {{.OutputPath}}/{{.CheckpointFile}} ] || return 0
+  CHECKPOINT=$(cksum < {{.OutputPath}}/{{.CheckpointFile}})
+  [ "$CHECKPOINT" != "$LAST_CHECKPOINT" ] || return 0
+  rm -rf {{.CheckpointStagingDir}}
+  mkdir -p {{.CheckpointStagingDir}}
+  cp {{.OutputPath}}/{{.CheckpointFile}} {{.CheckpointStagingDir}}/
+  if {{.Checkpoint}}; then
+    LAST_CHECKPOINT=$CHECKPOINT
+    echo "Delivered sweep checkpoint"
+  else
+    echo "Failed to deliver sweep checkpoint, retrying"
+  fi
+}
+
 echo "Waiting for profiler to complete..."
 while true; do
   # Check if profiler container has finished (either Completed or Error state)
@@ -170,43 +209,54 @@ while true; do
     echo "Profiler container has terminated"
     break
   fi
+  deliver_checkpoint
   sleep 5
 done
+deliver_checkpoint
+{{- if .ArtifactsDir}}
+
+# Keep everything the profiler wrote on the artifacts claim, including the output of failed runs
+mkdir -p {{.ArtifactsDir}}
+cp -R {{.OutputPath}}/. {{.ArtifactsDir}}/ || echo "Failed to keep profiling artifacts"
+{{- end}}
+
+# Fail the pod with the profiler, so the job retries it from the delivered checkpoint
+EXIT_CODE=$(kubectl get pod $HOSTNAME -n {{.Namespace}} -o jsonpath='{.status.containerStatuses[?(@.name=="profiler")].state.terminated.exitCode}' 2>/dev/null || echo "")
+if [ -n "$EXIT_CODE" ] && [ "$EXIT_CODE" != "0" ]; then
+  echo "Profiler failed with exit code $EXIT_CODE"
+  exit 1
+fi
 
 # Now wait for the output file to exist
 echo "Waiting for output file {{.OutputPath}}/{{.OutputFile}}..."
 while [ ! -f {{.OutputPath}}/{{.OutputFile}} ]; do sleep 2; done
-echo "Output file found, creating ConfigMap..."
-
-# Start building ConfigMap YAML with DGD spec
-cat >/tmp/cm.yaml <<EOF
-apiVersion: v1
-kind: ConfigMap
-metadata:
-  name: {{.ConfigMapName}}
-  namespace: {{.Namespace}}
-  labels:
-    dgdr.nvidia.com/name: {{.DGDRName}}
-    nvidia.com/managed-by: dynamo-operator
-data:
-  {{.OutputFile}}: |
-EOF
-sed 's/^/    /' {{.OutputPath}}/{{.OutputFile}} >> /tmp/cm.yaml
-
-# Add profiling data directories to ConfigMap for long-term storage
+echo "Output file found, collecting results..."
+
+# Collect the result files, one file per output key
+rm -rf {{.StagingDir}}
+mkdir -p {{.StagingDir}}
+cp {{.OutputPath}}/{{.OutputFile}} {{.StagingDir}}/
+
+# Add profiling data directories for long-term storage
 # Find all interpolation directories and add their raw_data.npz files
 for dir in {{.OutputPath}}/*/interpolation; do
   if [ -d "$dir" ]; then
     dirname=$(basename $(dirname "$dir"))
     if [ -f "$dir/raw_data.npz" ]; then
-      echo "  ${dirname}_raw_data.npz: |" >> /tmp/cm.yaml
-      base64 "$dir/raw_data.npz" | sed 's/^/    /' >> /tmp/cm.yaml
+      base64 "$dir/raw_data.npz" > {{.StagingDir}}/${dirname}_raw_data.npz
     fi
   fi
 done
 
-kubectl apply -f /tmp/cm.yaml
-echo "Saved profiling output to ConfigMap {{.ConfigMapName}}"
+# Add per-backend outputs (spec.backend: auto) and benchmark time windows (recordUtilization)
+for f in {{.OutputPath}}/{{.ComparisonFile}} {{.OutputPath}}/{{.WindowsFile}} {{.OutputPath}}/config_with_planner_*.yaml; do
+  if [ -f "$f" ]; then
+    cp "$f" {{.StagingDir}}/
+  fi
+done
+
+# Deliver the results with profilingConfig.resultTransport
+{{.Upload}}
 `
 
 // DynamoGraphDeploymentRequestReconciler reconciles a DynamoGraphDeploymentRequest object
@@ -215,8 +265,142 @@ type DynamoGraphDeploymentRequestReconciler struct {
 	Recorder record.EventRecorder
 	Config   commonController.Config
 
+	// APIReader reads objects the manager does not cache, such as events. Defaults to the client.
+	APIReader client.Reader
+
 	// RBACMgr handles RBAC setup for profiling jobs
 	RBACManager RBACManager
+
+	// MetricsQuerier queries GPU statistics for profilingConfig.recordUtilization.
+	// Nil when no Prometheus endpoint is configured.
+	MetricsQuerier MetricsQuerier
+
+	// ImageResolver resolves image tags to digests for deploymentOverrides.pinImageDigests
+	ImageResolver ImageDigestResolver
+
+	// ModelRegistry resolves spec.modelRef entries. Defaults to HTTPModelRegistry.
+	ModelRegistry ModelRegistry
+
+	// ProfilerInspector reads the capability labels of profiler images, which DGDRs are validated
+	// against. Nil skips the check.
+	ProfilerInspector ProfilerImageInspector
+
+	// ArchitectureInspector reads the CPU architectures of profiling and runtime images, which are
+	// validated against the target nodes. Nil skips the check.
+	ArchitectureInspector ImageArchitectureInspector
+
+	// LogReader reads the profiler and operator logs collected into support bundles. Nil collects
+	// bundles without logs.
+	LogReader PodLogReader
+
+	// OperatorPod is the pod the operator runs in, whose log lines about a DGDR are collected into its
+	// support bundle. Operator logs are not collected when unset.
+	OperatorPod types.NamespacedName
+
+	// ProfilerCapabilitiesRefreshInterval is how long discovered profiler capabilities are used before
+	// the image is inspected again. Defaults to DefaultProfilerCapabilitiesRefreshInterval.
+	ProfilerCapabilitiesRefreshInterval time.Duration
+
+	// DockerSecretRetriever finds the docker config secrets of a registry. Optional.
+	DockerSecretRetriever dockerSecretRetriever
+
+	// StuckPodGracePeriod is how long profiling pods may stay Terminating on unavailable nodes,
+	// past their termination grace period, before they are force deleted. Zero disables the cleanup.
+	StuckPodGracePeriod time.Duration
+
+	// JobTerminationTimeout is how long the finalizer of a deleted DGDR waits for the pods of its
+	// profiling job to terminate. 0 removes the finalizer without waiting.
+	JobTerminationTimeout time.Duration
+
+	// GeneratedSpecValidity is how long a generated spec stays valid when the DGDR does not set
+	// spec.generatedSpecValidity.validFor. 0 never expires generated specs.
+	GeneratedSpecValidity time.Duration
+
+	// DegradedGracePeriod is how long a DGD must stay non-Ready before a Degraded DGDR falls back to Deploying
+	DegradedGracePeriod time.Duration
+
+	// DegradedObservations is how many consecutive non-Ready observations are required before a
+	// Degraded DGDR falls back to Deploying
+	DegradedObservations int32
+
+	// ProfilerMode is ProfilerModeJob to run profiling jobs, or ProfilerModeMock to synthesize
+	// profiling results without a job on clusters without GPUs
+	ProfilerMode string
+
+	// PlaceholderTemplates overrides the per-backend templates of the mock profiler's generated deployment
+	PlaceholderTemplates map[string]string
+
+	// ResultsPVCPath is where the shared profiling output volume is mounted into the operator, for the
+	// PVC result transport. Empty when the volume is not mounted.
+	ResultsPVCPath string
+
+	// ResultsEndpoint is the URL of the operator's results endpoint as seen from profiling jobs, for the
+	// HTTP result transport. Empty when the endpoint is disabled.
+	ResultsEndpoint string
+
+	// ResultsCA is the PEM encoded CA that profiling jobs verify the results endpoint with.
+	// Profiling jobs skip verification when it is empty.
+	ResultsCA []byte
+
+	// ImageAllowlist restricts the image registries of DGDRs per namespace. Nil allows any image.
+	ImageAllowlist *ImageAllowlist
+
+	// CompatibilityMatrix holds the known model/backend/GPU memory combinations DGDRs are validated
+	// against. Nil skips the check.
+	CompatibilityMatrix *CompatibilityMatrixStore
+
+	// Catalog holds the configurations profiled ahead of time that replace profiling for DGDRs
+	// whose SLA they meet. Nil always profiles.
+	Catalog *ProfiledCatalogStore
+
+	// PodSecurityProfile is applied to profiling job pods and generated deployments. Empty applies none.
+	PodSecurityProfile PodSecurityProfile
+
+	// ServiceMesh is the mesh whose sidecar is injected into generated deployments and kept out of
+	// profiling jobs. Empty injects none.
+	ServiceMesh ServiceMesh
+
+	// AuditSink receives the audit records of generated specs and applied deployments. Nil records none.
+	AuditSink audit.Sink
+
+	// ApprovalAudited is set when the admission webhook records the users approving generated specs
+	// in the audit log
+	ApprovalAudited bool
+
+	// FaultInjector fails and delays DGDR operations for resilience testing. Nil injects no faults.
+	FaultInjector *FaultInjector
+
+	// NamespaceSelector restricts DGDR processing to namespaces with matching labels. Nil processes
+	// DGDRs in every watched namespace.
+	NamespaceSelector labels.Selector
+
+	// RuntimeImages maps backends to the runtime image the generated deployments of their DGDRs
+	// run, replacing the image written by the profiler. Unset backends keep the generated image.
+	RuntimeImages map[string]string
+
+	// Sharder restricts DGDR processing to the shard of this replica, so several active replicas
+	// process disjoint sets of DGDRs. Nil processes every DGDR on the leader.
+	Sharder *Sharder
+
+	// ArtifactsTTL is how long profiling artifacts are kept when profilingConfig.artifactsPVC sets no TTL.
+	// Zero keeps them indefinitely.
+	ArtifactsTTL time.Duration
+
+	// AttemptRetention is how many profiling attempts of a DGDR keep their profiling and hook jobs;
+	// the jobs of older attempts are deleted. Zero keeps the jobs of every attempt.
+	AttemptRetention int32
+
+	// OperatorInstance names this operator installation, which claims the DGDRs it manages so other
+	// installations watching them leave them untouched. Empty manages every watched DGDR.
+	OperatorInstance string
+
+	// ProfilingDurations keeps the durations of past profiling runs that the completion of new ones
+	// is estimated from. Nil estimates none.
+	ProfilingDurations *ProfilingDurationHistory
+
+	// PodMonitorEndpoints are the metrics endpoints, per component type, of the PodMonitors generated
+	// for deployments applied with autoApply. Nil, e.g. without the Prometheus Operator CRDs, generates none.
+	PodMonitorEndpoints map[string]PodMonitorEndpoint
 }
 
 // RBACManager interface for managing RBAC resources
@@ -233,6 +417,21 @@ func (r *DynamoGraphDeploymentRequestReconciler) GetRecorder() record.EventRecor
 func (r *DynamoGraphDeploymentRequestReconciler) FinalizeResource(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
 	logger := log.FromContext(ctx)
 
+	// Never leave nodes tainted behind a deleted DGDR
+	if err := r.releaseProfilingNodes(ctx, dgdr); err != nil {
+		return err
+	}
+
+	if err := r.deletePodDisruptionBudgets(ctx, dgdr); err != nil {
+		return err
+	}
+
+	if err := r.cleanupGeneratedArtifacts(ctx, dgdr); err != nil {
+		return err
+	}
+	forgetReferencedValues(dgdr)
+	resultsFetchRetries.Delete(dgdr.UID)
+
 	logger.Info("DGDR finalized successfully", "name", dgdr.Name)
 	return nil
 }
@@ -243,16 +442,38 @@ func (r *DynamoGraphDeploymentRequestReconciler) FinalizeResource(ctx context.Co
 // +kubebuilder:rbac:groups=nvidia.com,resources=dynamographdeployments,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=nvidia.com,resources=dynamographdeployments/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=nvidia.com,resources=dynamographdeployments/finalizers,verbs=update
+// +kubebuilder:rbac:groups=nvidia.com,resources=dynamoprofilercapabilities,verbs=get;list;watch;create;update
+// +kubebuilder:rbac:groups=nvidia.com,resources=dynamoprofilercapabilities/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;update;patch;delete
-// +kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;watch;create;delete
+// +kubebuilder:rbac:groups=core,resources=pods/log,verbs=get
+// +kubebuilder:rbac:groups=core,resources=persistentvolumeclaims,verbs=get;list;watch;create;update;patch
+// +kubebuilder:rbac:groups=core,resources=nodes,verbs=get;list;watch;update;patch
 // +kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;list;watch;create;update;patch;delete
-// +kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;create;update;delete
+// +kubebuilder:rbac:groups=core,resources=events,verbs=list;watch;create;patch
+// +kubebuilder:rbac:groups=apps,resources=replicasets;statefulsets,verbs=get
+// +kubebuilder:rbac:groups=core,resources=services,verbs=get;list;watch
+// +kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses,verbs=get;list;watch
+// +kubebuilder:rbac:groups=networking.istio.io,resources=virtualservices,verbs=get;list;watch
+// +kubebuilder:rbac:groups=authentication.k8s.io,resources=tokenreviews,verbs=create
+// +kubebuilder:rbac:groups=authorization.k8s.io,resources=subjectaccessreviews,verbs=create
+// +kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=get;list;watch;create;update;delete;deletecollection
+// +kubebuilder:rbac:groups=monitoring.coreos.com,resources=podmonitors,verbs=get;list;watch;create;update;delete;deletecollection
+// +kubebuilder:rbac:groups=networking.k8s.io,resources=networkpolicies,verbs=get;list;watch;create;update;delete
+// +kubebuilder:rbac:groups=discovery.k8s.io,resources=endpointslices,verbs=list
 
 // Reconcile handles the reconciliation loop for DynamoGraphDeploymentRequest
 func (r *DynamoGraphDeploymentRequestReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	logger := log.FromContext(ctx)
 	logger.Info("Reconciling DynamoGraphDeploymentRequest", "name", req.Name, "namespace", req.Namespace)
 
+	// DGDRs of other shards are processed by the replica holding that shard
+	if !r.Sharder.Owns(req.Namespace, req.Name) {
+		logger.V(1).Info("DGDR belongs to another shard, skipping", "shard", ShardFor(req.Namespace, req.Name, r.Sharder.Count))
+		return ctrl.Result{}, nil
+	}
+
 	// Fetch the DGDR instance
 	dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{}
 	if err := r.Get(ctx, req.NamespacedName, dgdr); err != nil {
@@ -263,6 +484,28 @@ func (r *DynamoGraphDeploymentRequestReconciler) Reconcile(ctx context.Context,
 		logger.Error(err, "Failed to get DGDR")
 		return ctrl.Result{}, err
 	}
+	ctx, logger = withLogLevelOverride(ctx, dgdr)
+	ctx = withStatusBase(ctx, dgdr)
+	logger.V(1).Info("Fetched DGDR",
+		"state", dgdr.Status.State,
+		"generation", dgdr.Generation,
+		"observedGeneration", dgdr.Status.ObservedGeneration,
+		"resourceVersion", dgdr.ResourceVersion)
+
+	// DGDRs watched by several operator installations are managed by the one that claimed them
+	if owned, err := r.handleOwnership(ctx, dgdr); !owned || err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Values the DGDR passes to its pods from ConfigMaps and Secrets are redacted from its messages
+	if err := r.collectReferencedValues(ctx, dgdr); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Keep a deleted DGDR until its profiling job released its GPUs
+	if result, err := r.handleProfilingJobTermination(ctx, dgdr); result != nil || err != nil {
+		return *result, err
+	}
 
 	// Handle finalizer using common function
 	finalized, err := commonController.HandleFinalizer(ctx, dgdr, r.Client, r)
@@ -274,11 +517,49 @@ func (r *DynamoGraphDeploymentRequestReconciler) Reconcile(ctx context.Context,
 		return ctrl.Result{}, nil
 	}
 
+	// DGDRs are only processed in namespaces opted in with the operator's namespace selector
+	if enabled, err := r.handleNamespaceSelector(ctx, dgdr); !enabled || err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Administrative pause and one-shot actions take precedence over the state machine
+	if result, err := r.handlePauseAndActions(ctx, dgdr); result != nil || err != nil {
+		return *result, err
+	}
+
+	// Handle annotation-triggered snapshot export
+	if exportRequested(dgdr) {
+		if err := r.handleExport(ctx, dgdr); err != nil {
+			r.Recorder.Event(dgdr, corev1.EventTypeWarning, EventReasonSnapshotExportFailed, err.Error())
+			return ctrl.Result{}, err
+		}
+	}
+
+	// Run or stop the artifacts browser pod as annotated
+	if err := r.reconcileArtifactsBrowser(ctx, dgdr); err != nil {
+		r.Recorder.Event(dgdr, corev1.EventTypeWarning, EventReasonArtifactsBrowserFailed, err.Error())
+		return ctrl.Result{}, err
+	}
+
+	// Handle annotation-triggered comparison with another DGDR
+	if comparisonRequested(dgdr) {
+		if err := r.handleComparison(ctx, dgdr); err != nil {
+			r.Recorder.Event(dgdr, corev1.EventTypeWarning, EventReasonComparisonFailed, err.Error())
+			return ctrl.Result{}, err
+		}
+	}
+
+	// Handle annotation-triggered re-check of the deployment
+	if recheckRequested(dgdr) {
+		return r.handleRecheck(ctx, dgdr)
+	}
+
 	// Check for spec changes (immutability enforcement)
 	if dgdr.Status.ObservedGeneration > 0 && dgdr.Status.ObservedGeneration != dgdr.Generation {
 		// Spec changed after initial processing
-		if dgdr.Status.State == StateProfiling || dgdr.Status.State == StateDeploying ||
-			dgdr.Status.State == StateReady || dgdr.Status.State == StateDeploymentDeleted {
+		if dgdr.Status.State == StateProfiling || dgdr.Status.State == StateAwaitingApproval || dgdr.Status.State == StateDeploying ||
+			dgdr.Status.State == StateReady || dgdr.Status.State == StateDegraded ||
+			dgdr.Status.State == StateDeploymentDeleted {
 			logger.Info("Spec change detected in immutable state",
 				"state", dgdr.Status.State,
 				"observedGeneration", dgdr.Status.ObservedGeneration,
@@ -292,6 +573,27 @@ func (r *DynamoGraphDeploymentRequestReconciler) Reconcile(ctx context.Context,
 			return ctrl.Result{}, nil
 		}
 	}
+
+	untilExpiry, err := r.reconcileSpecExpiry(ctx, dgdr)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Count conflicting updates to spot contention on DGDRs at scale
+	state := dgdr.Status.State
+	result, err := r.reconcileState(ctx, dgdr)
+	if apierrors.IsConflict(err) {
+		metrics.DGDRStatusUpdateConflictsTotal.WithLabelValues(dgdr.Namespace, state).Inc()
+	}
+	// Requeue to flip SpecStale when the generated spec expires
+	if err == nil && untilExpiry > 0 && (result.RequeueAfter == 0 || untilExpiry < result.RequeueAfter) {
+		result.RequeueAfter = untilExpiry
+	}
+	return result, err
+}
+
+// reconcileState runs the state machine step of the DGDR's current state
+func (r *DynamoGraphDeploymentRequestReconciler) reconcileState(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) (ctrl.Result, error) {
 	// State machine: handle different states
 	switch dgdr.Status.State {
 	case StateEmpty:
@@ -300,16 +602,20 @@ func (r *DynamoGraphDeploymentRequestReconciler) Reconcile(ctx context.Context,
 		return r.handlePendingState(ctx, dgdr)
 	case StateProfiling:
 		return r.handleProfilingState(ctx, dgdr)
+	case StateAwaitingApproval:
+		return r.handleAwaitingApprovalState(ctx, dgdr)
 	case StateDeploying:
 		return r.handleDeployingState(ctx, dgdr)
 	case StateReady:
 		return r.handleReadyState(ctx, dgdr)
+	case StateDegraded:
+		return r.handleDegradedState(ctx, dgdr)
 	case StateDeploymentDeleted:
 		return r.handleDeploymentDeletedState(ctx, dgdr)
 	case StateFailed:
 		return r.handleFailedState(ctx, dgdr)
 	default:
-		logger.Info("Unknown state", "state", dgdr.Status.State)
+		log.FromContext(ctx).Info("Unknown state", "state", dgdr.Status.State)
 		return r.updateStateAndRequeue(ctx, dgdr, StateFailed, MessageInvalidState)
 	}
 }
@@ -319,17 +625,34 @@ func (r *DynamoGraphDeploymentRequestReconciler) handleInitialState(ctx context.
 	logger := log.FromContext(ctx)
 	logger.Info("Handling initial state", "name", dgdr.Name)
 
+	// Rehydrate from a snapshot instead of profiling
+	if dgdr.Spec.ImportFrom != nil {
+		return r.handleImport(ctx, dgdr)
+	}
+
+	// Resolve the model registry entry, which the rest of the spec is validated against
+	if err := r.resolveModelRef(ctx, dgdr); err != nil {
+		if failureReasonFromError(err, "") == "" {
+			return ctrl.Result{}, err
+		}
+		r.Recorder.Event(dgdr, corev1.EventTypeWarning, EventReasonModelResolutionFailed, err.Error())
+		return r.updateStateToFailed(ctx, dgdr, nvidiacomv1alpha1.FailureReasonModelResolutionFailed,
+			ConditionTypeValidation, EventReasonModelResolutionFailed, err.Error())
+	}
+
 	// Validate the spec
 	if err := r.validateSpec(ctx, dgdr); err != nil {
 		r.Recorder.Event(dgdr, corev1.EventTypeWarning, EventReasonValidationFailed, err.Error())
-		return r.updateStateWithCondition(ctx, dgdr, StateFailed, ConditionTypeValidation, metav1.ConditionFalse, EventReasonValidationFailed, err.Error())
+		return r.updateStateToFailed(ctx, dgdr, failureReasonFromError(err, nvidiacomv1alpha1.FailureReasonValidationError),
+			ConditionTypeValidation, validationFailureReason(err), err.Error())
 	}
 
 	// Set observedGeneration to track the spec we're processing
 	dgdr.Status.ObservedGeneration = dgdr.Generation
 
-	// Populate backend in status from spec for display in kubectl output
+	// Populate backend and profiling mode in status from spec for display in kubectl output
 	dgdr.Status.Backend = dgdr.Spec.Backend
+	dgdr.Status.ProfilingMode = getProfilingMode(dgdr)
 
 	// Initialize status
 	r.Recorder.Event(dgdr, corev1.EventTypeNormal, EventReasonInitialized, MessageInitialized)
@@ -341,17 +664,80 @@ func (r *DynamoGraphDeploymentRequestReconciler) handlePendingState(ctx context.
 	logger := log.FromContext(ctx)
 	logger.Info("Handling pending state", "name", dgdr.Name)
 
+	if getProfilingMode(dgdr) == nvidiacomv1alpha1.ProfilingModeNone {
+		return r.handlePrecomputedDeployment(ctx, dgdr)
+	}
+
+	// A catalog entry profiled ahead of time that meets the SLA replaces profiling
+	if hit, err := r.matchCatalog(ctx, dgdr); err != nil {
+		r.Recorder.Event(dgdr, corev1.EventTypeWarning, MessageGenerationFailed, err.Error())
+		return r.updateStateToFailed(ctx, dgdr, failureReasonFromError(err, nvidiacomv1alpha1.FailureReasonValidationError),
+			ConditionTypeSpecGenerated, MessageGenerationFailed, err.Error())
+	} else if hit {
+		return r.handleCatalogHit(ctx, dgdr)
+	}
+
+	// The referenced ConfigMap may have changed since the DGDR was validated, the profiling job
+	// mounts its latest content
+	if err := r.validateProfilingConfigMap(ctx, dgdr); err != nil {
+		if validationFailureReason(err) != ReasonProfilingConfigMapInvalid {
+			return ctrl.Result{}, err
+		}
+		r.Recorder.Event(dgdr, corev1.EventTypeWarning, EventReasonValidationFailed, err.Error())
+		return r.updateStateToFailed(ctx, dgdr, nvidiacomv1alpha1.FailureReasonValidationError,
+			ConditionTypeValidation, ReasonProfilingConfigMapInvalid, err.Error())
+	}
+
+	// Pre-profiling hooks prepare the cluster before anything else is set up for profiling
+	if result, err := r.handleProfilingHooks(ctx, dgdr, nvidiacomv1alpha1.ProfilingHookPhasePre); result != nil || err != nil {
+		return *result, err
+	}
+
+	// Record the toolchain before any results are produced
+	recordProvenance(dgdr)
+
+	if r.isMockProfiling() {
+		if err := r.writeMockProfilingOutput(ctx, dgdr); err != nil {
+			r.Recorder.Event(dgdr, corev1.EventTypeWarning, EventReasonProfilingJobFailed, err.Error())
+			return r.updateStateToFailed(ctx, dgdr, nvidiacomv1alpha1.FailureReasonJobSchedulingFailed,
+				ConditionTypeProfiling, MessageJobCreationFailed, err.Error())
+		}
+		return r.updateStateWithCondition(ctx, dgdr, StateProfiling, ConditionTypeProfiling, metav1.ConditionFalse, "ProfilingRunning", MessageProfilingInProgress)
+	}
+
+	// Reserve dedicated nodes before the profiler starts deploying. Every failure from here on
+	// releases them, a conflict with another reservation is retried.
+	if needsNodeReservation(dgdr) {
+		if err := r.reserveProfilingNodes(ctx, dgdr); err != nil {
+			if apierrors.IsConflict(err) {
+				return ctrl.Result{}, err
+			}
+			return r.failProfilingStart(ctx, dgdr, MessageJobCreationFailed, err)
+		}
+	}
+
+	// Provision the artifacts claim before the profiling job mounts it
+	if err := r.prepareArtifacts(ctx, dgdr); err != nil {
+		return r.failProfilingStart(ctx, dgdr, MessageJobCreationFailed, err)
+	}
+
+	// Isolate the profiling job pods before they start
+	if err := r.isolateProfilingNetwork(ctx, dgdr); err != nil {
+		return r.failProfilingStart(ctx, dgdr, MessageJobCreationFailed, err)
+	}
+
 	// Create profiling job (online or AIC)
 	if err := r.createProfilingJob(ctx, dgdr); err != nil {
-		r.Recorder.Event(dgdr, corev1.EventTypeWarning, EventReasonProfilingJobFailed, err.Error())
-		return r.updateStateWithCondition(ctx, dgdr, StateFailed, ConditionTypeProfiling, metav1.ConditionFalse, MessageJobCreationFailed, err.Error())
+		return r.failProfilingStart(ctx, dgdr, rbacConditionReason(err, MessageJobCreationFailed), err)
 	}
 
+	r.estimateProfilingCompletion(ctx, dgdr)
+
 	// Record event with appropriate message
-	if isOnlineProfiling(dgdr) {
-		r.Recorder.Event(dgdr, corev1.EventTypeNormal, EventReasonProfilingJobCreated, MessageProfilingJobCreated)
-	} else {
+	if getProfilingMode(dgdr) == nvidiacomv1alpha1.ProfilingModeAIC {
 		r.Recorder.Event(dgdr, corev1.EventTypeNormal, EventReasonProfilingJobCreated, MessageAICProfilingJobCreated)
+	} else {
+		r.Recorder.Event(dgdr, corev1.EventTypeNormal, EventReasonProfilingJobCreated, MessageProfilingJobCreated)
 	}
 
 	// Update to Profiling state with Running status
@@ -363,19 +749,68 @@ func (r *DynamoGraphDeploymentRequestReconciler) handleProfilingState(ctx contex
 	logger := log.FromContext(ctx)
 	logger.Info("Handling profiling state", "name", dgdr.Name)
 
+	// Post-profiling hooks started once the profiling job succeeded, it is not checked again
+	if hooksStarted(dgdr, nvidiacomv1alpha1.ProfilingHookPhasePost) {
+		return r.handlePostProfilingHooks(ctx, dgdr)
+	}
+
 	// Check profiling job status (both online and offline/AIC run as Jobs)
 	// Note: We watch the Job via Owns(), so we'll be triggered automatically on Job changes
 	completed, err := r.checkProfilingJobStatus(ctx, dgdr)
+
+	// Release reserved nodes as soon as profiling is over, whatever the outcome
+	if (completed || err != nil) && len(dgdr.Status.ReservedNodes) > 0 {
+		if releaseErr := r.releaseProfilingNodes(ctx, dgdr); releaseErr != nil {
+			return ctrl.Result{}, releaseErr
+		}
+	}
+	if completed || err != nil {
+		if removeErr := r.removeProfilingNetworkIsolation(ctx, dgdr); removeErr != nil {
+			return ctrl.Result{}, removeErr
+		}
+	}
+
+	// Keep an audit record of every finished run; failing to write it does not fail the DGDR
+	if completed || err != nil {
+		result := nvidiacomv1alpha1.ProfilingRunSucceeded
+		outcome := nvidiacomv1alpha1.ProfilingAttemptSucceeded
+		if err != nil {
+			result = nvidiacomv1alpha1.ProfilingRunFailed
+			outcome = nvidiacomv1alpha1.ProfilingAttemptFailed
+		}
+		finishProfilingAttempt(dgdr, outcome)
+		r.recordProvenanceDigests(ctx, dgdr)
+		if recordErr := r.recordProfilingRun(ctx, dgdr, result); recordErr != nil {
+			logger.Error(recordErr, "Failed to record profiling run")
+			r.Recorder.Event(dgdr, corev1.EventTypeWarning, EventReasonProfilingRunRecordFailed, recordErr.Error())
+			setWarning(dgdr, WarningAuditRecordFailed, recordErr.Error())
+		}
+		// Only improves later estimates, failing to record it does not fail the DGDR
+		if recordErr := r.recordProfilingDuration(ctx, dgdr); recordErr != nil {
+			logger.Error(recordErr, "Failed to record profiling duration")
+		}
+	}
+
 	if err != nil {
 		r.Recorder.Event(dgdr, corev1.EventTypeWarning, MessageProfilingCheckFailed, err.Error())
 		// Job failed - transition to Failed state
-		return r.updateStateWithCondition(ctx, dgdr, StateFailed, ConditionTypeProfiling, metav1.ConditionFalse, "ProfilingFailed", err.Error())
+		return r.updateStateToFailed(ctx, dgdr, nvidiacomv1alpha1.FailureReasonProfilerCrash,
+			ConditionTypeProfiling, "ProfilingFailed", err.Error())
 	}
 
 	if !completed {
+		if err := r.observeProfilingRetries(ctx, dgdr); err != nil {
+			return ctrl.Result{}, err
+		}
+		// Pods stuck Terminating on a wedged node change neither the Job nor the DGDR, so they
+		// are checked again once they are overdue
+		recheck, err := r.cleanupStuckProfilingPods(ctx, dgdr)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
 		logger.Info("Profiling job still running", "name", dgdr.Name)
-		// Don't requeue - we'll be triggered when the Job completes/fails
-		return ctrl.Result{}, nil
+		// Otherwise don't requeue - we'll be triggered when the Job completes/fails
+		return ctrl.Result{RequeueAfter: recheck}, nil
 	}
 
 	// Mark profiling as completed successfully
@@ -387,23 +822,57 @@ func (r *DynamoGraphDeploymentRequestReconciler) handleProfilingState(ctx contex
 		Message:            "Profiling job completed successfully",
 	})
 
+	// GPU statistics are informational, so failing to collect them does not fail the DGDR
+	if err := r.recordUtilization(ctx, dgdr); err != nil {
+		logger.Error(err, "Failed to record GPU utilization")
+		r.Recorder.Event(dgdr, corev1.EventTypeWarning, EventReasonUtilizationRecordingFailed, err.Error())
+		setWarning(dgdr, WarningUtilizationUnavailable, err.Error())
+	}
+
+	if len(profilingHooks(dgdr, nvidiacomv1alpha1.ProfilingHookPhasePost)) > 0 {
+		return r.handlePostProfilingHooks(ctx, dgdr)
+	}
+	return r.generateProfiledSpec(ctx, dgdr)
+}
+
+// handlePostProfilingHooks runs the post-profiling hooks, then generates the spec
+func (r *DynamoGraphDeploymentRequestReconciler) handlePostProfilingHooks(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) (ctrl.Result, error) {
+	if result, err := r.handleProfilingHooks(ctx, dgdr, nvidiacomv1alpha1.ProfilingHookPhasePost); result != nil || err != nil {
+		return *result, err
+	}
+	return r.generateProfiledSpec(ctx, dgdr)
+}
+
+// generateProfiledSpec generates the spec from the results of the profiling job that succeeded
+// and moves the DGDR on
+func (r *DynamoGraphDeploymentRequestReconciler) generateProfiledSpec(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
 	// Retrieve profiling results and generate spec
 	if err := r.generateDGDSpec(ctx, dgdr); err != nil {
+		if after, pending := resultsPendingDelay(err); pending {
+			logger.Info("Profiling results not visible yet, fetching again", "after", after)
+			return ctrl.Result{RequeueAfter: after}, nil
+		}
 		r.Recorder.Event(dgdr, corev1.EventTypeWarning, MessageGenerationFailed, err.Error())
-		return r.updateStateWithCondition(ctx, dgdr, StateFailed, ConditionTypeSpecGenerated, metav1.ConditionFalse, MessageGenerationFailed, err.Error())
+		return r.updateStateToFailed(ctx, dgdr, failureReasonFromError(err, nvidiacomv1alpha1.FailureReasonResultsMissing),
+			ConditionTypeSpecGenerated, MessageGenerationFailed, err.Error())
 	}
 
 	// Record spec generation event
 	r.Recorder.Event(dgdr, corev1.EventTypeNormal, EventReasonSpecGenerated, MessageSpecGenerated)
 
-	// If autoApply is enabled, transition to Deploying state
+	// If autoApply is enabled, transition to Deploying state, otherwise to Ready state
+	state, message := StateReady, MessageSpecAvailable
 	if dgdr.Spec.AutoApply {
-		logger.Info("AutoApply enabled, transitioning to Deploying state")
-		return r.updateStateWithCondition(ctx, dgdr, StateDeploying, ConditionTypeSpecGenerated, metav1.ConditionTrue, EventReasonSpecGenerated, MessageSpecGenerated)
+		state, message = autoApplyState(dgdr), MessageSpecGenerated
+		logger.Info("AutoApply enabled, transitioning", "state", state)
 	}
-
-	// Otherwise, transition to Ready state
-	return r.updateStateWithCondition(ctx, dgdr, StateReady, ConditionTypeSpecGenerated, metav1.ConditionTrue, EventReasonSpecGenerated, MessageSpecAvailable)
+	result, err := r.updateStateWithCondition(ctx, dgdr, state, ConditionTypeSpecGenerated, metav1.ConditionTrue, EventReasonSpecGenerated, message)
+	if err == nil {
+		r.observeSpecGenerated(ctx, dgdr)
+	}
+	return result, err
 }
 
 // handleReadyState handles DGDR in Ready state
@@ -434,26 +903,17 @@ func (r *DynamoGraphDeploymentRequestReconciler) handleReadyState(ctx context.Co
 
 	// Update deployment status
 	dgdr.Status.Deployment.State = dgd.Status.State
+	if err := r.resolveEndpoint(ctx, dgdr, dgd); err != nil {
+		return ctrl.Result{}, err
+	}
 
-	// Check if DGD degraded from Ready
+	// Routine pod restarts briefly flip the DGD out of Ready, so only enter Degraded here and
+	// fall back to Deploying once the DGD stays non-Ready
 	if dgd.Status.State != "Ready" {
-		logger.Info("DGD degraded, transitioning back to Deploying",
-			"dgdState", dgd.Status.State)
-
-		dgdr.Status.State = StateDeploying
-
-		r.Recorder.Event(dgdr, corev1.EventTypeWarning, EventReasonDeploymentDegraded,
-			fmt.Sprintf(MessageDeploymentDegraded, dgd.Name, dgd.Status.State))
-
-		meta.SetStatusCondition(&dgdr.Status.Conditions, metav1.Condition{
-			Type:    ConditionTypeDeploymentReady,
-			Status:  metav1.ConditionFalse,
-			Reason:  EventReasonDeploymentDegraded,
-			Message: fmt.Sprintf("Deployment degraded to %s", dgd.Status.State),
-		})
+		return r.enterDegradedState(ctx, dgdr, dgd)
 	}
 
-	return ctrl.Result{}, r.Status().Update(ctx, dgdr)
+	return ctrl.Result{}, r.updateStatus(ctx, dgdr)
 }
 
 // handleDeployingState handles DGD creation and monitors deployment
@@ -465,11 +925,18 @@ func (r *DynamoGraphDeploymentRequestReconciler) handleDeployingState(ctx contex
 		// Shouldn't be in this state without autoApply
 		logger.Info("AutoApply not enabled, transitioning to Ready")
 		dgdr.Status.State = StateReady
-		return ctrl.Result{}, r.Status().Update(ctx, dgdr)
+		return ctrl.Result{}, r.updateStatus(ctx, dgdr)
 	}
 
 	// Check if we need to create DGD
 	if dgdr.Status.Deployment == nil || !dgdr.Status.Deployment.Created {
+		// A recreated DGD is created again once the previous one is gone
+		if terminating, err := r.deploymentTerminating(ctx, dgdr); terminating || err != nil {
+			return ctrl.Result{RequeueAfter: recreateWaitInterval}, err
+		}
+		if result, err := r.waitForMaintenanceWindow(ctx, dgdr, "creating the deployment"); result != nil || err != nil {
+			return *result, err
+		}
 		return r.createDGD(ctx, dgdr)
 	}
 
@@ -489,6 +956,31 @@ func (r *DynamoGraphDeploymentRequestReconciler) handleDeployingState(ctx contex
 		return ctrl.Result{}, err
 	}
 
+	// A re-profiled spec replaces the one applied before as spec.updateStrategy says. DGDs applied
+	// before the hash was recorded are left alone.
+	if applied, exists := dgd.Annotations[AnnotationGeneratedSpecHash]; exists {
+		hash, err := generatedSpecHash(dgdr)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		switch {
+		case applied == hash:
+			meta.RemoveStatusCondition(&dgdr.Status.Conditions, ConditionTypeUpdatePending)
+		case getUpdateStrategy(dgdr) == nvidiacomv1alpha1.DGDUpdateStrategyManual:
+			r.setUpdatePending(dgdr, dgd, applied, hash)
+		default:
+			if result, err := r.waitForMaintenanceWindow(ctx, dgdr, "rolling out the re-profiled spec"); result != nil || err != nil {
+				return *result, err
+			}
+			logger.Info("Generated spec changed, updating DGD", "name", dgd.Name, "appliedHash", applied, "specHash", hash,
+				"updateStrategy", getUpdateStrategy(dgdr))
+			if getUpdateStrategy(dgdr) == nvidiacomv1alpha1.DGDUpdateStrategyRecreate {
+				return r.recreateDGD(ctx, dgdr, dgd)
+			}
+			return r.createDGD(ctx, dgdr)
+		}
+	}
+
 	// Update deployment status
 	dgdr.Status.Deployment.State = dgd.Status.State
 
@@ -506,9 +998,45 @@ func (r *DynamoGraphDeploymentRequestReconciler) handleDeployingState(ctx contex
 			Reason:  EventReasonDeploymentReady,
 			Message: fmt.Sprintf(MessageDeploymentReady, dgd.Name),
 		})
+		dgdr.Status.Deployment.DeployingSince = nil
+		dgdr.Status.Deployment.SchedulingDiagnostics = nil
+		if err := r.resolveEndpoint(ctx, dgdr, dgd); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{}, r.updateStatus(ctx, dgdr)
+	}
+
+	// Fail instead of waiting forever if the DGD misses its readiness deadline
+	var recheck time.Duration
+	if remaining, enforced := deploymentReadyRemaining(dgdr); enforced {
+		if remaining <= 0 {
+			return r.failDeploymentTimeout(ctx, dgdr, dgd)
+		}
+		// Check the deadline again even if the DGD does not change
+		recheck = remaining
+	}
+
+	if r.reportPendingPods(ctx, dgdr, dgd) && (recheck == 0 || recheck > pendingPodsRecheckInterval) {
+		recheck = pendingPodsRecheckInterval
 	}
+	if err := r.updateStatus(ctx, dgdr); err != nil {
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{RequeueAfter: recheck}, nil
+}
 
-	return ctrl.Result{}, r.Status().Update(ctx, dgdr)
+// generatedSpecHash returns the hash of the deployment generated for the DGDR, which is recorded in
+// AnnotationGeneratedSpecHash on the DGD it was applied to
+func generatedSpecHash(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) (string, error) {
+	desired, err := dgdr.BuildDeployment()
+	if err != nil {
+		return "", err
+	}
+	hash, err := commonController.GetSpecHash(desired)
+	if err != nil {
+		return "", fmt.Errorf("failed to hash generated deployment: %w", err)
+	}
+	return hash, nil
 }
 
 // handleDeploymentDeletedState is a terminal state for when auto-created DGD is deleted
@@ -525,6 +1053,8 @@ func (r *DynamoGraphDeploymentRequestReconciler) handleDGDDeleted(ctx context.Co
 
 	dgdr.Status.State = StateDeploymentDeleted
 	dgdr.Status.Deployment.State = "Deleted"
+	dgdr.Status.Endpoint = nil
+	untrackOwnedResource(dgdr, "DynamoGraphDeployment", dgdr.Status.Deployment.Namespace, dgdr.Status.Deployment.Name)
 
 	r.Recorder.Event(dgdr, corev1.EventTypeWarning, EventReasonDeploymentDeleted,
 		fmt.Sprintf(MessageDeploymentDeleted, dgdr.Status.Deployment.Name))
@@ -536,183 +1066,229 @@ func (r *DynamoGraphDeploymentRequestReconciler) handleDGDDeleted(ctx context.Co
 		Message: "Deployment was deleted by user. Create a new DGDR to redeploy.",
 	})
 
-	return ctrl.Result{}, r.Status().Update(ctx, dgdr)
+	return ctrl.Result{}, r.updateStatus(ctx, dgdr)
 }
 
-// createDGD creates a DynamoGraphDeployment with the generated spec
+// createDGD creates the DynamoGraphDeployment with the generated spec, or patches it if the spec was regenerated
 func (r *DynamoGraphDeploymentRequestReconciler) createDGD(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) (ctrl.Result, error) {
 	logger := log.FromContext(ctx)
 
-	// Extract DGD from RawExtension
-	if dgdr.Status.GeneratedDeployment == nil {
-		return ctrl.Result{}, fmt.Errorf("generatedDeployment is not set")
+	dgd, err := dgdr.BuildDeployment()
+	if err != nil {
+		return ctrl.Result{}, err
 	}
+	dgdName, dgdNamespace := dgd.Name, dgd.Namespace
 
-	generatedDGD := &nvidiacomv1alpha1.DynamoGraphDeployment{}
-
-	// RawExtension can have either Object (already decoded) or Raw (JSON bytes)
-	if dgdr.Status.GeneratedDeployment.Object != nil {
-		var ok bool
-		generatedDGD, ok = dgdr.Status.GeneratedDeployment.Object.(*nvidiacomv1alpha1.DynamoGraphDeployment)
-		if !ok {
-			return ctrl.Result{}, fmt.Errorf("generatedDeployment.Object is not a DynamoGraphDeployment")
-		}
-	} else if dgdr.Status.GeneratedDeployment.Raw != nil {
-		if err := yaml.Unmarshal(dgdr.Status.GeneratedDeployment.Raw, generatedDGD); err != nil {
-			return ctrl.Result{}, fmt.Errorf("failed to unmarshal generated deployment: %w", err)
-		}
-	} else {
-		return ctrl.Result{}, fmt.Errorf("generatedDeployment has neither Object nor Raw set")
+	if isStaleApplyBlocked(dgdr) {
+		logger.Info("Generated spec expired, not applying it", "expiry", dgdr.Status.GeneratedSpecExpiry)
+		r.Recorder.Event(dgdr, corev1.EventTypeWarning, EventReasonStaleApplyBlocked, MessageStaleApplyBlocked)
+		meta.SetStatusCondition(&dgdr.Status.Conditions, metav1.Condition{
+			Type:               ConditionTypeDeploymentReady,
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: dgdr.Generation,
+			Reason:             ReasonStaleSpecBlocked,
+			Message:            MessageStaleApplyBlocked,
+		})
+		return ctrl.Result{}, r.updateStatus(ctx, dgdr)
 	}
 
-	// Determine DGD name and namespace
-	dgdName := generatedDGD.Name
-	dgdNamespace := dgdr.Namespace
+	// Note: We don't set owner reference on DGD
+	// If a DGDR is deleted, the DGD may be serving traffic, spec.cleanupPolicy decides whether it persists.
+	// We use labels (LabelDGDRName) to track the relationship.
 
-	if dgdr.Spec.DeploymentOverrides != nil {
-		if dgdr.Spec.DeploymentOverrides.Name != "" {
-			dgdName = dgdr.Spec.DeploymentOverrides.Name
-		}
-		if dgdr.Spec.DeploymentOverrides.Namespace != "" {
-			dgdNamespace = dgdr.Spec.DeploymentOverrides.Namespace
+	if err := r.ensureServiceAccounts(ctx, dgdr, dgdNamespace); err != nil {
+		r.Recorder.Event(dgdr, corev1.EventTypeWarning, MessageDeploymentCreationFailed, err.Error())
+		// Surface misconfigured RBAC in the conditions, it will not resolve by retrying alone
+		if reason := rbacConditionReason(err, ""); reason != "" {
+			meta.SetStatusCondition(&dgdr.Status.Conditions, metav1.Condition{
+				Type:               ConditionTypeDeploymentReady,
+				Status:             metav1.ConditionFalse,
+				ObservedGeneration: dgdr.Generation,
+				Reason:             reason,
+				Message:            err.Error(),
+			})
+			if updateErr := r.updateStatus(ctx, dgdr); updateErr != nil {
+				logger.Error(updateErr, "Failed to record RBAC failure in status")
+			}
 		}
+		return ctrl.Result{}, err
 	}
 
-	// Build labels (start with generated DGD's labels)
-	labels := make(map[string]string)
-	if generatedDGD.Labels != nil {
-		for k, v := range generatedDGD.Labels {
-			labels[k] = v
-		}
+	if err := r.ensurePodDisruptionBudgets(ctx, dgdr, dgd); err != nil {
+		r.Recorder.Event(dgdr, corev1.EventTypeWarning, MessageDeploymentCreationFailed, err.Error())
+		return ctrl.Result{}, err
 	}
-	// Add/override with managed labels
-	labels[LabelDGDRName] = dgdr.Name
-	labels[LabelDGDRNamespace] = dgdr.Namespace
-	labels[LabelManagedBy] = LabelValueDynamoOperator
 
-	// Merge custom labels from overrides
-	if dgdr.Spec.DeploymentOverrides != nil && dgdr.Spec.DeploymentOverrides.Labels != nil {
-		for k, v := range dgdr.Spec.DeploymentOverrides.Labels {
-			labels[k] = v
-		}
+	if err := r.ensurePodMonitors(ctx, dgdr, dgd); err != nil {
+		r.Recorder.Event(dgdr, corev1.EventTypeWarning, MessageDeploymentCreationFailed, err.Error())
+		return ctrl.Result{}, err
 	}
 
-	// Build annotations (start with generated DGD's annotations)
-	annotations := make(map[string]string)
-	if generatedDGD.Annotations != nil {
-		for k, v := range generatedDGD.Annotations {
-			annotations[k] = v
-		}
-	}
-	// Merge custom annotations from overrides
-	if dgdr.Spec.DeploymentOverrides != nil && dgdr.Spec.DeploymentOverrides.Annotations != nil {
-		for k, v := range dgdr.Spec.DeploymentOverrides.Annotations {
-			annotations[k] = v
-		}
+	if err := r.applyGeneratedResources(ctx, dgdr, dgdNamespace); err != nil {
+		r.Recorder.Event(dgdr, corev1.EventTypeWarning, MessageDeploymentCreationFailed, err.Error())
+		return ctrl.Result{}, err
 	}
 
-	// Create DGD from generated deployment
-	dgd := &nvidiacomv1alpha1.DynamoGraphDeployment{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:        dgdName,
-			Namespace:   dgdNamespace,
-			Labels:      labels,
-			Annotations: annotations,
-		},
-		Spec: generatedDGD.Spec,
+	hash, err := commonController.GetSpecHash(dgd)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to hash generated deployment: %w", err)
 	}
 
-	// Note: We don't set owner reference on DGD
-	// If a DGDR is deleted, the DGD may be serving traffic and should persist independently.
-	// We use labels (LabelDGDRName) to track the relationship.
+	logger.Info("Applying DynamoGraphDeployment", "name", dgdName, "namespace", dgdNamespace, "specHash", hash)
 
-	logger.Info("Creating DynamoGraphDeployment", "name", dgdName, "namespace", dgdNamespace)
-
-	if err := r.Create(ctx, dgd); err != nil {
-		if apierrors.IsAlreadyExists(err) {
-			// DGD already exists, just update status
-			logger.Info("DGD already exists, updating status")
-			dgdr.Status.Deployment = &nvidiacomv1alpha1.DeploymentStatus{
-				Name:      dgdName,
-				Namespace: dgdNamespace,
-				State:     "Pending",
-				Created:   true,
+	// The hash of the generated spec decides whether an existing DGD is patched, so re-profiled specs
+	// replace the previous one while unchanged specs leave the DGD, and any manual edits, untouched
+	live := &nvidiacomv1alpha1.DynamoGraphDeployment{ObjectMeta: metav1.ObjectMeta{Name: dgdName, Namespace: dgdNamespace}}
+	var previous *nvidiacomv1alpha1.DynamoGraphDeploymentSpec
+	var result controllerutil.OperationResult
+	if isApplyToExisting(dgdr) {
+		result, previous, err = r.mergeIntoExisting(ctx, dgdr, live, dgd, hash)
+	} else {
+		result, err = controllerutil.CreateOrPatch(ctx, r.Client, live, func() error {
+			// DGDs the DGDR did not create are never taken over
+			if !live.CreationTimestamp.IsZero() &&
+				(live.Labels[LabelDGDRName] != dgdr.Name || live.Labels[LabelDGDRNamespace] != dgdr.Namespace) {
+				return errDeploymentConflict
 			}
-			return ctrl.Result{}, r.Status().Update(ctx, dgdr)
-		}
+			if live.Annotations[AnnotationGeneratedSpecHash] == hash {
+				return nil
+			}
+			if !live.CreationTimestamp.IsZero() {
+				previous = live.Spec.DeepCopy()
+			}
+			desired := metadata.Of(dgd)
+			if desired.Annotations == nil {
+				desired.Annotations = map[string]string{}
+			}
+			desired.Annotations[AnnotationGeneratedSpecHash] = hash
+			metadata.Apply(live, desired)
+			live.Spec = dgd.Spec
+			return nil
+		})
+	}
+	if errors.Is(err, errDeploymentConflict) {
+		message := fmt.Sprintf(MessageDeploymentConflict, dgdNamespace, dgdName)
+		r.Recorder.Event(dgdr, corev1.EventTypeWarning, MessageDeploymentCreationFailed, message)
+		return r.updateStateToFailed(ctx, dgdr, nvidiacomv1alpha1.FailureReasonDGDConflict,
+			ConditionTypeDeploymentReady, string(nvidiacomv1alpha1.FailureReasonDGDConflict), message)
+	}
+	if err != nil {
 		r.Recorder.Event(dgdr, corev1.EventTypeWarning, MessageDeploymentCreationFailed, err.Error())
+		if apierrors.IsForbidden(err) {
+			// Retrying will not help until RBAC is fixed, so surface it as a terminal failure
+			return r.updateStateToFailed(ctx, dgdr, nvidiacomv1alpha1.FailureReasonDGDCreateForbidden,
+				ConditionTypeDeploymentReady, MessageDeploymentCreationFailed, err.Error())
+		}
+		return ctrl.Result{}, err
+	}
+	logger.Info("DynamoGraphDeployment applied", "name", dgdName, "result", result)
+	if result != controllerutil.OperationResultNone {
+		r.auditDeploymentApplied(ctx, dgdr, live, previous)
+	}
+
+	if err := r.ownGeneratedResources(ctx, dgdr, live); err != nil {
 		return ctrl.Result{}, err
 	}
+	// A DGD merged into with applyToExisting was not created for the DGDR
+	if !isApplyToExisting(dgdr) {
+		r.trackOwnedResource(ctx, dgdr, nvidiacomv1alpha1.OwnedResourceRoleDGD, live)
+	}
 
 	// Update status
+	now := metav1.Now()
 	dgdr.Status.Deployment = &nvidiacomv1alpha1.DeploymentStatus{
-		Name:      dgdName,
-		Namespace: dgdNamespace,
-		State:     "Pending",
-		Created:   true,
+		Name:           dgdName,
+		Namespace:      dgdNamespace,
+		State:          "Pending",
+		Created:        true,
+		DeployingSince: &now,
 	}
 
-	r.Recorder.Event(dgdr, corev1.EventTypeNormal, EventReasonDeploymentCreated,
-		fmt.Sprintf(MessageDeploymentCreated, dgdName))
+	reason, message := EventReasonDeploymentCreated, MessageDeploymentCreated
+	switch result {
+	case controllerutil.OperationResultUpdated:
+		reason, message = EventReasonDeploymentUpdated, MessageDeploymentUpdated
+	case controllerutil.OperationResultNone:
+		reason, message = EventReasonDeploymentUnchanged, MessageDeploymentUnchanged
+	}
+	r.Recorder.Event(dgdr, corev1.EventTypeNormal, reason, fmt.Sprintf(message, dgdName))
 
 	meta.SetStatusCondition(&dgdr.Status.Conditions, metav1.Condition{
 		Type:    ConditionTypeDeploymentReady,
 		Status:  metav1.ConditionFalse,
-		Reason:  EventReasonDeploymentCreated,
-		Message: fmt.Sprintf("DGD %s created, waiting for Ready", dgdName),
+		Reason:  reason,
+		Message: fmt.Sprintf(MessageDeploymentWaiting, dgdName),
 	})
 
-	logger.Info("DynamoGraphDeployment created successfully", "name", dgdName)
-
-	return ctrl.Result{}, r.Status().Update(ctx, dgdr)
+	return ctrl.Result{}, r.updateStatus(ctx, dgdr)
 }
 
+// errDeploymentConflict means that the DGD to apply exists but was not created by the DGDR
+var errDeploymentConflict = errors.New("DynamoGraphDeployment is not managed by the DGDR")
+
 // handleFailedState handles DGDR in Failed state
 func (r *DynamoGraphDeploymentRequestReconciler) handleFailedState(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) (ctrl.Result, error) {
 	logger := log.FromContext(ctx)
 	logger.Info("DGDR is in failed state", "name", dgdr.Name)
 
-	// Could implement retry logic here if desired
-	return ctrl.Result{}, nil
+	// A fixed profiling ConfigMap does not require recreating the DGDR
+	if restarted, err := r.revalidateProfilingConfigMap(ctx, dgdr); restarted || err != nil {
+		return ctrl.Result{Requeue: restarted}, err
+	}
+
+	return r.handleSupportBundle(ctx, dgdr)
 }
 
-// getProfilingJobName returns the job name for a DGDR
-func getProfilingJobName(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) string {
-	// Use "profile-" prefix for all profiling jobs
-	return fmt.Sprintf("profile-%s", dgdr.Name)
+// GetProfilingJobName returns the job name of the latest profiling attempt of a DGDR
+func GetProfilingJobName(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) string {
+	if n := len(dgdr.Status.Attempts); n > 0 {
+		return dgdr.Status.Attempts[n-1].JobName
+	}
+	return profilingJobNameForAttempt(dgdr, 1)
 }
 
-// getOutputConfigMapName returns the ConfigMap name for profiling output
-func getOutputConfigMapName(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) string {
+// GetOutputConfigMapName returns the ConfigMap name for profiling output
+func GetOutputConfigMapName(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) string {
 	return fmt.Sprintf("%s%s", ConfigMapOutputPrefix, dgdr.Name)
 }
 
-// isOnlineProfiling determines whether online profiling or AI Configurator is being used
-// based on the sweep.use_ai_configurator config value
+// isOnlineProfiling reports whether the profiler deploys and benchmarks candidate configurations
+// on the cluster's GPUs rather than estimating them with the AI Configurator
 func isOnlineProfiling(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) bool {
-	if dgdr.Spec.ProfilingConfig.Config == nil {
-		return true
+	// CPU-only profiling never deploys workers on GPUs
+	if isCPUOnly(dgdr) {
+		return false
 	}
+	return getProfilingMode(dgdr) == nvidiacomv1alpha1.ProfilingModeOnline
+}
 
-	var config map[string]interface{}
-	if err := yaml.Unmarshal(dgdr.Spec.ProfilingConfig.Config.Raw, &config); err != nil {
-		return true // Default to online on parse error
+// validateSpec validates the DGDR spec
+func (r *DynamoGraphDeploymentRequestReconciler) validateSpec(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	if err := validateProfilingMode(dgdr); err != nil {
+		return err
 	}
 
-	if sweep, ok := config["sweep"].(map[string]interface{}); ok {
-		if useAIC, exists := sweep["use_ai_configurator"].(bool); exists {
-			return !useAIC
-		}
+	// Profiling settings are unused when a precomputed deployment is supplied
+	if getProfilingMode(dgdr) == nvidiacomv1alpha1.ProfilingModeNone {
+		return r.validatePrecomputedDeployment(ctx, dgdr)
+	}
+
+	if err := r.validateProfilingSpec(ctx, dgdr); err != nil {
+		return err
 	}
-	// Default to online profiling if not specified
-	return true
+
+	// Probing the profiler image creates its DynamoProfilerCapabilities, so it is left out of validateProfilingSpec
+	return r.validateProfilerCapabilities(ctx, dgdr)
 }
 
-// validateSpec validates the DGDR spec
-func (r *DynamoGraphDeploymentRequestReconciler) validateSpec(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+// validateProfilingSpec validates the profiling settings of the DGDR spec. It only reads the
+// cluster and changes nothing but the warnings of the DGDR, so that the estimate endpoint can
+// validate the DGDRs posted to it.
+func (r *DynamoGraphDeploymentRequestReconciler) validateProfilingSpec(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
 	// Validate profiler image is specified in the new location
 	if dgdr.Spec.ProfilingConfig.ProfilerImage == "" {
-		return errors.New("profilingConfig.profilerImage is required")
+		return withFailureReason(nvidiacomv1alpha1.FailureReasonImageNotConfigured,
+			errors.New("profilingConfig.profilerImage is required"))
 	}
 
 	// Basic validation - check that profilingConfig.config is provided
@@ -721,82 +1297,352 @@ func (r *DynamoGraphDeploymentRequestReconciler) validateSpec(ctx context.Contex
 	}
 
 	// Validate ConfigMap if provided (for the DGD base config)
-	if dgdr.Spec.ProfilingConfig.ConfigMapRef != nil {
-		cm := &corev1.ConfigMap{}
-		err := r.Get(ctx, types.NamespacedName{
-			Name:      dgdr.Spec.ProfilingConfig.ConfigMapRef.Name,
-			Namespace: dgdr.Namespace,
-		}, cm)
+	if err := r.validateProfilingConfigMap(ctx, dgdr); err != nil {
+		return err
+	}
+
+	// Validate Secret if provided (for a DGD base config that embeds credentials)
+	if dgdr.Spec.ProfilingConfig.SecretRef != nil {
+		secret := &corev1.Secret{}
+		err := r.Get(ctx, types.NamespacedName{
+			Name:      dgdr.Spec.ProfilingConfig.SecretRef.Name,
+			Namespace: dgdr.Namespace,
+		}, secret)
 
 		if err != nil {
 			if apierrors.IsNotFound(err) {
-				return fmt.Errorf(MessageConfigMapNotFound,
-					dgdr.Spec.ProfilingConfig.ConfigMapRef.Name, dgdr.Namespace)
+				return fmt.Errorf(MessageSecretNotFound,
+					dgdr.Spec.ProfilingConfig.SecretRef.Name, dgdr.Namespace)
 			}
 			return err
 		}
 
-		// Validate key exists
-		key := dgdr.Spec.ProfilingConfig.ConfigMapRef.Key
+		key := dgdr.Spec.ProfilingConfig.SecretRef.Key
 		if key == "" {
-			key = "disagg.yaml"
+			key = ProfilingConfigFile
 		}
 
-		if _, exists := cm.Data[key]; !exists {
-			return fmt.Errorf(MessageConfigMapKeyNotFound, key, cm.Name)
+		if _, exists := secret.Data[key]; !exists {
+			return fmt.Errorf(MessageSecretKeyNotFound, key, secret.Name)
+		}
+	}
+
+	if err := r.validateImageAllowlist(ctx, dgdr); err != nil {
+		return err
+	}
+
+	if err := r.validateImageArchitectures(ctx, dgdr); err != nil {
+		return err
+	}
+
+	if err := validateWorkloadType(dgdr); err != nil {
+		return err
+	}
+
+	if err := validateHardware(dgdr); err != nil {
+		return err
+	}
+
+	if err := r.validateCompatibility(dgdr); err != nil {
+		return err
+	}
+
+	if err := r.validateFeatures(dgdr); err != nil {
+		return err
+	}
+
+	if err := r.validateModelFit(dgdr); err != nil {
+		return err
+	}
+
+	if err := validateBackendSelection(dgdr); err != nil {
+		return err
+	}
+
+	if err := validateOutput(dgdr); err != nil {
+		return err
+	}
+
+	if err := r.validateWorkloadFlavor(dgdr); err != nil {
+		return err
+	}
+
+	if err := validateOutputKey(dgdr); err != nil {
+		return err
+	}
+
+	if err := r.validateServiceAccounts(dgdr); err != nil {
+		return err
+	}
+
+	if err := r.validatePodAnnotations(dgdr); err != nil {
+		return err
+	}
+
+	if errs := ValidateServiceOverrides(dgdr); len(errs) > 0 {
+		return errs.ToAggregate()
+	}
+
+	if errs := ValidateDeploymentPatches(dgdr); len(errs) > 0 {
+		return errs.ToAggregate()
+	}
+
+	if errs := ValidateSecrets(dgdr); len(errs) > 0 {
+		return errs.ToAggregate()
+	}
+
+	if err := r.validatePinImageDigests(dgdr); err != nil {
+		return err
+	}
+
+	if err := validateCPUOnly(dgdr); err != nil {
+		return err
+	}
+
+	if err := validateAdapters(dgdr); err != nil {
+		return err
+	}
+
+	if err := r.validateResultTransport(dgdr); err != nil {
+		return err
+	}
+
+	if err := r.validateSupportBundle(dgdr); err != nil {
+		return err
+	}
+
+	if err := validateHooks(dgdr); err != nil {
+		return err
+	}
+
+	if err := r.validateNetworkIsolation(dgdr); err != nil {
+		return err
+	}
+
+	if err := r.validateArtifactsPVC(ctx, dgdr); err != nil {
+		return err
+	}
+
+	if err := validateMaintenanceWindow(dgdr); err != nil {
+		return err
+	}
+
+	if err := validateGeneratedSpecValidity(dgdr); err != nil {
+		return err
+	}
+
+	if err := validateApproval(dgdr); err != nil {
+		return err
+	}
+
+	// GPU-specific features are ignored when profiling without GPUs
+	if isCPUOnly(dgdr) {
+		if dgdr.Spec.ProfilingConfig.NodeReservation != nil || dgdr.Spec.ProfilingConfig.RecordUtilization {
+			setWarning(dgdr, WarningGPUFeaturesIgnored,
+				"profilingConfig.nodeReservation and profilingConfig.recordUtilization are ignored with profilingConfig.cpuOnly")
+		}
+	} else {
+		if err := r.validateNodeReservation(dgdr); err != nil {
+			return err
+		}
+
+		if err := r.validateRecordUtilization(dgdr); err != nil {
+			return err
 		}
 	}
 
 	// Parse config to validate structure
-	var config map[string]interface{}
-	if err := yaml.Unmarshal(dgdr.Spec.ProfilingConfig.Config.Raw, &config); err != nil {
+	config, err := unmarshalProfilingConfig(dgdr)
+	if err != nil {
 		return fmt.Errorf("failed to parse profilingConfig.config: %w", err)
 	}
 
 	// Warn if deployment.model or engine.backend are specified in config (they will be overwritten by spec fields)
-	if engineConfig, ok := config["engine"].(map[string]interface{}); ok {
-		if backend, ok := engineConfig["backend"].(string); ok && backend != "" && backend != dgdr.Spec.Backend {
-			logger := log.FromContext(ctx)
-			logger.Info("Warning: profilingConfig.config.engine.backend will be overwritten by spec.backend",
-				"configBackend", backend, "specBackend", dgdr.Spec.Backend)
-		}
+	engineConfig, _ := config["engine"].(map[string]interface{})
+	if backend, ok := engineConfig["backend"].(string); ok && backend != "" && backend != dgdr.Spec.Backend && dgdr.Spec.Backend != BackendAuto {
+		logger := log.FromContext(ctx)
+		logger.Info("Warning: profilingConfig.config.engine.backend will be overwritten by spec.backend",
+			"configBackend", backend, "specBackend", dgdr.Spec.Backend)
+		setWarning(dgdr, WarningBackendOverwritten,
+			fmt.Sprintf("profilingConfig.config.engine.backend %q is overwritten by spec.backend %q", backend, dgdr.Spec.Backend))
+	} else {
+		clearWarning(dgdr, WarningBackendOverwritten)
 	}
-	if deployment, ok := config["deployment"].(map[string]interface{}); ok {
-		if model, ok := deployment["model"].(string); ok && model != "" && model != dgdr.Spec.Model {
-			logger := log.FromContext(ctx)
-			logger.Info("Warning: profilingConfig.config.deployment.model will be overwritten by spec.model",
-				"configModel", model, "specModel", dgdr.Spec.Model)
-		}
+	deployment, _ := config["deployment"].(map[string]interface{})
+	if model, ok := deployment["model"].(string); ok && model != "" && model != modelName(dgdr) {
+		logger := log.FromContext(ctx)
+		logger.Info("Warning: profilingConfig.config.deployment.model will be overwritten by spec.model",
+			"configModel", model, "specModel", modelName(dgdr))
+		setWarning(dgdr, WarningModelOverwritten,
+			fmt.Sprintf("profilingConfig.config.deployment.model %q is overwritten by spec.model %q", model, modelName(dgdr)))
+	} else {
+		clearWarning(dgdr, WarningModelOverwritten)
 	}
 
+	warnOverwrittenLoadTarget(dgdr, config)
+
 	// The profiler will validate the rest of the configuration
 	return nil
 }
 
+// profilingDeploymentLabels returns the labels the profiler sets on the DGDs it deploys for the DGDR
+// and on their pods
+func profilingDeploymentLabels(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) map[string]string {
+	return map[string]string{
+		LabelDGDRName:      dgdr.Name,
+		LabelDGDRNamespace: dgdr.Namespace,
+	}
+}
+
+// buildProfilingConfig returns the profiler config of the DGDR: profilingConfig.config with the
+// settings derived from the rest of the spec applied
+func buildProfilingConfig(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) (map[string]interface{}, error) {
+	// Parse the profiling config from JSON
+	config, err := unmarshalProfilingConfig(dgdr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse profiling config: %w", err)
+	}
+	if config == nil {
+		config = map[string]interface{}{}
+	}
+
+	// Set deployment.namespace if not already set
+	deploymentVal, hasDeployment := config["deployment"]
+	var deploymentConfig map[string]interface{}
+	if !hasDeployment || deploymentVal == nil {
+		deploymentConfig = make(map[string]interface{})
+		config["deployment"] = deploymentConfig
+	} else {
+		var ok bool
+		deploymentConfig, ok = deploymentVal.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("profilingConfig.config.deployment must be an object, got %T", deploymentVal)
+		}
+	}
+	if _, hasNamespace := deploymentConfig["namespace"]; !hasNamespace {
+		deploymentConfig["namespace"] = dgdr.Namespace
+	}
+
+	// Set deployment.model from spec.model, or the model and revision spec.modelRef resolved to
+	deploymentConfig["model"] = modelName(dgdr)
+	if revision := modelRevision(dgdr); revision != "" {
+		deploymentConfig[ConfigKeyModelRevision] = revision
+	}
+
+	// Label the profiling DGDs and their pods with the DGDR, telling them apart from other workloads
+	labels, _ := deploymentConfig["labels"].(map[string]interface{})
+	if labels == nil {
+		labels = map[string]interface{}{}
+	}
+	for key, value := range profilingDeploymentLabels(dgdr) {
+		labels[key] = value
+	}
+	deploymentConfig["labels"] = labels
+
+	// Pin profiling deployments to the reserved nodes
+	if needsNodeReservation(dgdr) {
+		deploymentConfig["node_selector"], deploymentConfig["tolerations"] = profilingSchedulingConfig(dgdr)
+	}
+
+	// Profile serving with the adapters loaded
+	if len(dgdr.Spec.Adapters) > 0 {
+		deploymentConfig["adapters"] = adaptersProfilingConfig(dgdr)
+	}
+
+	// Set deployment.dgd_image from deploymentOverrides.workersImage if provided
+	if dgdr.Spec.DeploymentOverrides != nil && dgdr.Spec.DeploymentOverrides.WorkersImage != "" {
+		deploymentConfig["dgd_image"] = dgdr.Spec.DeploymentOverrides.WorkersImage
+	}
+
+	// Tell the profiler to skip cluster GPU discovery
+	if isCPUOnly(dgdr) {
+		hardwareConfig, ok := config["hardware"].(map[string]interface{})
+		if !ok {
+			hardwareConfig = make(map[string]interface{})
+			config["hardware"] = hardwareConfig
+		}
+		hardwareConfig["cpu_only"] = true
+	}
+
+	// Tell the profiler which GPUs to request for its profiling deployments
+	applyHardwareConfig(dgdr, config)
+
+	// Set output_dir if not already set
+	if _, hasOutputDir := config["output_dir"]; !hasOutputDir {
+		config["output_dir"] = ProfilingOutputPath
+	}
+
+	// Run the profiler in the profiling mode of the DGDR
+	if err := applyProfilingModeConfig(dgdr, config); err != nil {
+		return nil, err
+	}
+
+	// Re-sweep around the results of the previous attempt restored by the init container
+	if isDifferentialProfiling(dgdr) {
+		config[ConfigKeyPreviousResults] = fmt.Sprintf("%s/%s", ProfilingCheckpointPath, PreviousResultsFile)
+	}
+
+	// Set engine.backend from spec.backend
+	engineVal, hasEngine := config["engine"]
+	var engineConfig map[string]interface{}
+	if !hasEngine || engineVal == nil {
+		engineConfig = make(map[string]interface{})
+		config["engine"] = engineConfig
+	} else {
+		var ok bool
+		engineConfig, ok = engineVal.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("profilingConfig.config.engine must be an object, got %T", engineVal)
+		}
+	}
+	engineConfig["backend"] = dgdr.Spec.Backend
+
+	// Size the profiled deployment for the load target
+	if err := applyLoadTargetConfig(dgdr, config); err != nil {
+		return nil, err
+	}
+
+	// Older profilers only know generative workloads, so the type is only passed when it differs
+	if !isGenerative(dgdr) {
+		engineConfig[ConfigKeyWorkloadType] = string(getWorkloadType(dgdr))
+	}
+
+	applyFeaturesConfig(dgdr, engineConfig)
+
+	// For backend auto, AIC evaluates every candidate; the first one is the profiler's default
+	if dgdr.Spec.Backend == BackendAuto {
+		candidates := candidateBackends(dgdr)
+		engineConfig["backend"] = candidates[0]
+
+		sweepConfig, ok := config["sweep"].(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("profilingConfig.config.sweep must be an object, got %T", config["sweep"])
+		}
+		sweepConfig["aic_backends"] = candidates
+	}
+
+	// If ConfigMapRef or SecretRef is provided, set engine.config path
+	if dgdr.Spec.ProfilingConfig.ConfigMapRef != nil || dgdr.Spec.ProfilingConfig.SecretRef != nil {
+		engineConfig["config"] = fmt.Sprintf("%s/%s", ProfilingConfigPath, ProfilingConfigFile)
+	}
+
+	return config, nil
+}
+
 // createProfilingJob creates a Kubernetes Job for profiling using SyncResource
 func (r *DynamoGraphDeploymentRequestReconciler) createProfilingJob(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
 	logger := log.FromContext(ctx)
 
-	// Delete any existing output ConfigMap to ensure fresh profiling results
+	if err := r.FaultInjector.failJobCreation(ctx); err != nil {
+		return err
+	}
+
+	// Delete any existing profiling output to ensure fresh profiling results
 	// This prevents using stale data from previous profiling runs
-	outputConfigMapName := getOutputConfigMapName(dgdr)
-	existingCM := &corev1.ConfigMap{}
-	err := r.Get(ctx, types.NamespacedName{
-		Name:      outputConfigMapName,
-		Namespace: dgdr.Namespace,
-	}, existingCM)
-	if err == nil {
-		// ConfigMap exists, delete it
-		logger.Info("Deleting existing output ConfigMap to ensure fresh profiling results", "configMap", outputConfigMapName)
-		if err := r.Delete(ctx, existingCM); err != nil && !apierrors.IsNotFound(err) {
-			logger.Error(err, "Failed to delete existing output ConfigMap", "configMap", outputConfigMapName)
-			return fmt.Errorf("failed to delete existing output ConfigMap: %w", err)
-		}
-		logger.Info("Successfully deleted old output ConfigMap", "configMap", outputConfigMapName)
-	} else if !apierrors.IsNotFound(err) {
-		// Unexpected error checking for ConfigMap
-		logger.Error(err, "Failed to check for existing output ConfigMap", "configMap", outputConfigMapName)
-		return fmt.Errorf("failed to check for existing output ConfigMap: %w", err)
+	transport := r.resultTransport(dgdr)
+	if err := replacePreviousResults(ctx, dgdr, transport); err != nil {
+		logger.Error(err, "Failed to delete existing profiling output", "results", transport.Reference(dgdr))
+		return err
 	}
 
 	// Ensure profiling job RBAC exists (only for cluster-wide installation)
@@ -812,266 +1658,341 @@ func (r *DynamoGraphDeploymentRequestReconciler) createProfilingJob(ctx context.
 		}
 	}
 
-	// Use SyncResource to create/update the job
-	modified, job, err := commonController.SyncResource(ctx, r, dgdr, func(ctx context.Context) (*batchv1.Job, bool, error) {
-		jobName := getProfilingJobName(dgdr)
-		outputConfigMapName := getOutputConfigMapName(dgdr)
-
-		// Parse the profiling config from JSON
-		var config map[string]interface{}
-		if err := yaml.Unmarshal(dgdr.Spec.ProfilingConfig.Config.Raw, &config); err != nil {
-			return nil, false, fmt.Errorf("failed to parse profiling config: %w", err)
+	if getResultTransport(dgdr) == nvidiacomv1alpha1.ResultTransportHTTP {
+		if err := r.ensureResultsCAConfigMap(ctx, dgdr); err != nil {
+			return err
 		}
+	}
 
-		// Set deployment.namespace if not already set
-		deploymentVal, hasDeployment := config["deployment"]
-		var deploymentConfig map[string]interface{}
-		if !hasDeployment || deploymentVal == nil {
-			deploymentConfig = make(map[string]interface{})
-			config["deployment"] = deploymentConfig
-		} else {
-			var ok bool
-			deploymentConfig, ok = deploymentVal.(map[string]interface{})
-			if !ok {
-				return nil, false, fmt.Errorf("profilingConfig.config.deployment must be an object, got %T", deploymentVal)
-			}
-		}
-		if _, hasNamespace := deploymentConfig["namespace"]; !hasNamespace {
-			deploymentConfig["namespace"] = dgdr.Namespace
-		}
+	// Each attempt gets its own job so that the jobs of earlier attempts can be kept
+	attempt := startProfilingAttempt(dgdr)
+	if err := recordProfilingInputs(dgdr, attempt); err != nil {
+		return err
+	}
+	jobName := attempt.JobName
 
-		// Set deployment.model from spec.model
-		deploymentConfig["model"] = dgdr.Spec.Model
+	// Use SyncResource to create/update the job
+	modified, job, err := commonController.SyncResource(ctx, r, dgdr, func(ctx context.Context) (*batchv1.Job, bool, error) {
+		job, err := r.buildProfilingJob(ctx, dgdr, jobName, transport)
+		return job, false, err
+	}, commonController.WithManagedMetadata())
 
-		// Set deployment.dgd_image from deploymentOverrides.workersImage if provided
-		if dgdr.Spec.DeploymentOverrides != nil && dgdr.Spec.DeploymentOverrides.WorkersImage != "" {
-			deploymentConfig["dgd_image"] = dgdr.Spec.DeploymentOverrides.WorkersImage
-		}
+	if err != nil {
+		return err
+	}
+	r.trackOwnedResource(ctx, dgdr, nvidiacomv1alpha1.OwnedResourceRoleProfilingJob, job)
 
-		// Set output_dir if not already set
-		if _, hasOutputDir := config["output_dir"]; !hasOutputDir {
-			config["output_dir"] = ProfilingOutputPath
-		}
+	if modified {
+		logger.Info("Profiling job created/updated", "job", job.Name)
+	}
 
-		// Set engine.backend from spec.backend
-		engineVal, hasEngine := config["engine"]
-		var engineConfig map[string]interface{}
-		if !hasEngine || engineVal == nil {
-			engineConfig = make(map[string]interface{})
-			config["engine"] = engineConfig
-		} else {
-			var ok bool
-			engineConfig, ok = engineVal.(map[string]interface{})
-			if !ok {
-				return nil, false, fmt.Errorf("profilingConfig.config.engine must be an object, got %T", engineVal)
-			}
-		}
-		engineConfig["backend"] = dgdr.Spec.Backend
+	return r.pruneProfilingAttempts(ctx, dgdr)
+}
 
-		// If ConfigMapRef is provided, set engine.config path
-		if dgdr.Spec.ProfilingConfig.ConfigMapRef != nil {
-			engineConfig["config"] = fmt.Sprintf("%s/%s", ProfilingConfigPath, ProfilingConfigFile)
-		}
+// buildProfilingJob renders the profiling job of the DGDR, with its profiler, results sidecar and
+// checkpoint restorer containers
+func (r *DynamoGraphDeploymentRequestReconciler) buildProfilingJob(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, jobName string, transport ResultTransport) (*batchv1.Job, error) {
+	logger := log.FromContext(ctx)
 
-		// Serialize config to YAML for passing to profiler
-		configYAML, err := yaml.Marshal(config)
-		if err != nil {
-			return nil, false, fmt.Errorf("failed to marshal profiling config to YAML: %w", err)
-		}
+	config, err := buildProfilingConfig(dgdr)
+	if err != nil {
+		return nil, err
+	}
 
-		// Common environment variables
-		profilerEnv := []corev1.EnvVar{
-			{
-				Name: "HUGGING_FACE_HUB_TOKEN",
-				ValueFrom: &corev1.EnvVarSource{
-					SecretKeyRef: &corev1.SecretKeySelector{
-						LocalObjectReference: corev1.LocalObjectReference{
-							Name: "hf-token-secret",
-						},
-						Key: "HF_TOKEN",
+	// Serialize config to YAML for passing to profiler
+	configYAML, err := yaml.Marshal(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal profiling config to YAML: %w", err)
+	}
+
+	// Common environment variables
+	profilerEnv := []corev1.EnvVar{
+		{
+			Name: "HUGGING_FACE_HUB_TOKEN",
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: hfTokenSecretName(dgdr),
 					},
+					Key: HFTokenSecretKey,
 				},
 			},
-			{
-				Name:  "NATS_SERVER",
-				Value: fmt.Sprintf("nats://%s-nats:4222", dgdr.Namespace),
-			},
-			{
-				Name:  "ETCD_ENDPOINTS",
-				Value: fmt.Sprintf("%s-etcd:2379", dgdr.Namespace),
-			},
-			// DGDR metadata for setting ownerReferences
-			{
-				Name:  "DGDR_NAME",
-				Value: dgdr.Name,
-			},
-			{
-				Name:  "DGDR_NAMESPACE",
-				Value: dgdr.Namespace,
-			},
-			{
-				Name:  "DGDR_UID",
-				Value: string(dgdr.UID),
-			},
-		}
+		},
+		{
+			Name:  "NATS_SERVER",
+			Value: fmt.Sprintf("nats://%s-nats:4222", dgdr.Namespace),
+		},
+		{
+			Name:  "ETCD_ENDPOINTS",
+			Value: fmt.Sprintf("%s-etcd:2379", dgdr.Namespace),
+		},
+		// DGDR metadata for setting ownerReferences
+		{
+			Name:  "DGDR_NAME",
+			Value: dgdr.Name,
+		},
+		{
+			Name:  "DGDR_NAMESPACE",
+			Value: dgdr.Namespace,
+		},
+		{
+			Name:  "DGDR_UID",
+			Value: string(dgdr.UID),
+		},
+	}
 
-		// Build volume mounts
-		volumeMounts := []corev1.VolumeMount{
-			{
-				Name:      VolumeNameProfilingOutput,
-				MountPath: ProfilingOutputPath,
-			},
-		}
+	// Build volume mounts
+	volumeMounts := []corev1.VolumeMount{
+		{
+			Name:      VolumeNameProfilingOutput,
+			MountPath: ProfilingOutputPath,
+		},
+		{
+			Name:      VolumeNameProfilingCheckpoint,
+			MountPath: ProfilingCheckpointPath,
+			ReadOnly:  true,
+		},
+	}
 
-		// Add ConfigMap volume mount if provided
-		if dgdr.Spec.ProfilingConfig.ConfigMapRef != nil {
-			volumeMounts = append(volumeMounts, corev1.VolumeMount{
-				Name:      VolumeNameProfilingConfig,
-				MountPath: ProfilingConfigPath,
-				ReadOnly:  true,
-			})
-		}
+	// Add ConfigMap or Secret volume mount if provided
+	if dgdr.Spec.ProfilingConfig.ConfigMapRef != nil || dgdr.Spec.ProfilingConfig.SecretRef != nil {
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{
+			Name:      VolumeNameProfilingConfig,
+			MountPath: ProfilingConfigPath,
+			ReadOnly:  true,
+		})
+	}
 
-		// Profiler args: pass the config as an inline YAML string via --profile-config
-		profilerArgs := []string{
-			"--profile-config", string(configYAML),
-		}
+	// Profiler args: pass the config as an inline YAML string via --profile-config
+	profilerArgs := []string{
+		"--profile-config", string(configYAML),
+	}
 
-		// Use profiler image from profilingConfig
-		imageName := dgdr.Spec.ProfilingConfig.ProfilerImage
-		logger.Info("Using profiler image", "image", imageName)
-
-		profilerContainer := corev1.Container{
-			Name:    ContainerNameProfiler,
-			Image:   imageName,
-			Command: []string{"python", "-m", "benchmarks.profiler.profile_sla"},
-			Args:    profilerArgs,
-			Resources: corev1.ResourceRequirements{
-				Requests: corev1.ResourceList{
-					corev1.ResourceCPU:    resource.MustParse("16"),
-					corev1.ResourceMemory: resource.MustParse("10Gi"),
-				},
-			},
-			Env:          profilerEnv,
-			VolumeMounts: volumeMounts,
-		}
+	// Resume the sweep from the checkpoint restored by the init container, unless the config names one
+	profilerCommand := []string{"python", "-m", "benchmarks.profiler.profile_sla"}
+	if _, isSet := config[ConfigKeyResumeFrom]; !isSet {
+		profilerCommand = resumeFromCheckpointCommand(profilerCommand)
+	}
 
-		// Generate sidecar script from template
-		tmpl, err := template.New("sidecar").Parse(sidecarScriptTemplate)
-		if err != nil {
-			return nil, false, fmt.Errorf("failed to parse sidecar script template: %w", err)
-		}
+	// Use profiler image from profilingConfig
+	imageName := dgdr.Spec.ProfilingConfig.ProfilerImage
+	logger.Info("Using profiler image", "image", imageName)
+
+	profilerContainer := corev1.Container{
+		Name:         ContainerNameProfiler,
+		Image:        imageName,
+		Command:      profilerCommand,
+		Args:         profilerArgs,
+		Resources:    getProfilerResources(dgdr),
+		Env:          profilerEnv,
+		VolumeMounts: volumeMounts,
+	}
 
-		var scriptBuf bytes.Buffer
-		err = tmpl.Execute(&scriptBuf, map[string]string{
-			"OutputPath":    ProfilingOutputPath,
-			"OutputFile":    ProfilingOutputFile,
-			"ConfigMapName": outputConfigMapName,
-			"Namespace":     dgdr.Namespace,
-			"DGDRName":      dgdr.Name,
+	// Generate sidecar script from template
+	tmpl, err := template.New("sidecar").Parse(sidecarScriptTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse sidecar script template: %w", err)
+	}
+
+	artifactsDir := ""
+	if hasArtifacts(dgdr) {
+		artifactsDir = getArtifactsDir(dgdr)
+	}
+
+	var scriptBuf bytes.Buffer
+	err = tmpl.Execute(&scriptBuf, map[string]string{
+		"OutputPath":           ProfilingOutputPath,
+		"OutputFile":           getProfilingOutputKey(dgdr),
+		"ComparisonFile":       ProfilingComparisonFile,
+		"WindowsFile":          ProfilingWindowsFile,
+		"StagingDir":           ResultsStagingDir,
+		"Namespace":            dgdr.Namespace,
+		"Upload":               transport.UploadScript(dgdr),
+		"CheckpointFile":       ProfilingCheckpointFile,
+		"CheckpointStagingDir": CheckpointStagingDir,
+		"Checkpoint":           transport.CheckpointScript(dgdr),
+		"ArtifactsDir":         artifactsDir,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute sidecar script template: %w", err)
+	}
+
+	sidecarContainer := corev1.Container{
+		Name:    ContainerNameOutputCopier,
+		Image:   SidecarImage,
+		Command: []string{"/bin/sh", "-c"},
+		Args:    []string{scriptBuf.String()},
+		VolumeMounts: []corev1.VolumeMount{{
+			Name:      VolumeNameProfilingOutput,
+			MountPath: ProfilingOutputPath,
+			// The PVC transport copies the results to their directory on the volume
+			ReadOnly: getResultTransport(dgdr) != nvidiacomv1alpha1.ResultTransportPVC,
+		}},
+	}
+
+	if hasArtifacts(dgdr) {
+		sidecarContainer.VolumeMounts = append(sidecarContainer.VolumeMounts, corev1.VolumeMount{
+			Name:      VolumeNameProfilingArtifacts,
+			MountPath: ProfilingArtifactsPath,
 		})
-		if err != nil {
-			return nil, false, fmt.Errorf("failed to execute sidecar script template: %w", err)
-		}
+	}
 
-		sidecarContainer := corev1.Container{
-			Name:    ContainerNameOutputCopier,
-			Image:   SidecarImage,
-			Command: []string{"/bin/sh", "-c"},
-			Args:    []string{scriptBuf.String()},
-			VolumeMounts: []corev1.VolumeMount{{
-				Name:      VolumeNameProfilingOutput,
-				MountPath: ProfilingOutputPath,
-				ReadOnly:  true,
-			}},
-		}
+	restorerContainer := checkpointRestorerContainer(dgdr, transport)
 
-		// Build volumes - use dynamo-pvc for profiling output so data persists for the Planner
-		volumes := []corev1.Volume{{
+	// Build volumes - use dynamo-pvc for profiling output so data persists for the Planner
+	volumes := []corev1.Volume{
+		{
 			Name: VolumeNameProfilingOutput,
 			VolumeSource: corev1.VolumeSource{
 				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
 					ClaimName: "dynamo-pvc",
 				},
 			},
-		}}
+		},
+		{
+			Name: VolumeNameProfilingCheckpoint,
+			VolumeSource: corev1.VolumeSource{
+				EmptyDir: &corev1.EmptyDirVolumeSource{},
+			},
+		},
+	}
 
-		// Add ConfigMap volume if provided
-		if dgdr.Spec.ProfilingConfig.ConfigMapRef != nil {
-			key := dgdr.Spec.ProfilingConfig.ConfigMapRef.Key
-			if key == "" {
-				key = ProfilingConfigFile
-			}
+	if hasArtifacts(dgdr) {
+		volumes = append(volumes, artifactsVolume(getArtifactsClaimName(dgdr), false))
+	}
 
-			volumes = append(volumes, corev1.Volume{
-				Name: VolumeNameProfilingConfig,
-				VolumeSource: corev1.VolumeSource{
-					ConfigMap: &corev1.ConfigMapVolumeSource{
-						LocalObjectReference: corev1.LocalObjectReference{
-							Name: dgdr.Spec.ProfilingConfig.ConfigMapRef.Name,
+	// The HTTP transport authenticates to the results endpoint with a token bound to its audience,
+	// and verifies the endpoint with the CA mounted next to it
+	if getResultTransport(dgdr) == nvidiacomv1alpha1.ResultTransportHTTP {
+		expirationSeconds := ResultsTokenExpirationSecs
+		volumes = append(volumes, corev1.Volume{
+			Name: VolumeNameResultsToken,
+			VolumeSource: corev1.VolumeSource{
+				Projected: &corev1.ProjectedVolumeSource{
+					Sources: []corev1.VolumeProjection{
+						{
+							ServiceAccountToken: &corev1.ServiceAccountTokenProjection{
+								Audience:          ResultsTokenAudience,
+								ExpirationSeconds: &expirationSeconds,
+								Path:              ResultsTokenFile,
+							},
+						},
+						{
+							ConfigMap: &corev1.ConfigMapProjection{
+								LocalObjectReference: corev1.LocalObjectReference{Name: getResultsCAConfigMapName(dgdr)},
+								Items:                []corev1.KeyToPath{{Key: ResultsCAFile, Path: ResultsCAFile}},
+							},
 						},
-						Items: []corev1.KeyToPath{{
-							Key:  key,
-							Path: ProfilingConfigFile,
-						}},
 					},
 				},
+			},
+		})
+		for _, container := range []*corev1.Container{&sidecarContainer, &restorerContainer} {
+			container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+				Name:      VolumeNameResultsToken,
+				MountPath: ResultsTokenPath,
+				ReadOnly:  true,
 			})
 		}
+	}
 
-		// Limit retries to prevent infinite loop
-		backoffLimit := int32(3)
-
-		// Determine label based on whether AI Configurator is used
-		labelValue := LabelValueDynamoProfiler
-		if !isOnlineProfiling(dgdr) {
-			labelValue = LabelValueAICProfiler
+	// Add ConfigMap volume if provided
+	if dgdr.Spec.ProfilingConfig.ConfigMapRef != nil {
+		key := dgdr.Spec.ProfilingConfig.ConfigMapRef.Key
+		if key == "" {
+			key = ProfilingConfigFile
 		}
 
-		job := &batchv1.Job{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      jobName,
-				Namespace: dgdr.Namespace,
-				Labels: map[string]string{
-					LabelApp:       labelValue,
-					LabelDGDR:      dgdr.Name,
-					LabelManagedBy: LabelValueDynamoOperator,
-				},
-			},
-			Spec: batchv1.JobSpec{
-				BackoffLimit: &backoffLimit,
-				Template: corev1.PodTemplateSpec{
-					Spec: corev1.PodSpec{
-						ServiceAccountName: ServiceAccountProfilingJob,
-						RestartPolicy:      corev1.RestartPolicyNever,
-						Containers:         []corev1.Container{profilerContainer, sidecarContainer},
-						Volumes:            volumes,
-						ImagePullSecrets: []corev1.LocalObjectReference{
-							{Name: "nvcr-imagepullsecret"},
-						},
+		volumes = append(volumes, corev1.Volume{
+			Name: VolumeNameProfilingConfig,
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: dgdr.Spec.ProfilingConfig.ConfigMapRef.Name,
 					},
+					Items: []corev1.KeyToPath{{
+						Key:  key,
+						Path: ProfilingConfigFile,
+					}},
 				},
 			},
-		}
+		})
+	}
 
-		return job, false, nil
-	})
+	// Add Secret volume if provided
+	if dgdr.Spec.ProfilingConfig.SecretRef != nil {
+		key := dgdr.Spec.ProfilingConfig.SecretRef.Key
+		if key == "" {
+			key = ProfilingConfigFile
+		}
 
-	if err != nil {
-		return err
+		volumes = append(volumes, corev1.Volume{
+			Name: VolumeNameProfilingConfig,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: dgdr.Spec.ProfilingConfig.SecretRef.Name,
+					Items: []corev1.KeyToPath{{
+						Key:  key,
+						Path: ProfilingConfigFile,
+					}},
+				},
+			},
+		})
 	}
 
-	if modified {
-		logger.Info("Profiling job created/updated", "job", job.Name)
+	// Limit retries to prevent infinite loop
+	backoffLimit := int32(3)
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      jobName,
+			Namespace: dgdr.Namespace,
+			Labels: map[string]string{
+				LabelApp:       profilingJobLabelValue(dgdr),
+				LabelDGDR:      dgdr.Name,
+				LabelManagedBy: LabelValueDynamoOperator,
+			},
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: &backoffLimit,
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:      profilingPodLabels(dgdr),
+					Annotations: r.jobPodAnnotations(),
+				},
+				Spec: corev1.PodSpec{
+					ServiceAccountName: ServiceAccountProfilingJob,
+					RestartPolicy:      corev1.RestartPolicyNever,
+					InitContainers:     []corev1.Container{restorerContainer},
+					Containers:         []corev1.Container{profilerContainer, sidecarContainer},
+					Volumes:            volumes,
+					ImagePullSecrets:   profilingJobPullSecrets(),
+				},
+			},
+		},
 	}
 
-	return nil
+	r.applyJobPodSecurity(&job.Spec.Template.Spec)
+
+	return job, nil
 }
 
 // checkProfilingJobStatus checks if the profiling job has completed
 func (r *DynamoGraphDeploymentRequestReconciler) checkProfilingJobStatus(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) (bool, error) {
 	logger := log.FromContext(ctx)
-	jobName := getProfilingJobName(dgdr)
+	jobName := GetProfilingJobName(dgdr)
+
+	// The mock profiler writes its output before the DGDR enters Profiling
+	if r.isMockProfiling() {
+		return true, nil
+	}
+
+	// Results posted to the results endpoint complete profiling before the job exits
+	if getResultTransport(dgdr) == nvidiacomv1alpha1.ResultTransportHTTP {
+		if condition := meta.FindStatusCondition(dgdr.Status.Conditions, ConditionTypeProfiling); condition != nil && condition.Reason == EventReasonResultsReceived {
+			return true, nil
+		}
+	}
 
 	job := &batchv1.Job{}
 	if err := r.Get(ctx, types.NamespacedName{Name: jobName, Namespace: dgdr.Namespace}, job); err != nil {
@@ -1126,6 +2047,7 @@ func (r *DynamoGraphDeploymentRequestReconciler) getProfilingJobErrorDetails(ctx
 					if terminated.Message != "" {
 						errorMsg += fmt.Sprintf(", Message: %s", terminated.Message)
 					}
+					errorMsg = redactMessage(dgdr, errorMsg)
 					logger.Info("Retrieved profiling job error details", "error", errorMsg)
 					return errorMsg
 				}
@@ -1137,6 +2059,7 @@ func (r *DynamoGraphDeploymentRequestReconciler) getProfilingJobErrorDetails(ctx
 					waiting := containerStatus.State.Waiting
 					errorMsg := fmt.Sprintf("Pod: %s, Container: %s, Waiting - Reason: %s, Message: %s",
 						pod.Name, containerStatus.Name, waiting.Reason, waiting.Message)
+					errorMsg = redactMessage(dgdr, errorMsg)
 					logger.Info("Retrieved profiling job waiting details", "error", errorMsg)
 					return errorMsg
 				}
@@ -1152,36 +2075,61 @@ func (r *DynamoGraphDeploymentRequestReconciler) generateDGDSpec(ctx context.Con
 	logger := log.FromContext(ctx)
 	logger.Info("Generating DGD spec from profiling results", "name", dgdr.Name)
 
-	// Read the generated spec from ConfigMap (created by sidecar)
-	outputConfigMapName := getOutputConfigMapName(dgdr)
-	cm := &corev1.ConfigMap{}
-	err := r.Get(ctx, types.NamespacedName{
-		Name:      outputConfigMapName,
-		Namespace: dgdr.Namespace,
-	}, cm)
+	// Results the generated spec was already rendered from are not parsed again
+	transport := r.resultTransport(dgdr)
+	checksum := resultsChecksum(ctx, transport, dgdr)
+	if checksum != "" && checksum == dgdr.Status.ProfilingResultsChecksum && dgdr.Status.GeneratedDeployment != nil {
+		logger.Info("Profiling results unchanged, keeping the generated DGD", "results", transport.Reference(dgdr), "checksum", checksum)
+		metrics.DGDRResultsParsesSkippedTotal.WithLabelValues(dgdr.Namespace).Inc()
+		return nil
+	}
 
+	// Read the generated spec delivered by the sidecar
+	results, err := r.fetchResults(ctx, transport, dgdr)
 	if err != nil {
-		if apierrors.IsNotFound(err) {
-			return fmt.Errorf("output ConfigMap %s not found - profiling may not have completed yet", outputConfigMapName)
+		return err
+	}
+	logger.V(1).Info("Fetched profiling results", "results", transport.Reference(dgdr), "keys", slices.Sorted(maps.Keys(results)))
+
+	// For backend auto, pick the generated DGD of the selected backend
+	outputKey := getProfilingOutputKey(dgdr)
+	if dgdr.Spec.Backend == BackendAuto {
+		outputKey, err = applyBackendComparison(dgdr, results)
+		if err != nil {
+			return err
 		}
-		return fmt.Errorf("failed to get output ConfigMap: %w", err)
+		logger.Info("Selected backend from comparison", "backend", dgdr.Status.Backend)
+		r.Recorder.Event(dgdr, corev1.EventTypeNormal, EventReasonBackendSelected,
+			fmt.Sprintf("Selected backend %s from %d evaluated backends", dgdr.Status.Backend, len(dgdr.Status.BackendComparison)))
 	}
 
-	// Get YAML content from ConfigMap
-	yamlContent, exists := cm.Data[ProfilingOutputFile]
+	// Speculate only if the profiler measured a benefit
+	if err := applySpeculativeDecodingResults(dgdr, results); err != nil {
+		return err
+	}
+	if err := applySLAResults(dgdr, results); err != nil {
+		return err
+	}
+
+	// Get YAML content from the results
+	yamlContent, exists := results[outputKey]
 	if !exists {
-		return fmt.Errorf("key %s not found in ConfigMap %s", ProfilingOutputFile, outputConfigMapName)
+		return withFailureReason(nvidiacomv1alpha1.FailureReasonResultsMissing,
+			fmt.Errorf("key %s not found in profiling results %s", outputKey, transport.Reference(dgdr)))
 	}
 
-	logger.Info("Found profiling output in ConfigMap", "configMap", outputConfigMapName, "size", len(yamlContent))
+	logger.Info("Found profiling output", "results", transport.Reference(dgdr), "size", len(yamlContent))
 
-	// Parse YAML into full DynamoGraphDeployment object first to validate and get name
-	dgd := &nvidiacomv1alpha1.DynamoGraphDeployment{}
-	if err := yaml.Unmarshal([]byte(yamlContent), dgd); err != nil {
-		return fmt.Errorf("failed to parse %s: %w", ProfilingOutputFile, err)
+	dgd, err := r.renderGeneratedDeployment(ctx, dgdr, outputKey, []byte(yamlContent))
+	if err != nil {
+		return err
 	}
 
-	logger.Info("Parsed DGD from ConfigMap", "dgdName", dgd.Name)
+	resources, err := decodeGeneratedResources(outputKey, []byte(yamlContent))
+	if err != nil {
+		return err
+	}
+	dgdr.Status.GeneratedResources = resources
 
 	// Store as RawExtension (need to marshal to JSON as RawExtension expects JSON)
 	// This preserves all fields including metadata
@@ -1190,17 +2138,96 @@ func (r *DynamoGraphDeploymentRequestReconciler) generateDGDSpec(ctx context.Con
 	}
 
 	// Set profiling results reference
-	dgdr.Status.ProfilingResults = fmt.Sprintf("configmap/%s", outputConfigMapName)
+	dgdr.Status.ProfilingResults = transport.Reference(dgdr)
+	dgdr.Status.ProfilingResultsChecksum = checksum
+	dgdr.Status.ProfilingResultsEncoding = resultsEncoding(ctx, transport, dgdr)
+	if err := r.trackProfilingOutput(ctx, dgdr); err != nil {
+		return err
+	}
+	r.setGeneratedSpecExpiry(dgdr)
+	r.auditSpecGenerated(ctx, dgdr, dgd)
+	r.setDeploymentPreview(ctx, dgdr)
+	r.setPlacementReport(ctx, dgdr)
+
+	// Flatten into plain manifests for clusters that deploy with another serving stack
+	if getOutputFormat(dgdr) == nvidiacomv1alpha1.OutputFormatRawManifests {
+		if err := r.writeRawManifests(ctx, dgdr, transport, dgd); err != nil {
+			return err
+		}
+	}
 
 	logger.Info("Successfully generated DGD from profiling output", "dgdName", dgd.Name)
 
-	return r.Status().Update(ctx, dgdr)
+	return r.updateStatus(ctx, dgdr)
+}
+
+// renderGeneratedDeployment decodes the DGD generated by the profiler and applies the DGDR's
+// service accounts, workload settings, speculative decoding, overrides, adapters, pod security,
+// patches and image pinning to it
+func (r *DynamoGraphDeploymentRequestReconciler) renderGeneratedDeployment(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, outputKey string, content []byte) (*nvidiacomv1alpha1.DynamoGraphDeployment, error) {
+	logger := log.FromContext(ctx)
+
+	// Parse YAML into full DynamoGraphDeployment object first to validate and get name
+	dgd, err := decodeGeneratedDeployment(dgdr, outputKey, content)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("Parsed DGD from profiling output", "dgdName", dgd.Name)
+	logger.V(1).Info("Generated DGD services", "services", slices.Sorted(maps.Keys(dgd.Spec.Services)))
+
+	if err := applyServiceAccountOverrides(dgdr, dgd); err != nil {
+		return nil, err
+	}
+
+	applyWorkloadType(dgdr, dgd)
+	applyLoadTarget(dgdr, dgd)
+	r.applyRuntimeImages(ctx, dgdr, dgd)
+	if err := applySpeculativeDecoding(dgdr, dgd); err != nil {
+		return nil, err
+	}
+
+	// User overrides go last so that they win over the profiled values
+	if err := r.applyServiceOverrides(dgdr, dgd); err != nil {
+		return nil, err
+	}
+	applyGPUResourceName(dgdr, dgd)
+
+	if err := applyAdapters(dgdr, dgd); err != nil {
+		return nil, err
+	}
+
+	if err := applySecrets(dgdr, dgd); err != nil {
+		return nil, err
+	}
+
+	if err := r.applyWorkloadFlavor(dgdr, dgd); err != nil {
+		return nil, err
+	}
+	r.applyDeploymentPodAnnotations(dgdr, dgd)
+	r.applyDeploymentPodSecurity(dgd)
+
+	// Patches go after every typed override so they can adjust anything the operator generated
+	if err := applyDeploymentPatches(dgdr, dgd); err != nil {
+		return nil, err
+	}
+
+	// Images are pinned once final, so the images set by patches are pinned too
+	if err := r.pinImageDigests(ctx, dgdr, dgd); err != nil {
+		return nil, err
+	}
+
+	if err := r.validateDeploymentImages(ctx, dgdr, dgd); err != nil {
+		return nil, err
+	}
+
+	return dgd, nil
 }
 
 // updateStateAndRequeue updates the DGDR state and requeues
 func (r *DynamoGraphDeploymentRequestReconciler) updateStateAndRequeue(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, state, _ string) (ctrl.Result, error) {
 	dgdr.Status.State = state
-	if err := r.Status().Update(ctx, dgdr); err != nil {
+	if err := r.updateStatus(ctx, dgdr); err != nil {
 		return ctrl.Result{}, err
 	}
 	return ctrl.Result{Requeue: true}, nil
@@ -1224,21 +2251,76 @@ func (r *DynamoGraphDeploymentRequestReconciler) updateStateWithCondition(
 		ObservedGeneration: dgdr.Generation,
 		LastTransitionTime: metav1.Now(),
 		Reason:             reason,
-		Message:            message,
+		Message:            redactMessage(dgdr, message),
 	}
 
 	dgdr.AddStatusCondition(condition)
 
-	if err := r.Status().Update(ctx, dgdr); err != nil {
+	if err := r.updateStatus(ctx, dgdr); err != nil {
 		return ctrl.Result{}, err
 	}
 
 	return ctrl.Result{Requeue: true}, nil
 }
 
+// updateStateToFailed transitions the DGDR to Failed, recording a machine-readable failure reason
+// alongside the condition. The failure metric for that reason is incremented once the transition
+// is persisted, and not again for a DGDR that already failed.
+func (r *DynamoGraphDeploymentRequestReconciler) updateStateToFailed(
+	ctx context.Context,
+	dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest,
+	failureReason nvidiacomv1alpha1.FailureReason,
+	conditionType string,
+	reason string,
+	message string,
+) (ctrl.Result, error) {
+	previousState := dgdr.Status.State
+	dgdr.Status.FailureReason = failureReason
+	result, err := r.updateStateWithCondition(ctx, dgdr, StateFailed, conditionType, metav1.ConditionFalse, reason, message)
+	if err == nil && previousState != StateFailed {
+		metrics.DGDRFailuresTotal.WithLabelValues(dgdr.Namespace, string(failureReason)).Inc()
+	}
+	return result, err
+}
+
+// failureReasonError annotates an error with the FailureReason it should be reported as.
+type failureReasonError struct {
+	reason nvidiacomv1alpha1.FailureReason
+	err    error
+}
+
+func (e *failureReasonError) Error() string { return e.err.Error() }
+func (e *failureReasonError) Unwrap() error { return e.err }
+
+// withFailureReason wraps err so that failureReasonFromError reports the given reason.
+func withFailureReason(reason nvidiacomv1alpha1.FailureReason, err error) error {
+	return &failureReasonError{reason: reason, err: err}
+}
+
+// failureReasonFromError extracts the FailureReason attached to err, or returns fallback if none is attached.
+func failureReasonFromError(err error, fallback nvidiacomv1alpha1.FailureReason) nvidiacomv1alpha1.FailureReason {
+	var reasonErr *failureReasonError
+	if errors.As(err, &reasonErr) {
+		return reasonErr.reason
+	}
+	return fallback
+}
+
 // SetupWithManager sets up the controller with the Manager
 func (r *DynamoGraphDeploymentRequestReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{},
+		ProfilingConfigMapIndex, profilingConfigMapName); err != nil {
+		return fmt.Errorf("failed to index DGDRs by profiling ConfigMap: %w", err)
+	}
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{},
+		ComparisonBaselineIndex, comparisonBaselineName); err != nil {
+		return fmt.Errorf("failed to index DGDRs by comparison baseline: %w", err)
+	}
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &corev1.Event{}, EventReasonIndex, eventReason); err != nil {
+		return fmt.Errorf("failed to index events by reason: %w", err)
+	}
+
+	b := ctrl.NewControllerManagedBy(mgr).
 		For(&nvidiacomv1alpha1.DynamoGraphDeploymentRequest{}).
 		Owns(&batchv1.Job{}, builder.WithPredicates(predicate.Funcs{
 			// ignore creation cause we don't want to be called again after we create the job
@@ -1272,5 +2354,25 @@ func (r *DynamoGraphDeploymentRequestReconciler) SetupWithManager(mgr ctrl.Manag
 				GenericFunc: func(ge event.GenericEvent) bool { return true },
 			}),
 		). // Watch DGDs created by this controller (via label)
-		Complete(r)
+		Watches(
+			&corev1.ConfigMap{},
+			handler.EnqueueRequestsFromMapFunc(r.requestsForConfigMap),
+			builder.WithPredicates(predicate.ResourceVersionChangedPredicate{}),
+		). // Re-validate DGDRs when the ConfigMap of spec.profilingConfig.configMapRef changes
+		Watches(
+			&nvidiacomv1alpha1.DynamoGraphDeploymentRequest{},
+			handler.EnqueueRequestsFromMapFunc(r.requestsForComparisonBaseline),
+		) // Run deferred comparisons once the DGDR they compare with has a generated deployment
+	if r.NamespaceSelector != nil {
+		// Pick up DGDRs of namespaces as they are labeled or unlabeled
+		b = b.Watches(&corev1.Namespace{},
+			handler.EnqueueRequestsFromMapFunc(r.requestsForNamespace),
+			builder.WithPredicates(predicate.LabelChangedPredicate{}))
+	}
+	if r.Sharder != nil {
+		// Every replica processes its own shard, the DGDRs of a shard claimed later are enqueued then
+		b = b.WatchesRawSource(r.Sharder.Source()).
+			WithOptions(ctrlcontroller.Options{NeedLeaderElection: ptr.To(false)})
+	}
+	return b.Complete(r)
 }
@@ -20,6 +20,8 @@ package controller
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"time"
 
 	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
@@ -31,8 +33,10 @@ import (
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/yaml"
 )
@@ -273,14 +277,14 @@ var _ = Describe("DynamoGraphDeploymentRequest Controller", func() {
 
 			// Verify profiling job was created
 			Eventually(func() bool {
-				jobName := getProfilingJobName(dgdr)
+				jobName := GetProfilingJobName(dgdr)
 				job := &batchv1.Job{}
 				err := k8sClient.Get(ctx, types.NamespacedName{Name: jobName, Namespace: namespace}, job)
 				return err == nil
 			}, timeout, interval).Should(BeTrue())
 
 			// Verify job has correct labels
-			jobName := getProfilingJobName(dgdr)
+			jobName := GetProfilingJobName(dgdr)
 			job := &batchv1.Job{}
 			_ = k8sClient.Get(ctx, types.NamespacedName{Name: jobName, Namespace: namespace}, job)
 			Expect(job.Labels[LabelApp]).Should(Equal(LabelValueDynamoProfiler))
@@ -374,7 +378,7 @@ var _ = Describe("DynamoGraphDeploymentRequest Controller", func() {
 
 			// Verify job was created with AIC label
 			Eventually(func() string {
-				jobName := getProfilingJobName(dgdr)
+				jobName := GetProfilingJobName(dgdr)
 				job := &batchv1.Job{}
 				if err := k8sClient.Get(ctx, types.NamespacedName{Name: jobName, Namespace: namespace}, job); err != nil {
 					return ""
@@ -383,7 +387,7 @@ var _ = Describe("DynamoGraphDeploymentRequest Controller", func() {
 			}, timeout, interval).Should(Equal(LabelValueAICProfiler))
 
 			// Clean up
-			jobName := getProfilingJobName(dgdr)
+			jobName := GetProfilingJobName(dgdr)
 			job := &batchv1.Job{}
 			if err := k8sClient.Get(ctx, types.NamespacedName{Name: jobName, Namespace: namespace}, job); err == nil {
 				_ = k8sClient.Delete(ctx, job)
@@ -430,7 +434,7 @@ var _ = Describe("DynamoGraphDeploymentRequest Controller", func() {
 			Expect(k8sClient.Status().Update(ctx, dgdr)).Should(Succeed())
 
 			// Create completed profiling job
-			jobName := getProfilingJobName(dgdr)
+			jobName := GetProfilingJobName(dgdr)
 			job := &batchv1.Job{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      jobName,
@@ -474,7 +478,7 @@ spec:
     Frontend:
       replicas: 1`
 
-			outputConfigMapName := getOutputConfigMapName(dgdr)
+			outputConfigMapName := GetOutputConfigMapName(dgdr)
 			cm := &corev1.ConfigMap{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      outputConfigMapName,
@@ -503,6 +507,107 @@ spec:
 			// Verify state transitioned to Ready (since autoApply is false by default)
 			Expect(updated.Status.State).Should(Equal(StateReady))
 		})
+
+		It("Should render raw manifests when output format is RawManifests", func() {
+			ctx := context.Background()
+			dgdrName := "test-dgdr-raw-manifests"
+			namespace := defaultNamespace
+
+			dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      dgdrName,
+					Namespace: namespace,
+				},
+				Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+					Model:   "test-model",
+					Backend: "vllm",
+					ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+						ProfilerImage: "test-profiler:latest",
+						Config: createTestConfig(map[string]interface{}{
+							"sla": map[string]interface{}{
+								"ttft": 100.0,
+								"itl":  1500.0,
+							},
+						}),
+					},
+					Output: &nvidiacomv1alpha1.OutputSpec{
+						Format: nvidiacomv1alpha1.OutputFormatRawManifests,
+					},
+				},
+			}
+
+			Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+			defer func() { _ = k8sClient.Delete(ctx, dgdr) }()
+
+			dgdr.Status.State = StateProfiling
+			Expect(k8sClient.Status().Update(ctx, dgdr)).Should(Succeed())
+
+			job := &batchv1.Job{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      GetProfilingJobName(dgdr),
+					Namespace: namespace,
+				},
+				Spec: batchv1.JobSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers:    []corev1.Container{{Name: "test", Image: "test"}},
+							RestartPolicy: corev1.RestartPolicyNever,
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, job)).Should(Succeed())
+			defer func() { _ = k8sClient.Delete(ctx, job) }()
+
+			job.Status.Conditions = []batchv1.JobCondition{{
+				Type:   batchv1.JobComplete,
+				Status: corev1.ConditionTrue,
+			}}
+			Expect(k8sClient.Status().Update(ctx, job)).Should(Succeed())
+
+			dgdYAML := `apiVersion: nvidia.com/v1alpha1
+kind: DynamoGraphDeployment
+metadata:
+  name: test-dgd-raw
+spec:
+  backendFramework: vllm
+  services:
+    Frontend:
+      componentType: frontend
+      replicas: 1
+      extraPodSpec:
+        mainContainer:
+          image: frontend-image`
+
+			cm := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      GetOutputConfigMapName(dgdr),
+					Namespace: namespace,
+				},
+				Data: map[string]string{
+					ProfilingOutputFile: dgdYAML,
+				},
+			}
+			Expect(k8sClient.Create(ctx, cm)).Should(Succeed())
+			defer func() { _ = k8sClient.Delete(ctx, cm) }()
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: dgdrName, Namespace: namespace},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			var updated nvidiacomv1alpha1.DynamoGraphDeploymentRequest
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: dgdrName, Namespace: namespace}, &updated)).Should(Succeed())
+			Expect(updated.Status.State).Should(Equal(StateReady))
+			Expect(updated.Status.RenderedManifests).Should(Equal("configmap/" + cm.Name))
+
+			var updatedCM corev1.ConfigMap
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: cm.Name, Namespace: namespace}, &updatedCM)).Should(Succeed())
+			Expect(updatedCM.Data).Should(HaveKey(RawManifestsKey))
+			Expect(updatedCM.Data[RawManifestsKey]).Should(ContainSubstring("kind: Deployment"))
+			Expect(updatedCM.Data[RawManifestsKey]).Should(ContainSubstring("kind: Service"))
+			Expect(updatedCM.Data[RawManifestsKey]).ShouldNot(ContainSubstring("kind: DynamoGraphDeployment"))
+		})
 	})
 
 	Context("When autoApply is enabled", func() {
@@ -545,7 +650,7 @@ spec:
 			Expect(k8sClient.Status().Update(ctx, dgdr)).Should(Succeed())
 
 			// Create completed profiling job
-			jobName := getProfilingJobName(dgdr)
+			jobName := GetProfilingJobName(dgdr)
 			job := &batchv1.Job{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      jobName,
@@ -589,7 +694,7 @@ spec:
     Frontend:
       replicas: 1`
 
-			outputConfigMapName := getOutputConfigMapName(dgdr)
+			outputConfigMapName := GetOutputConfigMapName(dgdr)
 			cm := &corev1.ConfigMap{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      outputConfigMapName,
@@ -633,6 +738,115 @@ spec:
 			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: "test-dgd-auto", Namespace: namespace}, dgd)).Should(Succeed())
 			_ = k8sClient.Delete(ctx, dgd)
 		})
+
+		It("Should patch the DGD only when the generated spec changes", func() {
+			ctx := context.Background()
+			dgdrName := "test-dgdr-spechash"
+			namespace := defaultNamespace
+
+			generated := func(replicas int) *runtime.RawExtension {
+				return &runtime.RawExtension{Raw: []byte(fmt.Sprintf(
+					`{"apiVersion":"nvidia.com/v1alpha1","kind":"DynamoGraphDeployment","metadata":{"name":"test-dgd-spechash"},"spec":{"services":{"Frontend":{"replicas":%d}}}}`,
+					replicas))}
+			}
+
+			dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+				ObjectMeta: metav1.ObjectMeta{Name: dgdrName, Namespace: namespace},
+				Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+					Model:   "test-model",
+					Backend: "vllm",
+					ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+						ProfilerImage: "test-profiler:latest",
+						Config:        createTestConfig(map[string]interface{}{"sla": map[string]interface{}{"ttft": 100.0}}),
+					},
+					AutoApply: true,
+				},
+			}
+			Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+			defer func() { _ = k8sClient.Delete(ctx, dgdr) }()
+			defer func() {
+				_ = k8sClient.Delete(ctx, &nvidiacomv1alpha1.DynamoGraphDeployment{ObjectMeta: metav1.ObjectMeta{Name: "test-dgd-spechash", Namespace: namespace}})
+			}()
+
+			dgdr.Status.State = StateDeploying
+			dgdr.Status.GeneratedDeployment = generated(1)
+			Expect(k8sClient.Status().Update(ctx, dgdr)).Should(Succeed())
+
+			_, err := reconciler.createDGD(ctx, dgdr)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(recorder.Events).Should(Receive(ContainSubstring(EventReasonDeploymentCreated)))
+
+			dgd := &nvidiacomv1alpha1.DynamoGraphDeployment{}
+			dgdKey := types.NamespacedName{Name: "test-dgd-spechash", Namespace: namespace}
+			Expect(k8sClient.Get(ctx, dgdKey, dgd)).Should(Succeed())
+			Expect(dgd.Annotations).Should(HaveKey(AnnotationGeneratedSpecHash))
+			firstHash := dgd.Annotations[AnnotationGeneratedSpecHash]
+
+			// Manual edits survive reconciles of an unchanged spec
+			dgd.Spec.Services["Frontend"].Replicas = ptr.To(int32(3))
+			Expect(k8sClient.Update(ctx, dgd)).Should(Succeed())
+			_, err = reconciler.createDGD(ctx, dgdr)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(recorder.Events).Should(Receive(ContainSubstring(EventReasonDeploymentUnchanged)))
+			Expect(k8sClient.Get(ctx, dgdKey, dgd)).Should(Succeed())
+			Expect(*dgd.Spec.Services["Frontend"].Replicas).Should(Equal(int32(3)))
+
+			// A re-profiled spec is applied by the next deploying reconcile
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: dgdrName, Namespace: namespace}, dgdr)).Should(Succeed())
+			dgdr.Status.GeneratedDeployment = generated(2)
+			Expect(k8sClient.Status().Update(ctx, dgdr)).Should(Succeed())
+			_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: dgdrName, Namespace: namespace}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(recorder.Events).Should(Receive(ContainSubstring(EventReasonDeploymentUpdated)))
+
+			Expect(k8sClient.Get(ctx, dgdKey, dgd)).Should(Succeed())
+			Expect(*dgd.Spec.Services["Frontend"].Replicas).Should(Equal(int32(2)))
+			Expect(dgd.Annotations[AnnotationGeneratedSpecHash]).ShouldNot(Equal(firstHash))
+			Expect(dgd.Labels).Should(HaveKeyWithValue(LabelDGDRName, dgdrName))
+		})
+
+		It("Should fail instead of taking over a DGD it did not create", func() {
+			ctx := context.Background()
+			namespace := defaultNamespace
+
+			existing := &nvidiacomv1alpha1.DynamoGraphDeployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-dgd-conflict", Namespace: namespace},
+				Spec: nvidiacomv1alpha1.DynamoGraphDeploymentSpec{
+					Services: map[string]*nvidiacomv1alpha1.DynamoComponentDeploymentSharedSpec{"Frontend": {Replicas: ptr.To(int32(4))}},
+				},
+			}
+			Expect(k8sClient.Create(ctx, existing)).Should(Succeed())
+			defer func() { _ = k8sClient.Delete(ctx, existing) }()
+
+			dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-dgdr-conflict", Namespace: namespace},
+				Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+					Model:   "test-model",
+					Backend: "vllm",
+					ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+						ProfilerImage: "test-profiler:latest",
+						Config:        createTestConfig(map[string]interface{}{"sla": map[string]interface{}{"ttft": 100.0}}),
+					},
+					AutoApply: true,
+				},
+			}
+			Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+			defer func() { _ = k8sClient.Delete(ctx, dgdr) }()
+			dgdr.Status.State = StateDeploying
+			dgdr.Status.GeneratedDeployment = &runtime.RawExtension{Raw: []byte(
+				`{"apiVersion":"nvidia.com/v1alpha1","kind":"DynamoGraphDeployment","metadata":{"name":"test-dgd-conflict"},"spec":{"services":{"Frontend":{"replicas":1}}}}`)}
+			Expect(k8sClient.Status().Update(ctx, dgdr)).Should(Succeed())
+
+			_, err := reconciler.createDGD(ctx, dgdr)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(dgdr.Status.State).Should(Equal(StateFailed))
+			Expect(dgdr.Status.FailureReason).Should(Equal(nvidiacomv1alpha1.FailureReasonDGDConflict))
+
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: "test-dgd-conflict", Namespace: namespace}, existing)).Should(Succeed())
+			Expect(*existing.Spec.Services["Frontend"].Replicas).Should(Equal(int32(4)))
+			Expect(existing.Labels).ShouldNot(HaveKey(LabelDGDRName))
+			Expect(existing.Annotations).ShouldNot(HaveKey(AnnotationGeneratedSpecHash))
+		})
 	})
 
 	Context("When enforcing spec immutability", func() {
@@ -778,25 +992,25 @@ spec:
 })
 
 var _ = Describe("DGDR Helper Functions", func() {
-	Context("getProfilingJobName", func() {
+	Context("GetProfilingJobName", func() {
 		It("Should return correct job name", func() {
 			dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
 				ObjectMeta: metav1.ObjectMeta{
 					Name: "test-dgdr",
 				},
 			}
-			Expect(getProfilingJobName(dgdr)).Should(Equal("profile-test-dgdr"))
+			Expect(GetProfilingJobName(dgdr)).Should(Equal("profile-test-dgdr"))
 		})
 	})
 
-	Context("getOutputConfigMapName", func() {
+	Context("GetOutputConfigMapName", func() {
 		It("Should return correct ConfigMap name", func() {
 			dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
 				ObjectMeta: metav1.ObjectMeta{
 					Name: "test-dgdr",
 				},
 			}
-			Expect(getOutputConfigMapName(dgdr)).Should(Equal("dgdr-output-test-dgdr"))
+			Expect(GetOutputConfigMapName(dgdr)).Should(Equal("dgdr-output-test-dgdr"))
 		})
 	})
 
@@ -863,6 +1077,39 @@ var _ = Describe("DGDR Helper Functions", func() {
 	})
 })
 
+var _ = Describe("DGDR Failure Classification", func() {
+	Context("failureReasonFromError", func() {
+		It("Should return the attached reason through wrapping", func() {
+			err := fmt.Errorf("outer: %w", withFailureReason(nvidiacomv1alpha1.FailureReasonSpecParseError, errors.New("bad yaml")))
+			Expect(failureReasonFromError(err, nvidiacomv1alpha1.FailureReasonResultsMissing)).
+				Should(Equal(nvidiacomv1alpha1.FailureReasonSpecParseError))
+			Expect(err.Error()).Should(Equal("outer: bad yaml"))
+		})
+
+		It("Should return the fallback when no reason is attached", func() {
+			Expect(failureReasonFromError(errors.New("boom"), nvidiacomv1alpha1.FailureReasonResultsMissing)).
+				Should(Equal(nvidiacomv1alpha1.FailureReasonResultsMissing))
+		})
+	})
+
+	Context("validateSpec", func() {
+		It("Should classify a missing profiler image as ImageNotConfigured", func() {
+			reconciler := &DynamoGraphDeploymentRequestReconciler{Client: k8sClient}
+			dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+				Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+					Model:   "test-model",
+					Backend: "vllm",
+				},
+			}
+
+			err := reconciler.validateSpec(context.Background(), dgdr)
+			Expect(err).To(HaveOccurred())
+			Expect(failureReasonFromError(err, nvidiacomv1alpha1.FailureReasonValidationError)).
+				Should(Equal(nvidiacomv1alpha1.FailureReasonImageNotConfigured))
+		})
+	})
+})
+
 var _ = Describe("DGDR Validation", func() {
 	var reconciler *DynamoGraphDeploymentRequestReconciler
 
@@ -1001,7 +1248,7 @@ var _ = Describe("DGDR Profiler Arguments", func() {
 			Expect(err).NotTo(HaveOccurred())
 
 			// Verify job was created
-			jobName := getProfilingJobName(&fetchedDGDR)
+			jobName := GetProfilingJobName(&fetchedDGDR)
 			job := &batchv1.Job{}
 			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: jobName, Namespace: namespace}, job)).Should(Succeed())
 
@@ -1080,7 +1327,7 @@ var _ = Describe("DGDR Profiler Arguments", func() {
 			Expect(err).NotTo(HaveOccurred())
 
 			// Verify job was created
-			jobName := getProfilingJobName(&fetchedDGDR)
+			jobName := GetProfilingJobName(&fetchedDGDR)
 			job := &batchv1.Job{}
 			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: jobName, Namespace: namespace}, job)).Should(Succeed())
 
@@ -1156,7 +1403,7 @@ var _ = Describe("DGDR Error Handling", func() {
 			Expect(k8sClient.Status().Update(ctx, dgdr)).Should(Succeed())
 
 			// Create failed job
-			jobName := getProfilingJobName(dgdr)
+			jobName := GetProfilingJobName(dgdr)
 			job := &batchv1.Job{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      jobName,
@@ -1241,6 +1488,9 @@ var _ = Describe("DGDR Error Handling", func() {
 			Expect(condition).NotTo(BeNil())
 			Expect(condition.Status).Should(Equal(metav1.ConditionFalse))
 			Expect(condition.Message).Should(ContainSubstring("profiling job failed"))
+
+			// Verify the failure is classified
+			Expect(updated.Status.FailureReason).Should(Equal(nvidiacomv1alpha1.FailureReasonProfilerCrash))
 		})
 	})
 })
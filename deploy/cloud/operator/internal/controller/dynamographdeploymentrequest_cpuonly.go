@@ -0,0 +1,70 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"errors"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+)
+
+const (
+	// Validation messages
+	ValidationErrorCPUOnlyRequiresOffline = "profilingConfig.cpuOnly requires spec.profilingMode aic or profilingConfig.config.sweep.dry_run to be true"
+)
+
+// isCPUOnly reports whether the DGDR profiles without GPUs
+func isCPUOnly(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) bool {
+	return dgdr.Spec.ProfilingConfig.CPUOnly
+}
+
+// validateCPUOnly checks that CPU-only profiling does not need to deploy workers on GPUs
+func validateCPUOnly(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	if !isCPUOnly(dgdr) || getProfilingMode(dgdr) == nvidiacomv1alpha1.ProfilingModeAIC {
+		return nil
+	}
+
+	if sweep, ok := parseProfilingConfig(dgdr)["sweep"].(map[string]interface{}); ok {
+		if dryRun, _ := sweep["dry_run"].(bool); dryRun {
+			return nil
+		}
+	}
+	return errors.New(ValidationErrorCPUOnlyRequiresOffline)
+}
+
+// getProfilerResources returns the profiler container resources. CPU-only runs target small CI
+// clusters, so they request a fraction of what a full profiling run needs.
+func getProfilerResources(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) corev1.ResourceRequirements {
+	if isCPUOnly(dgdr) {
+		return corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("1"),
+				corev1.ResourceMemory: resource.MustParse("2Gi"),
+			},
+		}
+	}
+	return corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("16"),
+			corev1.ResourceMemory: resource.MustParse("10Gi"),
+		},
+	}
+}
@@ -0,0 +1,100 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/yaml"
+)
+
+var _ = Describe("DGDR CPU-only Profiling", func() {
+	var reconciler *DynamoGraphDeploymentRequestReconciler
+
+	BeforeEach(func() {
+		reconciler = &DynamoGraphDeploymentRequestReconciler{
+			Client:      k8sClient,
+			Recorder:    record.NewFakeRecorder(100),
+			RBACManager: &MockRBACManager{},
+		}
+	})
+
+	newCPUOnlyDGDR := func(name string, sweep map[string]interface{}) *nvidiacomv1alpha1.DynamoGraphDeploymentRequest {
+		return &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model:   "test-model",
+				Backend: "vllm",
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					ProfilerImage: "test-profiler:latest",
+					CPUOnly:       true,
+					Config: createTestConfig(map[string]interface{}{
+						"sweep": sweep,
+					}),
+					// Ignored in CPU-only mode even though no Prometheus endpoint is configured
+					RecordUtilization: true,
+				},
+			},
+		}
+	}
+
+	It("Should create a profiling job without GPU-sized requests", func() {
+		ctx := context.Background()
+
+		sa := &corev1.ServiceAccount{
+			ObjectMeta: metav1.ObjectMeta{Name: ServiceAccountProfilingJob, Namespace: defaultNamespace},
+		}
+		Expect(k8sClient.Create(ctx, sa)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, sa) }()
+
+		dgdr := newCPUOnlyDGDR("test-dgdr-cpu-only", map[string]interface{}{"dry_run": true})
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, dgdr) }()
+
+		Expect(reconciler.validateSpec(ctx, dgdr)).Should(Succeed())
+		Expect(isOnlineProfiling(dgdr)).To(BeFalse())
+		Expect(reconciler.createProfilingJob(ctx, dgdr)).Should(Succeed())
+
+		job := &batchv1.Job{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: GetProfilingJobName(dgdr), Namespace: defaultNamespace}, job)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, job) }()
+
+		profiler := job.Spec.Template.Spec.Containers[0]
+		Expect(profiler.Resources.Requests.Cpu().Cmp(resource.MustParse("1"))).To(Equal(0))
+		Expect(profiler.Resources.Requests).NotTo(HaveKey(corev1.ResourceName("nvidia.com/gpu")))
+		Expect(job.Labels[LabelApp]).Should(Equal(LabelValueAICProfiler))
+
+		var config map[string]interface{}
+		Expect(yaml.Unmarshal([]byte(profiler.Args[1]), &config)).Should(Succeed())
+		Expect(config["hardware"]).Should(HaveKeyWithValue("cpu_only", true))
+	})
+
+	It("Should reject CPU-only profiling that would deploy workers", func() {
+		dgdr := newCPUOnlyDGDR("test-dgdr-cpu-only-online", map[string]interface{}{"use_ai_configurator": false})
+		Expect(validateCPUOnly(dgdr)).To(MatchError(ValidationErrorCPUOnlyRequiresOffline))
+	})
+})
@@ -0,0 +1,263 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/pelletier/go-toml/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	kjson "sigs.k8s.io/json"
+	"sigs.k8s.io/yaml"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+)
+
+const (
+	// MessageUnknownFields is the status warning for fields of a generated deployment the operator does not know
+	MessageUnknownFields = "%s has fields this operator does not know, they were dropped (the profiler may be newer than the operator): %s"
+
+	// maxReportedUnknownFields bounds the fields listed in the warning message
+	maxReportedUnknownFields = 10
+)
+
+// profilerOutputFormat is the serialization format of a generated deployment
+type profilerOutputFormat string
+
+const (
+	profilerOutputFormatYAML profilerOutputFormat = "yaml"
+	profilerOutputFormatJSON profilerOutputFormat = "json"
+	profilerOutputFormatTOML profilerOutputFormat = "toml"
+)
+
+// tomlStatement matches the first statement of a TOML document: a table header or a key/value pair
+var tomlStatement = regexp.MustCompile(`^(\[\[?\s*[A-Za-z0-9_."'-]+\s*\]\]?|[A-Za-z0-9_."'-]+\s*=)`)
+
+// getProfilingOutputKey returns the result file holding the generated deployment. Backend auto
+// always uses the default, the deployment of the selected backend is read from its own file.
+func getProfilingOutputKey(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) string {
+	if key := dgdr.Spec.ProfilingConfig.OutputKey; key != "" && dgdr.Spec.Backend != BackendAuto {
+		return key
+	}
+	return ProfilingOutputFile
+}
+
+// validateOutputKey checks that the output key is a file name the sidecar can deliver as a result key
+func validateOutputKey(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	key := dgdr.Spec.ProfilingConfig.OutputKey
+	if key == "" {
+		return nil
+	}
+	if errs := validation.IsConfigMapKey(key); len(errs) > 0 {
+		return fmt.Errorf("profilingConfig.outputKey %q is not a valid file name: %s", key, strings.Join(errs, ", "))
+	}
+	return nil
+}
+
+// detectOutputFormat returns the format of a generated deployment from the extension of its source, or
+// from its content when the extension is not a known one
+func detectOutputFormat(source string, content []byte) profilerOutputFormat {
+	switch strings.ToLower(path.Ext(source)) {
+	case ".json":
+		return profilerOutputFormatJSON
+	case ".toml":
+		return profilerOutputFormatTOML
+	case ".yaml", ".yml":
+		return profilerOutputFormatYAML
+	}
+
+	trimmed := bytes.TrimSpace(content)
+	if bytes.HasPrefix(trimmed, []byte("{")) || (bytes.HasPrefix(trimmed, []byte("[")) && json.Valid(trimmed)) {
+		return profilerOutputFormatJSON
+	}
+	for _, line := range strings.Split(string(trimmed), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if tomlStatement.MatchString(line) {
+			return profilerOutputFormatTOML
+		}
+		break
+	}
+	return profilerOutputFormatYAML
+}
+
+// splitOutputDocuments converts a generated deployment to JSON documents: one per YAML document or
+// JSON array element, with the items of List documents expanded
+func splitOutputDocuments(format profilerOutputFormat, content []byte) ([][]byte, error) {
+	var documents [][]byte
+	switch format {
+	case profilerOutputFormatJSON:
+		trimmed := bytes.TrimSpace(content)
+		if !bytes.HasPrefix(trimmed, []byte("[")) {
+			documents = append(documents, trimmed)
+			break
+		}
+		var items []json.RawMessage
+		if err := json.Unmarshal(trimmed, &items); err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			documents = append(documents, item)
+		}
+	case profilerOutputFormatTOML:
+		var document map[string]interface{}
+		if err := toml.Unmarshal(content, &document); err != nil {
+			return nil, err
+		}
+		data, err := json.Marshal(document)
+		if err != nil {
+			return nil, err
+		}
+		documents = append(documents, data)
+	default:
+		reader := utilyaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(content)))
+		for {
+			document, err := reader.Read()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				return nil, err
+			}
+			data, err := yaml.YAMLToJSON(document)
+			if err != nil {
+				return nil, err
+			}
+			if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && !bytes.Equal(trimmed, []byte("null")) {
+				documents = append(documents, data)
+			}
+		}
+	}
+
+	expanded := make([][]byte, 0, len(documents))
+	for _, document := range documents {
+		var list struct {
+			Kind  string            `json:"kind"`
+			Items []json.RawMessage `json:"items"`
+		}
+		if err := json.Unmarshal(document, &list); err == nil && list.Kind == "List" {
+			for _, item := range list.Items {
+				expanded = append(expanded, item)
+			}
+			continue
+		}
+		expanded = append(expanded, document)
+	}
+	return expanded, nil
+}
+
+// selectDeploymentDocument returns the only document, or the only DynamoGraphDeployment among several
+func selectDeploymentDocument(documents [][]byte) ([]byte, error) {
+	switch len(documents) {
+	case 0:
+		return nil, errors.New("no document found")
+	case 1:
+		return documents[0], nil
+	}
+	var selected [][]byte
+	for _, document := range documents {
+		var typeMeta metav1.TypeMeta
+		if err := json.Unmarshal(document, &typeMeta); err == nil && typeMeta.Kind == "DynamoGraphDeployment" {
+			selected = append(selected, document)
+		}
+	}
+	if len(selected) != 1 {
+		return nil, fmt.Errorf("expected exactly one DynamoGraphDeployment among %d documents, found %d", len(documents), len(selected))
+	}
+	return selected[0], nil
+}
+
+// decodeDeployment strictly decodes a YAML, JSON or TOML DynamoGraphDeployment. The format is detected
+// from the extension of source, or sniffed from the content. Multiple YAML documents, JSON arrays and
+// Lists are accepted if exactly one of their objects is a DynamoGraphDeployment. Malformed values fail
+// with the path of the offending field. Unknown and duplicate fields do not fail decoding, they
+// are returned as field errors so that version skew between the profiler and the operator is
+// visible instead of silently producing a different deployment.
+func decodeDeployment(source string, content []byte) (*nvidiacomv1alpha1.DynamoGraphDeployment, []error, error) {
+	format := detectOutputFormat(source, content)
+	documents, err := splitOutputDocuments(format, content)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid %s: %w", format, err)
+	}
+	data, err := selectDeploymentDocument(documents)
+	if err != nil {
+		return nil, nil, err
+	}
+	dgd := &nvidiacomv1alpha1.DynamoGraphDeployment{}
+	strictErrs, err := kjson.UnmarshalStrict(data, dgd)
+	if err != nil {
+		return nil, nil, err
+	}
+	return dgd, strictErrs, nil
+}
+
+// encodeProfilerOutput converts a YAML generated deployment to the format of the result file key,
+// for results the operator synthesizes itself
+func encodeProfilerOutput(key string, content []byte) ([]byte, error) {
+	switch detectOutputFormat(key, content) {
+	case profilerOutputFormatJSON:
+		return yaml.YAMLToJSON(content)
+	case profilerOutputFormatTOML:
+		var document map[string]interface{}
+		if err := yaml.Unmarshal(content, &document); err != nil {
+			return nil, err
+		}
+		return toml.Marshal(document)
+	default:
+		return content, nil
+	}
+}
+
+// decodeGeneratedDeployment decodes a generated deployment and records its unknown fields as a
+// status warning of the DGDR. source names the deployment in errors and warnings.
+func decodeGeneratedDeployment(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, source string, content []byte) (*nvidiacomv1alpha1.DynamoGraphDeployment, error) {
+	dgd, strictErrs, err := decodeDeployment(source, content)
+	if err != nil {
+		return nil, withFailureReason(nvidiacomv1alpha1.FailureReasonSpecParseError,
+			fmt.Errorf("failed to parse %s: %w", source, err))
+	}
+	if len(strictErrs) > 0 {
+		setWarning(dgdr, WarningUnknownFields, fmt.Sprintf(MessageUnknownFields, source, summarizeFieldErrors(strictErrs)))
+	}
+	return dgd, nil
+}
+
+// summarizeFieldErrors joins field errors, eliding all but the first maxReportedUnknownFields
+func summarizeFieldErrors(errs []error) string {
+	messages := make([]string, 0, min(len(errs), maxReportedUnknownFields)+1)
+	for i, err := range errs {
+		if i == maxReportedUnknownFields {
+			messages = append(messages, fmt.Sprintf("and %d more", len(errs)-i))
+			break
+		}
+		messages = append(messages, err.Error())
+	}
+	return strings.Join(messages, ", ")
+}
@@ -0,0 +1,139 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"fmt"
+	"strings"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DGDR Generated Deployment Decoding", func() {
+	const generated = `apiVersion: nvidia.com/v1alpha1
+kind: DynamoGraphDeployment
+metadata:
+  name: test-dgd
+spec:
+  services:
+    Frontend:
+      replicas: %s
+      futureField: true
+`
+
+	It("Should drop unknown fields and report their paths as a warning", func() {
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{}
+		dgd, err := decodeGeneratedDeployment(dgdr, ProfilingOutputFile, []byte(fmt.Sprintf(generated, "2")))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(*dgd.Spec.Services["Frontend"].Replicas).Should(Equal(int32(2)))
+
+		Expect(dgdr.Status.Warnings).Should(HaveLen(1))
+		Expect(dgdr.Status.Warnings[0].Type).Should(Equal(WarningUnknownFields))
+		Expect(dgdr.Status.Warnings[0].Message).Should(ContainSubstring(`spec.services.Frontend.futureField`))
+		Expect(dgdr.Status.Warnings[0].Message).Should(ContainSubstring(ProfilingOutputFile))
+	})
+
+	It("Should fail malformed values with the field path", func() {
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{}
+		_, err := decodeGeneratedDeployment(dgdr, ProfilingOutputFile, []byte(fmt.Sprintf(generated, `"two"`)))
+		Expect(err).To(MatchError(ContainSubstring("spec.services.replicas")))
+		Expect(failureReasonFromError(err, nvidiacomv1alpha1.FailureReasonValidationError)).
+			To(Equal(nvidiacomv1alpha1.FailureReasonSpecParseError))
+		Expect(dgdr.Status.Warnings).Should(BeEmpty())
+	})
+
+	It("Should not warn about deployments the operator fully understands", func() {
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{}
+		content := strings.Replace(fmt.Sprintf(generated, "1"), "      futureField: true\n", "", 1)
+		_, err := decodeGeneratedDeployment(dgdr, ProfilingOutputFile, []byte(content))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(dgdr.Status.Warnings).Should(BeEmpty())
+	})
+
+	It("Should decode JSON and TOML deployments by extension or content", func() {
+		for _, tc := range []struct {
+			source  string
+			content string
+		}{
+			{"deploy.json", `{"apiVersion": "nvidia.com/v1alpha1", "kind": "DynamoGraphDeployment", "metadata": {"name": "test-dgd"}, "spec": {"services": {"Frontend": {"replicas": 2}}}}`},
+			{"spec.precomputedDeployment", `{"kind": "DynamoGraphDeployment", "metadata": {"name": "test-dgd"}, "spec": {"services": {"Frontend": {"replicas": 2}}}}`},
+			{"deploy.toml", "apiVersion = \"nvidia.com/v1alpha1\"\nkind = \"DynamoGraphDeployment\"\n\n[metadata]\nname = \"test-dgd\"\n\n[spec.services.Frontend]\nreplicas = 2\n"},
+			{"deploy", "# generated\nkind = \"DynamoGraphDeployment\"\n[metadata]\nname = \"test-dgd\"\n[spec.services.Frontend]\nreplicas = 2\n"},
+		} {
+			dgd, strictErrs, err := decodeDeployment(tc.source, []byte(tc.content))
+			Expect(err).NotTo(HaveOccurred(), tc.source)
+			Expect(strictErrs).Should(BeEmpty(), tc.source)
+			Expect(dgd.Name).Should(Equal("test-dgd"), tc.source)
+			Expect(*dgd.Spec.Services["Frontend"].Replicas).Should(Equal(int32(2)), tc.source)
+		}
+	})
+
+	It("Should pick the deployment among several documents", func() {
+		content := "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: planner\n---\n" +
+			strings.Replace(fmt.Sprintf(generated, "2"), "      futureField: true\n", "", 1)
+		dgd, _, err := decodeDeployment(ProfilingOutputFile, []byte(content))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(dgd.Name).Should(Equal("test-dgd"))
+
+		list := `{"kind": "List", "items": [{"kind": "ConfigMap"}, {"kind": "DynamoGraphDeployment", "metadata": {"name": "test-dgd"}}]}`
+		dgd, _, err = decodeDeployment("deploy.json", []byte(list))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(dgd.Name).Should(Equal("test-dgd"))
+
+		_, _, err = decodeDeployment("deploy.json", []byte(`[{"kind": "DynamoGraphDeployment"}, {"kind": "DynamoGraphDeployment"}]`))
+		Expect(err).To(MatchError(ContainSubstring("found 2")))
+		_, _, err = decodeDeployment(ProfilingOutputFile, []byte("kind: ConfigMap\n---\nkind: Service\n"))
+		Expect(err).To(MatchError(ContainSubstring("found 0")))
+	})
+
+	It("Should read the deployment from the configured output key", func() {
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{}
+		Expect(getProfilingOutputKey(dgdr)).Should(Equal(ProfilingOutputFile))
+		Expect(validateOutputKey(dgdr)).Should(Succeed())
+
+		dgdr.Spec.ProfilingConfig.OutputKey = "deploy.json"
+		Expect(getProfilingOutputKey(dgdr)).Should(Equal("deploy.json"))
+		Expect(requiredProfilingOutputFile(dgdr)).Should(Equal("deploy.json"))
+		Expect(validateOutputKey(dgdr)).Should(Succeed())
+
+		content, err := encodeProfilerOutput("deploy.json", []byte(fmt.Sprintf(generated, "2")))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(validateProfilingOutput(dgdr, map[string]string{"deploy.json": string(content)})).Should(Succeed())
+		Expect(validateProfilingOutput(dgdr, map[string]string{ProfilingOutputFile: string(content)})).
+			To(MatchError(ContainSubstring("missing deploy.json")))
+
+		dgdr.Spec.ProfilingConfig.OutputKey = "out/deploy.json"
+		Expect(validateOutputKey(dgdr)).To(MatchError(ContainSubstring("profilingConfig.outputKey")))
+
+		dgdr.Spec.Backend = BackendAuto
+		Expect(getProfilingOutputKey(dgdr)).Should(Equal(ProfilingOutputFile))
+	})
+
+	It("Should bound the reported fields", func() {
+		errs := make([]error, maxReportedUnknownFields+3)
+		for i := range errs {
+			errs[i] = fmt.Errorf("unknown field %q", fmt.Sprintf("spec.f%d", i))
+		}
+		summary := summarizeFieldErrors(errs)
+		Expect(summary).Should(ContainSubstring(`"spec.f9"`))
+		Expect(summary).ShouldNot(ContainSubstring(`"spec.f10"`))
+		Expect(summary).Should(HaveSuffix("and 3 more"))
+	})
+})
@@ -0,0 +1,205 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+)
+
+const (
+	// StateDegraded is entered when a Ready DGD stops being Ready, before the DGDR falls back to Deploying
+	StateDegraded = nvidiacomv1alpha1.DGDRStateDegraded
+
+	// ConditionTypeDeploymentDegraded is True while the DGD is not Ready but within the degradation grace period
+	ConditionTypeDeploymentDegraded = "DeploymentDegraded"
+
+	// Default degradation hysteresis
+	DefaultDegradedGracePeriod  = 60 * time.Second
+	DefaultDegradedObservations = 3
+
+	// MinDegradedObservationInterval is the least time between two observations of a degraded DGD
+	MinDegradedObservationInterval = 5 * time.Second
+
+	// Event reasons
+	EventReasonDeploymentNotReady  = "DeploymentNotReady"
+	EventReasonDeploymentRecovered = "DeploymentRecovered"
+
+	// Condition reasons
+	ReasonDegradationPersisted = "DegradationPersisted"
+
+	// Messages
+	MessageDeploymentNotReady  = "DynamoGraphDeployment %s is %s, redeploying if not Ready within %s"
+	MessageDeploymentRecovered = "DynamoGraphDeployment %s recovered to Ready"
+)
+
+// degradationThresholds returns how long and for how many consecutive observations a DGD must be
+// non-Ready before the DGDR leaves Degraded for Deploying
+func (r *DynamoGraphDeploymentRequestReconciler) degradationThresholds() (time.Duration, int32) {
+	gracePeriod, observations := r.DegradedGracePeriod, r.DegradedObservations
+	if gracePeriod < 0 {
+		gracePeriod = 0
+	}
+	if observations < 1 {
+		observations = 1
+	}
+	return gracePeriod, observations
+}
+
+// degradedObservationInterval returns how long apart a degraded DGD is observed: the required
+// observations are spread over the grace period, and never closer than MinDegradedObservationInterval
+func (r *DynamoGraphDeploymentRequestReconciler) degradedObservationInterval() time.Duration {
+	gracePeriod, observations := r.degradationThresholds()
+	return max(gracePeriod/time.Duration(observations), MinDegradedObservationInterval)
+}
+
+// enterDegradedState moves a Ready DGDR to Degraded after its DGD was observed non-Ready
+func (r *DynamoGraphDeploymentRequestReconciler) enterDegradedState(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, dgd *nvidiacomv1alpha1.DynamoGraphDeployment) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+	gracePeriod, _ := r.degradationThresholds()
+	logger.Info("DGD not Ready, entering Degraded state", "dgdState", dgd.Status.State, "gracePeriod", gracePeriod)
+
+	now := metav1.Now()
+	dgdr.Status.State = StateDegraded
+	dgdr.Status.Deployment.DegradedSince = &now
+	dgdr.Status.Deployment.DegradedObservations = 1
+
+	message := fmt.Sprintf(MessageDeploymentNotReady, dgd.Name, dgd.Status.State, gracePeriod)
+	r.Recorder.Event(dgdr, corev1.EventTypeWarning, EventReasonDeploymentNotReady, message)
+	meta.SetStatusCondition(&dgdr.Status.Conditions, metav1.Condition{
+		Type:               ConditionTypeDeploymentDegraded,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: dgdr.Generation,
+		Reason:             EventReasonDeploymentNotReady,
+		Message:            message,
+	})
+
+	if err := r.updateStatus(ctx, dgdr); err != nil {
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{RequeueAfter: r.degradedObservationInterval()}, nil
+}
+
+// handleDegradedState waits for a degraded DGD to recover. If it stays non-Ready for both the
+// grace period and the required number of consecutive observations, the DGDR falls back to Deploying.
+func (r *DynamoGraphDeploymentRequestReconciler) handleDegradedState(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	dgd := &nvidiacomv1alpha1.DynamoGraphDeployment{}
+	err := r.Get(ctx, types.NamespacedName{
+		Name:      dgdr.Status.Deployment.Name,
+		Namespace: dgdr.Status.Deployment.Namespace,
+	}, dgd)
+	if apierrors.IsNotFound(err) {
+		return r.handleDGDDeleted(ctx, dgdr)
+	}
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	dgdr.Status.Deployment.State = dgd.Status.State
+
+	if dgd.Status.State == "Ready" {
+		logger.Info("DGD recovered, returning to Ready state")
+		dgdr.Status.State = StateReady
+		clearDegradation(dgdr)
+
+		message := fmt.Sprintf(MessageDeploymentRecovered, dgd.Name)
+		r.Recorder.Event(dgdr, corev1.EventTypeNormal, EventReasonDeploymentRecovered, message)
+		meta.SetStatusCondition(&dgdr.Status.Conditions, metav1.Condition{
+			Type:               ConditionTypeDeploymentDegraded,
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: dgdr.Generation,
+			Reason:             EventReasonDeploymentRecovered,
+			Message:            message,
+		})
+		return ctrl.Result{}, r.updateStatus(ctx, dgdr)
+	}
+
+	gracePeriod, observations := r.degradationThresholds()
+	interval := r.degradedObservationInterval()
+	degradedFor := time.Duration(0)
+	if since := dgdr.Status.Deployment.DegradedSince; since != nil {
+		degradedFor = time.Since(since.Time)
+	}
+
+	// Observation n is due n-1 intervals after the DGD was first seen degraded, reconciles triggered
+	// by other events in between do not count
+	if degradedFor >= time.Duration(dgdr.Status.Deployment.DegradedObservations)*interval {
+		dgdr.Status.Deployment.DegradedObservations++
+	}
+
+	if degradedFor < gracePeriod || dgdr.Status.Deployment.DegradedObservations < observations {
+		logger.Info("DGD still not Ready, waiting for recovery",
+			"dgdState", dgd.Status.State,
+			"degradedFor", degradedFor,
+			"observations", dgdr.Status.Deployment.DegradedObservations)
+		if err := r.updateStatus(ctx, dgdr); err != nil {
+			return ctrl.Result{}, err
+		}
+		// Observe again when the next observation is due, or once the grace period ends
+		requeueAfter := gracePeriod - degradedFor
+		if dgdr.Status.Deployment.DegradedObservations < observations {
+			requeueAfter = time.Duration(dgdr.Status.Deployment.DegradedObservations)*interval - degradedFor
+		}
+		return ctrl.Result{RequeueAfter: max(requeueAfter, MinDegradedObservationInterval)}, nil
+	}
+
+	logger.Info("DGD degraded beyond grace period, transitioning back to Deploying",
+		"dgdState", dgd.Status.State, "degradedFor", degradedFor)
+	dgdr.Status.State = StateDeploying
+	clearDegradation(dgdr)
+	// The readiness deadline applies afresh to the redeployment
+	now := metav1.Now()
+	dgdr.Status.Deployment.DeployingSince = &now
+
+	r.Recorder.Event(dgdr, corev1.EventTypeWarning, EventReasonDeploymentDegraded,
+		fmt.Sprintf(MessageDeploymentDegraded, dgd.Name, dgd.Status.State))
+	meta.SetStatusCondition(&dgdr.Status.Conditions, metav1.Condition{
+		Type:               ConditionTypeDeploymentDegraded,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: dgdr.Generation,
+		Reason:             ReasonDegradationPersisted,
+		Message:            fmt.Sprintf("Deployment not Ready for %s, redeploying", degradedFor.Round(time.Second)),
+	})
+	meta.SetStatusCondition(&dgdr.Status.Conditions, metav1.Condition{
+		Type:    ConditionTypeDeploymentReady,
+		Status:  metav1.ConditionFalse,
+		Reason:  EventReasonDeploymentDegraded,
+		Message: fmt.Sprintf("Deployment degraded to %s", dgd.Status.State),
+	})
+
+	return ctrl.Result{}, r.updateStatus(ctx, dgdr)
+}
+
+// clearDegradation resets the degradation tracking of the DGD
+func clearDegradation(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) {
+	dgdr.Status.Deployment.DegradedSince = nil
+	dgdr.Status.Deployment.DegradedObservations = 0
+}
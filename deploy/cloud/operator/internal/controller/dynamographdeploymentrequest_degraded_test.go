@@ -0,0 +1,172 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var _ = Describe("DGDR Degradation Hysteresis", func() {
+	var reconciler *DynamoGraphDeploymentRequestReconciler
+
+	BeforeEach(func() {
+		reconciler = &DynamoGraphDeploymentRequestReconciler{
+			Client:               k8sClient,
+			Recorder:             record.NewFakeRecorder(100),
+			RBACManager:          &MockRBACManager{},
+			DegradedGracePeriod:  0,
+			DegradedObservations: 3,
+		}
+	})
+
+	// setup creates a DGD in the given state and a Ready DGDR managing it
+	setup := func(ctx context.Context, name, dgdState string) (*nvidiacomv1alpha1.DynamoGraphDeploymentRequest, *nvidiacomv1alpha1.DynamoGraphDeployment) {
+		dgd := &nvidiacomv1alpha1.DynamoGraphDeployment{
+			ObjectMeta: metav1.ObjectMeta{Name: name + "-dgd", Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentSpec{
+				Services: map[string]*nvidiacomv1alpha1.DynamoComponentDeploymentSharedSpec{"Frontend": {}},
+			},
+		}
+		Expect(k8sClient.Create(ctx, dgd)).Should(Succeed())
+		dgd.Status.State = dgdState
+		Expect(k8sClient.Status().Update(ctx, dgd)).Should(Succeed())
+
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model:   "test-model",
+				Backend: "vllm",
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					ProfilerImage: "test-profiler:latest",
+				},
+				AutoApply: true,
+			},
+		}
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		dgdr.Status.State = StateReady
+		dgdr.Status.ObservedGeneration = dgdr.Generation
+		dgdr.Status.Deployment = &nvidiacomv1alpha1.DeploymentStatus{
+			Name:      dgd.Name,
+			Namespace: defaultNamespace,
+			Created:   true,
+			State:     "Ready",
+		}
+		Expect(k8sClient.Status().Update(ctx, dgdr)).Should(Succeed())
+		return dgdr, dgd
+	}
+
+	reconcileAndGet := func(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) *nvidiacomv1alpha1.DynamoGraphDeploymentRequest {
+		request := reconcile.Request{NamespacedName: types.NamespacedName{Name: dgdr.Name, Namespace: dgdr.Namespace}}
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		updated := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{}
+		Expect(k8sClient.Get(ctx, request.NamespacedName, updated)).Should(Succeed())
+		return updated
+	}
+
+	It("Should fall back to Deploying only after consecutive non-Ready observations", func() {
+		ctx := context.Background()
+		dgdr, dgd := setup(ctx, "test-dgdr-degraded", "pending")
+		defer func() { _ = k8sClient.Delete(ctx, dgdr) }()
+		defer func() { _ = k8sClient.Delete(ctx, dgd) }()
+
+		updated := reconcileAndGet(ctx, dgdr)
+		Expect(updated.Status.State).Should(Equal(StateDegraded))
+		Expect(updated.Status.Deployment.DegradedSince).NotTo(BeNil())
+		Expect(updated.Status.Deployment.DegradedObservations).Should(Equal(int32(1)))
+		condition := meta.FindStatusCondition(updated.Status.Conditions, ConditionTypeDeploymentDegraded)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).Should(Equal(metav1.ConditionTrue))
+
+		// Reconciles before the next observation is due do not count, and do not requeue immediately
+		request := reconcile.Request{NamespacedName: types.NamespacedName{Name: dgdr.Name, Namespace: dgdr.Namespace}}
+		result, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).Should(BeNumerically(">", 0))
+		Expect(k8sClient.Get(ctx, request.NamespacedName, updated)).Should(Succeed())
+		Expect(updated.Status.Deployment.DegradedObservations).Should(Equal(int32(1)))
+
+		// elapse moves the start of the degradation back as if time passed
+		elapse := func(d time.Duration) {
+			Expect(k8sClient.Get(ctx, request.NamespacedName, updated)).Should(Succeed())
+			updated.Status.Deployment.DegradedSince = &metav1.Time{Time: updated.Status.Deployment.DegradedSince.Add(-d)}
+			Expect(k8sClient.Status().Update(ctx, updated)).Should(Succeed())
+		}
+
+		elapse(MinDegradedObservationInterval)
+		updated = reconcileAndGet(ctx, dgdr)
+		Expect(updated.Status.State).Should(Equal(StateDegraded))
+		Expect(updated.Status.Deployment.DegradedObservations).Should(Equal(int32(2)))
+
+		elapse(MinDegradedObservationInterval)
+		updated = reconcileAndGet(ctx, dgdr)
+		Expect(updated.Status.State).Should(Equal(StateDeploying))
+		Expect(updated.Status.Deployment.DegradedSince).To(BeNil())
+		Expect(meta.IsStatusConditionFalse(updated.Status.Conditions, ConditionTypeDeploymentReady)).To(BeTrue())
+		condition = meta.FindStatusCondition(updated.Status.Conditions, ConditionTypeDeploymentDegraded)
+		Expect(condition.Reason).Should(Equal(ReasonDegradationPersisted))
+	})
+
+	It("Should wait for the grace period before falling back to Deploying", func() {
+		ctx := context.Background()
+		reconciler.DegradedGracePeriod = time.Hour
+		reconciler.DegradedObservations = 1
+		dgdr, dgd := setup(ctx, "test-dgdr-degraded-grace", "pending")
+		defer func() { _ = k8sClient.Delete(ctx, dgdr) }()
+		defer func() { _ = k8sClient.Delete(ctx, dgd) }()
+
+		Expect(reconcileAndGet(ctx, dgdr).Status.State).Should(Equal(StateDegraded))
+
+		request := reconcile.Request{NamespacedName: types.NamespacedName{Name: dgdr.Name, Namespace: dgdr.Namespace}}
+		result, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).Should(BeNumerically(">", 59*time.Minute))
+		updated := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{}
+		Expect(k8sClient.Get(ctx, request.NamespacedName, updated)).Should(Succeed())
+		Expect(updated.Status.State).Should(Equal(StateDegraded))
+	})
+
+	It("Should return to Ready when the DGD recovers", func() {
+		ctx := context.Background()
+		dgdr, dgd := setup(ctx, "test-dgdr-degraded-recover", "pending")
+		defer func() { _ = k8sClient.Delete(ctx, dgdr) }()
+		defer func() { _ = k8sClient.Delete(ctx, dgd) }()
+
+		Expect(reconcileAndGet(ctx, dgdr).Status.State).Should(Equal(StateDegraded))
+
+		dgd.Status.State = "Ready"
+		Expect(k8sClient.Status().Update(ctx, dgd)).Should(Succeed())
+
+		updated := reconcileAndGet(ctx, dgdr)
+		Expect(updated.Status.State).Should(Equal(StateReady))
+		Expect(updated.Status.Deployment.DegradedObservations).Should(BeZero())
+		condition := meta.FindStatusCondition(updated.Status.Conditions, ConditionTypeDeploymentDegraded)
+		Expect(condition.Status).Should(Equal(metav1.ConditionFalse))
+		Expect(condition.Reason).Should(Equal(EventReasonDeploymentRecovered))
+	})
+})
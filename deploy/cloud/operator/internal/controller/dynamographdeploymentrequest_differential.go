@@ -0,0 +1,182 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"maps"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+)
+
+// Differential profiling contract: when a re-profile only changes the SLA, the results of the
+// previous attempt are delivered with the result transport as PreviousResultsFile, a JSON document
+// holding the attempt number, its SLA and its result files. The checkpoint restorer writes it to
+// ProfilingCheckpointPath and the profiler re-sweeps around the previous optimum, narrowing the
+// parallelism and batch ranges, if the previous_results config key names an existing file.
+const (
+	PreviousResultsFile      = "previous-results.json"
+	ConfigKeyPreviousResults = "previous_results"
+	ConfigKeySLA             = "sla"
+
+	// Event reasons
+	EventReasonDifferentialProfiling = "DifferentialProfiling"
+
+	// Messages
+	MessageDifferentialProfiling = "Only the SLA changed since profiling attempt %d, re-sweeping around its results"
+)
+
+// previousResults is the content of PreviousResultsFile
+type previousResults struct {
+	Attempt int32             `json:"attempt"`
+	SLA     json.RawMessage   `json:"sla,omitempty"`
+	Results map[string]string `json:"results"`
+}
+
+// profilingInputs returns the hash of the DGDR's profiler config without its SLA, and the SLA.
+// Settings that differ between attempts of the same inputs, like the checkpoint, are left out.
+func profilingInputs(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) (string, *apiextensionsv1.JSON, error) {
+	config, err := buildProfilingConfig(dgdr)
+	if err != nil {
+		return "", nil, err
+	}
+	var sla *apiextensionsv1.JSON
+	if value, exists := config[ConfigKeySLA]; exists {
+		raw, err := json.Marshal(value)
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to marshal profiling SLA: %w", err)
+		}
+		sla = &apiextensionsv1.JSON{Raw: raw}
+	}
+	for _, key := range []string{ConfigKeySLA, ConfigKeyResumeFrom, ConfigKeyPreviousResults} {
+		delete(config, key)
+	}
+	// Maps are marshaled with sorted keys, so equal configs hash equally
+	inputs, err := json.Marshal(config)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to marshal profiling config: %w", err)
+	}
+	hash := sha256.Sum256(inputs)
+	return hex.EncodeToString(hash[:]), sla, nil
+}
+
+// recordProfilingInputs records the inputs of a profiling attempt, which a later re-profile is compared against
+func recordProfilingInputs(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, attempt *nvidiacomv1alpha1.ProfilingAttempt) error {
+	hash, sla, err := profilingInputs(dgdr)
+	if err != nil {
+		return err
+	}
+	attempt.InputsHash = hash
+	attempt.SLA = sla
+	return nil
+}
+
+// differentialBaseAttempt returns the number of the last attempt if it succeeded with the same
+// profiling inputs as the current spec but a different SLA, or 0 if the DGDR must be profiled in full
+func differentialBaseAttempt(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) int32 {
+	n := len(dgdr.Status.Attempts)
+	if n == 0 {
+		return 0
+	}
+	last := dgdr.Status.Attempts[n-1]
+	if last.Outcome != nvidiacomv1alpha1.ProfilingAttemptSucceeded || last.InputsHash == "" || last.SLA == nil {
+		return 0
+	}
+	hash, sla, err := profilingInputs(dgdr)
+	if err != nil || hash != last.InputsHash || sla == nil {
+		return 0
+	}
+	if bytes.Equal(sla.Raw, last.SLA.Raw) {
+		return 0
+	}
+	return last.Attempt
+}
+
+// isDifferentialProfiling reports whether the next profiling job re-sweeps around previous results
+func isDifferentialProfiling(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) bool {
+	return dgdr.Status.Profiling != nil && dgdr.Status.Profiling.DifferentialFromAttempt > 0
+}
+
+// fetchPreviousResults packs the delivered results of the attempt the DGDR re-sweeps around into
+// the content of PreviousResultsFile. It returns "" if they are gone, in which case the DGDR is
+// profiled in full.
+func fetchPreviousResults(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, transport ResultTransport) (string, error) {
+	results, err := transport.Fetch(ctx, dgdr)
+	if isResultsMissing(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	attempt := dgdr.Status.Profiling.DifferentialFromAttempt
+	previous := previousResults{Attempt: attempt, Results: maps.Clone(results)}
+	delete(previous.Results, ProfilingCheckpointFile)
+	delete(previous.Results, PreviousResultsFile)
+	if len(previous.Results) == 0 {
+		// Packed already by an earlier job creation that did not complete
+		return results[PreviousResultsFile], nil
+	}
+	for _, a := range dgdr.Status.Attempts {
+		if a.Attempt == attempt && a.SLA != nil {
+			previous.SLA = a.SLA.Raw
+		}
+	}
+	content, err := json.Marshal(previous)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal previous profiling results: %w", err)
+	}
+	return string(content), nil
+}
+
+// replacePreviousResults deletes the results of earlier profiling runs before a new profiling job
+// starts. A differential re-profile keeps the results it re-sweeps around as PreviousResultsFile,
+// or falls back to a full sweep if they are gone.
+func replacePreviousResults(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, transport ResultTransport) error {
+	var previous string
+	if isDifferentialProfiling(dgdr) {
+		var err error
+		if previous, err = fetchPreviousResults(ctx, dgdr, transport); err != nil {
+			return err
+		}
+		if previous == "" {
+			log.FromContext(ctx).Info("Results of the previous profiling attempt are gone, profiling in full",
+				"attempt", dgdr.Status.Profiling.DifferentialFromAttempt)
+			dgdr.Status.Profiling.DifferentialFromAttempt = 0
+		}
+	}
+
+	if err := transport.Delete(ctx, dgdr); err != nil {
+		return err
+	}
+	untrackOwnedResources(dgdr, nvidiacomv1alpha1.OwnedResourceRoleOutput)
+	if previous == "" {
+		return nil
+	}
+	if err := transport.Store(ctx, dgdr, map[string]string{PreviousResultsFile: previous}); err != nil {
+		return fmt.Errorf("failed to store previous profiling results: %w", err)
+	}
+	return nil
+}
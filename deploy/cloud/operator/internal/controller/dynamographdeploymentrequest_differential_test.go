@@ -0,0 +1,158 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("DGDR Differential Profiling", func() {
+	var reconciler *DynamoGraphDeploymentRequestReconciler
+
+	BeforeEach(func() {
+		reconciler = &DynamoGraphDeploymentRequestReconciler{
+			Client:      k8sClient,
+			Recorder:    record.NewFakeRecorder(100),
+			RBACManager: &MockRBACManager{},
+		}
+	})
+
+	newDGDR := func(name string, ttft float64) *nvidiacomv1alpha1.DynamoGraphDeploymentRequest {
+		return &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model:   "Qwen/Qwen3-0.6B",
+				Backend: BackendVLLM,
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					ProfilerImage: "test-profiler:latest",
+					Config: createTestConfig(map[string]interface{}{
+						"sla": map[string]interface{}{"ttft": ttft, "itl": 20.0},
+					}),
+				},
+			},
+		}
+	}
+
+	It("Should hash the profiling inputs without the SLA", func() {
+		hash, sla, err := profilingInputs(newDGDR("test-dgdr-differential-inputs", 200))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(sla.Raw)).Should(Equal(`{"itl":20,"ttft":200}`))
+
+		otherHash, otherSLA, err := profilingInputs(newDGDR("test-dgdr-differential-inputs", 100))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(otherHash).Should(Equal(hash))
+		Expect(otherSLA.Raw).ShouldNot(Equal(sla.Raw))
+
+		otherModel := newDGDR("test-dgdr-differential-inputs", 200)
+		otherModel.Spec.Model = "Qwen/Qwen3-32B"
+		otherHash, _, err = profilingInputs(otherModel)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(otherHash).ShouldNot(Equal(hash))
+	})
+
+	It("Should re-sweep around the previous results when only the SLA changed", func() {
+		ctx := context.Background()
+		dgdr := newDGDR("test-dgdr-differential", 200)
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, dgdr) }()
+		defer func() {
+			_ = k8sClient.Delete(ctx, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: GetOutputConfigMapName(dgdr), Namespace: defaultNamespace}})
+		}()
+		dgdr.Status.State = StateProfiling
+		Expect(k8sClient.Status().Update(ctx, dgdr)).Should(Succeed())
+
+		// The first attempt profiles in full and delivers its results
+		Expect(reconciler.createProfilingJob(ctx, dgdr)).Should(Succeed())
+		Expect(dgdr.Status.Attempts).Should(HaveLen(1))
+		Expect(dgdr.Status.Attempts[0].InputsHash).ShouldNot(BeEmpty())
+		job := &batchv1.Job{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: GetProfilingJobName(dgdr), Namespace: defaultNamespace}, job)).Should(Succeed())
+		Expect(job.Spec.Template.Spec.Containers[0].Args[1]).ShouldNot(ContainSubstring(ConfigKeyPreviousResults))
+		Expect(k8sClient.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground))).Should(Succeed())
+
+		transport := reconciler.resultTransport(dgdr)
+		Expect(transport.Store(ctx, dgdr, map[string]string{
+			ProfilingOutputFile:     "kind: DynamoGraphDeployment\n",
+			ProfilingCheckpointFile: `{"completed": ["prefill_tp1"]}`,
+		})).Should(Succeed())
+		finishProfilingAttempt(dgdr, nvidiacomv1alpha1.ProfilingAttemptSucceeded)
+		dgdr.Status.State = StateReady
+		Expect(k8sClient.Status().Update(ctx, dgdr)).Should(Succeed())
+
+		// Re-profiling the same SLA starts over
+		reset, err := reconciler.resetForProfiling(ctx, dgdr.DeepCopy())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(reset).Should(BeTrue())
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: dgdr.Name, Namespace: defaultNamespace}, dgdr)).Should(Succeed())
+		Expect(isDifferentialProfiling(dgdr)).Should(BeFalse())
+		_, err = transport.Fetch(ctx, dgdr)
+		Expect(isResultsMissing(err)).Should(BeTrue())
+
+		// With only a tighter SLA the results of the attempt are kept
+		Expect(transport.Store(ctx, dgdr, map[string]string{ProfilingOutputFile: "kind: DynamoGraphDeployment\n"})).Should(Succeed())
+		dgdr.Spec.ProfilingConfig.Config = newDGDR(dgdr.Name, 100).Spec.ProfilingConfig.Config
+		Expect(k8sClient.Update(ctx, dgdr)).Should(Succeed())
+		reset, err = reconciler.resetForProfiling(ctx, dgdr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(reset).Should(BeTrue())
+		Expect(dgdr.Status.Profiling.DifferentialFromAttempt).Should(Equal(int32(1)))
+		_, err = transport.Fetch(ctx, dgdr)
+		Expect(err).NotTo(HaveOccurred())
+
+		dgdr.Status.State = StateProfiling
+		Expect(reconciler.createProfilingJob(ctx, dgdr)).Should(Succeed())
+		Expect(dgdr.Status.Attempts).Should(HaveLen(2))
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: GetProfilingJobName(dgdr), Namespace: defaultNamespace}, job)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, job) }()
+		Expect(job.Spec.Template.Spec.Containers[0].Args[1]).Should(ContainSubstring("previous_results: /checkpoint/" + PreviousResultsFile))
+		Expect(job.Spec.Template.Spec.InitContainers[0].Args[0]).Should(ContainSubstring(`{.data.previous-results\.json}`))
+
+		// The results are replaced by the packed previous results
+		results, err := transport.Fetch(ctx, dgdr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(results).Should(HaveLen(1))
+		previous := previousResults{}
+		Expect(json.Unmarshal([]byte(results[PreviousResultsFile]), &previous)).Should(Succeed())
+		Expect(previous.Attempt).Should(Equal(int32(1)))
+		Expect(string(previous.SLA)).Should(Equal(`{"itl":20,"ttft":200}`))
+		Expect(previous.Results).Should(Equal(map[string]string{ProfilingOutputFile: "kind: DynamoGraphDeployment\n"}))
+	})
+
+	It("Should profile in full when the previous results are gone", func() {
+		ctx := context.Background()
+		dgdr := newDGDR("test-dgdr-differential-missing", 100)
+		dgdr.Status.Profiling = &nvidiacomv1alpha1.ProfilingStatus{DifferentialFromAttempt: 1}
+		transport := reconciler.resultTransport(dgdr)
+
+		Expect(replacePreviousResults(ctx, dgdr, transport)).Should(Succeed())
+		Expect(isDifferentialProfiling(dgdr)).Should(BeFalse())
+		_, err := transport.Fetch(ctx, dgdr)
+		Expect(isResultsMissing(err)).Should(BeTrue())
+	})
+})
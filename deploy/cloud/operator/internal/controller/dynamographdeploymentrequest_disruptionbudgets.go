@@ -0,0 +1,129 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/consts"
+	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/dynamo"
+)
+
+// serviceReplicaFloor returns the replicas a service needs to sustain the SLA: the autoscaling
+// minimum when autoscaling is enabled, otherwise the replicas the profiler sized it to
+func serviceReplicaFloor(spec *nvidiacomv1alpha1.DynamoComponentDeploymentSharedSpec) int32 {
+	if spec.Autoscaling != nil && spec.Autoscaling.Enabled && spec.Autoscaling.MinReplicas > 0 {
+		return int32(spec.Autoscaling.MinReplicas)
+	}
+	if spec.Replicas != nil {
+		return *spec.Replicas
+	}
+	return 1
+}
+
+// generatePodDisruptionBudgets returns a PodDisruptionBudget for every frontend and worker service of the DGD
+func generatePodDisruptionBudgets(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, dgd *nvidiacomv1alpha1.DynamoGraphDeployment) []*policyv1.PodDisruptionBudget {
+	services := make([]string, 0, len(dgd.Spec.Services))
+	for service, spec := range dgd.Spec.Services {
+		if spec != nil && (spec.ComponentType == consts.ComponentTypeFrontend || spec.ComponentType == consts.ComponentTypeWorker) {
+			services = append(services, service)
+		}
+	}
+	sort.Strings(services)
+
+	pdbs := make([]*policyv1.PodDisruptionBudget, 0, len(services))
+	for _, service := range services {
+		floor := serviceReplicaFloor(dgd.Spec.Services[service])
+		if floor < 1 {
+			continue
+		}
+		minAvailable := intstr.FromInt32(floor)
+		pdbs = append(pdbs, &policyv1.PodDisruptionBudget{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      dynamo.GetDynamoComponentName(dgd, service),
+				Namespace: dgd.Namespace,
+				Labels: map[string]string{
+					LabelDGDRName:      dgdr.Name,
+					LabelDGDRNamespace: dgdr.Namespace,
+					LabelManagedBy:     LabelValueDynamoOperator,
+				},
+			},
+			Spec: policyv1.PodDisruptionBudgetSpec{
+				MinAvailable: &minAvailable,
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{
+					consts.KubeLabelDynamoGraphDeploymentName: dgd.Name,
+					consts.KubeLabelDynamoComponent:           service,
+				}},
+			},
+		})
+	}
+	return pdbs
+}
+
+// ensurePodDisruptionBudgets creates or updates the PodDisruptionBudgets of the DGD when requested.
+// Budgets in the DGDR namespace are owned by the DGDR. Owner references cannot cross namespaces, so
+// budgets of a DGD in another namespace are tracked by label and deleted when the DGDR is finalized.
+func (r *DynamoGraphDeploymentRequestReconciler) ensurePodDisruptionBudgets(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, dgd *nvidiacomv1alpha1.DynamoGraphDeployment) error {
+	if dgdr.Spec.DeploymentOverrides == nil || !dgdr.Spec.DeploymentOverrides.CreatePodDisruptionBudgets {
+		return nil
+	}
+	logger := log.FromContext(ctx)
+
+	for _, desired := range generatePodDisruptionBudgets(dgdr, dgd) {
+		pdb := &policyv1.PodDisruptionBudget{ObjectMeta: metav1.ObjectMeta{Name: desired.Name, Namespace: desired.Namespace}}
+		result, err := controllerutil.CreateOrUpdate(ctx, r.Client, pdb, func() error {
+			pdb.Labels = desired.Labels
+			pdb.Spec = desired.Spec
+			if pdb.Namespace == dgdr.Namespace {
+				return controllerutil.SetControllerReference(dgdr, pdb, r.Client.Scheme())
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("failed to apply PodDisruptionBudget %s: %w", desired.Name, err)
+		}
+		r.trackOwnedResource(ctx, dgdr, nvidiacomv1alpha1.OwnedResourceRolePDB, pdb)
+		logger.Info("Applied PodDisruptionBudget", "name", pdb.Name, "minAvailable", desired.Spec.MinAvailable.String(), "result", result)
+	}
+	return nil
+}
+
+// deletePodDisruptionBudgets deletes the budgets of a DGD outside the DGDR namespace, which
+// garbage collection cannot remove because they carry no owner reference
+func (r *DynamoGraphDeploymentRequestReconciler) deletePodDisruptionBudgets(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	if dgdr.Status.Deployment == nil || dgdr.Status.Deployment.Namespace == "" || dgdr.Status.Deployment.Namespace == dgdr.Namespace {
+		return nil
+	}
+	err := r.DeleteAllOf(ctx, &policyv1.PodDisruptionBudget{},
+		client.InNamespace(dgdr.Status.Deployment.Namespace),
+		client.MatchingLabels{LabelDGDRName: dgdr.Name, LabelDGDRNamespace: dgdr.Namespace})
+	if err != nil {
+		return fmt.Errorf("failed to delete PodDisruptionBudgets: %w", err)
+	}
+	return nil
+}
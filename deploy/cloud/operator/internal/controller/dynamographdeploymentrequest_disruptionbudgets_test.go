@@ -0,0 +1,138 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/consts"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("DGDR Pod Disruption Budgets", func() {
+	var reconciler *DynamoGraphDeploymentRequestReconciler
+
+	BeforeEach(func() {
+		reconciler = &DynamoGraphDeploymentRequestReconciler{
+			Client:      k8sClient,
+			Recorder:    record.NewFakeRecorder(100),
+			RBACManager: &MockRBACManager{},
+		}
+	})
+
+	generatedDGD := func() *nvidiacomv1alpha1.DynamoGraphDeployment {
+		return &nvidiacomv1alpha1.DynamoGraphDeployment{
+			TypeMeta: metav1.TypeMeta{APIVersion: nvidiacomv1alpha1.GroupVersion.String(), Kind: "DynamoGraphDeployment"},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentSpec{
+				Services: map[string]*nvidiacomv1alpha1.DynamoComponentDeploymentSharedSpec{
+					"Frontend": {ComponentType: consts.ComponentTypeFrontend},
+					"VllmDecodeWorker": {
+						ComponentType: consts.ComponentTypeWorker,
+						Replicas:      ptr.To(int32(4)),
+					},
+					"VllmPrefillWorker": {
+						ComponentType: consts.ComponentTypeWorker,
+						Replicas:      ptr.To(int32(6)),
+						Autoscaling:   &nvidiacomv1alpha1.Autoscaling{Enabled: true, MinReplicas: 2, MaxReplicas: 8},
+					},
+					"Planner": {ComponentType: consts.ComponentTypePlanner},
+				},
+			},
+		}
+	}
+
+	It("Should keep the profiled replica floor of frontend and worker services available", func() {
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{ObjectMeta: metav1.ObjectMeta{Name: "test-dgdr", Namespace: defaultNamespace}}
+		dgd := generatedDGD()
+		dgd.Name = "serving"
+		dgd.Namespace = defaultNamespace
+
+		pdbs := generatePodDisruptionBudgets(dgdr, dgd)
+		Expect(pdbs).Should(HaveLen(3))
+		floors := map[string]intstr.IntOrString{}
+		for _, pdb := range pdbs {
+			floors[pdb.Name] = *pdb.Spec.MinAvailable
+		}
+		Expect(floors).Should(Equal(map[string]intstr.IntOrString{
+			"serving-frontend":          intstr.FromInt32(1),
+			"serving-vllmdecodeworker":  intstr.FromInt32(4),
+			"serving-vllmprefillworker": intstr.FromInt32(2),
+		}))
+		Expect(pdbs[1].Spec.Selector.MatchLabels).Should(Equal(map[string]string{
+			consts.KubeLabelDynamoGraphDeploymentName: "serving",
+			consts.KubeLabelDynamoComponent:           "VllmDecodeWorker",
+		}))
+	})
+
+	It("Should create budgets owned by the DGDR with the deployment", func() {
+		ctx := context.Background()
+		generated := generatedDGD()
+		generated.Name = "test-dgdr-pdb"
+		raw, err := json.Marshal(generated)
+		Expect(err).NotTo(HaveOccurred())
+
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-dgdr-pdb", Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model:   "test-model",
+				Backend: "vllm",
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					ProfilerImage: "test-profiler:latest",
+				},
+				AutoApply:           true,
+				DeploymentOverrides: &nvidiacomv1alpha1.DeploymentOverridesSpec{CreatePodDisruptionBudgets: true},
+			},
+		}
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		DeferCleanup(func() { _ = k8sClient.Delete(ctx, dgdr) })
+		dgdr.Status.State = StateDeploying
+		dgdr.Status.GeneratedDeployment = &runtime.RawExtension{Raw: raw}
+		Expect(k8sClient.Status().Update(ctx, dgdr)).Should(Succeed())
+
+		_, err = reconciler.createDGD(ctx, dgdr)
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(func() {
+			_ = k8sClient.Delete(ctx, &nvidiacomv1alpha1.DynamoGraphDeployment{ObjectMeta: metav1.ObjectMeta{Name: generated.Name, Namespace: defaultNamespace}})
+		})
+
+		pdb := &policyv1.PodDisruptionBudget{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: "test-dgdr-pdb-vllmdecodeworker", Namespace: defaultNamespace}, pdb)).Should(Succeed())
+		Expect(pdb.Spec.MinAvailable.IntValue()).Should(Equal(4))
+		Expect(pdb.OwnerReferences).Should(HaveLen(1))
+		Expect(pdb.OwnerReferences[0].Name).Should(Equal(dgdr.Name))
+		Expect(pdb.Labels).Should(HaveKeyWithValue(LabelDGDRName, dgdr.Name))
+
+		list := &policyv1.PodDisruptionBudgetList{}
+		Expect(k8sClient.List(ctx, list, client.InNamespace(defaultNamespace), client.MatchingLabels{LabelDGDRName: dgdr.Name})).Should(Succeed())
+		Expect(list.Items).Should(HaveLen(3))
+		for i := range list.Items {
+			_ = k8sClient.Delete(ctx, &list.Items[i])
+		}
+	})
+})
@@ -0,0 +1,163 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	networkingv1beta1 "istio.io/client-go/pkg/apis/networking/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/consts"
+	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/dynamo"
+)
+
+// FrontendAPIKeyEnvSuffix marks the frontend environment variable holding the API key clients must
+// send. Only variables read from a Secret are reported, their values are never copied.
+const FrontendAPIKeyEnvSuffix = "API_KEY"
+
+// frontendService returns the name of the first frontend service of the DGD, or "" if it has none
+func frontendService(dgd *nvidiacomv1alpha1.DynamoGraphDeployment) string {
+	services := make([]string, 0, len(dgd.Spec.Services))
+	for service, spec := range dgd.Spec.Services {
+		if spec != nil && spec.ComponentType == consts.ComponentTypeFrontend {
+			services = append(services, service)
+		}
+	}
+	if len(services) == 0 {
+		return ""
+	}
+	sort.Strings(services)
+	return services[0]
+}
+
+// frontendAPIKeySecret returns the Secret key the frontend reads its API key from, if any
+func frontendAPIKeySecret(spec *nvidiacomv1alpha1.DynamoComponentDeploymentSharedSpec) *nvidiacomv1alpha1.SecretKeySelector {
+	envs := spec.Envs
+	if spec.ExtraPodSpec != nil && spec.ExtraPodSpec.MainContainer != nil {
+		envs = append(envs[:len(envs):len(envs)], spec.ExtraPodSpec.MainContainer.Env...)
+	}
+	for _, env := range envs {
+		if !strings.HasSuffix(env.Name, FrontendAPIKeyEnvSuffix) || env.ValueFrom == nil || env.ValueFrom.SecretKeyRef == nil {
+			continue
+		}
+		return &nvidiacomv1alpha1.SecretKeySelector{Name: env.ValueFrom.SecretKeyRef.Name, Key: env.ValueFrom.SecretKeyRef.Key}
+	}
+	return nil
+}
+
+// resolveEndpoint records in status how to call the model served by the DGD: the URL of its
+// frontend Service, the external URL of its Ingress or VirtualService and the Secret of its API
+// key. The endpoint is left unset until the frontend Service exists. The status is persisted by
+// the caller.
+func (r *DynamoGraphDeploymentRequestReconciler) resolveEndpoint(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, dgd *nvidiacomv1alpha1.DynamoGraphDeployment) error {
+	frontend := frontendService(dgd)
+	if frontend == "" {
+		dgdr.Status.Endpoint = nil
+		return nil
+	}
+	name := dynamo.GetDynamoComponentName(dgd, frontend)
+
+	service := &corev1.Service{}
+	if err := r.Get(ctx, types.NamespacedName{Name: name, Namespace: dgd.Namespace}, service); err != nil {
+		if apierrors.IsNotFound(err) {
+			dgdr.Status.Endpoint = nil
+			return nil
+		}
+		return fmt.Errorf("failed to get frontend service: %w", err)
+	}
+	port := int32(consts.DynamoServicePort)
+	for _, servicePort := range service.Spec.Ports {
+		if servicePort.Name == consts.DynamoServicePortName {
+			port = servicePort.Port
+		}
+	}
+
+	spec := dgd.Spec.Services[frontend]
+	url, err := r.externalURL(ctx, dgd, name, spec)
+	if err != nil {
+		return err
+	}
+	endpoint := &nvidiacomv1alpha1.EndpointStatus{
+		URL:             url,
+		InternalURL:     fmt.Sprintf("http://%s.%s.svc:%d", name, dgd.Namespace, port),
+		APIKeySecretRef: frontendAPIKeySecret(spec),
+	}
+	if !equality.Semantic.DeepEqual(dgdr.Status.Endpoint, endpoint) {
+		log.FromContext(ctx).Info("Resolved deployment endpoint", "url", endpoint.URL, "internalURL", endpoint.InternalURL)
+	}
+	dgdr.Status.Endpoint = endpoint
+	return nil
+}
+
+// externalURL returns the URL the frontend is exposed at outside the cluster, or "" if its Ingress
+// or VirtualService has not been created
+func (r *DynamoGraphDeploymentRequestReconciler) externalURL(ctx context.Context, dgd *nvidiacomv1alpha1.DynamoGraphDeployment, name string, spec *nvidiacomv1alpha1.DynamoComponentDeploymentSharedSpec) (string, error) {
+	// Same ingress settings as the DGD controller exposes the frontend with
+	ingressSpec := dynamo.GenerateDefaultIngressSpec(dgd, r.Config.IngressConfig)
+	if spec.Ingress != nil {
+		ingressSpec = *spec.Ingress
+	}
+	if !ingressSpec.Enabled {
+		return "", nil
+	}
+	scheme := "http"
+	if ingressSpec.TLS != nil {
+		scheme = "https"
+	}
+	key := types.NamespacedName{Name: name, Namespace: dgd.Namespace}
+
+	if ingressSpec.IsVirtualServiceEnabled() {
+		vs := &networkingv1beta1.VirtualService{}
+		if err := r.Get(ctx, key, vs); err != nil {
+			if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+				return "", nil
+			}
+			return "", fmt.Errorf("failed to get frontend virtual service: %w", err)
+		}
+		if len(vs.Spec.Hosts) == 0 {
+			return "", nil
+		}
+		return fmt.Sprintf("%s://%s", scheme, vs.Spec.Hosts[0]), nil
+	}
+
+	ingress := &networkingv1.Ingress{}
+	if err := r.Get(ctx, key, ingress); err != nil {
+		if apierrors.IsNotFound(err) {
+			return "", nil
+		}
+		return "", fmt.Errorf("failed to get frontend ingress: %w", err)
+	}
+	if len(ingress.Spec.Rules) == 0 || ingress.Spec.Rules[0].Host == "" {
+		return "", nil
+	}
+	if len(ingress.Spec.TLS) > 0 {
+		scheme = "https"
+	}
+	return fmt.Sprintf("%s://%s", scheme, ingress.Spec.Rules[0].Host), nil
+}
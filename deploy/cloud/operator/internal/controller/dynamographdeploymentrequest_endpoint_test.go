@@ -0,0 +1,140 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/consts"
+	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/dynamo"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var _ = Describe("DGDR Endpoint", func() {
+	var reconciler *DynamoGraphDeploymentRequestReconciler
+
+	BeforeEach(func() {
+		reconciler = &DynamoGraphDeploymentRequestReconciler{
+			Client:      k8sClient,
+			Recorder:    record.NewFakeRecorder(100),
+			RBACManager: &MockRBACManager{},
+		}
+	})
+
+	It("Should report the frontend API key Secret", func() {
+		spec := &nvidiacomv1alpha1.DynamoComponentDeploymentSharedSpec{
+			Envs: []corev1.EnvVar{{Name: "OPENAI_API_KEY", Value: "inline"}},
+		}
+		Expect(frontendAPIKeySecret(spec)).Should(BeNil())
+
+		spec.Envs = append(spec.Envs, corev1.EnvVar{Name: "DYN_API_KEY", ValueFrom: &corev1.EnvVarSource{
+			SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "llm-api-key"}, Key: "token"},
+		}})
+		Expect(frontendAPIKeySecret(spec)).Should(Equal(&nvidiacomv1alpha1.SecretKeySelector{Name: "llm-api-key", Key: "token"}))
+	})
+
+	It("Should surface the frontend endpoint of the Ready DGD", func() {
+		ctx := context.Background()
+		dgd := &nvidiacomv1alpha1.DynamoGraphDeployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-dgdr-endpoint-dgd", Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentSpec{
+				Services: map[string]*nvidiacomv1alpha1.DynamoComponentDeploymentSharedSpec{
+					"Frontend": {
+						ComponentType: consts.ComponentTypeFrontend,
+						Ingress: &nvidiacomv1alpha1.IngressSpec{
+							Enabled:                    true,
+							Host:                       "test-dgdr-endpoint",
+							IngressControllerClassName: ptr.To("nginx"),
+							TLS:                        &nvidiacomv1alpha1.IngressTLSSpec{SecretName: "tls"},
+						},
+					},
+					"VllmDecodeWorker": {ComponentType: consts.ComponentTypeWorker},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, dgd)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, dgd) }()
+		dgd.Status.State = "Ready"
+		Expect(k8sClient.Status().Update(ctx, dgd)).Should(Succeed())
+
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-dgdr-endpoint", Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model:   "test-model",
+				Backend: BackendVLLM,
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					ProfilerImage: "test-profiler:latest",
+				},
+				AutoApply: true,
+			},
+		}
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, dgdr) }()
+		dgdr.Status.State = StateReady
+		dgdr.Status.ObservedGeneration = dgdr.Generation
+		dgdr.Status.Deployment = &nvidiacomv1alpha1.DeploymentStatus{Name: dgd.Name, Namespace: defaultNamespace, Created: true, State: "Ready"}
+		Expect(k8sClient.Status().Update(ctx, dgdr)).Should(Succeed())
+
+		request := reconcile.Request{NamespacedName: types.NamespacedName{Name: dgdr.Name, Namespace: defaultNamespace}}
+		reconcileAndGet := func() *nvidiacomv1alpha1.DynamoGraphDeploymentRequest {
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+			updated := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{}
+			Expect(k8sClient.Get(ctx, request.NamespacedName, updated)).Should(Succeed())
+			return updated
+		}
+
+		// Without the frontend Service there is nothing to call yet
+		Expect(reconcileAndGet().Status.Endpoint).Should(BeNil())
+
+		name := dynamo.GetDynamoComponentName(dgd, "Frontend")
+		service, err := dynamo.GenerateComponentService(ctx, name, defaultNamespace)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(k8sClient.Create(ctx, service)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, service) }()
+
+		updated := reconcileAndGet()
+		Expect(updated.Status.Endpoint).Should(Equal(&nvidiacomv1alpha1.EndpointStatus{
+			InternalURL: "http://test-dgdr-endpoint-dgd-frontend.default.svc:8000",
+		}))
+
+		ingress := dynamo.GenerateComponentIngress(ctx, name, defaultNamespace, *dgd.Spec.Services["Frontend"].Ingress)
+		Expect(k8sClient.Create(ctx, ingress)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, ingress) }()
+		Expect(ingress.Spec.Rules[0].Host).Should(Equal("test-dgdr-endpoint.local"))
+
+		updated = reconcileAndGet()
+		Expect(updated.Status.Endpoint.URL).Should(Equal("https://test-dgdr-endpoint.local"))
+		Expect(updated.Status.Endpoint.InternalURL).Should(Equal("http://test-dgdr-endpoint-dgd-frontend.default.svc:8000"))
+		Expect(updated.Status.Endpoint.APIKeySecretRef).Should(BeNil())
+
+		// The endpoint is dropped with the DGD
+		Expect(k8sClient.Delete(ctx, dgd)).Should(Succeed())
+		updated = reconcileAndGet()
+		Expect(updated.Status.State).Should(Equal(StateDeploymentDeleted))
+		Expect(updated.Status.Endpoint).Should(BeNil())
+	})
+})
@@ -0,0 +1,405 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/yaml"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+)
+
+const (
+	// EstimateEndpointPath is the path DGDR specs are posted to for an estimate
+	EstimateEndpointPath = "/estimate"
+
+	// DefaultEstimateMaxBytes is the default size limit of an estimate request
+	DefaultEstimateMaxBytes = int64(1 << 20)
+	// DefaultEstimateTimeout is the default time AI Configurator has to answer an estimate request
+	DefaultEstimateTimeout = 2 * time.Minute
+
+	// estimateDefaultName names posted specs without a name, it only appears in validation messages
+	estimateDefaultName = "estimate"
+)
+
+// EstimateResponse is the answer of the estimate endpoint
+type EstimateResponse struct {
+	// Backend is the backend a DGDR with the posted spec would deploy
+	Backend string `json:"backend"`
+	// Estimates are the AI Configurator estimates of the evaluated backends
+	Estimates []BackendEstimate `json:"estimates"`
+	// Warnings are the status warnings a DGDR with the posted spec would report
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// BackendEstimate is the AI Configurator estimate of the deployment of one backend
+type BackendEstimate struct {
+	Backend string `json:"backend"`
+	// Feasible reports whether the deployment meets the SLA
+	Feasible bool `json:"feasible"`
+	// GPUs is the total number of GPUs of the deployment
+	GPUs int32 `json:"gpus"`
+	// Prefill and Decode are the workers of a disaggregated deployment, Decode alone an aggregated one
+	Prefill *WorkerEstimate `json:"prefill,omitempty"`
+	Decode  *WorkerEstimate `json:"decode,omitempty"`
+	// TTFT and ITL are the projected latencies in milliseconds
+	TTFT float64 `json:"ttft"`
+	ITL  float64 `json:"itl"`
+	// ThroughputPerGPU is the projected throughput in tokens per second per GPU
+	ThroughputPerGPU float64 `json:"throughputPerGPU"`
+}
+
+// WorkerEstimate is the parallelism of the prefill or decode workers of an estimate
+type WorkerEstimate struct {
+	Replicas             int32 `json:"replicas"`
+	TensorParallelSize   int32 `json:"tensorParallelSize"`
+	PipelineParallelSize int32 `json:"pipelineParallelSize,omitempty"`
+}
+
+// Estimator runs AI Configurator on the profiler config of a DGDR
+type Estimator interface {
+	Estimate(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, config map[string]interface{}) ([]BackendEstimate, error)
+}
+
+// AICEstimator posts profiler configs as YAML to an AI Configurator service, which answers with
+// the JSON list of its BackendEstimates
+type AICEstimator struct {
+	URL        string
+	HTTPClient *http.Client
+}
+
+// Estimate returns the estimates of the AI Configurator service
+func (e *AICEstimator) Estimate(ctx context.Context, _ *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, config map[string]interface{}) ([]BackendEstimate, error) {
+	body, err := yaml.Marshal(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal profiling config: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/yaml")
+
+	httpClient := e.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("AI Configurator request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	content, err := io.ReadAll(io.LimitReader(resp.Body, DefaultEstimateMaxBytes))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("AI Configurator answered %s: %s", resp.Status, strings.TrimSpace(string(content)))
+	}
+	var estimates []BackendEstimate
+	if err := json.Unmarshal(content, &estimates); err != nil {
+		return nil, fmt.Errorf("invalid AI Configurator answer: %w", err)
+	}
+	return estimates, nil
+}
+
+// MockEstimator returns the estimates the mock profiler reports, for clusters without AI Configurator
+type MockEstimator struct{}
+
+// Estimate returns a feasible single GPU deployment for every backend, ranked like the mock profiler ranks them
+func (MockEstimator) Estimate(_ context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, _ map[string]interface{}) ([]BackendEstimate, error) {
+	backends := []string{dgdr.Spec.Backend}
+	if dgdr.Spec.Backend == BackendAuto {
+		backends = candidateBackends(dgdr)
+	}
+	estimates := make([]BackendEstimate, 0, len(backends))
+	for i, backend := range backends {
+		estimates = append(estimates, BackendEstimate{
+			Backend:          backend,
+			Feasible:         true,
+			GPUs:             1,
+			Decode:           &WorkerEstimate{Replicas: 1, TensorParallelSize: 1},
+			TTFT:             100,
+			ITL:              10,
+			ThroughputPerGPU: float64(100 * (len(backends) - i)),
+		})
+	}
+	return estimates, nil
+}
+
+// EstimateServer is the HTTPS endpoint UIs and CLIs post DynamoGraphDeploymentRequests to for an
+// AI Configurator estimate of the deployment, without creating them. Requests authenticate with
+// a Kubernetes bearer token of a user allowed to create DGDRs in the namespace of the posted one.
+// The DGDR is validated like the controller validates it, and nothing is persisted.
+type EstimateServer struct {
+	Client     client.Client
+	Reconciler *DynamoGraphDeploymentRequestReconciler
+	Estimator  Estimator
+
+	// BindAddress is the address the endpoint listens on, e.g. ":8445"
+	BindAddress string
+
+	// TLSConfig holds the serving certificate
+	TLSConfig *tls.Config
+
+	// MaxBytes limits the size of a request, DefaultEstimateMaxBytes if zero
+	MaxBytes int64
+
+	// Timeout limits the time AI Configurator has to answer, DefaultEstimateTimeout if zero
+	Timeout time.Duration
+}
+
+// LoadEstimateServingCertificate returns the serving certificate of the estimate endpoint. It reads
+// tls.crt and tls.key from certDir, or generates a self-signed certificate for host if certDir is empty.
+func LoadEstimateServingCertificate(certDir, host string) (tls.Certificate, error) {
+	cert, _, err := loadServingCertificate(certDir, host)
+	if err != nil {
+		return tls.Certificate{}, fmt.Errorf("failed to load estimate endpoint certificate: %w", err)
+	}
+	return cert, nil
+}
+
+// NeedLeaderElection lets every replica serve estimates, they do not change the cluster
+func (s *EstimateServer) NeedLeaderElection() bool {
+	return false
+}
+
+// Start serves the endpoint until ctx is cancelled
+func (s *EstimateServer) Start(ctx context.Context) error {
+	mux := http.NewServeMux()
+	mux.Handle(EstimateEndpointPath, s)
+	server := &http.Server{
+		Addr:              s.BindAddress,
+		Handler:           mux,
+		TLSConfig:         s.TLSConfig,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+	}()
+
+	log.FromContext(ctx).Info("Serving estimate endpoint", "address", s.BindAddress)
+	if err := server.ListenAndServeTLS("", ""); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// ServeHTTP handles POST /estimate with a DynamoGraphDeploymentRequest as YAML or JSON and answers
+// with an EstimateResponse
+func (s *EstimateServer) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	logger := log.FromContext(req.Context())
+
+	response, err := s.handle(req)
+	if err != nil {
+		status := http.StatusInternalServerError
+		var requestErr *resultsRequestError
+		if errors.As(err, &requestErr) {
+			status = requestErr.status
+		}
+		logger.Info("Rejected estimate request", "status", status, "reason", err.Error())
+		http.Error(w, err.Error(), status)
+		return
+	}
+
+	content, err := json.Marshal(response)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(content)
+}
+
+func (s *EstimateServer) handle(req *http.Request) (*EstimateResponse, error) {
+	ctx := req.Context()
+
+	if req.Method != http.MethodPost {
+		return nil, requestError(http.StatusMethodNotAllowed, "method %s not allowed", req.Method)
+	}
+	dgdr, err := s.readRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorize(ctx, req, dgdr.Namespace); err != nil {
+		return nil, err
+	}
+
+	if getProfilingMode(dgdr) == nvidiacomv1alpha1.ProfilingModeNone {
+		return nil, requestError(http.StatusBadRequest, "spec.profilingMode none is not profiled, there is nothing to estimate")
+	}
+	if err := validateProfilingMode(dgdr); err != nil {
+		return nil, requestError(http.StatusUnprocessableEntity, "invalid DynamoGraphDeploymentRequest: %v", err)
+	}
+	if err := s.Reconciler.validateProfilingSpec(ctx, dgdr); err != nil {
+		return nil, requestError(http.StatusUnprocessableEntity, "invalid DynamoGraphDeploymentRequest: %v", err)
+	}
+	if isOnlineProfiling(dgdr) {
+		return nil, requestError(http.StatusUnprocessableEntity, "estimates require spec.profilingMode aic")
+	}
+	config, err := buildProfilingConfig(dgdr)
+	if err != nil {
+		return nil, requestError(http.StatusUnprocessableEntity, "invalid DynamoGraphDeploymentRequest: %v", err)
+	}
+
+	timeout := s.Timeout
+	if timeout == 0 {
+		timeout = DefaultEstimateTimeout
+	}
+	estimateCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	estimates, err := s.Estimator.Estimate(estimateCtx, dgdr, config)
+	if err != nil {
+		return nil, requestError(http.StatusBadGateway, "%v", err)
+	}
+
+	response := &EstimateResponse{Backend: dgdr.Spec.Backend, Estimates: estimates}
+	if dgdr.Spec.Backend == BackendAuto {
+		results := make([]backendResult, 0, len(estimates))
+		for _, estimate := range estimates {
+			results = append(results, backendResult{
+				Backend:          estimate.Backend,
+				Feasible:         estimate.Feasible,
+				TTFT:             estimate.TTFT,
+				ITL:              estimate.ITL,
+				ThroughputPerGPU: estimate.ThroughputPerGPU,
+			})
+		}
+		var preference []string
+		if len(dgdr.Spec.BackendPreference) > 0 {
+			preference = candidateBackends(dgdr)
+		}
+		if response.Backend, err = selectBackend(results, preference); err != nil {
+			return nil, requestError(http.StatusBadGateway, "%v", err)
+		}
+	}
+	for _, warning := range dgdr.Status.Warnings {
+		response.Warnings = append(response.Warnings, warning.Message)
+	}
+	return response, nil
+}
+
+// readRequest decodes the posted DynamoGraphDeploymentRequest, rejecting unknown fields
+func (s *EstimateServer) readRequest(req *http.Request) (*nvidiacomv1alpha1.DynamoGraphDeploymentRequest, error) {
+	maxBytes := s.MaxBytes
+	if maxBytes == 0 {
+		maxBytes = DefaultEstimateMaxBytes
+	}
+	content, err := io.ReadAll(http.MaxBytesReader(nil, req.Body, maxBytes))
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			return nil, requestError(http.StatusRequestEntityTooLarge, "request exceeds %d bytes", maxBytes)
+		}
+		return nil, err
+	}
+
+	dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{}
+	if err := yaml.UnmarshalStrict(content, dgdr); err != nil {
+		return nil, requestError(http.StatusBadRequest, "invalid DynamoGraphDeploymentRequest: %v", err)
+	}
+	if dgdr.Kind != "" && dgdr.Kind != "DynamoGraphDeploymentRequest" {
+		return nil, requestError(http.StatusBadRequest, "expected a DynamoGraphDeploymentRequest, got kind %q", dgdr.Kind)
+	}
+	if dgdr.Namespace == "" {
+		return nil, requestError(http.StatusBadRequest, "metadata.namespace is required")
+	}
+	if dgdr.Name == "" {
+		dgdr.Name = estimateDefaultName
+	}
+	dgdr.Status = nvidiacomv1alpha1.DynamoGraphDeploymentRequestStatus{}
+	return dgdr, nil
+}
+
+// authorize verifies that the bearer token of the request belongs to a user allowed to create
+// DynamoGraphDeploymentRequests in namespace
+func (s *EstimateServer) authorize(ctx context.Context, req *http.Request, namespace string) error {
+	user, err := authenticateRequest(ctx, s.Client, req)
+	if err != nil {
+		return err
+	}
+	allowed, err := reviewAccess(ctx, s.Client, user, authorizationv1.SubjectAccessReviewSpec{
+		ResourceAttributes: &authorizationv1.ResourceAttributes{
+			Namespace: namespace,
+			Verb:      "create",
+			Group:     nvidiacomv1alpha1.GroupVersion.Group,
+			Resource:  "dynamographdeploymentrequests",
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return requestError(http.StatusForbidden, "%s may not create DynamoGraphDeploymentRequests in namespace %s", user.Username, namespace)
+	}
+	return nil
+}
+
+// authenticateRequest returns the user the bearer token of the request belongs to
+func authenticateRequest(ctx context.Context, c client.Client, req *http.Request) (authenticationv1.UserInfo, error) {
+	token, ok := strings.CutPrefix(req.Header.Get("Authorization"), "Bearer ")
+	if !ok || token == "" {
+		return authenticationv1.UserInfo{}, requestError(http.StatusUnauthorized, "missing bearer token")
+	}
+
+	review := &authenticationv1.TokenReview{Spec: authenticationv1.TokenReviewSpec{Token: token}}
+	if err := c.Create(ctx, review); err != nil {
+		return authenticationv1.UserInfo{}, fmt.Errorf("failed to review token: %w", err)
+	}
+	if !review.Status.Authenticated {
+		return authenticationv1.UserInfo{}, requestError(http.StatusUnauthorized, "invalid token")
+	}
+	return review.Status.User, nil
+}
+
+// reviewAccess reports whether user may access the resource or path of spec
+func reviewAccess(ctx context.Context, c client.Client, user authenticationv1.UserInfo, spec authorizationv1.SubjectAccessReviewSpec) (bool, error) {
+	spec.User = user.Username
+	spec.UID = user.UID
+	spec.Groups = user.Groups
+	if len(user.Extra) > 0 {
+		spec.Extra = make(map[string]authorizationv1.ExtraValue, len(user.Extra))
+		for key, value := range user.Extra {
+			spec.Extra[key] = authorizationv1.ExtraValue(value)
+		}
+	}
+	access := &authorizationv1.SubjectAccessReview{Spec: spec}
+	if err := c.Create(ctx, access); err != nil {
+		return false, fmt.Errorf("failed to review access: %w", err)
+	}
+	return access.Status.Allowed, nil
+}
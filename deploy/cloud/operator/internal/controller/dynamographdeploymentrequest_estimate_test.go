@@ -0,0 +1,203 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/yaml"
+)
+
+var _ = Describe("DGDR Estimate Endpoint", func() {
+	const estimateRequest = `apiVersion: nvidia.com/v1alpha1
+kind: DynamoGraphDeploymentRequest
+metadata:
+  name: test-dgdr-estimate
+  namespace: default
+spec:
+  model: Qwen/Qwen3-0.6B
+  backend: auto
+  backendPreference: [sglang, vllm]
+  profilingConfig:
+    profilerImage: test-profiler:latest
+    config:
+      sla:
+        ttft: 200
+        itl: 20
+      sweep:
+        use_ai_configurator: true
+`
+
+	var server *EstimateServer
+
+	BeforeEach(func() {
+		server = &EstimateServer{
+			Client: k8sClient,
+			Reconciler: &DynamoGraphDeploymentRequestReconciler{
+				Client:      k8sClient,
+				Recorder:    record.NewFakeRecorder(100),
+				RBACManager: &MockRBACManager{},
+			},
+			Estimator: MockEstimator{},
+		}
+	})
+
+	// userToken returns a token of a ServiceAccount, allowed to create DGDRs in the default namespace if authorized
+	userToken := func(ctx context.Context, name string, authorized bool) string {
+		sa := &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: defaultNamespace}}
+		Expect(k8sClient.Create(ctx, sa)).Should(Succeed())
+		DeferCleanup(func() { _ = k8sClient.Delete(context.Background(), sa) })
+
+		if authorized {
+			role := &rbacv1.Role{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: defaultNamespace},
+				Rules: []rbacv1.PolicyRule{{
+					APIGroups: []string{nvidiacomv1alpha1.GroupVersion.Group},
+					Resources: []string{"dynamographdeploymentrequests"},
+					Verbs:     []string{"create"},
+				}},
+			}
+			binding := &rbacv1.RoleBinding{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: defaultNamespace},
+				RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "Role", Name: name},
+				Subjects:   []rbacv1.Subject{{Kind: rbacv1.ServiceAccountKind, Name: name, Namespace: defaultNamespace}},
+			}
+			Expect(k8sClient.Create(ctx, role)).Should(Succeed())
+			Expect(k8sClient.Create(ctx, binding)).Should(Succeed())
+			DeferCleanup(func() {
+				_ = k8sClient.Delete(context.Background(), binding)
+				_ = k8sClient.Delete(context.Background(), role)
+			})
+		}
+
+		request := &authenticationv1.TokenRequest{}
+		Expect(k8sClient.SubResource("token").Create(ctx, sa, request)).Should(Succeed())
+		return request.Status.Token
+	}
+
+	post := func(token, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, EstimateEndpointPath, strings.NewReader(body))
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		recorder := httptest.NewRecorder()
+		server.ServeHTTP(recorder, req)
+		return recorder
+	}
+
+	It("Should estimate a posted DGDR without creating it", func() {
+		ctx := context.Background()
+		token := userToken(ctx, "test-estimate-user", true)
+
+		recorder := post(token, estimateRequest)
+		Expect(recorder.Code).Should(Equal(http.StatusOK), recorder.Body.String())
+
+		response := &EstimateResponse{}
+		Expect(json.Unmarshal(recorder.Body.Bytes(), response)).Should(Succeed())
+		Expect(response.Backend).Should(Equal(BackendSGLang))
+		Expect(response.Estimates).Should(HaveLen(2))
+		Expect(response.Estimates[0].GPUs).Should(Equal(int32(1)))
+		Expect(response.Estimates[0].Decode.TensorParallelSize).Should(Equal(int32(1)))
+
+		err := k8sClient.Get(ctx, types.NamespacedName{Name: "test-dgdr-estimate", Namespace: defaultNamespace}, &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("Should not probe the profiler image of a posted DGDR", func() {
+		ctx := context.Background()
+		token := userToken(ctx, "test-estimate-probe", true)
+		inspector := &fakeProfilerInspector{}
+		server.Reconciler.ProfilerInspector = inspector
+
+		recorder := post(token, estimateRequest)
+		Expect(recorder.Code).Should(Equal(http.StatusOK), recorder.Body.String())
+		Expect(inspector.inspections).Should(BeZero())
+		err := k8sClient.Get(ctx, types.NamespacedName{Name: getProfilerCapabilitiesName("test-profiler:latest"), Namespace: defaultNamespace},
+			&nvidiacomv1alpha1.DynamoProfilerCapabilities{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("Should only estimate for users allowed to create DGDRs", func() {
+		ctx := context.Background()
+
+		Expect(post("", estimateRequest).Code).Should(Equal(http.StatusUnauthorized))
+		Expect(post("invalid", estimateRequest).Code).Should(Equal(http.StatusUnauthorized))
+		Expect(post(userToken(ctx, "test-estimate-denied", false), estimateRequest).Code).Should(Equal(http.StatusForbidden))
+	})
+
+	It("Should reject DGDRs the controller would reject", func() {
+		ctx := context.Background()
+		token := userToken(ctx, "test-estimate-invalid", true)
+
+		recorder := post(token, strings.Replace(estimateRequest, "use_ai_configurator: true", "use_ai_configurator: false", 1))
+		Expect(recorder.Code).Should(Equal(http.StatusUnprocessableEntity))
+		Expect(recorder.Body.String()).Should(ContainSubstring(ValidationErrorAutoRequiresAIC))
+
+		recorder = post(token, strings.Replace(estimateRequest, "  model:", "  modle:", 1))
+		Expect(recorder.Code).Should(Equal(http.StatusBadRequest))
+
+		recorder = post(token, strings.Replace(estimateRequest, "  namespace: default\n", "", 1))
+		Expect(recorder.Code).Should(Equal(http.StatusBadRequest))
+		Expect(recorder.Body.String()).Should(ContainSubstring("metadata.namespace is required"))
+
+		req := httptest.NewRequest(http.MethodGet, EstimateEndpointPath, nil)
+		get := httptest.NewRecorder()
+		server.ServeHTTP(get, req)
+		Expect(get.Code).Should(Equal(http.StatusMethodNotAllowed))
+	})
+
+	It("Should request estimates from the AI Configurator service", func() {
+		var posted map[string]interface{}
+		aic := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			body, err := io.ReadAll(req.Body)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(yaml.Unmarshal(body, &posted)).Should(Succeed())
+			_, _ = w.Write([]byte(`[{"backend": "vllm", "feasible": true, "gpus": 8, "prefill": {"replicas": 2, "tensorParallelSize": 2}, "decode": {"replicas": 1, "tensorParallelSize": 4}, "ttft": 150, "itl": 12, "throughputPerGPU": 900}]`))
+		}))
+		defer aic.Close()
+
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-dgdr-aic", Namespace: defaultNamespace},
+			Spec:       nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{Model: "Qwen/Qwen3-0.6B", Backend: BackendVLLM},
+		}
+		estimates, err := (&AICEstimator{URL: aic.URL}).Estimate(context.Background(), dgdr, map[string]interface{}{
+			"engine": map[string]interface{}{"backend": BackendVLLM},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(posted).Should(HaveKey("engine"))
+		Expect(estimates).Should(HaveLen(1))
+		Expect(estimates[0].GPUs).Should(Equal(int32(8)))
+		Expect(estimates[0].Prefill.Replicas).Should(Equal(int32(2)))
+	})
+})
@@ -0,0 +1,136 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// Fault injection points, set as <point>=<value> pairs of the --dgdr-fault-injection flag
+	FaultFailJobCreation    = "fail-job-creation"
+	FaultCorruptOutput      = "corrupt-output"
+	FaultDelayStatusUpdates = "delay-status-updates"
+
+	// CorruptedOutput replaces the profiling output of a corrupted fetch. It is not valid YAML.
+	CorruptedOutput = "kind: DynamoGraphDeployment\nspec: [corrupted by fault injection\n"
+)
+
+// ErrInjectedFault is returned by the operations failed by fault injection
+var ErrInjectedFault = errors.New("injected fault")
+
+// FaultInjector fails and delays DGDR operations on purpose so that the e2e suite can exercise the
+// error paths and retry policies of the state machine. Each failure point fails the given number of
+// operations across all DGDRs, then lets them through. A nil injector injects nothing; it must never
+// be configured in production.
+type FaultInjector struct {
+	mu sync.Mutex
+	// jobCreationFailures is the number of profiling job creations still to fail
+	jobCreationFailures int
+	// outputCorruptions is the number of profiling result fetches still to corrupt
+	outputCorruptions int
+	// statusUpdateDelay delays every DGDR status update
+	statusUpdateDelay time.Duration
+}
+
+// ParseFaultInjection parses the value of the fault injection flag, e.g.
+// "fail-job-creation=2,corrupt-output=1,delay-status-updates=5s". It returns nil for an empty value.
+func ParseFaultInjection(value string) (*FaultInjector, error) {
+	if strings.TrimSpace(value) == "" {
+		return nil, nil
+	}
+	injector := &FaultInjector{}
+	for _, pair := range strings.Split(value, ",") {
+		point, setting, found := strings.Cut(strings.TrimSpace(pair), "=")
+		if !found {
+			return nil, fmt.Errorf("fault injection %q must be <point>=<value>", pair)
+		}
+		var err error
+		switch point {
+		case FaultFailJobCreation:
+			injector.jobCreationFailures, err = strconv.Atoi(setting)
+		case FaultCorruptOutput:
+			injector.outputCorruptions, err = strconv.Atoi(setting)
+		case FaultDelayStatusUpdates:
+			injector.statusUpdateDelay, err = time.ParseDuration(setting)
+		default:
+			return nil, fmt.Errorf("unknown fault injection point %q, must be %s, %s or %s",
+				point, FaultFailJobCreation, FaultCorruptOutput, FaultDelayStatusUpdates)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid value of fault injection point %s: %w", point, err)
+		}
+		if setting[0] == '-' {
+			return nil, fmt.Errorf("invalid value of fault injection point %s: must not be negative", point)
+		}
+	}
+	return injector, nil
+}
+
+// take consumes one of the remaining injections of a failure point, reporting whether to inject
+func (f *FaultInjector) take(remaining *int) bool {
+	if f == nil {
+		return false
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if *remaining <= 0 {
+		return false
+	}
+	*remaining--
+	return true
+}
+
+// failJobCreation returns ErrInjectedFault while profiling job creations are to fail
+func (f *FaultInjector) failJobCreation(ctx context.Context) error {
+	if f == nil || !f.take(&f.jobCreationFailures) {
+		return nil
+	}
+	log.FromContext(ctx).Info("Injecting profiling job creation failure")
+	return fmt.Errorf("failed to create profiling job: %w", ErrInjectedFault)
+}
+
+// corruptOutput replaces the profiling output of fetched results while fetches are to be corrupted
+func (f *FaultInjector) corruptOutput(ctx context.Context, results map[string]string, outputKey string) {
+	if f == nil || !f.take(&f.outputCorruptions) {
+		return
+	}
+	log.FromContext(ctx).Info("Injecting corrupted profiling output", "key", outputKey)
+	results[outputKey] = CorruptedOutput
+}
+
+// delayStatusUpdate holds a status update back by the configured delay, or until ctx is done
+func (f *FaultInjector) delayStatusUpdate(ctx context.Context) error {
+	if f == nil || f.statusUpdateDelay <= 0 {
+		return nil
+	}
+	select {
+	case <-time.After(f.statusUpdateDelay):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
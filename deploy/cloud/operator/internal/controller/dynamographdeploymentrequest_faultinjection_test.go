@@ -0,0 +1,101 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+var _ = Describe("DGDR Fault Injection", func() {
+	newDGDR := func() *nvidiacomv1alpha1.DynamoGraphDeploymentRequest {
+		return &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-dgdr-faultinjection", Namespace: defaultNamespace},
+		}
+	}
+
+	It("Should parse the fault injection flag", func() {
+		injector, err := ParseFaultInjection("")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(injector).Should(BeNil())
+
+		injector, err = ParseFaultInjection("fail-job-creation=2, corrupt-output=1,delay-status-updates=5s")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(injector.jobCreationFailures).Should(Equal(2))
+		Expect(injector.outputCorruptions).Should(Equal(1))
+		Expect(injector.statusUpdateDelay).Should(Equal(5 * time.Second))
+
+		for _, value := range []string{"fail-job-creation", "fail-job-creation=-1", "corrupt-output=many", "delay-status-updates=5", "drop-events=1"} {
+			_, err := ParseFaultInjection(value)
+			Expect(err).Should(HaveOccurred(), value)
+		}
+	})
+
+	It("Should fail profiling job creation the configured number of times", func() {
+		injector, err := ParseFaultInjection("fail-job-creation=2")
+		Expect(err).NotTo(HaveOccurred())
+		reconciler := &DynamoGraphDeploymentRequestReconciler{Client: k8sClient, Recorder: record.NewFakeRecorder(100), FaultInjector: injector}
+
+		for i := 0; i < 2; i++ {
+			Expect(reconciler.createProfilingJob(context.Background(), newDGDR())).Should(MatchError(ErrInjectedFault))
+		}
+		Expect(injector.failJobCreation(context.Background())).Should(Succeed())
+	})
+
+	It("Should corrupt the fetched profiling output the configured number of times", func() {
+		injector, err := ParseFaultInjection("corrupt-output=1")
+		Expect(err).NotTo(HaveOccurred())
+		reconciler := &DynamoGraphDeploymentRequestReconciler{Client: k8sClient, Recorder: record.NewFakeRecorder(100), FaultInjector: injector}
+		dgdr := newDGDR()
+
+		results, err := reconciler.fetchResults(context.Background(), &flakyTransport{}, dgdr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(results[ProfilingOutputFile]).Should(Equal(CorruptedOutput))
+		_, err = decodeGeneratedDeployment(dgdr, ProfilingOutputFile, []byte(results[ProfilingOutputFile]))
+		Expect(err).Should(HaveOccurred())
+
+		results, err = reconciler.fetchResults(context.Background(), &flakyTransport{}, dgdr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(results[ProfilingOutputFile]).ShouldNot(Equal(CorruptedOutput))
+	})
+
+	It("Should delay status updates until the context is done", func() {
+		injector, err := ParseFaultInjection("delay-status-updates=1h")
+		Expect(err).NotTo(HaveOccurred())
+		reconciler := &DynamoGraphDeploymentRequestReconciler{Client: k8sClient, Recorder: record.NewFakeRecorder(100), FaultInjector: injector}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		Expect(reconciler.updateStatus(ctx, newDGDR())).Should(MatchError(context.DeadlineExceeded))
+	})
+
+	It("Should inject nothing without an injector", func() {
+		var injector *FaultInjector
+		results := map[string]string{ProfilingOutputFile: "kind: DynamoGraphDeployment\n"}
+		Expect(injector.failJobCreation(context.Background())).Should(Succeed())
+		injector.corruptOutput(context.Background(), results, ProfilingOutputFile)
+		Expect(results[ProfilingOutputFile]).ShouldNot(Equal(CorruptedOutput))
+		Expect(injector.delayStatusUpdate(context.Background())).Should(Succeed())
+	})
+})
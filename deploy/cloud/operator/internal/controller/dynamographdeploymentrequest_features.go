@@ -0,0 +1,143 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+)
+
+const (
+	// Features of spec.features, as named in the compatibility matrix
+	FeatureDisaggregation      = "disaggregation"
+	FeatureSpeculativeDecoding = "speculativeDecoding"
+
+	// Keys of profilingConfig.config.engine the features are passed to the profiler with
+	ConfigKeyDisaggregation      = "disaggregation"
+	ConfigKeySpeculativeDecoding = "speculative_decoding"
+
+	// Validation messages
+	ValidationErrorUnsupportedFeature   = "spec.features.%s is not supported by %s"
+	MessageExperimentalFeature          = "support of spec.features.%s on backend %s is experimental"
+	MessageFeatureDisabled              = "spec.features.%s was disabled, it is not supported by %s"
+	MessageUnsupportedFeatureCandidates = "spec.features.%s is not supported by candidate backends %s, exclude them with spec.backendPreference"
+)
+
+// requestedFeatures returns the features of spec.features that are set, true or false, by name.
+// Speculative decoding is enabled by configuring it.
+func requestedFeatures(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) map[string]bool {
+	features := map[string]bool{}
+	if dgdr.Spec.Features == nil {
+		return features
+	}
+	if dgdr.Spec.Features.Disaggregation != nil {
+		features[FeatureDisaggregation] = *dgdr.Spec.Features.Disaggregation
+	}
+	if dgdr.Spec.Features.SpeculativeDecoding != nil {
+		features[FeatureSpeculativeDecoding] = true
+	}
+	return features
+}
+
+// getUnsupportedFeaturePolicy returns the policy for unsupported features, defaulting to Reject
+func getUnsupportedFeaturePolicy(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) nvidiacomv1alpha1.UnsupportedFeaturePolicy {
+	if dgdr.Spec.Features == nil || dgdr.Spec.Features.OnUnsupported == "" {
+		return nvidiacomv1alpha1.UnsupportedFeaturePolicyReject
+	}
+	return dgdr.Spec.Features.OnUnsupported
+}
+
+// validateFeatures checks the enabled features of spec.features against the backend feature
+// matrix. A feature the backend, or every candidate backend, is known not to support fails
+// validation, or is disabled and listed in status.disabledFeatures with onUnsupported Downgrade.
+// Experimental features and unsupported candidates are reported as a warning.
+func (r *DynamoGraphDeploymentRequestReconciler) validateFeatures(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	dgdr.Status.DisabledFeatures = nil
+	if r.CompatibilityMatrix == nil {
+		return nil
+	}
+	matrix := r.CompatibilityMatrix.Get()
+	features := requestedFeatures(dgdr)
+
+	var messages []string
+	for _, feature := range []string{FeatureDisaggregation, FeatureSpeculativeDecoding} {
+		if !features[feature] {
+			continue
+		}
+		support := matrix.Features[feature]
+		var unsupported string
+		if dgdr.Spec.Backend == BackendAuto {
+			var excluded []string
+			candidates := candidateBackends(dgdr)
+			for _, backend := range candidates {
+				if support[backend] == BackendUnsupported {
+					excluded = append(excluded, backend)
+				}
+			}
+			if len(excluded) == len(candidates) {
+				unsupported = "any of the candidate backends " + strings.Join(candidates, ", ")
+			} else if len(excluded) > 0 {
+				messages = append(messages, fmt.Sprintf(MessageUnsupportedFeatureCandidates, feature, strings.Join(excluded, ", ")))
+			}
+		} else {
+			switch support[dgdr.Spec.Backend] {
+			case BackendUnsupported:
+				unsupported = "backend " + dgdr.Spec.Backend
+			case BackendExperimental:
+				messages = append(messages, fmt.Sprintf(MessageExperimentalFeature, feature, dgdr.Spec.Backend))
+			}
+		}
+		if unsupported == "" {
+			continue
+		}
+
+		if getUnsupportedFeaturePolicy(dgdr) == nvidiacomv1alpha1.UnsupportedFeaturePolicyReject {
+			return fmt.Errorf(ValidationErrorUnsupportedFeature, feature, unsupported)
+		}
+		dgdr.Status.DisabledFeatures = append(dgdr.Status.DisabledFeatures, feature)
+		messages = append(messages, fmt.Sprintf(MessageFeatureDisabled, feature, unsupported))
+	}
+
+	if len(messages) > 0 {
+		setWarning(dgdr, WarningUnsupportedFeature, strings.Join(messages, "; "))
+	}
+	return nil
+}
+
+// featureEnabled reports whether a feature of spec.features is enabled and was not disabled by validation
+func featureEnabled(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, feature string) bool {
+	return requestedFeatures(dgdr)[feature] && !slices.Contains(dgdr.Status.DisabledFeatures, feature)
+}
+
+// applyFeaturesConfig passes the features of spec.features to the profiler under engine, with
+// those disabled by validation turned off. Features that are not set are not passed, as older
+// profilers do not know them.
+func applyFeaturesConfig(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, engineConfig map[string]interface{}) {
+	if dgdr.Spec.Features == nil {
+		return
+	}
+	if dgdr.Spec.Features.Disaggregation != nil {
+		engineConfig[ConfigKeyDisaggregation] = featureEnabled(dgdr, FeatureDisaggregation)
+	}
+	if featureEnabled(dgdr, FeatureSpeculativeDecoding) {
+		engineConfig[ConfigKeySpeculativeDecoding] = speculativeDecodingProfilingConfig(dgdr.Spec.Features.SpeculativeDecoding)
+	}
+}
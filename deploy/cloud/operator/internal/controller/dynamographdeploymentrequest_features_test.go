@@ -0,0 +1,124 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+)
+
+var _ = Describe("DGDR Backend Features", func() {
+	var reconciler *DynamoGraphDeploymentRequestReconciler
+
+	BeforeEach(func() {
+		store, err := NewCompatibilityMatrixStore()
+		Expect(err).NotTo(HaveOccurred())
+		reconciler = &DynamoGraphDeploymentRequestReconciler{
+			Client:              k8sClient,
+			Recorder:            record.NewFakeRecorder(100),
+			RBACManager:         &MockRBACManager{},
+			CompatibilityMatrix: store,
+		}
+	})
+
+	eagle3 := &nvidiacomv1alpha1.SpeculativeDecodingSpec{
+		Method:     nvidiacomv1alpha1.SpeculativeDecodingMethodEAGLE3,
+		DraftModel: "yuhuili/EAGLE3-LLaMA3.1-Instruct-8B",
+	}
+
+	newDGDR := func(backend string, features *nvidiacomv1alpha1.FeaturesSpec) *nvidiacomv1alpha1.DynamoGraphDeploymentRequest {
+		return &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-dgdr-features", Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model:    "Qwen/Qwen3-0.6B",
+				Backend:  backend,
+				Features: features,
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					ProfilerImage: "test-profiler:latest",
+					Config: createTestConfig(map[string]interface{}{
+						"sweep": map[string]interface{}{"use_ai_configurator": true},
+					}),
+				},
+			},
+		}
+	}
+
+	It("Should parse the backend feature matrix", func() {
+		matrix := reconciler.CompatibilityMatrix.Get()
+		Expect(matrix.Features).Should(HaveKeyWithValue(FeatureDisaggregation, HaveKeyWithValue(BackendTRTLLM, BackendUnsupported)))
+
+		_, err := ParseCompatibilityMatrix([]byte("models: []\nfeatures:\n  disaggregation:\n    vllm: maybe\n"))
+		Expect(err).To(MatchError(ContainSubstring("for feature disaggregation")))
+	})
+
+	It("Should reject features the backend does not support", func() {
+		Expect(reconciler.validateFeatures(newDGDR(BackendVLLM, &nvidiacomv1alpha1.FeaturesSpec{Disaggregation: ptr.To(true)}))).Should(Succeed())
+		Expect(reconciler.validateFeatures(newDGDR(BackendTRTLLM, &nvidiacomv1alpha1.FeaturesSpec{Disaggregation: ptr.To(false)}))).Should(Succeed())
+
+		err := reconciler.validateFeatures(newDGDR(BackendTRTLLM, &nvidiacomv1alpha1.FeaturesSpec{Disaggregation: ptr.To(true)}))
+		Expect(err).To(MatchError("spec.features.disaggregation is not supported by backend trtllm"))
+
+		auto := newDGDR(BackendAuto, &nvidiacomv1alpha1.FeaturesSpec{Disaggregation: ptr.To(true)})
+		auto.Spec.BackendPreference = []nvidiacomv1alpha1.CandidateBackend{BackendTRTLLM}
+		Expect(reconciler.validateFeatures(auto)).To(MatchError(ContainSubstring("any of the candidate backends trtllm")))
+	})
+
+	It("Should warn about experimental features and unsupported candidates", func() {
+		dgdr := newDGDR(BackendSGLang, &nvidiacomv1alpha1.FeaturesSpec{SpeculativeDecoding: eagle3})
+		Expect(reconciler.validateFeatures(dgdr)).Should(Succeed())
+		Expect(dgdr.Status.Warnings).Should(ContainElement(HaveField("Message",
+			"support of spec.features.speculativeDecoding on backend sglang is experimental")))
+
+		auto := newDGDR(BackendAuto, &nvidiacomv1alpha1.FeaturesSpec{Disaggregation: ptr.To(true)})
+		Expect(reconciler.validateFeatures(auto)).Should(Succeed())
+		Expect(auto.Status.Warnings).Should(ContainElement(HaveField("Message", ContainSubstring("candidate backends trtllm"))))
+		Expect(auto.Status.DisabledFeatures).Should(BeEmpty())
+	})
+
+	It("Should disable unsupported features with onUnsupported Downgrade", func() {
+		dgdr := newDGDR(BackendTRTLLM, &nvidiacomv1alpha1.FeaturesSpec{
+			Disaggregation:      ptr.To(true),
+			SpeculativeDecoding: eagle3,
+			OnUnsupported:       nvidiacomv1alpha1.UnsupportedFeaturePolicyDowngrade,
+		})
+		Expect(reconciler.validateFeatures(dgdr)).Should(Succeed())
+		Expect(dgdr.Status.DisabledFeatures).Should(Equal([]string{FeatureDisaggregation}))
+		Expect(dgdr.Status.Warnings).Should(ContainElement(SatisfyAll(
+			HaveField("Type", WarningUnsupportedFeature),
+			HaveField("Message", "spec.features.disaggregation was disabled, it is not supported by backend trtllm"),
+		)))
+
+		config, err := buildProfilingConfig(dgdr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(config["engine"]).Should(HaveKeyWithValue(ConfigKeyDisaggregation, false))
+		Expect(config["engine"]).Should(HaveKeyWithValue(ConfigKeySpeculativeDecoding, map[string]interface{}{
+			"method":                 "eagle3",
+			"draft_model":            "yuhuili/EAGLE3-LLaMA3.1-Instruct-8B",
+			"num_speculative_tokens": int32(3),
+		}))
+
+		// Features that are not set are not passed to the profiler
+		config, err = buildProfilingConfig(newDGDR(BackendVLLM, nil))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(config["engine"]).ShouldNot(HaveKey(ConfigKeyDisaggregation))
+	})
+})
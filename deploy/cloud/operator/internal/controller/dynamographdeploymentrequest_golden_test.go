@@ -0,0 +1,191 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"flag"
+	"os"
+	"path/filepath"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
+)
+
+// updateGolden rewrites the golden files with the rendered objects instead of comparing them,
+// see make test-update-golden
+var updateGolden = flag.Bool("update-golden", false, "rewrite the golden files of the generated Job and DGD objects")
+
+const (
+	goldenDir = "testdata/golden"
+
+	// goldenHeader precedes the rendered object in golden files
+	goldenHeader = `# SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+# SPDX-License-Identifier: Apache-2.0
+# Generated by make test-update-golden, do not edit
+`
+)
+
+// goldenCase is a DGDR spec whose profiling Job and generated DGD are checked against golden files
+type goldenCase struct {
+	name string
+	spec nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec
+}
+
+// expectGolden compares the YAML of obj with the golden file, or rewrites it with -update-golden
+func expectGolden(path string, obj interface{}) {
+	content, err := yaml.Marshal(obj)
+	Expect(err).NotTo(HaveOccurred())
+	rendered := goldenHeader + string(content)
+	if *updateGolden {
+		Expect(os.MkdirAll(filepath.Dir(path), 0o755)).Should(Succeed())
+		Expect(os.WriteFile(path, []byte(rendered), 0o644)).Should(Succeed())
+		return
+	}
+	golden, err := os.ReadFile(path)
+	Expect(err).NotTo(HaveOccurred(), "missing golden file, run the tests with -update-golden to create it")
+	Expect(rendered).Should(Equal(string(golden)),
+		"%s differs from the rendered object, run the tests with -update-golden if the change is intended", path)
+}
+
+var _ = Describe("DGDR Golden Files", func() {
+	sla := map[string]interface{}{"sla": map[string]interface{}{"isl": 3000, "osl": 150, "ttft": 200.0, "itl": 20.0}}
+
+	cases := []goldenCase{
+		{
+			name: "online",
+			spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model:   "Qwen/Qwen3-0.6B",
+				Backend: BackendVLLM,
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					ProfilerImage: "nvcr.io/nvidia/ai-dynamo/vllm-runtime:0.6.1",
+					Config:        createTestConfig(sla),
+				},
+			},
+		},
+		{
+			name: "aic",
+			spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model:         "Qwen/Qwen3-32B",
+				Backend:       BackendTRTLLM,
+				ProfilingMode: nvidiacomv1alpha1.ProfilingModeAIC,
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					ProfilerImage: "nvcr.io/nvidia/ai-dynamo/trtllm-runtime:0.6.1",
+					Config: createTestConfig(map[string]interface{}{
+						"sla":   sla["sla"],
+						"sweep": map[string]interface{}{"aic_system": "h200_sxm", "aic_backend_version": "0.20.0"},
+					}),
+				},
+			},
+		},
+		{
+			name: "base-config",
+			spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model:   "Qwen/Qwen3-0.6B",
+				Backend: BackendSGLang,
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					ProfilerImage: "nvcr.io/nvidia/ai-dynamo/sglang-runtime:0.6.1",
+					Config:        createTestConfig(sla),
+					ConfigMapRef:  &nvidiacomv1alpha1.ConfigMapKeySelector{Name: "golden-profiling-config", Key: "profile.yaml"},
+				},
+			},
+		},
+		{
+			name: "overrides",
+			spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model:   "Qwen/Qwen3-0.6B",
+				Backend: BackendVLLM,
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					ProfilerImage: "nvcr.io/nvidia/ai-dynamo/vllm-runtime:0.6.1",
+					Config:        createTestConfig(sla),
+				},
+				AutoApply: true,
+				DeploymentOverrides: &nvidiacomv1alpha1.DeploymentOverridesSpec{
+					Name:         "golden-serving",
+					Labels:       map[string]string{"team": "inference"},
+					Annotations:  map[string]string{"owner": "inference@example.com"},
+					WorkersImage: "registry.example.com/vllm-runtime:custom",
+					Services: map[string]nvidiacomv1alpha1.ServiceOverride{
+						"VllmDecodeWorker": {
+							Replicas:  ptr.To(int32(3)),
+							Env:       []corev1.EnvVar{{Name: "VLLM_LOGGING_LEVEL", Value: "DEBUG"}},
+							ExtraArgs: []string{"--enable-prefix-caching"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "gpu-constraints",
+			spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model:    "Qwen/Qwen3-0.6B",
+				Backend:  BackendVLLM,
+				Hardware: &nvidiacomv1alpha1.HardwareSpec{GPUResourceName: "nvidia.com/mig-3g.40gb"},
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					ProfilerImage: "nvcr.io/nvidia/ai-dynamo/vllm-runtime:0.6.1",
+					Config: createTestConfig(map[string]interface{}{
+						"sla":      sla["sla"],
+						"hardware": map[string]interface{}{"min_num_gpus_per_engine": 2, "max_num_gpus_per_engine": 4, "num_gpus_per_node": 8},
+					}),
+				},
+			},
+		},
+	}
+
+	var reconciler *DynamoGraphDeploymentRequestReconciler
+
+	BeforeEach(func() {
+		reconciler = &DynamoGraphDeploymentRequestReconciler{
+			Client:             k8sClient,
+			Recorder:           record.NewFakeRecorder(100),
+			RBACManager:        &MockRBACManager{},
+			PodSecurityProfile: PodSecurityProfileRestricted,
+		}
+	})
+
+	for _, tc := range cases {
+		It("Should render the golden profiling Job and DGD for "+tc.name, func() {
+			ctx := context.Background()
+			dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+				ObjectMeta: metav1.ObjectMeta{Name: "golden-" + tc.name, Namespace: defaultNamespace},
+				Spec:       *tc.spec.DeepCopy(),
+			}
+
+			jobName := startProfilingAttempt(dgdr).JobName
+			job, err := reconciler.buildProfilingJob(ctx, dgdr, jobName, reconciler.resultTransport(dgdr))
+			Expect(err).NotTo(HaveOccurred())
+			expectGolden(filepath.Join(goldenDir, tc.name, "job.yaml"), job)
+
+			profilerOutput, err := os.ReadFile(filepath.Join(goldenDir, "profiler_output.yaml"))
+			Expect(err).NotTo(HaveOccurred())
+			generated, err := reconciler.renderGeneratedDeployment(ctx, dgdr, getProfilingOutputKey(dgdr), profilerOutput)
+			Expect(err).NotTo(HaveOccurred())
+			dgdr.Status.GeneratedDeployment = &runtime.RawExtension{Object: generated}
+			dgd, err := dgdr.BuildDeployment()
+			Expect(err).NotTo(HaveOccurred())
+			expectGolden(filepath.Join(goldenDir, tc.name, "dgd.yaml"), dgd)
+		})
+	}
+})
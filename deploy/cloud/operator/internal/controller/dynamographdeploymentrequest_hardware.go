@@ -0,0 +1,157 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	dynamoCommon "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/dynamo/common"
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	commonconsts "github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/consts"
+)
+
+const (
+	// Profiler config keys under hardware telling the profiler which GPUs to request
+	ConfigKeyGPUVendor       = "gpu_vendor"
+	ConfigKeyGPUResourceName = "gpu_resource_name"
+
+	// Validation messages
+	ValidationErrorBackendVendor   = "backend %s does not support %s GPUs"
+	ValidationErrorAICVendor       = "spec.profilingMode aic is only supported for NVIDIA GPUs, profile %s GPUs online"
+	ValidationErrorGPUResourceName = "hardware.gpuResourceName %q must be an extended resource name such as amd.com/gpu: %s"
+)
+
+// vendorGPUResourceNames are the default extended resources of each GPU vendor
+var vendorGPUResourceNames = map[nvidiacomv1alpha1.AcceleratorVendor]corev1.ResourceName{
+	nvidiacomv1alpha1.AcceleratorVendorNVIDIA: commonconsts.KubeResourceGPUNvidia,
+	nvidiacomv1alpha1.AcceleratorVendorAMD:    commonconsts.KubeResourceGPUAMD,
+}
+
+// vendorBackends lists the backends that run on the GPUs of each vendor
+var vendorBackends = map[nvidiacomv1alpha1.AcceleratorVendor][]string{
+	nvidiacomv1alpha1.AcceleratorVendorNVIDIA: {BackendVLLM, BackendSGLang, BackendTRTLLM},
+	nvidiacomv1alpha1.AcceleratorVendorAMD:    {BackendVLLM, BackendSGLang},
+}
+
+// getAcceleratorVendor returns the GPU vendor of the DGDR, defaulting to NVIDIA
+func getAcceleratorVendor(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) nvidiacomv1alpha1.AcceleratorVendor {
+	if dgdr.Spec.Hardware == nil || dgdr.Spec.Hardware.Vendor == "" {
+		return nvidiacomv1alpha1.AcceleratorVendorNVIDIA
+	}
+	return dgdr.Spec.Hardware.Vendor
+}
+
+// gpuResourceName returns the extended resource the GPUs of the DGDR are requested with
+func gpuResourceName(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) corev1.ResourceName {
+	if dgdr.Spec.Hardware != nil && dgdr.Spec.Hardware.GPUResourceName != "" {
+		return corev1.ResourceName(dgdr.Spec.Hardware.GPUResourceName)
+	}
+	return vendorGPUResourceNames[getAcceleratorVendor(dgdr)]
+}
+
+// usesDefaultGPUResource reports whether GPUs are requested as nvidia.com/gpu, which the
+// DynamoGraphDeployment gpu fields translate to
+func usesDefaultGPUResource(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) bool {
+	return gpuResourceName(dgdr) == commonconsts.KubeResourceGPUNvidia
+}
+
+// vendorSupportsBackend reports whether a backend runs on the GPUs of a vendor
+func vendorSupportsBackend(vendor nvidiacomv1alpha1.AcceleratorVendor, backend string) bool {
+	for _, supported := range vendorBackends[vendor] {
+		if supported == backend {
+			return true
+		}
+	}
+	return false
+}
+
+// validateHardware checks that the backend, the profiling mode and the GPU resource name are
+// supported on the GPUs of the DGDR's vendor
+func validateHardware(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	vendor := getAcceleratorVendor(dgdr)
+	if dgdr.Spec.Hardware != nil && dgdr.Spec.Hardware.GPUResourceName != "" {
+		name := dgdr.Spec.Hardware.GPUResourceName
+		if !strings.Contains(name, "/") {
+			return fmt.Errorf(ValidationErrorGPUResourceName, name, "missing domain prefix")
+		}
+		if errs := validation.IsQualifiedName(name); len(errs) > 0 {
+			return fmt.Errorf(ValidationErrorGPUResourceName, name, strings.Join(errs, ", "))
+		}
+	}
+	if vendor == nvidiacomv1alpha1.AcceleratorVendorNVIDIA {
+		return nil
+	}
+
+	backends := []string{dgdr.Spec.Backend}
+	if dgdr.Spec.Backend == BackendAuto {
+		backends = candidateBackends(dgdr)
+	}
+	for _, backend := range backends {
+		if !vendorSupportsBackend(vendor, backend) {
+			return fmt.Errorf(ValidationErrorBackendVendor, backend, vendor)
+		}
+	}
+	if !isCPUOnly(dgdr) && !isOnlineProfiling(dgdr) {
+		return fmt.Errorf(ValidationErrorAICVendor, vendor)
+	}
+	return nil
+}
+
+// applyHardwareConfig tells the profiler which GPUs its profiling deployments request. The keys
+// are only set for non-default GPUs so configs of older profilers stay unchanged.
+func applyHardwareConfig(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, config map[string]interface{}) {
+	if dgdr.Spec.Hardware == nil || (getAcceleratorVendor(dgdr) == nvidiacomv1alpha1.AcceleratorVendorNVIDIA && usesDefaultGPUResource(dgdr)) {
+		return
+	}
+	hardwareConfig, ok := config["hardware"].(map[string]interface{})
+	if !ok {
+		hardwareConfig = make(map[string]interface{})
+		config["hardware"] = hardwareConfig
+	}
+	hardwareConfig[ConfigKeyGPUVendor] = string(getAcceleratorVendor(dgdr))
+	hardwareConfig[ConfigKeyGPUResourceName] = string(gpuResourceName(dgdr))
+}
+
+// applyGPUResourceName moves the GPU counts of the generated DGD to the DGDR's GPU resource.
+// The gpu fields of a DynamoGraphDeployment always request nvidia.com/gpu, so other resources are
+// requested through the custom resources instead.
+func applyGPUResourceName(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, dgd *nvidiacomv1alpha1.DynamoGraphDeployment) {
+	if usesDefaultGPUResource(dgdr) {
+		return
+	}
+	name := string(gpuResourceName(dgdr))
+	for _, spec := range dgd.Spec.Services {
+		if spec == nil || spec.Resources == nil {
+			continue
+		}
+		for _, item := range []*dynamoCommon.ResourceItem{spec.Resources.Requests, spec.Resources.Limits} {
+			if item == nil || item.GPU == "" {
+				continue
+			}
+			if item.Custom == nil {
+				item.Custom = map[string]string{}
+			}
+			item.Custom[name] = item.GPU
+			item.GPU = ""
+		}
+	}
+}
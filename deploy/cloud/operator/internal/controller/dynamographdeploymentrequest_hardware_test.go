@@ -0,0 +1,156 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+
+	dynamoCommon "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/dynamo/common"
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/yaml"
+)
+
+var _ = Describe("DGDR Accelerator Vendors", func() {
+	var reconciler *DynamoGraphDeploymentRequestReconciler
+
+	BeforeEach(func() {
+		reconciler = &DynamoGraphDeploymentRequestReconciler{
+			Client:      k8sClient,
+			Recorder:    record.NewFakeRecorder(100),
+			RBACManager: &MockRBACManager{},
+		}
+	})
+
+	newDGDR := func(name, backend string, hardware *nvidiacomv1alpha1.HardwareSpec) *nvidiacomv1alpha1.DynamoGraphDeploymentRequest {
+		return &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model:    "Qwen/Qwen3-0.6B",
+				Backend:  backend,
+				Hardware: hardware,
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					ProfilerImage: "test-profiler:latest",
+					Config: createTestConfig(map[string]interface{}{
+						"sla":   map[string]interface{}{"ttft": 200.0, "itl": 20.0},
+						"sweep": map[string]interface{}{"use_ai_configurator": false},
+					}),
+				},
+			},
+		}
+	}
+	amd := &nvidiacomv1alpha1.HardwareSpec{Vendor: nvidiacomv1alpha1.AcceleratorVendorAMD}
+
+	It("Should default to NVIDIA GPUs", func() {
+		dgdr := newDGDR("test-dgdr-vendor-default", BackendTRTLLM, nil)
+		Expect(validateHardware(dgdr)).Should(Succeed())
+		Expect(gpuResourceName(dgdr)).Should(Equal(corev1.ResourceName("nvidia.com/gpu")))
+
+		dgdr.Spec.Hardware = &nvidiacomv1alpha1.HardwareSpec{GPUResourceName: "nvidia.com/mig-3g.40gb"}
+		Expect(validateHardware(dgdr)).Should(Succeed())
+		Expect(gpuResourceName(dgdr)).Should(Equal(corev1.ResourceName("nvidia.com/mig-3g.40gb")))
+	})
+
+	It("Should reject backends and profiling modes the vendor does not support", func() {
+		Expect(validateHardware(newDGDR("test-dgdr-vendor-vllm", BackendVLLM, amd))).Should(Succeed())
+		Expect(validateHardware(newDGDR("test-dgdr-vendor-trtllm", BackendTRTLLM, amd))).
+			To(MatchError("backend trtllm does not support amd GPUs"))
+
+		dgdr := newDGDR("test-dgdr-vendor-aic", BackendVLLM, amd)
+		dgdr.Spec.ProfilingConfig.Config = createTestConfig(map[string]interface{}{
+			"sla":   map[string]interface{}{"ttft": 200.0, "itl": 20.0},
+			"sweep": map[string]interface{}{"use_ai_configurator": true},
+		})
+		Expect(validateHardware(dgdr)).To(MatchError(ContainSubstring("spec.profilingMode aic is only supported for NVIDIA GPUs")))
+
+		dgdr = newDGDR("test-dgdr-vendor-name", BackendVLLM, &nvidiacomv1alpha1.HardwareSpec{GPUResourceName: "gpu"})
+		Expect(validateHardware(dgdr)).To(MatchError(ContainSubstring(`hardware.gpuResourceName "gpu"`)))
+	})
+
+	It("Should request the vendor's GPU resource in generated deployments", func() {
+		dgdr := newDGDR("test-dgdr-vendor-dgd", BackendVLLM, amd)
+		dgd := &nvidiacomv1alpha1.DynamoGraphDeployment{
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentSpec{
+				Services: map[string]*nvidiacomv1alpha1.DynamoComponentDeploymentSharedSpec{
+					"Frontend": {},
+					"VllmDecodeWorker": {Resources: &dynamoCommon.Resources{
+						Requests: &dynamoCommon.ResourceItem{GPU: "2"},
+						Limits:   &dynamoCommon.ResourceItem{GPU: "2", Memory: "64Gi"},
+					}},
+				},
+			},
+		}
+
+		applyGPUResourceName(dgdr, dgd)
+		resources := dgd.Spec.Services["VllmDecodeWorker"].Resources
+		Expect(resources.Requests).Should(Equal(&dynamoCommon.ResourceItem{Custom: map[string]string{"amd.com/gpu": "2"}}))
+		Expect(resources.Limits).Should(Equal(&dynamoCommon.ResourceItem{Memory: "64Gi", Custom: map[string]string{"amd.com/gpu": "2"}}))
+		Expect(dgd.Spec.Services["Frontend"].Resources).Should(BeNil())
+	})
+
+	It("Should tell the profiler which GPUs to request", func() {
+		ctx := context.Background()
+		sa := &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: ServiceAccountProfilingJob, Namespace: defaultNamespace}}
+		Expect(k8sClient.Create(ctx, sa)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, sa) }()
+
+		dgdr := newDGDR("test-dgdr-vendor-job", BackendVLLM, amd)
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, dgdr) }()
+
+		Expect(reconciler.createProfilingJob(ctx, dgdr)).Should(Succeed())
+		job := &batchv1.Job{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: GetProfilingJobName(dgdr), Namespace: defaultNamespace}, job)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, job) }()
+
+		var config map[string]interface{}
+		Expect(yaml.Unmarshal([]byte(job.Spec.Template.Spec.Containers[0].Args[1]), &config)).Should(Succeed())
+		Expect(config["hardware"]).Should(HaveKeyWithValue(ConfigKeyGPUVendor, "amd"))
+		Expect(config["hardware"]).Should(HaveKeyWithValue(ConfigKeyGPUResourceName, "amd.com/gpu"))
+	})
+
+	It("Should generate a deployment for AMD GPUs", func() {
+		ctx := context.Background()
+		reconciler.ProfilerMode = ProfilerModeMock
+		dgdr := newDGDR("test-dgdr-vendor-mock", BackendVLLM, amd)
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, dgdr) }()
+
+		request := reconcile.Request{NamespacedName: types.NamespacedName{Name: dgdr.Name, Namespace: defaultNamespace}}
+		for range 3 {
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		updated := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{}
+		Expect(k8sClient.Get(ctx, request.NamespacedName, updated)).Should(Succeed())
+		Expect(updated.Status.State).Should(Equal(StateReady))
+		dgd := &nvidiacomv1alpha1.DynamoGraphDeployment{}
+		Expect(yaml.Unmarshal(updated.Status.GeneratedDeployment.Raw, dgd)).Should(Succeed())
+		Expect(dgd.Spec.Services["VllmDecodeWorker"].Resources.Limits.Custom).Should(HaveKeyWithValue("amd.com/gpu", "1"))
+		Expect(dgd.Spec.Services["VllmDecodeWorker"].Resources.Limits.GPU).Should(BeEmpty())
+		_ = k8sClient.Delete(ctx, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: GetOutputConfigMapName(dgdr), Namespace: defaultNamespace}})
+	})
+})
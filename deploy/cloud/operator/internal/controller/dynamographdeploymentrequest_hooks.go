@@ -0,0 +1,454 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"strings"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/yaml"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	commonController "github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/controller_common"
+)
+
+const (
+	// LabelValueProfilingHook is the app label of the Jobs of profiling hooks
+	LabelValueProfilingHook = "dgdr-profiling-hook"
+
+	// ContainerNameHook names the container of hooks given as a container without a name
+	ContainerNameHook = "hook"
+
+	// DefaultHookTimeoutSeconds is how long a hook Job runs unless spec.hooks sets a timeout
+	DefaultHookTimeoutSeconds = int64(3600)
+
+	// Condition types
+	ConditionTypeProfilingHooks = "ProfilingHooks"
+
+	// Condition reasons
+	ReasonHookFailed = "HookFailed"
+
+	// Event reasons
+	EventReasonHookStarted   = "ProfilingHookStarted"
+	EventReasonHookSucceeded = "ProfilingHookSucceeded"
+	EventReasonHookFailed    = "ProfilingHookFailed"
+
+	// Messages
+	MessageHookStarted   = "%s hook %s started as Job %s"
+	MessageHookSucceeded = "%s hook %s succeeded"
+	MessageHookFailed    = "%s hook %s failed: %s"
+	MessageHookIgnored   = "%s hook %s failed and is ignored: %s"
+	MessageHookJobGone   = "hook Job %s was deleted before it finished"
+
+	// Validation messages
+	ValidationErrorHookSource     = "spec.hooks: exactly one of container or jobTemplate must be set on hook %s"
+	ValidationErrorHookInvalid    = "spec.hooks: invalid %s of hook %s: %v"
+	ValidationErrorHookNoImage    = "spec.hooks: container %s of hook %s has no image"
+	ValidationErrorHookPrivileged = "spec.hooks: hook %s must not set %s, its Job is created by the operator"
+)
+
+// profilingHookPhases are the phases hooks run in, in order
+var profilingHookPhases = []nvidiacomv1alpha1.ProfilingHookPhase{nvidiacomv1alpha1.ProfilingHookPhasePre, nvidiacomv1alpha1.ProfilingHookPhasePost}
+
+// hookFailedError reports a hook with failurePolicy Fail that failed
+type hookFailedError struct {
+	message string
+}
+
+func (e *hookFailedError) Error() string { return e.message }
+
+// profilingHooks returns the hooks of a phase
+func profilingHooks(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, phase nvidiacomv1alpha1.ProfilingHookPhase) []nvidiacomv1alpha1.ProfilingHook {
+	if dgdr.Spec.Hooks == nil {
+		return nil
+	}
+	if phase == nvidiacomv1alpha1.ProfilingHookPhasePre {
+		return dgdr.Spec.Hooks.PreProfiling
+	}
+	return dgdr.Spec.Hooks.PostProfiling
+}
+
+// findHookStatus returns the status of a hook of the current profiling run, nil if it did not start
+func findHookStatus(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, phase nvidiacomv1alpha1.ProfilingHookPhase, name string) *nvidiacomv1alpha1.ProfilingHookStatus {
+	for i := range dgdr.Status.Hooks {
+		if dgdr.Status.Hooks[i].Phase == phase && dgdr.Status.Hooks[i].Name == name {
+			return &dgdr.Status.Hooks[i]
+		}
+	}
+	return nil
+}
+
+// hooksStarted reports whether a hook of the phase started in the current profiling run
+func hooksStarted(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, phase nvidiacomv1alpha1.ProfilingHookPhase) bool {
+	for _, status := range dgdr.Status.Hooks {
+		if status.Phase == phase {
+			return true
+		}
+	}
+	return false
+}
+
+// hookJobName returns the name of the Job of a hook. Hooks run once per profiling attempt: pre
+// hooks belong to the attempt about to start, post hooks to the attempt that succeeded.
+func hookJobName(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, phase nvidiacomv1alpha1.ProfilingHookPhase, hook string) string {
+	attempt := int32(len(dgdr.Status.Attempts))
+	short := "post"
+	if phase == nvidiacomv1alpha1.ProfilingHookPhasePre {
+		short = "pre"
+		if currentProfilingAttempt(dgdr) == nil {
+			attempt++
+		}
+	}
+	suffix := fmt.Sprintf("-%s-%s-a%d", short, hook, max(attempt, 1))
+	name := "hook-" + dgdr.Name
+	if len(name)+len(suffix) > validation.DNS1123LabelMaxLength {
+		name = strings.TrimRight(name[:validation.DNS1123LabelMaxLength-len(suffix)], "-")
+	}
+	return name + suffix
+}
+
+// decodeHook returns the container or the Job template of a hook
+func decodeHook(hook *nvidiacomv1alpha1.ProfilingHook) (*corev1.Container, *batchv1.JobTemplateSpec, error) {
+	if (hook.Container == nil) == (hook.JobTemplate == nil) {
+		return nil, nil, fmt.Errorf(ValidationErrorHookSource, hook.Name)
+	}
+	if hook.Container != nil {
+		container := &corev1.Container{}
+		if err := yaml.UnmarshalStrict(hook.Container.Raw, container); err != nil {
+			return nil, nil, fmt.Errorf(ValidationErrorHookInvalid, "container", hook.Name, err)
+		}
+		if container.Name == "" {
+			container.Name = ContainerNameHook
+		}
+		if fields := privilegedContainerFields(container); len(fields) > 0 {
+			return nil, nil, fmt.Errorf(ValidationErrorHookPrivileged, hook.Name, strings.Join(fields, ", "))
+		}
+		return container, nil, nil
+	}
+	template := &batchv1.JobTemplateSpec{}
+	if err := yaml.UnmarshalStrict(hook.JobTemplate.Raw, template); err != nil {
+		return nil, nil, fmt.Errorf(ValidationErrorHookInvalid, "jobTemplate", hook.Name, err)
+	}
+	if len(template.Spec.Template.Spec.Containers) == 0 {
+		return nil, nil, fmt.Errorf(ValidationErrorHookInvalid, "jobTemplate", hook.Name, "no containers")
+	}
+	if fields := privilegedPodFields(&template.Spec.Template.Spec); len(fields) > 0 {
+		return nil, nil, fmt.Errorf(ValidationErrorHookPrivileged, hook.Name, strings.Join(fields, ", "))
+	}
+	return nil, template, nil
+}
+
+// privilegedPodFields returns the fields of a hook pod that would give it more than the permissions
+// of the DGDR's namespace: another service account, the host's namespaces, paths or ports, or a
+// privileged container
+func privilegedPodFields(spec *corev1.PodSpec) []string {
+	var fields []string
+	if spec.ServiceAccountName != "" || spec.DeprecatedServiceAccount != "" {
+		fields = append(fields, "serviceAccountName")
+	}
+	if spec.HostNetwork {
+		fields = append(fields, "hostNetwork")
+	}
+	if spec.HostPID {
+		fields = append(fields, "hostPID")
+	}
+	if spec.HostIPC {
+		fields = append(fields, "hostIPC")
+	}
+	for _, volume := range spec.Volumes {
+		if volume.HostPath != nil {
+			fields = append(fields, fmt.Sprintf("volume %s hostPath", volume.Name))
+		}
+	}
+	for _, c := range append(append([]corev1.Container{}, spec.InitContainers...), spec.Containers...) {
+		fields = append(fields, privilegedContainerFields(&c)...)
+	}
+	return fields
+}
+
+// privilegedContainerFields returns the fields of a hook container that reach into the host
+func privilegedContainerFields(c *corev1.Container) []string {
+	var fields []string
+	if c.SecurityContext != nil && ptr.Deref(c.SecurityContext.Privileged, false) {
+		fields = append(fields, fmt.Sprintf("container %s securityContext.privileged", c.Name))
+	}
+	for _, port := range c.Ports {
+		if port.HostPort != 0 {
+			fields = append(fields, fmt.Sprintf("container %s hostPort", c.Name))
+		}
+	}
+	return fields
+}
+
+// hookContainers returns the containers of a decoded hook
+func hookContainers(container *corev1.Container, template *batchv1.JobTemplateSpec) []corev1.Container {
+	if container != nil {
+		return []corev1.Container{*container}
+	}
+	podSpec := &template.Spec.Template.Spec
+	return append(append([]corev1.Container{}, podSpec.InitContainers...), podSpec.Containers...)
+}
+
+// validateHooks checks that every hook decodes and names the images it runs
+func validateHooks(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	for _, phase := range profilingHookPhases {
+		for i := range profilingHooks(dgdr, phase) {
+			hook := &profilingHooks(dgdr, phase)[i]
+			container, template, err := decodeHook(hook)
+			if err != nil {
+				return err
+			}
+			for _, c := range hookContainers(container, template) {
+				if c.Image == "" {
+					return fmt.Errorf(ValidationErrorHookNoImage, c.Name, hook.Name)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// hookImages returns the images the hooks of the DGDR run
+func hookImages(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) ([]string, error) {
+	var images []string
+	for _, phase := range profilingHookPhases {
+		for i := range profilingHooks(dgdr, phase) {
+			container, template, err := decodeHook(&profilingHooks(dgdr, phase)[i])
+			if err != nil {
+				return nil, err
+			}
+			for _, c := range hookContainers(container, template) {
+				images = append(images, c.Image)
+			}
+		}
+	}
+	return images, nil
+}
+
+// buildHookJob renders the Job of a hook. Hooks given as a container run it alone with the
+// profiling job's image pull secrets; Job templates, which decodeHook checked for privileged
+// fields, are taken as they are. Every container gets
+// the DGDR and the phase as environment variables.
+func (r *DynamoGraphDeploymentRequestReconciler) buildHookJob(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, phase nvidiacomv1alpha1.ProfilingHookPhase, hook *nvidiacomv1alpha1.ProfilingHook, jobName string) (*batchv1.Job, error) {
+	container, template, err := decodeHook(hook)
+	if err != nil {
+		return nil, err
+	}
+	if container != nil {
+		template = &batchv1.JobTemplateSpec{
+			Spec: batchv1.JobSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers:       []corev1.Container{*container},
+						ImagePullSecrets: profilingJobPullSecrets(),
+					},
+				},
+			},
+		}
+	}
+
+	labels := map[string]string{
+		LabelApp:       LabelValueProfilingHook,
+		LabelDGDR:      dgdr.Name,
+		LabelManagedBy: LabelValueDynamoOperator,
+	}
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        jobName,
+			Namespace:   dgdr.Namespace,
+			Labels:      maps.Clone(template.Labels),
+			Annotations: template.Annotations,
+		},
+		Spec: template.Spec,
+	}
+	if job.Labels == nil {
+		job.Labels = map[string]string{}
+	}
+	maps.Copy(job.Labels, labels)
+
+	podTemplate := &job.Spec.Template
+	if podTemplate.Labels == nil {
+		podTemplate.Labels = map[string]string{}
+	}
+	maps.Copy(podTemplate.Labels, labels)
+	if podTemplate.Spec.RestartPolicy == "" {
+		podTemplate.Spec.RestartPolicy = corev1.RestartPolicyNever
+	}
+	for key, value := range r.jobPodAnnotations() {
+		if podTemplate.Annotations == nil {
+			podTemplate.Annotations = map[string]string{}
+		}
+		podTemplate.Annotations[key] = value
+	}
+	if job.Spec.ActiveDeadlineSeconds == nil {
+		job.Spec.ActiveDeadlineSeconds = ptr.To(DefaultHookTimeoutSeconds)
+		if hook.TimeoutSeconds != nil {
+			job.Spec.ActiveDeadlineSeconds = ptr.To(*hook.TimeoutSeconds)
+		}
+	}
+
+	env := []corev1.EnvVar{
+		{Name: "DGDR_NAME", Value: dgdr.Name},
+		{Name: "DGDR_NAMESPACE", Value: dgdr.Namespace},
+		{Name: "DGDR_HOOK_PHASE", Value: string(phase)},
+	}
+	for _, containers := range [][]corev1.Container{podTemplate.Spec.InitContainers, podTemplate.Spec.Containers} {
+		for i := range containers {
+			containers[i].Env = mergeEnv(containers[i].Env, env)
+		}
+	}
+
+	r.applyJobPodSecurity(&podTemplate.Spec)
+	return job, nil
+}
+
+// mergeEnv appends the variables of extra that env does not set
+func mergeEnv(env, extra []corev1.EnvVar) []corev1.EnvVar {
+	for _, variable := range extra {
+		set := false
+		for _, existing := range env {
+			if existing.Name == variable.Name {
+				set = true
+				break
+			}
+		}
+		if !set {
+			env = append(env, variable)
+		}
+	}
+	return env
+}
+
+// runProfilingHooks runs the hooks of a phase one after the other and reports whether they all
+// finished. It only updates the status in memory. A failed hook with failurePolicy Fail is
+// returned as a hookFailedError.
+func (r *DynamoGraphDeploymentRequestReconciler) runProfilingHooks(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, phase nvidiacomv1alpha1.ProfilingHookPhase) (bool, error) {
+	logger := log.FromContext(ctx)
+	hooks := profilingHooks(dgdr, phase)
+	for i := range hooks {
+		hook := &hooks[i]
+		status := findHookStatus(dgdr, phase, hook.Name)
+		if status == nil {
+			jobName := hookJobName(dgdr, phase, hook.Name)
+			_, job, err := commonController.SyncResource(ctx, r, dgdr, func(ctx context.Context) (*batchv1.Job, bool, error) {
+				job, err := r.buildHookJob(dgdr, phase, hook, jobName)
+				return job, false, err
+			}, commonController.WithManagedMetadata())
+			if err != nil {
+				return false, fmt.Errorf("failed to create the Job of hook %s: %w", hook.Name, err)
+			}
+			r.trackOwnedResource(ctx, dgdr, nvidiacomv1alpha1.OwnedResourceRoleHookJob, job)
+			logger.Info("Started profiling hook", "phase", phase, "hook", hook.Name, "job", jobName)
+			r.Recorder.Event(dgdr, corev1.EventTypeNormal, EventReasonHookStarted, fmt.Sprintf(MessageHookStarted, phase, hook.Name, jobName))
+			dgdr.Status.Hooks = append(dgdr.Status.Hooks, nvidiacomv1alpha1.ProfilingHookStatus{
+				Name:      hook.Name,
+				Phase:     phase,
+				JobName:   jobName,
+				State:     nvidiacomv1alpha1.ProfilingHookRunning,
+				StartTime: metav1.Now(),
+			})
+			return false, nil
+		}
+
+		if status.State == nvidiacomv1alpha1.ProfilingHookRunning {
+			finished, err := r.observeHookJob(ctx, dgdr, status)
+			if err != nil || !finished {
+				return false, err
+			}
+		}
+		if status.State != nvidiacomv1alpha1.ProfilingHookFailed {
+			continue
+		}
+		if hook.FailurePolicy == nvidiacomv1alpha1.ProfilingHookFailurePolicyIgnore {
+			setWarning(dgdr, WarningHookFailed, fmt.Sprintf(MessageHookIgnored, phase, hook.Name, status.Message))
+			continue
+		}
+		return false, &hookFailedError{message: fmt.Sprintf(MessageHookFailed, phase, hook.Name, status.Message)}
+	}
+	return true, nil
+}
+
+// observeHookJob records the outcome of a running hook once its Job finished
+func (r *DynamoGraphDeploymentRequestReconciler) observeHookJob(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, status *nvidiacomv1alpha1.ProfilingHookStatus) (bool, error) {
+	job := &batchv1.Job{}
+	err := r.Get(ctx, types.NamespacedName{Name: status.JobName, Namespace: dgdr.Namespace}, job)
+	switch {
+	case apierrors.IsNotFound(err):
+		untrackOwnedResource(dgdr, "Job", dgdr.Namespace, status.JobName)
+		status.State = nvidiacomv1alpha1.ProfilingHookFailed
+		status.Message = fmt.Sprintf(MessageHookJobGone, status.JobName)
+	case err != nil:
+		return false, err
+	default:
+		for _, condition := range job.Status.Conditions {
+			if condition.Status != corev1.ConditionTrue {
+				continue
+			}
+			switch condition.Type {
+			case batchv1.JobComplete:
+				status.State = nvidiacomv1alpha1.ProfilingHookSucceeded
+			case batchv1.JobFailed:
+				status.State = nvidiacomv1alpha1.ProfilingHookFailed
+				status.Message = condition.Message
+			}
+		}
+	}
+
+	switch status.State {
+	case nvidiacomv1alpha1.ProfilingHookSucceeded:
+		r.Recorder.Event(dgdr, corev1.EventTypeNormal, EventReasonHookSucceeded, fmt.Sprintf(MessageHookSucceeded, status.Phase, status.Name))
+	case nvidiacomv1alpha1.ProfilingHookFailed:
+		r.Recorder.Event(dgdr, corev1.EventTypeWarning, EventReasonHookFailed, fmt.Sprintf(MessageHookFailed, status.Phase, status.Name, status.Message))
+	default:
+		return false, nil
+	}
+	status.CompletionTime = ptr.To(metav1.Now())
+	return true, nil
+}
+
+// handleProfilingHooks runs the hooks of a phase. It returns a result for the caller to return
+// while hooks are running or when one failed the DGDR, and nil once they all finished.
+func (r *DynamoGraphDeploymentRequestReconciler) handleProfilingHooks(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, phase nvidiacomv1alpha1.ProfilingHookPhase) (*ctrl.Result, error) {
+	done, err := r.runProfilingHooks(ctx, dgdr, phase)
+	var hookErr *hookFailedError
+	if errors.As(err, &hookErr) {
+		result, err := r.updateStateToFailed(ctx, dgdr, nvidiacomv1alpha1.FailureReasonHookFailed,
+			ConditionTypeProfilingHooks, ReasonHookFailed, redactMessage(dgdr, hookErr.Error()))
+		return &result, err
+	}
+	if err != nil {
+		return &ctrl.Result{}, err
+	}
+	if !done {
+		// Hook Jobs are owned by the DGDR, their completion triggers the next reconcile
+		return &ctrl.Result{}, r.updateStatus(ctx, dgdr)
+	}
+	return nil, nil
+}
@@ -0,0 +1,273 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var _ = Describe("DGDR Profiling Hooks", func() {
+	ctx := context.Background()
+	var reconciler *DynamoGraphDeploymentRequestReconciler
+
+	BeforeEach(func() {
+		reconciler = &DynamoGraphDeploymentRequestReconciler{
+			Client:       k8sClient,
+			Recorder:     record.NewFakeRecorder(100),
+			RBACManager:  &MockRBACManager{},
+			ProfilerMode: ProfilerModeMock,
+		}
+	})
+
+	containerHook := func(name string, policy nvidiacomv1alpha1.ProfilingHookFailurePolicy) nvidiacomv1alpha1.ProfilingHook {
+		return nvidiacomv1alpha1.ProfilingHook{
+			Name:          name,
+			Container:     &runtime.RawExtension{Raw: []byte(`{"image":"busybox:1.36","command":["true"]}`)},
+			FailurePolicy: policy,
+		}
+	}
+
+	// setup creates a DGDR profiled by the mock profiler with the given hooks
+	setup := func(name string, hooks *nvidiacomv1alpha1.ProfilingHooksSpec) *nvidiacomv1alpha1.DynamoGraphDeploymentRequest {
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model:   "Qwen/Qwen3-0.6B",
+				Backend: BackendVLLM,
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					ProfilerImage: "test-profiler:latest",
+					Config: createTestConfig(map[string]interface{}{
+						"sla":   map[string]interface{}{"ttft": 200.0, "itl": 20.0},
+						"sweep": map[string]interface{}{"use_ai_configurator": true},
+					}),
+				},
+				Hooks: hooks,
+			},
+		}
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		DeferCleanup(func() {
+			_ = k8sClient.DeleteAllOf(ctx, &batchv1.Job{}, client.InNamespace(defaultNamespace),
+				client.MatchingLabels{LabelDGDR: name}, client.PropagationPolicy(metav1.DeletePropagationBackground))
+			_ = k8sClient.Delete(ctx, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: GetOutputConfigMapName(dgdr), Namespace: defaultNamespace}})
+			_ = k8sClient.Delete(ctx, dgdr)
+		})
+		return dgdr
+	}
+
+	reconcileDGDR := func(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) *nvidiacomv1alpha1.DynamoGraphDeploymentRequest {
+		request := reconcile.Request{NamespacedName: types.NamespacedName{Name: dgdr.Name, Namespace: dgdr.Namespace}}
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		updated := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{}
+		Expect(k8sClient.Get(ctx, request.NamespacedName, updated)).Should(Succeed())
+		return updated
+	}
+
+	// finishJob marks a hook Job as complete or failed
+	finishJob := func(name string, succeeded bool) {
+		job := &batchv1.Job{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: name, Namespace: defaultNamespace}, job)).Should(Succeed())
+		start := metav1.NewTime(time.Now().Add(-time.Minute).Truncate(time.Second))
+		end := metav1.NewTime(start.Add(30 * time.Second))
+		job.Status.StartTime = &start
+		if succeeded {
+			job.Status.CompletionTime = &end
+			job.Status.Succeeded = 1
+			job.Status.Conditions = []batchv1.JobCondition{
+				{Type: batchv1.JobSuccessCriteriaMet, Status: corev1.ConditionTrue},
+				{Type: batchv1.JobComplete, Status: corev1.ConditionTrue},
+			}
+		} else {
+			job.Status.Failed = 1
+			job.Status.Conditions = []batchv1.JobCondition{
+				{Type: batchv1.JobFailureTarget, Status: corev1.ConditionTrue, Reason: "BackoffLimitExceeded"},
+				{Type: batchv1.JobFailed, Status: corev1.ConditionTrue, Reason: "BackoffLimitExceeded", Message: "Job has reached the specified backoff limit"},
+			}
+		}
+		Expect(k8sClient.Status().Update(ctx, job)).Should(Succeed())
+	}
+
+	It("Should run the hooks one after the other around profiling", func() {
+		dgdr := setup("test-dgdr-hooks", &nvidiacomv1alpha1.ProfilingHooksSpec{
+			PreProfiling: []nvidiacomv1alpha1.ProfilingHook{containerHook("warm-cache", "")},
+			PostProfiling: []nvidiacomv1alpha1.ProfilingHook{{
+				Name: "notify",
+				JobTemplate: &runtime.RawExtension{Raw: []byte(`{"metadata":{"labels":{"team":"capacity"}},` +
+					`"spec":{"activeDeadlineSeconds":60,"template":{"spec":{"volumes":[{"name":"scratch","emptyDir":{}}],` +
+					`"containers":[{"name":"notify","image":"curlimages/curl:8.8.0","env":[{"name":"DGDR_NAME","value":"custom"}]}]}}}}`)},
+			}},
+		})
+
+		Expect(reconcileDGDR(dgdr).Status.State).Should(Equal(StatePending))
+		updated := reconcileDGDR(dgdr)
+		Expect(updated.Status.State).Should(Equal(StatePending))
+		Expect(updated.Status.Hooks).Should(HaveLen(1))
+		pre := updated.Status.Hooks[0]
+		Expect(pre.Phase).Should(Equal(nvidiacomv1alpha1.ProfilingHookPhasePre))
+		Expect(pre.State).Should(Equal(nvidiacomv1alpha1.ProfilingHookRunning))
+		Expect(pre.JobName).Should(Equal("hook-test-dgdr-hooks-pre-warm-cache-a1"))
+
+		job := &batchv1.Job{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: pre.JobName, Namespace: defaultNamespace}, job)).Should(Succeed())
+		Expect(metav1.IsControlledBy(job, updated)).To(BeTrue())
+		Expect(job.Labels).Should(HaveKeyWithValue(LabelApp, LabelValueProfilingHook))
+		Expect(*job.Spec.ActiveDeadlineSeconds).Should(Equal(DefaultHookTimeoutSeconds))
+		podSpec := job.Spec.Template.Spec
+		Expect(podSpec.RestartPolicy).Should(Equal(corev1.RestartPolicyNever))
+		Expect(podSpec.Containers).Should(HaveLen(1))
+		Expect(podSpec.Containers[0].Name).Should(Equal(ContainerNameHook))
+		Expect(podSpec.Containers[0].Env).Should(ContainElements(
+			corev1.EnvVar{Name: "DGDR_NAME", Value: dgdr.Name},
+			corev1.EnvVar{Name: "DGDR_HOOK_PHASE", Value: string(nvidiacomv1alpha1.ProfilingHookPhasePre)},
+		))
+
+		// Profiling waits for the hook
+		Expect(reconcileDGDR(dgdr).Status.State).Should(Equal(StatePending))
+		finishJob(pre.JobName, true)
+		updated = reconcileDGDR(dgdr)
+		Expect(updated.Status.State).Should(Equal(StateProfiling))
+		Expect(updated.Status.Hooks[0].State).Should(Equal(nvidiacomv1alpha1.ProfilingHookSucceeded))
+		Expect(updated.Status.Hooks[0].CompletionTime).NotTo(BeNil())
+
+		// The spec is generated once the post hook finished
+		updated = reconcileDGDR(dgdr)
+		Expect(updated.Status.State).Should(Equal(StateProfiling))
+		Expect(updated.Status.GeneratedDeployment).To(BeNil())
+		Expect(updated.Status.Hooks).Should(HaveLen(2))
+		post := updated.Status.Hooks[1]
+		Expect(post.Phase).Should(Equal(nvidiacomv1alpha1.ProfilingHookPhasePost))
+		Expect(meta.IsStatusConditionTrue(updated.Status.Conditions, ConditionTypeProfiling)).To(BeTrue())
+
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: post.JobName, Namespace: defaultNamespace}, job)).Should(Succeed())
+		Expect(job.Labels).Should(HaveKeyWithValue("team", "capacity"))
+		Expect(*job.Spec.ActiveDeadlineSeconds).Should(Equal(int64(60)))
+		Expect(job.Spec.Template.Spec.Volumes).Should(ConsistOf(HaveField("Name", "scratch")))
+		Expect(job.Spec.Template.Spec.Containers[0].Env).Should(ContainElement(corev1.EnvVar{Name: "DGDR_NAME", Value: "custom"}))
+		Expect(job.Spec.Template.Spec.Containers[0].Env).Should(ContainElement(
+			corev1.EnvVar{Name: "DGDR_HOOK_PHASE", Value: string(nvidiacomv1alpha1.ProfilingHookPhasePost)}))
+
+		Expect(reconcileDGDR(dgdr).Status.State).Should(Equal(StateProfiling))
+		finishJob(post.JobName, true)
+		updated = reconcileDGDR(dgdr)
+		Expect(updated.Status.State).Should(Equal(StateReady))
+		Expect(updated.Status.GeneratedDeployment).NotTo(BeNil())
+		Expect(updated.Status.Hooks[1].State).Should(Equal(nvidiacomv1alpha1.ProfilingHookSucceeded))
+	})
+
+	It("Should fail the DGDR when a hook with failurePolicy Fail fails", func() {
+		dgdr := setup("test-dgdr-hooks-fail", &nvidiacomv1alpha1.ProfilingHooksSpec{
+			PreProfiling: []nvidiacomv1alpha1.ProfilingHook{
+				containerHook("first", nvidiacomv1alpha1.ProfilingHookFailurePolicyFail),
+				containerHook("second", ""),
+			},
+		})
+		reconcileDGDR(dgdr)
+		updated := reconcileDGDR(dgdr)
+		finishJob(updated.Status.Hooks[0].JobName, false)
+
+		updated = reconcileDGDR(dgdr)
+		Expect(updated.Status.State).Should(Equal(StateFailed))
+		Expect(updated.Status.FailureReason).Should(Equal(nvidiacomv1alpha1.FailureReasonHookFailed))
+		Expect(updated.Status.Hooks).Should(HaveLen(1))
+		Expect(updated.Status.Hooks[0].State).Should(Equal(nvidiacomv1alpha1.ProfilingHookFailed))
+		condition := meta.FindStatusCondition(updated.Status.Conditions, ConditionTypeProfilingHooks)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Reason).Should(Equal(ReasonHookFailed))
+		Expect(condition.Message).Should(ContainSubstring("backoff limit"))
+	})
+
+	It("Should go on with a warning when a hook with failurePolicy Ignore fails", func() {
+		dgdr := setup("test-dgdr-hooks-ignore", &nvidiacomv1alpha1.ProfilingHooksSpec{
+			PostProfiling: []nvidiacomv1alpha1.ProfilingHook{containerHook("notify", nvidiacomv1alpha1.ProfilingHookFailurePolicyIgnore)},
+		})
+		reconcileDGDR(dgdr)
+		Expect(reconcileDGDR(dgdr).Status.State).Should(Equal(StateProfiling))
+		updated := reconcileDGDR(dgdr)
+		Expect(updated.Status.Hooks).Should(HaveLen(1))
+		Expect(updated.Status.Hooks[0].JobName).Should(Equal("hook-test-dgdr-hooks-ignore-post-notify-a1"))
+		finishJob(updated.Status.Hooks[0].JobName, false)
+
+		updated = reconcileDGDR(dgdr)
+		Expect(updated.Status.State).Should(Equal(StateReady))
+		Expect(updated.Status.Hooks[0].State).Should(Equal(nvidiacomv1alpha1.ProfilingHookFailed))
+		Expect(updated.Status.Warnings).Should(ContainElement(HaveField("Type", WarningHookFailed)))
+	})
+
+	It("Should reject hooks that cannot be run", func() {
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-dgdr-hooks-invalid", Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Hooks: &nvidiacomv1alpha1.ProfilingHooksSpec{},
+			},
+		}
+		Expect(validateHooks(dgdr)).Should(Succeed())
+
+		dgdr.Spec.Hooks.PreProfiling = []nvidiacomv1alpha1.ProfilingHook{{Name: "empty"}}
+		Expect(validateHooks(dgdr)).Should(MatchError(ContainSubstring("exactly one of container or jobTemplate")))
+
+		dgdr.Spec.Hooks.PreProfiling = []nvidiacomv1alpha1.ProfilingHook{{
+			Name:      "typo",
+			Container: &runtime.RawExtension{Raw: []byte(`{"image":"busybox","comand":["true"]}`)},
+		}}
+		Expect(validateHooks(dgdr)).Should(MatchError(ContainSubstring("unknown field")))
+
+		dgdr.Spec.Hooks.PreProfiling = nil
+		dgdr.Spec.Hooks.PostProfiling = []nvidiacomv1alpha1.ProfilingHook{{
+			Name:        "noimage",
+			JobTemplate: &runtime.RawExtension{Raw: []byte(`{"spec":{"template":{"spec":{"containers":[{"name":"notify"}]}}}}`)},
+		}}
+		Expect(validateHooks(dgdr)).Should(MatchError(ContainSubstring("has no image")))
+
+		// Hook Jobs are created by the operator, so they get no more than the namespace allows
+		for template, field := range map[string]string{
+			`{"serviceAccountName":"admin","containers":[{"name":"c","image":"busybox"}]}`:                                                                   "serviceAccountName",
+			`{"hostNetwork":true,"containers":[{"name":"c","image":"busybox"}]}`:                                                                             "hostNetwork",
+			`{"hostPID":true,"containers":[{"name":"c","image":"busybox"}]}`:                                                                                 "hostPID",
+			`{"hostIPC":true,"containers":[{"name":"c","image":"busybox"}]}`:                                                                                 "hostIPC",
+			`{"volumes":[{"name":"root","hostPath":{"path":"/"}}],"containers":[{"name":"c","image":"busybox"}]}`:                                            "volume root hostPath",
+			`{"containers":[{"name":"c","image":"busybox","securityContext":{"privileged":true}}]}`:                                                          "container c securityContext.privileged",
+			`{"initContainers":[{"name":"i","image":"busybox","ports":[{"containerPort":80,"hostPort":80}]}],"containers":[{"name":"c","image":"busybox"}]}`: "container i hostPort",
+		} {
+			dgdr.Spec.Hooks.PostProfiling = []nvidiacomv1alpha1.ProfilingHook{{
+				Name:        "privileged",
+				JobTemplate: &runtime.RawExtension{Raw: []byte(`{"spec":{"template":{"spec":` + template + `}}}`)},
+			}}
+			Expect(validateHooks(dgdr)).Should(MatchError(fmt.Sprintf(ValidationErrorHookPrivileged, "privileged", field)), template)
+		}
+		dgdr.Spec.Hooks.PostProfiling = []nvidiacomv1alpha1.ProfilingHook{{
+			Name:      "privileged",
+			Container: &runtime.RawExtension{Raw: []byte(`{"image":"busybox","securityContext":{"privileged":true}}`)},
+		}}
+		Expect(validateHooks(dgdr)).Should(MatchError(ContainSubstring("container hook securityContext.privileged")))
+	})
+})
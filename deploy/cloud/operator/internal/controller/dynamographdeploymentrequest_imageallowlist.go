@@ -0,0 +1,263 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/registry"
+)
+
+// ImageAllowlist restricts the registries the profiling and runtime images of DGDRs may come from,
+// per tenant namespace
+type ImageAllowlist struct {
+	// Tenants map namespaces to the registries their DGDRs may use. A namespace matched by
+	// several tenants may use the registries of all of them.
+	Tenants []ImageAllowlistTenant `json:"tenants"`
+
+	// DefaultRegistries apply to namespaces no tenant matches. Such namespaces are unrestricted
+	// when empty.
+	DefaultRegistries []string `json:"defaultRegistries,omitempty"`
+}
+
+// ImageAllowlistTenant is the registry allowlist of a set of namespaces
+type ImageAllowlistTenant struct {
+	// Namespaces lists the namespaces of the tenant by name
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// NamespaceSelector selects the namespaces of the tenant by label
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// Registries are the registry hosts or repository prefixes images may come from,
+	// e.g. "nvcr.io/nvidia/ai-dynamo" or "registry.team-a.internal"
+	Registries []string `json:"registries"`
+
+	selector labels.Selector
+}
+
+// LoadImageAllowlist reads an image allowlist from a YAML file
+func LoadImageAllowlist(path string) (*ImageAllowlist, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read image allowlist: %w", err)
+	}
+	allowlist := &ImageAllowlist{}
+	if err := yaml.UnmarshalStrict(data, allowlist); err != nil {
+		return nil, fmt.Errorf("failed to parse image allowlist %s: %w", path, err)
+	}
+	for i := range allowlist.Tenants {
+		tenant := &allowlist.Tenants[i]
+		if len(tenant.Namespaces) == 0 && tenant.NamespaceSelector == nil {
+			return nil, fmt.Errorf("image allowlist tenant %d selects no namespaces", i)
+		}
+		if len(tenant.Registries) == 0 {
+			return nil, fmt.Errorf("image allowlist tenant %d allows no registries", i)
+		}
+		if tenant.NamespaceSelector != nil {
+			if tenant.selector, err = metav1.LabelSelectorAsSelector(tenant.NamespaceSelector); err != nil {
+				return nil, fmt.Errorf("invalid namespaceSelector of image allowlist tenant %d: %w", i, err)
+			}
+		}
+	}
+	return allowlist, nil
+}
+
+// AllowedRegistries returns the registries DGDRs in the namespace may use, false if they may use any
+func (a *ImageAllowlist) AllowedRegistries(ctx context.Context, reader client.Reader, namespace string) ([]string, bool, error) {
+	if a == nil {
+		return nil, false, nil
+	}
+	var namespaceLabels labels.Set
+	var registries []string
+	matched := false
+	for _, tenant := range a.Tenants {
+		selected := false
+		for _, name := range tenant.Namespaces {
+			selected = selected || name == namespace
+		}
+		if !selected && tenant.selector != nil {
+			if namespaceLabels == nil {
+				ns := &corev1.Namespace{}
+				if err := reader.Get(ctx, types.NamespacedName{Name: namespace}, ns); err != nil {
+					return nil, false, fmt.Errorf("failed to get namespace %s for the image allowlist: %w", namespace, err)
+				}
+				namespaceLabels = labels.Set(ns.Labels)
+			}
+			selected = tenant.selector.Matches(namespaceLabels)
+		}
+		if selected {
+			matched = true
+			registries = append(registries, tenant.Registries...)
+		}
+	}
+	if !matched {
+		return a.DefaultRegistries, len(a.DefaultRegistries) > 0, nil
+	}
+	return registries, true, nil
+}
+
+// CheckImages returns an error naming the images that DGDRs in the namespace may not use
+func (a *ImageAllowlist) CheckImages(ctx context.Context, reader client.Reader, namespace string, images []string) error {
+	registries, restricted, err := a.AllowedRegistries(ctx, reader, namespace)
+	if err != nil || !restricted {
+		return err
+	}
+	var denied []string
+	for _, image := range images {
+		if !imageAllowed(image, registries) {
+			denied = append(denied, image)
+		}
+	}
+	if len(denied) == 0 {
+		return nil
+	}
+	return &imageNotAllowedError{namespace: namespace, images: denied, registries: registries}
+}
+
+// imageNotAllowedError reports the images outside the allowlist of a namespace
+type imageNotAllowedError struct {
+	namespace  string
+	images     []string
+	registries []string
+}
+
+func (e *imageNotAllowedError) Error() string {
+	return fmt.Sprintf("images %s are not allowed in namespace %s, allowed registries: %s",
+		strings.Join(e.images, ", "), e.namespace, strings.Join(e.registries, ", "))
+}
+
+// imageAllowed reports whether the image comes from one of the registries, which are registry
+// hosts or repository prefixes matched on path boundaries
+func imageAllowed(image string, registries []string) bool {
+	ref, err := registry.ParseReference(image)
+	if err != nil {
+		return false
+	}
+	name := ref.Registry + "/" + ref.Repository
+	for _, allowed := range registries {
+		allowed = strings.TrimSuffix(allowed, "/")
+		if name == allowed || strings.HasPrefix(name, allowed+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// DGDRSpecImages returns the images the DGDR spec references directly: the profiler image, the
+// workers image override, the images of an inline precomputed deployment and those of the hooks
+func DGDRSpecImages(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) ([]string, error) {
+	images := []string{}
+	if dgdr.Spec.ProfilingConfig.ProfilerImage != "" {
+		images = append(images, dgdr.Spec.ProfilingConfig.ProfilerImage)
+	}
+	if dgdr.Spec.DeploymentOverrides != nil && dgdr.Spec.DeploymentOverrides.WorkersImage != "" {
+		images = append(images, dgdr.Spec.DeploymentOverrides.WorkersImage)
+	}
+	for _, service := range sortedServiceOverrides(dgdr) {
+		if image := dgdr.Spec.DeploymentOverrides.Services[service].Image; image != "" {
+			images = append(images, image)
+		}
+	}
+	if precomputed := dgdr.Spec.PrecomputedDeployment; precomputed != nil && precomputed.Deployment != nil {
+		dgd := &nvidiacomv1alpha1.DynamoGraphDeployment{}
+		if err := yaml.Unmarshal(precomputed.Deployment.Raw, dgd); err != nil {
+			return nil, fmt.Errorf("failed to parse precomputedDeployment.deployment: %w", err)
+		}
+		images = append(images, deploymentImages(dgd)...)
+	}
+	hooks, err := hookImages(dgdr)
+	if err != nil {
+		return nil, err
+	}
+	images = append(images, hooks...)
+	return images, nil
+}
+
+// deploymentImages returns the distinct container images of a DGD in a stable order
+func deploymentImages(dgd *nvidiacomv1alpha1.DynamoGraphDeployment) []string {
+	seen := map[string]bool{}
+	for _, spec := range dgd.Spec.Services {
+		if spec == nil || spec.ExtraPodSpec == nil {
+			continue
+		}
+		if spec.ExtraPodSpec.MainContainer != nil {
+			seen[spec.ExtraPodSpec.MainContainer.Image] = true
+		}
+		if podSpec := spec.ExtraPodSpec.PodSpec; podSpec != nil {
+			for _, container := range podSpec.InitContainers {
+				seen[container.Image] = true
+			}
+			for _, container := range podSpec.Containers {
+				seen[container.Image] = true
+			}
+		}
+	}
+	delete(seen, "")
+	images := make([]string, 0, len(seen))
+	for image := range seen {
+		images = append(images, image)
+	}
+	sort.Strings(images)
+	return images
+}
+
+// validateImageAllowlist checks the images referenced by the DGDR spec against the allowlist
+func (r *DynamoGraphDeploymentRequestReconciler) validateImageAllowlist(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	if r.ImageAllowlist == nil {
+		return nil
+	}
+	images, err := DGDRSpecImages(dgdr)
+	if err != nil {
+		return err
+	}
+	return r.checkAllowedImages(ctx, dgdr, images)
+}
+
+// validateDeploymentImages checks the images of the generated deployment against the allowlist
+func (r *DynamoGraphDeploymentRequestReconciler) validateDeploymentImages(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, dgd *nvidiacomv1alpha1.DynamoGraphDeployment) error {
+	if r.ImageAllowlist == nil {
+		return nil
+	}
+	return r.checkAllowedImages(ctx, dgdr, deploymentImages(dgd))
+}
+
+func (r *DynamoGraphDeploymentRequestReconciler) checkAllowedImages(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, images []string) error {
+	err := r.ImageAllowlist.CheckImages(ctx, r.Client, dgdr.Namespace, images)
+	if err == nil {
+		return nil
+	}
+	// Failures to look up the namespace are retried, disallowed images are not
+	var denied *imageNotAllowedError
+	if errors.As(err, &denied) {
+		return withFailureReason(nvidiacomv1alpha1.FailureReasonImageNotAllowed, err)
+	}
+	return err
+}
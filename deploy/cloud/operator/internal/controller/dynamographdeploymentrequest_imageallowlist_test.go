@@ -0,0 +1,157 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+
+	dynamoCommon "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/dynamo/common"
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+var _ = Describe("DGDR Image Allowlist", func() {
+	var reconciler *DynamoGraphDeploymentRequestReconciler
+
+	loadAllowlist := func(content string) *ImageAllowlist {
+		path := filepath.Join(GinkgoT().TempDir(), "allowlist.yaml")
+		Expect(os.WriteFile(path, []byte(content), 0o600)).Should(Succeed())
+		allowlist, err := LoadImageAllowlist(path)
+		Expect(err).NotTo(HaveOccurred())
+		return allowlist
+	}
+
+	BeforeEach(func() {
+		reconciler = &DynamoGraphDeploymentRequestReconciler{
+			Client:      k8sClient,
+			Recorder:    record.NewFakeRecorder(100),
+			RBACManager: &MockRBACManager{},
+			ImageAllowlist: loadAllowlist(`
+tenants:
+- namespaces: [default]
+  registries: ["nvcr.io/nvidia/ai-dynamo", "registry.team-a.internal"]
+- namespaceSelector:
+    matchLabels:
+      dynamo.nvidia.com/tenant: research
+  registries: ["docker.io/library"]
+defaultRegistries: ["nvcr.io"]
+`),
+		}
+	})
+
+	It("Should match registries on path boundaries", func() {
+		registries := []string{"nvcr.io/nvidia/ai-dynamo", "registry.team-a.internal", "docker.io/library"}
+		Expect(imageAllowed("nvcr.io/nvidia/ai-dynamo/vllm-runtime:0.6.0", registries)).To(BeTrue())
+		Expect(imageAllowed("registry.team-a.internal/profiler@sha256:"+strings.Repeat("a", 64), registries)).To(BeTrue())
+		Expect(imageAllowed("busybox:1.36", registries)).To(BeTrue())
+		Expect(imageAllowed("nvcr.io/nvidia/ai-dynamo-fork/vllm-runtime:0.6.0", registries)).To(BeFalse())
+		Expect(imageAllowed("registry.team-a.internal.evil.io/profiler:1", registries)).To(BeFalse())
+		Expect(imageAllowed("someone/vllm:latest", registries)).To(BeFalse())
+	})
+
+	It("Should select tenants by namespace name and labels", func() {
+		ctx := context.Background()
+		namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+			Name:   "test-image-allowlist-research",
+			Labels: map[string]string{"dynamo.nvidia.com/tenant": "research"},
+		}}
+		Expect(k8sClient.Create(ctx, namespace)).Should(Succeed())
+		DeferCleanup(func() { _ = k8sClient.Delete(ctx, namespace) })
+		unlabeled := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "test-image-allowlist-other"}}
+		Expect(k8sClient.Create(ctx, unlabeled)).Should(Succeed())
+		DeferCleanup(func() { _ = k8sClient.Delete(ctx, unlabeled) })
+
+		registries, restricted, err := reconciler.ImageAllowlist.AllowedRegistries(ctx, k8sClient, defaultNamespace)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(restricted).To(BeTrue())
+		Expect(registries).Should(Equal([]string{"nvcr.io/nvidia/ai-dynamo", "registry.team-a.internal"}))
+
+		registries, _, err = reconciler.ImageAllowlist.AllowedRegistries(ctx, k8sClient, namespace.Name)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(registries).Should(Equal([]string{"docker.io/library"}))
+
+		registries, _, err = reconciler.ImageAllowlist.AllowedRegistries(ctx, k8sClient, unlabeled.Name)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(registries).Should(Equal([]string{"nvcr.io"}))
+
+		_, restricted, err = (&ImageAllowlist{}).AllowedRegistries(ctx, k8sClient, unlabeled.Name)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(restricted).To(BeFalse())
+	})
+
+	It("Should fail DGDRs whose images are not allowed", func() {
+		ctx := context.Background()
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-dgdr-image-allowlist", Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model:   "Qwen/Qwen3-0.6B",
+				Backend: BackendVLLM,
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					ProfilerImage: "nvcr.io/nvidia/ai-dynamo/vllm-runtime:0.6.0",
+					Config: createTestConfig(map[string]interface{}{
+						"sla":   map[string]interface{}{"ttft": 200.0, "itl": 20.0},
+						"sweep": map[string]interface{}{"use_ai_configurator": true},
+					}),
+				},
+				DeploymentOverrides: &nvidiacomv1alpha1.DeploymentOverridesSpec{
+					WorkersImage: "docker.io/someone/vllm:latest",
+				},
+			},
+		}
+		err := reconciler.validateImageAllowlist(ctx, dgdr)
+		Expect(err).To(MatchError(ContainSubstring("docker.io/someone/vllm:latest")))
+		Expect(failureReasonFromError(err, nvidiacomv1alpha1.FailureReasonValidationError)).
+			To(Equal(nvidiacomv1alpha1.FailureReasonImageNotAllowed))
+
+		dgdr.Spec.DeploymentOverrides.WorkersImage = "registry.team-a.internal/vllm:latest"
+		Expect(reconciler.validateImageAllowlist(ctx, dgdr)).Should(Succeed())
+
+		// Generated deployments are checked as well
+		dgd := &nvidiacomv1alpha1.DynamoGraphDeployment{
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentSpec{
+				Services: map[string]*nvidiacomv1alpha1.DynamoComponentDeploymentSharedSpec{
+					"Frontend": {ExtraPodSpec: &dynamoCommon.ExtraPodSpec{
+						MainContainer: &corev1.Container{Image: "quay.io/someone/frontend:1"},
+					}},
+				},
+			},
+		}
+		err = reconciler.validateDeploymentImages(ctx, dgdr, dgd)
+		Expect(err).To(MatchError(ContainSubstring("quay.io/someone/frontend:1")))
+	})
+
+	It("Should reject invalid allowlists", func() {
+		path := filepath.Join(GinkgoT().TempDir(), "allowlist.yaml")
+		for _, content := range []string{
+			"tenants:\n- registries: [nvcr.io]\n",
+			"tenants:\n- namespaces: [default]\n",
+			"tenants:\n- namespaces: [default]\n  registries: [nvcr.io]\n  registry: nvcr.io\n",
+		} {
+			Expect(os.WriteFile(path, []byte(content), 0o600)).Should(Succeed())
+			_, err := LoadImageAllowlist(path)
+			Expect(err).To(HaveOccurred(), content)
+		}
+	})
+})
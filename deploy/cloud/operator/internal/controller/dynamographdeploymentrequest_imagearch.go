@@ -0,0 +1,129 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+)
+
+const (
+	// Validation messages
+	ValidationErrorImageArchMismatch = "image %s is built for %s, but the target nodes run %s"
+	MessageImageArchitecturesPartial = "image %s is built for %s and cannot run on the %s target nodes, pin its pods with a kubernetes.io/arch node selector"
+)
+
+// ImageArchitectureInspector reads the CPU architectures images are built for
+type ImageArchitectureInspector interface {
+	// ImageArchitectures returns the architectures of the image, authenticating with the given .dockerconfigjson documents
+	ImageArchitectures(ctx context.Context, image string, dockerConfigs [][]byte) ([]string, error)
+}
+
+// nodeArchitecture returns the CPU architecture of a node
+func nodeArchitecture(node *corev1.Node) string {
+	if arch := node.Labels[corev1.LabelArchStable]; arch != "" {
+		return arch
+	}
+	return node.Status.NodeInfo.Architecture
+}
+
+// targetNodeArchitectures returns the CPU architectures of the schedulable nodes the DGDR's
+// workloads run on: the reserved node pool if any, and only nodes with its GPUs unless it is CPU-only
+func (r *DynamoGraphDeploymentRequestReconciler) targetNodeArchitectures(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) ([]string, error) {
+	var selector client.MatchingLabels
+	if reservation := dgdr.Spec.ProfilingConfig.NodeReservation; reservation != nil {
+		selector = reservation.NodeSelector
+	}
+	nodes := &corev1.NodeList{}
+	if err := r.List(ctx, nodes, selector); err != nil {
+		return nil, fmt.Errorf("failed to list the target nodes: %w", err)
+	}
+	gpuResource := gpuResourceName(dgdr)
+	architectures := []string{}
+	for i := range nodes.Items {
+		node := &nodes.Items[i]
+		if node.Spec.Unschedulable {
+			continue
+		}
+		if gpus, ok := node.Status.Allocatable[gpuResource]; !isCPUOnly(dgdr) && (!ok || gpus.IsZero()) {
+			continue
+		}
+		if arch := nodeArchitecture(node); arch != "" && !slices.Contains(architectures, arch) {
+			architectures = append(architectures, arch)
+		}
+	}
+	slices.Sort(architectures)
+	return architectures, nil
+}
+
+// validateImageArchitectures fails DGDRs with a profiling or runtime image that is not built for
+// any CPU architecture of the target nodes, before its pods crash with exec format errors, and
+// warns about images that only run on some of them. Nodes are not listed by namespace-restricted
+// installations, and images whose registry cannot be inspected are not checked.
+func (r *DynamoGraphDeploymentRequestReconciler) validateImageArchitectures(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	if r.ArchitectureInspector == nil || r.Config.RestrictedNamespace != "" {
+		return nil
+	}
+	nodeArchitectures, err := r.targetNodeArchitectures(ctx, dgdr)
+	if err != nil || len(nodeArchitectures) == 0 {
+		return err
+	}
+	images, err := DGDRSpecImages(dgdr)
+	if err != nil {
+		return err
+	}
+	if image := r.runtimeImage(dgdr); image != "" {
+		images = append(images, image)
+	}
+
+	logger := log.FromContext(ctx)
+	for _, image := range images {
+		dockerConfigs, err := r.getDockerConfigs(ctx, dgdr.Namespace, image, profilingJobPullSecrets())
+		if err != nil {
+			return err
+		}
+		imageArchitectures, err := r.ArchitectureInspector.ImageArchitectures(ctx, image, dockerConfigs)
+		if err != nil || len(imageArchitectures) == 0 {
+			logger.V(1).Info("Skipping the architecture check of an image that could not be inspected", "image", image, "error", err)
+			continue
+		}
+		var unsupported []string
+		for _, arch := range nodeArchitectures {
+			if !slices.Contains(imageArchitectures, arch) {
+				unsupported = append(unsupported, arch)
+			}
+		}
+		switch {
+		case len(unsupported) == len(nodeArchitectures):
+			return withFailureReason(nvidiacomv1alpha1.FailureReasonImageArchMismatch, fmt.Errorf(ValidationErrorImageArchMismatch,
+				image, strings.Join(imageArchitectures, ", "), strings.Join(nodeArchitectures, ", ")))
+		case len(unsupported) > 0:
+			setWarning(dgdr, WarningImageArchitectures, fmt.Sprintf(MessageImageArchitecturesPartial,
+				image, strings.Join(imageArchitectures, ", "), strings.Join(unsupported, ", ")))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"errors"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/consts"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+// fakeArchitectureInspector returns fixed architectures per image
+type fakeArchitectureInspector map[string][]string
+
+func (f fakeArchitectureInspector) ImageArchitectures(_ context.Context, image string, _ [][]byte) ([]string, error) {
+	architectures, ok := f[image]
+	if !ok {
+		return nil, errors.New("unauthorized")
+	}
+	return architectures, nil
+}
+
+var _ = Describe("DGDR Image Architectures", func() {
+	ctx := context.Background()
+	var reconciler *DynamoGraphDeploymentRequestReconciler
+
+	createNode := func(name, arch string, gpus string) {
+		node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{"pool": "imagearch", corev1.LabelArchStable: arch},
+		}}
+		Expect(k8sClient.Create(ctx, node)).Should(Succeed())
+		DeferCleanup(func() { _ = k8sClient.Delete(ctx, node) })
+		node.Status.Allocatable = corev1.ResourceList{consts.KubeResourceGPUNvidia: resource.MustParse(gpus)}
+		Expect(k8sClient.Status().Update(ctx, node)).Should(Succeed())
+	}
+
+	BeforeEach(func() {
+		// GB200 nodes carry the GPUs, the x86 nodes only run CPU workloads
+		createNode("test-imagearch-gpu", "arm64", "4")
+		createNode("test-imagearch-cpu", "amd64", "0")
+		reconciler = &DynamoGraphDeploymentRequestReconciler{
+			Client:      k8sClient,
+			Recorder:    record.NewFakeRecorder(100),
+			RBACManager: &MockRBACManager{},
+			ArchitectureInspector: fakeArchitectureInspector{
+				"profiler:amd64":     {"amd64"},
+				"profiler:multiarch": {"amd64", "arm64"},
+			},
+		}
+	})
+
+	newDGDR := func(profilerImage string) *nvidiacomv1alpha1.DynamoGraphDeploymentRequest {
+		return &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-dgdr-imagearch", Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model:   "test-model",
+				Backend: BackendVLLM,
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					ProfilerImage: profilerImage,
+					NodeReservation: &nvidiacomv1alpha1.NodeReservationSpec{
+						NodeSelector: map[string]string{"pool": "imagearch"},
+						NodeCount:    1,
+					},
+				},
+			},
+		}
+	}
+
+	It("Should fail DGDRs with an image that is not built for the GPU nodes", func() {
+		err := reconciler.validateImageArchitectures(ctx, newDGDR("profiler:amd64"))
+		Expect(err).To(MatchError("image profiler:amd64 is built for amd64, but the target nodes run arm64"))
+		Expect(failureReasonFromError(err, nvidiacomv1alpha1.FailureReasonValidationError)).Should(Equal(nvidiacomv1alpha1.FailureReasonImageArchMismatch))
+
+		Expect(reconciler.validateImageArchitectures(ctx, newDGDR("profiler:multiarch"))).Should(Succeed())
+	})
+
+	It("Should warn about images that run on only some of the target nodes", func() {
+		dgdr := newDGDR("profiler:amd64")
+		dgdr.Spec.ProfilingConfig.CPUOnly = true
+		Expect(reconciler.validateImageArchitectures(ctx, dgdr)).Should(Succeed())
+		Expect(dgdr.Status.Warnings).Should(HaveLen(1))
+		Expect(dgdr.Status.Warnings[0].Type).Should(Equal(WarningImageArchitectures))
+		Expect(dgdr.Status.Warnings[0].Message).Should(ContainSubstring("cannot run on the arm64 target nodes"))
+	})
+
+	It("Should skip images that cannot be inspected", func() {
+		Expect(reconciler.validateImageArchitectures(ctx, newDGDR("private/profiler:latest"))).Should(Succeed())
+
+		reconciler.Config.RestrictedNamespace = defaultNamespace
+		Expect(reconciler.validateImageArchitectures(ctx, newDGDR("profiler:amd64"))).Should(Succeed())
+	})
+})
@@ -0,0 +1,167 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/registry"
+)
+
+const (
+	// Event reasons
+	EventReasonImagesPinned = "ImagesPinned"
+
+	// Validation messages
+	ValidationErrorPinImageDigestsNoResolver = "deploymentOverrides.pinImageDigests requires the operator to be configured with an image resolver"
+)
+
+// ImageDigestResolver resolves image references to content digests
+type ImageDigestResolver interface {
+	// ResolveDigest returns the digest of the image, authenticating with the given .dockerconfigjson documents
+	ResolveDigest(ctx context.Context, image string, dockerConfigs [][]byte) (string, error)
+}
+
+// shouldPinImageDigests reports whether the generated DGD images are pinned to digests
+func shouldPinImageDigests(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) bool {
+	return dgdr.Spec.DeploymentOverrides != nil && dgdr.Spec.DeploymentOverrides.PinImageDigests
+}
+
+// validatePinImageDigests checks that image digests can be resolved if requested
+func (r *DynamoGraphDeploymentRequestReconciler) validatePinImageDigests(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	if shouldPinImageDigests(dgdr) && r.ImageResolver == nil {
+		return errors.New(ValidationErrorPinImageDigestsNoResolver)
+	}
+	return nil
+}
+
+// pinImageDigests rewrites the images of the generated DGD to digest references and records the
+// resolved digests in status. Images that already reference a digest are left unchanged.
+func (r *DynamoGraphDeploymentRequestReconciler) pinImageDigests(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, dgd *nvidiacomv1alpha1.DynamoGraphDeployment) error {
+	if !shouldPinImageDigests(dgdr) {
+		return nil
+	}
+	logger := log.FromContext(ctx)
+	_, namespace := dgdr.DeploymentNameAndNamespace(dgd)
+
+	// Services are visited in order so that status is stable across reconciles
+	services := make([]string, 0, len(dgd.Spec.Services))
+	for service := range dgd.Spec.Services {
+		services = append(services, service)
+	}
+	sort.Strings(services)
+
+	digests := map[string]string{}
+	pinned := []nvidiacomv1alpha1.PinnedImage{}
+	for _, service := range services {
+		spec := dgd.Spec.Services[service]
+		if spec == nil || spec.ExtraPodSpec == nil {
+			continue
+		}
+		var pullSecrets []corev1.LocalObjectReference
+		containers := []*corev1.Container{}
+		if spec.ExtraPodSpec.MainContainer != nil {
+			containers = append(containers, spec.ExtraPodSpec.MainContainer)
+		}
+		if podSpec := spec.ExtraPodSpec.PodSpec; podSpec != nil {
+			pullSecrets = podSpec.ImagePullSecrets
+			for i := range podSpec.InitContainers {
+				containers = append(containers, &podSpec.InitContainers[i])
+			}
+			for i := range podSpec.Containers {
+				containers = append(containers, &podSpec.Containers[i])
+			}
+		}
+
+		for _, container := range containers {
+			if container.Image == "" || strings.Contains(container.Image, "@") {
+				continue
+			}
+			digest, resolved := digests[container.Image]
+			if !resolved {
+				dockerConfigs, err := r.getDockerConfigs(ctx, namespace, container.Image, pullSecrets)
+				if err != nil {
+					return err
+				}
+				if digest, err = r.ImageResolver.ResolveDigest(ctx, container.Image, dockerConfigs); err != nil {
+					return withFailureReason(nvidiacomv1alpha1.FailureReasonImageResolutionFailed,
+						fmt.Errorf("failed to pin image %s of service %s: %w", container.Image, service, err))
+				}
+				digests[container.Image] = digest
+			}
+			pinned = append(pinned, nvidiacomv1alpha1.PinnedImage{
+				Service:   service,
+				Container: container.Name,
+				Image:     container.Image,
+				Digest:    digest,
+			})
+			container.Image = container.Image + "@" + digest
+		}
+	}
+
+	dgdr.Status.PinnedImages = pinned
+	logger.Info("Pinned generated deployment images to digests", "images", len(pinned))
+	r.Recorder.Event(dgdr, corev1.EventTypeNormal, EventReasonImagesPinned,
+		fmt.Sprintf("Pinned %d images of the generated deployment to digests", len(pinned)))
+	return nil
+}
+
+// getDockerConfigs returns the .dockerconfigjson documents usable to pull the image: the service's
+// image pull secrets followed by the docker config secrets indexed for the image registry
+func (r *DynamoGraphDeploymentRequestReconciler) getDockerConfigs(ctx context.Context, namespace, image string, pullSecrets []corev1.LocalObjectReference) ([][]byte, error) {
+	names := []string{}
+	for _, pullSecret := range pullSecrets {
+		names = append(names, pullSecret.Name)
+	}
+	if r.DockerSecretRetriever != nil {
+		ref, err := registry.ParseReference(image)
+		if err != nil {
+			return nil, withFailureReason(nvidiacomv1alpha1.FailureReasonImageResolutionFailed, err)
+		}
+		indexed, err := r.DockerSecretRetriever.GetSecrets(namespace, ref.Registry)
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, indexed...)
+	}
+
+	dockerConfigs := [][]byte{}
+	for _, name := range names {
+		secret := &corev1.Secret{}
+		if err := r.Get(ctx, types.NamespacedName{Name: name, Namespace: namespace}, secret); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to get image pull secret %s: %w", name, err)
+		}
+		if dockerConfig, ok := secret.Data[corev1.DockerConfigJsonKey]; ok {
+			dockerConfigs = append(dockerConfigs, dockerConfig)
+		}
+	}
+	return dockerConfigs, nil
+}
@@ -0,0 +1,177 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	dynamoCommon "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/dynamo/common"
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+// fakeImageResolver resolves images from a fixed table and records the credentials it was given
+type fakeImageResolver struct {
+	digests       map[string]string
+	dockerConfigs map[string][][]byte
+}
+
+func (f *fakeImageResolver) ResolveDigest(_ context.Context, image string, dockerConfigs [][]byte) (string, error) {
+	f.dockerConfigs[image] = dockerConfigs
+	digest, ok := f.digests[image]
+	if !ok {
+		return "", fmt.Errorf("manifest unknown")
+	}
+	return digest, nil
+}
+
+var _ = Describe("DGDR Image Digest Pinning", func() {
+	var (
+		reconciler *DynamoGraphDeploymentRequestReconciler
+		resolver   *fakeImageResolver
+	)
+
+	BeforeEach(func() {
+		resolver = &fakeImageResolver{
+			digests: map[string]string{
+				"nvcr.io/nvidia/ai-dynamo/vllm-runtime:0.6.1": "sha256:runtime",
+				"nvcr.io/nvidia/ai-dynamo/frontend:0.6.1":     "sha256:frontend",
+			},
+			dockerConfigs: map[string][][]byte{},
+		}
+		reconciler = &DynamoGraphDeploymentRequestReconciler{
+			Client:        k8sClient,
+			Recorder:      record.NewFakeRecorder(100),
+			RBACManager:   &MockRBACManager{},
+			ImageResolver: resolver,
+		}
+	})
+
+	newDGDR := func(pin bool) *nvidiacomv1alpha1.DynamoGraphDeploymentRequest {
+		return &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-dgdr-pin", Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				DeploymentOverrides: &nvidiacomv1alpha1.DeploymentOverridesSpec{PinImageDigests: pin},
+			},
+		}
+	}
+
+	newDGD := func() *nvidiacomv1alpha1.DynamoGraphDeployment {
+		return &nvidiacomv1alpha1.DynamoGraphDeployment{
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentSpec{
+				Services: map[string]*nvidiacomv1alpha1.DynamoComponentDeploymentSharedSpec{
+					"Frontend": {
+						ExtraPodSpec: &dynamoCommon.ExtraPodSpec{
+							MainContainer: &corev1.Container{Image: "nvcr.io/nvidia/ai-dynamo/frontend:0.6.1"},
+						},
+					},
+					"VllmDecodeWorker": {
+						ExtraPodSpec: &dynamoCommon.ExtraPodSpec{
+							PodSpec: &corev1.PodSpec{
+								ImagePullSecrets: []corev1.LocalObjectReference{{Name: "test-pin-regcred"}},
+								Containers:       []corev1.Container{{Name: "sidecar", Image: "busybox@sha256:already"}},
+							},
+							MainContainer: &corev1.Container{Image: "nvcr.io/nvidia/ai-dynamo/vllm-runtime:0.6.1"},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	It("Should pin images to digests and record them in status", func() {
+		ctx := context.Background()
+
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-pin-regcred", Namespace: defaultNamespace},
+			Type:       corev1.SecretTypeDockerConfigJson,
+			Data:       map[string][]byte{corev1.DockerConfigJsonKey: []byte(`{"auths":{"nvcr.io":{}}}`)},
+		}
+		Expect(k8sClient.Create(ctx, secret)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, secret) }()
+
+		dgdr := newDGDR(true)
+		dgd := newDGD()
+		Expect(reconciler.pinImageDigests(ctx, dgdr, dgd)).Should(Succeed())
+
+		Expect(dgd.Spec.Services["Frontend"].ExtraPodSpec.MainContainer.Image).
+			Should(Equal("nvcr.io/nvidia/ai-dynamo/frontend:0.6.1@sha256:frontend"))
+		Expect(dgd.Spec.Services["VllmDecodeWorker"].ExtraPodSpec.MainContainer.Image).
+			Should(Equal("nvcr.io/nvidia/ai-dynamo/vllm-runtime:0.6.1@sha256:runtime"))
+		Expect(dgd.Spec.Services["VllmDecodeWorker"].ExtraPodSpec.Containers[0].Image).Should(Equal("busybox@sha256:already"))
+
+		Expect(dgdr.Status.PinnedImages).Should(Equal([]nvidiacomv1alpha1.PinnedImage{
+			{Service: "Frontend", Image: "nvcr.io/nvidia/ai-dynamo/frontend:0.6.1", Digest: "sha256:frontend"},
+			{Service: "VllmDecodeWorker", Image: "nvcr.io/nvidia/ai-dynamo/vllm-runtime:0.6.1", Digest: "sha256:runtime"},
+		}))
+		Expect(resolver.dockerConfigs["nvcr.io/nvidia/ai-dynamo/vllm-runtime:0.6.1"]).Should(HaveLen(1))
+		Expect(resolver.dockerConfigs["nvcr.io/nvidia/ai-dynamo/frontend:0.6.1"]).Should(BeEmpty())
+	})
+
+	It("Should pin the images set by deployment patches", func() {
+		dgdr := newDGDR(true)
+		dgdr.Spec.DeploymentOverrides.Patches = []nvidiacomv1alpha1.DeploymentPatch{{
+			Type:  nvidiacomv1alpha1.DeploymentPatchTypeJSON6902,
+			Patch: `[{"op": "replace", "path": "/spec/services/Frontend/extraPodSpec/mainContainer/image", "value": "nvcr.io/nvidia/ai-dynamo/frontend:0.6.1"}]`,
+		}}
+		generated := []byte(`apiVersion: nvidia.com/v1alpha1
+kind: DynamoGraphDeployment
+metadata:
+  name: test-pin
+spec:
+  services:
+    Frontend:
+      extraPodSpec:
+        mainContainer:
+          image: nvcr.io/nvidia/ai-dynamo/frontend:unpinned
+`)
+
+		dgd, err := reconciler.renderGeneratedDeployment(context.Background(), dgdr, getProfilingOutputKey(dgdr), generated)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(dgd.Spec.Services["Frontend"].ExtraPodSpec.MainContainer.Image).
+			Should(Equal("nvcr.io/nvidia/ai-dynamo/frontend:0.6.1@sha256:frontend"))
+	})
+
+	It("Should fail with ImageResolutionFailed when an image cannot be resolved", func() {
+		dgd := newDGD()
+		dgd.Spec.Services["Frontend"].ExtraPodSpec.MainContainer.Image = "nvcr.io/nvidia/ai-dynamo/frontend:missing"
+
+		err := reconciler.pinImageDigests(context.Background(), newDGDR(true), dgd)
+		Expect(err).To(HaveOccurred())
+		Expect(failureReasonFromError(err, nvidiacomv1alpha1.FailureReasonResultsMissing)).
+			To(Equal(nvidiacomv1alpha1.FailureReasonImageResolutionFailed))
+	})
+
+	It("Should leave images unchanged when pinning is not requested", func() {
+		dgdr := newDGDR(false)
+		dgd := newDGD()
+		Expect(reconciler.pinImageDigests(context.Background(), dgdr, dgd)).Should(Succeed())
+		Expect(dgd.Spec.Services["Frontend"].ExtraPodSpec.MainContainer.Image).Should(Equal("nvcr.io/nvidia/ai-dynamo/frontend:0.6.1"))
+		Expect(dgdr.Status.PinnedImages).Should(BeEmpty())
+	})
+
+	It("Should reject pinning without an image resolver", func() {
+		reconciler.ImageResolver = nil
+		Expect(reconciler.validatePinImageDigests(newDGDR(true))).Should(MatchError(ValidationErrorPinImageDigestsNoResolver))
+	})
+})
@@ -0,0 +1,230 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	commonconsts "github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/consts"
+)
+
+const (
+	// SLA keys of the load target passed to the profiler
+	SLAKeyRequestsPerSecond  = "requests_per_second"
+	SLAKeyConcurrentUsers    = "concurrent_users"
+	SLAKeyMinTokensPerSecond = "min_tokens_per_second"
+
+	// Latency percentiles of spec.sla.tokenLatency.percentiles, suffixes of the ttft and itl keys
+	PercentileP50 = "p50"
+	PercentileP90 = "p90"
+	PercentileP99 = "p99"
+
+	// SLAResultsFile is where the profiler reports the latency percentile it sized the deployment for
+	SLAResultsFile = "sla_results.yaml"
+
+	// MessagePercentileUnreported warns that the profiler did not report the percentile it sized for
+	MessagePercentileUnreported = "spec.sla has %s targets, but the profiler did not report the percentile it sized the deployment for"
+
+	// FrontendRequestsPerSecond is the request rate a single frontend replica is sized for
+	FrontendRequestsPerSecond = 1000
+	// FrontendConcurrentUsers is the number of in-flight requests a single frontend replica is sized for
+	FrontendConcurrentUsers = 2000
+)
+
+// slaConfig returns the profiler SLA keys of spec.sla. Latencies are in milliseconds, like the profiler's.
+func slaConfig(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) map[string]int32 {
+	target := map[string]int32{}
+	if dgdr.Spec.SLA == nil {
+		return target
+	}
+	if dgdr.Spec.SLA.RequestsPerSecond != nil {
+		target[SLAKeyRequestsPerSecond] = *dgdr.Spec.SLA.RequestsPerSecond
+	}
+	if dgdr.Spec.SLA.ConcurrentUsers != nil {
+		target[SLAKeyConcurrentUsers] = *dgdr.Spec.SLA.ConcurrentUsers
+	}
+	if latency := dgdr.Spec.SLA.TokenLatency; latency != nil {
+		if latency.TTFTMilliseconds > 0 {
+			target[SLAKeyTTFT] = latency.TTFTMilliseconds
+		}
+		if latency.ITLMilliseconds > 0 {
+			target[SLAKeyITL] = latency.ITLMilliseconds
+		}
+		if latency.Percentiles != nil {
+			addPercentileTargets(target, SLAKeyTTFT, latency.Percentiles.TTFT)
+			addPercentileTargets(target, SLAKeyITL, latency.Percentiles.ITL)
+		}
+	}
+	if dgdr.Spec.SLA.BatchLatencyMilliseconds != nil {
+		target[SLAKeyBatchLatency] = *dgdr.Spec.SLA.BatchLatencyMilliseconds
+	}
+	if dgdr.Spec.SLA.MinTokensPerSecond != nil {
+		target[SLAKeyMinTokensPerSecond] = *dgdr.Spec.SLA.MinTokensPerSecond
+	}
+	return target
+}
+
+// addPercentileTargets adds the percentile targets of a latency as <key>_p50, <key>_p90 and <key>_p99
+func addPercentileTargets(target map[string]int32, key string, percentiles *nvidiacomv1alpha1.PercentileTargets) {
+	if percentiles == nil {
+		return
+	}
+	for percentile, value := range map[string]*int32{
+		PercentileP50: percentiles.P50Milliseconds,
+		PercentileP90: percentiles.P90Milliseconds,
+		PercentileP99: percentiles.P99Milliseconds,
+	} {
+		if value != nil {
+			target[key+"_"+percentile] = *value
+		}
+	}
+}
+
+// slaResult is the content of SLAResultsFile
+type slaResult struct {
+	SizedForPercentile string `json:"sized_for_percentile"`
+}
+
+// requestedPercentile returns the highest latency percentile of spec.sla with a target, or "" without
+// percentile targets
+func requestedPercentile(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) string {
+	target := slaConfig(dgdr)
+	for _, percentile := range []string{PercentileP99, PercentileP90, PercentileP50} {
+		for _, key := range []string{SLAKeyTTFT, SLAKeyITL} {
+			if _, ok := target[key+"_"+percentile]; ok {
+				return percentile
+			}
+		}
+	}
+	return ""
+}
+
+// applySLAResults records the latency percentile the profiler sized the deployment for. Profilers that
+// do not report it leave status.sizedForPercentile unset, with a warning if spec.sla has percentile targets.
+func applySLAResults(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, results map[string]string) error {
+	dgdr.Status.SizedForPercentile = ""
+	content, exists := results[SLAResultsFile]
+	if !exists {
+		if requested := requestedPercentile(dgdr); requested != "" {
+			setWarning(dgdr, WarningPercentileUnreported, fmt.Sprintf(MessagePercentileUnreported, requested))
+		} else {
+			clearWarning(dgdr, WarningPercentileUnreported)
+		}
+		return nil
+	}
+	clearWarning(dgdr, WarningPercentileUnreported)
+
+	var result slaResult
+	if err := yaml.Unmarshal([]byte(content), &result); err != nil {
+		return withFailureReason(nvidiacomv1alpha1.FailureReasonSpecParseError,
+			fmt.Errorf("failed to parse %s: %w", SLAResultsFile, err))
+	}
+	switch result.SizedForPercentile {
+	case "", PercentileP50, PercentileP90, PercentileP99:
+	default:
+		return withFailureReason(nvidiacomv1alpha1.FailureReasonSpecParseError,
+			fmt.Errorf("invalid sized_for_percentile %q in %s", result.SizedForPercentile, SLAResultsFile))
+	}
+	dgdr.Status.SizedForPercentile = result.SizedForPercentile
+	return nil
+}
+
+// warnOverwrittenLoadTarget warns about targets in profilingConfig.config.sla that spec.sla overwrites
+func warnOverwrittenLoadTarget(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, config map[string]interface{}) {
+	sla, _ := config["sla"].(map[string]interface{})
+	target := slaConfig(dgdr)
+	var messages []string
+	for _, key := range slices.Sorted(maps.Keys(target)) {
+		if configured, ok := sla[key].(float64); ok && configured != float64(target[key]) {
+			messages = append(messages,
+				fmt.Sprintf("profilingConfig.config.sla.%s %v is overwritten by spec.sla (%d)", key, configured, target[key]))
+		}
+	}
+	if len(messages) == 0 {
+		clearWarning(dgdr, WarningSLAOverwritten)
+		return
+	}
+	setWarning(dgdr, WarningSLAOverwritten, strings.Join(messages, "; "))
+}
+
+// applyLoadTargetConfig sets the load and latency targets of spec.sla in the profiling config
+func applyLoadTargetConfig(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, config map[string]interface{}) error {
+	target := slaConfig(dgdr)
+	if len(target) == 0 {
+		return nil
+	}
+	slaVal, hasSLA := config["sla"]
+	var sla map[string]interface{}
+	if !hasSLA || slaVal == nil {
+		sla = make(map[string]interface{})
+		config["sla"] = sla
+	} else {
+		var ok bool
+		sla, ok = slaVal.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("profilingConfig.config.sla must be an object, got %T", slaVal)
+		}
+	}
+	for key, value := range target {
+		sla[key] = value
+	}
+	return nil
+}
+
+// frontendReplicasForLoad returns the frontend replicas needed for the load target, 0 without one
+func frontendReplicasForLoad(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) int32 {
+	var replicas int32
+	target := slaConfig(dgdr)
+	if rps, ok := target[SLAKeyRequestsPerSecond]; ok {
+		replicas = max(replicas, (rps+FrontendRequestsPerSecond-1)/FrontendRequestsPerSecond)
+	}
+	if users, ok := target[SLAKeyConcurrentUsers]; ok {
+		replicas = max(replicas, (users+FrontendConcurrentUsers-1)/FrontendConcurrentUsers)
+	}
+	return replicas
+}
+
+// applyLoadTarget scales the frontends of a generated deployment up to the load target. The
+// profiler sizes the workers; frontends also route requests, so they are sized by the operator.
+// Autoscaled frontends get a higher minimum instead of fixed replicas.
+func applyLoadTarget(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, dgd *nvidiacomv1alpha1.DynamoGraphDeployment) {
+	replicas := frontendReplicasForLoad(dgdr)
+	if replicas == 0 {
+		return
+	}
+	for _, spec := range dgd.Spec.Services {
+		if spec == nil || spec.ComponentType != commonconsts.ComponentTypeFrontend {
+			continue
+		}
+		if spec.Autoscaling != nil && spec.Autoscaling.Enabled {
+			spec.Autoscaling.MinReplicas = max(spec.Autoscaling.MinReplicas, int(replicas))
+			spec.Autoscaling.MaxReplicas = max(spec.Autoscaling.MaxReplicas, spec.Autoscaling.MinReplicas)
+			continue
+		}
+		if spec.Replicas == nil || *spec.Replicas < replicas {
+			spec.Replicas = ptr.To(replicas)
+		}
+	}
+}
@@ -0,0 +1,237 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	commonconsts "github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/consts"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
+)
+
+var _ = Describe("DGDR Load Targets", func() {
+	var reconciler *DynamoGraphDeploymentRequestReconciler
+
+	BeforeEach(func() {
+		reconciler = &DynamoGraphDeploymentRequestReconciler{
+			Client:      k8sClient,
+			Recorder:    record.NewFakeRecorder(100),
+			RBACManager: &MockRBACManager{},
+		}
+	})
+
+	newDGDR := func(name string, sla *nvidiacomv1alpha1.SLASpec) *nvidiacomv1alpha1.DynamoGraphDeploymentRequest {
+		return &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model:   "Qwen/Qwen3-0.6B",
+				Backend: BackendVLLM,
+				SLA:     sla,
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					ProfilerImage: "test-profiler:latest",
+					Config: createTestConfig(map[string]interface{}{
+						"sla":   map[string]interface{}{"ttft": 200.0, "itl": 20.0, SLAKeyRequestsPerSecond: 10.0},
+						"sweep": map[string]interface{}{"use_ai_configurator": true},
+					}),
+				},
+			},
+		}
+	}
+
+	It("Should pass the load target to the profiler", func() {
+		ctx := context.Background()
+
+		sa := &corev1.ServiceAccount{
+			ObjectMeta: metav1.ObjectMeta{Name: ServiceAccountProfilingJob, Namespace: defaultNamespace},
+		}
+		Expect(k8sClient.Create(ctx, sa)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, sa) }()
+
+		dgdr := newDGDR("test-dgdr-load-target", &nvidiacomv1alpha1.SLASpec{
+			RequestsPerSecond: ptr.To(int32(2500)),
+			ConcurrentUsers:   ptr.To(int32(100)),
+		})
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, dgdr) }()
+
+		Expect(reconciler.validateSpec(ctx, dgdr)).Should(Succeed())
+		Expect(dgdr.Status.Warnings).Should(ContainElement(HaveField("Message", ContainSubstring("sla.requests_per_second 10 is overwritten"))))
+		Expect(reconciler.createProfilingJob(ctx, dgdr)).Should(Succeed())
+
+		job := &batchv1.Job{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: GetProfilingJobName(dgdr), Namespace: defaultNamespace}, job)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, job) }()
+
+		var config map[string]interface{}
+		Expect(yaml.Unmarshal([]byte(job.Spec.Template.Spec.Containers[0].Args[1]), &config)).Should(Succeed())
+		Expect(config["sla"]).Should(HaveKeyWithValue(SLAKeyRequestsPerSecond, 2500.0))
+		Expect(config["sla"]).Should(HaveKeyWithValue(SLAKeyConcurrentUsers, 100.0))
+		Expect(config["sla"]).Should(HaveKeyWithValue("ttft", 200.0))
+	})
+
+	It("Should pass typed latency targets in milliseconds to the profiler", func() {
+		ctx := context.Background()
+		dgdr := newDGDR("test-dgdr-latency", &nvidiacomv1alpha1.SLASpec{
+			TokenLatency: &nvidiacomv1alpha1.TokenLatencySpec{TTFTMilliseconds: 500},
+		})
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, dgdr) }()
+
+		// The API server defaults the inter-token latency
+		Expect(dgdr.Spec.SLA.TokenLatency.ITLMilliseconds).Should(Equal(int32(20)))
+		Expect(slaConfig(dgdr)).Should(Equal(map[string]int32{SLAKeyTTFT: 500, SLAKeyITL: 20}))
+
+		config := map[string]interface{}{"sla": map[string]interface{}{SLAKeyTTFT: 200.0, SLAKeyITL: 20.0}}
+		warnOverwrittenLoadTarget(dgdr, config)
+		Expect(dgdr.Status.Warnings).Should(ContainElement(SatisfyAll(
+			HaveField("Type", WarningSLAOverwritten),
+			HaveField("Message", ContainSubstring("sla.ttft 200 is overwritten")),
+		)))
+		Expect(applyLoadTargetConfig(dgdr, config)).Should(Succeed())
+		Expect(config["sla"]).Should(HaveKeyWithValue(SLAKeyTTFT, int32(500)))
+
+		// The warning is cleared once the config agrees with spec.sla
+		warnOverwrittenLoadTarget(dgdr, map[string]interface{}{"sla": map[string]interface{}{SLAKeyTTFT: 500.0}})
+		Expect(dgdr.Status.Warnings).ShouldNot(ContainElement(HaveField("Type", WarningSLAOverwritten)))
+
+		embedding := newDGDR("test-dgdr-batch-latency", &nvidiacomv1alpha1.SLASpec{BatchLatencyMilliseconds: ptr.To(int32(50))})
+		embedding.Spec.WorkloadType = nvidiacomv1alpha1.WorkloadTypeEmbedding
+		embedding.Spec.ProfilingConfig.Config = createTestConfig(map[string]interface{}{
+			"sweep": map[string]interface{}{"use_ai_configurator": true},
+		})
+		Expect(validateWorkloadType(embedding)).Should(Succeed())
+	})
+
+	It("Should pass percentile targets and the throughput floor to the profiler", func() {
+		ctx := context.Background()
+		dgdr := newDGDR("test-dgdr-percentiles", &nvidiacomv1alpha1.SLASpec{
+			MinTokensPerSecond: ptr.To(int32(5000)),
+			TokenLatency: &nvidiacomv1alpha1.TokenLatencySpec{TTFTMilliseconds: 200, ITLMilliseconds: 20,
+				Percentiles: &nvidiacomv1alpha1.LatencyPercentilesSpec{
+					TTFT: &nvidiacomv1alpha1.PercentileTargets{P50Milliseconds: ptr.To(int32(150)), P90Milliseconds: ptr.To(int32(400))},
+					ITL:  &nvidiacomv1alpha1.PercentileTargets{P50Milliseconds: ptr.To(int32(15))},
+				}},
+		})
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, dgdr) }()
+
+		Expect(slaConfig(dgdr)).Should(Equal(map[string]int32{
+			SLAKeyTTFT: 200, SLAKeyITL: 20, SLAKeyMinTokensPerSecond: 5000,
+			"ttft_p50": 150, "ttft_p90": 400, "itl_p50": 15,
+		}))
+		Expect(requestedPercentile(dgdr)).Should(Equal(PercentileP90))
+
+		dgdr.Spec.SLA.TokenLatency.Percentiles.ITL.P99Milliseconds = ptr.To(int32(50))
+		Expect(requestedPercentile(dgdr)).Should(Equal(PercentileP99))
+		dgdr.Spec.SLA.TokenLatency.Percentiles = nil
+		Expect(requestedPercentile(dgdr)).Should(BeEmpty())
+	})
+
+	It("Should report the percentile the profiler sized for", func() {
+		dgdr := newDGDR("test-dgdr-sized-for", &nvidiacomv1alpha1.SLASpec{
+			TokenLatency: &nvidiacomv1alpha1.TokenLatencySpec{TTFTMilliseconds: 200,
+				Percentiles: &nvidiacomv1alpha1.LatencyPercentilesSpec{
+					TTFT: &nvidiacomv1alpha1.PercentileTargets{P99Milliseconds: ptr.To(int32(800))},
+				}},
+		})
+
+		// The profiler may size for a lower percentile than the highest requested
+		Expect(applySLAResults(dgdr, map[string]string{SLAResultsFile: "sized_for_percentile: p90\n"})).Should(Succeed())
+		Expect(dgdr.Status.SizedForPercentile).Should(Equal(PercentileP90))
+		Expect(dgdr.Status.Warnings).Should(BeEmpty())
+
+		// Profilers that do not report it leave it unset
+		Expect(applySLAResults(dgdr, map[string]string{})).Should(Succeed())
+		Expect(dgdr.Status.SizedForPercentile).Should(BeEmpty())
+		Expect(dgdr.Status.Warnings).Should(ContainElement(HaveField("Type", WarningPercentileUnreported)))
+
+		Expect(applySLAResults(dgdr, map[string]string{SLAResultsFile: "sized_for_percentile: p95\n"})).
+			To(MatchError(ContainSubstring(`invalid sized_for_percentile "p95"`)))
+	})
+
+	It("Should reject invalid latency targets at the API server", func() {
+		ctx := context.Background()
+		for _, tc := range []struct {
+			name         string
+			workloadType nvidiacomv1alpha1.WorkloadType
+			sla          *nvidiacomv1alpha1.SLASpec
+			message      string
+		}{
+			{"test-dgdr-ttft-below-itl", "", &nvidiacomv1alpha1.SLASpec{
+				TokenLatency: &nvidiacomv1alpha1.TokenLatencySpec{TTFTMilliseconds: 10, ITLMilliseconds: 20},
+			}, "ttftMilliseconds must be greater than itlMilliseconds"},
+			{"test-dgdr-itl-range", "", &nvidiacomv1alpha1.SLASpec{
+				TokenLatency: &nvidiacomv1alpha1.TokenLatencySpec{TTFTMilliseconds: 100000, ITLMilliseconds: 90000},
+			}, "itlMilliseconds"},
+			{"test-dgdr-batch-llm", "", &nvidiacomv1alpha1.SLASpec{
+				BatchLatencyMilliseconds: ptr.To(int32(50)),
+			}, "sla.batchLatencyMilliseconds is only valid for workloadType embedding or reranker"},
+			{"test-dgdr-token-embedding", nvidiacomv1alpha1.WorkloadTypeEmbedding, &nvidiacomv1alpha1.SLASpec{
+				TokenLatency: &nvidiacomv1alpha1.TokenLatencySpec{TTFTMilliseconds: 200, ITLMilliseconds: 20},
+			}, "sla.tokenLatency is only valid for workloadType llm"},
+			{"test-dgdr-percentile-order", "", &nvidiacomv1alpha1.SLASpec{
+				TokenLatency: &nvidiacomv1alpha1.TokenLatencySpec{TTFTMilliseconds: 200, ITLMilliseconds: 20,
+					Percentiles: &nvidiacomv1alpha1.LatencyPercentilesSpec{
+						TTFT: &nvidiacomv1alpha1.PercentileTargets{P50Milliseconds: ptr.To(int32(300)), P90Milliseconds: ptr.To(int32(250))},
+					}},
+			}, "p50Milliseconds must not be greater than p90Milliseconds"},
+			{"test-dgdr-throughput-embedding", nvidiacomv1alpha1.WorkloadTypeEmbedding, &nvidiacomv1alpha1.SLASpec{
+				BatchLatencyMilliseconds: ptr.To(int32(50)), MinTokensPerSecond: ptr.To(int32(1000)),
+			}, "sla.minTokensPerSecond is only valid for workloadType llm"},
+		} {
+			dgdr := newDGDR(tc.name, tc.sla)
+			dgdr.Spec.WorkloadType = tc.workloadType
+			Expect(k8sClient.Create(ctx, dgdr)).To(MatchError(ContainSubstring(tc.message)), tc.name)
+		}
+	})
+
+	It("Should size the frontends for the load target", func() {
+		dgd := &nvidiacomv1alpha1.DynamoGraphDeployment{
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentSpec{
+				Services: map[string]*nvidiacomv1alpha1.DynamoComponentDeploymentSharedSpec{
+					"Frontend": {ComponentType: commonconsts.ComponentTypeFrontend, Replicas: ptr.To(int32(1))},
+					"Router": {ComponentType: commonconsts.ComponentTypeFrontend, Autoscaling: &nvidiacomv1alpha1.Autoscaling{
+						Enabled: true, MinReplicas: 1, MaxReplicas: 2,
+					}},
+					"Worker": {ComponentType: commonconsts.ComponentTypeWorker, Replicas: ptr.To(int32(1))},
+				},
+			},
+		}
+
+		applyLoadTarget(newDGDR("test-dgdr-no-target", nil), dgd)
+		Expect(*dgd.Spec.Services["Frontend"].Replicas).Should(Equal(int32(1)))
+
+		applyLoadTarget(newDGDR("test-dgdr-load-target", &nvidiacomv1alpha1.SLASpec{
+			RequestsPerSecond: ptr.To(int32(1500)),
+			ConcurrentUsers:   ptr.To(int32(6001)),
+		}), dgd)
+		Expect(*dgd.Spec.Services["Frontend"].Replicas).Should(Equal(int32(4)))
+		Expect(dgd.Spec.Services["Router"].Autoscaling.MinReplicas).Should(Equal(4))
+		Expect(dgd.Spec.Services["Router"].Autoscaling.MaxReplicas).Should(Equal(4))
+		Expect(*dgd.Spec.Services["Worker"].Replicas).Should(Equal(int32(1)))
+	})
+})
@@ -0,0 +1,106 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+)
+
+const (
+	// AnnotationLogLevel raises the log verbosity of the reconciles of a single DGDR
+	AnnotationLogLevel = "nvidia.com/log-level"
+
+	// Log levels accepted by AnnotationLogLevel
+	LogLevelInfo  = "info"
+	LogLevelDebug = "debug"
+	LogLevelTrace = "trace"
+)
+
+// logLevelVerbosity maps AnnotationLogLevel values to logr verbosity
+var logLevelVerbosity = map[string]int{
+	LogLevelInfo:  0,
+	LogLevelDebug: 1,
+	LogLevelTrace: 2,
+}
+
+// withLogLevelOverride returns a context whose logger emits the verbose logs requested by the DGDR's
+// log level annotation, regardless of the operator's global level. Other DGDRs are not affected.
+func withLogLevelOverride(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) (context.Context, logr.Logger) {
+	logger := log.FromContext(ctx)
+	value, exists := dgdr.Annotations[AnnotationLogLevel]
+	if !exists {
+		return ctx, logger
+	}
+	verbosity, known := logLevelVerbosity[value]
+	if !known {
+		logger.Info("Ignoring unknown log level", "annotation", AnnotationLogLevel, "value", value)
+		return ctx, logger
+	}
+	if verbosity == 0 || logger.GetSink() == nil {
+		return ctx, logger
+	}
+	logger = logr.New(newVerbositySink(logger.GetSink(), verbosity)).WithValues("logLevel", value)
+	return log.IntoContext(ctx, logger), logger
+}
+
+// verbositySink enables the logs up to a verbosity its delegate filters out and emits them at the
+// delegate's base level, tagged with their original verbosity
+type verbositySink struct {
+	delegate  logr.LogSink
+	verbosity int
+}
+
+func newVerbositySink(delegate logr.LogSink, verbosity int) logr.LogSink {
+	// Account for the extra frame of the wrapper when the delegate reports callers
+	if withCallDepth, ok := delegate.(logr.CallDepthLogSink); ok {
+		delegate = withCallDepth.WithCallDepth(1)
+	}
+	return &verbositySink{delegate: delegate, verbosity: verbosity}
+}
+
+// Init is a no-op, the delegate was initialized by the logger it comes from
+func (s *verbositySink) Init(logr.RuntimeInfo) {}
+
+func (s *verbositySink) Enabled(level int) bool {
+	return level <= s.verbosity || s.delegate.Enabled(level)
+}
+
+func (s *verbositySink) Info(level int, msg string, keysAndValues ...any) {
+	if s.delegate.Enabled(level) {
+		s.delegate.Info(level, msg, keysAndValues...)
+		return
+	}
+	s.delegate.Info(0, msg, append(keysAndValues, "v", level)...)
+}
+
+func (s *verbositySink) Error(err error, msg string, keysAndValues ...any) {
+	s.delegate.Error(err, msg, keysAndValues...)
+}
+
+func (s *verbositySink) WithValues(keysAndValues ...any) logr.LogSink {
+	return &verbositySink{delegate: s.delegate.WithValues(keysAndValues...), verbosity: s.verbosity}
+}
+
+func (s *verbositySink) WithName(name string) logr.LogSink {
+	return &verbositySink{delegate: s.delegate.WithName(name), verbosity: s.verbosity}
+}
@@ -0,0 +1,72 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	"github.com/go-logr/logr/funcr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+var _ = Describe("DGDR Log Level Override", func() {
+	var lines []string
+	var ctx context.Context
+
+	BeforeEach(func() {
+		lines = nil
+		logger := funcr.New(func(prefix, args string) { lines = append(lines, args) }, funcr.Options{Verbosity: 0})
+		ctx = log.IntoContext(context.Background(), logger)
+	})
+
+	newDGDR := func(annotations map[string]string) *nvidiacomv1alpha1.DynamoGraphDeploymentRequest {
+		return &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-dgdr-loglevel", Namespace: defaultNamespace, Annotations: annotations},
+		}
+	}
+
+	It("Should emit debug logs only for the annotated DGDR", func() {
+		_, logger := withLogLevelOverride(ctx, newDGDR(nil))
+		logger.V(1).Info("hidden")
+		Expect(lines).Should(BeEmpty())
+
+		debugCtx, logger := withLogLevelOverride(ctx, newDGDR(map[string]string{AnnotationLogLevel: LogLevelDebug}))
+		logger.V(1).Info("visible")
+		logger.V(2).Info("hidden")
+		Expect(lines).Should(HaveLen(1))
+		Expect(lines[0]).Should(ContainSubstring(`"msg"="visible"`))
+		Expect(lines[0]).Should(ContainSubstring(`"logLevel"="debug"`))
+		Expect(lines[0]).Should(ContainSubstring(`"v"=1`))
+
+		// Loggers derived from the context keep the override
+		log.FromContext(debugCtx).WithName("child").WithValues("step", "spec").V(1).Info("derived")
+		Expect(lines).Should(HaveLen(2))
+		Expect(lines[1]).Should(ContainSubstring(`"step"="spec"`))
+	})
+
+	It("Should ignore unknown log levels", func() {
+		_, logger := withLogLevelOverride(ctx, newDGDR(map[string]string{AnnotationLogLevel: "verbose"}))
+		Expect(lines).Should(HaveLen(1))
+		Expect(lines[0]).Should(ContainSubstring("Ignoring unknown log level"))
+		Expect(logger.V(1).Enabled()).To(BeFalse())
+	})
+})
@@ -0,0 +1,271 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+)
+
+const (
+	// Condition types
+	ConditionTypeWaitingForMaintenanceWindow = "WaitingForMaintenanceWindow"
+
+	// Condition reasons
+	ReasonOutsideMaintenanceWindow = "OutsideMaintenanceWindow"
+	ReasonMaintenanceWindowOpen    = "MaintenanceWindowOpen"
+
+	// Event reasons
+	EventReasonWaitingForMaintenanceWindow = "WaitingForMaintenanceWindow"
+
+	// Messages
+	MessageWaitingForMaintenanceWindow = "Waiting for the maintenance window opening at %s before %s"
+	MessageMaintenanceWindowOpen       = "Maintenance window open until %s"
+
+	// maxScheduleLookahead bounds the search for the next time a cron schedule fires
+	maxScheduleLookahead = 5 * 366 * 24 * time.Hour
+)
+
+// cronField describes one field of a cron expression
+type cronField struct {
+	name     string
+	min, max int
+}
+
+var cronFields = []cronField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12},
+	{name: "day of week", min: 0, max: 7},
+}
+
+// cronSchedule is a parsed five-field cron expression, each field a bit set of matching values
+type cronSchedule struct {
+	minutes, hours, days, months, weekdays uint64
+	// Like cron, a day matches either restricted day field when both are restricted
+	anyDay, anyWeekday bool
+}
+
+// parseCronSchedule parses a cron expression of the form "minute hour day-of-month month day-of-week".
+// Fields accept *, values, ranges (a-b), steps (*/n, a-b/n, a/n) and comma separated lists.
+func parseCronSchedule(expr string) (*cronSchedule, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != len(cronFields) {
+		return nil, fmt.Errorf("expected %d fields (minute hour day-of-month month day-of-week), got %d", len(cronFields), len(fields))
+	}
+	sets := make([]uint64, len(fields))
+	for i, field := range fields {
+		set, err := parseCronField(field, cronFields[i])
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s field %q: %w", cronFields[i].name, field, err)
+		}
+		sets[i] = set
+	}
+	// Sunday is both 0 and 7
+	if sets[4]&(1<<7) != 0 {
+		sets[4] |= 1
+	}
+	return &cronSchedule{
+		minutes:    sets[0],
+		hours:      sets[1],
+		days:       sets[2],
+		months:     sets[3],
+		weekdays:   sets[4],
+		anyDay:     strings.HasPrefix(fields[2], "*"),
+		anyWeekday: strings.HasPrefix(fields[4], "*"),
+	}, nil
+}
+
+// parseCronField returns the bit set of the values a cron field matches
+func parseCronField(field string, bounds cronField) (uint64, error) {
+	var set uint64
+	for _, part := range strings.Split(field, ",") {
+		values, step := part, 1
+		if before, after, found := strings.Cut(part, "/"); found {
+			values = before
+			parsed, err := strconv.Atoi(after)
+			if err != nil || parsed < 1 {
+				return 0, fmt.Errorf("invalid step %q", after)
+			}
+			step = parsed
+		}
+
+		low, high := bounds.min, bounds.max
+		if values != "*" {
+			lowValue, highValue, isRange := strings.Cut(values, "-")
+			var err error
+			if low, err = strconv.Atoi(lowValue); err != nil {
+				return 0, fmt.Errorf("invalid value %q", lowValue)
+			}
+			switch {
+			case isRange:
+				if high, err = strconv.Atoi(highValue); err != nil {
+					return 0, fmt.Errorf("invalid value %q", highValue)
+				}
+			case step == 1:
+				high = low
+			}
+			if low < bounds.min || high > bounds.max || low > high {
+				return 0, fmt.Errorf("%s is outside %d-%d", values, bounds.min, bounds.max)
+			}
+		}
+		for value := low; value <= high; value += step {
+			set |= 1 << value
+		}
+	}
+	return set, nil
+}
+
+// matchesDay reports whether the schedule fires on the day of t
+func (s *cronSchedule) matchesDay(t time.Time) bool {
+	day := s.days&(1<<t.Day()) != 0
+	weekday := s.weekdays&(1<<int(t.Weekday())) != 0
+	if s.anyDay || s.anyWeekday {
+		return day && weekday
+	}
+	return day || weekday
+}
+
+// next returns the first minute after t the schedule fires, evaluated in the location of t.
+// It returns false if the schedule does not fire within maxScheduleLookahead.
+func (s *cronSchedule) next(t time.Time) (time.Time, bool) {
+	loc := t.Location()
+	t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute()+1, 0, 0, loc)
+	limit := t.Add(maxScheduleLookahead)
+	for t.Before(limit) {
+		switch {
+		case s.months&(1<<int(t.Month())) == 0:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+		case !s.matchesDay(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+		case s.hours&(1<<t.Hour()) == 0:
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+		case s.minutes&(1<<t.Minute()) == 0:
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute()+1, 0, 0, loc)
+		default:
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// parseMaintenanceWindow returns the schedule and time zone of a maintenance window
+func parseMaintenanceWindow(window *nvidiacomv1alpha1.MaintenanceWindowSpec) (*cronSchedule, *time.Location, error) {
+	schedule, err := parseCronSchedule(window.Schedule)
+	if err != nil {
+		return nil, nil, fmt.Errorf("spec.maintenanceWindow.schedule: %w", err)
+	}
+	loc := time.UTC
+	if window.TimeZone != "" {
+		if loc, err = time.LoadLocation(window.TimeZone); err != nil {
+			return nil, nil, fmt.Errorf("spec.maintenanceWindow.timeZone: %w", err)
+		}
+	}
+	return schedule, loc, nil
+}
+
+// maintenanceWindowState returns whether the maintenance window is open at now, together with
+// when it closes if it is open, or when the next one opens otherwise
+func maintenanceWindowState(window *nvidiacomv1alpha1.MaintenanceWindowSpec, now time.Time) (bool, time.Time, error) {
+	schedule, loc, err := parseMaintenanceWindow(window)
+	if err != nil {
+		return false, time.Time{}, err
+	}
+	now = now.In(loc)
+	duration := window.Duration.Duration
+	if start, ok := schedule.next(now.Add(-duration)); ok && !start.After(now) {
+		return true, start.Add(duration), nil
+	}
+	next, ok := schedule.next(now)
+	if !ok {
+		return false, time.Time{}, fmt.Errorf("spec.maintenanceWindow.schedule %q never opens a window", window.Schedule)
+	}
+	return false, next, nil
+}
+
+// validateMaintenanceWindow checks that the maintenance window can open
+func validateMaintenanceWindow(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	window := dgdr.Spec.MaintenanceWindow
+	if window == nil {
+		return nil
+	}
+	if window.Duration.Duration <= 0 {
+		return fmt.Errorf("spec.maintenanceWindow.duration must be positive, got %s", window.Duration.Duration)
+	}
+	_, _, err := maintenanceWindowState(window, time.Now())
+	return err
+}
+
+// waitForMaintenanceWindow holds a change to the DGD until the DGDR's maintenance window opens,
+// recording the wait in the WaitingForMaintenanceWindow condition. It returns nil when the change
+// may be applied now.
+func (r *DynamoGraphDeploymentRequestReconciler) waitForMaintenanceWindow(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, change string) (*ctrl.Result, error) {
+	window := dgdr.Spec.MaintenanceWindow
+	if window == nil {
+		return nil, nil
+	}
+	open, at, err := maintenanceWindowState(window, time.Now())
+	if err != nil {
+		return &ctrl.Result{}, err
+	}
+	waiting := meta.IsStatusConditionTrue(dgdr.Status.Conditions, ConditionTypeWaitingForMaintenanceWindow)
+
+	if open {
+		// The caller persists the condition with the change it applies
+		if waiting {
+			meta.SetStatusCondition(&dgdr.Status.Conditions, metav1.Condition{
+				Type:               ConditionTypeWaitingForMaintenanceWindow,
+				Status:             metav1.ConditionFalse,
+				ObservedGeneration: dgdr.Generation,
+				Reason:             ReasonMaintenanceWindowOpen,
+				Message:            fmt.Sprintf(MessageMaintenanceWindowOpen, at.Format(time.RFC3339)),
+			})
+		}
+		return nil, nil
+	}
+
+	message := fmt.Sprintf(MessageWaitingForMaintenanceWindow, at.Format(time.RFC3339), change)
+	log.FromContext(ctx).Info("Outside the maintenance window, holding the deployment change",
+		"change", change, "windowOpens", at)
+	if !waiting {
+		r.Recorder.Event(dgdr, corev1.EventTypeNormal, EventReasonWaitingForMaintenanceWindow, message)
+	}
+	meta.SetStatusCondition(&dgdr.Status.Conditions, metav1.Condition{
+		Type:               ConditionTypeWaitingForMaintenanceWindow,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: dgdr.Generation,
+		Reason:             ReasonOutsideMaintenanceWindow,
+		Message:            message,
+	})
+	if err := r.updateStatus(ctx, dgdr); err != nil {
+		return &ctrl.Result{}, err
+	}
+	return &ctrl.Result{RequeueAfter: time.Until(at)}, nil
+}
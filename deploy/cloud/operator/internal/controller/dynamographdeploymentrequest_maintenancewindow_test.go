@@ -0,0 +1,177 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var _ = Describe("DGDR Maintenance Window", func() {
+	var reconciler *DynamoGraphDeploymentRequestReconciler
+
+	BeforeEach(func() {
+		reconciler = &DynamoGraphDeploymentRequestReconciler{
+			Client:      k8sClient,
+			Recorder:    record.NewFakeRecorder(100),
+			RBACManager: &MockRBACManager{},
+		}
+	})
+
+	window := func(schedule string, duration time.Duration, timeZone string) *nvidiacomv1alpha1.MaintenanceWindowSpec {
+		return &nvidiacomv1alpha1.MaintenanceWindowSpec{
+			Schedule: schedule,
+			Duration: metav1.Duration{Duration: duration},
+			TimeZone: timeZone,
+		}
+	}
+
+	It("Should parse cron schedules", func() {
+		schedule, err := parseCronSchedule("0,30 2-4 * * 6")
+		Expect(err).NotTo(HaveOccurred())
+		saturday := time.Date(2025, 6, 7, 1, 15, 0, 0, time.UTC)
+		next, ok := schedule.next(saturday)
+		Expect(ok).Should(BeTrue())
+		Expect(next).Should(Equal(time.Date(2025, 6, 7, 2, 0, 0, 0, time.UTC)))
+		next, _ = schedule.next(next)
+		Expect(next).Should(Equal(time.Date(2025, 6, 7, 2, 30, 0, 0, time.UTC)))
+		next, _ = schedule.next(time.Date(2025, 6, 7, 4, 30, 0, 0, time.UTC))
+		Expect(next).Should(Equal(time.Date(2025, 6, 14, 2, 0, 0, 0, time.UTC)))
+
+		// Sunday is also 7, and */n steps from the start of the range
+		schedule, err = parseCronSchedule("*/20 0 * * 7")
+		Expect(err).NotTo(HaveOccurred())
+		next, _ = schedule.next(time.Date(2025, 6, 8, 0, 25, 0, 0, time.UTC))
+		Expect(next).Should(Equal(time.Date(2025, 6, 8, 0, 40, 0, 0, time.UTC)))
+
+		// Restricted day of month and day of week match either
+		schedule, err = parseCronSchedule("0 0 1 * 1")
+		Expect(err).NotTo(HaveOccurred())
+		next, _ = schedule.next(time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC))
+		Expect(next).Should(Equal(time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC)))
+
+		for _, invalid := range []string{"* * * *", "60 * * * *", "* * 0 * *", "5-1 * * * *", "*/0 * * * *", "a * * * *"} {
+			_, err := parseCronSchedule(invalid)
+			Expect(err).To(HaveOccurred(), invalid)
+		}
+	})
+
+	It("Should tell whether the window is open in its time zone", func() {
+		berlin, err := time.LoadLocation("Europe/Berlin")
+		Expect(err).NotTo(HaveOccurred())
+		spec := window("0 2 * * 6", 4*time.Hour, "Europe/Berlin")
+
+		open, closes, err := maintenanceWindowState(spec, time.Date(2025, 6, 7, 3, 0, 0, 0, berlin))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(open).Should(BeTrue())
+		Expect(closes.Equal(time.Date(2025, 6, 7, 6, 0, 0, 0, berlin))).Should(BeTrue())
+
+		// 06:00 in Berlin is 04:00 UTC
+		open, opens, err := maintenanceWindowState(spec, time.Date(2025, 6, 7, 4, 0, 0, 0, time.UTC))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(open).Should(BeFalse())
+		Expect(opens.Equal(time.Date(2025, 6, 14, 2, 0, 0, 0, berlin))).Should(BeTrue())
+	})
+
+	It("Should reject invalid maintenance windows", func() {
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{}
+		Expect(validateMaintenanceWindow(dgdr)).Should(Succeed())
+
+		dgdr.Spec.MaintenanceWindow = window("0 2 * * 6", time.Hour, "Mars/Olympus")
+		Expect(validateMaintenanceWindow(dgdr)).To(MatchError(ContainSubstring("spec.maintenanceWindow.timeZone")))
+		dgdr.Spec.MaintenanceWindow = window("0 2 30 2 *", time.Hour, "")
+		Expect(validateMaintenanceWindow(dgdr)).To(MatchError(ContainSubstring("never opens a window")))
+		dgdr.Spec.MaintenanceWindow = window("0 2 * * 6", 0, "")
+		Expect(validateMaintenanceWindow(dgdr)).To(MatchError(ContainSubstring("must be positive")))
+	})
+
+	It("Should hold the DGD until the window opens", func() {
+		ctx := context.Background()
+
+		// Windows open for a minute, half an hour from now
+		opens := time.Now().UTC().Add(30 * time.Minute)
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-dgdr-maintenance", Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model:   "test-model",
+				Backend: BackendVLLM,
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					ProfilerImage: "test-profiler:latest",
+				},
+				AutoApply:         true,
+				MaintenanceWindow: window(fmt.Sprintf("%d * * * *", opens.Minute()), time.Minute, ""),
+			},
+		}
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, dgdr) }()
+		dgdr.Status.State = StateDeploying
+		dgdr.Status.ObservedGeneration = dgdr.Generation
+		dgdr.Status.GeneratedDeployment = &runtime.RawExtension{
+			Raw: []byte(`{"apiVersion":"nvidia.com/v1alpha1","kind":"DynamoGraphDeployment","metadata":{"name":"test-dgdr-maintenance-dgd"},"spec":{"services":{"Frontend":{}}}}`),
+		}
+		Expect(k8sClient.Status().Update(ctx, dgdr)).Should(Succeed())
+
+		request := reconcile.Request{NamespacedName: types.NamespacedName{Name: dgdr.Name, Namespace: defaultNamespace}}
+		result, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).Should(BeNumerically(">", 28*time.Minute))
+		Expect(result.RequeueAfter).Should(BeNumerically("<=", 30*time.Minute))
+
+		updated := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{}
+		Expect(k8sClient.Get(ctx, request.NamespacedName, updated)).Should(Succeed())
+		Expect(updated.Status.State).Should(Equal(StateDeploying))
+		Expect(updated.Status.Deployment).Should(BeNil())
+		condition := meta.FindStatusCondition(updated.Status.Conditions, ConditionTypeWaitingForMaintenanceWindow)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).Should(Equal(metav1.ConditionTrue))
+		Expect(condition.Reason).Should(Equal(ReasonOutsideMaintenanceWindow))
+
+		dgd := &nvidiacomv1alpha1.DynamoGraphDeployment{}
+		dgdKey := types.NamespacedName{Name: "test-dgdr-maintenance-dgd", Namespace: defaultNamespace}
+		Expect(apierrors.IsNotFound(k8sClient.Get(ctx, dgdKey, dgd))).Should(BeTrue())
+
+		// Once the window is open the DGD is created
+		updated.Spec.MaintenanceWindow = window("* * * * *", time.Hour, "")
+		Expect(k8sClient.Update(ctx, updated)).Should(Succeed())
+		updated.Status.ObservedGeneration = updated.Generation
+		Expect(k8sClient.Status().Update(ctx, updated)).Should(Succeed())
+
+		_, err = reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(k8sClient.Get(ctx, dgdKey, dgd)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, dgd) }()
+
+		Expect(k8sClient.Get(ctx, request.NamespacedName, updated)).Should(Succeed())
+		Expect(updated.Status.Deployment.Created).Should(BeTrue())
+		condition = meta.FindStatusCondition(updated.Status.Conditions, ConditionTypeWaitingForMaintenanceWindow)
+		Expect(condition.Status).Should(Equal(metav1.ConditionFalse))
+		Expect(condition.Reason).Should(Equal(ReasonMaintenanceWindowOpen))
+	})
+})
@@ -0,0 +1,118 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/metrics"
+)
+
+const (
+	// resultsFetchAttempts is how often results that are not visible yet are fetched, e.g. because
+	// the informer cache has not caught up with the ConfigMap written just before the Job completed
+	resultsFetchAttempts = 3
+
+	// resultsFetchRetryDelay is the delay before the first retry, doubled for every further retry
+	resultsFetchRetryDelay = 250 * time.Millisecond
+)
+
+// resultsFetchRetries counts, by DGDR UID, the fetches that found the results missing so far
+var resultsFetchRetries sync.Map
+
+// resultsPendingError means that the results are missing but will be fetched again after a delay
+type resultsPendingError struct {
+	after time.Duration
+	err   error
+}
+
+func (e *resultsPendingError) Error() string {
+	return fmt.Sprintf("%v, fetching again in %s", e.err, e.after)
+}
+
+func (e *resultsPendingError) Unwrap() error {
+	return e.err
+}
+
+// resultsPendingDelay returns how long to wait before fetching missing results again, false if err
+// does not mean the results are pending
+func resultsPendingDelay(err error) (time.Duration, bool) {
+	var pending *resultsPendingError
+	if errors.As(err, &pending) {
+		return pending.after, true
+	}
+	return 0, false
+}
+
+// fetchResults fetches the profiling results. Results that are missing are reported as a
+// resultsPendingError until resultsFetchAttempts fetches missed them, so the DGDR is requeued
+// instead of blocking the worker.
+func (r *DynamoGraphDeploymentRequestReconciler) fetchResults(ctx context.Context, transport ResultTransport, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) (map[string]string, error) {
+	results, err := transport.Fetch(ctx, dgdr)
+	retries := 0
+	if value, ok := resultsFetchRetries.Load(dgdr.UID); ok {
+		retries = value.(int)
+	}
+	if isResultsMissing(err) && retries+1 < resultsFetchAttempts {
+		resultsFetchRetries.Store(dgdr.UID, retries+1)
+		return nil, &resultsPendingError{after: resultsFetchRetryDelay << retries, err: err}
+	}
+	resultsFetchRetries.Delete(dgdr.UID)
+	metrics.DGDRResultsFetchRetries.WithLabelValues(dgdr.Namespace).Observe(float64(retries))
+	if err != nil {
+		return nil, err
+	}
+	r.FaultInjector.corruptOutput(ctx, results, getProfilingOutputKey(dgdr))
+	return results, nil
+}
+
+// observeSpecGenerated records how long the generated spec took after the profiling Job completed
+// and how large it is
+func (r *DynamoGraphDeploymentRequestReconciler) observeSpecGenerated(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) {
+	if generated := dgdr.Status.GeneratedDeployment; generated != nil {
+		raw := generated.Raw
+		if generated.Object != nil {
+			var err error
+			if raw, err = json.Marshal(generated.Object); err != nil {
+				log.FromContext(ctx).Error(err, "Failed to measure the generated spec")
+			}
+		}
+		if len(raw) > 0 {
+			metrics.DGDRGeneratedSpecBytes.WithLabelValues(dgdr.Namespace).Observe(float64(len(raw)))
+		}
+	}
+
+	// The mock profiler and the HTTP transport do not wait for a completed Job
+	job := &batchv1.Job{}
+	if err := r.Get(ctx, types.NamespacedName{Name: GetProfilingJobName(dgdr), Namespace: dgdr.Namespace}, job); err != nil {
+		return
+	}
+	if job.Status.CompletionTime != nil {
+		metrics.DGDRSpecGenerationSeconds.WithLabelValues(dgdr.Namespace).Observe(time.Since(job.Status.CompletionTime.Time).Seconds())
+	}
+}
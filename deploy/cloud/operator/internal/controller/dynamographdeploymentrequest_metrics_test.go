@@ -0,0 +1,161 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/metrics"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// flakyTransport reports the results as missing for the first misses fetches
+type flakyTransport struct {
+	ResultTransport
+	misses  int
+	fetches int
+}
+
+func (t *flakyTransport) Fetch(_ context.Context, _ *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) (map[string]string, error) {
+	t.fetches++
+	if t.fetches <= t.misses {
+		return nil, withFailureReason(nvidiacomv1alpha1.FailureReasonResultsMissing, errResultsNotDelivered)
+	}
+	return map[string]string{ProfilingOutputFile: "kind: DynamoGraphDeployment\n"}, nil
+}
+
+var errResultsNotDelivered = errors.New("results not delivered")
+
+var _ = Describe("DGDR Metrics", func() {
+	histogram := func(observer prometheus.Observer) *dto.Histogram {
+		metric := &dto.Metric{}
+		Expect(observer.(prometheus.Metric).Write(metric)).Should(Succeed())
+		return metric.GetHistogram()
+	}
+
+	newDGDR := func(namespace string) *nvidiacomv1alpha1.DynamoGraphDeploymentRequest {
+		return &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-dgdr-metrics", Namespace: namespace},
+		}
+	}
+
+	It("Should requeue instead of waiting for results that are not visible yet", func() {
+		reconciler := &DynamoGraphDeploymentRequestReconciler{Client: k8sClient, Recorder: record.NewFakeRecorder(100)}
+		dgdr := newDGDR("test-metrics-fetch")
+		dgdr.UID = "test-metrics-fetch-uid"
+
+		transport := &flakyTransport{misses: 1}
+		_, err := reconciler.fetchResults(context.Background(), transport, dgdr)
+		after, pending := resultsPendingDelay(err)
+		Expect(pending).To(BeTrue())
+		Expect(after).Should(Equal(resultsFetchRetryDelay))
+		results, err := reconciler.fetchResults(context.Background(), transport, dgdr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(results).Should(HaveKey(ProfilingOutputFile))
+		retries := histogram(metrics.DGDRResultsFetchRetries.WithLabelValues(dgdr.Namespace))
+		Expect(retries.GetSampleCount()).Should(Equal(uint64(1)))
+		Expect(retries.GetSampleSum()).Should(Equal(1.0))
+
+		// Results still missing after the last attempt fail the DGDR
+		transport = &flakyTransport{misses: 10}
+		for attempt := 1; attempt < resultsFetchAttempts; attempt++ {
+			_, err = reconciler.fetchResults(context.Background(), transport, dgdr)
+			after, pending = resultsPendingDelay(err)
+			Expect(pending).To(BeTrue())
+			Expect(after).Should(Equal(resultsFetchRetryDelay << (attempt - 1)))
+		}
+		_, err = reconciler.fetchResults(context.Background(), transport, dgdr)
+		_, pending = resultsPendingDelay(err)
+		Expect(pending).To(BeFalse())
+		Expect(isResultsMissing(err)).To(BeTrue())
+		Expect(transport.fetches).Should(Equal(resultsFetchAttempts))
+		retries = histogram(metrics.DGDRResultsFetchRetries.WithLabelValues(dgdr.Namespace))
+		Expect(retries.GetSampleCount()).Should(Equal(uint64(2)))
+		Expect(retries.GetSampleSum()).Should(Equal(float64(1 + resultsFetchAttempts - 1)))
+	})
+
+	It("Should count a failure once its transition to Failed is persisted", func() {
+		ctx := context.Background()
+		dgdr := newDGDR("test-metrics-failures")
+		failures := func() float64 {
+			metric := &dto.Metric{}
+			counter := metrics.DGDRFailuresTotal.WithLabelValues(dgdr.Namespace, string(nvidiacomv1alpha1.FailureReasonValidationError))
+			Expect(counter.Write(metric)).Should(Succeed())
+			return metric.GetCounter().GetValue()
+		}
+		fail := func(reconciler *DynamoGraphDeploymentRequestReconciler) error {
+			_, err := reconciler.updateStateToFailed(ctx, dgdr, nvidiacomv1alpha1.FailureReasonValidationError,
+				ConditionTypeValidation, EventReasonValidationFailed, "invalid spec")
+			return err
+		}
+
+		// The status update of a DGDR that is gone fails and is not counted
+		reconciler := &DynamoGraphDeploymentRequestReconciler{
+			Client:   fake.NewClientBuilder().WithScheme(k8sClient.Scheme()).WithStatusSubresource(dgdr).Build(),
+			Recorder: record.NewFakeRecorder(100),
+		}
+		Expect(fail(reconciler)).ShouldNot(Succeed())
+		Expect(failures()).Should(Equal(0.0))
+
+		dgdr.Status.State = StateProfiling
+		reconciler.Client = fake.NewClientBuilder().WithScheme(k8sClient.Scheme()).WithObjects(dgdr).WithStatusSubresource(dgdr).Build()
+		Expect(fail(reconciler)).Should(Succeed())
+		Expect(dgdr.Status.State).Should(Equal(StateFailed))
+		Expect(failures()).Should(Equal(1.0))
+
+		// Failing a DGDR that already failed is not another failure
+		Expect(fail(reconciler)).Should(Succeed())
+		Expect(failures()).Should(Equal(1.0))
+	})
+
+	It("Should observe the generated spec size and the latency after the Job completed", func() {
+		dgdr := newDGDR("test-metrics-generated")
+		dgdr.Status.GeneratedDeployment = &runtime.RawExtension{Object: &nvidiacomv1alpha1.DynamoGraphDeployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-dgd"},
+		}}
+		completed := metav1.NewTime(time.Now().Add(-5 * time.Second))
+		job := &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{Name: GetProfilingJobName(dgdr), Namespace: dgdr.Namespace},
+			Status:     batchv1.JobStatus{CompletionTime: &completed},
+		}
+		reconciler := &DynamoGraphDeploymentRequestReconciler{
+			Client:   fake.NewClientBuilder().WithScheme(k8sClient.Scheme()).WithObjects(job).Build(),
+			Recorder: record.NewFakeRecorder(100),
+		}
+
+		reconciler.observeSpecGenerated(context.Background(), dgdr)
+
+		size := histogram(metrics.DGDRGeneratedSpecBytes.WithLabelValues(dgdr.Namespace))
+		Expect(size.GetSampleCount()).Should(Equal(uint64(1)))
+		Expect(size.GetSampleSum()).Should(BeNumerically(">", 0))
+		latency := histogram(metrics.DGDRSpecGenerationSeconds.WithLabelValues(dgdr.Namespace))
+		Expect(latency.GetSampleCount()).Should(Equal(uint64(1)))
+		Expect(latency.GetSampleSum()).Should(BeNumerically(">=", 5))
+	})
+})
@@ -0,0 +1,221 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"text/template"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/yaml"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+)
+
+const (
+	// Profiler modes
+	ProfilerModeJob  = "job"
+	ProfilerModeMock = "mock"
+
+	// AnnotationPlaceholder marks a generated deployment that was not produced by a profiler
+	AnnotationPlaceholder = "nvidia.com/dgdr-placeholder"
+
+	// Event reasons
+	EventReasonMockProfilingCompleted = "MockProfilingCompleted"
+
+	// Messages
+	MessageMockProfilingCompleted = "Profiler mode is mock, synthesized profiling output without running a profiling job"
+	MessagePlaceholderSpec        = "The generated deployment is a placeholder rendered from a template, not a profiled configuration"
+)
+
+// defaultPlaceholderTemplates are the per-backend deployments synthesized by the mock profiler.
+// Templates receive the DGDR name, backend, image, model and workload type.
+var defaultPlaceholderTemplates = map[string]string{
+	BackendVLLM:   defaultPlaceholderTemplate("VllmDecodeWorker", "dynamo.vllm", "--model"),
+	BackendSGLang: defaultPlaceholderTemplate("decode", "dynamo.sglang", "--model-path"),
+	BackendTRTLLM: defaultPlaceholderTemplate("TRTLLMDecodeWorker", "dynamo.trtllm", "--model-path"),
+}
+
+// defaultPlaceholderTemplate returns a single-worker aggregated deployment template for a backend
+func defaultPlaceholderTemplate(worker, module, modelArg string) string {
+	return `apiVersion: nvidia.com/v1alpha1
+kind: DynamoGraphDeployment
+metadata:
+  name: {{.Name}}
+  annotations:
+    ` + AnnotationPlaceholder + `: "true"
+spec:
+  backendFramework: {{.Backend}}
+  services:
+    Frontend:
+      componentType: frontend
+      replicas: 1
+      extraPodSpec:
+        mainContainer:
+          image: {{printf "%q" .Image}}
+    ` + worker + `:
+      componentType: worker
+{{- if eq .WorkloadType "llm"}}
+      subComponentType: decode
+{{- end}}
+      replicas: 1
+      resources:
+        limits:
+          gpu: "1"
+      extraPodSpec:
+        mainContainer:
+          image: {{printf "%q" .Image}}
+          command:
+          - python3
+          - -m
+          - ` + module + `
+          args:
+          - ` + modelArg + `
+          - {{printf "%q" .Model}}
+`
+}
+
+// LoadPlaceholderTemplates reads the per-backend placeholder templates from dir, one
+// <backend>.yaml file per backend. Backends without a file keep the built-in template.
+func LoadPlaceholderTemplates(dir string) (map[string]string, error) {
+	templates := map[string]string{}
+	for _, backend := range defaultCandidateBackends {
+		content, err := os.ReadFile(filepath.Join(dir, backend+".yaml"))
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if _, err := template.New(backend).Parse(string(content)); err != nil {
+			return nil, fmt.Errorf("invalid placeholder template for %s: %w", backend, err)
+		}
+		templates[backend] = string(content)
+	}
+	return templates, nil
+}
+
+// isMockProfiling reports whether profiling jobs are replaced by synthesized results
+func (r *DynamoGraphDeploymentRequestReconciler) isMockProfiling() bool {
+	return r.ProfilerMode == ProfilerModeMock
+}
+
+// placeholderTemplate returns the configured placeholder template of a backend
+func (r *DynamoGraphDeploymentRequestReconciler) placeholderTemplate(backend string) string {
+	if tmpl, ok := r.PlaceholderTemplates[backend]; ok {
+		return tmpl
+	}
+	return defaultPlaceholderTemplates[backend]
+}
+
+// renderPlaceholderDGD renders the placeholder deployment of a backend. It only depends on the
+// DGDR spec, so repeated runs produce the same deployment.
+func (r *DynamoGraphDeploymentRequestReconciler) renderPlaceholderDGD(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, backend string) (string, error) {
+	image := dgdr.Spec.ProfilingConfig.ProfilerImage
+	if dgdr.Spec.DeploymentOverrides != nil && dgdr.Spec.DeploymentOverrides.WorkersImage != "" {
+		image = dgdr.Spec.DeploymentOverrides.WorkersImage
+	}
+
+	tmpl, err := template.New(backend).Parse(r.placeholderTemplate(backend))
+	if err != nil {
+		return "", fmt.Errorf("failed to parse placeholder template for %s: %w", backend, err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, map[string]string{
+		"Name":         dgdr.Name,
+		"Backend":      backend,
+		"Image":        image,
+		"Model":        modelName(dgdr),
+		"WorkloadType": string(getWorkloadType(dgdr)),
+	}); err != nil {
+		return "", fmt.Errorf("failed to execute placeholder template for %s: %w", backend, err)
+	}
+	return buf.String(), nil
+}
+
+// mockProfilingOutput returns the ConfigMap data the profiling sidecar would have written.
+// For backend auto every candidate is feasible and the candidates rank in preference order.
+func (r *DynamoGraphDeploymentRequestReconciler) mockProfilingOutput(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) (map[string]string, error) {
+	data := map[string]string{}
+	if dgdr.Spec.Backend != BackendAuto {
+		dgd, err := r.renderPlaceholderDGD(dgdr, dgdr.Spec.Backend)
+		if err != nil {
+			return nil, err
+		}
+		outputKey := getProfilingOutputKey(dgdr)
+		content, err := encodeProfilerOutput(outputKey, []byte(dgd))
+		if err != nil {
+			return nil, err
+		}
+		data[outputKey] = string(content)
+		return data, nil
+	}
+
+	candidates := candidateBackends(dgdr)
+	results := make([]backendResult, 0, len(candidates))
+	for i, backend := range candidates {
+		dgd, err := r.renderPlaceholderDGD(dgdr, backend)
+		if err != nil {
+			return nil, err
+		}
+		data[getBackendOutputFile(backend)] = dgd
+		results = append(results, backendResult{
+			Backend:          backend,
+			Feasible:         true,
+			TTFT:             100,
+			ITL:              10,
+			ThroughputPerGPU: float64(100 * (len(candidates) - i)),
+		})
+	}
+	comparison, err := yaml.Marshal(results)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal mock backend comparison: %w", err)
+	}
+	data[ProfilingComparisonFile] = string(comparison)
+	return data, nil
+}
+
+// writeMockProfilingOutput delivers synthesized profiling results with the DGDR's result transport
+// in place of the profiling job, so the rest of the DGDR lifecycle runs unchanged
+func (r *DynamoGraphDeploymentRequestReconciler) writeMockProfilingOutput(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	data, err := r.mockProfilingOutput(dgdr)
+	if err != nil {
+		return err
+	}
+
+	transport := r.resultTransport(dgdr)
+	if err := transport.Delete(ctx, dgdr); err != nil {
+		return err
+	}
+	untrackOwnedResources(dgdr, nvidiacomv1alpha1.OwnedResourceRoleOutput)
+	if err := transport.Store(ctx, dgdr, data); err != nil {
+		return fmt.Errorf("failed to write mock profiling output: %w", err)
+	}
+
+	log.FromContext(ctx).Info("Wrote mock profiling output", "results", transport.Reference(dgdr))
+	r.Recorder.Event(dgdr, corev1.EventTypeNormal, EventReasonMockProfilingCompleted, MessageMockProfilingCompleted)
+	setWarning(dgdr, WarningPlaceholderSpec, MessagePlaceholderSpec)
+	return nil
+}
@@ -0,0 +1,139 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/yaml"
+)
+
+var _ = Describe("DGDR Mock Profiler", func() {
+	var reconciler *DynamoGraphDeploymentRequestReconciler
+
+	BeforeEach(func() {
+		reconciler = &DynamoGraphDeploymentRequestReconciler{
+			Client:       k8sClient,
+			Recorder:     record.NewFakeRecorder(100),
+			RBACManager:  &MockRBACManager{},
+			ProfilerMode: ProfilerModeMock,
+		}
+	})
+
+	newDGDR := func(name, backend string) *nvidiacomv1alpha1.DynamoGraphDeploymentRequest {
+		return &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model:   "Qwen/Qwen3-0.6B",
+				Backend: backend,
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					ProfilerImage: "test-profiler:latest",
+					Config: createTestConfig(map[string]interface{}{
+						"sla":   map[string]interface{}{"ttft": 200.0, "itl": 20.0},
+						"sweep": map[string]interface{}{"use_ai_configurator": true},
+					}),
+				},
+			},
+		}
+	}
+
+	It("Should generate a deployment without a profiling job", func() {
+		ctx := context.Background()
+		dgdr := newDGDR("test-dgdr-mock", BackendVLLM)
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, dgdr) }()
+
+		request := reconcile.Request{NamespacedName: types.NamespacedName{Name: dgdr.Name, Namespace: defaultNamespace}}
+		for range 3 {
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		updated := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{}
+		Expect(k8sClient.Get(ctx, request.NamespacedName, updated)).Should(Succeed())
+		Expect(updated.Status.State).Should(Equal(StateReady))
+		Expect(updated.Status.GeneratedDeployment).NotTo(BeNil())
+
+		dgd := &nvidiacomv1alpha1.DynamoGraphDeployment{}
+		Expect(yaml.Unmarshal(updated.Status.GeneratedDeployment.Raw, dgd)).Should(Succeed())
+		Expect(dgd.Name).Should(Equal(dgdr.Name))
+		Expect(dgd.Spec.BackendFramework).Should(Equal(BackendVLLM))
+		Expect(dgd.Annotations).Should(HaveKeyWithValue(AnnotationPlaceholder, "true"))
+		Expect(dgd.Spec.Services["VllmDecodeWorker"].ExtraPodSpec.MainContainer.Args).Should(Equal([]string{"--model", "Qwen/Qwen3-0.6B"}))
+		Expect(updated.Status.Warnings).Should(ContainElement(HaveField("Type", WarningPlaceholderSpec)))
+
+		err := k8sClient.Get(ctx, types.NamespacedName{Name: GetProfilingJobName(dgdr), Namespace: defaultNamespace}, &batchv1.Job{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		_ = k8sClient.Delete(ctx, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: GetOutputConfigMapName(dgdr), Namespace: defaultNamespace}})
+	})
+
+	It("Should synthesize a backend comparison for backend auto", func() {
+		dgdr := newDGDR("test-dgdr-mock-auto", BackendAuto)
+		dgdr.Spec.BackendPreference = []nvidiacomv1alpha1.CandidateBackend{BackendSGLang, BackendVLLM}
+
+		data, err := reconciler.mockProfilingOutput(dgdr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(data).To(HaveKey(getBackendOutputFile(BackendSGLang)))
+		Expect(data).To(HaveKey(getBackendOutputFile(BackendVLLM)))
+
+		outputKey, err := applyBackendComparison(dgdr, data)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(outputKey).Should(Equal(getBackendOutputFile(BackendSGLang)))
+
+		again, err := reconciler.mockProfilingOutput(dgdr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(again).Should(Equal(data))
+	})
+
+	It("Should render placeholder templates from the operator configuration", func() {
+		dir := GinkgoT().TempDir()
+		custom := "apiVersion: nvidia.com/v1alpha1\nkind: DynamoGraphDeployment\nmetadata:\n  name: {{.Name}}-custom\n"
+		Expect(os.WriteFile(filepath.Join(dir, BackendSGLang+".yaml"), []byte(custom), 0o600)).Should(Succeed())
+
+		templates, err := LoadPlaceholderTemplates(dir)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(templates).Should(HaveLen(1))
+		reconciler.PlaceholderTemplates = templates
+
+		dgdr := newDGDR("test-dgdr-custom", BackendSGLang)
+		rendered, err := reconciler.renderPlaceholderDGD(dgdr, BackendSGLang)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rendered).Should(ContainSubstring("name: test-dgdr-custom-custom"))
+
+		// Backends without a custom template keep the built-in one
+		rendered, err = reconciler.renderPlaceholderDGD(dgdr, BackendTRTLLM)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rendered).Should(ContainSubstring("TRTLLMDecodeWorker"))
+
+		Expect(os.WriteFile(filepath.Join(dir, BackendVLLM+".yaml"), []byte("{{.Name"), 0o600)).Should(Succeed())
+		_, err = LoadPlaceholderTemplates(dir)
+		Expect(err).To(HaveOccurred())
+	})
+})
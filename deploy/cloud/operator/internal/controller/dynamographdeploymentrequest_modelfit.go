@@ -0,0 +1,125 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+)
+
+const (
+	// Validation messages
+	ValidationErrorInsufficientGPUMemory = "model %s (%s) needs at least %gGiB of GPU memory per engine, but hardware.max_num_gpus_per_engine %d of %s provides %gGiB"
+	ValidationErrorModelDoesNotFit       = "model %s cannot fit on requested hardware at any parallelism ≤ %d GPUs: its weights (about %gB parameters in %s) take %.0fGiB, %d %s GPUs provide %.0fGiB"
+
+	// gib is the bytes of a GiB
+	gib = 1 << 30
+)
+
+// weightDTypes are the weight data types recognized in model names, with their bytes per
+// parameter. Quantized checkpoints name their format; unnamed ones are assumed to be 16-bit.
+var weightDTypes = []struct {
+	name    string
+	pattern *regexp.Regexp
+	bytes   float64
+}{
+	{name: "4-bit", pattern: regexp.MustCompile(`(?i)(?:^|[^a-z0-9])(nvfp4|mxfp4|fp4|int4|awq|gptq|w4a16|w4a8|4bit)(?:$|[^a-z0-9])`), bytes: 0.5},
+	{name: "8-bit", pattern: regexp.MustCompile(`(?i)(?:^|[^a-z0-9])(fp8|int8|w8a8|w8a16|8bit)(?:$|[^a-z0-9])`), bytes: 1},
+}
+
+// migMemoryPattern matches the memory of a MIG profile resource such as nvidia.com/mig-3g.40gb
+var migMemoryPattern = regexp.MustCompile(`mig-\d+g\.(\d+)gb$`)
+
+// modelWeightDType returns the data type of the weights of a model, as named by the model, and
+// its bytes per parameter
+func modelWeightDType(model string) (string, float64) {
+	for _, dtype := range weightDTypes {
+		if dtype.pattern.MatchString(model) {
+			return dtype.name, dtype.bytes
+		}
+	}
+	return "16-bit", 2
+}
+
+// gpuMemoryGiB returns the memory per GPU of the DGDR and the name of the GPU, from the MIG profile
+// GPUs are requested as or the sweep.aic_system of the profiling config. It returns 0 if unknown.
+func gpuMemoryGiB(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, matrix *CompatibilityMatrix, config map[string]interface{}) (float64, string) {
+	resourceName := string(gpuResourceName(dgdr))
+	if match := migMemoryPattern.FindStringSubmatch(resourceName); match != nil {
+		memory, _ := strconv.ParseFloat(match[1], 64)
+		return memory, resourceName
+	}
+	if matrix == nil {
+		return 0, ""
+	}
+	sweep, _ := config["sweep"].(map[string]interface{})
+	system, _ := sweep["aic_system"].(string)
+	return matrix.Systems[system], system
+}
+
+// validateModelFit fails DGDRs whose model cannot fit the GPUs the profiling config allows per
+// engine at any parallelism, before a profiling job is spent on finding out. The memory the model
+// needs is the compatibility matrix's minGPUMemoryGiB if it lists one, else the size of its weights
+// estimated from the parameter count and data type in its name. Only the weights are counted, so
+// models that pass may still need more GPUs for their KV cache. Nothing is checked if the model
+// size, the memory per GPU or hardware.max_num_gpus_per_engine is unknown.
+func (r *DynamoGraphDeploymentRequestReconciler) validateModelFit(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	if isCPUOnly(dgdr) || dgdr.Spec.ProfilingConfig.Config == nil {
+		return nil
+	}
+	config := parseProfilingConfig(dgdr)
+	hardware, _ := config["hardware"].(map[string]interface{})
+	maxGPUs, _ := hardware[ConfigKeyMaxGPUsPerEngine].(float64)
+	if maxGPUs <= 0 {
+		return nil
+	}
+	var matrix *CompatibilityMatrix
+	if r.CompatibilityMatrix != nil {
+		matrix = r.CompatibilityMatrix.Get()
+	}
+	memory, gpu := gpuMemoryGiB(dgdr, matrix, config)
+	if memory == 0 {
+		return nil
+	}
+	available := maxGPUs * memory
+	model := modelName(dgdr)
+
+	if matrix != nil {
+		if entry := matrix.lookup(model); entry != nil && entry.MinGPUMemoryGiB > 0 {
+			if available < entry.MinGPUMemoryGiB {
+				return withFailureReason(nvidiacomv1alpha1.FailureReasonInsufficientGPUMemory, fmt.Errorf(ValidationErrorInsufficientGPUMemory,
+					model, entry.Architecture, entry.MinGPUMemoryGiB, int(maxGPUs), gpu, available))
+			}
+			return nil
+		}
+	}
+
+	paramsB := modelParamsB(model)
+	if paramsB == 0 {
+		return nil
+	}
+	dtype, bytes := modelWeightDType(model)
+	if weights := paramsB * 1e9 * bytes / gib; available < weights {
+		return withFailureReason(nvidiacomv1alpha1.FailureReasonInsufficientGPUMemory, fmt.Errorf(ValidationErrorModelDoesNotFit,
+			model, int(maxGPUs), paramsB, dtype, weights, int(maxGPUs), gpu, available))
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("DGDR Model Fit", func() {
+	var reconciler *DynamoGraphDeploymentRequestReconciler
+
+	BeforeEach(func() {
+		matrix, err := ParseCompatibilityMatrix([]byte("systems:\n  h100_sxm: 80\n  l40s: 48\nmodels: []\n"))
+		Expect(err).NotTo(HaveOccurred())
+		store := &CompatibilityMatrixStore{}
+		store.set(matrix, nil, "test")
+		reconciler = &DynamoGraphDeploymentRequestReconciler{CompatibilityMatrix: store}
+	})
+
+	newDGDR := func(model, system string, maxGPUs float64) *nvidiacomv1alpha1.DynamoGraphDeploymentRequest {
+		return &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-dgdr-model-fit", Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model:   model,
+				Backend: BackendVLLM,
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					Config: createTestConfig(map[string]interface{}{
+						"hardware": map[string]interface{}{"max_num_gpus_per_engine": maxGPUs},
+						"sweep":    map[string]interface{}{"aic_system": system},
+					}),
+				},
+			},
+		}
+	}
+
+	It("Should recognize the data type of the weights in model names", func() {
+		for model, dtype := range map[string]string{
+			"meta-llama/Llama-3.1-70B-Instruct":     "16-bit",
+			"nvidia/Llama-3.1-70B-Instruct-FP8":     "8-bit",
+			"nvidia/DeepSeek-R1-NVFP4":              "4-bit",
+			"hugging-quants/Llama-3.1-70B-AWQ-INT4": "4-bit",
+			"example/fp8finetuned-7B":               "16-bit",
+		} {
+			name, _ := modelWeightDType(model)
+			Expect(name).Should(Equal(dtype), model)
+		}
+	})
+
+	It("Should fail models whose weights do not fit at any parallelism", func() {
+		// 70B 16-bit weights take about 130GiB
+		err := reconciler.validateModelFit(newDGDR("meta-llama/Llama-3.1-70B-Instruct", "l40s", 2))
+		Expect(err).To(MatchError("model meta-llama/Llama-3.1-70B-Instruct cannot fit on requested hardware at any parallelism ≤ 2 GPUs: " +
+			"its weights (about 70B parameters in 16-bit) take 130GiB, 2 l40s GPUs provide 96GiB"))
+		Expect(failureReasonFromError(err, "")).Should(Equal(nvidiacomv1alpha1.FailureReasonInsufficientGPUMemory))
+
+		Expect(reconciler.validateModelFit(newDGDR("meta-llama/Llama-3.1-70B-Instruct", "l40s", 4))).Should(Succeed())
+		Expect(reconciler.validateModelFit(newDGDR("nvidia/Llama-3.1-70B-Instruct-FP8", "l40s", 2))).Should(Succeed())
+		Expect(reconciler.validateModelFit(newDGDR("meta-llama/Llama-3.1-70B-Instruct", "h100_sxm", 2))).Should(Succeed())
+	})
+
+	It("Should use the memory of MIG profiles", func() {
+		dgdr := newDGDR("Qwen/Qwen3-32B", "", 1)
+		dgdr.Spec.Hardware = &nvidiacomv1alpha1.HardwareSpec{GPUResourceName: "nvidia.com/mig-3g.40gb"}
+		Expect(reconciler.validateModelFit(dgdr)).To(MatchError(ContainSubstring("1 nvidia.com/mig-3g.40gb GPUs provide 40GiB")))
+	})
+
+	It("Should not check what it cannot estimate", func() {
+		// No parameter count in the name, unknown GPU, unbounded GPUs per engine
+		Expect(reconciler.validateModelFit(newDGDR("deepseek-ai/DeepSeek-R1", "l40s", 1))).Should(Succeed())
+		Expect(reconciler.validateModelFit(newDGDR("meta-llama/Llama-3.1-405B", "unknown_gpu", 1))).Should(Succeed())
+		Expect(reconciler.validateModelFit(newDGDR("meta-llama/Llama-3.1-405B", "l40s", 0))).Should(Succeed())
+	})
+})
@@ -0,0 +1,377 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+)
+
+const (
+	// Public registry APIs, used when modelRef.url is empty
+	DefaultHuggingFaceURL = "https://huggingface.co"
+	DefaultNGCURL         = "https://api.ngc.nvidia.com"
+
+	// DefaultHuggingFaceRevision is resolved when modelRef.version is empty
+	DefaultHuggingFaceRevision = "main"
+
+	// ConfigKeyModelRevision pins the revision of the resolved model for the profiler
+	ConfigKeyModelRevision = "model_revision"
+
+	// maxModelRegistryResponseBytes bounds the registry answers read by the controller
+	maxModelRegistryResponseBytes = 4 << 20
+
+	// Event reasons
+	EventReasonModelResolved         = "ModelResolved"
+	EventReasonModelResolutionFailed = "ModelResolutionFailed"
+
+	// Messages
+	MessageModelResolved = "Resolved modelRef %s %q to %s at revision %q"
+)
+
+// ResolvedModel is a model registry entry resolved to a concrete model
+type ResolvedModel struct {
+	// Model is the model name or URI the deployment loads
+	Model string
+	// Revision is the immutable revision of the entry
+	Revision string
+	// Parameters and SizeBytes are the resources reported by the registry, 0 if unknown
+	Parameters int64
+	SizeBytes  int64
+}
+
+// ModelRegistry resolves spec.modelRef entries
+type ModelRegistry interface {
+	// Resolve returns the model the entry resolves to, calling the registry with token if not empty.
+	// Entries the registry does not know fail with a ModelResolutionFailed reason.
+	Resolve(ctx context.Context, ref *nvidiacomv1alpha1.ModelRefSpec, token string) (*ResolvedModel, error)
+}
+
+// HTTPModelRegistry resolves entries with the REST APIs of Hugging Face Hub, NGC and MLflow
+type HTTPModelRegistry struct {
+	// HTTPClient calls the registries, http.DefaultClient if nil
+	HTTPClient *http.Client
+}
+
+// Resolve dispatches to the API of the entry's provider
+func (m *HTTPModelRegistry) Resolve(ctx context.Context, ref *nvidiacomv1alpha1.ModelRefSpec, token string) (*ResolvedModel, error) {
+	switch ref.Provider {
+	case nvidiacomv1alpha1.ModelRegistryHuggingFace:
+		return m.resolveHuggingFace(ctx, ref, token)
+	case nvidiacomv1alpha1.ModelRegistryNGC:
+		return m.resolveNGC(ctx, ref, token)
+	case nvidiacomv1alpha1.ModelRegistryMLflow:
+		return m.resolveMLflow(ctx, ref, token)
+	default:
+		return nil, withFailureReason(nvidiacomv1alpha1.FailureReasonModelResolutionFailed,
+			fmt.Errorf("unknown model registry provider %q", ref.Provider))
+	}
+}
+
+// get decodes the JSON answer of a registry GET request into out
+func (m *HTTPModelRegistry) get(ctx context.Context, endpoint, token string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	httpClient := m.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("model registry request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	content, err := io.ReadAll(io.LimitReader(resp.Body, maxModelRegistryResponseBytes))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		// The answer is left out, registries may echo the request or its credentials
+		err := fmt.Errorf("model registry answered %s", resp.Status)
+		if resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+			return withFailureReason(nvidiacomv1alpha1.FailureReasonModelResolutionFailed, err)
+		}
+		return err
+	}
+	if err := json.Unmarshal(content, out); err != nil {
+		return fmt.Errorf("invalid model registry answer: %w", err)
+	}
+	return nil
+}
+
+// registryURL returns the API endpoint of the entry
+func registryURL(ref *nvidiacomv1alpha1.ModelRefSpec, fallback string) string {
+	if ref.URL != "" {
+		return strings.TrimSuffix(ref.URL, "/")
+	}
+	return fallback
+}
+
+// escapeRegistryPath escapes each /-separated segment of a registry name for a URL path, rejecting
+// segments that would change the path of the API endpoint
+func escapeRegistryPath(segments ...string) (string, error) {
+	escaped := make([]string, 0, len(segments))
+	for _, segment := range segments {
+		if segment == "" || segment == "." || segment == ".." {
+			return "", withFailureReason(nvidiacomv1alpha1.FailureReasonModelResolutionFailed,
+				fmt.Errorf("invalid modelRef.name segment %q", segment))
+		}
+		escaped = append(escaped, url.PathEscape(segment))
+	}
+	return strings.Join(escaped, "/"), nil
+}
+
+// resolveHuggingFace resolves the revision of a Hub repository to its commit
+func (m *HTTPModelRegistry) resolveHuggingFace(ctx context.Context, ref *nvidiacomv1alpha1.ModelRefSpec, token string) (*ResolvedModel, error) {
+	revision := ref.Version
+	if revision == "" {
+		revision = DefaultHuggingFaceRevision
+	}
+	var info struct {
+		ID          string `json:"id"`
+		SHA         string `json:"sha"`
+		UsedStorage int64  `json:"usedStorage"`
+		Safetensors *struct {
+			Total int64 `json:"total"`
+		} `json:"safetensors"`
+	}
+	name, err := escapeRegistryPath(strings.Split(ref.Name, "/")...)
+	if err != nil {
+		return nil, err
+	}
+	endpoint := fmt.Sprintf("%s/api/models/%s/revision/%s", registryURL(ref, DefaultHuggingFaceURL), name, url.PathEscape(revision))
+	if err := m.get(ctx, endpoint, token, &info); err != nil {
+		return nil, err
+	}
+	resolved := &ResolvedModel{Model: info.ID, Revision: info.SHA, SizeBytes: info.UsedStorage}
+	if resolved.Model == "" {
+		resolved.Model = ref.Name
+	}
+	if info.Safetensors != nil {
+		resolved.Parameters = info.Safetensors.Total
+	}
+	return resolved, nil
+}
+
+// resolveNGC resolves an NGC catalog model to the URI of one of its versions
+func (m *HTTPModelRegistry) resolveNGC(ctx context.Context, ref *nvidiacomv1alpha1.ModelRefSpec, token string) (*ResolvedModel, error) {
+	parts := strings.Split(ref.Name, "/")
+	if len(parts) < 2 || len(parts) > 3 {
+		return nil, withFailureReason(nvidiacomv1alpha1.FailureReasonModelResolutionFailed,
+			fmt.Errorf("modelRef.name %q must be <org>/[<team>/]<model> for the ngc provider", ref.Name))
+	}
+	if _, err := escapeRegistryPath(parts...); err != nil {
+		return nil, err
+	}
+	base := registryURL(ref, DefaultNGCURL) + "/v2/org/" + url.PathEscape(parts[0])
+	if len(parts) == 3 {
+		base += "/team/" + url.PathEscape(parts[1])
+	}
+	base += "/models/" + url.PathEscape(parts[len(parts)-1])
+
+	version := ref.Version
+	if version == "" {
+		var model struct {
+			Model struct {
+				LatestVersionIDStr string `json:"latestVersionIdStr"`
+			} `json:"model"`
+		}
+		if err := m.get(ctx, base, token, &model); err != nil {
+			return nil, err
+		}
+		if version = model.Model.LatestVersionIDStr; version == "" {
+			return nil, withFailureReason(nvidiacomv1alpha1.FailureReasonModelResolutionFailed,
+				fmt.Errorf("ngc model %s has no versions", ref.Name))
+		}
+	}
+	var info struct {
+		ModelVersion struct {
+			VersionID        string `json:"versionId"`
+			TotalSizeInBytes int64  `json:"totalSizeInBytes"`
+		} `json:"modelVersion"`
+	}
+	if err := m.get(ctx, base+"/versions/"+url.PathEscape(version), token, &info); err != nil {
+		return nil, err
+	}
+	if info.ModelVersion.VersionID != "" {
+		version = info.ModelVersion.VersionID
+	}
+	return &ResolvedModel{
+		Model:     fmt.Sprintf("ngc://%s:%s", ref.Name, version),
+		Revision:  version,
+		SizeBytes: info.ModelVersion.TotalSizeInBytes,
+	}, nil
+}
+
+// mlflowModelVersion is a model version of the MLflow model registry
+type mlflowModelVersion struct {
+	Version string `json:"version"`
+	Source  string `json:"source"`
+}
+
+// resolveMLflow resolves a registered model version or alias to the URI of its artifacts
+func (m *HTTPModelRegistry) resolveMLflow(ctx context.Context, ref *nvidiacomv1alpha1.ModelRefSpec, token string) (*ResolvedModel, error) {
+	if ref.URL == "" {
+		return nil, withFailureReason(nvidiacomv1alpha1.FailureReasonModelResolutionFailed,
+			fmt.Errorf("modelRef.url is required for the mlflow provider"))
+	}
+	api := registryURL(ref, "") + "/api/2.0/mlflow"
+	query := url.Values{"name": {ref.Name}}
+
+	var version mlflowModelVersion
+	switch _, err := strconv.Atoi(ref.Version); {
+	case ref.Version == "":
+		var latest struct {
+			ModelVersions []mlflowModelVersion `json:"model_versions"`
+		}
+		if err := m.get(ctx, api+"/registered-models/get-latest-versions?"+query.Encode(), token, &latest); err != nil {
+			return nil, err
+		}
+		for _, candidate := range latest.ModelVersions {
+			if n, _ := strconv.Atoi(candidate.Version); n > 0 && (version.Version == "" || n > mustAtoi(version.Version)) {
+				version = candidate
+			}
+		}
+	case err == nil:
+		query.Set("version", ref.Version)
+		var answer struct {
+			ModelVersion mlflowModelVersion `json:"model_version"`
+		}
+		if err := m.get(ctx, api+"/model-versions/get?"+query.Encode(), token, &answer); err != nil {
+			return nil, err
+		}
+		version = answer.ModelVersion
+	default:
+		query.Set("alias", ref.Version)
+		var answer struct {
+			ModelVersion mlflowModelVersion `json:"model_version"`
+		}
+		if err := m.get(ctx, api+"/registered-models/alias?"+query.Encode(), token, &answer); err != nil {
+			return nil, err
+		}
+		version = answer.ModelVersion
+	}
+	if version.Source == "" {
+		return nil, withFailureReason(nvidiacomv1alpha1.FailureReasonModelResolutionFailed,
+			fmt.Errorf("mlflow registered model %s has no version with a source", ref.Name))
+	}
+	return &ResolvedModel{Model: version.Source, Revision: version.Version}, nil
+}
+
+// mustAtoi returns the number in s, 0 if it is not one
+func mustAtoi(s string) int {
+	n, _ := strconv.Atoi(s)
+	return n
+}
+
+// modelName returns the model the DGDR deploys: spec.model, or the model spec.modelRef resolved to
+func modelName(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) string {
+	if dgdr.Spec.ModelRef != nil && dgdr.Status.ResolvedModel != nil {
+		return dgdr.Status.ResolvedModel.Model
+	}
+	return dgdr.Spec.Model
+}
+
+// modelRevision returns the revision spec.modelRef resolved to, "" without one
+func modelRevision(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) string {
+	if dgdr.Spec.ModelRef != nil && dgdr.Status.ResolvedModel != nil {
+		return dgdr.Status.ResolvedModel.Revision
+	}
+	return ""
+}
+
+// resolveModelRef resolves spec.modelRef into status.resolvedModel, unless it was resolved
+// already. Errors carrying a failure reason are permanent, others are retried. The status is
+// persisted by the caller.
+func (r *DynamoGraphDeploymentRequestReconciler) resolveModelRef(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	ref := dgdr.Spec.ModelRef
+	if ref == nil {
+		dgdr.Status.ResolvedModel = nil
+		return nil
+	}
+	if dgdr.Status.ResolvedModel != nil && dgdr.Status.ResolvedModel.Provider == ref.Provider {
+		return nil
+	}
+
+	var token string
+	if ref.CredentialsSecretRef != nil {
+		secret := &corev1.Secret{}
+		if err := r.Get(ctx, types.NamespacedName{Name: ref.CredentialsSecretRef.Name, Namespace: dgdr.Namespace}, secret); err != nil {
+			err = fmt.Errorf("failed to get modelRef.credentialsSecretRef: %w", err)
+			if apierrors.IsNotFound(err) {
+				return withFailureReason(nvidiacomv1alpha1.FailureReasonModelResolutionFailed, err)
+			}
+			return err
+		}
+		value, exists := secret.Data[ref.CredentialsSecretRef.Key]
+		if !exists {
+			return withFailureReason(nvidiacomv1alpha1.FailureReasonModelResolutionFailed,
+				fmt.Errorf("key %q not found in Secret %s", ref.CredentialsSecretRef.Key, ref.CredentialsSecretRef.Name))
+		}
+		token = strings.TrimSpace(string(value))
+	}
+
+	registry := r.ModelRegistry
+	if registry == nil {
+		registry = &HTTPModelRegistry{}
+	}
+	resolved, err := registry.Resolve(ctx, ref, token)
+	if err != nil {
+		// Errors without a failure reason are transient and retried
+		return fmt.Errorf("failed to resolve modelRef %s %q: %w", ref.Provider, ref.Name, err)
+	}
+
+	status := &nvidiacomv1alpha1.ResolvedModelStatus{
+		Provider:     ref.Provider,
+		Model:        resolved.Model,
+		Revision:     resolved.Revision,
+		Parameters:   resolved.Parameters,
+		ResolvedTime: metav1.Now(),
+	}
+	if resolved.SizeBytes > 0 {
+		status.Size = resource.NewQuantity(resolved.SizeBytes, resource.BinarySI)
+	}
+	dgdr.Status.ResolvedModel = status
+
+	message := fmt.Sprintf(MessageModelResolved, ref.Provider, ref.Name, resolved.Model, resolved.Revision)
+	log.FromContext(ctx).Info("Resolved modelRef", "provider", ref.Provider, "name", ref.Name, "model", resolved.Model, "revision", resolved.Revision)
+	r.Recorder.Event(dgdr, corev1.EventTypeNormal, EventReasonModelResolved, message)
+	return nil
+}
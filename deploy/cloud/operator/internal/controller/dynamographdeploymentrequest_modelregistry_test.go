@@ -0,0 +1,257 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var _ = Describe("DGDR Model Registry", func() {
+	var (
+		server   *httptest.Server
+		answers  map[string]string
+		auth     string
+		registry *HTTPModelRegistry
+	)
+
+	BeforeEach(func() {
+		answers = map[string]string{}
+		auth = ""
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			auth = req.Header.Get("Authorization")
+			answer, exists := answers[req.URL.RequestURI()]
+			if !exists {
+				http.NotFound(w, req)
+				return
+			}
+			_, _ = w.Write([]byte(answer))
+		}))
+		registry = &HTTPModelRegistry{}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("Should resolve a Hugging Face revision to its commit", func() {
+		answers["/api/models/Qwen/Qwen3-0.6B/revision/main"] = `{"id": "Qwen/Qwen3-0.6B", "sha": "c1899de", "usedStorage": 1503238553, "safetensors": {"total": 751632384}}`
+
+		resolved, err := registry.Resolve(context.Background(), &nvidiacomv1alpha1.ModelRefSpec{
+			Provider: nvidiacomv1alpha1.ModelRegistryHuggingFace,
+			Name:     "Qwen/Qwen3-0.6B",
+			URL:      server.URL,
+		}, "hf-token")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resolved).Should(Equal(&ResolvedModel{Model: "Qwen/Qwen3-0.6B", Revision: "c1899de", Parameters: 751632384, SizeBytes: 1503238553}))
+		Expect(auth).Should(Equal("Bearer hf-token"))
+
+		_, err = registry.Resolve(context.Background(), &nvidiacomv1alpha1.ModelRefSpec{
+			Provider: nvidiacomv1alpha1.ModelRegistryHuggingFace,
+			Name:     "Qwen/Qwen3-0.6B",
+			Version:  "missing",
+			URL:      server.URL,
+		}, "")
+		Expect(err).To(HaveOccurred())
+		Expect(failureReasonFromError(err, "")).Should(Equal(nvidiacomv1alpha1.FailureReasonModelResolutionFailed))
+		Expect(auth).Should(BeEmpty())
+	})
+
+	It("Should escape model names and keep the answers of failed requests out of errors", func() {
+		answers["/api/models/Qwen/Qwen3%3F0.6B/revision/main"] = `{"id": "Qwen/Qwen3?0.6B", "sha": "c1899de"}`
+		resolved, err := registry.Resolve(context.Background(), &nvidiacomv1alpha1.ModelRefSpec{
+			Provider: nvidiacomv1alpha1.ModelRegistryHuggingFace,
+			Name:     "Qwen/Qwen3?0.6B",
+			URL:      server.URL,
+		}, "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resolved.Revision).Should(Equal("c1899de"))
+
+		_, err = registry.Resolve(context.Background(), &nvidiacomv1alpha1.ModelRefSpec{
+			Provider: nvidiacomv1alpha1.ModelRegistryNGC,
+			Name:     "nim/../llama-3.1-8b-instruct",
+			URL:      server.URL,
+		}, "")
+		Expect(err).To(MatchError(ContainSubstring(`invalid modelRef.name segment ".."`)))
+
+		_, err = registry.Resolve(context.Background(), &nvidiacomv1alpha1.ModelRefSpec{
+			Provider: nvidiacomv1alpha1.ModelRegistryHuggingFace,
+			Name:     "Qwen/missing",
+			URL:      server.URL,
+		}, "hf-token")
+		Expect(err).To(MatchError("model registry answered 404 Not Found"))
+	})
+
+	It("Should resolve the latest version of an NGC model", func() {
+		answers["/v2/org/nim/team/meta/models/llama-3.1-8b-instruct"] = `{"model": {"latestVersionIdStr": "1.2"}}`
+		answers["/v2/org/nim/team/meta/models/llama-3.1-8b-instruct/versions/1.2"] = `{"modelVersion": {"versionId": "1.2", "totalSizeInBytes": 16060522496}}`
+
+		resolved, err := registry.Resolve(context.Background(), &nvidiacomv1alpha1.ModelRefSpec{
+			Provider: nvidiacomv1alpha1.ModelRegistryNGC,
+			Name:     "nim/meta/llama-3.1-8b-instruct",
+			URL:      server.URL,
+		}, "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resolved).Should(Equal(&ResolvedModel{Model: "ngc://nim/meta/llama-3.1-8b-instruct:1.2", Revision: "1.2", SizeBytes: 16060522496}))
+
+		_, err = registry.Resolve(context.Background(), &nvidiacomv1alpha1.ModelRefSpec{
+			Provider: nvidiacomv1alpha1.ModelRegistryNGC,
+			Name:     "llama-3.1-8b-instruct",
+			URL:      server.URL,
+		}, "")
+		Expect(failureReasonFromError(err, "")).Should(Equal(nvidiacomv1alpha1.FailureReasonModelResolutionFailed))
+	})
+
+	It("Should resolve MLflow versions and aliases to their source", func() {
+		answers["/api/2.0/mlflow/registered-models/get-latest-versions?name=chat"] = `{"model_versions": [{"version": "3", "source": "s3://models/chat/3"}, {"version": "12", "source": "s3://models/chat/12"}]}`
+		answers["/api/2.0/mlflow/model-versions/get?name=chat&version=3"] = `{"model_version": {"version": "3", "source": "s3://models/chat/3"}}`
+		answers["/api/2.0/mlflow/registered-models/alias?alias=champion&name=chat"] = `{"model_version": {"version": "7", "source": "s3://models/chat/7"}}`
+
+		for version, expected := range map[string]*ResolvedModel{
+			"":         {Model: "s3://models/chat/12", Revision: "12"},
+			"3":        {Model: "s3://models/chat/3", Revision: "3"},
+			"champion": {Model: "s3://models/chat/7", Revision: "7"},
+		} {
+			resolved, err := registry.Resolve(context.Background(), &nvidiacomv1alpha1.ModelRefSpec{
+				Provider: nvidiacomv1alpha1.ModelRegistryMLflow,
+				Name:     "chat",
+				Version:  version,
+				URL:      server.URL,
+			}, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resolved).Should(Equal(expected))
+		}
+	})
+
+	It("Should profile the model spec.modelRef resolves to", func() {
+		ctx := context.Background()
+		answers["/api/models/Qwen/Qwen3-0.6B/revision/v1"] = `{"id": "Qwen/Qwen3-0.6B", "sha": "c1899de", "usedStorage": 1073741824}`
+		reconciler := &DynamoGraphDeploymentRequestReconciler{
+			Client:        k8sClient,
+			Recorder:      record.NewFakeRecorder(100),
+			RBACManager:   &MockRBACManager{},
+			ModelRegistry: registry,
+		}
+
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-dgdr-modelref-token", Namespace: defaultNamespace},
+			Data:       map[string][]byte{"token": []byte("hf-token\n")},
+		}
+		Expect(k8sClient.Create(ctx, secret)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, secret) }()
+
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-dgdr-modelref", Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				ModelRef: &nvidiacomv1alpha1.ModelRefSpec{
+					Provider:             nvidiacomv1alpha1.ModelRegistryHuggingFace,
+					Name:                 "Qwen/Qwen3-0.6B",
+					Version:              "v1",
+					URL:                  server.URL,
+					CredentialsSecretRef: &nvidiacomv1alpha1.SecretKeySelector{Name: secret.Name, Key: "token"},
+				},
+				Backend: BackendVLLM,
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					ProfilerImage: "test-profiler:latest",
+					Config:        createTestConfig(map[string]interface{}{}),
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, dgdr) }()
+
+		request := reconcile.Request{NamespacedName: types.NamespacedName{Name: dgdr.Name, Namespace: defaultNamespace}}
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(k8sClient.Get(ctx, request.NamespacedName, dgdr)).Should(Succeed())
+		Expect(auth).Should(Equal("Bearer hf-token"))
+		Expect(dgdr.Status.State).Should(Equal(StatePending))
+		Expect(dgdr.Status.ResolvedModel).NotTo(BeNil())
+		Expect(dgdr.Status.ResolvedModel.Model).Should(Equal("Qwen/Qwen3-0.6B"))
+		Expect(dgdr.Status.ResolvedModel.Revision).Should(Equal("c1899de"))
+		Expect(dgdr.Status.ResolvedModel.Size.Equal(resource.MustParse("1Gi"))).Should(BeTrue())
+
+		config, err := buildProfilingConfig(dgdr)
+		Expect(err).NotTo(HaveOccurred())
+		deployment := config["deployment"].(map[string]interface{})
+		Expect(deployment["model"]).Should(Equal("Qwen/Qwen3-0.6B"))
+		Expect(deployment[ConfigKeyModelRevision]).Should(Equal("c1899de"))
+	})
+
+	It("Should fail DGDRs whose modelRef does not resolve", func() {
+		ctx := context.Background()
+		reconciler := &DynamoGraphDeploymentRequestReconciler{
+			Client:        k8sClient,
+			Recorder:      record.NewFakeRecorder(100),
+			RBACManager:   &MockRBACManager{},
+			ModelRegistry: registry,
+		}
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-dgdr-modelref-missing", Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				ModelRef: &nvidiacomv1alpha1.ModelRefSpec{
+					Provider: nvidiacomv1alpha1.ModelRegistryHuggingFace,
+					Name:     "Qwen/Missing",
+					URL:      server.URL,
+				},
+				Backend: BackendVLLM,
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					ProfilerImage: "test-profiler:latest",
+					Config:        createTestConfig(map[string]interface{}{}),
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, dgdr) }()
+
+		request := reconcile.Request{NamespacedName: types.NamespacedName{Name: dgdr.Name, Namespace: defaultNamespace}}
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(k8sClient.Get(ctx, request.NamespacedName, dgdr)).Should(Succeed())
+		Expect(dgdr.Status.State).Should(Equal(StateFailed))
+		Expect(dgdr.Status.FailureReason).Should(Equal(nvidiacomv1alpha1.FailureReasonModelResolutionFailed))
+	})
+
+	It("Should require exactly one of model and modelRef", func() {
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-dgdr-modelref-both", Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model:    "Qwen/Qwen3-0.6B",
+				ModelRef: &nvidiacomv1alpha1.ModelRefSpec{Provider: nvidiacomv1alpha1.ModelRegistryHuggingFace, Name: "Qwen/Qwen3-0.6B"},
+				Backend:  BackendVLLM,
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					ProfilerImage: "test-profiler:latest",
+				},
+			},
+		}
+		err := k8sClient.Create(context.Background(), dgdr)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).Should(ContainSubstring("exactly one of model and modelRef must be set"))
+	})
+})
@@ -0,0 +1,109 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+)
+
+const (
+	// ConditionTypeNamespaceNotEnabled is True while the DGDR namespace does not match the operator's
+	// namespace selector and the DGDR is not processed
+	ConditionTypeNamespaceNotEnabled = "NamespaceNotEnabled"
+
+	// Event reasons
+	EventReasonNamespaceNotEnabled = "NamespaceNotEnabled"
+	EventReasonNamespaceEnabled    = "NamespaceEnabled"
+
+	// Messages
+	MessageNamespaceNotEnabled = "Namespace %s is not enabled for DynamoGraphDeploymentRequests: it must match the label selector %q"
+	MessageNamespaceEnabled    = "Namespace enabled for DynamoGraphDeploymentRequests"
+)
+
+// namespaceEnabled reports whether DGDRs in the namespace are processed
+func (r *DynamoGraphDeploymentRequestReconciler) namespaceEnabled(ctx context.Context, namespace string) (bool, error) {
+	if r.NamespaceSelector == nil {
+		return true, nil
+	}
+	ns := &corev1.Namespace{}
+	if err := r.Get(ctx, types.NamespacedName{Name: namespace}, ns); err != nil {
+		return false, fmt.Errorf("failed to get namespace %s: %w", namespace, err)
+	}
+	return r.NamespaceSelector.Matches(labels.Set(ns.Labels)), nil
+}
+
+// handleNamespaceSelector reports whether the DGDR namespace is enabled, recording the
+// NamespaceNotEnabled condition when that changes. DGDRs in namespaces that are not enabled are
+// left untouched until the namespace is labeled.
+func (r *DynamoGraphDeploymentRequestReconciler) handleNamespaceSelector(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) (bool, error) {
+	enabled, err := r.namespaceEnabled(ctx, dgdr.Namespace)
+	if err != nil {
+		return false, err
+	}
+	wasDisabled := meta.IsStatusConditionTrue(dgdr.Status.Conditions, ConditionTypeNamespaceNotEnabled)
+	if enabled != wasDisabled {
+		return enabled, nil
+	}
+
+	condition := metav1.Condition{
+		Type:               ConditionTypeNamespaceNotEnabled,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: dgdr.Generation,
+		Reason:             EventReasonNamespaceEnabled,
+		Message:            MessageNamespaceEnabled,
+	}
+	if !enabled {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = EventReasonNamespaceNotEnabled
+		condition.Message = fmt.Sprintf(MessageNamespaceNotEnabled, dgdr.Namespace, r.NamespaceSelector.String())
+	}
+	log.FromContext(ctx).Info("Updating namespace enablement", "namespace", dgdr.Namespace, "enabled", enabled)
+	eventType := corev1.EventTypeNormal
+	if !enabled {
+		eventType = corev1.EventTypeWarning
+	}
+	r.Recorder.Event(dgdr, eventType, condition.Reason, condition.Message)
+	meta.SetStatusCondition(&dgdr.Status.Conditions, condition)
+	return enabled, r.updateStatus(ctx, dgdr)
+}
+
+// requestsForNamespace enqueues the DGDRs of a namespace whose labels changed
+func (r *DynamoGraphDeploymentRequestReconciler) requestsForNamespace(ctx context.Context, obj client.Object) []ctrl.Request {
+	dgdrs := &nvidiacomv1alpha1.DynamoGraphDeploymentRequestList{}
+	if err := r.List(ctx, dgdrs, client.InNamespace(obj.GetName())); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to list DGDRs of namespace", "namespace", obj.GetName())
+		return nil
+	}
+	requests := make([]ctrl.Request, 0, len(dgdrs.Items))
+	for _, dgdr := range dgdrs.Items {
+		requests = append(requests, ctrl.Request{NamespacedName: types.NamespacedName{Name: dgdr.Name, Namespace: dgdr.Namespace}})
+	}
+	return requests
+}
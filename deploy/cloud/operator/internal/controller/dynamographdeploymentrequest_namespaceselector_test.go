@@ -0,0 +1,96 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var _ = Describe("DGDR Namespace Selector", func() {
+	const namespace = "test-dgdr-opt-in"
+
+	It("Should only process DGDRs once their namespace is labeled", func() {
+		ctx := context.Background()
+		reconciler := &DynamoGraphDeploymentRequestReconciler{
+			Client:            k8sClient,
+			Recorder:          record.NewFakeRecorder(100),
+			RBACManager:       &MockRBACManager{},
+			NamespaceSelector: labels.SelectorFromSet(labels.Set{"dynamo.nvidia.com/enabled": "true"}),
+		}
+
+		ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespace}}
+		Expect(k8sClient.Create(ctx, ns)).Should(Succeed())
+
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-dgdr-opt-in", Namespace: namespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model:   "Qwen/Qwen3-0.6B",
+				Backend: BackendVLLM,
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					ProfilerImage: "test-profiler:latest",
+					Config: createTestConfig(map[string]interface{}{
+						"sla":   map[string]interface{}{"ttft": 200.0, "itl": 20.0},
+						"sweep": map[string]interface{}{"use_ai_configurator": true},
+					}),
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, dgdr) }()
+
+		request := reconcile.Request{NamespacedName: types.NamespacedName{Name: dgdr.Name, Namespace: namespace}}
+		for range 2 {
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		updated := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{}
+		Expect(k8sClient.Get(ctx, request.NamespacedName, updated)).Should(Succeed())
+		Expect(updated.Status.State).Should(BeEmpty())
+		condition := meta.FindStatusCondition(updated.Status.Conditions, ConditionTypeNamespaceNotEnabled)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).Should(Equal(metav1.ConditionTrue))
+		Expect(condition.Message).Should(ContainSubstring("dynamo.nvidia.com/enabled=true"))
+
+		Expect(reconciler.requestsForNamespace(ctx, ns)).Should(ConsistOf(request))
+
+		// Labeling the namespace opts its DGDRs in
+		ns.Labels = map[string]string{"dynamo.nvidia.com/enabled": "true"}
+		Expect(k8sClient.Update(ctx, ns)).Should(Succeed())
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(k8sClient.Get(ctx, request.NamespacedName, updated)).Should(Succeed())
+		Expect(meta.IsStatusConditionFalse(updated.Status.Conditions, ConditionTypeNamespaceNotEnabled)).To(BeTrue())
+		_, err = reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(k8sClient.Get(ctx, request.NamespacedName, updated)).Should(Succeed())
+		Expect(updated.Status.State).ShouldNot(BeEmpty())
+	})
+})
@@ -0,0 +1,216 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/consts"
+	commonController "github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/controller_common"
+)
+
+const (
+	// NetworkPolicyProfilingIsolationPrefix names the NetworkPolicy isolating the profiling job pods of a DGDR
+	NetworkPolicyProfilingIsolationPrefix = "dgdr-profiling-isolation-"
+
+	// apiServerService is the Service of the Kubernetes API server in the default namespace
+	apiServerService = "kubernetes"
+
+	// Validation messages
+	ValidationErrorNetworkIsolationRestricted = "profilingConfig.networkIsolation requires a cluster-wide operator installation"
+	ValidationErrorNetworkIsolationHTTP       = "profilingConfig.networkIsolation Restricted does not support resultTransport HTTP, the profiling job could not reach the operator's results endpoint"
+)
+
+// externalNetworks are the addresses isolated profiling pods may reach over HTTPS, everything but
+// the private, shared and link-local ranges clusters take pod, service and node addresses from.
+// Link-local excludes cloud instance metadata services.
+var externalNetworks = []networkingv1.IPBlock{
+	{CIDR: "0.0.0.0/0", Except: []string{"10.0.0.0/8", "100.64.0.0/10", "169.254.0.0/16", "172.16.0.0/12", "192.168.0.0/16"}},
+	{CIDR: "::/0", Except: []string{"fc00::/7", "fe80::/10"}},
+}
+
+// isNetworkIsolated reports whether the profiling job pods of the DGDR are isolated
+func isNetworkIsolated(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) bool {
+	return dgdr.Spec.ProfilingConfig.NetworkIsolation == nvidiacomv1alpha1.NetworkIsolationRestricted
+}
+
+// validateNetworkIsolation checks that the profiling job can still do its work when isolated. The
+// API server endpoints are read from the default namespace, which restricted installations cannot.
+func (r *DynamoGraphDeploymentRequestReconciler) validateNetworkIsolation(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	if !isNetworkIsolated(dgdr) {
+		return nil
+	}
+	if r.Config.RestrictedNamespace != "" {
+		return errors.New(ValidationErrorNetworkIsolationRestricted)
+	}
+	if getResultTransport(dgdr) == nvidiacomv1alpha1.ResultTransportHTTP {
+		return errors.New(ValidationErrorNetworkIsolationHTTP)
+	}
+	return nil
+}
+
+// getProfilingNetworkPolicyName returns the name of the NetworkPolicy isolating the profiling job pods of the DGDR
+func getProfilingNetworkPolicyName(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) string {
+	return NetworkPolicyProfilingIsolationPrefix + dgdr.Name
+}
+
+// profilingPodLabels returns the labels of the profiling job pods of the DGDR. The app label tells
+// them apart from the pods of its profiling hooks, which are not isolated.
+func profilingPodLabels(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) map[string]string {
+	return map[string]string{
+		LabelApp:       profilingJobLabelValue(dgdr),
+		LabelDGDR:      dgdr.Name,
+		LabelManagedBy: LabelValueDynamoOperator,
+	}
+}
+
+// isolateProfilingNetwork creates the NetworkPolicy isolating the profiling job pods of the DGDR
+// before they start. It is owned by the DGDR and deleted once profiling finishes.
+func (r *DynamoGraphDeploymentRequestReconciler) isolateProfilingNetwork(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	if !isNetworkIsolated(dgdr) {
+		return nil
+	}
+	apiServer, err := r.apiServerEgress(ctx)
+	if err != nil {
+		return err
+	}
+	_, policy, err := commonController.SyncResource(ctx, r, dgdr, func(ctx context.Context) (*networkingv1.NetworkPolicy, bool, error) {
+		return buildProfilingNetworkPolicy(dgdr, apiServer), false, nil
+	}, commonController.WithManagedMetadata())
+	if err != nil {
+		return err
+	}
+	r.trackOwnedResource(ctx, dgdr, nvidiacomv1alpha1.OwnedResourceRoleNetworkPolicy, policy)
+	return nil
+}
+
+// removeProfilingNetworkIsolation deletes the NetworkPolicy isolating the profiling job pods of the DGDR
+func (r *DynamoGraphDeploymentRequestReconciler) removeProfilingNetworkIsolation(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	if !isNetworkIsolated(dgdr) {
+		return nil
+	}
+	_, _, err := commonController.SyncResource(ctx, r, dgdr, func(ctx context.Context) (*networkingv1.NetworkPolicy, bool, error) {
+		return &networkingv1.NetworkPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: getProfilingNetworkPolicyName(dgdr), Namespace: dgdr.Namespace},
+		}, true, nil
+	})
+	if err != nil {
+		return err
+	}
+	untrackOwnedResources(dgdr, nvidiacomv1alpha1.OwnedResourceRoleNetworkPolicy)
+	return nil
+}
+
+// apiServerEgress returns the egress rule to the endpoints of the Kubernetes API server. Policies
+// apply to the endpoints the kubernetes Service is translated to, not to its cluster IP.
+func (r *DynamoGraphDeploymentRequestReconciler) apiServerEgress(ctx context.Context) (networkingv1.NetworkPolicyEgressRule, error) {
+	rule := networkingv1.NetworkPolicyEgressRule{}
+	endpointSlices := &discoveryv1.EndpointSliceList{}
+	if err := r.apiReader().List(ctx, endpointSlices, client.InNamespace(metav1.NamespaceDefault),
+		client.MatchingLabels{discoveryv1.LabelServiceName: apiServerService}); err != nil {
+		return rule, fmt.Errorf("failed to list the endpoints of the Kubernetes API server: %w", err)
+	}
+	seenPorts := map[int32]bool{}
+	for _, endpointSlice := range endpointSlices.Items {
+		for _, endpoint := range endpointSlice.Endpoints {
+			for _, address := range endpoint.Addresses {
+				ip, err := netip.ParseAddr(address)
+				if err != nil {
+					continue
+				}
+				rule.To = append(rule.To, networkingv1.NetworkPolicyPeer{
+					IPBlock: &networkingv1.IPBlock{CIDR: netip.PrefixFrom(ip, ip.BitLen()).String()},
+				})
+			}
+		}
+		for _, port := range endpointSlice.Ports {
+			if port.Port == nil || seenPorts[*port.Port] {
+				continue
+			}
+			seenPorts[*port.Port] = true
+			rule.Ports = append(rule.Ports, networkingv1.NetworkPolicyPort{
+				Protocol: ptr.To(ptr.Deref(port.Protocol, corev1.ProtocolTCP)),
+				Port:     ptr.To(intstr.FromInt32(*port.Port)),
+			})
+		}
+	}
+	if len(rule.To) == 0 {
+		return rule, errors.New("the Kubernetes API server has no endpoints to allow the isolated profiling job to reach")
+	}
+	return rule, nil
+}
+
+// buildProfilingNetworkPolicy returns the NetworkPolicy isolating the profiling job pods of the
+// DGDR: no ingress, egress only to DNS, the API server, HTTPS outside the cluster and, for online
+// profiling, the frontends of the deployments the profiler deploys for the DGDR
+func buildProfilingNetworkPolicy(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, apiServer networkingv1.NetworkPolicyEgressRule) *networkingv1.NetworkPolicy {
+	external := make([]networkingv1.NetworkPolicyPeer, 0, len(externalNetworks))
+	for _, block := range externalNetworks {
+		external = append(external, networkingv1.NetworkPolicyPeer{IPBlock: block.DeepCopy()})
+	}
+	egress := []networkingv1.NetworkPolicyEgressRule{
+		{
+			Ports: []networkingv1.NetworkPolicyPort{
+				{Protocol: ptr.To(corev1.ProtocolUDP), Port: ptr.To(intstr.FromInt32(53))},
+				{Protocol: ptr.To(corev1.ProtocolTCP), Port: ptr.To(intstr.FromInt32(53))},
+			},
+		},
+		apiServer,
+		{
+			To:    external,
+			Ports: []networkingv1.NetworkPolicyPort{{Protocol: ptr.To(corev1.ProtocolTCP), Port: ptr.To(intstr.FromInt32(443))}},
+		},
+	}
+	if isOnlineProfiling(dgdr) {
+		frontends := profilingDeploymentLabels(dgdr)
+		frontends[consts.KubeLabelDynamoComponentType] = consts.ComponentTypeFrontend
+		egress = append(egress, networkingv1.NetworkPolicyEgressRule{
+			To: []networkingv1.NetworkPolicyPeer{{
+				PodSelector: &metav1.LabelSelector{MatchLabels: frontends},
+			}},
+		})
+	}
+
+	return &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      getProfilingNetworkPolicyName(dgdr),
+			Namespace: dgdr.Namespace,
+			Labels: map[string]string{
+				LabelDGDR:      dgdr.Name,
+				LabelManagedBy: LabelValueDynamoOperator,
+			},
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{MatchLabels: profilingPodLabels(dgdr)},
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress},
+			Egress:      egress,
+		},
+	}
+}
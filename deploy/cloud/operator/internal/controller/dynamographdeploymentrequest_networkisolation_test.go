@@ -0,0 +1,124 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/consts"
+	commonController "github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/controller_common"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("DGDR Profiling Network Isolation", func() {
+	var reconciler *DynamoGraphDeploymentRequestReconciler
+
+	BeforeEach(func() {
+		reconciler = &DynamoGraphDeploymentRequestReconciler{Client: k8sClient, Recorder: record.NewFakeRecorder(100)}
+	})
+
+	newDGDR := func(name string, useAIC bool) *nvidiacomv1alpha1.DynamoGraphDeploymentRequest {
+		return &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model:   "Qwen/Qwen3-0.6B",
+				Backend: BackendVLLM,
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					ProfilerImage:    "test-profiler:latest",
+					NetworkIsolation: nvidiacomv1alpha1.NetworkIsolationRestricted,
+					Config: createTestConfig(map[string]interface{}{
+						"sweep": map[string]interface{}{"use_ai_configurator": useAIC},
+					}),
+				},
+			},
+		}
+	}
+
+	It("Should only allow DNS, the API server, HTTPS outside the cluster and benchmark frontends", func() {
+		apiServer := networkingv1.NetworkPolicyEgressRule{
+			To: []networkingv1.NetworkPolicyPeer{{IPBlock: &networkingv1.IPBlock{CIDR: "10.0.0.1/32"}}},
+		}
+		policy := buildProfilingNetworkPolicy(newDGDR("test-dgdr-isolation", false), apiServer)
+
+		Expect(policy.Name).Should(Equal("dgdr-profiling-isolation-test-dgdr-isolation"))
+		Expect(policy.Spec.PodSelector.MatchLabels).Should(Equal(profilingPodLabels(newDGDR("test-dgdr-isolation", false))))
+		// The pods of profiling hooks are not selected
+		Expect(policy.Spec.PodSelector.MatchLabels).Should(HaveKeyWithValue(LabelApp, LabelValueDynamoProfiler))
+		Expect(policy.Spec.PolicyTypes).Should(ConsistOf(networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress))
+		Expect(policy.Spec.Ingress).Should(BeEmpty())
+		Expect(policy.Spec.Egress).Should(HaveLen(4))
+		Expect(policy.Spec.Egress[1]).Should(Equal(apiServer))
+		external := policy.Spec.Egress[2]
+		Expect(external.Ports).Should(HaveLen(1))
+		Expect(external.Ports[0].Port.IntVal).Should(Equal(int32(443)))
+		Expect(external.To[0].IPBlock.Except).Should(ContainElements("10.0.0.0/8", "169.254.0.0/16"))
+		frontends := policy.Spec.Egress[3].To[0].PodSelector.MatchLabels
+		Expect(frontends).Should(HaveKeyWithValue(consts.KubeLabelDynamoComponentType, consts.ComponentTypeFrontend))
+		// Only the frontends the profiler deployed for the DGDR are reached
+		Expect(frontends).Should(HaveKeyWithValue(LabelDGDRName, "test-dgdr-isolation"))
+		Expect(frontends).Should(HaveKeyWithValue(LabelDGDRNamespace, defaultNamespace))
+
+		// AI Configurator profiling deploys nothing to benchmark
+		Expect(buildProfilingNetworkPolicy(newDGDR("test-dgdr-isolation", true), apiServer).Spec.Egress).Should(HaveLen(3))
+	})
+
+	It("Should isolate the profiling pods while profiling runs", func() {
+		ctx := context.Background()
+		dgdr := newDGDR("test-dgdr-isolation-lifecycle", true)
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, dgdr) }()
+
+		Expect(reconciler.isolateProfilingNetwork(ctx, dgdr)).Should(Succeed())
+		policy := &networkingv1.NetworkPolicy{}
+		key := client.ObjectKey{Name: getProfilingNetworkPolicyName(dgdr), Namespace: defaultNamespace}
+		Expect(k8sClient.Get(ctx, key, policy)).Should(Succeed())
+		Expect(metav1.IsControlledBy(policy, dgdr)).To(BeTrue())
+		// The API server is reached at the endpoints of the kubernetes Service
+		Expect(policy.Spec.Egress[1].To).ShouldNot(BeEmpty())
+		Expect(policy.Spec.Egress[1].To[0].IPBlock.CIDR).Should(MatchRegexp(`/(32|128)$`))
+
+		job, err := reconciler.buildProfilingJob(ctx, dgdr, GetProfilingJobName(dgdr), reconciler.resultTransport(dgdr))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(job.Spec.Template.Labels).Should(Equal(policy.Spec.PodSelector.MatchLabels))
+
+		Expect(reconciler.removeProfilingNetworkIsolation(ctx, dgdr)).Should(Succeed())
+		Expect(apierrors.IsNotFound(k8sClient.Get(ctx, key, policy))).To(BeTrue())
+	})
+
+	It("Should reject isolation the profiling job cannot work under", func() {
+		dgdr := newDGDR("test-dgdr-isolation-validation", false)
+		Expect(reconciler.validateNetworkIsolation(dgdr)).Should(Succeed())
+
+		dgdr.Spec.ProfilingConfig.ResultTransport = nvidiacomv1alpha1.ResultTransportHTTP
+		Expect(reconciler.validateNetworkIsolation(dgdr)).To(MatchError(ValidationErrorNetworkIsolationHTTP))
+
+		dgdr.Spec.ProfilingConfig.ResultTransport = ""
+		reconciler.Config = commonController.Config{RestrictedNamespace: defaultNamespace}
+		Expect(reconciler.validateNetworkIsolation(dgdr)).To(MatchError(ValidationErrorNetworkIsolationRestricted))
+
+		dgdr.Spec.ProfilingConfig.NetworkIsolation = nvidiacomv1alpha1.NetworkIsolationNone
+		Expect(reconciler.validateNetworkIsolation(dgdr)).Should(Succeed())
+	})
+})
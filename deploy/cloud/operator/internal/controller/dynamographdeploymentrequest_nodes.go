@@ -0,0 +1,224 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+)
+
+const (
+	// LabelProfilingReservation marks a node as reserved for profiling; the value is the DGDR UID.
+	// The same key is used for the NoSchedule taint placed on reserved nodes.
+	LabelProfilingReservation = "nvidia.com/dgdr-profiling-reservation"
+
+	// Event reasons
+	EventReasonNodesReserved = "ProfilingNodesReserved"
+	EventReasonNodesReleased = "ProfilingNodesReleased"
+
+	// Validation messages
+	ValidationErrorNodeReservationRestricted = "profilingConfig.nodeReservation requires a cluster-wide operator installation"
+)
+
+// needsNodeReservation reports whether nodes must be reserved for this DGDR's profiling run
+func needsNodeReservation(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) bool {
+	return dgdr.Spec.ProfilingConfig.NodeReservation != nil && isOnlineProfiling(dgdr)
+}
+
+// shouldTaintReservedNodes reports whether reserved nodes are tainted (the default)
+func shouldTaintReservedNodes(reservation *nvidiacomv1alpha1.NodeReservationSpec) bool {
+	return reservation.Taint == nil || *reservation.Taint
+}
+
+// validateNodeReservation validates the node reservation settings against the operator installation
+func (r *DynamoGraphDeploymentRequestReconciler) validateNodeReservation(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	if dgdr.Spec.ProfilingConfig.NodeReservation != nil && r.Config.RestrictedNamespace != "" {
+		return errors.New(ValidationErrorNodeReservationRestricted)
+	}
+	return nil
+}
+
+// profilingReservationTaint returns the taint placed on nodes reserved for the DGDR
+func profilingReservationTaint(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) corev1.Taint {
+	return corev1.Taint{
+		Key:    LabelProfilingReservation,
+		Value:  string(dgdr.UID),
+		Effect: corev1.TaintEffectNoSchedule,
+	}
+}
+
+// profilingSchedulingConfig returns the node selector and tolerations the profiler applies to its
+// profiling deployments so they land on (and only on) the reserved nodes
+func profilingSchedulingConfig(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) (map[string]interface{}, []interface{}) {
+	nodeSelector := map[string]interface{}{
+		LabelProfilingReservation: string(dgdr.UID),
+	}
+	var tolerations []interface{}
+	if shouldTaintReservedNodes(dgdr.Spec.ProfilingConfig.NodeReservation) {
+		taint := profilingReservationTaint(dgdr)
+		tolerations = append(tolerations, map[string]interface{}{
+			"key":      taint.Key,
+			"operator": string(corev1.TolerationOpEqual),
+			"value":    taint.Value,
+			"effect":   string(taint.Effect),
+		})
+	}
+	return nodeSelector, tolerations
+}
+
+// reserveProfilingNodes labels (and optionally taints) the requested number of nodes matching the
+// reservation's node selector. Nodes already reserved by this DGDR are reused, nodes reserved by
+// another DGDR or cordoned are skipped. Nodes are patched with an optimistic lock so two DGDRs
+// cannot reserve the same node, the conflict is returned and the reservation retried.
+func (r *DynamoGraphDeploymentRequestReconciler) reserveProfilingNodes(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	logger := log.FromContext(ctx)
+	reservation := dgdr.Spec.ProfilingConfig.NodeReservation
+	reservationID := string(dgdr.UID)
+
+	nodeCount := int(reservation.NodeCount)
+	if nodeCount < 1 {
+		nodeCount = 1
+	}
+
+	nodes := &corev1.NodeList{}
+	if err := r.List(ctx, nodes, client.MatchingLabels(reservation.NodeSelector)); err != nil {
+		return fmt.Errorf("failed to list candidate profiling nodes: %w", err)
+	}
+
+	var reserved, free []*corev1.Node
+	for i := range nodes.Items {
+		node := &nodes.Items[i]
+		owner, isReserved := node.Labels[LabelProfilingReservation]
+		switch {
+		case isReserved && owner == reservationID:
+			reserved = append(reserved, node)
+		case isReserved || node.Spec.Unschedulable:
+			continue
+		default:
+			free = append(free, node)
+		}
+	}
+	sort.Slice(free, func(i, j int) bool { return free[i].Name < free[j].Name })
+
+	for _, node := range free {
+		if len(reserved) >= nodeCount {
+			break
+		}
+		reserved = append(reserved, node)
+	}
+	if len(reserved) < nodeCount {
+		return fmt.Errorf("only %d of %d requested profiling nodes matching %v are available", len(reserved), nodeCount, reservation.NodeSelector)
+	}
+
+	taint := profilingReservationTaint(dgdr)
+	names := make([]string, 0, len(reserved))
+	for _, node := range reserved {
+		patch := client.MergeFromWithOptions(node.DeepCopy(), client.MergeFromWithOptimisticLock{})
+		if node.Labels == nil {
+			node.Labels = map[string]string{}
+		}
+		node.Labels[LabelProfilingReservation] = reservationID
+		if shouldTaintReservedNodes(reservation) && !hasTaint(node, taint) {
+			node.Spec.Taints = append(node.Spec.Taints, taint)
+		}
+		if err := r.Patch(ctx, node, patch); err != nil {
+			dgdr.Status.ReservedNodes = names
+			return fmt.Errorf("failed to reserve node %s: %w", node.Name, err)
+		}
+		names = append(names, node.Name)
+	}
+
+	dgdr.Status.ReservedNodes = names
+	logger.Info("Reserved profiling nodes", "nodes", names)
+	r.Recorder.Event(dgdr, corev1.EventTypeNormal, EventReasonNodesReserved,
+		fmt.Sprintf("Reserved nodes for profiling: %s", strings.Join(names, ", ")))
+	return nil
+}
+
+// releaseProfilingNodes removes the reservation label and taint from all nodes reserved by the DGDR
+func (r *DynamoGraphDeploymentRequestReconciler) releaseProfilingNodes(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	logger := log.FromContext(ctx)
+	if dgdr.UID == "" {
+		return nil
+	}
+
+	nodes := &corev1.NodeList{}
+	if err := r.List(ctx, nodes, client.MatchingLabels{LabelProfilingReservation: string(dgdr.UID)}); err != nil {
+		return fmt.Errorf("failed to list reserved profiling nodes: %w", err)
+	}
+	if len(nodes.Items) == 0 {
+		dgdr.Status.ReservedNodes = nil
+		return nil
+	}
+
+	taint := profilingReservationTaint(dgdr)
+	names := make([]string, 0, len(nodes.Items))
+	for i := range nodes.Items {
+		node := &nodes.Items[i]
+		patch := client.MergeFromWithOptions(node.DeepCopy(), client.MergeFromWithOptimisticLock{})
+		delete(node.Labels, LabelProfilingReservation)
+		taints := node.Spec.Taints[:0]
+		for _, t := range node.Spec.Taints {
+			if !t.MatchTaint(&taint) {
+				taints = append(taints, t)
+			}
+		}
+		node.Spec.Taints = taints
+		if err := r.Patch(ctx, node, patch); err != nil {
+			return fmt.Errorf("failed to release node %s: %w", node.Name, err)
+		}
+		names = append(names, node.Name)
+	}
+
+	dgdr.Status.ReservedNodes = nil
+	logger.Info("Released profiling nodes", "nodes", names)
+	r.Recorder.Event(dgdr, corev1.EventTypeNormal, EventReasonNodesReleased,
+		fmt.Sprintf("Released profiling nodes: %s", strings.Join(names, ", ")))
+	return nil
+}
+
+// failProfilingStart fails a DGDR whose profiling could not be started, releasing the nodes
+// reserved for it so they are not left tainted behind the failed DGDR
+func (r *DynamoGraphDeploymentRequestReconciler) failProfilingStart(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, reason string, err error) (ctrl.Result, error) {
+	r.Recorder.Event(dgdr, corev1.EventTypeWarning, EventReasonProfilingJobFailed, err.Error())
+	if releaseErr := r.releaseProfilingNodes(ctx, dgdr); releaseErr != nil {
+		return ctrl.Result{}, releaseErr
+	}
+	return r.updateStateToFailed(ctx, dgdr, nvidiacomv1alpha1.FailureReasonJobSchedulingFailed,
+		ConditionTypeProfiling, reason, err.Error())
+}
+
+// hasTaint reports whether the node already carries the taint
+func hasTaint(node *corev1.Node, taint corev1.Taint) bool {
+	for i := range node.Spec.Taints {
+		if node.Spec.Taints[i].MatchTaint(&taint) && node.Spec.Taints[i].Value == taint.Value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,240 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"errors"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	commonController "github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/controller_common"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// staleNodeListClient lists the nodes as they were before another writer changed them
+type staleNodeListClient struct {
+	client.Client
+	nodes *corev1.NodeList
+}
+
+func (c *staleNodeListClient) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	if nodes, ok := list.(*corev1.NodeList); ok {
+		c.nodes.DeepCopyInto(nodes)
+		return nil
+	}
+	return c.Client.List(ctx, list, opts...)
+}
+
+var _ = Describe("DGDR Profiling Node Reservation", func() {
+	var reconciler *DynamoGraphDeploymentRequestReconciler
+
+	BeforeEach(func() {
+		reconciler = &DynamoGraphDeploymentRequestReconciler{
+			Client:   k8sClient,
+			Recorder: record.NewFakeRecorder(100),
+			Config: commonController.Config{
+				RBAC: commonController.RBACConfig{
+					DGDRProfilingClusterRoleName: "test-cluster-role",
+				},
+			},
+			RBACManager: &MockRBACManager{},
+		}
+	})
+
+	newNode := func(name string) *corev1.Node {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   name,
+				Labels: map[string]string{"pool": "profiling"},
+			},
+		}
+	}
+
+	It("Should reserve and taint nodes when profiling starts and release them afterwards", func() {
+		ctx := context.Background()
+		namespace := defaultNamespace
+
+		nodeA := newNode("test-profiling-node-a")
+		nodeB := newNode("test-profiling-node-b")
+		for _, node := range []*corev1.Node{nodeA, nodeB} {
+			Expect(k8sClient.Create(ctx, node)).Should(Succeed())
+			defer func(node *corev1.Node) { _ = k8sClient.Delete(ctx, node) }(node)
+		}
+
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-dgdr-node-reservation",
+				Namespace: namespace,
+			},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model:   "test-model",
+				Backend: "vllm",
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					ProfilerImage: "test-profiler:latest",
+					Config: createTestConfig(map[string]interface{}{
+						"sla": map[string]interface{}{"ttft": 100.0, "itl": 1500.0},
+					}),
+					NodeReservation: &nvidiacomv1alpha1.NodeReservationSpec{
+						NodeSelector: map[string]string{"pool": "profiling"},
+						NodeCount:    1,
+					},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, dgdr) }()
+
+		dgdr.Status.State = StatePending
+		Expect(k8sClient.Status().Update(ctx, dgdr)).Should(Succeed())
+
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{
+			NamespacedName: types.NamespacedName{Name: dgdr.Name, Namespace: namespace},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		var updated nvidiacomv1alpha1.DynamoGraphDeploymentRequest
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: dgdr.Name, Namespace: namespace}, &updated)).Should(Succeed())
+		Expect(updated.Status.State).Should(Equal(StateProfiling))
+		Expect(updated.Status.ReservedNodes).Should(Equal([]string{nodeA.Name}))
+
+		var reservedNode corev1.Node
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: nodeA.Name}, &reservedNode)).Should(Succeed())
+		Expect(reservedNode.Labels).Should(HaveKeyWithValue(LabelProfilingReservation, string(updated.UID)))
+		Expect(reservedNode.Spec.Taints).Should(ContainElement(profilingReservationTaint(&updated)))
+
+		var otherNode corev1.Node
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: nodeB.Name}, &otherNode)).Should(Succeed())
+		Expect(otherNode.Labels).ShouldNot(HaveKey(LabelProfilingReservation))
+
+		// Releasing removes the label and taint
+		Expect(reconciler.releaseProfilingNodes(ctx, &updated)).Should(Succeed())
+		Expect(updated.Status.ReservedNodes).Should(BeEmpty())
+
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: nodeA.Name}, &reservedNode)).Should(Succeed())
+		Expect(reservedNode.Labels).ShouldNot(HaveKey(LabelProfilingReservation))
+		Expect(reservedNode.Spec.Taints).ShouldNot(ContainElement(profilingReservationTaint(&updated)))
+	})
+
+	It("Should fail when not enough nodes are available", func() {
+		ctx := context.Background()
+
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-dgdr-no-nodes", Namespace: defaultNamespace, UID: "test-uid"},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					NodeReservation: &nvidiacomv1alpha1.NodeReservationSpec{
+						NodeSelector: map[string]string{"pool": "does-not-exist"},
+						NodeCount:    2,
+					},
+				},
+			},
+		}
+
+		err := reconciler.reserveProfilingNodes(ctx, dgdr)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).Should(ContainSubstring("only 0 of 2 requested profiling nodes"))
+	})
+
+	It("Should not reserve a node another DGDR reserved concurrently", func() {
+		ctx := context.Background()
+		node := newNode("test-profiling-node-contended")
+		node.Labels["pool"] = "contended"
+		Expect(k8sClient.Create(ctx, node)).Should(Succeed())
+		DeferCleanup(func() { _ = k8sClient.Delete(ctx, node) })
+
+		newReservingDGDR := func(name string) *nvidiacomv1alpha1.DynamoGraphDeploymentRequest {
+			return &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: defaultNamespace, UID: types.UID(name + "-uid")},
+				Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+					ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+						NodeReservation: &nvidiacomv1alpha1.NodeReservationSpec{NodeSelector: map[string]string{"pool": "contended"}},
+					},
+				},
+			}
+		}
+
+		stale := &corev1.NodeList{}
+		Expect(k8sClient.List(ctx, stale, client.MatchingLabels{"pool": "contended"})).Should(Succeed())
+		Expect(reconciler.reserveProfilingNodes(ctx, newReservingDGDR("test-dgdr-first"))).Should(Succeed())
+
+		reconciler.Client = &staleNodeListClient{Client: k8sClient, nodes: stale}
+		err := reconciler.reserveProfilingNodes(ctx, newReservingDGDR("test-dgdr-second"))
+		Expect(apierrors.IsConflict(err)).Should(BeTrue())
+
+		var contended corev1.Node
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: node.Name}, &contended)).Should(Succeed())
+		Expect(contended.Labels).Should(HaveKeyWithValue(LabelProfilingReservation, "test-dgdr-first-uid"))
+	})
+
+	It("Should release the reserved nodes when profiling cannot be started", func() {
+		ctx := context.Background()
+		node := newNode("test-profiling-node-failed-start")
+		node.Labels["pool"] = "failed-start"
+		Expect(k8sClient.Create(ctx, node)).Should(Succeed())
+		DeferCleanup(func() { _ = k8sClient.Delete(ctx, node) })
+
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-dgdr-failed-start", Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model:   "test-model",
+				Backend: "vllm",
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					ProfilerImage: "test-profiler:latest",
+					NodeReservation: &nvidiacomv1alpha1.NodeReservationSpec{
+						NodeSelector: map[string]string{"pool": "failed-start"},
+					},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		DeferCleanup(func() { _ = k8sClient.Delete(ctx, dgdr) })
+		Expect(reconciler.reserveProfilingNodes(ctx, dgdr)).Should(Succeed())
+
+		_, err := reconciler.failProfilingStart(ctx, dgdr, MessageJobCreationFailed, errors.New("job rejected"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(dgdr.Status.State).Should(Equal(StateFailed))
+		Expect(dgdr.Status.ReservedNodes).Should(BeEmpty())
+
+		var released corev1.Node
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: node.Name}, &released)).Should(Succeed())
+		Expect(released.Labels).ShouldNot(HaveKey(LabelProfilingReservation))
+		Expect(released.Spec.Taints).ShouldNot(ContainElement(profilingReservationTaint(dgdr)))
+	})
+
+	It("Should reject node reservation in a namespace-restricted installation", func() {
+		reconciler.Config.RestrictedNamespace = defaultNamespace
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					NodeReservation: &nvidiacomv1alpha1.NodeReservationSpec{
+						NodeSelector: map[string]string{"pool": "profiling"},
+					},
+				},
+			},
+		}
+		Expect(reconciler.validateNodeReservation(dgdr)).To(MatchError(ValidationErrorNodeReservationRestricted))
+	})
+})
@@ -0,0 +1,338 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/yaml"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+)
+
+// OrphanPolicy is what the orphan scanner does with DGDR resources whose DGDR is gone or was recreated
+type OrphanPolicy string
+
+const (
+	// OrphanPolicyOff disables the orphan scan
+	OrphanPolicyOff OrphanPolicy = "off"
+	// OrphanPolicyReport only reports orphaned resources, nothing is modified
+	OrphanPolicyReport OrphanPolicy = "report"
+	// OrphanPolicyAdopt re-links resources to their live DGDR
+	OrphanPolicyAdopt OrphanPolicy = "adopt"
+	// OrphanPolicyDelete re-links resources to their live DGDR and deletes those without one
+	OrphanPolicyDelete OrphanPolicy = "delete"
+)
+
+const (
+	// OrphanReportConfigMapName is the ConfigMap the orphan scan report is published in
+	OrphanReportConfigMapName = "dgdr-orphan-report"
+	// OrphanReportKey is the key of the report in the ConfigMap
+	OrphanReportKey = "report.yaml"
+
+	// Orphan scan actions
+	OrphanActionAdopted  = "Adopted"
+	OrphanActionDeleted  = "Deleted"
+	OrphanActionOrphaned = "Orphaned"
+)
+
+// ParseOrphanPolicy validates the value of the orphan policy flag
+func ParseOrphanPolicy(value string) (OrphanPolicy, error) {
+	switch policy := OrphanPolicy(value); policy {
+	case OrphanPolicyOff, OrphanPolicyReport, OrphanPolicyAdopt, OrphanPolicyDelete:
+		return policy, nil
+	default:
+		return "", fmt.Errorf("unknown orphan policy %q, must be one of off, report, adopt, delete", value)
+	}
+}
+
+// OrphanReportEntry is a resource the orphan scan found and what was done with it
+type OrphanReportEntry struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	DGDR      string `json:"dgdr"`
+	Action    string `json:"action"`
+}
+
+// OrphanReport is the outcome of an orphan scan
+type OrphanReport struct {
+	Policy  OrphanPolicy        `json:"policy"`
+	Time    metav1.Time         `json:"time"`
+	Entries []OrphanReportEntry `json:"entries"`
+}
+
+// OrphanScanner looks for profiling Jobs, result ConfigMaps and Secrets, and DGDs labelled for a DGDR that
+// does not exist or that they are not linked to anymore, as left behind by etcd restores and namespace
+// migrations. Jobs of a recreated DGDR still reference the old UID and would be garbage collected, so they
+// are adopted. Resources of a missing DGDR are deleted, except DGDs, which outlive their DGDR by design
+// and are only reported.
+type OrphanScanner struct {
+	Client client.Client
+
+	// APIReader lists resources uncached, so the scan does not depend on the cache label selectors
+	APIReader client.Reader
+
+	Policy OrphanPolicy
+
+	// WatchNamespace restricts the scan to a namespace, all namespaces are scanned if empty
+	WatchNamespace string
+
+	// ReportNamespace is where the report is published, it is only logged if empty
+	ReportNamespace string
+}
+
+// NeedLeaderElection modifies resources from a single replica
+func (s *OrphanScanner) NeedLeaderElection() bool {
+	return true
+}
+
+// Start runs the scan once, when the replica becomes leader
+func (s *OrphanScanner) Start(ctx context.Context) error {
+	if s.Policy == "" || s.Policy == OrphanPolicyOff {
+		return nil
+	}
+	logger := log.FromContext(ctx)
+	report, err := s.Scan(ctx)
+	if err != nil {
+		// The scan is a maintenance task and must not stop the manager
+		logger.Error(err, "Orphan scan failed", "policy", s.Policy)
+	}
+	logger.Info("Orphan scan finished", "policy", s.Policy, "found", len(report.Entries))
+	if err := s.publish(ctx, report); err != nil {
+		logger.Error(err, "Failed to publish the orphan scan report", "namespace", s.ReportNamespace)
+	}
+	return nil
+}
+
+// Scan finds orphaned resources and handles them according to the policy. The report lists what was done
+// before any error.
+func (s *OrphanScanner) Scan(ctx context.Context) (*OrphanReport, error) {
+	report := &OrphanReport{Policy: s.Policy, Time: metav1.Now()}
+	err := errors.Join(
+		s.scanJobs(ctx, report),
+		s.scanResults(ctx, &corev1.ConfigMapList{}, report),
+		s.scanResults(ctx, &corev1.SecretList{}, report),
+		s.scanDeployments(ctx, report),
+	)
+	sort.SliceStable(report.Entries, func(i, j int) bool {
+		a, b := report.Entries[i], report.Entries[j]
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Name < b.Name
+	})
+	return report, err
+}
+
+func (s *OrphanScanner) reader() client.Reader {
+	if s.APIReader != nil {
+		return s.APIReader
+	}
+	return s.Client
+}
+
+// getDGDR returns the DGDR a resource is labelled for, nil if it does not exist
+func (s *OrphanScanner) getDGDR(ctx context.Context, namespace, name string) (*nvidiacomv1alpha1.DynamoGraphDeploymentRequest, error) {
+	dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{}
+	err := s.reader().Get(ctx, types.NamespacedName{Name: name, Namespace: namespace}, dgdr)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DGDR %s/%s: %w", namespace, name, err)
+	}
+	return dgdr, nil
+}
+
+// scanJobs adopts profiling Jobs not owned by their live DGDR and deletes those without a DGDR
+func (s *OrphanScanner) scanJobs(ctx context.Context, report *OrphanReport) error {
+	jobs := &batchv1.JobList{}
+	if err := s.reader().List(ctx, jobs, client.InNamespace(s.WatchNamespace),
+		client.MatchingLabels{LabelManagedBy: LabelValueDynamoOperator}, client.HasLabels{LabelDGDR}); err != nil {
+		return fmt.Errorf("failed to list profiling jobs: %w", err)
+	}
+	var errs []error
+	for i := range jobs.Items {
+		job := &jobs.Items[i]
+		dgdr, err := s.getDGDR(ctx, job.Namespace, job.Labels[LabelDGDR])
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if dgdr == nil {
+			errs = append(errs, s.handleOrphan(ctx, report, "Job", job, job.Labels[LabelDGDR],
+				client.PropagationPolicy(metav1.DeletePropagationBackground)))
+			continue
+		}
+		if metav1.IsControlledBy(job, dgdr) {
+			continue
+		}
+		errs = append(errs, s.adopt(ctx, report, "Job", job, dgdr))
+	}
+	return errors.Join(errs...)
+}
+
+// scanResults deletes the profiling results of DGDRs that do not exist anymore. Results carry no owner
+// reference, and export snapshots are kept since they are meant to survive their DGDR.
+func (s *OrphanScanner) scanResults(ctx context.Context, list client.ObjectList, report *OrphanReport) error {
+	if err := s.reader().List(ctx, list, client.InNamespace(s.WatchNamespace),
+		client.MatchingLabels{LabelManagedBy: LabelValueDynamoOperator}, client.HasLabels{LabelDGDRName}); err != nil {
+		return fmt.Errorf("failed to list profiling results: %w", err)
+	}
+	var objects []client.Object
+	kind := "ConfigMap"
+	switch l := list.(type) {
+	case *corev1.ConfigMapList:
+		for i := range l.Items {
+			objects = append(objects, &l.Items[i])
+		}
+	case *corev1.SecretList:
+		kind = "Secret"
+		for i := range l.Items {
+			objects = append(objects, &l.Items[i])
+		}
+	}
+
+	var errs []error
+	for _, obj := range objects {
+		name := obj.GetLabels()[LabelDGDRName]
+		if obj.GetName() != ConfigMapOutputPrefix+name {
+			continue
+		}
+		dgdr, err := s.getDGDR(ctx, obj.GetNamespace(), name)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if dgdr == nil {
+			errs = append(errs, s.handleOrphan(ctx, report, kind, obj, name))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// scanDeployments reports DGDs whose DGDR does not exist anymore, they keep serving and are never deleted
+func (s *OrphanScanner) scanDeployments(ctx context.Context, report *OrphanReport) error {
+	dgds := &nvidiacomv1alpha1.DynamoGraphDeploymentList{}
+	if err := s.reader().List(ctx, dgds, client.InNamespace(s.WatchNamespace),
+		client.MatchingLabels{LabelManagedBy: LabelValueDynamoOperator}, client.HasLabels{LabelDGDRName, LabelDGDRNamespace}); err != nil {
+		return fmt.Errorf("failed to list DGDs: %w", err)
+	}
+	var errs []error
+	for _, dgd := range dgds.Items {
+		dgdr, err := s.getDGDR(ctx, dgd.Labels[LabelDGDRNamespace], dgd.Labels[LabelDGDRName])
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if dgdr == nil {
+			report.Entries = append(report.Entries, OrphanReportEntry{
+				Kind:      "DynamoGraphDeployment",
+				Namespace: dgd.Namespace,
+				Name:      dgd.Name,
+				DGDR:      dgd.Labels[LabelDGDRNamespace] + "/" + dgd.Labels[LabelDGDRName],
+				Action:    OrphanActionOrphaned,
+			})
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// handleOrphan deletes a resource without a DGDR under the delete policy and reports it
+func (s *OrphanScanner) handleOrphan(ctx context.Context, report *OrphanReport, kind string, obj client.Object, dgdrName string, opts ...client.DeleteOption) error {
+	entry := OrphanReportEntry{
+		Kind:      kind,
+		Namespace: obj.GetNamespace(),
+		Name:      obj.GetName(),
+		DGDR:      obj.GetNamespace() + "/" + dgdrName,
+		Action:    OrphanActionOrphaned,
+	}
+	if s.Policy == OrphanPolicyDelete {
+		if err := s.Client.Delete(ctx, obj, opts...); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to delete orphaned %s %s/%s: %w", kind, obj.GetNamespace(), obj.GetName(), err)
+		}
+		entry.Action = OrphanActionDeleted
+	}
+	report.Entries = append(report.Entries, entry)
+	return nil
+}
+
+// adopt replaces the stale DGDR owner references of a resource with its live DGDR
+func (s *OrphanScanner) adopt(ctx context.Context, report *OrphanReport, kind string, obj client.Object, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	entry := OrphanReportEntry{
+		Kind:      kind,
+		Namespace: obj.GetNamespace(),
+		Name:      obj.GetName(),
+		DGDR:      dgdr.Namespace + "/" + dgdr.Name,
+		Action:    OrphanActionOrphaned,
+	}
+	if s.Policy == OrphanPolicyAdopt || s.Policy == OrphanPolicyDelete {
+		var refs []metav1.OwnerReference
+		for _, ref := range obj.GetOwnerReferences() {
+			if ref.Kind != "DynamoGraphDeploymentRequest" {
+				refs = append(refs, ref)
+			}
+		}
+		obj.SetOwnerReferences(refs)
+		if err := controllerutil.SetControllerReference(dgdr, obj, s.Client.Scheme()); err != nil {
+			return err
+		}
+		if err := s.Client.Update(ctx, obj); err != nil {
+			return fmt.Errorf("failed to adopt %s %s/%s: %w", kind, obj.GetNamespace(), obj.GetName(), err)
+		}
+		entry.Action = OrphanActionAdopted
+	}
+	report.Entries = append(report.Entries, entry)
+	return nil
+}
+
+// publish writes the report to the report ConfigMap
+func (s *OrphanScanner) publish(ctx context.Context, report *OrphanReport) error {
+	if s.ReportNamespace == "" {
+		return nil
+	}
+	content, err := yaml.Marshal(report)
+	if err != nil {
+		return fmt.Errorf("failed to marshal orphan report: %w", err)
+	}
+	cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: OrphanReportConfigMapName, Namespace: s.ReportNamespace}}
+	_, err = controllerutil.CreateOrUpdate(ctx, s.Client, cm, func() error {
+		if cm.Labels == nil {
+			cm.Labels = map[string]string{}
+		}
+		cm.Labels[LabelManagedBy] = LabelValueDynamoOperator
+		cm.Data = map[string]string{OrphanReportKey: string(content)}
+		return nil
+	})
+	return err
+}
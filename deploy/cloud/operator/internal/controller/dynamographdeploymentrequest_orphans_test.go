@@ -0,0 +1,174 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+var _ = Describe("DGDR Orphan Scanner", func() {
+	const namespace = "test-orphan-scan"
+
+	newJob := func(dgdrName string, owner *metav1.OwnerReference) *batchv1.Job {
+		job := &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "profile-" + dgdrName,
+				Namespace: namespace,
+				Labels: map[string]string{
+					LabelApp:       LabelValueDynamoProfiler,
+					LabelDGDR:      dgdrName,
+					LabelManagedBy: LabelValueDynamoOperator,
+				},
+			},
+			Spec: batchv1.JobSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						RestartPolicy: corev1.RestartPolicyNever,
+						Containers:    []corev1.Container{{Name: "profiler", Image: "test-profiler:latest"}},
+					},
+				},
+			},
+		}
+		if owner != nil {
+			job.OwnerReferences = []metav1.OwnerReference{*owner}
+		}
+		return job
+	}
+
+	newConfigMap := func(name, dgdrName string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels:    map[string]string{LabelDGDRName: dgdrName, LabelManagedBy: LabelValueDynamoOperator},
+		}}
+	}
+
+	It("Should report, adopt and delete resources left without their DGDR", func() {
+		ctx := context.Background()
+		ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespace}}
+		Expect(k8sClient.Create(ctx, ns)).Should(Succeed())
+		DeferCleanup(func() { _ = k8sClient.Delete(ctx, ns) })
+
+		// A DGDR recreated by a restore, its Job still references the previous incarnation
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "restored", Namespace: namespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model:   "Qwen/Qwen3-0.6B",
+				Backend: BackendVLLM,
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					ProfilerImage: "test-profiler:latest",
+					Config:        createTestConfig(map[string]interface{}{"sla": map[string]interface{}{"ttft": 200.0}}),
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		DeferCleanup(func() { _ = k8sClient.Delete(ctx, dgdr) })
+
+		stale := &metav1.OwnerReference{
+			APIVersion: nvidiacomv1alpha1.GroupVersion.String(),
+			Kind:       "DynamoGraphDeploymentRequest",
+			Name:       dgdr.Name,
+			UID:        "stale-uid",
+			Controller: ptr.To(true),
+		}
+		adoptable := newJob(dgdr.Name, stale)
+		orphanJob := newJob("gone", nil)
+		results := newConfigMap(ConfigMapOutputPrefix+"gone", "gone")
+		liveResults := newConfigMap(ConfigMapOutputPrefix+dgdr.Name, dgdr.Name)
+		snapshot := newConfigMap("gone-snapshot", "gone")
+		dgd := &nvidiacomv1alpha1.DynamoGraphDeployment{ObjectMeta: metav1.ObjectMeta{
+			Name:      "gone",
+			Namespace: namespace,
+			Labels: map[string]string{
+				LabelDGDRName:      "gone",
+				LabelDGDRNamespace: namespace,
+				LabelManagedBy:     LabelValueDynamoOperator,
+			},
+		}}
+		for _, obj := range []client.Object{adoptable, orphanJob, results, liveResults, snapshot, dgd} {
+			Expect(k8sClient.Create(ctx, obj)).Should(Succeed())
+		}
+		DeferCleanup(func() {
+			_ = k8sClient.Delete(ctx, adoptable)
+			_ = k8sClient.Delete(ctx, liveResults)
+			_ = k8sClient.Delete(ctx, snapshot)
+			_ = k8sClient.Delete(ctx, dgd)
+		})
+
+		scanner := &OrphanScanner{Client: k8sClient, Policy: OrphanPolicyReport, WatchNamespace: namespace, ReportNamespace: namespace}
+		report, err := scanner.Scan(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(report.Entries).Should(Equal([]OrphanReportEntry{
+			{Kind: "ConfigMap", Namespace: namespace, Name: results.Name, DGDR: namespace + "/gone", Action: OrphanActionOrphaned},
+			{Kind: "DynamoGraphDeployment", Namespace: namespace, Name: dgd.Name, DGDR: namespace + "/gone", Action: OrphanActionOrphaned},
+			{Kind: "Job", Namespace: namespace, Name: orphanJob.Name, DGDR: namespace + "/gone", Action: OrphanActionOrphaned},
+			{Kind: "Job", Namespace: namespace, Name: adoptable.Name, DGDR: namespace + "/" + dgdr.Name, Action: OrphanActionOrphaned},
+		}))
+		job := &batchv1.Job{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: adoptable.Name, Namespace: namespace}, job)).Should(Succeed())
+		Expect(job.OwnerReferences[0].UID).Should(BeEquivalentTo("stale-uid"))
+
+		scanner.Policy = OrphanPolicyDelete
+		Expect(scanner.Start(ctx)).Should(Succeed())
+
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: adoptable.Name, Namespace: namespace}, job)).Should(Succeed())
+		Expect(metav1.IsControlledBy(job, dgdr)).To(BeTrue())
+		Expect(job.OwnerReferences).Should(HaveLen(1))
+
+		err = k8sClient.Get(ctx, types.NamespacedName{Name: orphanJob.Name, Namespace: namespace}, &batchv1.Job{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		err = k8sClient.Get(ctx, types.NamespacedName{Name: results.Name, Namespace: namespace}, &corev1.ConfigMap{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+		// Results of live DGDRs, export snapshots and DGDs are kept
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: liveResults.Name, Namespace: namespace}, &corev1.ConfigMap{})).Should(Succeed())
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: snapshot.Name, Namespace: namespace}, &corev1.ConfigMap{})).Should(Succeed())
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: dgd.Name, Namespace: namespace}, &nvidiacomv1alpha1.DynamoGraphDeployment{})).Should(Succeed())
+
+		cm := &corev1.ConfigMap{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: OrphanReportConfigMapName, Namespace: namespace}, cm)).Should(Succeed())
+		published := &OrphanReport{}
+		Expect(yaml.Unmarshal([]byte(cm.Data[OrphanReportKey]), published)).Should(Succeed())
+		Expect(published.Policy).Should(Equal(OrphanPolicyDelete))
+		Expect(published.Entries).Should(ContainElements(
+			OrphanReportEntry{Kind: "Job", Namespace: namespace, Name: adoptable.Name, DGDR: namespace + "/" + dgdr.Name, Action: OrphanActionAdopted},
+			OrphanReportEntry{Kind: "Job", Namespace: namespace, Name: orphanJob.Name, DGDR: namespace + "/gone", Action: OrphanActionDeleted},
+			OrphanReportEntry{Kind: "ConfigMap", Namespace: namespace, Name: results.Name, DGDR: namespace + "/gone", Action: OrphanActionDeleted},
+		))
+	})
+
+	It("Should reject unknown policies", func() {
+		policy, err := ParseOrphanPolicy("adopt")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(policy).Should(Equal(OrphanPolicyAdopt))
+		_, err = ParseOrphanPolicy("purge")
+		Expect(err).To(HaveOccurred())
+	})
+})
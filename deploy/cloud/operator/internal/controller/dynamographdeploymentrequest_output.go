@@ -0,0 +1,186 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/yaml"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/consts"
+	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/dynamo"
+)
+
+const (
+	// RawManifestsKey is the output ConfigMap key holding the rendered plain manifests
+	RawManifestsKey = "raw_manifests.yaml"
+
+	// Validation messages
+	ValidationErrorRawManifestsAutoApply = "spec.output.format RawManifests cannot be combined with autoApply"
+	ValidationErrorGroveNotEnabled       = "spec.output.workloadFlavor grove requires Grove, which is not installed in the cluster"
+	ValidationErrorLWSNotEnabled         = "spec.output.workloadFlavor lws requires LeaderWorkerSet for multinode service %s, which is not installed in the cluster"
+	ValidationErrorMultinodeRaw          = "service %s spans %d nodes, which plain Deployments cannot express; set spec.output.workloadFlavor to lws or grove"
+	ValidationErrorLWSWithoutGPU         = "service %s spans %d nodes but sets no GPU limit, which LeaderWorkerSet workers require"
+)
+
+// getOutputFormat returns the requested output format, defaulting to DynamoGraphDeployment
+func getOutputFormat(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) nvidiacomv1alpha1.OutputFormat {
+	if dgdr.Spec.Output == nil || dgdr.Spec.Output.Format == "" {
+		return nvidiacomv1alpha1.OutputFormatDynamoGraphDeployment
+	}
+	return dgdr.Spec.Output.Format
+}
+
+// validateOutput validates the output settings
+func validateOutput(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	if getOutputFormat(dgdr) == nvidiacomv1alpha1.OutputFormatRawManifests && dgdr.Spec.AutoApply {
+		return errors.New(ValidationErrorRawManifestsAutoApply)
+	}
+	return nil
+}
+
+// getWorkloadFlavor returns the requested workload flavor, defaulting to dgd
+func getWorkloadFlavor(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) nvidiacomv1alpha1.WorkloadFlavor {
+	if dgdr.Spec.Output == nil || dgdr.Spec.Output.WorkloadFlavor == "" {
+		return nvidiacomv1alpha1.WorkloadFlavorDGD
+	}
+	return dgdr.Spec.Output.WorkloadFlavor
+}
+
+// validateWorkloadFlavor checks that the orchestrator a generated DGD is pinned to is installed.
+// The topology is only known after profiling, see applyWorkloadFlavor.
+func (r *DynamoGraphDeploymentRequestReconciler) validateWorkloadFlavor(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	if getOutputFormat(dgdr) == nvidiacomv1alpha1.OutputFormatDynamoGraphDeployment &&
+		getWorkloadFlavor(dgdr) == nvidiacomv1alpha1.WorkloadFlavorGrove && !r.Config.Grove.Enabled {
+		return errors.New(ValidationErrorGroveNotEnabled)
+	}
+	return nil
+}
+
+// applyWorkloadFlavor checks that the workload flavor supports the topology of the generated deployment
+// and pins a generated DGD to the orchestrator of the flavor
+func (r *DynamoGraphDeploymentRequestReconciler) applyWorkloadFlavor(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, dgd *nvidiacomv1alpha1.DynamoGraphDeployment) error {
+	flavor := getWorkloadFlavor(dgdr)
+	rawManifests := getOutputFormat(dgdr) == nvidiacomv1alpha1.OutputFormatRawManifests
+
+	for _, name := range slices.Sorted(maps.Keys(dgd.Spec.Services)) {
+		spec := dgd.Spec.Services[name]
+		if spec == nil || spec.GetNumberOfNodes() <= 1 {
+			continue
+		}
+		nodes := spec.GetNumberOfNodes()
+		switch flavor {
+		case nvidiacomv1alpha1.WorkloadFlavorDGD:
+			if rawManifests {
+				return withFailureReason(nvidiacomv1alpha1.FailureReasonValidationError, fmt.Errorf(ValidationErrorMultinodeRaw, name, nodes))
+			}
+		case nvidiacomv1alpha1.WorkloadFlavorLWS:
+			if spec.Resources == nil || spec.Resources.Limits == nil || spec.Resources.Limits.GPU == "" {
+				return withFailureReason(nvidiacomv1alpha1.FailureReasonValidationError, fmt.Errorf(ValidationErrorLWSWithoutGPU, name, nodes))
+			}
+			if !rawManifests && !r.Config.LWS.Enabled {
+				return withFailureReason(nvidiacomv1alpha1.FailureReasonValidationError, fmt.Errorf(ValidationErrorLWSNotEnabled, name))
+			}
+		}
+	}
+
+	if rawManifests || flavor == nvidiacomv1alpha1.WorkloadFlavorDGD {
+		return nil
+	}
+	if dgd.Annotations == nil {
+		dgd.Annotations = map[string]string{}
+	}
+	// The Dynamo operator runs multinode services as LeaderWorkerSets when Grove is disabled
+	dgd.Annotations[consts.KubeAnnotationEnableGrove] = strconv.FormatBool(flavor == nvidiacomv1alpha1.WorkloadFlavorGrove)
+	return nil
+}
+
+// renderRawManifests flattens the generated DGD into a multi-document YAML of plain Kubernetes objects
+func (r *DynamoGraphDeploymentRequestReconciler) renderRawManifests(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, generatedDGD *nvidiacomv1alpha1.DynamoGraphDeployment) (string, error) {
+	dgd := generatedDGD.DeepCopy()
+	dgd.Name, dgd.Namespace = dgdr.DeploymentNameAndNamespace(generatedDGD)
+
+	var objects []client.Object
+	var err error
+	switch getWorkloadFlavor(dgdr) {
+	case nvidiacomv1alpha1.WorkloadFlavorLWS:
+		objects, err = dynamo.GenerateLWSManifests(ctx, dgd, r.Config)
+	case nvidiacomv1alpha1.WorkloadFlavorGrove:
+		// The manifests target another cluster, its scheduler queues cannot be looked up from here
+		config := r.Config
+		config.KaiScheduler.Enabled = false
+		objects, err = dynamo.GenerateGroveManifests(ctx, dgd, config)
+	default:
+		objects, err = dynamo.GenerateRawManifests(ctx, dgd, r.Config)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	// Generated configuration goes first so that the workloads never start without it
+	resources, err := generatedResourceObjects(dgdr, dgd.Namespace)
+	if err != nil {
+		return "", err
+	}
+	manifests := make([]client.Object, 0, len(resources)+len(objects))
+	for _, resource := range resources {
+		manifests = append(manifests, resource)
+	}
+	objects = append(manifests, objects...)
+
+	var buf bytes.Buffer
+	for i, obj := range objects {
+		content, err := yaml.Marshal(obj)
+		if err != nil {
+			return "", fmt.Errorf("failed to marshal %s %s: %w", obj.GetObjectKind().GroupVersionKind().Kind, obj.GetName(), err)
+		}
+		if i > 0 {
+			buf.WriteString("---\n")
+		}
+		buf.Write(content)
+	}
+	return buf.String(), nil
+}
+
+// writeRawManifests renders the generated DGD as plain manifests into the profiling results
+func (r *DynamoGraphDeploymentRequestReconciler) writeRawManifests(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, transport ResultTransport, generatedDGD *nvidiacomv1alpha1.DynamoGraphDeployment) error {
+	logger := log.FromContext(ctx)
+
+	manifests, err := r.renderRawManifests(ctx, dgdr, generatedDGD)
+	if err != nil {
+		return withFailureReason(nvidiacomv1alpha1.FailureReasonSpecParseError,
+			fmt.Errorf("failed to render raw manifests: %w", err))
+	}
+
+	if err := transport.Store(ctx, dgdr, map[string]string{RawManifestsKey: manifests}); err != nil {
+		return fmt.Errorf("failed to write raw manifests: %w", err)
+	}
+
+	dgdr.Status.RenderedManifests = transport.Reference(dgdr)
+	logger.Info("Rendered raw manifests", "results", dgdr.Status.RenderedManifests, "key", RawManifestsKey)
+	return nil
+}
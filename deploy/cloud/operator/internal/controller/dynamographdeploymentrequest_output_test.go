@@ -0,0 +1,130 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+
+	dynamoCommon "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/dynamo/common"
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/consts"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+)
+
+var _ = Describe("DGDR Workload Flavor", func() {
+	var reconciler *DynamoGraphDeploymentRequestReconciler
+
+	BeforeEach(func() {
+		reconciler = &DynamoGraphDeploymentRequestReconciler{
+			Client:      k8sClient,
+			Recorder:    record.NewFakeRecorder(100),
+			RBACManager: &MockRBACManager{},
+		}
+	})
+
+	newDGDR := func(format nvidiacomv1alpha1.OutputFormat, flavor nvidiacomv1alpha1.WorkloadFlavor) *nvidiacomv1alpha1.DynamoGraphDeploymentRequest {
+		return &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-dgdr-flavor", Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model:   "Qwen/Qwen3-0.6B",
+				Backend: BackendVLLM,
+				Output:  &nvidiacomv1alpha1.OutputSpec{Format: format, WorkloadFlavor: flavor},
+			},
+		}
+	}
+
+	newDGD := func(gpu string) *nvidiacomv1alpha1.DynamoGraphDeployment {
+		worker := &nvidiacomv1alpha1.DynamoComponentDeploymentSharedSpec{
+			ComponentType: consts.ComponentTypeWorker,
+			Replicas:      ptr.To(int32(1)),
+			Multinode:     &nvidiacomv1alpha1.MultinodeSpec{NodeCount: 2},
+			ExtraPodSpec: &dynamoCommon.ExtraPodSpec{
+				MainContainer: &corev1.Container{
+					Image:   "worker-image",
+					Command: []string{"python3", "-m", "dynamo.vllm"},
+					Args:    []string{"--model", "Qwen/Qwen3-0.6B"},
+				},
+			},
+		}
+		if gpu != "" {
+			worker.Resources = &dynamoCommon.Resources{Limits: &dynamoCommon.ResourceItem{GPU: gpu}}
+		}
+		return &nvidiacomv1alpha1.DynamoGraphDeployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-dgd-flavor", Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentSpec{
+				BackendFramework: BackendVLLM,
+				Services: map[string]*nvidiacomv1alpha1.DynamoComponentDeploymentSharedSpec{
+					"VllmDecodeWorker": worker,
+				},
+			},
+		}
+	}
+
+	It("Should reject multinode services in plain manifests without a flavor", func() {
+		dgdr := newDGDR(nvidiacomv1alpha1.OutputFormatRawManifests, "")
+		err := reconciler.applyWorkloadFlavor(dgdr, newDGD("8"))
+		Expect(err).To(MatchError(ContainSubstring("set spec.output.workloadFlavor to lws or grove")))
+		Expect(failureReasonFromError(err, "")).Should(Equal(nvidiacomv1alpha1.FailureReasonValidationError))
+
+		// DGDs leave multinode services to the Dynamo operator
+		dgdr = newDGDR(nvidiacomv1alpha1.OutputFormatDynamoGraphDeployment, "")
+		dgd := newDGD("8")
+		Expect(reconciler.applyWorkloadFlavor(dgdr, dgd)).Should(Succeed())
+		Expect(dgd.Annotations).NotTo(HaveKey(consts.KubeAnnotationEnableGrove))
+	})
+
+	It("Should check the topology against the lws flavor", func() {
+		dgdr := newDGDR(nvidiacomv1alpha1.OutputFormatRawManifests, nvidiacomv1alpha1.WorkloadFlavorLWS)
+		Expect(reconciler.applyWorkloadFlavor(dgdr, newDGD(""))).To(MatchError(ContainSubstring("sets no GPU limit")))
+		Expect(reconciler.applyWorkloadFlavor(dgdr, newDGD("8"))).Should(Succeed())
+
+		manifests, err := reconciler.renderRawManifests(context.Background(), dgdr, newDGD("8"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(manifests).Should(ContainSubstring("kind: LeaderWorkerSet"))
+
+		// A DGD pinned to LeaderWorkerSet needs it installed
+		dgdr = newDGDR(nvidiacomv1alpha1.OutputFormatDynamoGraphDeployment, nvidiacomv1alpha1.WorkloadFlavorLWS)
+		Expect(reconciler.applyWorkloadFlavor(dgdr, newDGD("8"))).To(MatchError(ContainSubstring("requires LeaderWorkerSet")))
+		reconciler.Config.LWS.Enabled = true
+		dgd := newDGD("8")
+		Expect(reconciler.applyWorkloadFlavor(dgdr, dgd)).Should(Succeed())
+		Expect(dgd.Annotations).Should(HaveKeyWithValue(consts.KubeAnnotationEnableGrove, "false"))
+	})
+
+	It("Should render and pin the grove flavor", func() {
+		dgdr := newDGDR(nvidiacomv1alpha1.OutputFormatRawManifests, nvidiacomv1alpha1.WorkloadFlavorGrove)
+		Expect(reconciler.validateWorkloadFlavor(dgdr)).Should(Succeed())
+		Expect(reconciler.applyWorkloadFlavor(dgdr, newDGD(""))).Should(Succeed())
+		manifests, err := reconciler.renderRawManifests(context.Background(), dgdr, newDGD(""))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(manifests).Should(ContainSubstring("kind: PodCliqueSet"))
+
+		dgdr = newDGDR(nvidiacomv1alpha1.OutputFormatDynamoGraphDeployment, nvidiacomv1alpha1.WorkloadFlavorGrove)
+		Expect(reconciler.validateWorkloadFlavor(dgdr)).To(MatchError(ValidationErrorGroveNotEnabled))
+		reconciler.Config.Grove.Enabled = true
+		Expect(reconciler.validateWorkloadFlavor(dgdr)).Should(Succeed())
+		dgd := newDGD("")
+		Expect(reconciler.applyWorkloadFlavor(dgdr, dgd)).Should(Succeed())
+		Expect(dgd.Annotations).Should(HaveKeyWithValue(consts.KubeAnnotationEnableGrove, "true"))
+	})
+})
@@ -0,0 +1,96 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+)
+
+// trackOwnedResource records an object created for the DGDR in status.ownedResources, replacing
+// the entry of an earlier object with the same kind, namespace and name. The status is persisted by
+// the caller's next status update.
+func (r *DynamoGraphDeploymentRequestReconciler) trackOwnedResource(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, role nvidiacomv1alpha1.OwnedResourceRole, obj client.Object) {
+	gvk, err := apiutil.GVKForObject(obj, r.Scheme())
+	if err != nil {
+		log.FromContext(ctx).Error(err, "Failed to record owned resource", "name", obj.GetName(), "role", role)
+		return
+	}
+	entry := nvidiacomv1alpha1.OwnedResource{
+		APIVersion: gvk.GroupVersion().String(),
+		Kind:       gvk.Kind,
+		Namespace:  obj.GetNamespace(),
+		Name:       obj.GetName(),
+		UID:        obj.GetUID(),
+		Role:       role,
+	}
+	if i := ownedResourceIndex(dgdr, entry.Kind, entry.Namespace, entry.Name); i >= 0 {
+		dgdr.Status.OwnedResources[i] = entry
+		return
+	}
+	dgdr.Status.OwnedResources = append(dgdr.Status.OwnedResources, entry)
+}
+
+// untrackOwnedResource removes an object that was deleted, or found gone, from status.ownedResources
+func untrackOwnedResource(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, kind, namespace, name string) {
+	if i := ownedResourceIndex(dgdr, kind, namespace, name); i >= 0 {
+		dgdr.Status.OwnedResources = slices.Delete(dgdr.Status.OwnedResources, i, i+1)
+	}
+}
+
+// untrackOwnedResources removes all the objects with a role from status.ownedResources, once the
+// operator deleted them as a whole
+func untrackOwnedResources(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, role nvidiacomv1alpha1.OwnedResourceRole) {
+	dgdr.Status.OwnedResources = slices.DeleteFunc(dgdr.Status.OwnedResources, func(entry nvidiacomv1alpha1.OwnedResource) bool {
+		return entry.Role == role
+	})
+}
+
+// ownedResourceIndex returns the index of an object in status.ownedResources, or -1
+func ownedResourceIndex(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, kind, namespace, name string) int {
+	return slices.IndexFunc(dgdr.Status.OwnedResources, func(entry nvidiacomv1alpha1.OwnedResource) bool {
+		return entry.Kind == kind && entry.Namespace == namespace && entry.Name == name
+	})
+}
+
+// trackProfilingOutput records the ConfigMap or Secret the profiling results were delivered in.
+// Results delivered to a volume are not kept in an object.
+func (r *DynamoGraphDeploymentRequestReconciler) trackProfilingOutput(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	var output client.Object
+	switch getResultTransport(dgdr) {
+	case nvidiacomv1alpha1.ResultTransportConfigMap:
+		output = &corev1.ConfigMap{}
+	case nvidiacomv1alpha1.ResultTransportSecret, nvidiacomv1alpha1.ResultTransportHTTP:
+		output = &corev1.Secret{}
+	default:
+		return nil
+	}
+	if err := r.Get(ctx, types.NamespacedName{Name: GetOutputConfigMapName(dgdr), Namespace: dgdr.Namespace}, output); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	r.trackOwnedResource(ctx, dgdr, nvidiacomv1alpha1.OwnedResourceRoleOutput, output)
+	return nil
+}
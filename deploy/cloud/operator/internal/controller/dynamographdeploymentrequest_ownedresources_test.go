@@ -0,0 +1,133 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var _ = Describe("DGDR Owned Resources", func() {
+	ctx := context.Background()
+	var reconciler *DynamoGraphDeploymentRequestReconciler
+
+	BeforeEach(func() {
+		reconciler = &DynamoGraphDeploymentRequestReconciler{
+			Client:       k8sClient,
+			Recorder:     record.NewFakeRecorder(100),
+			RBACManager:  &MockRBACManager{},
+			ProfilerMode: ProfilerModeMock,
+		}
+	})
+
+	It("Should record an object once per kind, namespace and name", func() {
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-dgdr-owned", Namespace: defaultNamespace},
+		}
+		job := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: "profile-test-dgdr-owned", Namespace: defaultNamespace, UID: "uid-1"}}
+		reconciler.trackOwnedResource(ctx, dgdr, nvidiacomv1alpha1.OwnedResourceRoleProfilingJob, job)
+		Expect(dgdr.Status.OwnedResources).Should(Equal([]nvidiacomv1alpha1.OwnedResource{{
+			APIVersion: "batch/v1",
+			Kind:       "Job",
+			Namespace:  defaultNamespace,
+			Name:       job.Name,
+			UID:        "uid-1",
+			Role:       nvidiacomv1alpha1.OwnedResourceRoleProfilingJob,
+		}}))
+
+		// A recreated object replaces the entry of its predecessor
+		job.UID = "uid-2"
+		reconciler.trackOwnedResource(ctx, dgdr, nvidiacomv1alpha1.OwnedResourceRoleProfilingJob, job)
+		cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: job.Name, Namespace: defaultNamespace}}
+		reconciler.trackOwnedResource(ctx, dgdr, nvidiacomv1alpha1.OwnedResourceRoleOutput, cm)
+		Expect(dgdr.Status.OwnedResources).Should(HaveLen(2))
+		Expect(dgdr.Status.OwnedResources[0].UID).Should(Equal(types.UID("uid-2")))
+		Expect(dgdr.Status.OwnedResources[1].Kind).Should(Equal("ConfigMap"))
+
+		untrackOwnedResource(dgdr, "Job", defaultNamespace, job.Name)
+		Expect(dgdr.Status.OwnedResources).Should(ConsistOf(HaveField("Kind", "ConfigMap")))
+		untrackOwnedResources(dgdr, nvidiacomv1alpha1.OwnedResourceRoleOutput)
+		Expect(dgdr.Status.OwnedResources).Should(BeEmpty())
+	})
+
+	It("Should list the profiling output and the DGD created for the DGDR", func() {
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-dgdr-owned-deploy", Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model:     "Qwen/Qwen3-0.6B",
+				Backend:   BackendVLLM,
+				AutoApply: true,
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					ProfilerImage: "test-profiler:latest",
+					Config: createTestConfig(map[string]interface{}{
+						"sla":   map[string]interface{}{"ttft": 200.0, "itl": 20.0},
+						"sweep": map[string]interface{}{"use_ai_configurator": true},
+					}),
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		DeferCleanup(func() {
+			_ = k8sClient.Delete(ctx, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: GetOutputConfigMapName(dgdr), Namespace: defaultNamespace}})
+			_ = k8sClient.Delete(ctx, dgdr)
+		})
+
+		request := reconcile.Request{NamespacedName: types.NamespacedName{Name: dgdr.Name, Namespace: dgdr.Namespace}}
+		updated := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{}
+		for i := 0; i < 5 && updated.Status.Deployment == nil; i++ {
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, request.NamespacedName, updated)).Should(Succeed())
+		}
+		Expect(updated.Status.Deployment).NotTo(BeNil())
+
+		dgd := &nvidiacomv1alpha1.DynamoGraphDeployment{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: updated.Status.Deployment.Name, Namespace: defaultNamespace}, dgd)).Should(Succeed())
+		DeferCleanup(func() { _ = k8sClient.Delete(ctx, dgd) })
+		output := &corev1.ConfigMap{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: GetOutputConfigMapName(dgdr), Namespace: defaultNamespace}, output)).Should(Succeed())
+
+		Expect(updated.Status.OwnedResources).Should(ConsistOf(
+			nvidiacomv1alpha1.OwnedResource{
+				APIVersion: "v1",
+				Kind:       "ConfigMap",
+				Namespace:  defaultNamespace,
+				Name:       output.Name,
+				UID:        output.UID,
+				Role:       nvidiacomv1alpha1.OwnedResourceRoleOutput,
+			},
+			nvidiacomv1alpha1.OwnedResource{
+				APIVersion: nvidiacomv1alpha1.GroupVersion.String(),
+				Kind:       "DynamoGraphDeployment",
+				Namespace:  defaultNamespace,
+				Name:       dgd.Name,
+				UID:        dgd.UID,
+				Role:       nvidiacomv1alpha1.OwnedResourceRoleDGD,
+			},
+		))
+	})
+})
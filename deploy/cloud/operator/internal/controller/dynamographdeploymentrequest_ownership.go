@@ -0,0 +1,97 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+)
+
+const (
+	// AnnotationOperatorInstance names the operator instance that claimed the DGDR. Removing it
+	// hands the DGDR over to the next instance reconciling it.
+	AnnotationOperatorInstance = "nvidia.com/dgdr-operator-instance"
+
+	// ConditionTypeNotOwner is True while the DGDR is managed by another operator instance than the
+	// one reporting it
+	ConditionTypeNotOwner = "NotOwner"
+
+	// Event reasons
+	EventReasonNotOwner = "NotOwner"
+
+	// Messages
+	MessageNotOwner = "Managed by operator instance %s, operator instance %s leaves it untouched"
+)
+
+// handleOwnership reports whether this operator instance manages the DGDR. Several operator
+// installations, e.g. namespace-restricted ones next to a cluster-wide one, may watch the same
+// DGDR; the first instance reconciling it claims it with the operator instance annotation and the
+// others record the NotOwner condition and leave it untouched. Without an operator instance every
+// DGDR is managed.
+func (r *DynamoGraphDeploymentRequestReconciler) handleOwnership(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) (bool, error) {
+	owner := dgdr.Annotations[AnnotationOperatorInstance]
+	if r.OperatorInstance == "" || owner == r.OperatorInstance {
+		return true, r.clearNotOwner(ctx, dgdr)
+	}
+	logger := log.FromContext(ctx)
+
+	if owner == "" {
+		// The update fails with a conflict if another instance claimed the DGDR first
+		if dgdr.Annotations == nil {
+			dgdr.Annotations = map[string]string{}
+		}
+		dgdr.Annotations[AnnotationOperatorInstance] = r.OperatorInstance
+		if err := r.Update(ctx, dgdr); err != nil {
+			return false, fmt.Errorf("failed to claim DGDR for operator instance %s: %w", r.OperatorInstance, err)
+		}
+		logger.Info("Claimed DGDR", "operatorInstance", r.OperatorInstance)
+		return true, r.clearNotOwner(ctx, dgdr)
+	}
+
+	message := fmt.Sprintf(MessageNotOwner, owner, r.OperatorInstance)
+	if condition := meta.FindStatusCondition(dgdr.Status.Conditions, ConditionTypeNotOwner); condition != nil &&
+		condition.Status == metav1.ConditionTrue && condition.Message == message {
+		return false, nil
+	}
+	logger.Info("DGDR is managed by another operator instance, skipping", "owner", owner, "operatorInstance", r.OperatorInstance)
+	r.Recorder.Event(dgdr, corev1.EventTypeNormal, EventReasonNotOwner, message)
+	meta.SetStatusCondition(&dgdr.Status.Conditions, metav1.Condition{
+		Type:               ConditionTypeNotOwner,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: dgdr.Generation,
+		Reason:             EventReasonNotOwner,
+		Message:            message,
+	})
+	return false, r.updateStatus(ctx, dgdr)
+}
+
+// clearNotOwner removes the NotOwner condition of a DGDR this instance owns. It is reported by
+// instances that do not own the DGDR, or by this instance before the DGDR was handed over to it.
+func (r *DynamoGraphDeploymentRequestReconciler) clearNotOwner(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	if !meta.RemoveStatusCondition(&dgdr.Status.Conditions, ConditionTypeNotOwner) {
+		return nil
+	}
+	return r.updateStatus(ctx, dgdr)
+}
@@ -0,0 +1,107 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var _ = Describe("DGDR Operator Instance Ownership", func() {
+	It("Should only let the operator instance that claimed a DGDR manage it", func() {
+		ctx := context.Background()
+		newReconciler := func(instance string) *DynamoGraphDeploymentRequestReconciler {
+			return &DynamoGraphDeploymentRequestReconciler{
+				Client:           k8sClient,
+				Recorder:         record.NewFakeRecorder(100),
+				RBACManager:      &MockRBACManager{},
+				OperatorInstance: instance,
+			}
+		}
+		restricted, clusterWide := newReconciler("team-a/dynamo-platform"), newReconciler("dynamo-system/dynamo-platform")
+
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-dgdr-ownership", Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model:   "Qwen/Qwen3-0.6B",
+				Backend: BackendVLLM,
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					ProfilerImage: "test-profiler:latest",
+					Config: createTestConfig(map[string]interface{}{
+						"sla":   map[string]interface{}{"ttft": 200.0, "itl": 20.0},
+						"sweep": map[string]interface{}{"use_ai_configurator": true},
+					}),
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, dgdr) }()
+
+		request := reconcile.Request{NamespacedName: types.NamespacedName{Name: dgdr.Name, Namespace: defaultNamespace}}
+		_, err := restricted.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+
+		updated := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{}
+		Expect(k8sClient.Get(ctx, request.NamespacedName, updated)).Should(Succeed())
+		Expect(updated.Annotations).Should(HaveKeyWithValue(AnnotationOperatorInstance, "team-a/dynamo-platform"))
+		Expect(updated.Finalizers).ShouldNot(BeEmpty())
+		state := updated.Status.State
+
+		// The other instance reports the owner and leaves the DGDR untouched
+		for range 2 {
+			_, err = clusterWide.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+		}
+		Expect(k8sClient.Get(ctx, request.NamespacedName, updated)).Should(Succeed())
+		Expect(updated.Annotations).Should(HaveKeyWithValue(AnnotationOperatorInstance, "team-a/dynamo-platform"))
+		Expect(updated.Status.State).Should(Equal(state))
+		condition := meta.FindStatusCondition(updated.Status.Conditions, ConditionTypeNotOwner)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).Should(Equal(metav1.ConditionTrue))
+		Expect(condition.Message).Should(Equal("Managed by operator instance team-a/dynamo-platform, operator instance dynamo-system/dynamo-platform leaves it untouched"))
+
+		// Removing the annotation hands the DGDR over to the next instance reconciling it
+		delete(updated.Annotations, AnnotationOperatorInstance)
+		Expect(k8sClient.Update(ctx, updated)).Should(Succeed())
+		_, err = clusterWide.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(k8sClient.Get(ctx, request.NamespacedName, updated)).Should(Succeed())
+		Expect(updated.Annotations).Should(HaveKeyWithValue(AnnotationOperatorInstance, "dynamo-system/dynamo-platform"))
+		Expect(meta.FindStatusCondition(updated.Status.Conditions, ConditionTypeNotOwner)).To(BeNil())
+
+		// Handing the DGDR over by setting the annotation clears the condition reported before
+		_, err = restricted.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(k8sClient.Get(ctx, request.NamespacedName, updated)).Should(Succeed())
+		Expect(meta.IsStatusConditionTrue(updated.Status.Conditions, ConditionTypeNotOwner)).To(BeTrue())
+		updated.Annotations[AnnotationOperatorInstance] = "team-a/dynamo-platform"
+		Expect(k8sClient.Update(ctx, updated)).Should(Succeed())
+		_, err = restricted.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(k8sClient.Get(ctx, request.NamespacedName, updated)).Should(Succeed())
+		Expect(meta.FindStatusCondition(updated.Status.Conditions, ConditionTypeNotOwner)).To(BeNil())
+	})
+})
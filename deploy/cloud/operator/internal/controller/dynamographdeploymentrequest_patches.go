@@ -0,0 +1,277 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/yaml"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+)
+
+const (
+	// ConditionTypePatchesApplied reports the fields of the generated deployment changed by deploymentOverrides.patches
+	ConditionTypePatchesApplied = "PatchesApplied"
+
+	// ReasonPatchesApplied is the reason of the PatchesApplied condition
+	ReasonPatchesApplied = "PatchesApplied"
+
+	// Messages
+	MessagePatchesApplied   = "Applied %d patches, changed %s"
+	MessagePatchesNoChanges = "Applied %d patches, they changed nothing"
+
+	// maxReportedPatchedFields bounds the changed fields listed in the PatchesApplied condition
+	maxReportedPatchedFields = 20
+)
+
+// protectedPatchPaths are the JSON pointers of the fields patches must not change, the operator
+// manages the identity of the generated deployment
+var protectedPatchPaths = []string{"/apiVersion", "/kind", "/metadata/name", "/metadata/namespace"}
+
+// ValidateDeploymentPatches checks that every patch of spec.deploymentOverrides.patches parses and
+// leaves the identity of the deployment alone. Whether a patch applies is only known once the
+// deployment has been generated.
+func ValidateDeploymentPatches(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) field.ErrorList {
+	if dgdr.Spec.DeploymentOverrides == nil {
+		return nil
+	}
+	var allErrs field.ErrorList
+	patchesPath := field.NewPath("spec", "deploymentOverrides", "patches")
+	for i, patch := range dgdr.Spec.DeploymentOverrides.Patches {
+		path := patchesPath.Index(i).Child("patch")
+		document, err := yaml.YAMLToJSON([]byte(patch.Patch))
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(path, patch.Patch, err.Error()))
+			continue
+		}
+		switch patch.Type {
+		case nvidiacomv1alpha1.DeploymentPatchTypeJSON6902:
+			operations, err := jsonpatch.DecodePatch(document)
+			if err != nil {
+				allErrs = append(allErrs, field.Invalid(path, patch.Patch, err.Error()))
+				continue
+			}
+			for j, operation := range operations {
+				pointer, err := operation.Path()
+				if err != nil {
+					allErrs = append(allErrs, field.Invalid(path.Index(j), patch.Patch, err.Error()))
+				} else if isProtectedPatchPath(pointer) {
+					allErrs = append(allErrs, field.Forbidden(path.Index(j), fmt.Sprintf("must not change %s", pointer)))
+				}
+			}
+		case nvidiacomv1alpha1.DeploymentPatchTypeStrategicMerge:
+			var object map[string]interface{}
+			if err := json.Unmarshal(document, &object); err != nil || object == nil {
+				allErrs = append(allErrs, field.Invalid(path, patch.Patch, "must be a partial DynamoGraphDeployment object"))
+				continue
+			}
+			metadata, _ := object["metadata"].(map[string]interface{})
+			for _, pointer := range protectedPatchPaths {
+				key := pointer[strings.LastIndex(pointer, "/")+1:]
+				fields := object
+				if strings.HasPrefix(pointer, "/metadata/") {
+					fields = metadata
+				}
+				if _, set := fields[key]; set {
+					allErrs = append(allErrs, field.Forbidden(path, fmt.Sprintf("must not change %s", pointer)))
+				}
+			}
+		default:
+			allErrs = append(allErrs, field.NotSupported(patchesPath.Index(i).Child("type"), patch.Type,
+				[]string{string(nvidiacomv1alpha1.DeploymentPatchTypeJSON6902), string(nvidiacomv1alpha1.DeploymentPatchTypeStrategicMerge)}))
+		}
+	}
+	return allErrs
+}
+
+// isProtectedPatchPath reports whether a JSON pointer addresses a protected field or its parent
+func isProtectedPatchPath(pointer string) bool {
+	if pointer == "" || pointer == "/metadata" {
+		return true
+	}
+	for _, protected := range protectedPatchPaths {
+		if pointer == protected || strings.HasPrefix(pointer, protected+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// applyDeploymentPatches applies spec.deploymentOverrides.patches to the generated DGD in order and
+// reports the fields they changed in the PatchesApplied condition
+func applyDeploymentPatches(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, dgd *nvidiacomv1alpha1.DynamoGraphDeployment) error {
+	if dgdr.Spec.DeploymentOverrides == nil || len(dgdr.Spec.DeploymentOverrides.Patches) == 0 {
+		meta.RemoveStatusCondition(&dgdr.Status.Conditions, ConditionTypePatchesApplied)
+		return nil
+	}
+	patches := dgdr.Spec.DeploymentOverrides.Patches
+
+	original, err := json.Marshal(dgd)
+	if err != nil {
+		return fmt.Errorf("failed to encode generated deployment: %w", err)
+	}
+	document := original
+	for i, patch := range patches {
+		if document, err = applyDeploymentPatch(document, patch); err != nil {
+			return withFailureReason(nvidiacomv1alpha1.FailureReasonValidationError,
+				fmt.Errorf("deploymentOverrides.patches[%d] does not apply to the generated deployment: %w", i, err))
+		}
+	}
+
+	// Fields the DGD does not have would be dropped silently, reject them instead
+	patched := &nvidiacomv1alpha1.DynamoGraphDeployment{}
+	decoder := json.NewDecoder(bytes.NewReader(document))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(patched); err != nil {
+		return withFailureReason(nvidiacomv1alpha1.FailureReasonValidationError,
+			fmt.Errorf("deploymentOverrides.patches produce an invalid DynamoGraphDeployment: %w", err))
+	}
+	if patched.Name != dgd.Name || patched.Namespace != dgd.Namespace {
+		return withFailureReason(nvidiacomv1alpha1.FailureReasonValidationError,
+			fmt.Errorf("deploymentOverrides.patches must not change the name or namespace of the generated deployment"))
+	}
+
+	changed, err := patchedFields(original, document)
+	if err != nil {
+		return err
+	}
+	*dgd = *patched
+
+	message := fmt.Sprintf(MessagePatchesNoChanges, len(patches))
+	if len(changed) > 0 {
+		if len(changed) > maxReportedPatchedFields {
+			changed = append(changed[:maxReportedPatchedFields], fmt.Sprintf("and %d more", len(changed)-maxReportedPatchedFields))
+		}
+		message = fmt.Sprintf(MessagePatchesApplied, len(patches), strings.Join(changed, ", "))
+	}
+	meta.SetStatusCondition(&dgdr.Status.Conditions, metav1.Condition{
+		Type:               ConditionTypePatchesApplied,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: dgdr.Generation,
+		Reason:             ReasonPatchesApplied,
+		Message:            message,
+	})
+	return nil
+}
+
+// applyDeploymentPatch applies one patch to the JSON document of a DGD
+func applyDeploymentPatch(document []byte, patch nvidiacomv1alpha1.DeploymentPatch) ([]byte, error) {
+	patchDocument, err := yaml.YAMLToJSON([]byte(patch.Patch))
+	if err != nil {
+		return nil, err
+	}
+	switch patch.Type {
+	case nvidiacomv1alpha1.DeploymentPatchTypeJSON6902:
+		operations, err := jsonpatch.DecodePatch(patchDocument)
+		if err != nil {
+			return nil, err
+		}
+		return operations.Apply(document)
+	case nvidiacomv1alpha1.DeploymentPatchTypeStrategicMerge:
+		schema, err := strategicpatch.NewPatchMetaFromStruct(&nvidiacomv1alpha1.DynamoGraphDeployment{})
+		if err != nil {
+			return nil, err
+		}
+		return strategicpatch.StrategicMergePatchUsingLookupPatchMeta(document, patchDocument, deploymentPatchMeta{schema})
+	default:
+		return nil, fmt.Errorf("unknown patch type %q", patch.Type)
+	}
+}
+
+// deploymentPatchMeta looks up the strategic merge metadata of the DGD types. Unlike the
+// Kubernetes types they hold maps of structs, e.g. spec.services, which the struct lookup of
+// strategicpatch does not descend into: map values are merged as the struct they hold.
+type deploymentPatchMeta struct {
+	strategicpatch.PatchMetaFromStruct
+}
+
+// mapElem returns the value type of the map the lookup is at, if it is at one
+func (m deploymentPatchMeta) mapElem() (reflect.Type, bool) {
+	t := m.T
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Map {
+		return nil, false
+	}
+	return t.Elem(), true
+}
+
+func (m deploymentPatchMeta) LookupPatchMetadataForStruct(key string) (strategicpatch.LookupPatchMeta, strategicpatch.PatchMeta, error) {
+	if elem, ok := m.mapElem(); ok {
+		return deploymentPatchMeta{strategicpatch.PatchMetaFromStruct{T: elem}}, strategicpatch.PatchMeta{}, nil
+	}
+	schema, patchMeta, err := m.PatchMetaFromStruct.LookupPatchMetadataForStruct(key)
+	if err != nil {
+		return nil, strategicpatch.PatchMeta{}, err
+	}
+	return deploymentPatchMeta{schema.(strategicpatch.PatchMetaFromStruct)}, patchMeta, nil
+}
+
+func (m deploymentPatchMeta) LookupPatchMetadataForSlice(key string) (strategicpatch.LookupPatchMeta, strategicpatch.PatchMeta, error) {
+	if elem, ok := m.mapElem(); ok {
+		// Map values have no merge key, lists held by a map are replaced
+		if elem.Kind() != reflect.Slice {
+			return nil, strategicpatch.PatchMeta{}, fmt.Errorf("expected slice, but got: %s", elem.Kind())
+		}
+		return deploymentPatchMeta{strategicpatch.PatchMetaFromStruct{T: elem.Elem()}}, strategicpatch.PatchMeta{}, nil
+	}
+	schema, patchMeta, err := m.PatchMetaFromStruct.LookupPatchMetadataForSlice(key)
+	if err != nil {
+		return nil, strategicpatch.PatchMeta{}, err
+	}
+	return deploymentPatchMeta{schema.(strategicpatch.PatchMetaFromStruct)}, patchMeta, nil
+}
+
+// patchedFields returns the dotted paths of the fields that differ between two JSON documents,
+// sorted. Objects are descended into, other values are reported as a whole.
+func patchedFields(original, patched []byte) ([]string, error) {
+	mergePatch, err := jsonpatch.CreateMergePatch(original, patched)
+	if err != nil {
+		return nil, fmt.Errorf("failed to diff patched deployment: %w", err)
+	}
+	var diff map[string]interface{}
+	if err := json.Unmarshal(mergePatch, &diff); err != nil {
+		return nil, fmt.Errorf("failed to diff patched deployment: %w", err)
+	}
+	var fields []string
+	var collect func(prefix string, object map[string]interface{})
+	collect = func(prefix string, object map[string]interface{}) {
+		for key, value := range object {
+			if nested, ok := value.(map[string]interface{}); ok && len(nested) > 0 {
+				collect(prefix+key+".", nested)
+				continue
+			}
+			fields = append(fields, prefix+key)
+		}
+	}
+	collect("", diff)
+	sort.Strings(fields)
+	return fields, nil
+}
@@ -0,0 +1,141 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	dynamoCommon "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/dynamo/common"
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/consts"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+var _ = Describe("DGDR Deployment Patches", func() {
+	newDGDRWithPatches := func(patches ...nvidiacomv1alpha1.DeploymentPatch) *nvidiacomv1alpha1.DynamoGraphDeploymentRequest {
+		return &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-dgdr", Namespace: defaultNamespace, Generation: 1},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				DeploymentOverrides: &nvidiacomv1alpha1.DeploymentOverridesSpec{Patches: patches},
+			},
+		}
+	}
+	newGeneratedDGD := func() *nvidiacomv1alpha1.DynamoGraphDeployment {
+		return &nvidiacomv1alpha1.DynamoGraphDeployment{
+			TypeMeta:   metav1.TypeMeta{APIVersion: nvidiacomv1alpha1.GroupVersion.String(), Kind: "DynamoGraphDeployment"},
+			ObjectMeta: metav1.ObjectMeta{Name: "serving", Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentSpec{
+				Services: map[string]*nvidiacomv1alpha1.DynamoComponentDeploymentSharedSpec{
+					"Frontend": {ComponentType: consts.ComponentTypeFrontend, Replicas: ptr.To(int32(1))},
+					"VllmDecodeWorker": {
+						ComponentType: consts.ComponentTypeWorker,
+						Replicas:      ptr.To(int32(2)),
+						ExtraPodSpec: &dynamoCommon.ExtraPodSpec{MainContainer: &corev1.Container{
+							Name: "main",
+							Env:  []corev1.EnvVar{{Name: "DYN_LOG", Value: "info"}},
+						}},
+					},
+				},
+			},
+		}
+	}
+
+	It("Should reject patches that do not parse or change the deployment's identity", func() {
+		dgdr := newDGDRWithPatches(
+			nvidiacomv1alpha1.DeploymentPatch{Type: nvidiacomv1alpha1.DeploymentPatchTypeJSON6902, Patch: "[{"},
+			nvidiacomv1alpha1.DeploymentPatch{Type: nvidiacomv1alpha1.DeploymentPatchTypeJSON6902, Patch: `[{"op": "replace", "path": "/metadata/name", "value": "other"}]`},
+			nvidiacomv1alpha1.DeploymentPatch{Type: nvidiacomv1alpha1.DeploymentPatchTypeStrategicMerge, Patch: "- not an object"},
+			nvidiacomv1alpha1.DeploymentPatch{Type: nvidiacomv1alpha1.DeploymentPatchTypeStrategicMerge, Patch: "metadata:\n  namespace: other"},
+		)
+		errs := ValidateDeploymentPatches(dgdr)
+		Expect(errs).Should(HaveLen(4))
+		Expect(errs[1].Field).Should(Equal("spec.deploymentOverrides.patches[1].patch[0]"))
+		Expect(errs[3].Detail).Should(ContainSubstring("/metadata/namespace"))
+
+		dgdr = newDGDRWithPatches(
+			nvidiacomv1alpha1.DeploymentPatch{Type: nvidiacomv1alpha1.DeploymentPatchTypeJSON6902, Patch: "- op: add\n  path: /metadata/labels\n  value: {team: serving}"},
+			nvidiacomv1alpha1.DeploymentPatch{Type: nvidiacomv1alpha1.DeploymentPatchTypeStrategicMerge, Patch: "metadata:\n  annotations:\n    team: serving"},
+		)
+		Expect(ValidateDeploymentPatches(dgdr)).Should(BeEmpty())
+	})
+
+	It("Should apply the patches in order and report the changed fields", func() {
+		dgdr := newDGDRWithPatches(
+			nvidiacomv1alpha1.DeploymentPatch{
+				Type:  nvidiacomv1alpha1.DeploymentPatchTypeJSON6902,
+				Patch: "- op: replace\n  path: /spec/services/VllmDecodeWorker/replicas\n  value: 4",
+			},
+			nvidiacomv1alpha1.DeploymentPatch{
+				Type: nvidiacomv1alpha1.DeploymentPatchTypeStrategicMerge,
+				Patch: `spec:
+  services:
+    VllmDecodeWorker:
+      extraPodSpec:
+        mainContainer:
+          env:
+          - name: NCCL_DEBUG
+            value: INFO
+`,
+			},
+		)
+		dgd := newGeneratedDGD()
+
+		Expect(applyDeploymentPatches(dgdr, dgd)).To(Succeed())
+		worker := dgd.Spec.Services["VllmDecodeWorker"]
+		Expect(*worker.Replicas).Should(Equal(int32(4)))
+		// Strategic merge merges the env by name instead of replacing it
+		Expect(worker.ExtraPodSpec.MainContainer.Env).Should(ConsistOf(
+			corev1.EnvVar{Name: "DYN_LOG", Value: "info"},
+			corev1.EnvVar{Name: "NCCL_DEBUG", Value: "INFO"},
+		))
+		Expect(*dgd.Spec.Services["Frontend"].Replicas).Should(Equal(int32(1)))
+
+		condition := meta.FindStatusCondition(dgdr.Status.Conditions, ConditionTypePatchesApplied)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).Should(Equal(metav1.ConditionTrue))
+		Expect(condition.Message).Should(Equal("Applied 2 patches, changed " +
+			"spec.services.VllmDecodeWorker.extraPodSpec.mainContainer.env, spec.services.VllmDecodeWorker.replicas"))
+
+		dgdr.Spec.DeploymentOverrides.Patches = nil
+		Expect(applyDeploymentPatches(dgdr, dgd)).To(Succeed())
+		Expect(meta.FindStatusCondition(dgdr.Status.Conditions, ConditionTypePatchesApplied)).To(BeNil())
+	})
+
+	It("Should fail with a validation error when a patch does not apply", func() {
+		dgdr := newDGDRWithPatches(nvidiacomv1alpha1.DeploymentPatch{
+			Type:  nvidiacomv1alpha1.DeploymentPatchTypeJSON6902,
+			Patch: `[{"op": "replace", "path": "/spec/services/Planner/replicas", "value": 1}]`,
+		})
+		err := applyDeploymentPatches(dgdr, newGeneratedDGD())
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).Should(ContainSubstring("deploymentOverrides.patches[0]"))
+		Expect(failureReasonFromError(err, "")).Should(Equal(nvidiacomv1alpha1.FailureReasonValidationError))
+
+		// Fields a DynamoGraphDeployment does not have are rejected rather than dropped
+		dgdr = newDGDRWithPatches(nvidiacomv1alpha1.DeploymentPatch{
+			Type:  nvidiacomv1alpha1.DeploymentPatchTypeStrategicMerge,
+			Patch: "spec:\n  services:\n    Frontend:\n      replica: 3",
+		})
+		err = applyDeploymentPatches(dgdr, newGeneratedDGD())
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).Should(ContainSubstring("invalid DynamoGraphDeployment"))
+	})
+})
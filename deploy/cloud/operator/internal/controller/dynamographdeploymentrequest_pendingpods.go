@@ -0,0 +1,242 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/consts"
+)
+
+const (
+	// ReasonPodsPending is the DeploymentReady condition reason while pods of the DGD cannot start
+	ReasonPodsPending = "PodsPending"
+
+	// Pending reasons reported in the DeploymentReady condition
+	PendingReasonUnschedulable = "Unschedulable"
+	PendingReasonQuotaExceeded = "QuotaExceeded"
+
+	// Messages
+	MessageDeploymentWaiting = "DGD %s created, waiting for Ready"
+	MessagePodsPending       = "DGD %s is not Ready: %s"
+
+	// pendingPodsRecheckInterval is how often pods and quota events are checked while pods of the
+	// DGD are pending. Neither triggers reconciles of the DGDR.
+	pendingPodsRecheckInterval = 30 * time.Second
+
+	// EventReasonFailedCreate is the reason of the events recording that a controller could not
+	// create a pod, e.g. because it exceeded a ResourceQuota. Only these events are cached.
+	EventReasonFailedCreate = "FailedCreate"
+
+	// EventReasonIndex indexes cached events by their reason
+	EventReasonIndex = "reason"
+
+	// maxPendingReasons caps the reasons reported in the DeploymentReady condition
+	maxPendingReasons = 3
+
+	// maxPendingReasonMessage caps the length of the example message of each reason
+	maxPendingReasonMessage = 200
+)
+
+// pendingReason aggregates the pods, or the quota events, of one reason the pods of a DGD cannot start
+type pendingReason struct {
+	Reason  string
+	Count   int32
+	Message string
+	seen    time.Time
+}
+
+// eventReason is the EventReasonIndex of an event
+func eventReason(obj client.Object) []string {
+	return []string{obj.(*corev1.Event).Reason}
+}
+
+// apiReader returns the reader for objects the manager does not cache
+func (r *DynamoGraphDeploymentRequestReconciler) apiReader() client.Reader {
+	if r.APIReader != nil {
+		return r.APIReader
+	}
+	return r.Client
+}
+
+// collectPendingReasons aggregates why the DGD's pods cannot start into the most frequent reasons:
+// the scheduling diagnostics of its pending pods, and the quota rejections of the objects creating
+// them since the DGD was created. It also returns whether any pod of the DGD is pending.
+func (r *DynamoGraphDeploymentRequestReconciler) collectPendingReasons(ctx context.Context, dgd *nvidiacomv1alpha1.DynamoGraphDeployment) ([]pendingReason, bool, error) {
+	diagnostics, err := r.collectSchedulingDiagnostics(ctx, dgd)
+	if err != nil {
+		return nil, false, err
+	}
+	reasons := map[string]*pendingReason{}
+	aggregate := func(reason string) *pendingReason {
+		if _, exists := reasons[reason]; !exists {
+			reasons[reason] = &pendingReason{Reason: reason}
+		}
+		return reasons[reason]
+	}
+	for _, diagnostic := range diagnostics {
+		pods := aggregate(diagnostic.Reason)
+		pods.Count++
+		if pods.Message == "" {
+			pods.Message = diagnostic.Message
+		}
+	}
+
+	events := &corev1.EventList{}
+	if err := r.List(ctx, events, client.InNamespace(dgd.Namespace),
+		client.MatchingFields{EventReasonIndex: EventReasonFailedCreate}); err != nil {
+		return nil, false, fmt.Errorf("failed to list events of DynamoGraphDeployment %s: %w", dgd.Name, err)
+	}
+	for i := range events.Items {
+		event := &events.Items[i]
+		seen := eventTime(event)
+		if seen.Before(dgd.CreationTimestamp.Time) || !strings.Contains(event.Message, "exceeded quota") {
+			continue
+		}
+		if created, err := r.createsPodsOf(ctx, event.InvolvedObject, dgd); err != nil {
+			return nil, false, err
+		} else if !created {
+			continue
+		}
+		quota := aggregate(PendingReasonQuotaExceeded)
+		quota.Count += eventCount(event)
+		// Report the most recent explanation
+		if !seen.Before(quota.seen) {
+			quota.seen = seen
+			quota.Message = event.Message
+		}
+	}
+
+	sorted := make([]pendingReason, 0, len(reasons))
+	for _, reason := range reasons {
+		sorted = append(sorted, *reason)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Count != sorted[j].Count {
+			return sorted[i].Count > sorted[j].Count
+		}
+		return sorted[i].Reason < sorted[j].Reason
+	})
+	if len(sorted) > maxPendingReasons {
+		sorted = sorted[:maxPendingReasons]
+	}
+	return sorted, len(diagnostics) > 0, nil
+}
+
+// createsPodsOf reports whether the object a quota event was recorded on creates pods of the DGD.
+// Quota is enforced when pods are created, so the events are recorded on the ReplicaSets and
+// StatefulSets creating them, which carry the labels of their pods.
+func (r *DynamoGraphDeploymentRequestReconciler) createsPodsOf(ctx context.Context, involved corev1.ObjectReference, dgd *nvidiacomv1alpha1.DynamoGraphDeployment) (bool, error) {
+	obj := &metav1.PartialObjectMetadata{}
+	obj.SetGroupVersionKind(schema.FromAPIVersionAndKind(involved.APIVersion, involved.Kind))
+	if err := r.apiReader().Get(ctx, types.NamespacedName{Name: involved.Name, Namespace: dgd.Namespace}, obj); err != nil {
+		if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to get %s %s: %w", involved.Kind, involved.Name, err)
+	}
+	return obj.GetLabels()[consts.KubeLabelDynamoGraphDeploymentName] == dgd.Name, nil
+}
+
+// eventTime returns when an event was last observed
+func eventTime(event *corev1.Event) time.Time {
+	switch {
+	case event.Series != nil && !event.Series.LastObservedTime.IsZero():
+		return event.Series.LastObservedTime.Time
+	case !event.LastTimestamp.IsZero():
+		return event.LastTimestamp.Time
+	case !event.EventTime.IsZero():
+		return event.EventTime.Time
+	}
+	return event.CreationTimestamp.Time
+}
+
+// eventCount returns how often an event occurred
+func eventCount(event *corev1.Event) int32 {
+	if event.Series != nil && event.Series.Count > 0 {
+		return event.Series.Count
+	}
+	if event.Count > 0 {
+		return event.Count
+	}
+	return 1
+}
+
+// truncateMessage shortens a message to at most max runes, marking the cut with "..."
+func truncateMessage(message string, max int) string {
+	if utf8.RuneCountInString(message) <= max {
+		return message
+	}
+	return string([]rune(message)[:max]) + "..."
+}
+
+// summarizePendingReasons formats the pending reasons, e.g.
+// "Unschedulable (12): 0/4 nodes are available: 4 Insufficient nvidia.com/gpu"
+func summarizePendingReasons(reasons []pendingReason) string {
+	parts := make([]string, 0, len(reasons))
+	for _, reason := range reasons {
+		parts = append(parts, fmt.Sprintf("%s (%d): %s", reason.Reason, reason.Count, truncateMessage(reason.Message, maxPendingReasonMessage)))
+	}
+	return strings.Join(parts, "; ")
+}
+
+// reportPendingPods surfaces why the pods of a DGD that is not Ready cannot start in the
+// DeploymentReady condition of the DGDR, so users do not have to inspect the pods themselves.
+// It returns whether pods are still pending and should be checked again.
+func (r *DynamoGraphDeploymentRequestReconciler) reportPendingPods(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, dgd *nvidiacomv1alpha1.DynamoGraphDeployment) bool {
+	reasons, pending, err := r.collectPendingReasons(ctx, dgd)
+	if err != nil {
+		// The condition keeps its last message
+		log.FromContext(ctx).Error(err, "Failed to collect pending pod events")
+		return false
+	}
+	if len(reasons) == 0 {
+		// The pods got past the reported problems
+		if condition := meta.FindStatusCondition(dgdr.Status.Conditions, ConditionTypeDeploymentReady); condition != nil && condition.Reason == ReasonPodsPending {
+			meta.SetStatusCondition(&dgdr.Status.Conditions, metav1.Condition{
+				Type:    ConditionTypeDeploymentReady,
+				Status:  metav1.ConditionFalse,
+				Reason:  EventReasonDeploymentCreated,
+				Message: fmt.Sprintf(MessageDeploymentWaiting, dgd.Name),
+			})
+		}
+		return pending
+	}
+	meta.SetStatusCondition(&dgdr.Status.Conditions, metav1.Condition{
+		Type:    ConditionTypeDeploymentReady,
+		Status:  metav1.ConditionFalse,
+		Reason:  ReasonPodsPending,
+		Message: fmt.Sprintf(MessagePodsPending, dgd.Name, summarizePendingReasons(reasons)),
+	})
+	return true
+}
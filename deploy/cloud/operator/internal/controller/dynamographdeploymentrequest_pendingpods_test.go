@@ -0,0 +1,160 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/consts"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("DGDR Pending Pod Diagnostics", func() {
+	var reconciler *DynamoGraphDeploymentRequestReconciler
+
+	BeforeEach(func() {
+		reconciler = &DynamoGraphDeploymentRequestReconciler{
+			Client:      k8sClient,
+			Recorder:    record.NewFakeRecorder(100),
+			RBACManager: &MockRBACManager{},
+		}
+	})
+
+	createPod := func(ctx context.Context, name, dgdName, message string) {
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: defaultNamespace,
+				Labels:    map[string]string{consts.KubeLabelDynamoGraphDeploymentName: dgdName},
+			},
+			Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "main", Image: "vllm-runtime:0.6.0"}}},
+		}
+		Expect(k8sClient.Create(ctx, pod)).Should(Succeed())
+		pod.Status.Phase = corev1.PodPending
+		pod.Status.Conditions = []corev1.PodCondition{{
+			Type:    corev1.PodScheduled,
+			Status:  corev1.ConditionFalse,
+			Reason:  corev1.PodReasonUnschedulable,
+			Message: message,
+		}}
+		Expect(k8sClient.Status().Update(ctx, pod)).Should(Succeed())
+		DeferCleanup(func() { _ = k8sClient.Delete(ctx, pod, client.GracePeriodSeconds(0)) })
+	}
+
+	createReplicaSet := func(ctx context.Context, name, dgdName string) {
+		labels := map[string]string{consts.KubeLabelDynamoGraphDeploymentName: dgdName}
+		replicaSet := &appsv1.ReplicaSet{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: defaultNamespace, Labels: labels},
+			Spec: appsv1.ReplicaSetSpec{
+				Selector: &metav1.LabelSelector{MatchLabels: labels},
+				Template: corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Labels: labels},
+					Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "main", Image: "vllm-runtime:0.6.0"}}},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, replicaSet)).Should(Succeed())
+		DeferCleanup(func() { _ = k8sClient.Delete(ctx, replicaSet) })
+	}
+
+	createEvent := func(ctx context.Context, name, object, message string, count int32, at time.Time) {
+		event := &corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: name, Namespace: defaultNamespace},
+			InvolvedObject: corev1.ObjectReference{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: object, Namespace: defaultNamespace},
+			Reason:         EventReasonFailedCreate,
+			Message:        message,
+			Type:           corev1.EventTypeWarning,
+			Count:          count,
+			FirstTimestamp: metav1.NewTime(at),
+			LastTimestamp:  metav1.NewTime(at),
+		}
+		Expect(k8sClient.Create(ctx, event)).Should(Succeed())
+		DeferCleanup(func() { _ = k8sClient.Delete(ctx, event) })
+	}
+
+	It("Should report the most frequent reasons pods cannot start in the DeploymentReady condition", func() {
+		ctx := context.Background()
+		dgd := &nvidiacomv1alpha1.DynamoGraphDeployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-dgd-pending", Namespace: defaultNamespace},
+		}
+		Expect(k8sClient.Create(ctx, dgd)).Should(Succeed())
+		DeferCleanup(func() { _ = k8sClient.Delete(ctx, dgd) })
+
+		createPod(ctx, "test-dgd-pending-worker-0", dgd.Name, "0/4 nodes are available: 4 Insufficient nvidia.com/gpu.")
+		createPod(ctx, "test-dgd-pending-worker-1", dgd.Name, "0/4 nodes are available: 4 Insufficient nvidia.com/gpu.")
+		createPod(ctx, "other-worker-0", "other", "0/4 nodes are available")
+		createReplicaSet(ctx, "test-dgd-pending-frontend-7d9f", dgd.Name)
+		createReplicaSet(ctx, "test-dgd-pending-planner-1", dgd.Name)
+		createReplicaSet(ctx, "test-dgd-pending-2-frontend-5c8b", "test-dgd-pending-2")
+		now := time.Now().Add(time.Minute)
+		createEvent(ctx, "frontend-quota", "test-dgd-pending-frontend-7d9f",
+			`pods "test-dgd-pending-frontend-7d9f-x" is forbidden: exceeded quota: team-a, requested: cpu=4, used: cpu=60, limited: cpu=64`, 3, now)
+		// Objects of other DGDs, even if named like the DGD, and events from before the DGD was created are ignored
+		createEvent(ctx, "other-quota", "test-dgd-pending-2-frontend-5c8b", "exceeded quota: team-a", 20, now)
+		createEvent(ctx, "old-quota", "test-dgd-pending-planner-1", "exceeded quota: team-a", 20, now.Add(-time.Hour))
+
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{ObjectMeta: metav1.ObjectMeta{Name: "test-dgdr-pending", Namespace: defaultNamespace}}
+		Expect(reconciler.reportPendingPods(ctx, dgdr, dgd)).To(BeTrue())
+
+		condition := meta.FindStatusCondition(dgdr.Status.Conditions, ConditionTypeDeploymentReady)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).Should(Equal(metav1.ConditionFalse))
+		Expect(condition.Reason).Should(Equal(ReasonPodsPending))
+		Expect(condition.Message).Should(Equal("DGD test-dgd-pending is not Ready: " +
+			`QuotaExceeded (3): pods "test-dgd-pending-frontend-7d9f-x" is forbidden: exceeded quota: team-a, requested: cpu=4, used: cpu=60, limited: cpu=64; ` +
+			"Unschedulable (2): 0/4 nodes are available: 4 Insufficient nvidia.com/gpu."))
+	})
+
+	It("Should truncate long messages on rune boundaries", func() {
+		message := strings.Repeat("é", maxPendingReasonMessage+1)
+		Expect(summarizePendingReasons([]pendingReason{{Reason: PendingReasonUnschedulable, Count: 1, Message: message}})).
+			Should(Equal("Unschedulable (1): " + strings.Repeat("é", maxPendingReasonMessage) + "..."))
+	})
+
+	It("Should restore the waiting message once no pods are pending", func() {
+		ctx := context.Background()
+		dgd := &nvidiacomv1alpha1.DynamoGraphDeployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-dgd-scheduled", Namespace: defaultNamespace},
+		}
+		Expect(k8sClient.Create(ctx, dgd)).Should(Succeed())
+		DeferCleanup(func() { _ = k8sClient.Delete(ctx, dgd) })
+
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{ObjectMeta: metav1.ObjectMeta{Name: "test-dgdr-scheduled", Namespace: defaultNamespace}}
+		meta.SetStatusCondition(&dgdr.Status.Conditions, metav1.Condition{
+			Type:    ConditionTypeDeploymentReady,
+			Status:  metav1.ConditionFalse,
+			Reason:  ReasonPodsPending,
+			Message: "DGD test-dgd-scheduled is not Ready: Unschedulable (1): 0/4 nodes are available",
+		})
+		Expect(reconciler.reportPendingPods(ctx, dgdr, dgd)).To(BeFalse())
+
+		condition := meta.FindStatusCondition(dgdr.Status.Conditions, ConditionTypeDeploymentReady)
+		Expect(condition.Reason).Should(Equal(EventReasonDeploymentCreated))
+		Expect(condition.Message).Should(Equal("DGD test-dgd-scheduled created, waiting for Ready"))
+	})
+})
@@ -0,0 +1,271 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+)
+
+const (
+	// EventReasonPlacementInfeasible reports a generated deployment that does not fit the free GPUs
+	EventReasonPlacementInfeasible = "PlacementInfeasible"
+
+	// Messages
+	MessagePlacementInfeasible = "The generated deployment does not fit the GPUs free now: %s"
+	MessagePlacementFits       = "%s fits now"
+	MessagePlacementDrain      = "%s requires draining %s %s"
+	MessagePlacementPartial    = "%d of %s fit now, the rest requires draining %s %s"
+	MessagePlacementNoCapacity = "%s does not fit, %d nodes have %d or more allocatable GPUs"
+)
+
+// nodeGPUs is the GPU capacity of a schedulable node
+type nodeGPUs struct {
+	name        string
+	allocatable int64
+	free        int64
+}
+
+// podGPURequest returns the GPUs a pod requests, the larger of its containers' sum and its
+// largest init container, as the scheduler accounts them
+func podGPURequest(pod *corev1.Pod, resourceName corev1.ResourceName) int64 {
+	containerGPUs := func(container corev1.Container) int64 {
+		if quantity, ok := container.Resources.Requests[resourceName]; ok {
+			return quantity.Value()
+		}
+		// Extended resources may only set limits, which then are the requests
+		quantity := container.Resources.Limits[resourceName]
+		return quantity.Value()
+	}
+	var sum, initMax int64
+	for _, container := range pod.Spec.Containers {
+		sum += containerGPUs(container)
+	}
+	for _, container := range pod.Spec.InitContainers {
+		initMax = max(initMax, containerGPUs(container))
+	}
+	return max(sum, initMax)
+}
+
+// isNodeReady reports whether the node's Ready condition is True
+func isNodeReady(node *corev1.Node) bool {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == corev1.NodeReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// freeNodeGPUs returns the allocatable and free GPUs of the ready, schedulable nodes with GPUs,
+// sorted by name. Free GPUs are the allocatable ones minus those requested by running pods.
+func (r *DynamoGraphDeploymentRequestReconciler) freeNodeGPUs(ctx context.Context, resourceName corev1.ResourceName) ([]nodeGPUs, error) {
+	nodes := &corev1.NodeList{}
+	if err := r.List(ctx, nodes); err != nil {
+		return nil, fmt.Errorf("failed to list nodes: %w", err)
+	}
+	byName := map[string]*nodeGPUs{}
+	for i := range nodes.Items {
+		node := &nodes.Items[i]
+		allocatable := node.Status.Allocatable[resourceName]
+		if node.Spec.Unschedulable || !isNodeReady(node) || allocatable.Value() == 0 {
+			continue
+		}
+		byName[node.Name] = &nodeGPUs{name: node.Name, allocatable: allocatable.Value(), free: allocatable.Value()}
+	}
+
+	// Pods of every namespace hold GPUs, list them uncached rather than caching all pods
+	pods := &corev1.PodList{}
+	if err := r.apiReader().List(ctx, pods, client.MatchingFieldsSelector{
+		Selector: fields.ParseSelectorOrDie("status.phase!=" + string(corev1.PodSucceeded) + ",status.phase!=" + string(corev1.PodFailed)),
+	}); err != nil {
+		return nil, fmt.Errorf("failed to list pods: %w", err)
+	}
+	for i := range pods.Items {
+		if node, ok := byName[pods.Items[i].Spec.NodeName]; ok {
+			node.free -= podGPURequest(&pods.Items[i], resourceName)
+		}
+	}
+
+	result := make([]nodeGPUs, 0, len(byName))
+	for _, node := range byName {
+		node.free = max(node.free, 0)
+		result = append(result, *node)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].name < result[j].name })
+	return result, nil
+}
+
+// placementShape describes the replicas of a service, e.g. "8x1-GPU" or "2x2-node 8-GPU"
+func placementShape(replicas int32, gpusPerNode int64, nodesPerReplica int32) string {
+	if nodesPerReplica > 1 {
+		return fmt.Sprintf("%dx%d-node %d-GPU", replicas, nodesPerReplica, gpusPerNode)
+	}
+	return fmt.Sprintf("%dx%d-GPU", replicas, gpusPerNode)
+}
+
+// placeReplica places one replica onto nodesPerReplica distinct nodes with gpusPerNode free GPUs,
+// best fit first so large gaps stay available to larger replicas. It returns false, leaving the
+// nodes untouched, if the replica does not fit.
+func placeReplica(nodes []nodeGPUs, gpusPerNode int64, nodesPerReplica int32) bool {
+	candidates := make([]int, 0, len(nodes))
+	for i := range nodes {
+		if nodes[i].free >= gpusPerNode {
+			candidates = append(candidates, i)
+		}
+	}
+	if len(candidates) < int(nodesPerReplica) {
+		return false
+	}
+	sort.SliceStable(candidates, func(a, b int) bool { return nodes[candidates[a]].free < nodes[candidates[b]].free })
+	for _, i := range candidates[:nodesPerReplica] {
+		nodes[i].free -= gpusPerNode
+	}
+	return true
+}
+
+// countFreeNodes returns how many nodes have gpusPerNode GPUs free
+func countFreeNodes(nodes []nodeGPUs, gpusPerNode int64) int {
+	count := 0
+	for _, node := range nodes {
+		if node.free >= gpusPerNode {
+			count++
+		}
+	}
+	return count
+}
+
+// drainCandidates returns the nodes that have gpusPerNode GPUs once drained, those with the most
+// free GPUs first since draining them evicts the least
+func drainCandidates(nodes []nodeGPUs, gpusPerNode int64) []nodeGPUs {
+	var candidates []nodeGPUs
+	for _, node := range nodes {
+		if node.allocatable >= gpusPerNode && node.free < gpusPerNode {
+			candidates = append(candidates, node)
+		}
+	}
+	sort.SliceStable(candidates, func(a, b int) bool { return candidates[a].free > candidates[b].free })
+	return candidates
+}
+
+// planPlacement packs the replicas of the GPU services of the DGD onto the free GPUs of the nodes,
+// largest replicas first, and advises which nodes to drain for the replicas that do not fit
+func planPlacement(dgd *nvidiacomv1alpha1.DynamoGraphDeployment, nodes []nodeGPUs) *nvidiacomv1alpha1.PlacementReport {
+	var services []nvidiacomv1alpha1.ServicePlacement
+	for name, service := range dgd.Spec.Services {
+		if service == nil {
+			continue
+		}
+		gpus := serviceGPUs(service.Resources)
+		if gpus == nil || gpus.Value() == 0 {
+			continue
+		}
+		placement := nvidiacomv1alpha1.ServicePlacement{Service: name, Replicas: 1, GPUsPerNode: gpus.Value(), NodesPerReplica: service.GetNumberOfNodes()}
+		if service.Replicas != nil {
+			placement.Replicas = *service.Replicas
+		}
+		services = append(services, placement)
+	}
+	sort.Slice(services, func(i, j int) bool {
+		if services[i].GPUsPerNode != services[j].GPUsPerNode {
+			return services[i].GPUsPerNode > services[j].GPUsPerNode
+		}
+		return services[i].Service < services[j].Service
+	})
+
+	free := append([]nodeGPUs(nil), nodes...)
+	report := &nvidiacomv1alpha1.PlacementReport{Feasible: true, Services: services, ObservedTime: metav1.Now()}
+	for i := range services {
+		service := &services[i]
+		nodesPerReplica := max(service.NodesPerReplica, 1)
+		for service.FittingReplicas < service.Replicas && placeReplica(free, service.GPUsPerNode, nodesPerReplica) {
+			service.FittingReplicas++
+		}
+		shape := placementShape(service.Replicas, service.GPUsPerNode, nodesPerReplica)
+		if service.FittingReplicas == service.Replicas {
+			service.Message = fmt.Sprintf(MessagePlacementFits, shape)
+			continue
+		}
+
+		report.Feasible = false
+		// Nodes that still have the GPUs free host part of the next replica without draining
+		needed := int(service.Replicas-service.FittingReplicas)*int(nodesPerReplica) - countFreeNodes(free, service.GPUsPerNode)
+		candidates := drainCandidates(free, service.GPUsPerNode)
+		if len(candidates) < needed {
+			service.Message = fmt.Sprintf(MessagePlacementNoCapacity, shape, len(candidates), service.GPUsPerNode)
+			continue
+		}
+		names := make([]string, 0, needed)
+		for _, node := range candidates[:needed] {
+			names = append(names, node.name)
+		}
+		noun := "node"
+		if needed > 1 {
+			noun = "nodes"
+		}
+		if service.FittingReplicas == 0 {
+			service.Message = fmt.Sprintf(MessagePlacementDrain, shape, noun, strings.Join(names, ", "))
+		} else {
+			service.Message = fmt.Sprintf(MessagePlacementPartial, service.FittingReplicas, shape, noun, strings.Join(names, ", "))
+		}
+	}
+	return report
+}
+
+// setPlacementReport records in the status whether the generated deployment fits the GPUs that
+// are free now, so users can weigh the generated spec against the cluster before applying it.
+// The report is advisory: it is left unset when the nodes or pods cannot be listed. The status is
+// persisted by the caller's next status update.
+func (r *DynamoGraphDeploymentRequestReconciler) setPlacementReport(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) {
+	logger := log.FromContext(ctx)
+	dgdr.Status.Placement = nil
+	if isCPUOnly(dgdr) {
+		return
+	}
+	dgd, err := dgdr.BuildDeployment()
+	if err != nil {
+		logger.Error(err, "Failed to build the deployment for the placement report")
+		return
+	}
+	nodes, err := r.freeNodeGPUs(ctx, gpuResourceName(dgdr))
+	if err != nil {
+		logger.Error(err, "Failed to observe the free GPUs for the placement report")
+		return
+	}
+
+	report := planPlacement(dgd, nodes)
+	dgdr.Status.Placement = report
+	if !report.Feasible {
+		advice := make([]string, 0, len(report.Services))
+		for _, service := range report.Services {
+			advice = append(advice, fmt.Sprintf("%s: %s", service.Service, service.Message))
+		}
+		r.Recorder.Event(dgdr, corev1.EventTypeWarning, EventReasonPlacementInfeasible,
+			fmt.Sprintf(MessagePlacementInfeasible, strings.Join(advice, "; ")))
+	}
+}
@@ -0,0 +1,128 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+
+	dynamoCommon "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/dynamo/common"
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/consts"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+)
+
+var _ = Describe("DGDR Placement Report", func() {
+	gpuService := func(replicas int32, gpus string) *nvidiacomv1alpha1.DynamoComponentDeploymentSharedSpec {
+		return &nvidiacomv1alpha1.DynamoComponentDeploymentSharedSpec{
+			ComponentType: consts.ComponentTypeWorker,
+			Replicas:      ptr.To(replicas),
+			Resources:     &dynamoCommon.Resources{Limits: &dynamoCommon.ResourceItem{GPU: gpus}},
+		}
+	}
+
+	It("Should report replicas that fit the free GPUs now", func() {
+		dgd := &nvidiacomv1alpha1.DynamoGraphDeployment{Spec: nvidiacomv1alpha1.DynamoGraphDeploymentSpec{
+			Services: map[string]*nvidiacomv1alpha1.DynamoComponentDeploymentSharedSpec{
+				"Frontend":         {ComponentType: consts.ComponentTypeFrontend},
+				"VllmDecodeWorker": gpuService(8, "1"),
+			},
+		}}
+		nodes := []nodeGPUs{{name: "gpu-1", allocatable: 8, free: 3}, {name: "gpu-2", allocatable: 8, free: 5}}
+
+		report := planPlacement(dgd, nodes)
+		Expect(report.Feasible).To(BeTrue())
+		Expect(report.Services).Should(HaveLen(1))
+		Expect(report.Services[0].FittingReplicas).Should(Equal(int32(8)))
+		Expect(report.Services[0].Message).Should(Equal("8x1-GPU fits now"))
+	})
+
+	It("Should advise draining the nodes of replicas that do not fit", func() {
+		dgd := &nvidiacomv1alpha1.DynamoGraphDeployment{Spec: nvidiacomv1alpha1.DynamoGraphDeploymentSpec{
+			Services: map[string]*nvidiacomv1alpha1.DynamoComponentDeploymentSharedSpec{
+				"VllmPrefillWorker": gpuService(1, "8"),
+				"VllmDecodeWorker":  gpuService(3, "4"),
+			},
+		}}
+		nodes := []nodeGPUs{{name: "gpu-1", allocatable: 8, free: 3}, {name: "gpu-2", allocatable: 8, free: 6}, {name: "gpu-3", allocatable: 4, free: 4}}
+
+		report := planPlacement(dgd, nodes)
+		Expect(report.Feasible).To(BeFalse())
+		Expect(report.Services).Should(HaveLen(2))
+		// The largest replicas are placed first
+		Expect(report.Services[0].Service).Should(Equal("VllmPrefillWorker"))
+		Expect(report.Services[0].Message).Should(Equal("1x8-GPU requires draining node gpu-2"))
+		Expect(report.Services[1].FittingReplicas).Should(Equal(int32(2)))
+		Expect(report.Services[1].Message).Should(Equal("2 of 3x4-GPU fit now, the rest requires draining node gpu-1"))
+
+		dgd.Spec.Services["VllmPrefillWorker"] = gpuService(1, "16")
+		report = planPlacement(dgd, nodes)
+		Expect(report.Services[0].Message).Should(Equal("1x16-GPU does not fit, 0 nodes have 16 or more allocatable GPUs"))
+	})
+
+	It("Should place multinode replicas on distinct nodes", func() {
+		service := gpuService(1, "8")
+		service.Multinode = &nvidiacomv1alpha1.MultinodeSpec{NodeCount: 2}
+		dgd := &nvidiacomv1alpha1.DynamoGraphDeployment{Spec: nvidiacomv1alpha1.DynamoGraphDeploymentSpec{
+			Services: map[string]*nvidiacomv1alpha1.DynamoComponentDeploymentSharedSpec{"TrtllmWorker": service},
+		}}
+
+		report := planPlacement(dgd, []nodeGPUs{{name: "gpu-1", allocatable: 8, free: 8}, {name: "gpu-2", allocatable: 8, free: 8}})
+		Expect(report.Services[0].Message).Should(Equal("1x2-node 8-GPU fits now"))
+		report = planPlacement(dgd, []nodeGPUs{{name: "gpu-1", allocatable: 8, free: 8}, {name: "gpu-2", allocatable: 8, free: 2}})
+		Expect(report.Services[0].Message).Should(Equal("1x2-node 8-GPU requires draining node gpu-2"))
+		report = planPlacement(dgd, []nodeGPUs{{name: "gpu-1", allocatable: 8, free: 1}, {name: "gpu-2", allocatable: 8, free: 2}})
+		Expect(report.Services[0].Message).Should(Equal("1x2-node 8-GPU requires draining nodes gpu-2, gpu-1"))
+	})
+
+	It("Should subtract the GPUs of running pods from the node's allocatable GPUs", func() {
+		ctx := context.Background()
+		node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "test-placement-node"}}
+		Expect(k8sClient.Create(ctx, node)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, node) }()
+		node.Status.Allocatable = corev1.ResourceList{consts.KubeResourceGPUNvidia: resource.MustParse("8")}
+		node.Status.Conditions = []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}}
+		Expect(k8sClient.Status().Update(ctx, node)).Should(Succeed())
+
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-placement-pod", Namespace: defaultNamespace},
+			Spec: corev1.PodSpec{
+				NodeName: node.Name,
+				Containers: []corev1.Container{{
+					Name:  "worker",
+					Image: "test",
+					Resources: corev1.ResourceRequirements{
+						Limits: corev1.ResourceList{consts.KubeResourceGPUNvidia: resource.MustParse("6")},
+					},
+				}},
+			},
+		}
+		Expect(k8sClient.Create(ctx, pod)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, pod) }()
+
+		reconciler := &DynamoGraphDeploymentRequestReconciler{Client: k8sClient, Recorder: record.NewFakeRecorder(10)}
+		nodes, err := reconciler.freeNodeGPUs(ctx, consts.KubeResourceGPUNvidia)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(nodes).Should(ContainElement(nodeGPUs{name: node.Name, allocatable: 8, free: 2}))
+	})
+})
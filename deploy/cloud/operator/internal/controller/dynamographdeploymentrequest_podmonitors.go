@@ -0,0 +1,208 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/consts"
+	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/metadata"
+)
+
+// MessagePodMonitorNotManaged reports PodMonitors the DGDR would generate that exist and are not its own
+const MessagePodMonitorNotManaged = "PodMonitors %s exist and are not managed by this DGDR, they were left as they are"
+
+// errPodMonitorNotManaged is returned when a PodMonitor to apply exists and is not managed by the DGDR
+var errPodMonitorNotManaged = errors.New("PodMonitor is not managed by the DGDR")
+
+// PodMonitorEndpoint is the metrics endpoint Prometheus scrapes on the pods of a component type
+type PodMonitorEndpoint struct {
+	// Port is the name of the container port
+	Port string
+	// Path is the HTTP path of the metrics
+	Path string
+}
+
+// ParsePodMonitorEndpoints parses the comma separated componentType=port:path endpoints of the
+// --dgdr-pod-monitor-endpoints flag. Empty generates no PodMonitors.
+func ParsePodMonitorEndpoints(value string) (map[string]PodMonitorEndpoint, error) {
+	if value == "" {
+		return nil, nil
+	}
+	endpoints := map[string]PodMonitorEndpoint{}
+	for _, pair := range strings.Split(value, ",") {
+		componentType, endpoint, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		port, path, hasPath := strings.Cut(endpoint, ":")
+		if !ok || !hasPath || port == "" || !strings.HasPrefix(path, "/") {
+			return nil, fmt.Errorf("invalid pod monitor endpoint %q, must be componentType=port:/path", pair)
+		}
+		switch componentType {
+		case consts.ComponentTypeFrontend, consts.ComponentTypeWorker, consts.ComponentTypePlanner:
+		default:
+			return nil, fmt.Errorf("unknown component type %q in pod monitor endpoint %q, must be one of %s, %s, %s",
+				componentType, pair, consts.ComponentTypeFrontend, consts.ComponentTypeWorker, consts.ComponentTypePlanner)
+		}
+		if _, duplicate := endpoints[componentType]; duplicate {
+			return nil, fmt.Errorf("pod monitor endpoint of component type %s is configured more than once", componentType)
+		}
+		endpoints[componentType] = PodMonitorEndpoint{Port: port, Path: path}
+	}
+	return endpoints, nil
+}
+
+// generatePodMonitors returns a PodMonitor for every component type of the DGD with a configured endpoint
+func generatePodMonitors(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, dgd *nvidiacomv1alpha1.DynamoGraphDeployment, endpoints map[string]PodMonitorEndpoint) []*monitoringv1.PodMonitor {
+	componentTypes := map[string]bool{}
+	for _, spec := range dgd.Spec.Services {
+		if spec == nil {
+			continue
+		}
+		if _, ok := endpoints[spec.ComponentType]; ok {
+			componentTypes[spec.ComponentType] = true
+		}
+	}
+	sorted := make([]string, 0, len(componentTypes))
+	for componentType := range componentTypes {
+		sorted = append(sorted, componentType)
+	}
+	sort.Strings(sorted)
+
+	monitors := make([]*monitoringv1.PodMonitor, 0, len(sorted))
+	for _, componentType := range sorted {
+		endpoint := endpoints[componentType]
+		monitors = append(monitors, &monitoringv1.PodMonitor{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      fmt.Sprintf("%s-%s", dgd.Name, componentType),
+				Namespace: dgd.Namespace,
+				Labels: map[string]string{
+					LabelDGDRName:      dgdr.Name,
+					LabelDGDRNamespace: dgdr.Namespace,
+					LabelManagedBy:     LabelValueDynamoOperator,
+				},
+			},
+			Spec: monitoringv1.PodMonitorSpec{
+				Selector: metav1.LabelSelector{MatchLabels: map[string]string{
+					consts.KubeLabelDynamoGraphDeploymentName: dgd.Name,
+					consts.KubeLabelDynamoComponentType:       componentType,
+					consts.KubeLabelMetricsEnabled:            consts.KubeLabelValueTrue,
+				}},
+				PodMetricsEndpoints: []monitoringv1.PodMetricsEndpoint{{Port: endpoint.Port, Path: endpoint.Path}},
+			},
+		})
+	}
+	return monitors
+}
+
+// isPodMonitorOf reports whether the PodMonitor was generated for the DGDR
+func isPodMonitorOf(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, monitor *monitoringv1.PodMonitor) bool {
+	return monitor.Labels[LabelManagedBy] == LabelValueDynamoOperator &&
+		monitor.Labels[LabelDGDRName] == dgdr.Name && monitor.Labels[LabelDGDRNamespace] == dgdr.Namespace
+}
+
+// ensurePodMonitors creates or updates the PodMonitors of the DGD so that its pods are scraped.
+// Monitors in the DGDR namespace are owned by the DGDR. Owner references cannot cross namespaces, so
+// monitors of a DGD in another namespace are tracked by label and cleaned up when the DGDR is
+// finalized. Existing monitors of the same name that were not generated for the DGDR are left alone.
+func (r *DynamoGraphDeploymentRequestReconciler) ensurePodMonitors(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, dgd *nvidiacomv1alpha1.DynamoGraphDeployment) error {
+	if len(r.PodMonitorEndpoints) == 0 {
+		return nil
+	}
+	logger := log.FromContext(ctx)
+
+	var notManaged []string
+	for _, desired := range generatePodMonitors(dgdr, dgd, r.PodMonitorEndpoints) {
+		monitor := &monitoringv1.PodMonitor{ObjectMeta: metav1.ObjectMeta{Name: desired.Name, Namespace: desired.Namespace}}
+		result, err := controllerutil.CreateOrUpdate(ctx, r.Client, monitor, func() error {
+			if monitor.ResourceVersion != "" && !isPodMonitorOf(dgdr, monitor) {
+				return errPodMonitorNotManaged
+			}
+			monitor.Labels = desired.Labels
+			monitor.Spec = desired.Spec
+			if monitor.Namespace == dgdr.Namespace {
+				return controllerutil.SetControllerReference(dgdr, monitor, r.Client.Scheme())
+			}
+			return nil
+		})
+		if errors.Is(err, errPodMonitorNotManaged) {
+			logger.Info("Not overwriting PodMonitor the DGDR does not manage", "name", monitor.Name, "namespace", monitor.Namespace)
+			notManaged = append(notManaged, monitor.Namespace+"/"+monitor.Name)
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to apply PodMonitor %s: %w", desired.Name, err)
+		}
+		r.trackOwnedResource(ctx, dgdr, nvidiacomv1alpha1.OwnedResourceRolePodMonitor, monitor)
+		logger.Info("Applied PodMonitor", "name", monitor.Name, "result", result)
+	}
+	if len(notManaged) > 0 {
+		setWarning(dgdr, WarningPodMonitorNotManaged, fmt.Sprintf(MessagePodMonitorNotManaged, strings.Join(notManaged, ", ")))
+	} else {
+		clearWarning(dgdr, WarningPodMonitorNotManaged)
+	}
+	return nil
+}
+
+// cleanupPodMonitors deletes or retains the monitors of the DGD along with it. Deleted monitors in
+// the DGDR namespace are garbage collected through their owner reference, those in other namespaces
+// are deleted by label. Retained monitors lose their owner reference and the labels linking them to
+// the DGDR, so they keep scraping the retained DGD.
+func (r *DynamoGraphDeploymentRequestReconciler) cleanupPodMonitors(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, remove bool) error {
+	if len(r.PodMonitorEndpoints) == 0 || dgdr.Status.Deployment == nil || dgdr.Status.Deployment.Namespace == "" {
+		return nil
+	}
+	namespace := dgdr.Status.Deployment.Namespace
+	selector := client.MatchingLabels{LabelDGDRName: dgdr.Name, LabelDGDRNamespace: dgdr.Namespace}
+	if remove {
+		if namespace == dgdr.Namespace {
+			return nil
+		}
+		if err := r.DeleteAllOf(ctx, &monitoringv1.PodMonitor{}, client.InNamespace(namespace), selector); err != nil {
+			return fmt.Errorf("failed to delete PodMonitors: %w", err)
+		}
+		return nil
+	}
+
+	monitors := &monitoringv1.PodMonitorList{}
+	if err := r.List(ctx, monitors, client.InNamespace(namespace), selector); err != nil {
+		return fmt.Errorf("failed to list PodMonitors: %w", err)
+	}
+	for _, monitor := range monitors.Items {
+		monitor.OwnerReferences = slices.DeleteFunc(monitor.OwnerReferences, func(ref metav1.OwnerReference) bool {
+			return ref.UID == dgdr.UID
+		})
+		metadata.Strip(monitor, LabelDGDRName, LabelDGDRNamespace)
+		log.FromContext(ctx).Info("Retaining PodMonitor", "name", monitor.Name, "namespace", monitor.Namespace)
+		if err := r.Update(ctx, monitor); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to release PodMonitor %s from its DGDR: %w", monitor.Name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/consts"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("DGDR Pod Monitors", func() {
+	const testEndpoints = "frontend=http:/metrics,worker=system:/metrics"
+
+	It("Should parse the pod monitor endpoints", func() {
+		endpoints, err := ParsePodMonitorEndpoints(testEndpoints)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(endpoints).Should(Equal(map[string]PodMonitorEndpoint{
+			consts.ComponentTypeFrontend: {Port: "http", Path: "/metrics"},
+			consts.ComponentTypeWorker:   {Port: "system", Path: "/metrics"},
+		}))
+
+		endpoints, err = ParsePodMonitorEndpoints("")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(endpoints).Should(BeNil())
+
+		for _, invalid := range []string{"frontend", "frontend=http", "frontend=http:metrics", "router=http:/metrics", "worker=system:/a,worker=system:/b"} {
+			_, err = ParsePodMonitorEndpoints(invalid)
+			Expect(err).To(HaveOccurred(), invalid)
+		}
+	})
+
+	It("Should select the frontend and worker pods of the DGD", func() {
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{ObjectMeta: metav1.ObjectMeta{Name: "test-dgdr", Namespace: defaultNamespace}}
+		dgd := &nvidiacomv1alpha1.DynamoGraphDeployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "serving", Namespace: "serving-ns"},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentSpec{
+				Services: map[string]*nvidiacomv1alpha1.DynamoComponentDeploymentSharedSpec{
+					"Frontend":          {ComponentType: consts.ComponentTypeFrontend},
+					"VllmDecodeWorker":  {ComponentType: consts.ComponentTypeWorker},
+					"VllmPrefillWorker": {ComponentType: consts.ComponentTypeWorker},
+					"Planner":           {ComponentType: consts.ComponentTypePlanner},
+				},
+			},
+		}
+		endpoints, err := ParsePodMonitorEndpoints(testEndpoints)
+		Expect(err).NotTo(HaveOccurred())
+
+		monitors := generatePodMonitors(dgdr, dgd, endpoints)
+		Expect(monitors).Should(HaveLen(2))
+		Expect(monitors[0].Name).Should(Equal("serving-frontend"))
+		Expect(monitors[1].Name).Should(Equal("serving-worker"))
+		worker := monitors[1]
+		Expect(worker.Namespace).Should(Equal("serving-ns"))
+		Expect(worker.Labels).Should(HaveKeyWithValue(LabelDGDRName, dgdr.Name))
+		Expect(worker.Spec.Selector.MatchLabels).Should(Equal(map[string]string{
+			consts.KubeLabelDynamoGraphDeploymentName: "serving",
+			consts.KubeLabelDynamoComponentType:       consts.ComponentTypeWorker,
+			consts.KubeLabelMetricsEnabled:            consts.KubeLabelValueTrue,
+		}))
+		Expect(worker.Spec.PodMetricsEndpoints).Should(HaveLen(1))
+		Expect(worker.Spec.PodMetricsEndpoints[0].Port).Should(Equal("system"))
+		Expect(worker.Spec.PodMetricsEndpoints[0].Path).Should(Equal("/metrics"))
+	})
+
+	It("Should generate no PodMonitors without endpoints", func() {
+		reconciler := &DynamoGraphDeploymentRequestReconciler{Client: k8sClient}
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{ObjectMeta: metav1.ObjectMeta{Name: "test-dgdr", Namespace: defaultNamespace}}
+		dgd := &nvidiacomv1alpha1.DynamoGraphDeployment{ObjectMeta: metav1.ObjectMeta{Name: "serving", Namespace: defaultNamespace}}
+		// The PodMonitor CRD is not installed, any request would fail
+		Expect(reconciler.ensurePodMonitors(context.Background(), dgdr, dgd)).To(Succeed())
+		Expect(reconciler.cleanupPodMonitors(context.Background(), dgdr, true)).To(Succeed())
+	})
+
+	It("Should leave PodMonitors it does not manage alone and retain its own with the DGD", func() {
+		ctx := context.Background()
+		endpoints, err := ParsePodMonitorEndpoints(testEndpoints)
+		Expect(err).NotTo(HaveOccurred())
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-dgdr", Namespace: defaultNamespace, UID: "test-uid"},
+			Status: nvidiacomv1alpha1.DynamoGraphDeploymentRequestStatus{
+				Deployment: &nvidiacomv1alpha1.DeploymentStatus{Name: "serving", Namespace: defaultNamespace, Created: true},
+			},
+		}
+		dgd := &nvidiacomv1alpha1.DynamoGraphDeployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "serving", Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentSpec{
+				Services: map[string]*nvidiacomv1alpha1.DynamoComponentDeploymentSharedSpec{
+					"Frontend":         {ComponentType: consts.ComponentTypeFrontend},
+					"VllmDecodeWorker": {ComponentType: consts.ComponentTypeWorker},
+				},
+			},
+		}
+		userMonitor := &monitoringv1.PodMonitor{
+			ObjectMeta: metav1.ObjectMeta{Name: "serving-frontend", Namespace: defaultNamespace, Labels: map[string]string{"team": "serving"}},
+			Spec:       monitoringv1.PodMonitorSpec{PodMetricsEndpoints: []monitoringv1.PodMetricsEndpoint{{Port: "custom"}}},
+		}
+		reconciler := &DynamoGraphDeploymentRequestReconciler{
+			Client:              fake.NewClientBuilder().WithScheme(k8sClient.Scheme()).WithObjects(dgdr, userMonitor).Build(),
+			PodMonitorEndpoints: endpoints,
+		}
+
+		Expect(reconciler.ensurePodMonitors(ctx, dgdr, dgd)).To(Succeed())
+		monitor := &monitoringv1.PodMonitor{}
+		Expect(reconciler.Get(ctx, client.ObjectKeyFromObject(userMonitor), monitor)).To(Succeed())
+		Expect(monitor.Spec.PodMetricsEndpoints[0].Port).Should(Equal("custom"))
+		Expect(monitor.OwnerReferences).Should(BeEmpty())
+		Expect(dgdr.Status.Warnings).Should(ContainElement(HaveField("Type", WarningPodMonitorNotManaged)))
+
+		worker := types.NamespacedName{Name: "serving-worker", Namespace: defaultNamespace}
+		Expect(reconciler.Get(ctx, worker, monitor)).To(Succeed())
+		Expect(monitor.OwnerReferences).Should(HaveLen(1))
+
+		// RetainDGD keeps the monitors scraping the retained DGD
+		Expect(reconciler.cleanupPodMonitors(ctx, dgdr, false)).To(Succeed())
+		Expect(reconciler.Get(ctx, worker, monitor)).To(Succeed())
+		Expect(monitor.OwnerReferences).Should(BeEmpty())
+		Expect(monitor.Labels).ShouldNot(HaveKey(LabelDGDRName))
+		Expect(monitor.Labels).Should(HaveKeyWithValue(LabelManagedBy, LabelValueDynamoOperator))
+	})
+})
@@ -0,0 +1,146 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	dynamoCommon "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/dynamo/common"
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+)
+
+// PodSecurityProfile is the security context the operator applies to the pods it creates
+type PodSecurityProfile string
+
+const (
+	// PodSecurityProfileRestricted makes profiling job pods and generated deployments pass the
+	// restricted Pod Security Standard: non-root, RuntimeDefault seccomp, no privilege escalation,
+	// all capabilities dropped, and a read-only root filesystem with a writable /tmp
+	PodSecurityProfileRestricted PodSecurityProfile = "restricted"
+	// PodSecurityProfileNone leaves the security context to the images and generated deployments
+	PodSecurityProfileNone PodSecurityProfile = "none"
+
+	// VolumeNameTmp is the writable scratch volume mounted at TmpPath under the restricted profile
+	VolumeNameTmp = "dgdr-tmp"
+	// TmpPath is where the scratch volume is mounted
+	TmpPath = "/tmp"
+)
+
+// ParsePodSecurityProfile validates the value of the pod security profile flag
+func ParsePodSecurityProfile(value string) (PodSecurityProfile, error) {
+	switch profile := PodSecurityProfile(value); profile {
+	case PodSecurityProfileRestricted, PodSecurityProfileNone:
+		return profile, nil
+	default:
+		return "", fmt.Errorf("unknown pod security profile %q, must be restricted or none", value)
+	}
+}
+
+// restrictedPodSecurityContext is the pod security context of the restricted profile
+func restrictedPodSecurityContext() *corev1.PodSecurityContext {
+	return &corev1.PodSecurityContext{
+		RunAsNonRoot:   ptr.To(true),
+		SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+	}
+}
+
+// restrictedContainerSecurityContext is the container security context of the restricted profile
+func restrictedContainerSecurityContext() *corev1.SecurityContext {
+	return &corev1.SecurityContext{
+		AllowPrivilegeEscalation: ptr.To(false),
+		ReadOnlyRootFilesystem:   ptr.To(true),
+		Capabilities:             &corev1.Capabilities{Drop: []corev1.Capability{"ALL"}},
+	}
+}
+
+// hardenContainer sets the restricted security context on a container that has none and gives it a
+// writable /tmp. It returns whether the scratch volume is mounted.
+func hardenContainer(container *corev1.Container) bool {
+	if container.SecurityContext != nil {
+		return false
+	}
+	container.SecurityContext = restrictedContainerSecurityContext()
+	for _, mount := range container.VolumeMounts {
+		if mount.MountPath == TmpPath {
+			return false
+		}
+	}
+	container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{Name: VolumeNameTmp, MountPath: TmpPath})
+	return true
+}
+
+// hardenPodSpec applies the restricted profile to the pod and its containers without a security context
+// of their own, main is the main container of generated deployments which is not part of the pod spec
+func hardenPodSpec(podSpec *corev1.PodSpec, main *corev1.Container) {
+	podSpec.SecurityContext = restrictedPodSecurityContext()
+	mounted := false
+	for _, containers := range [][]corev1.Container{podSpec.InitContainers, podSpec.Containers} {
+		for i := range containers {
+			mounted = hardenContainer(&containers[i]) || mounted
+		}
+	}
+	if main != nil {
+		mounted = hardenContainer(main) || mounted
+	}
+	if mounted {
+		podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+			Name:         VolumeNameTmp,
+			VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+		})
+	}
+}
+
+// applyJobPodSecurity applies the operator's pod security profile to a profiling job pod
+func (r *DynamoGraphDeploymentRequestReconciler) applyJobPodSecurity(podSpec *corev1.PodSpec) {
+	if r.PodSecurityProfile != PodSecurityProfileRestricted {
+		return
+	}
+	hardenPodSpec(podSpec, nil)
+}
+
+// applyDeploymentPodSecurity applies the operator's pod security profile to the services of a generated
+// deployment. Services whose pod or main container sets a security context are left as they are, a
+// partial profile could conflict with it, e.g. runAsNonRoot with a container running as root.
+func (r *DynamoGraphDeploymentRequestReconciler) applyDeploymentPodSecurity(dgd *nvidiacomv1alpha1.DynamoGraphDeployment) {
+	if r.PodSecurityProfile != PodSecurityProfileRestricted {
+		return
+	}
+	for _, spec := range dgd.Spec.Services {
+		if spec == nil {
+			continue
+		}
+		if spec.ExtraPodSpec == nil {
+			spec.ExtraPodSpec = &dynamoCommon.ExtraPodSpec{}
+		}
+		extra := spec.ExtraPodSpec
+		if (extra.PodSpec != nil && extra.PodSpec.SecurityContext != nil) ||
+			(extra.MainContainer != nil && extra.MainContainer.SecurityContext != nil) {
+			continue
+		}
+		if extra.PodSpec == nil {
+			extra.PodSpec = &corev1.PodSpec{}
+		}
+		if extra.MainContainer == nil {
+			extra.MainContainer = &corev1.Container{}
+		}
+		hardenPodSpec(extra.PodSpec, extra.MainContainer)
+	}
+}
@@ -0,0 +1,125 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+
+	dynamoCommon "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/dynamo/common"
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+)
+
+var _ = Describe("DGDR Pod Security", func() {
+	var reconciler *DynamoGraphDeploymentRequestReconciler
+
+	BeforeEach(func() {
+		reconciler = &DynamoGraphDeploymentRequestReconciler{
+			Client:             k8sClient,
+			Recorder:           record.NewFakeRecorder(100),
+			RBACManager:        &MockRBACManager{},
+			PodSecurityProfile: PodSecurityProfileRestricted,
+		}
+	})
+
+	expectRestricted := func(container corev1.Container) {
+		Expect(container.SecurityContext).Should(Equal(restrictedContainerSecurityContext()), container.Name)
+		Expect(container.VolumeMounts).Should(ContainElement(corev1.VolumeMount{Name: VolumeNameTmp, MountPath: TmpPath}), container.Name)
+	}
+
+	It("Should harden the profiling job pod", func() {
+		ctx := context.Background()
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-dgdr-podsecurity", Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model:   "Qwen/Qwen3-0.6B",
+				Backend: BackendVLLM,
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					ProfilerImage: "test-profiler:latest",
+					Config: createTestConfig(map[string]interface{}{
+						"sla":   map[string]interface{}{"ttft": 200.0, "itl": 20.0},
+						"sweep": map[string]interface{}{"use_ai_configurator": true},
+					}),
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, dgdr) }()
+
+		Expect(reconciler.createProfilingJob(ctx, dgdr)).Should(Succeed())
+		job := &batchv1.Job{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: GetProfilingJobName(dgdr), Namespace: defaultNamespace}, job)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, job) }()
+
+		podSpec := job.Spec.Template.Spec
+		Expect(podSpec.SecurityContext.RunAsNonRoot).Should(Equal(ptr.To(true)))
+		Expect(podSpec.SecurityContext.SeccompProfile.Type).Should(Equal(corev1.SeccompProfileTypeRuntimeDefault))
+		for _, container := range append(podSpec.InitContainers, podSpec.Containers...) {
+			expectRestricted(container)
+		}
+		Expect(podSpec.Volumes).Should(ContainElement(HaveField("Name", VolumeNameTmp)))
+	})
+
+	It("Should harden generated services unless they set their own security context", func() {
+		custom := &corev1.SecurityContext{RunAsUser: ptr.To(int64(0))}
+		dgd := &nvidiacomv1alpha1.DynamoGraphDeployment{
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentSpec{
+				Services: map[string]*nvidiacomv1alpha1.DynamoComponentDeploymentSharedSpec{
+					"Frontend": {},
+					"VllmDecodeWorker": {
+						ExtraPodSpec: &dynamoCommon.ExtraPodSpec{
+							MainContainer: &corev1.Container{Args: []string{"--model", "m"}, SecurityContext: custom},
+						},
+					},
+				},
+			},
+		}
+		reconciler.applyDeploymentPodSecurity(dgd)
+
+		frontend := dgd.Spec.Services["Frontend"].ExtraPodSpec
+		Expect(frontend.PodSpec.SecurityContext).Should(Equal(restrictedPodSecurityContext()))
+		expectRestricted(*frontend.MainContainer)
+		Expect(frontend.PodSpec.Volumes).Should(HaveLen(1))
+
+		worker := dgd.Spec.Services["VllmDecodeWorker"].ExtraPodSpec
+		Expect(worker.MainContainer.SecurityContext).Should(Equal(custom))
+		Expect(worker.MainContainer.VolumeMounts).Should(BeEmpty())
+		Expect(worker.PodSpec).Should(BeNil())
+	})
+
+	It("Should leave pods untouched without a profile", func() {
+		reconciler.PodSecurityProfile = ""
+		dgd := &nvidiacomv1alpha1.DynamoGraphDeployment{
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentSpec{
+				Services: map[string]*nvidiacomv1alpha1.DynamoComponentDeploymentSharedSpec{"Frontend": {}},
+			},
+		}
+		reconciler.applyDeploymentPodSecurity(dgd)
+		Expect(dgd.Spec.Services["Frontend"].ExtraPodSpec).Should(BeNil())
+
+		_, err := ParsePodSecurityProfile("baseline")
+		Expect(err).To(HaveOccurred())
+	})
+})
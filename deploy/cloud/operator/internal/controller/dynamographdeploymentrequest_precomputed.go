@@ -0,0 +1,227 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+)
+
+const (
+	// ConditionTypeProfilingSkipped is set when profiling was skipped in favor of a precomputed deployment
+	// or a catalog entry
+	ConditionTypeProfilingSkipped = "ProfilingSkipped"
+
+	// Condition reasons
+	ReasonPrecomputedDeployment = "PrecomputedDeployment"
+
+	// Messages
+	MessagePrecomputedDeployment = "Profiling skipped, using spec.precomputedDeployment"
+
+	// Validation messages
+	ValidationErrorPrecomputedRawManifests = "spec.precomputedDeployment cannot be combined with spec.output.format RawManifests"
+)
+
+// loadPrecomputedDeployment reads and parses the DGD supplied in spec.precomputedDeployment
+func (r *DynamoGraphDeploymentRequestReconciler) loadPrecomputedDeployment(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) (*nvidiacomv1alpha1.DynamoGraphDeployment, error) {
+	precomputed := dgdr.Spec.PrecomputedDeployment
+
+	var content []byte
+	source := "spec.precomputedDeployment.deployment"
+	if precomputed.ConfigMapRef != nil {
+		cm := &corev1.ConfigMap{}
+		if err := r.Get(ctx, types.NamespacedName{Name: precomputed.ConfigMapRef.Name, Namespace: dgdr.Namespace}, cm); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, fmt.Errorf(MessageConfigMapNotFound, precomputed.ConfigMapRef.Name, dgdr.Namespace)
+			}
+			return nil, err
+		}
+		key := precomputed.ConfigMapRef.Key
+		if key == "" {
+			key = ProfilingConfigFile
+		}
+		value, exists := cm.Data[key]
+		if !exists {
+			return nil, fmt.Errorf(MessageConfigMapKeyNotFound, key, cm.Name)
+		}
+		content = []byte(value)
+		source = fmt.Sprintf("ConfigMap %s key %s", cm.Name, key)
+	} else if precomputed.Deployment != nil {
+		content = precomputed.Deployment.Raw
+	}
+	if len(content) == 0 {
+		return nil, errors.New("spec.precomputedDeployment must set deployment or configMapRef")
+	}
+
+	dgd, err := decodeGeneratedDeployment(dgdr, source, content)
+	if err != nil {
+		return nil, err
+	}
+	if dgd.Kind != "DynamoGraphDeployment" {
+		return nil, withFailureReason(nvidiacomv1alpha1.FailureReasonSpecParseError,
+			fmt.Errorf("%s must be a DynamoGraphDeployment, got kind %q", source, dgd.Kind))
+	}
+	if len(dgd.Spec.Services) == 0 {
+		return nil, withFailureReason(nvidiacomv1alpha1.FailureReasonSpecParseError,
+			fmt.Errorf("%s has no services", source))
+	}
+	return dgd, nil
+}
+
+// validatePrecomputedDeployment validates a DGDR that skips profiling. Profiling settings are not
+// used in this mode, so only the deployment and its overrides are checked.
+func (r *DynamoGraphDeploymentRequestReconciler) validatePrecomputedDeployment(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	if getOutputFormat(dgdr) == nvidiacomv1alpha1.OutputFormatRawManifests {
+		return errors.New(ValidationErrorPrecomputedRawManifests)
+	}
+	if err := r.validateDeploymentSettings(ctx, dgdr); err != nil {
+		return err
+	}
+
+	dgd, err := r.loadPrecomputedDeployment(ctx, dgdr)
+	if err != nil {
+		return err
+	}
+	if err := applyServiceAccountOverrides(dgdr, dgd); err != nil {
+		return err
+	}
+	if _, err := mergeServiceOverrides(dgdr, dgd); err != nil {
+		return err
+	}
+	applyGPUResourceName(dgdr, dgd)
+	if err := applyAdapters(dgdr, dgd); err != nil {
+		return err
+	}
+	if err := applySecrets(dgdr, dgd); err != nil {
+		return err
+	}
+	if err := applyDeploymentPatches(dgdr, dgd); err != nil {
+		return err
+	}
+	return r.validateDeploymentImages(ctx, dgdr, dgd)
+}
+
+// validateDeploymentSettings validates the settings of a DGDR that are applied to a deployment it
+// does not profile for, a precomputed deployment or a catalog entry
+func (r *DynamoGraphDeploymentRequestReconciler) validateDeploymentSettings(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	if err := r.validateServiceAccounts(dgdr); err != nil {
+		return err
+	}
+	if err := r.validatePodAnnotations(dgdr); err != nil {
+		return err
+	}
+	if errs := ValidateServiceOverrides(dgdr); len(errs) > 0 {
+		return errs.ToAggregate()
+	}
+	if errs := ValidateDeploymentPatches(dgdr); len(errs) > 0 {
+		return errs.ToAggregate()
+	}
+	if errs := ValidateSecrets(dgdr); len(errs) > 0 {
+		return errs.ToAggregate()
+	}
+	if err := r.validatePinImageDigests(dgdr); err != nil {
+		return err
+	}
+	if err := validateAdapters(dgdr); err != nil {
+		return err
+	}
+	if err := validateGeneratedSpecValidity(dgdr); err != nil {
+		return err
+	}
+	if err := validateApproval(dgdr); err != nil {
+		return err
+	}
+	if err := r.validateImageAllowlist(ctx, dgdr); err != nil {
+		return err
+	}
+	return r.validateImageArchitectures(ctx, dgdr)
+}
+
+// handlePrecomputedDeployment uses the precomputed deployment as the generated deployment and
+// moves the DGDR straight to Ready (or Deploying with autoApply)
+func (r *DynamoGraphDeploymentRequestReconciler) handlePrecomputedDeployment(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+	logger.Info("Skipping profiling, using precomputed deployment", "name", dgdr.Name)
+
+	dgd, err := r.loadPrecomputedDeployment(ctx, dgdr)
+	if err == nil {
+		err = applyServiceAccountOverrides(dgdr, dgd)
+	}
+	if err == nil {
+		err = r.applyServiceOverrides(dgdr, dgd)
+	}
+	if err == nil {
+		applyGPUResourceName(dgdr, dgd)
+	}
+	if err == nil {
+		err = r.pinImageDigests(ctx, dgdr, dgd)
+	}
+	if err == nil {
+		err = applyAdapters(dgdr, dgd)
+	}
+	if err == nil {
+		err = applySecrets(dgdr, dgd)
+	}
+	if err == nil {
+		err = r.applyWorkloadFlavor(dgdr, dgd)
+	}
+	if err == nil {
+		r.applyDeploymentPodAnnotations(dgdr, dgd)
+		r.applyDeploymentPodSecurity(dgd)
+		err = applyDeploymentPatches(dgdr, dgd)
+	}
+	if err == nil {
+		err = r.validateDeploymentImages(ctx, dgdr, dgd)
+	}
+	if err != nil {
+		r.Recorder.Event(dgdr, corev1.EventTypeWarning, MessageGenerationFailed, err.Error())
+		return r.updateStateToFailed(ctx, dgdr, failureReasonFromError(err, nvidiacomv1alpha1.FailureReasonValidationError),
+			ConditionTypeSpecGenerated, MessageGenerationFailed, err.Error())
+	}
+
+	dgdr.Status.GeneratedDeployment = &runtime.RawExtension{Object: dgd}
+	r.setGeneratedSpecExpiry(dgdr)
+	r.auditSpecGenerated(ctx, dgdr, dgd)
+	r.setDeploymentPreview(ctx, dgdr)
+	r.setPlacementReport(ctx, dgdr)
+	meta.SetStatusCondition(&dgdr.Status.Conditions, metav1.Condition{
+		Type:               ConditionTypeProfilingSkipped,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: dgdr.Generation,
+		Reason:             ReasonPrecomputedDeployment,
+		Message:            MessagePrecomputedDeployment,
+	})
+	r.Recorder.Event(dgdr, corev1.EventTypeNormal, ReasonPrecomputedDeployment, MessagePrecomputedDeployment)
+
+	if dgdr.Spec.AutoApply {
+		return r.updateStateWithCondition(ctx, dgdr, autoApplyState(dgdr), ConditionTypeSpecGenerated, metav1.ConditionTrue, ReasonPrecomputedDeployment, MessagePrecomputedDeployment)
+	}
+	return r.updateStateWithCondition(ctx, dgdr, StateReady, ConditionTypeSpecGenerated, metav1.ConditionTrue, ReasonPrecomputedDeployment, MessageSpecAvailable)
+}
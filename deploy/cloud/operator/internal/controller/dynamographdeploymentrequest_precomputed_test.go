@@ -0,0 +1,138 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var _ = Describe("DGDR Precomputed Deployment", func() {
+	var reconciler *DynamoGraphDeploymentRequestReconciler
+
+	BeforeEach(func() {
+		reconciler = &DynamoGraphDeploymentRequestReconciler{
+			Client:      k8sClient,
+			Recorder:    record.NewFakeRecorder(100),
+			RBACManager: &MockRBACManager{},
+		}
+	})
+
+	newDGDR := func(name string, precomputed *nvidiacomv1alpha1.PrecomputedDeploymentSpec) *nvidiacomv1alpha1.DynamoGraphDeploymentRequest {
+		return &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model:   "test-model",
+				Backend: "vllm",
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					ProfilerImage: "test-profiler:latest",
+				},
+				PrecomputedDeployment: precomputed,
+			},
+		}
+	}
+
+	reconcileTwice := func(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) *nvidiacomv1alpha1.DynamoGraphDeploymentRequest {
+		request := reconcile.Request{NamespacedName: types.NamespacedName{Name: dgdr.Name, Namespace: dgdr.Namespace}}
+		for range 2 {
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+		}
+		updated := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{}
+		Expect(k8sClient.Get(ctx, request.NamespacedName, updated)).Should(Succeed())
+		return updated
+	}
+
+	It("Should skip profiling and become Ready with an inline deployment", func() {
+		ctx := context.Background()
+
+		dgdr := newDGDR("test-dgdr-precomputed", &nvidiacomv1alpha1.PrecomputedDeploymentSpec{
+			Deployment: &runtime.RawExtension{
+				Object: &nvidiacomv1alpha1.DynamoGraphDeployment{
+					TypeMeta:   metav1.TypeMeta{APIVersion: "nvidia.com/v1alpha1", Kind: "DynamoGraphDeployment"},
+					ObjectMeta: metav1.ObjectMeta{Name: "known-good"},
+					Spec: nvidiacomv1alpha1.DynamoGraphDeploymentSpec{
+						Services: map[string]*nvidiacomv1alpha1.DynamoComponentDeploymentSharedSpec{
+							"Frontend": {},
+						},
+					},
+				},
+			},
+		})
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, dgdr) }()
+
+		updated := reconcileTwice(ctx, dgdr)
+		Expect(updated.Status.State).Should(Equal(StateReady))
+		Expect(string(updated.Status.GeneratedDeployment.Raw)).Should(ContainSubstring("known-good"))
+
+		condition := meta.FindStatusCondition(updated.Status.Conditions, ConditionTypeProfilingSkipped)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).Should(Equal(metav1.ConditionTrue))
+		Expect(condition.Reason).Should(Equal(ReasonPrecomputedDeployment))
+
+		// No profiling job is created
+		job := &batchv1.Job{}
+		err := k8sClient.Get(ctx, types.NamespacedName{Name: GetProfilingJobName(dgdr), Namespace: defaultNamespace}, job)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("Should fail validation when the referenced manifest is not a DGD", func() {
+		ctx := context.Background()
+
+		cm := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-precomputed-not-dgd", Namespace: defaultNamespace},
+			Data:       map[string]string{ProfilingConfigFile: "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: x\n"},
+		}
+		Expect(k8sClient.Create(ctx, cm)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, cm) }()
+
+		dgdr := newDGDR("test-dgdr-precomputed-invalid", &nvidiacomv1alpha1.PrecomputedDeploymentSpec{
+			ConfigMapRef: &nvidiacomv1alpha1.ConfigMapKeySelector{Name: cm.Name},
+		})
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, dgdr) }()
+
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: dgdr.Name, Namespace: defaultNamespace}})
+		Expect(err).NotTo(HaveOccurred())
+
+		updated := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: dgdr.Name, Namespace: defaultNamespace}, updated)).Should(Succeed())
+		Expect(updated.Status.State).Should(Equal(StateFailed))
+		Expect(updated.Status.FailureReason).Should(Equal(nvidiacomv1alpha1.FailureReasonSpecParseError))
+	})
+
+	It("Should reject setting both deployment and configMapRef", func() {
+		dgdr := newDGDR("test-dgdr-precomputed-both", &nvidiacomv1alpha1.PrecomputedDeploymentSpec{
+			Deployment:   &runtime.RawExtension{Raw: []byte(`{"apiVersion":"nvidia.com/v1alpha1","kind":"DynamoGraphDeployment","metadata":{"name":"x"}}`)},
+			ConfigMapRef: &nvidiacomv1alpha1.ConfigMapKeySelector{Name: "x"},
+		})
+		Expect(k8sClient.Create(context.Background(), dgdr)).ShouldNot(Succeed())
+	})
+})
@@ -0,0 +1,176 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+)
+
+const (
+	// ConditionTypePreviewAvailable is True once the summary of the deployment that is applied for
+	// the generated spec is available, before it is applied
+	ConditionTypePreviewAvailable = "PreviewAvailable"
+
+	// Condition reasons of the PreviewAvailable condition
+	ReasonPreviewNewDeployment = "NewDeployment"
+	ReasonPreviewUpdate        = "UpdatesDeployment"
+	ReasonPreviewNoChanges     = "NoChanges"
+
+	// EventReasonPreviewAvailable is the event carrying the deployment preview
+	EventReasonPreviewAvailable = "PreviewAvailable"
+
+	// Messages
+	MessagePreviewSummary   = "DynamoGraphDeployment %s/%s: %d services, %d GPUs in total. %s"
+	MessagePreviewService   = "%s: %d replicas of %d GPUs, image %s"
+	MessagePreviewChanges   = " Changes versus the live deployment: %s"
+	MessagePreviewNoChanges = " No changes to services, replicas, GPUs or images versus the live deployment"
+
+	// previewDefaultImage stands for services without an image, which run the default image
+	previewDefaultImage = "<default>"
+)
+
+// servicePreview is what the preview reports about a service of the deployment
+type servicePreview struct {
+	replicas int32
+	gpus     int64
+	image    string
+}
+
+// previewServices returns the preview of every service of a DGD spec. GPUs are per replica and
+// include every node of multinode services.
+func previewServices(spec *nvidiacomv1alpha1.DynamoGraphDeploymentSpec) map[string]servicePreview {
+	services := make(map[string]servicePreview, len(spec.Services))
+	for name, service := range spec.Services {
+		if service == nil {
+			continue
+		}
+		preview := servicePreview{replicas: 1, image: previewDefaultImage}
+		if service.Replicas != nil {
+			preview.replicas = *service.Replicas
+		}
+		if gpus := serviceGPUs(service.Resources); gpus != nil {
+			preview.gpus = gpus.Value() * int64(service.GetNumberOfNodes())
+		}
+		if service.ExtraPodSpec != nil && service.ExtraPodSpec.MainContainer != nil && service.ExtraPodSpec.MainContainer.Image != "" {
+			preview.image = service.ExtraPodSpec.MainContainer.Image
+		}
+		services[name] = preview
+	}
+	return services
+}
+
+// summarizeDeployment returns the human-readable summary of the services, replicas, GPUs and
+// images of a DGD
+func summarizeDeployment(dgd *nvidiacomv1alpha1.DynamoGraphDeployment) string {
+	services := previewServices(&dgd.Spec)
+	var totalGPUs int64
+	lines := make([]string, 0, len(services))
+	for _, name := range slices.Sorted(maps.Keys(services)) {
+		service := services[name]
+		totalGPUs += int64(service.replicas) * service.gpus
+		lines = append(lines, fmt.Sprintf(MessagePreviewService, name, service.replicas, service.gpus, service.image))
+	}
+	return fmt.Sprintf(MessagePreviewSummary, dgd.Namespace, dgd.Name, len(services), totalGPUs, strings.Join(lines, "; "))
+}
+
+// diffDeployments returns the changes to services, replicas, GPUs and images from the live to
+// the generated DGD spec, empty when there are none
+func diffDeployments(live, generated *nvidiacomv1alpha1.DynamoGraphDeploymentSpec) []string {
+	before, after := previewServices(live), previewServices(generated)
+	names := slices.Collect(maps.Keys(before))
+	for name := range after {
+		if _, existed := before[name]; !existed {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	var changes []string
+	for _, name := range names {
+		old, existed := before[name]
+		updated, exists := after[name]
+		switch {
+		case !existed:
+			changes = append(changes, fmt.Sprintf("%s added", name))
+		case !exists:
+			changes = append(changes, fmt.Sprintf("%s removed", name))
+		default:
+			if old.replicas != updated.replicas {
+				changes = append(changes, fmt.Sprintf("%s replicas %d -> %d", name, old.replicas, updated.replicas))
+			}
+			if old.gpus != updated.gpus {
+				changes = append(changes, fmt.Sprintf("%s GPUs %d -> %d", name, old.gpus, updated.gpus))
+			}
+			if old.image != updated.image {
+				changes = append(changes, fmt.Sprintf("%s image %s -> %s", name, old.image, updated.image))
+			}
+		}
+	}
+	return changes
+}
+
+// setDeploymentPreview records the summary of the deployment the generated spec applies in the
+// PreviewAvailable condition and an event, with the changes versus the live DGD when it exists, so
+// the spec can be reviewed before it is applied. The status is persisted by the caller's next
+// status update.
+func (r *DynamoGraphDeploymentRequestReconciler) setDeploymentPreview(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) {
+	logger := log.FromContext(ctx)
+	dgd, err := dgdr.BuildDeployment()
+	if err != nil {
+		logger.Error(err, "Failed to build the deployment preview")
+		return
+	}
+
+	reason, message := ReasonPreviewNewDeployment, summarizeDeployment(dgd)
+	live := &nvidiacomv1alpha1.DynamoGraphDeployment{}
+	err = r.Get(ctx, types.NamespacedName{Name: dgd.Name, Namespace: dgd.Namespace}, live)
+	switch {
+	case err == nil:
+		if changes := diffDeployments(&live.Spec, &dgd.Spec); len(changes) > 0 {
+			reason = ReasonPreviewUpdate
+			message += fmt.Sprintf(MessagePreviewChanges, strings.Join(changes, "; "))
+		} else {
+			reason = ReasonPreviewNoChanges
+			message += MessagePreviewNoChanges
+		}
+	case !apierrors.IsNotFound(err):
+		// The summary is still useful without the diff
+		logger.Error(err, "Failed to get the live deployment for the preview", "dgd", dgd.Name)
+	}
+
+	meta.SetStatusCondition(&dgdr.Status.Conditions, metav1.Condition{
+		Type:               ConditionTypePreviewAvailable,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: dgdr.Generation,
+		Reason:             reason,
+		Message:            message,
+	})
+	r.Recorder.Event(dgdr, corev1.EventTypeNormal, EventReasonPreviewAvailable, message)
+}
@@ -0,0 +1,105 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+)
+
+var _ = Describe("DGDR Deployment Preview", func() {
+	generated := `{"apiVersion":"nvidia.com/v1alpha1","kind":"DynamoGraphDeployment","metadata":{"name":"test-dgd-preview"},"spec":{"services":{` +
+		`"Frontend":{"replicas":1,"extraPodSpec":{"mainContainer":{"image":"runtime:2"}}},` +
+		`"VllmDecodeWorker":{"replicas":2,"resources":{"limits":{"gpu":"2"}},"extraPodSpec":{"mainContainer":{"image":"runtime:2"}}},` +
+		`"VllmPrefillWorker":{"replicas":1,"resources":{"limits":{"gpu":"1"}},"multinode":{"nodeCount":2},"extraPodSpec":{"mainContainer":{"image":"runtime:2"}}}}}}`
+
+	newDGDR := func() *nvidiacomv1alpha1.DynamoGraphDeploymentRequest {
+		return &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-dgdr-preview", Namespace: defaultNamespace},
+			Status: nvidiacomv1alpha1.DynamoGraphDeploymentRequestStatus{
+				GeneratedDeployment: &runtime.RawExtension{Raw: []byte(generated)},
+			},
+		}
+	}
+
+	It("Should summarize a new deployment", func() {
+		recorder := record.NewFakeRecorder(10)
+		reconciler := &DynamoGraphDeploymentRequestReconciler{Client: k8sClient, Recorder: recorder}
+		dgdr := newDGDR()
+
+		reconciler.setDeploymentPreview(context.Background(), dgdr)
+		condition := meta.FindStatusCondition(dgdr.Status.Conditions, ConditionTypePreviewAvailable)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).Should(Equal(metav1.ConditionTrue))
+		Expect(condition.Reason).Should(Equal(ReasonPreviewNewDeployment))
+		Expect(condition.Message).Should(Equal("DynamoGraphDeployment " + defaultNamespace + "/test-dgd-preview: 3 services, 6 GPUs in total. " +
+			"Frontend: 1 replicas of 0 GPUs, image runtime:2; VllmDecodeWorker: 2 replicas of 2 GPUs, image runtime:2; " +
+			"VllmPrefillWorker: 1 replicas of 2 GPUs, image runtime:2"))
+		Expect(recorder.Events).Should(Receive(ContainSubstring(EventReasonPreviewAvailable)))
+	})
+
+	It("Should diff against the live deployment", func() {
+		ctx := context.Background()
+		live := &nvidiacomv1alpha1.DynamoGraphDeployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-dgd-preview", Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentSpec{
+				Services: map[string]*nvidiacomv1alpha1.DynamoComponentDeploymentSharedSpec{
+					"Frontend":  {Replicas: ptr.To(int32(1))},
+					"OldWorker": {Replicas: ptr.To(int32(4))},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, live)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, live) }()
+
+		reconciler := &DynamoGraphDeploymentRequestReconciler{Client: k8sClient, Recorder: record.NewFakeRecorder(10)}
+		dgdr := newDGDR()
+		reconciler.setDeploymentPreview(ctx, dgdr)
+		condition := meta.FindStatusCondition(dgdr.Status.Conditions, ConditionTypePreviewAvailable)
+		Expect(condition.Reason).Should(Equal(ReasonPreviewUpdate))
+		Expect(condition.Message).Should(HaveSuffix("Changes versus the live deployment: Frontend image <default> -> runtime:2; " +
+			"OldWorker removed; VllmDecodeWorker added; VllmPrefillWorker added"))
+
+		// Applying the same spec again changes nothing
+		built, err := dgdr.BuildDeployment()
+		Expect(err).NotTo(HaveOccurred())
+		live.Spec = built.Spec
+		Expect(k8sClient.Update(ctx, live)).Should(Succeed())
+		reconciler.setDeploymentPreview(ctx, dgdr)
+		condition = meta.FindStatusCondition(dgdr.Status.Conditions, ConditionTypePreviewAvailable)
+		Expect(condition.Reason).Should(Equal(ReasonPreviewNoChanges))
+	})
+
+	It("Should diff replicas, GPUs and images of kept services", func() {
+		live := &nvidiacomv1alpha1.DynamoGraphDeploymentSpec{
+			Services: map[string]*nvidiacomv1alpha1.DynamoComponentDeploymentSharedSpec{"Worker": {Replicas: ptr.To(int32(1))}},
+		}
+		updated := live.DeepCopy()
+		updated.Services["Worker"].Replicas = ptr.To(int32(3))
+		Expect(diffDeployments(live, updated)).Should(Equal([]string{"Worker replicas 1 -> 3"}))
+		Expect(diffDeployments(live, live)).Should(BeEmpty())
+	})
+})
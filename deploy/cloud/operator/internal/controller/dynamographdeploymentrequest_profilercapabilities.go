@@ -0,0 +1,262 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+)
+
+const (
+	// Image labels profiler images declare their capabilities with
+	ImageLabelProfilerBackends          = "com.nvidia.dynamo.profiler.backends"
+	ImageLabelProfilerArgsSchemaVersion = "com.nvidia.dynamo.profiler.args-schema-version"
+	ImageLabelProfilerFeatures          = "com.nvidia.dynamo.profiler.features"
+
+	// Profiler features required by DGDR spec fields
+	ProfilerFeatureAIConfigurator = "ai-configurator"
+	ProfilerFeatureBackendAuto    = "backend-auto"
+	ProfilerFeatureBatchLatency   = "batch-latency"
+	ProfilerFeatureAdapters       = "adapters"
+	ProfilerFeatureCPUOnly        = "cpu-only"
+	ProfilerFeatureLoadTarget     = "load-target"
+
+	// ProfilerArgsSchemaVersion is the version of the profiler config schema the operator writes
+	ProfilerArgsSchemaVersion = 1
+
+	// DefaultProfilerCapabilitiesRefreshInterval is how long discovered profiler capabilities are
+	// used before the image is inspected again
+	DefaultProfilerCapabilitiesRefreshInterval = time.Hour
+
+	// profilerCapabilitiesNamePrefix prefixes the names of DynamoProfilerCapabilities objects
+	profilerCapabilitiesNamePrefix = "profiler-"
+
+	// Validation messages
+	ValidationErrorProfilerSchemaVersion = "profiler image %s accepts profiler config schema version %d, the operator writes version %d"
+	ValidationErrorProfilerBackend       = "profiler image %s does not support backend %s (supported: %s)"
+	ValidationErrorProfilerFeature       = "profiler image %s does not support %s, which requires the %s profiler feature"
+)
+
+// ProfilerImageInspector reads the labels of profiler images
+type ProfilerImageInspector interface {
+	// ImageLabels returns the labels of the image, authenticating with the given .dockerconfigjson documents
+	ImageLabels(ctx context.Context, image string, dockerConfigs [][]byte) (map[string]string, error)
+}
+
+// getProfilerCapabilitiesName returns the name of the DynamoProfilerCapabilities object of an image
+func getProfilerCapabilitiesName(image string) string {
+	sum := sha256.Sum256([]byte(image))
+	return profilerCapabilitiesNamePrefix + hex.EncodeToString(sum[:])[:16]
+}
+
+// parseProfilerCapabilities reads the capabilities declared in the labels of a profiler image.
+// Images without the capability labels are reported as not discovered.
+func parseProfilerCapabilities(labels map[string]string) nvidiacomv1alpha1.DynamoProfilerCapabilitiesStatus {
+	status := nvidiacomv1alpha1.DynamoProfilerCapabilitiesStatus{}
+	version, hasVersion := labels[ImageLabelProfilerArgsSchemaVersion]
+	if !hasVersion {
+		status.Message = fmt.Sprintf("the image has no %s label", ImageLabelProfilerArgsSchemaVersion)
+		return status
+	}
+	parsed, err := strconv.ParseInt(strings.TrimSpace(version), 10, 32)
+	if err != nil {
+		status.Message = fmt.Sprintf("invalid %s label %q", ImageLabelProfilerArgsSchemaVersion, version)
+		return status
+	}
+	status.Discovered = true
+	status.ArgsSchemaVersion = int32(parsed)
+	status.Backends = splitLabelList(labels[ImageLabelProfilerBackends])
+	status.SweepFeatures = splitLabelList(labels[ImageLabelProfilerFeatures])
+	return status
+}
+
+// splitLabelList splits a comma separated label value
+func splitLabelList(value string) []string {
+	items := []string{}
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// getProfilerCapabilities returns the capabilities of the DGDR's profiler image, inspecting the
+// image when its DynamoProfilerCapabilities object is missing or older than the refresh interval.
+// Images that cannot be inspected are recorded as not discovered rather than failing the DGDR.
+func (r *DynamoGraphDeploymentRequestReconciler) getProfilerCapabilities(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) (*nvidiacomv1alpha1.DynamoProfilerCapabilities, error) {
+	image := dgdr.Spec.ProfilingConfig.ProfilerImage
+	capabilities := &nvidiacomv1alpha1.DynamoProfilerCapabilities{}
+	key := types.NamespacedName{Name: getProfilerCapabilitiesName(image), Namespace: dgdr.Namespace}
+	err := r.apiReader().Get(ctx, key, capabilities)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to get profiler capabilities: %w", err)
+	}
+	if err == nil && capabilities.Status.LastProbeTime != nil &&
+		time.Since(capabilities.Status.LastProbeTime.Time) < r.profilerCapabilitiesRefreshInterval() {
+		return capabilities, nil
+	}
+
+	if apierrors.IsNotFound(err) {
+		capabilities = &nvidiacomv1alpha1.DynamoProfilerCapabilities{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      key.Name,
+				Namespace: key.Namespace,
+				Labels:    map[string]string{LabelManagedBy: LabelValueDynamoOperator},
+			},
+			Spec: nvidiacomv1alpha1.DynamoProfilerCapabilitiesSpec{Image: image},
+		}
+		if err := r.Create(ctx, capabilities); apierrors.IsAlreadyExists(err) {
+			// Created concurrently for another DGDR using the same image
+			if err := r.apiReader().Get(ctx, key, capabilities); err != nil {
+				return nil, fmt.Errorf("failed to get profiler capabilities: %w", err)
+			}
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to create profiler capabilities: %w", err)
+		}
+	}
+
+	status := r.inspectProfilerImage(ctx, dgdr.Namespace, image)
+	// A refresh that fails keeps the capabilities discovered earlier
+	if !status.Discovered && capabilities.Status.Discovered {
+		capabilities.Status.Message = status.Message
+		capabilities.Status.LastProbeTime = status.LastProbeTime
+	} else {
+		capabilities.Status = status
+	}
+	if err := r.Status().Update(ctx, capabilities); err != nil {
+		return nil, fmt.Errorf("failed to update profiler capabilities: %w", err)
+	}
+	log.FromContext(ctx).Info("Inspected profiler image capabilities", "image", image,
+		"discovered", capabilities.Status.Discovered, "backends", capabilities.Status.Backends)
+	return capabilities, nil
+}
+
+// inspectProfilerImage reads the capability labels of a profiler image from its registry
+func (r *DynamoGraphDeploymentRequestReconciler) inspectProfilerImage(ctx context.Context, namespace, image string) nvidiacomv1alpha1.DynamoProfilerCapabilitiesStatus {
+	now := metav1.Now()
+	dockerConfigs, err := r.getDockerConfigs(ctx, namespace, image, profilingJobPullSecrets())
+	if err != nil {
+		return nvidiacomv1alpha1.DynamoProfilerCapabilitiesStatus{Message: err.Error(), LastProbeTime: &now}
+	}
+	labels, err := r.ProfilerInspector.ImageLabels(ctx, image, dockerConfigs)
+	if err != nil {
+		return nvidiacomv1alpha1.DynamoProfilerCapabilitiesStatus{Message: err.Error(), LastProbeTime: &now}
+	}
+	status := parseProfilerCapabilities(labels)
+	status.LastProbeTime = &now
+	return status
+}
+
+func (r *DynamoGraphDeploymentRequestReconciler) profilerCapabilitiesRefreshInterval() time.Duration {
+	if r.ProfilerCapabilitiesRefreshInterval > 0 {
+		return r.ProfilerCapabilitiesRefreshInterval
+	}
+	return DefaultProfilerCapabilitiesRefreshInterval
+}
+
+// requiredProfilerFeatures returns the profiler features the DGDR spec relies on, keyed by the
+// spec setting that requires them
+func requiredProfilerFeatures(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) map[string]string {
+	required := map[string]string{}
+	if getProfilingMode(dgdr) == nvidiacomv1alpha1.ProfilingModeAIC {
+		required["spec.profilingMode aic"] = ProfilerFeatureAIConfigurator
+	}
+	if dgdr.Spec.Backend == BackendAuto {
+		required["spec.backend auto"] = ProfilerFeatureBackendAuto
+	}
+	if !isGenerative(dgdr) {
+		required[fmt.Sprintf("spec.workloadType %s", getWorkloadType(dgdr))] = ProfilerFeatureBatchLatency
+	}
+	if len(dgdr.Spec.Adapters) > 0 {
+		required["spec.adapters"] = ProfilerFeatureAdapters
+	}
+	if isCPUOnly(dgdr) {
+		required["profilingConfig.cpuOnly"] = ProfilerFeatureCPUOnly
+	}
+	target := slaConfig(dgdr)
+	_, hasRequestRate := target[SLAKeyRequestsPerSecond]
+	_, hasConcurrency := target[SLAKeyConcurrentUsers]
+	if hasRequestRate || hasConcurrency {
+		required["spec.sla load targets"] = ProfilerFeatureLoadTarget
+	}
+	return required
+}
+
+// validateProfilerCapabilities rejects DGDRs whose spec the profiler image does not support,
+// before a profiling job is started. It is a no-op without a profiler image inspector, and
+// images that do not declare their capabilities are only reported as a warning.
+func (r *DynamoGraphDeploymentRequestReconciler) validateProfilerCapabilities(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	if r.ProfilerInspector == nil || r.isMockProfiling() {
+		return nil
+	}
+	capabilities, err := r.getProfilerCapabilities(ctx, dgdr)
+	if err != nil {
+		return err
+	}
+	image := dgdr.Spec.ProfilingConfig.ProfilerImage
+	status := capabilities.Status
+	if !status.Discovered {
+		setWarning(dgdr, WarningProfilerCapabilitiesUnknown,
+			fmt.Sprintf("capabilities of profiler image %s are unknown, the spec is not checked against them: %s", image, status.Message))
+		return nil
+	}
+
+	if status.ArgsSchemaVersion != ProfilerArgsSchemaVersion {
+		return withFailureReason(nvidiacomv1alpha1.FailureReasonUnsupportedByProfiler,
+			fmt.Errorf(ValidationErrorProfilerSchemaVersion, image, status.ArgsSchemaVersion, ProfilerArgsSchemaVersion))
+	}
+
+	backends := []string{dgdr.Spec.Backend}
+	if dgdr.Spec.Backend == BackendAuto {
+		backends = candidateBackends(dgdr)
+	}
+	for _, backend := range backends {
+		if !slices.Contains(status.Backends, backend) {
+			return withFailureReason(nvidiacomv1alpha1.FailureReasonUnsupportedByProfiler,
+				fmt.Errorf(ValidationErrorProfilerBackend, image, backend, strings.Join(status.Backends, ", ")))
+		}
+	}
+
+	required := requiredProfilerFeatures(dgdr)
+	settings := make([]string, 0, len(required))
+	for setting := range required {
+		settings = append(settings, setting)
+	}
+	slices.Sort(settings)
+	for _, setting := range settings {
+		if !slices.Contains(status.SweepFeatures, required[setting]) {
+			return withFailureReason(nvidiacomv1alpha1.FailureReasonUnsupportedByProfiler,
+				fmt.Errorf(ValidationErrorProfilerFeature, image, setting, required[setting]))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,164 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+)
+
+// fakeProfilerInspector returns image labels from a fixed table and counts the inspections
+type fakeProfilerInspector struct {
+	labels      map[string]map[string]string
+	inspections int
+}
+
+func (f *fakeProfilerInspector) ImageLabels(_ context.Context, image string, _ [][]byte) (map[string]string, error) {
+	f.inspections++
+	labels, ok := f.labels[image]
+	if !ok {
+		return nil, fmt.Errorf("manifest unknown")
+	}
+	return labels, nil
+}
+
+var _ = Describe("DGDR Profiler Capabilities", func() {
+	var (
+		reconciler *DynamoGraphDeploymentRequestReconciler
+		inspector  *fakeProfilerInspector
+	)
+
+	BeforeEach(func() {
+		inspector = &fakeProfilerInspector{
+			labels: map[string]map[string]string{
+				"test-profiler:capable": {
+					ImageLabelProfilerArgsSchemaVersion: "1",
+					ImageLabelProfilerBackends:          "vllm, sglang",
+					ImageLabelProfilerFeatures:          "ai-configurator,load-target",
+				},
+				"test-profiler:old-schema": {
+					ImageLabelProfilerArgsSchemaVersion: "0",
+					ImageLabelProfilerBackends:          "vllm",
+				},
+				"test-profiler:unlabeled": {},
+			},
+		}
+		reconciler = &DynamoGraphDeploymentRequestReconciler{
+			Client:            k8sClient,
+			Recorder:          record.NewFakeRecorder(100),
+			RBACManager:       &MockRBACManager{},
+			ProfilerInspector: inspector,
+		}
+	})
+
+	newDGDR := func(image, backend string, mode nvidiacomv1alpha1.ProfilingMode) *nvidiacomv1alpha1.DynamoGraphDeploymentRequest {
+		return &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-dgdr-capabilities", Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model:         "test-model",
+				Backend:       backend,
+				ProfilingMode: mode,
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					ProfilerImage: image,
+					Config: createTestConfig(map[string]interface{}{
+						"sla": map[string]interface{}{"ttft": 200.0, "itl": 20.0},
+					}),
+				},
+			},
+		}
+	}
+
+	deleteCapabilities := func(ctx context.Context, image string) {
+		capabilities := &nvidiacomv1alpha1.DynamoProfilerCapabilities{
+			ObjectMeta: metav1.ObjectMeta{Name: getProfilerCapabilitiesName(image), Namespace: defaultNamespace},
+		}
+		_ = k8sClient.Delete(ctx, capabilities)
+	}
+
+	It("Should parse the capability labels of profiler images", func() {
+		status := parseProfilerCapabilities(inspector.labels["test-profiler:capable"])
+		Expect(status.Discovered).Should(BeTrue())
+		Expect(status.ArgsSchemaVersion).Should(Equal(int32(1)))
+		Expect(status.Backends).Should(Equal([]string{"vllm", "sglang"}))
+		Expect(status.SweepFeatures).Should(Equal([]string{ProfilerFeatureAIConfigurator, ProfilerFeatureLoadTarget}))
+
+		Expect(parseProfilerCapabilities(map[string]string{}).Discovered).Should(BeFalse())
+		Expect(parseProfilerCapabilities(map[string]string{ImageLabelProfilerArgsSchemaVersion: "v1"}).Discovered).Should(BeFalse())
+	})
+
+	It("Should cache discovered capabilities per image", func() {
+		ctx := context.Background()
+		defer deleteCapabilities(ctx, "test-profiler:capable")
+
+		dgdr := newDGDR("test-profiler:capable", BackendVLLM, nvidiacomv1alpha1.ProfilingModeAIC)
+		Expect(reconciler.validateProfilerCapabilities(ctx, dgdr)).Should(Succeed())
+		Expect(reconciler.validateProfilerCapabilities(ctx, dgdr)).Should(Succeed())
+		Expect(inspector.inspections).Should(Equal(1))
+		Expect(dgdr.Status.Warnings).Should(BeEmpty())
+
+		capabilities := &nvidiacomv1alpha1.DynamoProfilerCapabilities{}
+		key := types.NamespacedName{Name: getProfilerCapabilitiesName("test-profiler:capable"), Namespace: defaultNamespace}
+		Expect(k8sClient.Get(ctx, key, capabilities)).Should(Succeed())
+		Expect(capabilities.Spec.Image).Should(Equal("test-profiler:capable"))
+		Expect(capabilities.Status.Discovered).Should(BeTrue())
+		Expect(capabilities.Status.Backends).Should(ConsistOf("vllm", "sglang"))
+		Expect(capabilities.Status.LastProbeTime).NotTo(BeNil())
+	})
+
+	It("Should reject specs the profiler image does not support", func() {
+		ctx := context.Background()
+		defer deleteCapabilities(ctx, "test-profiler:capable")
+		defer deleteCapabilities(ctx, "test-profiler:old-schema")
+
+		err := reconciler.validateProfilerCapabilities(ctx, newDGDR("test-profiler:capable", BackendTRTLLM, nvidiacomv1alpha1.ProfilingModeOnline))
+		Expect(err).To(MatchError(ContainSubstring("does not support backend trtllm")))
+		Expect(failureReasonFromError(err, "")).Should(Equal(nvidiacomv1alpha1.FailureReasonUnsupportedByProfiler))
+
+		dgdr := newDGDR("test-profiler:capable", BackendVLLM, nvidiacomv1alpha1.ProfilingModeOnline)
+		dgdr.Spec.ProfilingConfig.CPUOnly = true
+		err = reconciler.validateProfilerCapabilities(ctx, dgdr)
+		Expect(err).To(MatchError(fmt.Sprintf(ValidationErrorProfilerFeature, "test-profiler:capable", "profilingConfig.cpuOnly", ProfilerFeatureCPUOnly)))
+		Expect(failureReasonFromError(err, "")).Should(Equal(nvidiacomv1alpha1.FailureReasonUnsupportedByProfiler))
+
+		err = reconciler.validateProfilerCapabilities(ctx, newDGDR("test-profiler:old-schema", BackendVLLM, nvidiacomv1alpha1.ProfilingModeOnline))
+		Expect(err).To(MatchError(fmt.Sprintf(ValidationErrorProfilerSchemaVersion, "test-profiler:old-schema", 0, ProfilerArgsSchemaVersion)))
+	})
+
+	It("Should only warn for images without capability labels", func() {
+		ctx := context.Background()
+		defer deleteCapabilities(ctx, "test-profiler:unlabeled")
+		defer deleteCapabilities(ctx, "test-profiler:missing")
+
+		dgdr := newDGDR("test-profiler:unlabeled", BackendTRTLLM, nvidiacomv1alpha1.ProfilingModeOnline)
+		Expect(reconciler.validateProfilerCapabilities(ctx, dgdr)).Should(Succeed())
+		Expect(dgdr.Status.Warnings).Should(HaveLen(1))
+		Expect(dgdr.Status.Warnings[0].Type).Should(Equal(WarningProfilerCapabilitiesUnknown))
+
+		dgdr = newDGDR("test-profiler:missing", BackendVLLM, nvidiacomv1alpha1.ProfilingModeOnline)
+		Expect(reconciler.validateProfilerCapabilities(ctx, dgdr)).Should(Succeed())
+		Expect(dgdr.Status.Warnings).Should(HaveLen(1))
+		Expect(dgdr.Status.Warnings[0].Message).Should(ContainSubstring("manifest unknown"))
+	})
+})
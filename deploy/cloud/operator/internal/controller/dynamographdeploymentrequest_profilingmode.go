@@ -0,0 +1,106 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"errors"
+	"fmt"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+)
+
+const (
+	// ConfigKeyUseAIConfigurator selects AI Configurator profiling, under sweep
+	ConfigKeyUseAIConfigurator = "use_ai_configurator"
+
+	// Validation messages
+	ValidationErrorModeNoneRequiresPrecomputed = "spec.profilingMode none requires spec.precomputedDeployment"
+	ValidationErrorPrecomputedRequiresModeNone = "spec.precomputedDeployment requires spec.profilingMode none, got %s"
+)
+
+// configuredUseAIConfigurator returns sweep.use_ai_configurator of profilingConfig.config and
+// whether it is set
+func configuredUseAIConfigurator(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) (bool, bool) {
+	sweep, ok := parseProfilingConfig(dgdr)["sweep"].(map[string]interface{})
+	if !ok {
+		return false, false
+	}
+	useAIC, exists := sweep[ConfigKeyUseAIConfigurator].(bool)
+	return useAIC, exists
+}
+
+// getProfilingMode returns the profiling mode of the DGDR. DGDRs that omit spec.profilingMode are
+// converted from the fields that selected the mode before: spec.precomputedDeployment selects none,
+// and sweep.use_ai_configurator selects aic over online.
+func getProfilingMode(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) nvidiacomv1alpha1.ProfilingMode {
+	if dgdr.Spec.ProfilingMode != "" {
+		return dgdr.Spec.ProfilingMode
+	}
+	if dgdr.Spec.PrecomputedDeployment != nil {
+		return nvidiacomv1alpha1.ProfilingModeNone
+	}
+	if useAIC, _ := configuredUseAIConfigurator(dgdr); useAIC {
+		return nvidiacomv1alpha1.ProfilingModeAIC
+	}
+	return nvidiacomv1alpha1.ProfilingModeOnline
+}
+
+// validateProfilingMode checks that the profiling mode and spec.precomputedDeployment agree, and
+// warns when spec.profilingMode overrides sweep.use_ai_configurator of profilingConfig.config
+func validateProfilingMode(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	clearWarning(dgdr, WarningProfilingModeOverwritten)
+	mode := getProfilingMode(dgdr)
+	if mode == nvidiacomv1alpha1.ProfilingModeNone {
+		if dgdr.Spec.PrecomputedDeployment == nil {
+			return errors.New(ValidationErrorModeNoneRequiresPrecomputed)
+		}
+		return nil
+	}
+	if dgdr.Spec.PrecomputedDeployment != nil {
+		return fmt.Errorf(ValidationErrorPrecomputedRequiresModeNone, mode)
+	}
+
+	if useAIC, set := configuredUseAIConfigurator(dgdr); set && useAIC != (mode == nvidiacomv1alpha1.ProfilingModeAIC) {
+		setWarning(dgdr, WarningProfilingModeOverwritten,
+			fmt.Sprintf("profilingConfig.config.sweep.%s %t is overwritten by spec.profilingMode %q", ConfigKeyUseAIConfigurator, useAIC, mode))
+	}
+	return nil
+}
+
+// applyProfilingModeConfig tells the profiler which mode to run in
+func applyProfilingModeConfig(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, config map[string]interface{}) error {
+	sweepVal, hasSweep := config["sweep"]
+	if !hasSweep || sweepVal == nil {
+		sweepVal = make(map[string]interface{})
+		config["sweep"] = sweepVal
+	}
+	sweepConfig, ok := sweepVal.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("profilingConfig.config.sweep must be an object, got %T", sweepVal)
+	}
+	sweepConfig[ConfigKeyUseAIConfigurator] = getProfilingMode(dgdr) == nvidiacomv1alpha1.ProfilingModeAIC
+	return nil
+}
+
+// profilingJobLabelValue returns the app label of the profiling job for the profiling mode
+func profilingJobLabelValue(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) string {
+	if isOnlineProfiling(dgdr) {
+		return LabelValueDynamoProfiler
+	}
+	return LabelValueAICProfiler
+}
@@ -0,0 +1,140 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var _ = Describe("DGDR Profiling Mode", func() {
+	var reconciler *DynamoGraphDeploymentRequestReconciler
+
+	BeforeEach(func() {
+		reconciler = &DynamoGraphDeploymentRequestReconciler{
+			Client:      k8sClient,
+			Recorder:    record.NewFakeRecorder(100),
+			RBACManager: &MockRBACManager{},
+		}
+	})
+
+	newDGDR := func(name string, mode nvidiacomv1alpha1.ProfilingMode, sweep map[string]interface{}) *nvidiacomv1alpha1.DynamoGraphDeploymentRequest {
+		return &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model:         "test-model",
+				Backend:       BackendVLLM,
+				ProfilingMode: mode,
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					ProfilerImage: "test-profiler:latest",
+					Config: createTestConfig(map[string]interface{}{
+						"sla":   map[string]interface{}{"ttft": 200.0, "itl": 20.0},
+						"sweep": sweep,
+					}),
+				},
+			},
+		}
+	}
+
+	precomputed := &nvidiacomv1alpha1.PrecomputedDeploymentSpec{
+		Deployment: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"nvidia.com/v1alpha1","kind":"DynamoGraphDeployment","metadata":{"name":"known-good"},"spec":{"services":{"Frontend":{}}}}`)},
+	}
+
+	It("Should derive the mode of DGDRs that omit profilingMode", func() {
+		Expect(getProfilingMode(newDGDR("test-dgdr-mode", "", nil))).Should(Equal(nvidiacomv1alpha1.ProfilingModeOnline))
+		Expect(getProfilingMode(newDGDR("test-dgdr-mode", "", map[string]interface{}{"use_ai_configurator": false}))).
+			Should(Equal(nvidiacomv1alpha1.ProfilingModeOnline))
+		Expect(getProfilingMode(newDGDR("test-dgdr-mode", "", map[string]interface{}{"use_ai_configurator": true}))).
+			Should(Equal(nvidiacomv1alpha1.ProfilingModeAIC))
+
+		dgdr := newDGDR("test-dgdr-mode", "", nil)
+		dgdr.Spec.PrecomputedDeployment = precomputed
+		Expect(getProfilingMode(dgdr)).Should(Equal(nvidiacomv1alpha1.ProfilingModeNone))
+
+		// An explicit mode wins over the profiler config
+		Expect(getProfilingMode(newDGDR("test-dgdr-mode", nvidiacomv1alpha1.ProfilingModeOnline, map[string]interface{}{"use_ai_configurator": true}))).
+			Should(Equal(nvidiacomv1alpha1.ProfilingModeOnline))
+	})
+
+	It("Should require precomputedDeployment exactly with mode none", func() {
+		Expect(validateProfilingMode(newDGDR("test-dgdr-mode", nvidiacomv1alpha1.ProfilingModeNone, nil))).
+			To(MatchError(ValidationErrorModeNoneRequiresPrecomputed))
+
+		dgdr := newDGDR("test-dgdr-mode", nvidiacomv1alpha1.ProfilingModeAIC, nil)
+		dgdr.Spec.PrecomputedDeployment = precomputed
+		Expect(validateProfilingMode(dgdr)).
+			To(MatchError(fmt.Sprintf(ValidationErrorPrecomputedRequiresModeNone, nvidiacomv1alpha1.ProfilingModeAIC)))
+
+		dgdr.Spec.ProfilingMode = nvidiacomv1alpha1.ProfilingModeNone
+		Expect(validateProfilingMode(dgdr)).Should(Succeed())
+
+		// The API server rejects the same combinations
+		dgdr = newDGDR("test-dgdr-mode-none-without-precomputed", nvidiacomv1alpha1.ProfilingModeNone, nil)
+		Expect(k8sClient.Create(context.Background(), dgdr)).ShouldNot(Succeed())
+	})
+
+	It("Should warn when profilingMode overrides sweep.use_ai_configurator", func() {
+		dgdr := newDGDR("test-dgdr-mode", nvidiacomv1alpha1.ProfilingModeAIC, map[string]interface{}{"use_ai_configurator": false})
+		Expect(validateProfilingMode(dgdr)).Should(Succeed())
+		Expect(dgdr.Status.Warnings).Should(HaveLen(1))
+		Expect(dgdr.Status.Warnings[0].Type).Should(Equal(WarningProfilingModeOverwritten))
+
+		config, err := buildProfilingConfig(dgdr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(config["sweep"]).Should(HaveKeyWithValue(ConfigKeyUseAIConfigurator, true))
+
+		// The warning is cleared once the config agrees with spec.profilingMode
+		dgdr.Spec.ProfilingConfig.Config = newDGDR("test-dgdr-mode", nvidiacomv1alpha1.ProfilingModeAIC, map[string]interface{}{"use_ai_configurator": true}).Spec.ProfilingConfig.Config
+		Expect(validateProfilingMode(dgdr)).Should(Succeed())
+		Expect(dgdr.Status.Warnings).Should(BeEmpty())
+	})
+
+	It("Should create and label the profiling job for mode aic", func() {
+		ctx := context.Background()
+
+		dgdr := newDGDR("test-dgdr-mode-aic", nvidiacomv1alpha1.ProfilingModeAIC, nil)
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, dgdr) }()
+
+		request := reconcile.Request{NamespacedName: types.NamespacedName{Name: dgdr.Name, Namespace: defaultNamespace}}
+		for range 2 {
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		updated := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{}
+		Expect(k8sClient.Get(ctx, request.NamespacedName, updated)).Should(Succeed())
+		Expect(updated.Status.State).Should(Equal(StateProfiling))
+		Expect(updated.Status.ProfilingMode).Should(Equal(nvidiacomv1alpha1.ProfilingModeAIC))
+
+		job := &batchv1.Job{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: GetProfilingJobName(updated), Namespace: defaultNamespace}, job)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, job) }()
+		Expect(job.Labels).Should(HaveKeyWithValue(LabelApp, LabelValueAICProfiler))
+	})
+})
@@ -0,0 +1,128 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+)
+
+// Operator build information recorded in status.provenance. Set at build time with
+// -ldflags "-X github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/controller.OperatorVersion=..."
+var (
+	OperatorVersion = "dev"
+	OperatorCommit  = ""
+)
+
+// operatorCommit returns the source revision of the operator build, falling back to the VCS
+// revision stamped by the Go toolchain when OperatorCommit is not set
+func operatorCommit() string {
+	if OperatorCommit != "" {
+		return OperatorCommit
+	}
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return ""
+	}
+	for _, setting := range info.Settings {
+		if setting.Key == "vcs.revision" {
+			return setting.Value
+		}
+	}
+	return ""
+}
+
+// profilingJobPullSecrets returns the image pull secrets of the profiling job pod
+func profilingJobPullSecrets() []corev1.LocalObjectReference {
+	return []corev1.LocalObjectReference{{Name: "nvcr-imagepullsecret"}}
+}
+
+// recordProvenance records the profiler and sidecar images and the operator build in status when
+// profiling starts. The digests of the images are only known once the profiling pods ran them, and
+// are recorded by recordProvenanceDigests; images referenced by digest have it recorded right away.
+func recordProvenance(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) {
+	dgdr.Status.Provenance = &nvidiacomv1alpha1.ProfilingProvenance{
+		ProfilerImage:       dgdr.Spec.ProfilingConfig.ProfilerImage,
+		ProfilerImageDigest: imageDigest(dgdr.Spec.ProfilingConfig.ProfilerImage),
+		SidecarImage:        SidecarImage,
+		SidecarImageDigest:  imageDigest(SidecarImage),
+		OperatorVersion:     OperatorVersion,
+		OperatorCommit:      operatorCommit(),
+		RecordedTime:        metav1.Now(),
+	}
+}
+
+// recordProvenanceDigests records the digests of the profiler and sidecar images from the image IDs
+// the profiling pods report in their container statuses, so that they are the digests that actually
+// ran rather than those the tags point to now. Images no pod reported a digest for are recorded
+// without one and reported as a warning rather than failing the DGDR.
+func (r *DynamoGraphDeploymentRequestReconciler) recordProvenanceDigests(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) {
+	provenance := dgdr.Status.Provenance
+	if provenance == nil || r.isMockProfiling() {
+		return
+	}
+
+	pods := &corev1.PodList{}
+	if err := r.List(ctx, pods, client.InNamespace(dgdr.Namespace), client.MatchingLabels{"job-name": GetProfilingJobName(dgdr)}); err != nil {
+		log.FromContext(ctx).Info("Failed to list profiling pods for provenance", "error", err.Error())
+	}
+	for _, pod := range pods.Items {
+		for _, status := range pod.Status.ContainerStatuses {
+			digest := imageDigest(status.ImageID)
+			if digest == "" {
+				continue
+			}
+			switch {
+			case status.Name == ContainerNameProfiler && provenance.ProfilerImageDigest == "":
+				provenance.ProfilerImageDigest = digest
+			case status.Name == ContainerNameOutputCopier && provenance.SidecarImageDigest == "":
+				provenance.SidecarImageDigest = digest
+			}
+		}
+	}
+
+	unresolved := []string{}
+	if provenance.ProfilerImageDigest == "" {
+		unresolved = append(unresolved, provenance.ProfilerImage)
+	}
+	if provenance.SidecarImageDigest == "" {
+		unresolved = append(unresolved, provenance.SidecarImage)
+	}
+	if len(unresolved) > 0 {
+		setWarning(dgdr, WarningProvenanceIncomplete,
+			fmt.Sprintf("The profiling pods reported no digests of %s; status.provenance records the tags only", strings.Join(unresolved, ", ")))
+	} else {
+		clearWarning(dgdr, WarningProvenanceIncomplete)
+	}
+}
+
+// imageDigest returns the digest of an image reference or image ID, e.g. "sha256:..." of
+// "nvcr.io/nvidia/ai-dynamo/profiler@sha256:...", or "" when it has none
+func imageDigest(image string) string {
+	_, digest, _ := strings.Cut(image, "@")
+	return digest
+}
@@ -0,0 +1,136 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var _ = Describe("DGDR Provenance", func() {
+	var reconciler *DynamoGraphDeploymentRequestReconciler
+
+	BeforeEach(func() {
+		reconciler = &DynamoGraphDeploymentRequestReconciler{
+			Client:       k8sClient,
+			Recorder:     record.NewFakeRecorder(100),
+			RBACManager:  &MockRBACManager{},
+			ProfilerMode: ProfilerModeMock,
+		}
+	})
+
+	newDGDR := func(name, image string) *nvidiacomv1alpha1.DynamoGraphDeploymentRequest {
+		return &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model:   "Qwen/Qwen3-0.6B",
+				Backend: BackendVLLM,
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					ProfilerImage: image,
+					Config: createTestConfig(map[string]interface{}{
+						"sla":   map[string]interface{}{"ttft": 200.0, "itl": 20.0},
+						"sweep": map[string]interface{}{"use_ai_configurator": true},
+					}),
+				},
+			},
+		}
+	}
+
+	// createProfilingPod creates a pod of the profiling job of a DGDR reporting the given image IDs
+	createProfilingPod := func(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, profilerImageID, sidecarImageID string) {
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      dgdr.Name + "-profiling",
+				Namespace: defaultNamespace,
+				Labels:    map[string]string{"job-name": GetProfilingJobName(dgdr)},
+			},
+			Spec: corev1.PodSpec{Containers: []corev1.Container{
+				{Name: ContainerNameProfiler, Image: dgdr.Spec.ProfilingConfig.ProfilerImage},
+				{Name: ContainerNameOutputCopier, Image: SidecarImage},
+			}},
+		}
+		Expect(k8sClient.Create(ctx, pod)).Should(Succeed())
+		DeferCleanup(func() { _ = k8sClient.Delete(context.Background(), pod) })
+		pod.Status.ContainerStatuses = []corev1.ContainerStatus{
+			{Name: ContainerNameProfiler, Image: dgdr.Spec.ProfilingConfig.ProfilerImage, ImageID: profilerImageID},
+			{Name: ContainerNameOutputCopier, Image: SidecarImage, ImageID: sidecarImageID},
+		}
+		Expect(k8sClient.Status().Update(ctx, pod)).Should(Succeed())
+	}
+
+	It("Should record the images and the operator build when profiling starts", func() {
+		ctx := context.Background()
+		dgdr := newDGDR("test-dgdr-provenance", "test-profiler:latest")
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, dgdr) }()
+
+		request := reconcile.Request{NamespacedName: types.NamespacedName{Name: dgdr.Name, Namespace: defaultNamespace}}
+		for range 2 {
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		updated := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{}
+		Expect(k8sClient.Get(ctx, request.NamespacedName, updated)).Should(Succeed())
+		Expect(updated.Status.Provenance).NotTo(BeNil())
+		Expect(updated.Status.Provenance.ProfilerImage).Should(Equal("test-profiler:latest"))
+		Expect(updated.Status.Provenance.SidecarImage).Should(Equal(SidecarImage))
+		Expect(updated.Status.Provenance.OperatorVersion).Should(Equal(OperatorVersion))
+		Expect(updated.Status.Provenance.RecordedTime.IsZero()).To(BeFalse())
+		Expect(updated.Status.Warnings).ShouldNot(ContainElement(HaveField("Type", WarningProvenanceIncomplete)))
+
+		// Digest references are recorded before any pod ran them
+		pinned := newDGDR("test-dgdr-provenance-pinned", "test-profiler@sha256:pinned")
+		recordProvenance(pinned)
+		Expect(pinned.Status.Provenance.ProfilerImageDigest).Should(Equal("sha256:pinned"))
+	})
+
+	It("Should record the digests the profiling pods ran", func() {
+		ctx := context.Background()
+		reconciler.ProfilerMode = ""
+		dgdr := newDGDR("test-dgdr-provenance-digests", "test-profiler:latest")
+		createProfilingPod(ctx, dgdr, "docker.io/library/test-profiler@sha256:profiler", "docker.io/bitnami/kubectl@sha256:sidecar")
+
+		recordProvenance(dgdr)
+		reconciler.recordProvenanceDigests(ctx, dgdr)
+		Expect(dgdr.Status.Provenance.ProfilerImageDigest).Should(Equal("sha256:profiler"))
+		Expect(dgdr.Status.Provenance.SidecarImageDigest).Should(Equal("sha256:sidecar"))
+		Expect(dgdr.Status.Warnings).ShouldNot(ContainElement(HaveField("Type", WarningProvenanceIncomplete)))
+	})
+
+	It("Should record images no pod reported a digest of without failing", func() {
+		ctx := context.Background()
+		reconciler.ProfilerMode = ""
+		dgdr := newDGDR("test-dgdr-provenance-unresolved", "test-profiler:unknown")
+		createProfilingPod(ctx, dgdr, "docker.io/library/test-profiler@sha256:profiler", "")
+
+		recordProvenance(dgdr)
+		reconciler.recordProvenanceDigests(ctx, dgdr)
+		Expect(dgdr.Status.Provenance.ProfilerImageDigest).Should(Equal("sha256:profiler"))
+		Expect(dgdr.Status.Provenance.SidecarImageDigest).Should(BeEmpty())
+		Expect(dgdr.Status.Warnings).Should(ContainElement(HaveField("Message", ContainSubstring(SidecarImage))))
+	})
+})
@@ -0,0 +1,148 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/consts"
+)
+
+const (
+	// Condition reasons
+	ReasonDeploymentTimeout = "DeploymentTimeout"
+
+	// Messages
+	MessageDeploymentTimeout = "DynamoGraphDeployment %s not Ready within %s (state %q)"
+
+	// maxSchedulingDiagnostics caps the pending pods reported in status
+	maxSchedulingDiagnostics = 10
+)
+
+// deploymentReadyRemaining returns how long the DGD has left to become Ready, false if the DGDR
+// sets no deadline
+func deploymentReadyRemaining(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) (time.Duration, bool) {
+	if dgdr.Spec.DeploymentReadyTimeoutSeconds == nil {
+		return 0, false
+	}
+	// DGDs created before the deadline was tracked start the clock when first observed
+	if dgdr.Status.Deployment.DeployingSince == nil {
+		now := metav1.Now()
+		dgdr.Status.Deployment.DeployingSince = &now
+	}
+	timeout := time.Duration(*dgdr.Spec.DeploymentReadyTimeoutSeconds) * time.Second
+	return timeout - time.Since(dgdr.Status.Deployment.DeployingSince.Time), true
+}
+
+// failDeploymentTimeout fails a DGDR whose DGD missed the deploymentReadyTimeoutSeconds deadline,
+// recording why its pods are still pending
+func (r *DynamoGraphDeploymentRequestReconciler) failDeploymentTimeout(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, dgd *nvidiacomv1alpha1.DynamoGraphDeployment) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+	diagnostics, err := r.collectSchedulingDiagnostics(ctx, dgd)
+	if err != nil {
+		// The timeout stands without diagnostics
+		logger.Error(err, "Failed to collect scheduling diagnostics")
+	}
+	dgdr.Status.Deployment.SchedulingDiagnostics = diagnostics
+
+	timeout := time.Duration(*dgdr.Spec.DeploymentReadyTimeoutSeconds) * time.Second
+	message := fmt.Sprintf(MessageDeploymentTimeout, dgd.Name, timeout, dgd.Status.State)
+	if summary := summarizeSchedulingDiagnostics(diagnostics); summary != "" {
+		message += ": " + summary
+	}
+	logger.Info("DGD not Ready before the deadline, failing", "timeout", timeout, "dgdState", dgd.Status.State)
+	r.Recorder.Event(dgdr, corev1.EventTypeWarning, ReasonDeploymentTimeout, message)
+
+	return r.updateStateToFailed(ctx, dgdr, nvidiacomv1alpha1.FailureReasonDeploymentTimeout,
+		ConditionTypeDeploymentReady, ReasonDeploymentTimeout, message)
+}
+
+// collectSchedulingDiagnostics explains why the pods of the DGD are not running, from the
+// scheduling condition and container states the kubelet and scheduler record on each pod
+func (r *DynamoGraphDeploymentRequestReconciler) collectSchedulingDiagnostics(ctx context.Context, dgd *nvidiacomv1alpha1.DynamoGraphDeployment) ([]nvidiacomv1alpha1.SchedulingDiagnostic, error) {
+	pods := &corev1.PodList{}
+	if err := r.List(ctx, pods, client.InNamespace(dgd.Namespace),
+		client.MatchingLabels{consts.KubeLabelDynamoGraphDeploymentName: dgd.Name}); err != nil {
+		return nil, fmt.Errorf("failed to list pods of DynamoGraphDeployment %s: %w", dgd.Name, err)
+	}
+	sort.Slice(pods.Items, func(i, j int) bool { return pods.Items[i].Name < pods.Items[j].Name })
+
+	var diagnostics []nvidiacomv1alpha1.SchedulingDiagnostic
+	for i := range pods.Items {
+		if len(diagnostics) == maxSchedulingDiagnostics {
+			break
+		}
+		if diagnostic, ok := podDiagnostic(&pods.Items[i]); ok {
+			diagnostics = append(diagnostics, diagnostic)
+		}
+	}
+	return diagnostics, nil
+}
+
+// podDiagnostic returns why a pending pod is not running, false if the pod is not pending
+// or the reason is unknown
+func podDiagnostic(pod *corev1.Pod) (nvidiacomv1alpha1.SchedulingDiagnostic, bool) {
+	if pod.Status.Phase != corev1.PodPending {
+		return nvidiacomv1alpha1.SchedulingDiagnostic{}, false
+	}
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodScheduled && condition.Status == corev1.ConditionFalse {
+			return nvidiacomv1alpha1.SchedulingDiagnostic{Pod: pod.Name, Reason: condition.Reason, Message: condition.Message}, true
+		}
+	}
+	// Scheduled but not started, e.g. images cannot be pulled
+	statuses := append(append([]corev1.ContainerStatus{}, pod.Status.InitContainerStatuses...), pod.Status.ContainerStatuses...)
+	for _, status := range statuses {
+		if waiting := status.State.Waiting; waiting != nil && waiting.Reason != "" && waiting.Reason != "PodInitializing" && waiting.Reason != "ContainerCreating" {
+			return nvidiacomv1alpha1.SchedulingDiagnostic{Pod: pod.Name, Reason: waiting.Reason, Message: waiting.Message}, true
+		}
+	}
+	return nvidiacomv1alpha1.SchedulingDiagnostic{}, false
+}
+
+// summarizeSchedulingDiagnostics counts the pending pods per reason, e.g. "2 pods Unschedulable"
+func summarizeSchedulingDiagnostics(diagnostics []nvidiacomv1alpha1.SchedulingDiagnostic) string {
+	counts := map[string]int{}
+	var reasons []string
+	for _, diagnostic := range diagnostics {
+		if counts[diagnostic.Reason] == 0 {
+			reasons = append(reasons, diagnostic.Reason)
+		}
+		counts[diagnostic.Reason]++
+	}
+	parts := make([]string, 0, len(reasons))
+	for _, reason := range reasons {
+		noun := "pods"
+		if counts[reason] == 1 {
+			noun = "pod"
+		}
+		parts = append(parts, fmt.Sprintf("%d %s %s", counts[reason], noun, reason))
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,148 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/consts"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var _ = Describe("DGDR Deployment Ready Timeout", func() {
+	var reconciler *DynamoGraphDeploymentRequestReconciler
+
+	BeforeEach(func() {
+		reconciler = &DynamoGraphDeploymentRequestReconciler{
+			Client:      k8sClient,
+			Recorder:    record.NewFakeRecorder(100),
+			RBACManager: &MockRBACManager{},
+		}
+	})
+
+	// setup creates a pending DGD and a Deploying DGDR that has waited for it since deployingSince
+	setup := func(ctx context.Context, name string, deployingSince time.Time) (*nvidiacomv1alpha1.DynamoGraphDeploymentRequest, *nvidiacomv1alpha1.DynamoGraphDeployment) {
+		dgd := &nvidiacomv1alpha1.DynamoGraphDeployment{
+			ObjectMeta: metav1.ObjectMeta{Name: name + "-dgd", Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentSpec{
+				Services: map[string]*nvidiacomv1alpha1.DynamoComponentDeploymentSharedSpec{"Frontend": {}},
+			},
+		}
+		Expect(k8sClient.Create(ctx, dgd)).Should(Succeed())
+		dgd.Status.State = "pending"
+		Expect(k8sClient.Status().Update(ctx, dgd)).Should(Succeed())
+
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model:   "test-model",
+				Backend: "vllm",
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					ProfilerImage: "test-profiler:latest",
+				},
+				AutoApply:                     true,
+				DeploymentReadyTimeoutSeconds: ptr.To(int32(600)),
+			},
+		}
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		dgdr.Status.State = StateDeploying
+		dgdr.Status.ObservedGeneration = dgdr.Generation
+		dgdr.Status.Deployment = &nvidiacomv1alpha1.DeploymentStatus{
+			Name:           dgd.Name,
+			Namespace:      defaultNamespace,
+			Created:        true,
+			State:          "pending",
+			DeployingSince: &metav1.Time{Time: deployingSince},
+		}
+		Expect(k8sClient.Status().Update(ctx, dgdr)).Should(Succeed())
+		return dgdr, dgd
+	}
+
+	reconcileAndGet := func(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) (reconcile.Result, *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) {
+		request := reconcile.Request{NamespacedName: types.NamespacedName{Name: dgdr.Name, Namespace: dgdr.Namespace}}
+		result, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		updated := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{}
+		Expect(k8sClient.Get(ctx, request.NamespacedName, updated)).Should(Succeed())
+		return result, updated
+	}
+
+	It("Should keep waiting until the deadline", func() {
+		ctx := context.Background()
+		dgdr, dgd := setup(ctx, "test-dgdr-ready-wait", time.Now())
+		defer func() { _ = k8sClient.Delete(ctx, dgdr) }()
+		defer func() { _ = k8sClient.Delete(ctx, dgd) }()
+
+		result, updated := reconcileAndGet(ctx, dgdr)
+		Expect(updated.Status.State).Should(Equal(StateDeploying))
+		Expect(result.RequeueAfter).Should(BeNumerically(">", 590*time.Second))
+		Expect(result.RequeueAfter).Should(BeNumerically("<=", 600*time.Second))
+	})
+
+	It("Should fail with scheduling diagnostics once the deadline passes", func() {
+		ctx := context.Background()
+		dgdr, dgd := setup(ctx, "test-dgdr-ready-timeout", time.Now().Add(-time.Hour))
+		defer func() { _ = k8sClient.Delete(ctx, dgdr) }()
+		defer func() { _ = k8sClient.Delete(ctx, dgd) }()
+
+		for _, name := range []string{"test-dgdr-ready-timeout-worker-0", "test-dgdr-ready-timeout-worker-1"} {
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: defaultNamespace,
+					Labels:    map[string]string{consts.KubeLabelDynamoGraphDeploymentName: dgd.Name},
+				},
+				Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "main", Image: "test"}}},
+			}
+			Expect(k8sClient.Create(ctx, pod)).Should(Succeed())
+			defer func() { _ = k8sClient.Delete(ctx, pod) }()
+			pod.Status.Phase = corev1.PodPending
+			pod.Status.Conditions = []corev1.PodCondition{{
+				Type:    corev1.PodScheduled,
+				Status:  corev1.ConditionFalse,
+				Reason:  corev1.PodReasonUnschedulable,
+				Message: "0/4 nodes are available: 4 Insufficient nvidia.com/gpu.",
+			}}
+			Expect(k8sClient.Status().Update(ctx, pod)).Should(Succeed())
+		}
+
+		_, updated := reconcileAndGet(ctx, dgdr)
+		Expect(updated.Status.State).Should(Equal(StateFailed))
+		Expect(updated.Status.FailureReason).Should(Equal(nvidiacomv1alpha1.FailureReasonDeploymentTimeout))
+		Expect(updated.Status.Deployment.SchedulingDiagnostics).Should(HaveLen(2))
+		Expect(updated.Status.Deployment.SchedulingDiagnostics[0]).Should(Equal(nvidiacomv1alpha1.SchedulingDiagnostic{
+			Pod:     "test-dgdr-ready-timeout-worker-0",
+			Reason:  corev1.PodReasonUnschedulable,
+			Message: "0/4 nodes are available: 4 Insufficient nvidia.com/gpu.",
+		}))
+		condition := meta.FindStatusCondition(updated.Status.Conditions, ConditionTypeDeploymentReady)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Reason).Should(Equal(ReasonDeploymentTimeout))
+		Expect(condition.Message).Should(ContainSubstring("2 pods Unschedulable"))
+	})
+})
@@ -0,0 +1,189 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	commonController "github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/controller_common"
+)
+
+const (
+	// AnnotationRecheck set to "true" on a Ready DGDR re-evaluates its deployment immediately; it is
+	// removed once processed
+	AnnotationRecheck = "nvidia.com/recheck"
+
+	// Event reasons
+	EventReasonRechecked         = "Rechecked"
+	EventReasonRecheckRejected   = "RecheckRejected"
+	EventReasonDeploymentDrifted = "DeploymentDrifted"
+
+	// Messages
+	MessageRechecked         = "Re-checked DynamoGraphDeployment %s"
+	MessageDeploymentDrifted = "DynamoGraphDeployment %s no longer matches the generated spec, re-applying it"
+	MessageSLANotMet         = "the deployment may not meet the SLA: %s"
+)
+
+// recheckRequested reports whether the recheck annotation is set on the DGDR
+func recheckRequested(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) bool {
+	return dgdr.Annotations[AnnotationRecheck] == "true"
+}
+
+// handleRecheck re-evaluates the deployment of a Ready DGDR on request, for when DGD events were
+// missed, e.g. while the operator was down. A DGD whose spec no longer matches the generated spec
+// is re-applied, otherwise its SLA compliance is re-evaluated and the DGD is checked as on any of
+// its events: whether it still exists, its state and its endpoint.
+func (r *DynamoGraphDeploymentRequestReconciler) handleRecheck(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	// Clear the trigger so the re-check runs once per request
+	patch := client.MergeFrom(dgdr.DeepCopy())
+	delete(dgdr.Annotations, AnnotationRecheck)
+	if err := r.Patch(ctx, dgdr, patch); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to clear recheck annotation: %w", err)
+	}
+
+	var rejection string
+	switch {
+	case dgdr.Status.State != StateReady:
+		rejection = fmt.Sprintf("recheck requires state %s, DGDR is %s", StateReady, dgdr.Status.State)
+	case !dgdr.Spec.AutoApply || dgdr.Status.Deployment == nil:
+		rejection = "recheck requires a deployment applied with autoApply"
+	}
+	if rejection != "" {
+		logger.Info("Rejecting recheck", "reason", rejection)
+		r.Recorder.Event(dgdr, corev1.EventTypeWarning, EventReasonRecheckRejected, rejection)
+		return ctrl.Result{}, nil
+	}
+	logger.Info("Re-checking deployment", "name", dgdr.Status.Deployment.Name)
+
+	dgd := &nvidiacomv1alpha1.DynamoGraphDeployment{}
+	err := r.Get(ctx, types.NamespacedName{
+		Name:      dgdr.Status.Deployment.Name,
+		Namespace: dgdr.Status.Deployment.Namespace,
+	}, dgd)
+	if apierrors.IsNotFound(err) {
+		return r.handleDGDDeleted(ctx, dgdr)
+	}
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	drifted, err := deploymentDrifted(dgdr, dgd)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if drifted {
+		message := fmt.Sprintf(MessageDeploymentDrifted, dgd.Name)
+		logger.Info("Deployment drifted from the generated spec", "name", dgd.Name)
+		r.Recorder.Event(dgdr, corev1.EventTypeWarning, EventReasonDeploymentDrifted, message)
+		meta.SetStatusCondition(&dgdr.Status.Conditions, metav1.Condition{
+			Type:    ConditionTypeDeploymentReady,
+			Status:  metav1.ConditionFalse,
+			Reason:  EventReasonDeploymentDrifted,
+			Message: message,
+		})
+
+		// createDGD leaves a DGD that carries the hash of the generated spec alone
+		dgdPatch := client.MergeFrom(dgd.DeepCopy())
+		delete(dgd.Annotations, AnnotationGeneratedSpecHash)
+		if err := r.Patch(ctx, dgd, dgdPatch); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to clear the spec hash of %s: %w", dgd.Name, err)
+		}
+		dgdr.Status.State = StateDeploying
+		return r.createDGD(ctx, dgdr)
+	}
+
+	if err := evaluateSLACompliance(dgdr); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	r.Recorder.Event(dgdr, corev1.EventTypeNormal, EventReasonRechecked, fmt.Sprintf(MessageRechecked, dgd.Name))
+	return r.handleReadyState(ctx, dgdr)
+}
+
+// deploymentDrifted reports whether the live DGD no longer carries the generated spec. A DGD the
+// generated spec was merged into with applyToExisting has fields of its own, so only the hash of
+// the spec last merged into it is compared.
+func deploymentDrifted(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, dgd *nvidiacomv1alpha1.DynamoGraphDeployment) (bool, error) {
+	hash, err := generatedSpecHash(dgdr)
+	if err != nil {
+		return false, err
+	}
+	if isApplyToExisting(dgdr) {
+		applied, exists := dgd.Annotations[AnnotationGeneratedSpecHash]
+		return exists && applied != hash, nil
+	}
+	live, err := commonController.GetSpecHash(dgd)
+	if err != nil {
+		return false, fmt.Errorf("failed to hash %s: %w", dgd.Name, err)
+	}
+	return live != hash, nil
+}
+
+// evaluateSLACompliance warns when the generated deployment is not known to meet the SLA the DGDR
+// requests now: the profiler estimated that the selected backend misses it, or the deployment was
+// profiled for another SLA.
+func evaluateSLACompliance(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	var reasons []string
+	for _, evaluation := range dgdr.Status.BackendComparison {
+		if evaluation.Selected && !evaluation.Feasible {
+			reasons = append(reasons, fmt.Sprintf("the profiler estimated that backend %s does not meet it", evaluation.Backend))
+		}
+	}
+	if profiled := profiledSLA(dgdr); profiled != nil {
+		_, requested, err := profilingInputs(dgdr)
+		if err != nil {
+			return err
+		}
+		if requested == nil || !bytes.Equal(profiled.Raw, requested.Raw) {
+			reasons = append(reasons, fmt.Sprintf("it was profiled for SLA %s", profiled.Raw))
+		}
+	}
+
+	if len(reasons) == 0 {
+		clearWarning(dgdr, WarningSLANotMet)
+		return nil
+	}
+	setWarning(dgdr, WarningSLANotMet, fmt.Sprintf(MessageSLANotMet, strings.Join(reasons, "; ")))
+	return nil
+}
+
+// profiledSLA returns the SLA of the last successful profiling attempt, nil if none was recorded
+func profiledSLA(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) *apiextensionsv1.JSON {
+	for i := len(dgdr.Status.Attempts) - 1; i >= 0; i-- {
+		if attempt := dgdr.Status.Attempts[i]; attempt.Outcome == nvidiacomv1alpha1.ProfilingAttemptSucceeded {
+			return attempt.SLA
+		}
+	}
+	return nil
+}
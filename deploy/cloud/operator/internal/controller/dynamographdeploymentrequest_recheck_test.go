@@ -0,0 +1,168 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var _ = Describe("DGDR Recheck", func() {
+	var reconciler *DynamoGraphDeploymentRequestReconciler
+	var recorder *record.FakeRecorder
+
+	BeforeEach(func() {
+		recorder = record.NewFakeRecorder(100)
+		reconciler = &DynamoGraphDeploymentRequestReconciler{
+			Client:      k8sClient,
+			Recorder:    recorder,
+			RBACManager: &MockRBACManager{},
+		}
+	})
+
+	// setup creates a DGD in the given state and a DGDR in the given state managing it, with the
+	// recheck annotation set
+	setup := func(ctx context.Context, name, state, dgdState string, dgdAnnotations map[string]string) *nvidiacomv1alpha1.DynamoGraphDeploymentRequest {
+		dgd := &nvidiacomv1alpha1.DynamoGraphDeployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name + "-dgd",
+				Namespace:   defaultNamespace,
+				Annotations: dgdAnnotations,
+				Labels:      map[string]string{LabelDGDRName: name, LabelDGDRNamespace: defaultNamespace},
+			},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentSpec{
+				Services: map[string]*nvidiacomv1alpha1.DynamoComponentDeploymentSharedSpec{"Frontend": {Replicas: ptr.To(int32(1))}},
+			},
+		}
+		Expect(k8sClient.Create(ctx, dgd)).Should(Succeed())
+		DeferCleanup(func() { _ = k8sClient.Delete(ctx, dgd) })
+		dgd.Status.State = dgdState
+		Expect(k8sClient.Status().Update(ctx, dgd)).Should(Succeed())
+
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Namespace:   defaultNamespace,
+				Annotations: map[string]string{AnnotationRecheck: "true"},
+			},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model:   "test-model",
+				Backend: "vllm",
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					ProfilerImage: "test-profiler:latest",
+				},
+				AutoApply: true,
+			},
+		}
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		DeferCleanup(func() { _ = k8sClient.Delete(ctx, dgdr) })
+		dgdr.Status.State = state
+		dgdr.Status.ObservedGeneration = dgdr.Generation
+		dgdr.Status.GeneratedDeployment = &runtime.RawExtension{Raw: []byte(
+			`{"apiVersion":"nvidia.com/v1alpha1","kind":"DynamoGraphDeployment","metadata":{"name":"` + dgd.Name + `"},"spec":{"services":{"Frontend":{"replicas":1}}}}`)}
+		dgdr.Status.Deployment = &nvidiacomv1alpha1.DeploymentStatus{
+			Name:      dgd.Name,
+			Namespace: defaultNamespace,
+			Created:   true,
+			State:     "Ready",
+		}
+		Expect(k8sClient.Status().Update(ctx, dgdr)).Should(Succeed())
+		return dgdr
+	}
+
+	reconcileAndGet := func(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) *nvidiacomv1alpha1.DynamoGraphDeploymentRequest {
+		request := reconcile.Request{NamespacedName: types.NamespacedName{Name: dgdr.Name, Namespace: dgdr.Namespace}}
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		updated := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{}
+		Expect(k8sClient.Get(ctx, request.NamespacedName, updated)).Should(Succeed())
+		Expect(updated.Annotations).NotTo(HaveKey(AnnotationRecheck))
+		return updated
+	}
+
+	It("Should pick up a DGD state change that was missed", func() {
+		ctx := context.Background()
+		dgdr := setup(ctx, "test-dgdr-recheck", StateReady, "pending", nil)
+
+		updated := reconcileAndGet(ctx, dgdr)
+		Expect(updated.Status.State).Should(Equal(StateDegraded))
+		Expect(updated.Status.Deployment.State).Should(Equal("pending"))
+		Expect(recorder.Events).Should(Receive(ContainSubstring(EventReasonRechecked)))
+	})
+
+	It("Should re-apply a DGD that drifted from the generated spec", func() {
+		ctx := context.Background()
+		dgdr := setup(ctx, "test-dgdr-recheck-drift", StateReady, "Ready", nil)
+		hash, err := generatedSpecHash(dgdr)
+		Expect(err).NotTo(HaveOccurred())
+
+		// The spec was edited while the DGD kept the hash of the generated spec
+		dgd := &nvidiacomv1alpha1.DynamoGraphDeployment{}
+		key := types.NamespacedName{Name: dgdr.Status.Deployment.Name, Namespace: defaultNamespace}
+		Expect(k8sClient.Get(ctx, key, dgd)).Should(Succeed())
+		dgd.Annotations = map[string]string{AnnotationGeneratedSpecHash: hash}
+		dgd.Spec.Services["Frontend"].Replicas = ptr.To(int32(3))
+		Expect(k8sClient.Update(ctx, dgd)).Should(Succeed())
+
+		updated := reconcileAndGet(ctx, dgdr)
+		Expect(updated.Status.State).Should(Equal(StateDeploying))
+		Expect(recorder.Events).Should(Receive(ContainSubstring(EventReasonDeploymentDrifted)))
+		Expect(k8sClient.Get(ctx, key, dgd)).Should(Succeed())
+		Expect(dgd.Spec.Services["Frontend"].Replicas).Should(Equal(ptr.To(int32(1))))
+		Expect(dgd.Annotations).Should(HaveKeyWithValue(AnnotationGeneratedSpecHash, hash))
+	})
+
+	It("Should re-evaluate the SLA compliance of the deployment", func() {
+		ctx := context.Background()
+		dgdr := setup(ctx, "test-dgdr-recheck-sla", StateReady, "Ready", nil)
+		dgdr.Status.BackendComparison = []nvidiacomv1alpha1.BackendEvaluation{{Backend: BackendVLLM, Feasible: false, Selected: true}}
+		Expect(k8sClient.Status().Update(ctx, dgdr)).Should(Succeed())
+
+		updated := reconcileAndGet(ctx, dgdr)
+		Expect(updated.Status.State).Should(Equal(StateReady))
+		Expect(updated.Status.Warnings).Should(ContainElement(And(
+			HaveField("Type", WarningSLANotMet),
+			HaveField("Message", ContainSubstring("backend vllm does not meet it")))))
+
+		// A feasible deployment clears the warning on the next recheck
+		updated.Annotations = map[string]string{AnnotationRecheck: "true"}
+		Expect(k8sClient.Update(ctx, updated)).Should(Succeed())
+		updated.Status.BackendComparison[0].Feasible = true
+		Expect(k8sClient.Status().Update(ctx, updated)).Should(Succeed())
+		updated = reconcileAndGet(ctx, updated)
+		Expect(updated.Status.Warnings).ShouldNot(ContainElement(HaveField("Type", WarningSLANotMet)))
+	})
+
+	It("Should reject rechecks of DGDRs that are not Ready", func() {
+		ctx := context.Background()
+		dgdr := setup(ctx, "test-dgdr-recheck-failed", StateFailed, "pending", nil)
+
+		updated := reconcileAndGet(ctx, dgdr)
+		Expect(updated.Status.State).Should(Equal(StateFailed))
+		Expect(recorder.Events).Should(Receive(ContainSubstring(EventReasonRecheckRejected)))
+	})
+})
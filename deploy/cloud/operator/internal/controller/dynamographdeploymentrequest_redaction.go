@@ -0,0 +1,344 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+)
+
+const (
+	// RedactedValue replaces profiling config values that are not known to be safe to echo
+	RedactedValue = "<redacted>"
+
+	// minRedactedLength is the shortest config value replaced in free-form messages. Shorter values
+	// are too likely to match unrelated text and are only redacted from structured copies of the config.
+	minRedactedLength = 6
+)
+
+// profilingConfigAllowlist lists the profiling config keys whose string values are echoed verbatim.
+// They name models, images, backends and paths the operator itself sets or reports elsewhere.
+// Values inside lists inherit the key of the list.
+var profilingConfigAllowlist = map[string]bool{
+	"model":               true,
+	"dgd_image":           true,
+	"namespace":           true,
+	"backend":             true,
+	"workload_type":       true,
+	"gpu_vendor":          true,
+	"gpu_resource_name":   true,
+	"config":              true,
+	"output_dir":          true,
+	"resume_from":         true,
+	"service_name":        true,
+	"aic_system":          true,
+	"aic_model_name":      true,
+	"aic_hf_id":           true,
+	"aic_backend":         true,
+	"aic_backend_version": true,
+	"aic_backends":        true,
+	"operator":            true,
+	"effect":              true,
+}
+
+// credentialKeyPattern matches profiling config keys that look like they hold credentials
+var credentialKeyPattern = regexp.MustCompile(`(?i)(token|password|passwd|secret|credential|api_?key|auth)`)
+
+// CredentialConfigPaths returns the dotted paths of the keys in an inline profiling config that look
+// like they hold credentials, which belong in a Secret referenced by profilingConfig.secretRef
+func CredentialConfigPaths(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) []string {
+	var paths []string
+	var walk func(value interface{}, path string)
+	walk = func(value interface{}, path string) {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			for k, child := range v {
+				childPath := k
+				if path != "" {
+					childPath = path + "." + k
+				}
+				if _, nested := child.(map[string]interface{}); !nested && credentialKeyPattern.MatchString(k) {
+					paths = append(paths, childPath)
+					continue
+				}
+				walk(child, childPath)
+			}
+		case []interface{}:
+			for i, child := range v {
+				walk(child, fmt.Sprintf("%s[%d]", path, i))
+			}
+		}
+	}
+	walk(parseProfilingConfig(dgdr), "")
+	slices.Sort(paths)
+	return paths
+}
+
+// redactProfilingConfig returns a copy of a parsed profiling config with the string values of
+// keys outside profilingConfigAllowlist replaced by RedactedValue
+func redactProfilingConfig(value interface{}, key string) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		redacted := make(map[string]interface{}, len(v))
+		for k, child := range v {
+			redacted[k] = redactProfilingConfig(child, k)
+		}
+		return redacted
+	case []interface{}:
+		redacted := make([]interface{}, len(v))
+		for i, child := range v {
+			redacted[i] = redactProfilingConfig(child, key)
+		}
+		return redacted
+	case string:
+		if profilingConfigAllowlist[key] {
+			return v
+		}
+		return RedactedValue
+	default:
+		return v
+	}
+}
+
+// collectSensitiveValues appends the string values of keys outside profilingConfigAllowlist
+func collectSensitiveValues(value interface{}, key string, values []string) []string {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for k, child := range v {
+			values = collectSensitiveValues(child, k, values)
+		}
+	case []interface{}:
+		for _, child := range v {
+			values = collectSensitiveValues(child, key, values)
+		}
+	case string:
+		if !profilingConfigAllowlist[key] && len(v) >= minRedactedLength {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+// unmarshalProfilingConfig returns the DGDR's inline profiling config, nil if it is missing
+func unmarshalProfilingConfig(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) (map[string]interface{}, error) {
+	if dgdr.Spec.ProfilingConfig.Config == nil {
+		return nil, nil
+	}
+	var config map[string]interface{}
+	if err := yaml.Unmarshal(dgdr.Spec.ProfilingConfig.Config.Raw, &config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// parseProfilingConfig returns the DGDR's inline profiling config, nil if it is missing or invalid.
+// Invalid configs are reported by the config structure validation.
+func parseProfilingConfig(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) map[string]interface{} {
+	config, _ := unmarshalProfilingConfig(dgdr)
+	return config
+}
+
+// referencedValues holds, by DGDR UID, the values of the ConfigMaps and Secrets the DGDR passes to
+// its pods. They are collected on every reconcile, as messages are redacted where no client is at hand.
+var referencedValues sync.Map
+
+// collectReferencedValues reads the ConfigMaps and Secrets the DGDR passes to its pods, its
+// Hugging Face token, spec.secrets, the profiling config reference and the valueFrom of
+// deploymentOverrides env, and remembers their values for redactMessage. Objects that do not
+// exist yet are skipped, validation reports them.
+func (r *DynamoGraphDeploymentRequestReconciler) collectReferencedValues(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	secretNames := []string{hfTokenSecretName(dgdr)}
+	for _, secret := range dgdr.Spec.Secrets {
+		secretNames = append(secretNames, secret.Name)
+	}
+	secretKeys := map[string][]string{}
+	configMapKeys := map[string][]string{}
+	if overrides := dgdr.Spec.DeploymentOverrides; overrides != nil {
+		for _, service := range overrides.Services {
+			for _, env := range service.Env {
+				switch {
+				case env.ValueFrom == nil:
+				case env.ValueFrom.SecretKeyRef != nil:
+					ref := env.ValueFrom.SecretKeyRef
+					secretKeys[ref.Name] = append(secretKeys[ref.Name], ref.Key)
+				case env.ValueFrom.ConfigMapKeyRef != nil:
+					ref := env.ValueFrom.ConfigMapKeyRef
+					configMapKeys[ref.Name] = append(configMapKeys[ref.Name], ref.Key)
+				}
+			}
+		}
+	}
+
+	var values []string
+	addValue := func(value string) {
+		if len(strings.TrimSpace(value)) >= minRedactedLength {
+			values = append(values, value)
+		}
+	}
+	addConfig := func(content string) {
+		var config map[string]interface{}
+		if err := yaml.Unmarshal([]byte(content), &config); err == nil {
+			values = collectSensitiveValues(config, "", values)
+		}
+	}
+	getObject := func(name string, obj client.Object) (bool, error) {
+		if err := r.Get(ctx, types.NamespacedName{Name: name, Namespace: dgdr.Namespace}, obj); err != nil {
+			if apierrors.IsNotFound(err) {
+				return false, nil
+			}
+			return false, fmt.Errorf("failed to get %s for redaction: %w", name, err)
+		}
+		return true, nil
+	}
+
+	for _, name := range secretNames {
+		secret := &corev1.Secret{}
+		if found, err := getObject(name, secret); err != nil {
+			return err
+		} else if found {
+			for _, value := range secret.Data {
+				addValue(string(value))
+			}
+		}
+	}
+	for name, keys := range secretKeys {
+		secret := &corev1.Secret{}
+		if found, err := getObject(name, secret); err != nil {
+			return err
+		} else if found {
+			for _, key := range keys {
+				addValue(string(secret.Data[key]))
+			}
+		}
+	}
+	for name, keys := range configMapKeys {
+		cm := &corev1.ConfigMap{}
+		if found, err := getObject(name, cm); err != nil {
+			return err
+		} else if found {
+			for _, key := range keys {
+				addValue(cm.Data[key])
+			}
+		}
+	}
+	if ref := dgdr.Spec.ProfilingConfig.SecretRef; ref != nil {
+		secret := &corev1.Secret{}
+		if found, err := getObject(ref.Name, secret); err != nil {
+			return err
+		} else if found {
+			addConfig(string(secret.Data[cmp.Or(ref.Key, ProfilingConfigFile)]))
+		}
+	}
+	if ref := dgdr.Spec.ProfilingConfig.ConfigMapRef; ref != nil {
+		cm := &corev1.ConfigMap{}
+		if found, err := getObject(ref.Name, cm); err != nil {
+			return err
+		} else if found {
+			addConfig(cm.Data[cmp.Or(ref.Key, ProfilingConfigFile)])
+		}
+	}
+
+	referencedValues.Store(dgdr.UID, values)
+	return nil
+}
+
+// forgetReferencedValues drops the values collected for a DGDR that is going away
+func forgetReferencedValues(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) {
+	referencedValues.Delete(dgdr.UID)
+}
+
+// redactMessage replaces the values of the DGDR's profiling config that are not known to be safe,
+// and those of the ConfigMaps and Secrets it passes to its pods, in a message echoed into events,
+// logs or status
+func redactMessage(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, message string) string {
+	values := collectSensitiveValues(parseProfilingConfig(dgdr), "", nil)
+	if referenced, ok := referencedValues.Load(dgdr.UID); ok {
+		values = append(values, referenced.([]string)...)
+	}
+	// Replace longer values first, so values containing others are replaced whole
+	slices.SortFunc(values, func(a, b string) int { return cmp.Compare(len(b), len(a)) })
+	for _, value := range values {
+		message = strings.ReplaceAll(message, value, RedactedValue)
+	}
+	return message
+}
+
+// redactedSpec returns a copy of the DGDR spec with the profiling config redacted, for records that
+// outlive the DGDR
+func redactedSpec(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) (*nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec, error) {
+	spec := dgdr.Spec.DeepCopy()
+	config := parseProfilingConfig(dgdr)
+	if config == nil {
+		return spec, nil
+	}
+	raw, err := yaml.Marshal(redactProfilingConfig(config, ""))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal redacted profiling config: %w", err)
+	}
+	raw, err = yaml.YAMLToJSON(raw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert redacted profiling config: %w", err)
+	}
+	spec.ProfilingConfig.Config.Raw = raw
+	return spec, nil
+}
+
+// redactingRecorder redacts the profiling config of DGDRs from the messages of their events
+type redactingRecorder struct {
+	record.EventRecorder
+}
+
+// NewRedactingRecorder wraps recorder so that events of DGDRs never echo profiling config values
+// that are not known to be safe
+func NewRedactingRecorder(recorder record.EventRecorder) record.EventRecorder {
+	return &redactingRecorder{EventRecorder: recorder}
+}
+
+func (r *redactingRecorder) redact(object runtime.Object, message string) string {
+	if dgdr, ok := object.(*nvidiacomv1alpha1.DynamoGraphDeploymentRequest); ok {
+		return redactMessage(dgdr, message)
+	}
+	return message
+}
+
+func (r *redactingRecorder) Event(object runtime.Object, eventtype, reason, message string) {
+	r.EventRecorder.Event(object, eventtype, reason, r.redact(object, message))
+}
+
+func (r *redactingRecorder) Eventf(object runtime.Object, eventtype, reason, messageFmt string, args ...interface{}) {
+	r.EventRecorder.Event(object, eventtype, reason, r.redact(object, fmt.Sprintf(messageFmt, args...)))
+}
+
+func (r *redactingRecorder) AnnotatedEventf(object runtime.Object, annotations map[string]string, eventtype, reason, messageFmt string, args ...interface{}) {
+	r.EventRecorder.AnnotatedEventf(object, annotations, eventtype, reason, "%s", r.redact(object, fmt.Sprintf(messageFmt, args...)))
+}
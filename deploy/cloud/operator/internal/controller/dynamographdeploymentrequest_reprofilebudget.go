@@ -0,0 +1,66 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+)
+
+const (
+	// ConditionTypeReprofileBudgetExhausted is True once a retry or reprofile action was refused
+	// because spec.maxReprofiles was reached
+	ConditionTypeReprofileBudgetExhausted = "ReprofileBudgetExhausted"
+
+	// ReasonMaxReprofilesReached is the reason of the ReprofileBudgetExhausted condition
+	ReasonMaxReprofilesReached = "MaxReprofilesReached"
+
+	// MessageReprofileBudgetExhausted reports a refused action
+	MessageReprofileBudgetExhausted = "%s refused, profiling was already restarted %d times, the maxReprofiles budget"
+)
+
+// isReprofileBudgetExhausted reports whether the DGDR used up its budget of profiling restarts
+func isReprofileBudgetExhausted(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) bool {
+	return dgdr.Spec.MaxReprofiles != nil && dgdr.Status.Reprofiles >= *dgdr.Spec.MaxReprofiles
+}
+
+// recordReprofile counts a restart of profiling by an action. The status is persisted by the
+// caller's next status update.
+func recordReprofile(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) {
+	now := metav1.Now()
+	dgdr.Status.Reprofiles++
+	dgdr.Status.LastReprofileTime = &now
+}
+
+// setReprofileBudgetExhausted records that the action was refused for the reprofile budget and
+// returns the rejection message. The status is persisted by the caller's next status update.
+func setReprofileBudgetExhausted(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, action string) string {
+	message := fmt.Sprintf(MessageReprofileBudgetExhausted, action, dgdr.Status.Reprofiles)
+	meta.SetStatusCondition(&dgdr.Status.Conditions, metav1.Condition{
+		Type:               ConditionTypeReprofileBudgetExhausted,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: dgdr.Generation,
+		Reason:             ReasonMaxReprofilesReached,
+		Message:            message,
+	})
+	return message
+}
@@ -0,0 +1,107 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+)
+
+var _ = Describe("DGDR Reprofile Budget", func() {
+	It("Should only be exhausted with a budget", func() {
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{}
+		recordReprofile(dgdr)
+		Expect(dgdr.Status.Reprofiles).Should(Equal(int32(1)))
+		Expect(dgdr.Status.LastReprofileTime).NotTo(BeNil())
+		Expect(isReprofileBudgetExhausted(dgdr)).To(BeFalse())
+
+		dgdr.Spec.MaxReprofiles = ptr.To(int32(2))
+		Expect(isReprofileBudgetExhausted(dgdr)).To(BeFalse())
+		recordReprofile(dgdr)
+		Expect(isReprofileBudgetExhausted(dgdr)).To(BeTrue())
+	})
+
+	It("Should refuse a retry once maxReprofiles is reached", func() {
+		ctx := context.Background()
+		reconciler := &DynamoGraphDeploymentRequestReconciler{
+			Client:      k8sClient,
+			Recorder:    record.NewFakeRecorder(100),
+			RBACManager: &MockRBACManager{},
+		}
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "test-dgdr-reprofile-budget",
+				Namespace:   defaultNamespace,
+				Annotations: map[string]string{AnnotationAction: ActionRetry},
+			},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model:         "test-model",
+				Backend:       "vllm",
+				MaxReprofiles: ptr.To(int32(1)),
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					ProfilerImage: "test-profiler:latest",
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, dgdr) }()
+
+		get := func() *nvidiacomv1alpha1.DynamoGraphDeploymentRequest {
+			updated := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: dgdr.Name, Namespace: defaultNamespace}, updated)).Should(Succeed())
+			return updated
+		}
+		fail := func() {
+			updated := get()
+			updated.Status.State = StateFailed
+			updated.Status.FailureReason = nvidiacomv1alpha1.FailureReasonProfilerCrash
+			Expect(k8sClient.Status().Update(ctx, updated)).Should(Succeed())
+		}
+
+		// The first retry is within the budget and counted
+		fail()
+		Expect(reconciler.handleAction(ctx, get())).Should(BeTrue())
+		updated := get()
+		Expect(updated.Status.State).Should(Equal(StateEmpty))
+		Expect(updated.Status.Reprofiles).Should(Equal(int32(1)))
+		Expect(updated.Status.LastReprofileTime).NotTo(BeNil())
+
+		// The second one is refused and the DGDR stays failed
+		fail()
+		updated = get()
+		updated.Annotations = map[string]string{AnnotationAction: ActionRetry}
+		Expect(k8sClient.Update(ctx, updated)).Should(Succeed())
+		Expect(reconciler.handleAction(ctx, get())).Should(BeTrue())
+		updated = get()
+		Expect(updated.Status.State).Should(Equal(StateFailed))
+		Expect(updated.Status.Reprofiles).Should(Equal(int32(1)))
+		Expect(updated.Annotations).ShouldNot(HaveKey(AnnotationAction))
+		condition := meta.FindStatusCondition(updated.Status.Conditions, ConditionTypeReprofileBudgetExhausted)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).Should(Equal(metav1.ConditionTrue))
+		Expect(condition.Reason).Should(Equal(ReasonMaxReprofilesReached))
+	})
+})
@@ -0,0 +1,215 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/metadata"
+)
+
+// generatedResourceKinds are the core/v1 kinds the profiler may emit beside the generated deployment
+var generatedResourceKinds = map[string]bool{
+	"ConfigMap": true,
+	"Secret":    true,
+	"Service":   true,
+}
+
+// decodeGeneratedResources returns the objects beside the DynamoGraphDeployment in a generated
+// deployment, reduced to what is applied: their name, labels, annotations and content. The data
+// of Secrets is dropped, they are only placeholders for the user to fill in.
+func decodeGeneratedResources(source string, content []byte) ([]runtime.RawExtension, error) {
+	documents, err := splitOutputDocuments(detectOutputFormat(source, content), content)
+	if err != nil || len(documents) < 2 {
+		// A single document is the deployment, parse errors are reported when decoding it
+		return nil, nil
+	}
+
+	var resources []runtime.RawExtension
+	seen := map[string]bool{}
+	for _, document := range documents {
+		object := map[string]interface{}{}
+		if err := json.Unmarshal(document, &object); err != nil {
+			return nil, withFailureReason(nvidiacomv1alpha1.FailureReasonSpecParseError,
+				fmt.Errorf("failed to parse %s: %w", source, err))
+		}
+		parsed := &unstructured.Unstructured{Object: object}
+		kind, name := parsed.GetKind(), parsed.GetName()
+		if kind == "DynamoGraphDeployment" {
+			continue
+		}
+		if parsed.GetAPIVersion() != "v1" || !generatedResourceKinds[kind] {
+			return nil, withFailureReason(nvidiacomv1alpha1.FailureReasonSpecParseError,
+				fmt.Errorf("%s holds a %s %s %q, only v1 ConfigMaps, Secrets and Services may accompany the deployment",
+					source, parsed.GetAPIVersion(), kind, name))
+		}
+		if name == "" {
+			return nil, withFailureReason(nvidiacomv1alpha1.FailureReasonSpecParseError,
+				fmt.Errorf("%s holds a %s without a name", source, kind))
+		}
+		if seen[kind+"/"+name] {
+			return nil, withFailureReason(nvidiacomv1alpha1.FailureReasonSpecParseError,
+				fmt.Errorf("%s holds %s %q more than once", source, kind, name))
+		}
+		seen[kind+"/"+name] = true
+
+		resource := &unstructured.Unstructured{Object: map[string]interface{}{}}
+		for key, value := range object {
+			switch key {
+			case "metadata", "status":
+			case "data", "stringData":
+				if kind != "Secret" {
+					resource.Object[key] = value
+				}
+			default:
+				resource.Object[key] = value
+			}
+		}
+		resource.SetName(name)
+		resource.SetLabels(parsed.GetLabels())
+		resource.SetAnnotations(parsed.GetAnnotations())
+
+		raw, err := resource.MarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, runtime.RawExtension{Raw: raw})
+	}
+	return resources, nil
+}
+
+// generatedResourceObjects returns the generated resources of the DGDR in the given namespace
+func generatedResourceObjects(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, namespace string) ([]*unstructured.Unstructured, error) {
+	objects := make([]*unstructured.Unstructured, 0, len(dgdr.Status.GeneratedResources))
+	for i, raw := range dgdr.Status.GeneratedResources {
+		object := &unstructured.Unstructured{}
+		if err := object.UnmarshalJSON(raw.Raw); err != nil {
+			return nil, fmt.Errorf("failed to decode generated resource %d: %w", i, err)
+		}
+		object.SetNamespace(namespace)
+		objects = append(objects, object)
+	}
+	return objects, nil
+}
+
+// applyGeneratedResources creates or updates the generated resources in the deployment namespace.
+// It runs before the DynamoGraphDeployment is applied so that its pods never start without their
+// configuration. Objects the DGDR did not create are never overwritten, and existing Secrets are
+// left alone since their data is filled in by the user.
+func (r *DynamoGraphDeploymentRequestReconciler) applyGeneratedResources(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, namespace string) error {
+	logger := log.FromContext(ctx)
+
+	desired, err := generatedResourceObjects(dgdr, namespace)
+	if err != nil {
+		return err
+	}
+	for _, resource := range desired {
+		labels := resource.GetLabels()
+		if labels == nil {
+			labels = map[string]string{}
+		}
+		labels[LabelDGDRName] = dgdr.Name
+		labels[LabelDGDRNamespace] = dgdr.Namespace
+		labels[LabelManagedBy] = LabelValueDynamoOperator
+		resource.SetLabels(labels)
+
+		live := &unstructured.Unstructured{}
+		live.SetGroupVersionKind(resource.GroupVersionKind())
+		err := r.Get(ctx, types.NamespacedName{Name: resource.GetName(), Namespace: namespace}, live)
+		if apierrors.IsNotFound(err) {
+			metadata.Apply(resource, metadata.Of(resource))
+			if err := r.Create(ctx, resource); err != nil {
+				return fmt.Errorf("failed to create generated %s %s: %w", resource.GetKind(), resource.GetName(), err)
+			}
+			logger.Info("Created generated resource", "kind", resource.GetKind(), "name", resource.GetName(), "namespace", namespace)
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get generated %s %s: %w", resource.GetKind(), resource.GetName(), err)
+		}
+
+		if live.GetLabels()[LabelDGDRName] != dgdr.Name || live.GetLabels()[LabelDGDRNamespace] != dgdr.Namespace {
+			return fmt.Errorf("generated %s %s/%s already exists and is not managed by this DGDR",
+				resource.GetKind(), namespace, resource.GetName())
+		}
+		if resource.GetKind() == "Secret" {
+			continue
+		}
+
+		patch := client.MergeFrom(live.DeepCopy())
+		metadata.Apply(live, metadata.Of(resource))
+		for key, value := range resource.Object {
+			switch key {
+			case "apiVersion", "kind", "metadata":
+			case "spec":
+				// Keep the fields the API server allocates, such as the cluster IP of a Service
+				spec, _, _ := unstructured.NestedMap(live.Object, "spec")
+				if spec == nil {
+					spec = map[string]interface{}{}
+				}
+				desiredSpec, _, _ := unstructured.NestedMap(resource.Object, "spec")
+				maps.Copy(spec, desiredSpec)
+				live.Object["spec"] = spec
+			default:
+				live.Object[key] = value
+			}
+		}
+		if err := r.Patch(ctx, live, patch); err != nil {
+			return fmt.Errorf("failed to update generated %s %s: %w", resource.GetKind(), resource.GetName(), err)
+		}
+		logger.Info("Updated generated resource", "kind", resource.GetKind(), "name", resource.GetName(), "namespace", namespace)
+	}
+	return nil
+}
+
+// ownGeneratedResources makes the applied DynamoGraphDeployment the owner of the generated resources,
+// so that they are garbage collected with the deployment that uses them rather than with the DGDR
+func (r *DynamoGraphDeploymentRequestReconciler) ownGeneratedResources(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, dgd *nvidiacomv1alpha1.DynamoGraphDeployment) error {
+	resources, err := generatedResourceObjects(dgdr, dgd.Namespace)
+	if err != nil {
+		return err
+	}
+	for _, resource := range resources {
+		live := &unstructured.Unstructured{}
+		live.SetGroupVersionKind(resource.GroupVersionKind())
+		if err := r.Get(ctx, client.ObjectKeyFromObject(resource), live); err != nil {
+			return fmt.Errorf("failed to get generated %s %s: %w", resource.GetKind(), resource.GetName(), err)
+		}
+		patch := client.MergeFrom(live.DeepCopy())
+		if err := controllerutil.SetOwnerReference(dgd, live, r.Client.Scheme()); err != nil {
+			return err
+		}
+		if err := r.Patch(ctx, live, patch); err != nil {
+			return fmt.Errorf("failed to set the owner of generated %s %s: %w", resource.GetKind(), resource.GetName(), err)
+		}
+		r.trackOwnedResource(ctx, dgdr, nvidiacomv1alpha1.OwnedResourceRoleGenerated, live)
+	}
+	return nil
+}
@@ -0,0 +1,178 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+)
+
+var _ = Describe("DGDR Generated Resources", func() {
+	const output = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-dgdr-engine-config
+  namespace: elsewhere
+  uid: 1234
+data:
+  engine.yaml: "max_batch_size: 64"
+---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: test-dgdr-hf-token
+stringData:
+  HF_TOKEN: replace-me
+---
+apiVersion: nvidia.com/v1alpha1
+kind: DynamoGraphDeployment
+metadata:
+  name: test-dgdr-resources
+spec:
+  services:
+    Frontend:
+      replicas: 1
+`
+
+	var reconciler *DynamoGraphDeploymentRequestReconciler
+
+	BeforeEach(func() {
+		reconciler = &DynamoGraphDeploymentRequestReconciler{
+			Client:      k8sClient,
+			Recorder:    record.NewFakeRecorder(100),
+			RBACManager: &MockRBACManager{},
+		}
+	})
+
+	It("Should keep the objects beside the deployment without Secret data", func() {
+		resources, err := decodeGeneratedResources(ProfilingOutputFile, []byte(output))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resources).Should(HaveLen(2))
+
+		objects, err := generatedResourceObjects(&nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			Status: nvidiacomv1alpha1.DynamoGraphDeploymentRequestStatus{GeneratedResources: resources},
+		}, defaultNamespace)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(objects[0].GetName()).Should(Equal("test-dgdr-engine-config"))
+		Expect(objects[0].GetNamespace()).Should(Equal(defaultNamespace))
+		Expect(string(objects[0].GetUID())).Should(BeEmpty())
+		Expect(objects[0].Object).Should(HaveKey("data"))
+		Expect(objects[1].GetKind()).Should(Equal("Secret"))
+		Expect(objects[1].Object).ShouldNot(HaveKey("stringData"))
+
+		_, err = decodeGeneratedResources(ProfilingOutputFile, []byte("apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: x\n---\n"+output))
+		Expect(err).To(MatchError(ContainSubstring("only v1 ConfigMaps, Secrets and Services")))
+		Expect(failureReasonFromError(err, "")).Should(Equal(nvidiacomv1alpha1.FailureReasonSpecParseError))
+
+		_, err = decodeGeneratedResources(ProfilingOutputFile, []byte("apiVersion: v1\nkind: Service\n---\n"+output))
+		Expect(err).To(MatchError(ContainSubstring("without a name")))
+
+		resources, err = decodeGeneratedResources(ProfilingOutputFile, []byte("kind: DynamoGraphDeployment\nmetadata:\n  name: x\n"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resources).Should(BeEmpty())
+	})
+
+	It("Should create the resources before the deployment and let the deployment own them", func() {
+		ctx := context.Background()
+		dgd, err := decodeGeneratedDeployment(&nvidiacomv1alpha1.DynamoGraphDeploymentRequest{}, ProfilingOutputFile, []byte(output))
+		Expect(err).NotTo(HaveOccurred())
+		raw, err := json.Marshal(dgd)
+		Expect(err).NotTo(HaveOccurred())
+		resources, err := decodeGeneratedResources(ProfilingOutputFile, []byte(output))
+		Expect(err).NotTo(HaveOccurred())
+
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-dgdr-resources", Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model:   "test-model",
+				Backend: "vllm",
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					ProfilerImage: "test-profiler:latest",
+				},
+				AutoApply: true,
+			},
+		}
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		DeferCleanup(func() { _ = k8sClient.Delete(ctx, dgdr) })
+		dgdr.Status.State = StateDeploying
+		dgdr.Status.GeneratedDeployment = &runtime.RawExtension{Raw: raw}
+		dgdr.Status.GeneratedResources = resources
+		Expect(k8sClient.Status().Update(ctx, dgdr)).Should(Succeed())
+
+		// A Secret filled in by the user is never overwritten
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-dgdr-hf-token", Namespace: defaultNamespace, Labels: map[string]string{
+				LabelDGDRName:      dgdr.Name,
+				LabelDGDRNamespace: dgdr.Namespace,
+			}},
+			StringData: map[string]string{"HF_TOKEN": "hf_user"},
+		}
+		Expect(k8sClient.Create(ctx, secret)).Should(Succeed())
+		DeferCleanup(func() { _ = k8sClient.Delete(ctx, secret) })
+
+		_, err = reconciler.createDGD(ctx, dgdr)
+		Expect(err).NotTo(HaveOccurred())
+		live := &nvidiacomv1alpha1.DynamoGraphDeployment{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: dgd.Name, Namespace: defaultNamespace}, live)).Should(Succeed())
+		DeferCleanup(func() { _ = k8sClient.Delete(ctx, live) })
+
+		cm := &corev1.ConfigMap{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: "test-dgdr-engine-config", Namespace: defaultNamespace}, cm)).Should(Succeed())
+		DeferCleanup(func() { _ = k8sClient.Delete(ctx, cm) })
+		Expect(cm.Data).Should(HaveKeyWithValue("engine.yaml", "max_batch_size: 64"))
+		Expect(cm.Labels).Should(HaveKeyWithValue(LabelDGDRName, dgdr.Name))
+		Expect(cm.OwnerReferences).Should(HaveLen(1))
+		Expect(cm.OwnerReferences[0].UID).Should(Equal(live.UID))
+
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: secret.Name, Namespace: defaultNamespace}, secret)).Should(Succeed())
+		Expect(string(secret.Data["HF_TOKEN"])).Should(Equal("hf_user"))
+		Expect(secret.OwnerReferences).Should(HaveLen(1))
+
+		// Re-profiled configuration replaces the previous one
+		cm.Data["engine.yaml"] = "max_batch_size: 1"
+		Expect(k8sClient.Update(ctx, cm)).Should(Succeed())
+		_, err = reconciler.createDGD(ctx, dgdr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: cm.Name, Namespace: defaultNamespace}, cm)).Should(Succeed())
+		Expect(cm.Data).Should(HaveKeyWithValue("engine.yaml", "max_batch_size: 64"))
+	})
+
+	It("Should not take over objects of other owners", func() {
+		ctx := context.Background()
+		resources, err := decodeGeneratedResources(ProfilingOutputFile, []byte(output))
+		Expect(err).NotTo(HaveOccurred())
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{ObjectMeta: metav1.ObjectMeta{Name: "test-dgdr-takeover", Namespace: defaultNamespace}}
+		dgdr.Status.GeneratedResources = resources
+
+		cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "test-dgdr-engine-config", Namespace: defaultNamespace}}
+		Expect(k8sClient.Create(ctx, cm)).Should(Succeed())
+		DeferCleanup(func() { _ = k8sClient.Delete(ctx, cm) })
+
+		err = reconciler.applyGeneratedResources(ctx, dgdr, defaultNamespace)
+		Expect(err).To(MatchError(ContainSubstring("not managed by this DGDR")))
+	})
+})
@@ -0,0 +1,180 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+)
+
+const (
+	// AnnotationResultsEncoding is set by the output copier sidecar on the output ConfigMap or Secret
+	// to the encoding of the result files, Plain or Gzip
+	AnnotationResultsEncoding = "nvidia.com/dgdr-results-encoding"
+
+	// ResultsEncodedDir is where the output copier sidecar writes the chunks of compressed results
+	ResultsEncodedDir = "/tmp/results-encoded"
+
+	// ResultsChunkSuffix precedes the index of a chunk in the keys of compressed result files
+	ResultsChunkSuffix = ".gz."
+
+	// ResultsChunkSize is the size of the base64 encoded chunks compressed result files are split into
+	ResultsChunkSize = 256 * 1024
+
+	// ResultsCompressionThreshold is the size above which results are compressed with resultEncoding
+	// Auto. It stays well below the 1MiB limit of Kubernetes objects since kubectl apply also keeps
+	// a copy of the applied results in the last-applied-configuration annotation.
+	ResultsCompressionThreshold = 128 * 1024
+
+	// ResultsMaxDecodedBytes limits the total size compressed results decompress to, so results
+	// that expand far beyond what profiling produces are rejected instead of exhausting memory
+	ResultsMaxDecodedBytes = 64 << 20
+)
+
+// resultChunkKey matches the keys of compressed result chunks, "<file>.gz.<index>"
+var resultChunkKey = regexp.MustCompile(`^(.+)` + regexp.QuoteMeta(ResultsChunkSuffix) + `(\d+)$`)
+
+// getResultEncoding returns the requested result encoding, defaulting to Auto
+func getResultEncoding(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) nvidiacomv1alpha1.ResultEncoding {
+	if dgdr.Spec.ProfilingConfig.ResultEncoding == "" {
+		return nvidiacomv1alpha1.ResultEncodingAuto
+	}
+	return dgdr.Spec.ProfilingConfig.ResultEncoding
+}
+
+// resultEncodingScript returns the commands that compress the result files staged in
+// ResultsStagingDir into chunks in ResultsEncodedDir when the result encoding asks for it. They
+// set ENCODING to the encoding used and RESULTS_DIR to the directory to deliver.
+func resultEncodingScript(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) string {
+	return fmt.Sprintf(`ENCODING=%[1]s
+RESULTS_DIR=%[2]s
+SIZE=$(cat %[2]s/* | wc -c)
+if [ "%[3]s" = "%[4]s" ] || { [ "%[3]s" = "%[5]s" ] && [ "$SIZE" -gt %[6]d ]; }; then
+  ENCODING=%[4]s
+  RESULTS_DIR=%[7]s
+  rm -rf %[7]s
+  mkdir -p %[7]s
+  for f in %[2]s/*; do
+    gzip -9 -c "$f" | base64 -w0 | split -b %[8]d -d -a 3 - %[7]s/$(basename "$f")%[9]s
+  done
+  echo "Compressed $SIZE bytes of profiling output into $(cat %[7]s/* | wc -c) bytes"
+fi`,
+		nvidiacomv1alpha1.ResultEncodingPlain, ResultsStagingDir,
+		getResultEncoding(dgdr), nvidiacomv1alpha1.ResultEncodingGzip, nvidiacomv1alpha1.ResultEncodingAuto,
+		ResultsCompressionThreshold, ResultsEncodedDir, ResultsChunkSize, ResultsChunkSuffix)
+}
+
+// decodeResults reassembles and decompresses the chunks of results delivered with the Gzip
+// encoding. Keys that are not chunks are returned as they are. Results decompressing to more than
+// ResultsMaxDecodedBytes fail to parse.
+func decodeResults(data map[string]string, encoding string) (map[string]string, error) {
+	if encoding != string(nvidiacomv1alpha1.ResultEncodingGzip) {
+		return data, nil
+	}
+	type chunk struct {
+		index int
+		data  string
+	}
+	chunks := map[string][]chunk{}
+	decoded := make(map[string]string, len(data))
+	for key, value := range data {
+		match := resultChunkKey.FindStringSubmatch(key)
+		if match == nil {
+			decoded[key] = value
+			continue
+		}
+		index, err := strconv.Atoi(match[2])
+		if err != nil {
+			return nil, fmt.Errorf("invalid chunk index of results key %s: %w", key, err)
+		}
+		chunks[match[1]] = append(chunks[match[1]], chunk{index: index, data: value})
+	}
+
+	remaining := int64(ResultsMaxDecodedBytes)
+	for file, parts := range chunks {
+		slices.SortFunc(parts, func(a, b chunk) int { return a.index - b.index })
+		var encoded strings.Builder
+		for i, part := range parts {
+			if part.index != i {
+				return nil, fmt.Errorf("chunk %d of compressed result %s is missing", i, file)
+			}
+			encoded.WriteString(part.data)
+		}
+		compressed, err := base64.StdEncoding.DecodeString(encoded.String())
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode compressed result %s: %w", file, err)
+		}
+		reader, err := gzip.NewReader(bytes.NewReader(compressed))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decompress result %s: %w", file, err)
+		}
+		content, err := io.ReadAll(io.LimitReader(reader, remaining+1))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decompress result %s: %w", file, err)
+		}
+		if int64(len(content)) > remaining {
+			return nil, withFailureReason(nvidiacomv1alpha1.FailureReasonSpecParseError,
+				fmt.Errorf("decompressed results exceed %d bytes at result %s", ResultsMaxDecodedBytes, file))
+		}
+		remaining -= int64(len(content))
+		decoded[file] = string(content)
+	}
+	return decoded, nil
+}
+
+// ResultsEncodingReporter is implemented by result transports that can store the results compressed
+type ResultsEncodingReporter interface {
+	// Encoding returns how the delivered results are encoded, or "" if they have not been delivered
+	Encoding(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) (nvidiacomv1alpha1.ResultEncoding, error)
+}
+
+// resultsEncoding returns the encoding of the delivered results, or "" if the transport does not
+// encode them. It is only reported, so errors are logged.
+func resultsEncoding(ctx context.Context, transport ResultTransport, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) nvidiacomv1alpha1.ResultEncoding {
+	reporter, ok := transport.(ResultsEncodingReporter)
+	if !ok {
+		return ""
+	}
+	encoding, err := reporter.Encoding(ctx, dgdr)
+	if err != nil {
+		log.FromContext(ctx).Error(err, "Failed to read the profiling results encoding", "results", transport.Reference(dgdr))
+		return ""
+	}
+	return encoding
+}
+
+// annotatedResultsEncoding returns the encoding annotated on the output ConfigMap or Secret.
+// Results delivered before encodings were annotated, or stored by the operator, are plain.
+func annotatedResultsEncoding(annotations map[string]string) nvidiacomv1alpha1.ResultEncoding {
+	if encoding := annotations[AnnotationResultsEncoding]; encoding != "" {
+		return nvidiacomv1alpha1.ResultEncoding(encoding)
+	}
+	return nvidiacomv1alpha1.ResultEncodingPlain
+}
@@ -0,0 +1,122 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+var _ = Describe("DGDR Result Encoding", func() {
+	ctx := context.Background()
+	var reconciler *DynamoGraphDeploymentRequestReconciler
+
+	BeforeEach(func() {
+		reconciler = &DynamoGraphDeploymentRequestReconciler{
+			Client:      k8sClient,
+			Recorder:    record.NewFakeRecorder(100),
+			RBACManager: &MockRBACManager{},
+		}
+	})
+
+	// chunks compresses content like the output copier sidecar, split into chunks of size bytes
+	chunks := func(file, content string, size int) map[string]string {
+		var compressed bytes.Buffer
+		writer := gzip.NewWriter(&compressed)
+		_, err := writer.Write([]byte(content))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(writer.Close()).Should(Succeed())
+		encoded := base64.StdEncoding.EncodeToString(compressed.Bytes())
+
+		data := map[string]string{}
+		for i := 0; i*size < len(encoded); i++ {
+			data[fmt.Sprintf("%s%s%03d", file, ResultsChunkSuffix, i)] = encoded[i*size : min((i+1)*size, len(encoded))]
+		}
+		return data
+	}
+
+	It("Should compress the results in the sidecar as requested", func() {
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-dgdr-encoding", Namespace: defaultNamespace},
+		}
+		Expect(getResultEncoding(dgdr)).Should(Equal(nvidiacomv1alpha1.ResultEncodingAuto))
+
+		script := reconciler.resultTransport(dgdr).UploadScript(dgdr)
+		Expect(script).Should(ContainSubstring(`[ "Auto" = "Auto" ] && [ "$SIZE" -gt 131072 ]`))
+		Expect(script).Should(ContainSubstring("split -b 262144 -d -a 3 - " + ResultsEncodedDir))
+		Expect(script).Should(ContainSubstring("--from-file=${RESULTS_DIR}"))
+		Expect(script).Should(ContainSubstring(AnnotationResultsEncoding + "=${ENCODING}"))
+
+		dgdr.Spec.ProfilingConfig.ResultEncoding = nvidiacomv1alpha1.ResultEncodingPlain
+		Expect(reconciler.resultTransport(dgdr).UploadScript(dgdr)).Should(ContainSubstring(`[ "Plain" = "Gzip" ]`))
+	})
+
+	It("Should reassemble compressed results when fetching them", func() {
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-dgdr-encoding-fetch", Namespace: defaultNamespace},
+		}
+		transport := reconciler.resultTransport(dgdr)
+		output := "kind: DynamoGraphDeployment\n" + strings.Repeat("# padding\n", 2000)
+		data := chunks(ProfilingOutputFile, output, 64)
+		Expect(len(data)).Should(BeNumerically(">", 1))
+		data[RawManifestsKey] = "kind: Deployment\n"
+
+		cm := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        GetOutputConfigMapName(dgdr),
+				Namespace:   defaultNamespace,
+				Annotations: map[string]string{AnnotationResultsEncoding: string(nvidiacomv1alpha1.ResultEncodingGzip)},
+			},
+			Data: data,
+		}
+		Expect(k8sClient.Create(ctx, cm)).Should(Succeed())
+		DeferCleanup(func() { _ = k8sClient.Delete(ctx, cm) })
+
+		Eventually(func() (map[string]string, error) { return transport.Fetch(ctx, dgdr) }).Should(Equal(map[string]string{
+			ProfilingOutputFile: output,
+			RawManifestsKey:     "kind: Deployment\n",
+		}))
+		Expect(resultsEncoding(ctx, transport, dgdr)).Should(Equal(nvidiacomv1alpha1.ResultEncodingGzip))
+
+		// A missing chunk fails instead of yielding a truncated spec
+		delete(data, ProfilingOutputFile+ResultsChunkSuffix+"001")
+		_, err := decodeResults(data, string(nvidiacomv1alpha1.ResultEncodingGzip))
+		Expect(err).To(MatchError(ContainSubstring("chunk 1 of compressed result " + ProfilingOutputFile + " is missing")))
+
+		// Results decompressing beyond the limit fail to parse instead of being read whole
+		_, err = decodeResults(chunks(ProfilingOutputFile, strings.Repeat("\n", ResultsMaxDecodedBytes+1), ResultsChunkSize),
+			string(nvidiacomv1alpha1.ResultEncodingGzip))
+		Expect(err).To(MatchError(ContainSubstring(fmt.Sprintf("decompressed results exceed %d bytes", ResultsMaxDecodedBytes))))
+		Expect(failureReasonFromError(err, "")).Should(Equal(nvidiacomv1alpha1.FailureReasonSpecParseError))
+
+		// Results without the annotation are plain
+		Expect(decodeResults(data, "")).Should(Equal(data))
+		Expect(annotatedResultsEncoding(nil)).Should(Equal(nvidiacomv1alpha1.ResultEncodingPlain))
+	})
+})
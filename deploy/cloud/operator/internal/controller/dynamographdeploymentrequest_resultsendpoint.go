@@ -0,0 +1,566 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"maps"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	certutil "k8s.io/client-go/util/cert"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/yaml"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+)
+
+const (
+	// ResultsTokenAudience is the audience of the ServiceAccount tokens profiling jobs authenticate with
+	ResultsTokenAudience = "dynamo-operator-results"
+
+	// Projected ServiceAccount token of the output copier sidecar for the HTTP result transport,
+	// along with the CA of the results endpoint from the results CA ConfigMap
+	VolumeNameResultsToken     = "results-token"
+	ResultsTokenPath           = "/var/run/secrets/dynamo/results"
+	ResultsTokenFile           = "token"
+	ResultsTokenExpirationSecs = int64(3600)
+	ResultsCAFile              = "ca.crt"
+
+	// ResultsCertSecretName is the Secret in the operator namespace that the self-signed certificate
+	// of the results endpoint is shared between replicas through
+	ResultsCertSecretName = "dgdr-results-tls"
+
+	// ConfigMapResultsCAPrefix is the name prefix of the ConfigMap holding the CA of the results
+	// endpoint, which is mounted into profiling job pods
+	ConfigMapResultsCAPrefix = "dgdr-results-ca-"
+
+	// Extra fields of the user of a pod-bound ServiceAccount token naming the pod it is bound to
+	tokenExtraPodName = "authentication.kubernetes.io/pod-name"
+	tokenExtraPodUID  = "authentication.kubernetes.io/pod-uid"
+
+	// DefaultResultsMaxBytes is the default size limit of the results posted to the results endpoint
+	DefaultResultsMaxBytes = int64(8 << 20)
+
+	// Event and condition reasons
+	EventReasonResultsReceived = "ResultsReceived"
+
+	// Messages
+	MessageResultsReceived = "Profiling results received from the profiling job"
+)
+
+// ResultsServer is the HTTPS endpoint profiling jobs post their results to with profilingConfig.resultTransport HTTP.
+// Requests authenticate with a ServiceAccount token of the profiling job for ResultsTokenAudience,
+// verified with a TokenReview and bound to a pod of the profiling Job of the DGDR. Accepted results are stored in the output Secret, and the Profiling
+// condition records their arrival, which completes profiling. Sweep checkpoints are stored in the
+// output Secret too, and served back to retried profiling job pods.
+type ResultsServer struct {
+	Client   client.Client
+	Recorder record.EventRecorder
+
+	// BindAddress is the address the endpoint listens on, e.g. ":8444"
+	BindAddress string
+
+	// TLSConfig holds the serving certificate
+	TLSConfig *tls.Config
+
+	// MaxBytes limits the size of a request, DefaultResultsMaxBytes if zero
+	MaxBytes int64
+}
+
+// LoadResultsServingCertificate returns the serving certificate of the results endpoint and the CA
+// profiling jobs verify it with. It reads tls.crt, tls.key and the optional ca.crt from certDir, or
+// shares a self-signed certificate for the host of endpoint between the replicas through the
+// ResultsCertSecretName Secret in namespace if certDir is empty.
+func LoadResultsServingCertificate(ctx context.Context, c client.Client, certDir, endpoint, namespace string) (tls.Certificate, []byte, error) {
+	var cert tls.Certificate
+	var ca []byte
+	var err error
+	if certDir != "" {
+		cert, ca, err = loadServingCertificate(certDir, "")
+	} else {
+		var endpointURL *url.URL
+		if endpointURL, err = url.Parse(endpoint); err != nil {
+			return tls.Certificate{}, nil, fmt.Errorf("invalid results endpoint %q: %w", endpoint, err)
+		}
+		if namespace == "" {
+			return tls.Certificate{}, nil, errors.New("the operator namespace is required to share the self-signed certificate between replicas")
+		}
+		cert, ca, err = loadSharedSelfSignedCertificate(ctx, c, types.NamespacedName{Namespace: namespace, Name: ResultsCertSecretName}, endpointURL.Hostname())
+	}
+	if err != nil {
+		return tls.Certificate{}, nil, fmt.Errorf("failed to load results endpoint certificate: %w", err)
+	}
+	return cert, ca, nil
+}
+
+// loadSharedSelfSignedCertificate returns the self-signed certificate for host kept in the Secret
+// key, generating it if the Secret does not exist or holds a certificate for another host. The
+// first replica to create the Secret wins, the others load its certificate.
+func loadSharedSelfSignedCertificate(ctx context.Context, c client.Client, key types.NamespacedName, host string) (tls.Certificate, []byte, error) {
+	secret := &corev1.Secret{}
+	err := c.Get(ctx, key, secret)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return tls.Certificate{}, nil, fmt.Errorf("failed to get Secret %s: %w", key, err)
+	}
+	if err == nil {
+		cert, err := tls.X509KeyPair(secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey])
+		if err == nil && cert.Leaf.VerifyHostname(host) == nil {
+			return cert, secret.Data[corev1.TLSCertKey], nil
+		}
+	}
+
+	certPEM, keyPEM, err := certutil.GenerateSelfSignedCertKey(host, nil, nil)
+	if err != nil {
+		return tls.Certificate{}, nil, err
+	}
+	data := map[string][]byte{corev1.TLSCertKey: certPEM, corev1.TLSPrivateKeyKey: keyPEM}
+	if secret.ResourceVersion == "" {
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      key.Name,
+				Namespace: key.Namespace,
+				Labels:    map[string]string{LabelManagedBy: LabelValueDynamoOperator},
+			},
+			Type: corev1.SecretTypeTLS,
+			Data: data,
+		}
+		err = c.Create(ctx, secret)
+	} else {
+		secret.Data = data
+		err = c.Update(ctx, secret)
+	}
+	if apierrors.IsAlreadyExists(err) || apierrors.IsConflict(err) {
+		// Another replica stored its certificate first
+		return loadSharedSelfSignedCertificate(ctx, c, key, host)
+	}
+	if err != nil {
+		return tls.Certificate{}, nil, fmt.Errorf("failed to store the certificate in Secret %s: %w", key, err)
+	}
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	return cert, certPEM, err
+}
+
+// loadServingCertificate reads tls.crt, tls.key and the optional ca.crt from certDir, or generates
+// a self-signed certificate for host if certDir is empty. It returns the certificate and its CA.
+func loadServingCertificate(certDir, host string) (tls.Certificate, []byte, error) {
+	if certDir == "" {
+		certPEM, keyPEM, err := certutil.GenerateSelfSignedCertKey(host, nil, nil)
+		if err != nil {
+			return tls.Certificate{}, nil, err
+		}
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		return cert, certPEM, err
+	}
+
+	cert, err := tls.LoadX509KeyPair(filepath.Join(certDir, "tls.crt"), filepath.Join(certDir, "tls.key"))
+	if err != nil {
+		return tls.Certificate{}, nil, err
+	}
+	ca, err := os.ReadFile(filepath.Join(certDir, "ca.crt"))
+	if errors.Is(err, fs.ErrNotExist) {
+		ca, err = os.ReadFile(filepath.Join(certDir, "tls.crt"))
+	}
+	if err != nil {
+		return tls.Certificate{}, nil, fmt.Errorf("failed to load CA: %w", err)
+	}
+	return cert, ca, nil
+}
+
+// getResultsCAConfigMapName returns the name of the DGDR's results CA ConfigMap
+func getResultsCAConfigMapName(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) string {
+	return ConfigMapResultsCAPrefix + dgdr.Name
+}
+
+// ensureResultsCAConfigMap writes the CA of the results endpoint to the DGDR's results CA ConfigMap,
+// which profiling job pods verify the endpoint with
+func (r *DynamoGraphDeploymentRequestReconciler) ensureResultsCAConfigMap(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	if len(r.ResultsCA) == 0 {
+		return errors.New("the CA of the results endpoint is not configured")
+	}
+	cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: getResultsCAConfigMapName(dgdr), Namespace: dgdr.Namespace}}
+	_, err := controllerutil.CreateOrUpdate(ctx, r.Client, cm, func() error {
+		cm.Labels = resultLabels(dgdr)
+		cm.Data = map[string]string{ResultsCAFile: string(r.ResultsCA)}
+		return controllerutil.SetControllerReference(dgdr, cm, r.Client.Scheme())
+	})
+	if err != nil {
+		return fmt.Errorf("failed to write results CA ConfigMap: %w", err)
+	}
+	return nil
+}
+
+// NeedLeaderElection lets every replica serve results, status updates are safe from any replica
+func (s *ResultsServer) NeedLeaderElection() bool {
+	return false
+}
+
+// Start serves the endpoint until ctx is cancelled
+func (s *ResultsServer) Start(ctx context.Context) error {
+	mux := http.NewServeMux()
+	mux.Handle(ResultsEndpointPath+"/", s)
+	server := &http.Server{
+		Addr:              s.BindAddress,
+		Handler:           mux,
+		TLSConfig:         s.TLSConfig,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+	}()
+
+	log.FromContext(ctx).Info("Serving profiling results endpoint", "address", s.BindAddress)
+	if err := server.ListenAndServeTLS("", ""); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// resultsRequestError is an error with the HTTP status to answer the request with
+type resultsRequestError struct {
+	status int
+	err    error
+}
+
+func (e *resultsRequestError) Error() string { return e.err.Error() }
+
+func requestError(status int, format string, args ...any) error {
+	return &resultsRequestError{status: status, err: fmt.Errorf(format, args...)}
+}
+
+// ServeHTTP handles POST /results/<namespace>/<name> with the result files as multipart form files,
+// and GET of the same path, which returns the last posted sweep checkpoint
+func (s *ResultsServer) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	logger := log.FromContext(req.Context())
+
+	key, checkpoint, err := s.handle(req)
+	if err != nil {
+		status := http.StatusInternalServerError
+		var requestErr *resultsRequestError
+		if errors.As(err, &requestErr) {
+			status = requestErr.status
+		}
+		logger.Info("Rejected profiling results request", "dgdr", key, "method", req.Method, "status", status, "reason", err.Error())
+		http.Error(w, err.Error(), status)
+		return
+	}
+
+	if req.Method == http.MethodGet {
+		logger.Info("Served sweep checkpoint", "dgdr", key)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, checkpoint)
+		return
+	}
+	logger.Info("Accepted profiling results", "dgdr", key)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *ResultsServer) handle(req *http.Request) (types.NamespacedName, string, error) {
+	ctx := req.Context()
+
+	if req.Method != http.MethodPost && req.Method != http.MethodGet {
+		return types.NamespacedName{}, "", requestError(http.StatusMethodNotAllowed, "method %s not allowed", req.Method)
+	}
+	parts := strings.Split(strings.TrimPrefix(req.URL.Path, ResultsEndpointPath+"/"), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return types.NamespacedName{}, "", requestError(http.StatusNotFound, "expected %s/<namespace>/<name>", ResultsEndpointPath)
+	}
+	key := types.NamespacedName{Namespace: parts[0], Name: parts[1]}
+
+	if err := s.authenticate(ctx, req, key); err != nil {
+		return key, "", err
+	}
+
+	if req.Method == http.MethodGet {
+		content, err := s.getDeliveredFile(ctx, key, req.URL.Query().Get(ResultsFileParam))
+		return key, content, err
+	}
+
+	results, err := s.readResults(req)
+	if err != nil {
+		return key, "", err
+	}
+
+	dgdr, err := s.getProfilingDGDR(ctx, key)
+	if err != nil {
+		return key, "", err
+	}
+	if dgdr.Status.State != StateProfiling {
+		return key, "", requestError(http.StatusConflict, "DynamoGraphDeploymentRequest %s is not profiling (state %q)", key, dgdr.Status.State)
+	}
+
+	// A sweep checkpoint posted alone is kept without completing profiling
+	if _, isCheckpoint := results[ProfilingCheckpointFile]; isCheckpoint && len(results) == 1 {
+		return key, "", s.storeResults(ctx, dgdr, results, false)
+	}
+
+	if err := validateProfilingOutput(dgdr, results); err != nil {
+		return key, "", requestError(http.StatusBadRequest, "invalid profiling results: %v", err)
+	}
+	if err := s.storeResults(ctx, dgdr, results, true); err != nil {
+		return key, "", err
+	}
+
+	return key, "", retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		dgdr, err := s.getProfilingDGDR(ctx, key)
+		if err != nil {
+			return err
+		}
+		meta.SetStatusCondition(&dgdr.Status.Conditions, metav1.Condition{
+			Type:               ConditionTypeProfiling,
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: dgdr.Generation,
+			Reason:             EventReasonResultsReceived,
+			Message:            MessageResultsReceived,
+		})
+		if err := s.Client.Status().Update(ctx, dgdr); err != nil {
+			return err
+		}
+		s.Recorder.Event(dgdr, corev1.EventTypeNormal, EventReasonResultsReceived, MessageResultsReceived)
+		return nil
+	})
+}
+
+// storeResults writes posted files to the output Secret of the DGDR, owned by the DGDR. Complete
+// results replace the files delivered before, a checkpoint is added to them.
+func (s *ResultsServer) storeResults(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, results map[string]string, replace bool) error {
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: GetOutputConfigMapName(dgdr), Namespace: dgdr.Namespace}}
+	_, err := controllerutil.CreateOrUpdate(ctx, s.Client, secret, func() error {
+		if secret.Labels == nil {
+			secret.Labels = map[string]string{}
+		}
+		maps.Copy(secret.Labels, resultLabels(dgdr))
+		if replace || secret.Data == nil {
+			secret.Data = map[string][]byte{}
+		}
+		for name, content := range results {
+			secret.Data[name] = []byte(content)
+		}
+		return controllerutil.SetControllerReference(dgdr, secret, s.Client.Scheme())
+	})
+	if err != nil {
+		return fmt.Errorf("failed to store profiling results in Secret %s: %w", secret.Name, err)
+	}
+	return nil
+}
+
+// getProfilingDGDR returns the DGDR the request is for, if it uses the HTTP result transport
+func (s *ResultsServer) getProfilingDGDR(ctx context.Context, key types.NamespacedName) (*nvidiacomv1alpha1.DynamoGraphDeploymentRequest, error) {
+	dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{}
+	if err := s.Client.Get(ctx, key, dgdr); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			return nil, requestError(http.StatusNotFound, "DynamoGraphDeploymentRequest %s not found", key)
+		}
+		return nil, err
+	}
+	if getResultTransport(dgdr) != nvidiacomv1alpha1.ResultTransportHTTP {
+		return nil, requestError(http.StatusConflict, "DynamoGraphDeploymentRequest %s does not use the HTTP result transport", key)
+	}
+	return dgdr, nil
+}
+
+// getDeliveredFile returns a file the profiling job restores for the DGDR: the last sweep checkpoint
+// posted, or the previous results stored for differential profiling
+func (s *ResultsServer) getDeliveredFile(ctx context.Context, key types.NamespacedName, file string) (string, error) {
+	if file == "" {
+		file = ProfilingCheckpointFile
+	}
+	if file != ProfilingCheckpointFile && file != PreviousResultsFile {
+		return "", requestError(http.StatusBadRequest, "%s must be %s or %s", ResultsFileParam, ProfilingCheckpointFile, PreviousResultsFile)
+	}
+	dgdr, err := s.getProfilingDGDR(ctx, key)
+	if err != nil {
+		return "", err
+	}
+	secret := &corev1.Secret{}
+	if err := s.Client.Get(ctx, types.NamespacedName{Name: GetOutputConfigMapName(dgdr), Namespace: dgdr.Namespace}, secret); client.IgnoreNotFound(err) != nil {
+		return "", err
+	}
+	content, exists := secret.Data[file]
+	if !exists {
+		return "", requestError(http.StatusNotFound, "no %s has been delivered for %s", file, key)
+	}
+	return string(content), nil
+}
+
+// authenticate verifies that the request carries a token of the namespace's profiling job
+// ServiceAccount, bound to a pod of the profiling Job of the DGDR
+func (s *ResultsServer) authenticate(ctx context.Context, req *http.Request, key types.NamespacedName) error {
+	token, ok := strings.CutPrefix(req.Header.Get("Authorization"), "Bearer ")
+	if !ok || token == "" {
+		return requestError(http.StatusUnauthorized, "missing bearer token")
+	}
+
+	review := &authenticationv1.TokenReview{
+		Spec: authenticationv1.TokenReviewSpec{
+			Token:     token,
+			Audiences: []string{ResultsTokenAudience},
+		},
+	}
+	if err := s.Client.Create(ctx, review); err != nil {
+		return fmt.Errorf("failed to review token: %w", err)
+	}
+	if !review.Status.Authenticated || !slices.Contains(review.Status.Audiences, ResultsTokenAudience) {
+		return requestError(http.StatusUnauthorized, "invalid token")
+	}
+
+	user := review.Status.User
+	expected := fmt.Sprintf("system:serviceaccount:%s:%s", key.Namespace, ServiceAccountProfilingJob)
+	if user.Username != expected {
+		return requestError(http.StatusForbidden, "%s may not post results for namespace %s", user.Username, key.Namespace)
+	}
+
+	// Every DGDR of the namespace shares the ServiceAccount, so the pod the token is bound to tells
+	// which DGDR the request may act for
+	podName, podUID := extraValue(user.Extra, tokenExtraPodName), extraValue(user.Extra, tokenExtraPodUID)
+	if podName == "" || podUID == "" {
+		return requestError(http.StatusForbidden, "token of %s is not bound to a pod", user.Username)
+	}
+	dgdr, err := s.getProfilingDGDR(ctx, key)
+	if err != nil {
+		return err
+	}
+	pod := &corev1.Pod{}
+	if err := s.Client.Get(ctx, types.NamespacedName{Namespace: key.Namespace, Name: podName}, pod); err != nil {
+		if apierrors.IsNotFound(err) {
+			return requestError(http.StatusForbidden, "pod %s the token is bound to does not exist", podName)
+		}
+		return err
+	}
+	owner := metav1.GetControllerOf(pod)
+	if string(pod.UID) != podUID || owner == nil || owner.Kind != "Job" || owner.Name != GetProfilingJobName(dgdr) {
+		return requestError(http.StatusForbidden, "pod %s is not of the profiling job of DynamoGraphDeploymentRequest %s", podName, key)
+	}
+	job := &batchv1.Job{}
+	if err := s.Client.Get(ctx, types.NamespacedName{Namespace: key.Namespace, Name: owner.Name}, job); err != nil {
+		if apierrors.IsNotFound(err) {
+			return requestError(http.StatusForbidden, "profiling job %s of pod %s does not exist", owner.Name, podName)
+		}
+		return err
+	}
+	if job.UID != owner.UID || !metav1.IsControlledBy(job, dgdr) {
+		return requestError(http.StatusForbidden, "pod %s is not of the profiling job of DynamoGraphDeploymentRequest %s", podName, key)
+	}
+	return nil
+}
+
+// extraValue returns the single value of an extra field of a TokenReview user
+func extraValue(extra map[string]authenticationv1.ExtraValue, key string) string {
+	if values := extra[key]; len(values) == 1 {
+		return values[0]
+	}
+	return ""
+}
+
+// readResults reads the posted result files by form field name
+func (s *ResultsServer) readResults(req *http.Request) (map[string]string, error) {
+	maxBytes := s.MaxBytes
+	if maxBytes == 0 {
+		maxBytes = DefaultResultsMaxBytes
+	}
+	req.Body = http.MaxBytesReader(nil, req.Body, maxBytes)
+	if err := req.ParseMultipartForm(maxBytes); err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			return nil, requestError(http.StatusRequestEntityTooLarge, "results exceed %d bytes", maxBytes)
+		}
+		return nil, requestError(http.StatusBadRequest, "invalid multipart form: %v", err)
+	}
+	defer func() { _ = req.MultipartForm.RemoveAll() }()
+
+	results := map[string]string{}
+	for name, headers := range req.MultipartForm.File {
+		if len(headers) != 1 {
+			return nil, requestError(http.StatusBadRequest, "expected one file for %s", name)
+		}
+		file, err := headers[0].Open()
+		if err != nil {
+			return nil, err
+		}
+		content, err := io.ReadAll(file)
+		_ = file.Close()
+		if err != nil {
+			return nil, err
+		}
+		results[name] = string(content)
+	}
+	return results, nil
+}
+
+// requiredProfilingOutputFile returns the result file that completes profiling: the generated
+// deployment, or the backend comparison for backend auto
+func requiredProfilingOutputFile(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) string {
+	if dgdr.Spec.Backend == BackendAuto {
+		return ProfilingComparisonFile
+	}
+	return getProfilingOutputKey(dgdr)
+}
+
+// validateProfilingOutput checks that the results hold a generated deployment for the DGDR's
+// backend, or a backend comparison for backend auto, and that every generated deployment parses
+func validateProfilingOutput(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, results map[string]string) error {
+	required := requiredProfilingOutputFile(dgdr)
+	if _, exists := results[required]; !exists {
+		return fmt.Errorf("missing %s", required)
+	}
+
+	for name, content := range results {
+		switch {
+		case name == ProfilingComparisonFile:
+			var evaluations []backendResult
+			if err := yaml.Unmarshal([]byte(content), &evaluations); err != nil {
+				return fmt.Errorf("failed to parse %s: %w", name, err)
+			}
+		case name == getProfilingOutputKey(dgdr) || strings.HasPrefix(name, strings.TrimSuffix(ProfilingOutputFile, ".yaml")):
+			// Unknown fields are reported as warnings once the deployment is generated
+			dgd, _, err := decodeDeployment(name, []byte(content))
+			if err != nil {
+				return fmt.Errorf("failed to parse %s: %w", name, err)
+			}
+			if dgd.Kind != "DynamoGraphDeployment" {
+				return fmt.Errorf("%s is a %q, expected a DynamoGraphDeployment", name, dgd.Kind)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,288 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+var _ = Describe("DGDR Results Endpoint", func() {
+	var server *ResultsServer
+
+	BeforeEach(func() {
+		server = &ResultsServer{
+			Client:   k8sClient,
+			Recorder: record.NewFakeRecorder(100),
+		}
+	})
+
+	newDGDR := func(name string) *nvidiacomv1alpha1.DynamoGraphDeploymentRequest {
+		return &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model:   "Qwen/Qwen3-0.6B",
+				Backend: BackendVLLM,
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					ProfilerImage: "test-profiler:latest",
+					Config: createTestConfig(map[string]interface{}{
+						"sla": map[string]interface{}{"ttft": 200.0, "itl": 20.0},
+					}),
+					ResultTransport: nvidiacomv1alpha1.ResultTransportHTTP,
+				},
+			},
+		}
+	}
+
+	serviceAccountToken := func(ctx context.Context, namespace, audience string, boundPod *corev1.Pod) string {
+		sa := &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: ServiceAccountProfilingJob, Namespace: namespace}}
+		if err := k8sClient.Get(ctx, client.ObjectKeyFromObject(sa), sa); err != nil {
+			Expect(k8sClient.Create(ctx, sa)).Should(Succeed())
+			DeferCleanup(func() { _ = k8sClient.Delete(context.Background(), sa) })
+		}
+		request := &authenticationv1.TokenRequest{Spec: authenticationv1.TokenRequestSpec{Audiences: []string{audience}}}
+		if boundPod != nil {
+			request.Spec.BoundObjectRef = &authenticationv1.BoundObjectReference{Kind: "Pod", APIVersion: "v1", Name: boundPod.Name, UID: boundPod.UID}
+		}
+		Expect(k8sClient.SubResource("token").Create(ctx, sa, request)).Should(Succeed())
+		return request.Status.Token
+	}
+
+	// profilingJobToken returns a token bound to a pod of the profiling Job of the DGDR, as
+	// projected into the pods of the Job
+	profilingJobToken := func(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, audience string) string {
+		podSpec := corev1.PodSpec{
+			ServiceAccountName: ServiceAccountProfilingJob,
+			RestartPolicy:      corev1.RestartPolicyNever,
+			Containers:         []corev1.Container{{Name: ContainerNameProfiler, Image: "test-profiler:latest"}},
+		}
+		job := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: GetProfilingJobName(dgdr), Namespace: dgdr.Namespace}}
+		if err := k8sClient.Get(ctx, client.ObjectKeyFromObject(job), job); err != nil {
+			job.Spec.Template.Spec = podSpec
+			Expect(controllerutil.SetControllerReference(dgdr, job, k8sClient.Scheme())).Should(Succeed())
+			Expect(k8sClient.Create(ctx, job)).Should(Succeed())
+			DeferCleanup(func() {
+				_ = k8sClient.Delete(context.Background(), job, client.PropagationPolicy(metav1.DeletePropagationBackground))
+			})
+		}
+		pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: job.Name + "-pod", Namespace: dgdr.Namespace}, Spec: podSpec}
+		if err := k8sClient.Get(ctx, client.ObjectKeyFromObject(pod), pod); err != nil {
+			Expect(controllerutil.SetControllerReference(job, pod, k8sClient.Scheme())).Should(Succeed())
+			Expect(k8sClient.Create(ctx, pod)).Should(Succeed())
+			DeferCleanup(func() { _ = k8sClient.Delete(context.Background(), pod) })
+		}
+		return serviceAccountToken(ctx, dgdr.Namespace, audience, pod)
+	}
+
+	post := func(path, token string, files map[string]string) *httptest.ResponseRecorder {
+		body := &bytes.Buffer{}
+		writer := multipart.NewWriter(body)
+		for name, content := range files {
+			part, err := writer.CreateFormFile(name, name)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = part.Write([]byte(content))
+			Expect(err).NotTo(HaveOccurred())
+		}
+		Expect(writer.Close()).Should(Succeed())
+
+		req := httptest.NewRequest(http.MethodPost, path, body)
+		req.Header.Set("Content-Type", writer.FormDataContentType())
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		recorder := httptest.NewRecorder()
+		server.ServeHTTP(recorder, req)
+		return recorder
+	}
+
+	dgdOutput := "apiVersion: nvidia.com/v1alpha1\nkind: DynamoGraphDeployment\nmetadata:\n  name: test\n"
+
+	It("Should store results posted by the profiling job in the output Secret", func() {
+		ctx := context.Background()
+		dgdr := newDGDR("test-dgdr-results-post")
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, dgdr) }()
+		dgdr.Status.State = StateProfiling
+		Expect(k8sClient.Status().Update(ctx, dgdr)).Should(Succeed())
+
+		path := ResultsEndpointPath + "/" + defaultNamespace + "/" + dgdr.Name
+		token := profilingJobToken(ctx, dgdr, ResultsTokenAudience)
+
+		Expect(post(path, "", map[string]string{ProfilingOutputFile: dgdOutput}).Code).Should(Equal(http.StatusUnauthorized))
+		Expect(post(path, profilingJobToken(ctx, dgdr, "other"), map[string]string{ProfilingOutputFile: dgdOutput}).Code).
+			Should(Equal(http.StatusUnauthorized))
+		Expect(post(ResultsEndpointPath+"/other/"+dgdr.Name, token, map[string]string{ProfilingOutputFile: dgdOutput}).Code).
+			Should(Equal(http.StatusForbidden))
+		Expect(post(path, token, map[string]string{"other.yaml": dgdOutput}).Code).Should(Equal(http.StatusBadRequest))
+
+		Expect(post(path, token, map[string]string{ProfilingOutputFile: dgdOutput}).Code).Should(Equal(http.StatusNoContent))
+
+		secret := &corev1.Secret{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: GetOutputConfigMapName(dgdr), Namespace: defaultNamespace}, secret)).Should(Succeed())
+		Expect(secret.Data).Should(HaveKeyWithValue(ProfilingOutputFile, []byte(dgdOutput)))
+		Expect(metav1.IsControlledBy(secret, dgdr)).To(BeTrue())
+		DeferCleanup(func() { _ = k8sClient.Delete(context.Background(), secret) })
+
+		updated := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: dgdr.Name, Namespace: defaultNamespace}, updated)).Should(Succeed())
+		condition := meta.FindStatusCondition(updated.Status.Conditions, ConditionTypeProfiling)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Reason).Should(Equal(EventReasonResultsReceived))
+
+		reconciler := &DynamoGraphDeploymentRequestReconciler{Client: k8sClient, Recorder: record.NewFakeRecorder(100), RBACManager: &MockRBACManager{}}
+		completed, err := reconciler.checkProfilingJobStatus(ctx, updated)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(completed).To(BeTrue())
+	})
+
+	It("Should reject tokens not bound to a pod of the profiling job of the DGDR", func() {
+		ctx := context.Background()
+		dgdr := newDGDR("test-dgdr-results-bound")
+		other := newDGDR("test-dgdr-results-bound-other")
+		for _, obj := range []*nvidiacomv1alpha1.DynamoGraphDeploymentRequest{dgdr, other} {
+			Expect(k8sClient.Create(ctx, obj)).Should(Succeed())
+			defer func() { _ = k8sClient.Delete(ctx, obj) }()
+			obj.Status.State = StateProfiling
+			Expect(k8sClient.Status().Update(ctx, obj)).Should(Succeed())
+		}
+
+		path := ResultsEndpointPath + "/" + defaultNamespace + "/" + dgdr.Name
+		files := map[string]string{ProfilingOutputFile: dgdOutput}
+
+		// The token of the profiling job of another DGDR in the same namespace
+		response := post(path, profilingJobToken(ctx, other, ResultsTokenAudience), files)
+		Expect(response.Code).Should(Equal(http.StatusForbidden))
+		Expect(response.Body.String()).Should(ContainSubstring("is not of the profiling job"))
+
+		// A token of the ServiceAccount that is not bound to a pod
+		response = post(path, serviceAccountToken(ctx, defaultNamespace, ResultsTokenAudience, nil), files)
+		Expect(response.Code).Should(Equal(http.StatusForbidden))
+		Expect(response.Body.String()).Should(ContainSubstring("not bound to a pod"))
+
+		secret := &corev1.Secret{}
+		err := k8sClient.Get(ctx, types.NamespacedName{Name: GetOutputConfigMapName(dgdr), Namespace: defaultNamespace}, secret)
+		Expect(err).To(HaveOccurred(), "rejected results must not be stored")
+	})
+
+	It("Should keep posted checkpoints without completing profiling", func() {
+		ctx := context.Background()
+		dgdr := newDGDR("test-dgdr-results-checkpoint")
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, dgdr) }()
+		dgdr.Status.State = StateProfiling
+		Expect(k8sClient.Status().Update(ctx, dgdr)).Should(Succeed())
+
+		path := ResultsEndpointPath + "/" + defaultNamespace + "/" + dgdr.Name
+		token := profilingJobToken(ctx, dgdr, ResultsTokenAudience)
+		get := func(query string) *httptest.ResponseRecorder {
+			req := httptest.NewRequest(http.MethodGet, path+query, nil)
+			req.Header.Set("Authorization", "Bearer "+token)
+			recorder := httptest.NewRecorder()
+			server.ServeHTTP(recorder, req)
+			return recorder
+		}
+
+		Expect(get("").Code).Should(Equal(http.StatusNotFound))
+		Expect(post(path, token, map[string]string{ProfilingCheckpointFile: `{"completed": []}`}).Code).Should(Equal(http.StatusNoContent))
+
+		response := get("")
+		Expect(response.Code).Should(Equal(http.StatusOK))
+		Expect(response.Body.String()).Should(Equal(`{"completed": []}`))
+		DeferCleanup(func() {
+			_ = k8sClient.Delete(context.Background(), &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: GetOutputConfigMapName(dgdr), Namespace: defaultNamespace}})
+		})
+
+		// Only the files profiling job pods restore are served
+		Expect(get("?" + ResultsFileParam + "=" + PreviousResultsFile).Code).Should(Equal(http.StatusNotFound))
+		Expect(get("?" + ResultsFileParam + "=" + ProfilingOutputFile).Code).Should(Equal(http.StatusBadRequest))
+
+		updated := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: dgdr.Name, Namespace: defaultNamespace}, updated)).Should(Succeed())
+		reconciler := &DynamoGraphDeploymentRequestReconciler{Client: k8sClient, Recorder: record.NewFakeRecorder(100), RBACManager: &MockRBACManager{}}
+		completed, err := reconciler.checkProfilingJobStatus(ctx, updated)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(completed).To(BeFalse(), "profiling must wait for the job without posted results")
+	})
+
+	It("Should reject results for DGDRs that are not profiling", func() {
+		ctx := context.Background()
+		dgdr := newDGDR("test-dgdr-results-not-profiling")
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, dgdr) }()
+
+		token := profilingJobToken(ctx, dgdr, ResultsTokenAudience)
+		path := ResultsEndpointPath + "/" + defaultNamespace + "/" + dgdr.Name
+		Expect(post(path, token, map[string]string{ProfilingOutputFile: dgdOutput}).Code).Should(Equal(http.StatusConflict))
+		Expect(post(ResultsEndpointPath+"/"+defaultNamespace+"/missing", token, map[string]string{ProfilingOutputFile: dgdOutput}).Code).
+			Should(Equal(http.StatusNotFound))
+
+		server.MaxBytes = 16
+		Expect(post(path, token, map[string]string{ProfilingOutputFile: dgdOutput}).Code).Should(Equal(http.StatusRequestEntityTooLarge))
+	})
+
+	It("Should share the self-signed certificate between replicas", func() {
+		ctx := context.Background()
+		endpoint := "https://dynamo-operator-results.default.svc:8444"
+		cert, ca, err := LoadResultsServingCertificate(ctx, k8sClient, "", endpoint, defaultNamespace)
+		Expect(err).NotTo(HaveOccurred())
+		secret := &corev1.Secret{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: ResultsCertSecretName, Namespace: defaultNamespace}, secret)).Should(Succeed())
+		DeferCleanup(func() { _ = k8sClient.Delete(context.Background(), secret) })
+
+		// Another replica loads the same certificate
+		other, otherCA, err := LoadResultsServingCertificate(ctx, k8sClient, "", endpoint, defaultNamespace)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(other.Certificate).To(Equal(cert.Certificate))
+		Expect(otherCA).To(Equal(ca))
+
+		// A certificate for another host is replaced
+		moved, _, err := LoadResultsServingCertificate(ctx, k8sClient, "", "https://results.example.com:8444", defaultNamespace)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(moved.Leaf.VerifyHostname("results.example.com")).To(Succeed())
+
+		_, _, err = LoadResultsServingCertificate(ctx, k8sClient, "", endpoint, "")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("Should validate the posted profiling output", func() {
+		dgdr := newDGDR("test-dgdr-results-validate")
+		Expect(validateProfilingOutput(dgdr, map[string]string{ProfilingOutputFile: dgdOutput})).Should(Succeed())
+		Expect(validateProfilingOutput(dgdr, map[string]string{})).To(MatchError(ContainSubstring("missing " + ProfilingOutputFile)))
+		Expect(validateProfilingOutput(dgdr, map[string]string{ProfilingOutputFile: "kind: ConfigMap\n"})).To(HaveOccurred())
+
+		dgdr.Spec.Backend = BackendAuto
+		Expect(validateProfilingOutput(dgdr, map[string]string{ProfilingOutputFile: dgdOutput})).To(HaveOccurred())
+		Expect(validateProfilingOutput(dgdr, map[string]string{ProfilingComparisonFile: "not: a list\n"})).To(HaveOccurred())
+	})
+})
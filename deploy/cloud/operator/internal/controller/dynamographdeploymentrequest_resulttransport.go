@@ -0,0 +1,545 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+)
+
+const (
+	// ResultsStagingDir is where the output copier sidecar collects the result files before delivering them
+	ResultsStagingDir = "/tmp/results"
+
+	// ResultsPVCDir is the directory of the shared profiling output volume that holds the results
+	// delivered with the PVC transport, one <namespace>/<name> directory per DGDR
+	ResultsPVCDir = "dgdr-results"
+
+	// ResultsCompleteMarker is written last by the PVC transport, so partially copied results are never read
+	ResultsCompleteMarker = ".complete"
+
+	// ResultsEndpointPath is the path of the operator's results endpoint, followed by /<namespace>/<name>
+	ResultsEndpointPath = "/results"
+
+	// ResultsFileParam selects the delivered file a GET of the results endpoint returns, the checkpoint by default
+	ResultsFileParam = "file"
+
+	// AnnotationResultsChecksum is set by the output copier sidecar on the output ConfigMap or Secret
+	// to the SHA-256 of the result files, so unchanged results are recognized without reading them
+	AnnotationResultsChecksum = "nvidia.com/dgdr-results-checksum"
+
+	// Validation messages
+	ValidationErrorResultTransportPVC  = "profilingConfig.resultTransport PVC requires the shared profiling volume to be mounted into the operator (--results-pvc-path)"
+	ValidationErrorResultTransportHTTP = "profilingConfig.resultTransport HTTP requires the operator results endpoint to be enabled"
+)
+
+// ResultTransport delivers the profiling results from the profiling job to the controller
+type ResultTransport interface {
+	// UploadScript returns the shell commands that deliver the result files collected in
+	// ResultsStagingDir. They run in the output copier sidecar once the profiler has finished.
+	UploadScript(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) string
+
+	// Fetch returns the delivered results by file name, or a ResultsMissing error if the
+	// results have not been delivered.
+	Fetch(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) (map[string]string, error)
+
+	// Store adds files to the delivered results, e.g. the rendered raw manifests
+	Store(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, data map[string]string) error
+
+	// Delete removes the results of a previous profiling run
+	Delete(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error
+
+	// Reference returns where the results are stored, reported in status.profilingResults
+	Reference(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) string
+
+	// CheckpointScript returns the command that delivers the sweep checkpoint staged in
+	// CheckpointStagingDir while the profiler runs. It must exit non-zero if delivery failed.
+	CheckpointScript(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) string
+
+	// RestoreScript returns the commands that write the delivered file, the sweep checkpoint or
+	// PreviousResultsFile, to ProfilingCheckpointPath. They run in the checkpoint restorer init
+	// container of every profiling job pod.
+	RestoreScript(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, file string) string
+
+	// FetchCheckpoint returns the last delivered sweep checkpoint, or a ResultsMissing error if
+	// the profiler has not saved one.
+	FetchCheckpoint(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) (string, error)
+}
+
+// ResultsChecksummer is implemented by result transports that record a checksum of the delivered
+// results alongside them
+type ResultsChecksummer interface {
+	// Checksum returns the checksum of the delivered results, or "" if none was recorded
+	Checksum(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) (string, error)
+}
+
+// getResultTransport returns the requested result transport, defaulting to ConfigMap
+func getResultTransport(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) nvidiacomv1alpha1.ResultTransport {
+	if dgdr.Spec.ProfilingConfig.ResultTransport == "" {
+		return nvidiacomv1alpha1.ResultTransportConfigMap
+	}
+	return dgdr.Spec.ProfilingConfig.ResultTransport
+}
+
+// isResultsMissing reports whether err means that the profiling results have not been delivered
+func isResultsMissing(err error) bool {
+	return failureReasonFromError(err, "") == nvidiacomv1alpha1.FailureReasonResultsMissing
+}
+
+// resultsChecksum returns the checksum recorded with the delivered results, or "" if the transport
+// records none. Failing to read it only costs parsing the results again, so errors are logged.
+func resultsChecksum(ctx context.Context, transport ResultTransport, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) string {
+	checksummer, ok := transport.(ResultsChecksummer)
+	if !ok {
+		return ""
+	}
+	checksum, err := checksummer.Checksum(ctx, dgdr)
+	if err != nil {
+		log.FromContext(ctx).Error(err, "Failed to read the profiling results checksum", "results", transport.Reference(dgdr))
+		return ""
+	}
+	return checksum
+}
+
+// validateResultTransport checks that the operator is configured for the requested result transport
+func (r *DynamoGraphDeploymentRequestReconciler) validateResultTransport(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	switch getResultTransport(dgdr) {
+	case nvidiacomv1alpha1.ResultTransportPVC:
+		if r.ResultsPVCPath == "" {
+			return errors.New(ValidationErrorResultTransportPVC)
+		}
+	case nvidiacomv1alpha1.ResultTransportHTTP:
+		if r.ResultsEndpoint == "" {
+			return errors.New(ValidationErrorResultTransportHTTP)
+		}
+	}
+	return nil
+}
+
+// resultTransport returns the transport of the DGDR's profiling results
+func (r *DynamoGraphDeploymentRequestReconciler) resultTransport(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) ResultTransport {
+	switch getResultTransport(dgdr) {
+	case nvidiacomv1alpha1.ResultTransportSecret:
+		return &secretResultTransport{client: r.Client}
+	case nvidiacomv1alpha1.ResultTransportPVC:
+		return &pvcResultTransport{root: r.ResultsPVCPath}
+	case nvidiacomv1alpha1.ResultTransportHTTP:
+		return &httpResultTransport{secretResultTransport: secretResultTransport{client: r.Client}, endpoint: r.ResultsEndpoint}
+	default:
+		return &configMapResultTransport{client: r.Client}
+	}
+}
+
+// resultLabels are the labels of the objects holding profiling results
+func resultLabels(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) map[string]string {
+	return map[string]string{
+		LabelDGDRName:  dgdr.Name,
+		LabelDGDRUID:   string(dgdr.UID),
+		LabelManagedBy: LabelValueDynamoOperator,
+	}
+}
+
+// kubectlApplyScript returns the command that applies the files staged in dir as a ConfigMap or Secret
+func kubectlApplyScript(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, kind, dir string) string {
+	return fmt.Sprintf(`kubectl create %s %s -n %s --from-file=%s --dry-run=client -o yaml | \
+  kubectl label --local -f - %s=%s %s=%s %s=%s -o yaml | \
+  kubectl apply -f -`,
+		kind, GetOutputConfigMapName(dgdr), dgdr.Namespace, dir,
+		LabelDGDRName, dgdr.Name, LabelDGDRUID, dgdr.UID, LabelManagedBy, LabelValueDynamoOperator)
+}
+
+// kubectlUploadScript returns the commands that apply the staged result files as a ConfigMap or Secret,
+// annotated with the checksum of the uncompressed files and their encoding
+func kubectlUploadScript(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, kind string) string {
+	return fmt.Sprintf(`CHECKSUM=$(cd %s && find . -maxdepth 1 -type f | LC_ALL=C sort | xargs cat | sha256sum | cut -d' ' -f1)
+%s
+kubectl create %s %s -n %s --from-file=${RESULTS_DIR} --dry-run=client -o yaml | \
+  kubectl label --local -f - %s=%s %s=%s %s=%s -o yaml | \
+  kubectl annotate --local -f - %s=sha256:${CHECKSUM} %s=${ENCODING} -o yaml | \
+  kubectl apply -f -
+echo "Saved profiling output to %s %s"`,
+		ResultsStagingDir, resultEncodingScript(dgdr),
+		kind, GetOutputConfigMapName(dgdr), dgdr.Namespace,
+		LabelDGDRName, dgdr.Name, LabelDGDRUID, dgdr.UID, LabelManagedBy, LabelValueDynamoOperator,
+		AnnotationResultsChecksum, AnnotationResultsEncoding, kind, GetOutputConfigMapName(dgdr))
+}
+
+// kubectlRestoreScript returns the command that writes the file's key of the ConfigMap or Secret
+// to ProfilingCheckpointPath, through decode for Secrets
+func kubectlRestoreScript(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, kind, decode, file string) string {
+	return fmt.Sprintf(`kubectl get %s %s -n %s -o jsonpath='{.data.%s}' %s> %s || true`,
+		kind, GetOutputConfigMapName(dgdr), dgdr.Namespace, strings.ReplaceAll(file, ".", `\.`),
+		decode, path.Join(ProfilingCheckpointPath, file))
+}
+
+// checkpointFrom returns the checkpoint held by fetched results
+func checkpointFrom(data map[string]string, err error) (string, error) {
+	if err != nil {
+		return "", err
+	}
+	checkpoint, exists := data[ProfilingCheckpointFile]
+	if !exists {
+		return "", withFailureReason(nvidiacomv1alpha1.FailureReasonResultsMissing,
+			fmt.Errorf("no %s has been delivered", ProfilingCheckpointFile))
+	}
+	return checkpoint, nil
+}
+
+// configMapResultTransport stores the results in the output ConfigMap
+type configMapResultTransport struct {
+	client client.Client
+}
+
+func (t *configMapResultTransport) UploadScript(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) string {
+	return kubectlUploadScript(dgdr, "configmap")
+}
+
+func (t *configMapResultTransport) Fetch(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) (map[string]string, error) {
+	cm := &corev1.ConfigMap{}
+	if err := t.client.Get(ctx, types.NamespacedName{Name: GetOutputConfigMapName(dgdr), Namespace: dgdr.Namespace}, cm); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, withFailureReason(nvidiacomv1alpha1.FailureReasonResultsMissing,
+				fmt.Errorf("output ConfigMap %s not found - profiling may not have completed yet", GetOutputConfigMapName(dgdr)))
+		}
+		return nil, fmt.Errorf("failed to get output ConfigMap: %w", err)
+	}
+	return decodeResults(cm.Data, cm.Annotations[AnnotationResultsEncoding])
+}
+
+func (t *configMapResultTransport) Checksum(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) (string, error) {
+	cm := &corev1.ConfigMap{}
+	if err := t.client.Get(ctx, types.NamespacedName{Name: GetOutputConfigMapName(dgdr), Namespace: dgdr.Namespace}, cm); err != nil {
+		return "", client.IgnoreNotFound(err)
+	}
+	return cm.Annotations[AnnotationResultsChecksum], nil
+}
+
+func (t *configMapResultTransport) Encoding(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) (nvidiacomv1alpha1.ResultEncoding, error) {
+	cm := &corev1.ConfigMap{}
+	if err := t.client.Get(ctx, types.NamespacedName{Name: GetOutputConfigMapName(dgdr), Namespace: dgdr.Namespace}, cm); err != nil {
+		return "", client.IgnoreNotFound(err)
+	}
+	return annotatedResultsEncoding(cm.Annotations), nil
+}
+
+func (t *configMapResultTransport) Store(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, data map[string]string) error {
+	cm := &corev1.ConfigMap{}
+	err := t.client.Get(ctx, types.NamespacedName{Name: GetOutputConfigMapName(dgdr), Namespace: dgdr.Namespace}, cm)
+	if apierrors.IsNotFound(err) {
+		cm = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      GetOutputConfigMapName(dgdr),
+				Namespace: dgdr.Namespace,
+				Labels:    resultLabels(dgdr),
+			},
+			Data: data,
+		}
+		if err := t.client.Create(ctx, cm); err != nil {
+			return fmt.Errorf("failed to create output ConfigMap: %w", err)
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get output ConfigMap: %w", err)
+	}
+	if cm.Data == nil {
+		cm.Data = map[string]string{}
+	}
+	maps.Copy(cm.Data, data)
+	if err := t.client.Update(ctx, cm); err != nil {
+		return fmt.Errorf("failed to update output ConfigMap %s: %w", cm.Name, err)
+	}
+	return nil
+}
+
+func (t *configMapResultTransport) Delete(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: GetOutputConfigMapName(dgdr), Namespace: dgdr.Namespace}}
+	if err := t.client.Delete(ctx, cm); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete output ConfigMap: %w", err)
+	}
+	return nil
+}
+
+func (t *configMapResultTransport) Reference(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) string {
+	return fmt.Sprintf("configmap/%s", GetOutputConfigMapName(dgdr))
+}
+
+func (t *configMapResultTransport) CheckpointScript(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) string {
+	return kubectlApplyScript(dgdr, "configmap", CheckpointStagingDir)
+}
+
+func (t *configMapResultTransport) RestoreScript(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, file string) string {
+	return kubectlRestoreScript(dgdr, "configmap", "", file)
+}
+
+func (t *configMapResultTransport) FetchCheckpoint(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) (string, error) {
+	return checkpointFrom(t.Fetch(ctx, dgdr))
+}
+
+// secretResultTransport stores the results in a Secret named like the output ConfigMap
+type secretResultTransport struct {
+	client client.Client
+}
+
+func (t *secretResultTransport) UploadScript(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) string {
+	return kubectlUploadScript(dgdr, "secret generic")
+}
+
+func (t *secretResultTransport) Fetch(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) (map[string]string, error) {
+	secret := &corev1.Secret{}
+	if err := t.client.Get(ctx, types.NamespacedName{Name: GetOutputConfigMapName(dgdr), Namespace: dgdr.Namespace}, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, withFailureReason(nvidiacomv1alpha1.FailureReasonResultsMissing,
+				fmt.Errorf("output Secret %s not found - profiling may not have completed yet", GetOutputConfigMapName(dgdr)))
+		}
+		return nil, fmt.Errorf("failed to get output Secret: %w", err)
+	}
+	data := make(map[string]string, len(secret.Data))
+	for key, value := range secret.Data {
+		data[key] = string(value)
+	}
+	return decodeResults(data, secret.Annotations[AnnotationResultsEncoding])
+}
+
+func (t *secretResultTransport) Checksum(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) (string, error) {
+	secret := &corev1.Secret{}
+	if err := t.client.Get(ctx, types.NamespacedName{Name: GetOutputConfigMapName(dgdr), Namespace: dgdr.Namespace}, secret); err != nil {
+		return "", client.IgnoreNotFound(err)
+	}
+	return secret.Annotations[AnnotationResultsChecksum], nil
+}
+
+func (t *secretResultTransport) Encoding(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) (nvidiacomv1alpha1.ResultEncoding, error) {
+	secret := &corev1.Secret{}
+	if err := t.client.Get(ctx, types.NamespacedName{Name: GetOutputConfigMapName(dgdr), Namespace: dgdr.Namespace}, secret); err != nil {
+		return "", client.IgnoreNotFound(err)
+	}
+	return annotatedResultsEncoding(secret.Annotations), nil
+}
+
+func (t *secretResultTransport) Store(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, data map[string]string) error {
+	secret := &corev1.Secret{}
+	err := t.client.Get(ctx, types.NamespacedName{Name: GetOutputConfigMapName(dgdr), Namespace: dgdr.Namespace}, secret)
+	if apierrors.IsNotFound(err) {
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      GetOutputConfigMapName(dgdr),
+				Namespace: dgdr.Namespace,
+				Labels:    resultLabels(dgdr),
+			},
+			StringData: data,
+		}
+		if err := t.client.Create(ctx, secret); err != nil {
+			return fmt.Errorf("failed to create output Secret: %w", err)
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get output Secret: %w", err)
+	}
+	if secret.Data == nil {
+		secret.Data = map[string][]byte{}
+	}
+	for key, value := range data {
+		secret.Data[key] = []byte(value)
+	}
+	if err := t.client.Update(ctx, secret); err != nil {
+		return fmt.Errorf("failed to update output Secret %s: %w", secret.Name, err)
+	}
+	return nil
+}
+
+func (t *secretResultTransport) Delete(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: GetOutputConfigMapName(dgdr), Namespace: dgdr.Namespace}}
+	if err := t.client.Delete(ctx, secret); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete output Secret: %w", err)
+	}
+	return nil
+}
+
+func (t *secretResultTransport) Reference(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) string {
+	return fmt.Sprintf("secret/%s", GetOutputConfigMapName(dgdr))
+}
+
+func (t *secretResultTransport) CheckpointScript(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) string {
+	return kubectlApplyScript(dgdr, "secret generic", CheckpointStagingDir)
+}
+
+func (t *secretResultTransport) RestoreScript(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, file string) string {
+	return kubectlRestoreScript(dgdr, "secret", "| base64 -d ", file)
+}
+
+func (t *secretResultTransport) FetchCheckpoint(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) (string, error) {
+	return checkpointFrom(t.Fetch(ctx, dgdr))
+}
+
+// pvcResultTransport leaves the results on the shared profiling output volume, which the operator
+// mounts at root
+type pvcResultTransport struct {
+	root string
+}
+
+// pvcResultsDir returns the results directory of a DGDR relative to the root of the volume
+func pvcResultsDir(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) string {
+	return path.Join(ResultsPVCDir, dgdr.Namespace, dgdr.Name)
+}
+
+func (t *pvcResultTransport) UploadScript(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) string {
+	dir := path.Join(ProfilingOutputPath, pvcResultsDir(dgdr))
+	return fmt.Sprintf(`rm -rf %[1]s
+mkdir -p %[1]s
+cp %[2]s/* %[1]s/
+touch %[1]s/%[3]s
+echo "Saved profiling output to %[1]s"`, dir, ResultsStagingDir, ResultsCompleteMarker)
+}
+
+func (t *pvcResultTransport) dir(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) string {
+	return filepath.Join(t.root, pvcResultsDir(dgdr))
+}
+
+func (t *pvcResultTransport) Fetch(_ context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) (map[string]string, error) {
+	dir := t.dir(dgdr)
+	if _, err := os.Stat(filepath.Join(dir, ResultsCompleteMarker)); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, withFailureReason(nvidiacomv1alpha1.FailureReasonResultsMissing,
+				fmt.Errorf("output directory %s not found - profiling may not have completed yet", dir))
+		}
+		return nil, fmt.Errorf("failed to check output directory: %w", err)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read output directory: %w", err)
+	}
+	data := map[string]string{}
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() || entry.Name() == ResultsCompleteMarker {
+			continue
+		}
+		content, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", entry.Name(), err)
+		}
+		data[entry.Name()] = string(content)
+	}
+	return data, nil
+}
+
+func (t *pvcResultTransport) Store(_ context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, data map[string]string) error {
+	dir := t.dir(dgdr)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create output directory: %w", err)
+	}
+	for name, content := range data {
+		if err := os.WriteFile(filepath.Join(dir, filepath.Base(name)), []byte(content), 0o644); err != nil {
+			return fmt.Errorf("failed to write %s: %w", name, err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(dir, ResultsCompleteMarker), nil, 0o644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", ResultsCompleteMarker, err)
+	}
+	return nil
+}
+
+func (t *pvcResultTransport) Delete(_ context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	if err := os.RemoveAll(t.dir(dgdr)); err != nil {
+		return fmt.Errorf("failed to delete output directory: %w", err)
+	}
+	return nil
+}
+
+func (t *pvcResultTransport) Reference(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) string {
+	return fmt.Sprintf("pvc/dynamo-pvc/%s", pvcResultsDir(dgdr))
+}
+
+// CheckpointScript copies the checkpoint next to the results without the complete marker
+func (t *pvcResultTransport) CheckpointScript(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) string {
+	dir := path.Join(ProfilingOutputPath, pvcResultsDir(dgdr))
+	return fmt.Sprintf(`mkdir -p %[1]s && cp %[2]s/%[3]s %[1]s/`, dir, CheckpointStagingDir, ProfilingCheckpointFile)
+}
+
+func (t *pvcResultTransport) RestoreScript(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, file string) string {
+	return fmt.Sprintf(`cp %s %s/ 2>/dev/null || true`,
+		path.Join(ProfilingOutputPath, pvcResultsDir(dgdr), file), ProfilingCheckpointPath)
+}
+
+func (t *pvcResultTransport) FetchCheckpoint(_ context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) (string, error) {
+	content, err := os.ReadFile(filepath.Join(t.dir(dgdr), ProfilingCheckpointFile))
+	if errors.Is(err, fs.ErrNotExist) {
+		return "", withFailureReason(nvidiacomv1alpha1.FailureReasonResultsMissing,
+			fmt.Errorf("no %s has been delivered", ProfilingCheckpointFile))
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s: %w", ProfilingCheckpointFile, err)
+	}
+	return string(content), nil
+}
+
+// httpResultTransport posts the generated deployments to the operator's results endpoint, which
+// stores them in the output Secret. They are read, stored and deleted like the Secret transport's.
+type httpResultTransport struct {
+	secretResultTransport
+	endpoint string
+}
+
+// curl returns a curl command line for the DGDR's results endpoint, authenticated with the projected
+// token and verifying the endpoint with the CA mounted next to it
+func (t *httpResultTransport) curl(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, args string) string {
+	return fmt.Sprintf(`curl --fail --silent --show-error --retry 5 --cacert %s %s \
+  -H "Authorization: Bearer $(cat %s)" \
+  %s%s/%s/%s`,
+		path.Join(ResultsTokenPath, ResultsCAFile), args, path.Join(ResultsTokenPath, ResultsTokenFile),
+		t.endpoint, ResultsEndpointPath, dgdr.Namespace, dgdr.Name)
+}
+
+func (t *httpResultTransport) UploadScript(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) string {
+	// Only the YAML results are posted, the raw profiling data stays on the profiling volume
+	return fmt.Sprintf(`FORM=""
+for f in %s/*.yaml; do FORM="$FORM -F $(basename $f)=@$f"; done
+%s
+echo "Posted profiling output to the operator results endpoint"`,
+		ResultsStagingDir, t.curl(dgdr, "-X POST $FORM"))
+}
+
+// CheckpointScript posts the checkpoint alone, which the endpoint keeps without completing profiling
+func (t *httpResultTransport) CheckpointScript(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) string {
+	return t.curl(dgdr, fmt.Sprintf("-X POST -F %[1]s=@%[2]s/%[1]s", ProfilingCheckpointFile, CheckpointStagingDir))
+}
+
+// RestoreScript gets the file from the results endpoint, which serves the delivered checkpoint and previous results
+func (t *httpResultTransport) RestoreScript(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, file string) string {
+	dest := path.Join(ProfilingCheckpointPath, file)
+	return fmt.Sprintf("%s || rm -f %s",
+		t.curl(dgdr, fmt.Sprintf("-G --data-urlencode %s=%s -o %s", ResultsFileParam, file, dest)), dest)
+}
@@ -0,0 +1,245 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var _ = Describe("DGDR Result Transports", func() {
+	var reconciler *DynamoGraphDeploymentRequestReconciler
+
+	BeforeEach(func() {
+		reconciler = &DynamoGraphDeploymentRequestReconciler{
+			Client:      k8sClient,
+			Recorder:    record.NewFakeRecorder(100),
+			RBACManager: &MockRBACManager{},
+		}
+	})
+
+	newDGDR := func(name string, transport nvidiacomv1alpha1.ResultTransport) *nvidiacomv1alpha1.DynamoGraphDeploymentRequest {
+		return &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model:   "Qwen/Qwen3-0.6B",
+				Backend: BackendVLLM,
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					ProfilerImage: "test-profiler:latest",
+					Config: createTestConfig(map[string]interface{}{
+						"sla":   map[string]interface{}{"ttft": 200.0, "itl": 20.0},
+						"sweep": map[string]interface{}{"use_ai_configurator": true},
+					}),
+					ResultTransport: transport,
+				},
+			},
+		}
+	}
+
+	roundTrip := func(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) {
+		ctx := context.Background()
+		transport := reconciler.resultTransport(dgdr)
+
+		_, err := transport.Fetch(ctx, dgdr)
+		Expect(isResultsMissing(err)).To(BeTrue())
+
+		Expect(transport.Store(ctx, dgdr, map[string]string{ProfilingOutputFile: "kind: DynamoGraphDeployment\n"})).Should(Succeed())
+		Expect(transport.Store(ctx, dgdr, map[string]string{RawManifestsKey: "kind: Deployment\n"})).Should(Succeed())
+
+		data, err := transport.Fetch(ctx, dgdr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(data).Should(Equal(map[string]string{
+			ProfilingOutputFile: "kind: DynamoGraphDeployment\n",
+			RawManifestsKey:     "kind: Deployment\n",
+		}))
+
+		Expect(transport.Delete(ctx, dgdr)).Should(Succeed())
+		_, err = transport.Fetch(ctx, dgdr)
+		Expect(isResultsMissing(err)).To(BeTrue())
+		Expect(transport.Delete(ctx, dgdr)).Should(Succeed())
+	}
+
+	It("Should store results in a ConfigMap by default", func() {
+		dgdr := newDGDR("test-dgdr-transport-cm", "")
+		roundTrip(dgdr)
+		Expect(reconciler.resultTransport(dgdr).Reference(dgdr)).Should(Equal("configmap/" + GetOutputConfigMapName(dgdr)))
+	})
+
+	It("Should store results in a Secret", func() {
+		dgdr := newDGDR("test-dgdr-transport-secret", nvidiacomv1alpha1.ResultTransportSecret)
+		roundTrip(dgdr)
+		Expect(reconciler.resultTransport(dgdr).Reference(dgdr)).Should(Equal("secret/" + GetOutputConfigMapName(dgdr)))
+	})
+
+	It("Should read results from the shared volume only once they are complete", func() {
+		reconciler.ResultsPVCPath = GinkgoT().TempDir()
+		dgdr := newDGDR("test-dgdr-transport-pvc", nvidiacomv1alpha1.ResultTransportPVC)
+		Expect(reconciler.validateResultTransport(dgdr)).Should(Succeed())
+		roundTrip(dgdr)
+
+		// Files copied by the sidecar are ignored until the marker is written
+		dir := filepath.Join(reconciler.ResultsPVCPath, ResultsPVCDir, dgdr.Namespace, dgdr.Name)
+		Expect(os.MkdirAll(dir, 0o755)).Should(Succeed())
+		Expect(os.WriteFile(filepath.Join(dir, ProfilingOutputFile), []byte("partial"), 0o600)).Should(Succeed())
+		_, err := reconciler.resultTransport(dgdr).Fetch(context.Background(), dgdr)
+		Expect(isResultsMissing(err)).To(BeTrue())
+	})
+
+	It("Should keep posted results in the output Secret", func() {
+		reconciler.ResultsEndpoint = "https://dynamo-operator-results.dynamo-system.svc:8444"
+		dgdr := newDGDR("test-dgdr-transport-http", nvidiacomv1alpha1.ResultTransportHTTP)
+		Expect(reconciler.validateResultTransport(dgdr)).Should(Succeed())
+		roundTrip(dgdr)
+		Expect(reconciler.resultTransport(dgdr).Reference(dgdr)).Should(Equal("secret/" + GetOutputConfigMapName(dgdr)))
+
+		// The endpoint is always verified with the mounted CA
+		script := reconciler.resultTransport(dgdr).UploadScript(dgdr)
+		Expect(script).Should(ContainSubstring("https://dynamo-operator-results.dynamo-system.svc:8444/results/default/test-dgdr-transport-http"))
+		Expect(script).Should(ContainSubstring("--cacert " + ResultsTokenPath + "/" + ResultsCAFile))
+		Expect(script).ShouldNot(ContainSubstring("--insecure"))
+	})
+
+	It("Should mount the CA of the results endpoint into the profiling job", func() {
+		ctx := context.Background()
+		reconciler.ResultsEndpoint = "https://dynamo-operator-results.dynamo-system.svc:8444"
+		dgdr := newDGDR("test-dgdr-transport-http-ca", nvidiacomv1alpha1.ResultTransportHTTP)
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		DeferCleanup(func() { _ = k8sClient.Delete(context.Background(), dgdr) })
+
+		Expect(reconciler.ensureResultsCAConfigMap(ctx, dgdr)).To(HaveOccurred())
+		reconciler.ResultsCA = []byte("-----BEGIN CERTIFICATE-----")
+		Expect(reconciler.ensureResultsCAConfigMap(ctx, dgdr)).Should(Succeed())
+		cm := &corev1.ConfigMap{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: getResultsCAConfigMapName(dgdr), Namespace: defaultNamespace}, cm)).Should(Succeed())
+		DeferCleanup(func() { _ = k8sClient.Delete(context.Background(), cm) })
+		Expect(cm.Data).Should(HaveKeyWithValue(ResultsCAFile, "-----BEGIN CERTIFICATE-----"))
+
+		job, err := reconciler.buildProfilingJob(ctx, dgdr, GetProfilingJobName(dgdr), reconciler.resultTransport(dgdr))
+		Expect(err).NotTo(HaveOccurred())
+		var projected *corev1.ProjectedVolumeSource
+		for _, volume := range job.Spec.Template.Spec.Volumes {
+			if volume.Name == VolumeNameResultsToken {
+				projected = volume.Projected
+			}
+		}
+		Expect(projected).NotTo(BeNil())
+		Expect(projected.Sources).Should(ContainElement(HaveField("ConfigMap.Name", getResultsCAConfigMapName(dgdr))))
+	})
+
+	It("Should reject transports the operator is not configured for", func() {
+		err := reconciler.validateResultTransport(newDGDR("test-dgdr-transport-pvc", nvidiacomv1alpha1.ResultTransportPVC))
+		Expect(err).To(MatchError(ValidationErrorResultTransportPVC))
+
+		err = reconciler.validateResultTransport(newDGDR("test-dgdr-transport-http", nvidiacomv1alpha1.ResultTransportHTTP))
+		Expect(err).To(MatchError(ValidationErrorResultTransportHTTP))
+	})
+
+	It("Should configure the profiling job sidecar for the transport", func() {
+		ctx := context.Background()
+		reconciler.ResultsPVCPath = GinkgoT().TempDir()
+
+		for _, tc := range []struct {
+			name      string
+			transport nvidiacomv1alpha1.ResultTransport
+			upload    string
+			readOnly  bool
+		}{
+			{"test-dgdr-sidecar-secret", nvidiacomv1alpha1.ResultTransportSecret, "kubectl create secret generic dgdr-output-test-dgdr-sidecar-secret", true},
+			{"test-dgdr-sidecar-pvc", nvidiacomv1alpha1.ResultTransportPVC, "touch /data/dgdr-results/default/test-dgdr-sidecar-pvc/.complete", false},
+		} {
+			dgdr := newDGDR(tc.name, tc.transport)
+			Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+
+			Expect(reconciler.createProfilingJob(ctx, dgdr)).Should(Succeed())
+			job := &batchv1.Job{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: GetProfilingJobName(dgdr), Namespace: defaultNamespace}, job)).Should(Succeed())
+			sidecar := job.Spec.Template.Spec.Containers[1]
+			Expect(sidecar.Args[0]).Should(ContainSubstring(tc.upload))
+			Expect(sidecar.VolumeMounts[0].ReadOnly).Should(Equal(tc.readOnly))
+
+			_ = k8sClient.Delete(ctx, job)
+			_ = k8sClient.Delete(ctx, dgdr)
+		}
+	})
+
+	It("Should not parse results again when their checksum is unchanged", func() {
+		ctx := context.Background()
+		dgdr := newDGDR("test-dgdr-results-checksum", "")
+		Expect(reconciler.resultTransport(dgdr).UploadScript(dgdr)).Should(ContainSubstring(
+			"kubectl annotate --local -f - " + AnnotationResultsChecksum + "=sha256:${CHECKSUM}"))
+		Expect(resultsChecksum(ctx, reconciler.resultTransport(dgdr), dgdr)).Should(BeEmpty())
+
+		cm := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        GetOutputConfigMapName(dgdr),
+				Namespace:   defaultNamespace,
+				Annotations: map[string]string{AnnotationResultsChecksum: "sha256:1111"},
+			},
+			Data: map[string]string{ProfilingOutputFile: "not: [a deployment"},
+		}
+		Expect(k8sClient.Create(ctx, cm)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, cm) }()
+
+		dgdr.Status.GeneratedDeployment = &runtime.RawExtension{Raw: []byte(`{"kind":"DynamoGraphDeployment"}`)}
+		dgdr.Status.ProfilingResultsChecksum = "sha256:1111"
+		Eventually(func() error { return reconciler.generateDGDSpec(ctx, dgdr) }).Should(Succeed())
+
+		// Changed results are parsed, failing on the invalid output
+		cm.Annotations[AnnotationResultsChecksum] = "sha256:2222"
+		Expect(k8sClient.Update(ctx, cm)).Should(Succeed())
+		Eventually(func() error { return reconciler.generateDGDSpec(ctx, dgdr) }).ShouldNot(Succeed())
+		Expect(dgdr.Status.ProfilingResultsChecksum).Should(Equal("sha256:1111"))
+	})
+
+	It("Should generate the deployment from results delivered in a Secret", func() {
+		ctx := context.Background()
+		reconciler.ProfilerMode = ProfilerModeMock
+		dgdr := newDGDR("test-dgdr-mock-secret", nvidiacomv1alpha1.ResultTransportSecret)
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, dgdr) }()
+
+		request := reconcile.Request{NamespacedName: types.NamespacedName{Name: dgdr.Name, Namespace: defaultNamespace}}
+		for range 3 {
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		updated := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{}
+		Expect(k8sClient.Get(ctx, request.NamespacedName, updated)).Should(Succeed())
+		Expect(updated.Status.State).Should(Equal(StateReady))
+		Expect(updated.Status.ProfilingResults).Should(Equal("secret/" + GetOutputConfigMapName(dgdr)))
+
+		secret := &corev1.Secret{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: GetOutputConfigMapName(dgdr), Namespace: defaultNamespace}, secret)).Should(Succeed())
+		Expect(secret.Data).Should(HaveKey(ProfilingOutputFile))
+		Expect(secret.Labels).Should(HaveKeyWithValue(LabelDGDRName, dgdr.Name))
+		_ = k8sClient.Delete(ctx, secret)
+	})
+})
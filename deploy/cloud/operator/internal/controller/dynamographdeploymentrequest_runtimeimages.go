@@ -0,0 +1,93 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+)
+
+// ParseRuntimeImages parses the runtime images flag, comma separated backend=image pairs such as
+// vllm=nvcr.io/nvidia/ai-dynamo/vllm-runtime:0.6.1. Images may be pinned with a tag or digest.
+func ParseRuntimeImages(value string) (map[string]string, error) {
+	if value == "" {
+		return nil, nil
+	}
+	images := map[string]string{}
+	for _, pair := range strings.Split(value, ",") {
+		backend, image, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		if !ok || image == "" || strings.ContainsAny(image, " \t") {
+			return nil, fmt.Errorf("invalid runtime image %q, must be backend=image", pair)
+		}
+		switch backend {
+		case BackendVLLM, BackendSGLang, BackendTRTLLM:
+		default:
+			return nil, fmt.Errorf("unknown backend %q in runtime image %q, must be one of %s, %s, %s", backend, pair, BackendVLLM, BackendSGLang, BackendTRTLLM)
+		}
+		if _, duplicate := images[backend]; duplicate {
+			return nil, fmt.Errorf("runtime image of backend %s is configured more than once", backend)
+		}
+		images[backend] = image
+	}
+	return images, nil
+}
+
+// generatedBackend returns the backend the deployment of the DGDR was generated for
+func generatedBackend(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) string {
+	if dgdr.Status.Backend != "" {
+		return dgdr.Status.Backend
+	}
+	return dgdr.Spec.Backend
+}
+
+// runtimeImage returns the runtime image configured on the operator for the backend of the DGDR.
+// It is empty when the DGDR pins the workers image itself.
+func (r *DynamoGraphDeploymentRequestReconciler) runtimeImage(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) string {
+	if dgdr.Spec.DeploymentOverrides != nil && dgdr.Spec.DeploymentOverrides.WorkersImage != "" {
+		return ""
+	}
+	return r.RuntimeImages[generatedBackend(dgdr)]
+}
+
+// applyRuntimeImages replaces the images the profiler wrote into the main containers of the
+// generated deployment with the runtime image configured for its backend. Services whose image is
+// overridden in deploymentOverrides.services keep the override, which is applied afterwards.
+func (r *DynamoGraphDeploymentRequestReconciler) applyRuntimeImages(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, dgd *nvidiacomv1alpha1.DynamoGraphDeployment) {
+	image := r.runtimeImage(dgdr)
+	if image == "" {
+		return
+	}
+	for name, spec := range dgd.Spec.Services {
+		if spec == nil || spec.ExtraPodSpec == nil || spec.ExtraPodSpec.MainContainer == nil || spec.ExtraPodSpec.MainContainer.Image == "" {
+			continue
+		}
+		if dgdr.Spec.DeploymentOverrides != nil && dgdr.Spec.DeploymentOverrides.Services[name].Image != "" {
+			continue
+		}
+		if spec.ExtraPodSpec.MainContainer.Image != image {
+			log.FromContext(ctx).Info("Replacing generated image with the backend runtime image",
+				"service", name, "generated", spec.ExtraPodSpec.MainContainer.Image, "image", image)
+			spec.ExtraPodSpec.MainContainer.Image = image
+		}
+	}
+}
@@ -0,0 +1,101 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+
+	dynamoCommon "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/dynamo/common"
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+)
+
+var _ = Describe("DGDR Runtime Images", func() {
+	It("Should parse backend runtime images", func() {
+		images, err := ParseRuntimeImages("vllm=nvcr.io/nvidia/ai-dynamo/vllm-runtime:0.6.1, sglang=registry.local/sglang-runtime@sha256:abc")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(images).Should(Equal(map[string]string{
+			BackendVLLM:   "nvcr.io/nvidia/ai-dynamo/vllm-runtime:0.6.1",
+			BackendSGLang: "registry.local/sglang-runtime@sha256:abc",
+		}))
+
+		images, err = ParseRuntimeImages("")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(images).To(BeNil())
+
+		_, err = ParseRuntimeImages("vllm")
+		Expect(err).Should(MatchError(ContainSubstring("must be backend=image")))
+		_, err = ParseRuntimeImages("auto=runtime:1")
+		Expect(err).Should(MatchError(ContainSubstring("unknown backend")))
+		_, err = ParseRuntimeImages("vllm=runtime:1,vllm=runtime:2")
+		Expect(err).Should(MatchError(ContainSubstring("more than once")))
+	})
+
+	It("Should replace generated images unless the DGDR pins them", func() {
+		ctx := context.Background()
+		reconciler := &DynamoGraphDeploymentRequestReconciler{
+			RuntimeImages: map[string]string{BackendSGLang: "nvcr.io/nvidia/ai-dynamo/sglang-runtime:0.6.1"},
+		}
+		service := func(image string) *nvidiacomv1alpha1.DynamoComponentDeploymentSharedSpec {
+			return &nvidiacomv1alpha1.DynamoComponentDeploymentSharedSpec{
+				ExtraPodSpec: &dynamoCommon.ExtraPodSpec{MainContainer: &corev1.Container{Image: image}},
+			}
+		}
+		newDGD := func() *nvidiacomv1alpha1.DynamoGraphDeployment {
+			return &nvidiacomv1alpha1.DynamoGraphDeployment{
+				Spec: nvidiacomv1alpha1.DynamoGraphDeploymentSpec{
+					Services: map[string]*nvidiacomv1alpha1.DynamoComponentDeploymentSharedSpec{
+						"Frontend":      service("my-registry/sglang-runtime:my-tag"),
+						"SGLangWorker":  service("my-registry/sglang-runtime:my-tag"),
+						"CustomService": service("custom:1"),
+					},
+				},
+			}
+		}
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Backend: BackendSGLang,
+				DeploymentOverrides: &nvidiacomv1alpha1.DeploymentOverridesSpec{
+					Services: map[string]nvidiacomv1alpha1.ServiceOverride{"CustomService": {Image: "custom:2"}},
+				},
+			},
+		}
+
+		dgd := newDGD()
+		reconciler.applyRuntimeImages(ctx, dgdr, dgd)
+		Expect(dgd.Spec.Services["Frontend"].ExtraPodSpec.MainContainer.Image).Should(Equal("nvcr.io/nvidia/ai-dynamo/sglang-runtime:0.6.1"))
+		Expect(dgd.Spec.Services["SGLangWorker"].ExtraPodSpec.MainContainer.Image).Should(Equal("nvcr.io/nvidia/ai-dynamo/sglang-runtime:0.6.1"))
+		Expect(dgd.Spec.Services["CustomService"].ExtraPodSpec.MainContainer.Image).Should(Equal("custom:1"))
+
+		// The workers image of the DGDR wins over the operator configuration
+		dgdr.Spec.DeploymentOverrides.WorkersImage = "team/sglang-runtime:dev"
+		dgd = newDGD()
+		reconciler.applyRuntimeImages(ctx, dgdr, dgd)
+		Expect(dgd.Spec.Services["SGLangWorker"].ExtraPodSpec.MainContainer.Image).Should(Equal("my-registry/sglang-runtime:my-tag"))
+
+		// The backend selected for auto is used
+		dgdr.Spec.DeploymentOverrides = nil
+		dgdr.Spec.Backend = BackendAuto
+		dgdr.Status.Backend = BackendVLLM
+		dgd = newDGD()
+		reconciler.applyRuntimeImages(ctx, dgdr, dgd)
+		Expect(dgd.Spec.Services["SGLangWorker"].ExtraPodSpec.MainContainer.Image).Should(Equal("my-registry/sglang-runtime:my-tag"))
+	})
+})
@@ -0,0 +1,135 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	dynamoCommon "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/dynamo/common"
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+)
+
+const (
+	// DefaultHFTokenSecretName is the Secret the Hugging Face token is read from without a hfToken secret
+	DefaultHFTokenSecretName = "hf-token-secret"
+	// HFTokenSecretKey is the key of the Hugging Face token in its Secret
+	HFTokenSecretKey = "HF_TOKEN"
+)
+
+// hfTokenSecretName returns the Secret holding the Hugging Face token of the DGDR
+func hfTokenSecretName(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) string {
+	for _, secret := range dgdr.Spec.Secrets {
+		if secret.Purpose == nvidiacomv1alpha1.SecretPurposeHFToken {
+			return secret.Name
+		}
+	}
+	return DefaultHFTokenSecretName
+}
+
+// ValidateSecrets checks spec.secrets of a DGDR. Whether the listed services exist is only known
+// once the deployment has been generated.
+func ValidateSecrets(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) field.ErrorList {
+	var allErrs field.ErrorList
+	secretsPath := field.NewPath("spec", "secrets")
+	hfTokens := 0
+	for i, secret := range dgdr.Spec.Secrets {
+		path := secretsPath.Index(i)
+		for _, msg := range validation.IsDNS1123Subdomain(secret.Name) {
+			allErrs = append(allErrs, field.Invalid(path.Child("name"), secret.Name, msg))
+		}
+		if secret.Purpose == nvidiacomv1alpha1.SecretPurposeHFToken {
+			if hfTokens++; hfTokens > 1 {
+				allErrs = append(allErrs, field.Forbidden(path.Child("purpose"), "at most one secret may have the hfToken purpose"))
+			}
+		}
+		for j, service := range secret.Services {
+			if service == "" {
+				allErrs = append(allErrs, field.Required(path.Child("services").Index(j), "service name must not be empty"))
+			}
+		}
+	}
+	return allErrs
+}
+
+// applySecrets wires spec.secrets into the services of the generated DGD by purpose: the hfToken
+// secret becomes their envFromSecret, registry secrets are added to their imagePullSecrets and s3
+// secrets to the envFrom of their main container
+func applySecrets(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, dgd *nvidiacomv1alpha1.DynamoGraphDeployment) error {
+	if len(dgdr.Spec.Secrets) == 0 {
+		return nil
+	}
+	all := make([]string, 0, len(dgd.Spec.Services))
+	for name, spec := range dgd.Spec.Services {
+		if spec != nil {
+			all = append(all, name)
+		}
+	}
+	sort.Strings(all)
+
+	for i, secret := range dgdr.Spec.Secrets {
+		services := all
+		if len(secret.Services) > 0 {
+			services = secret.Services
+		}
+		for _, name := range services {
+			spec := dgd.Spec.Services[name]
+			if spec == nil {
+				return withFailureReason(nvidiacomv1alpha1.FailureReasonValidationError,
+					fmt.Errorf("secrets[%d] references service %q which is not in the generated deployment", i, name))
+			}
+			switch secret.Purpose {
+			case nvidiacomv1alpha1.SecretPurposeHFToken:
+				spec.EnvFromSecret = &secret.Name
+			case nvidiacomv1alpha1.SecretPurposeRegistry:
+				if spec.ExtraPodSpec == nil {
+					spec.ExtraPodSpec = &dynamoCommon.ExtraPodSpec{}
+				}
+				if spec.ExtraPodSpec.PodSpec == nil {
+					spec.ExtraPodSpec.PodSpec = &corev1.PodSpec{}
+				}
+				reference := corev1.LocalObjectReference{Name: secret.Name}
+				if !slices.Contains(spec.ExtraPodSpec.ImagePullSecrets, reference) {
+					spec.ExtraPodSpec.ImagePullSecrets = append(spec.ExtraPodSpec.ImagePullSecrets, reference)
+				}
+			case nvidiacomv1alpha1.SecretPurposeS3:
+				if spec.ExtraPodSpec == nil {
+					spec.ExtraPodSpec = &dynamoCommon.ExtraPodSpec{}
+				}
+				if spec.ExtraPodSpec.MainContainer == nil {
+					spec.ExtraPodSpec.MainContainer = &corev1.Container{}
+				}
+				container := spec.ExtraPodSpec.MainContainer
+				exposed := slices.ContainsFunc(container.EnvFrom, func(source corev1.EnvFromSource) bool {
+					return source.SecretRef != nil && source.SecretRef.Name == secret.Name
+				})
+				if !exposed {
+					container.EnvFrom = append(container.EnvFrom, corev1.EnvFromSource{
+						SecretRef: &corev1.SecretEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: secret.Name}},
+					})
+				}
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("DGDR Secrets", func() {
+	newDGDR := func(secrets ...nvidiacomv1alpha1.DeploymentSecret) *nvidiacomv1alpha1.DynamoGraphDeploymentRequest {
+		return &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-dgdr-secrets", Namespace: defaultNamespace},
+			Spec:       nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{Secrets: secrets},
+		}
+	}
+
+	newDGD := func() *nvidiacomv1alpha1.DynamoGraphDeployment {
+		return &nvidiacomv1alpha1.DynamoGraphDeployment{
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentSpec{
+				Services: map[string]*nvidiacomv1alpha1.DynamoComponentDeploymentSharedSpec{
+					"Frontend":         {},
+					"VllmDecodeWorker": {},
+				},
+			},
+		}
+	}
+
+	It("Should wire secrets into the services by purpose", func() {
+		dgdr := newDGDR(
+			nvidiacomv1alpha1.DeploymentSecret{Purpose: nvidiacomv1alpha1.SecretPurposeHFToken, Name: "my-hf-token"},
+			nvidiacomv1alpha1.DeploymentSecret{Purpose: nvidiacomv1alpha1.SecretPurposeRegistry, Name: "ngc-pull"},
+			nvidiacomv1alpha1.DeploymentSecret{Purpose: nvidiacomv1alpha1.SecretPurposeS3, Name: "model-bucket", Services: []string{"VllmDecodeWorker"}},
+		)
+		dgd := newDGD()
+
+		Expect(applySecrets(dgdr, dgd)).Should(Succeed())
+		for _, name := range []string{"Frontend", "VllmDecodeWorker"} {
+			spec := dgd.Spec.Services[name]
+			Expect(spec.EnvFromSecret).ShouldNot(BeNil())
+			Expect(*spec.EnvFromSecret).Should(Equal("my-hf-token"))
+			Expect(spec.ExtraPodSpec.ImagePullSecrets).Should(ConsistOf(corev1.LocalObjectReference{Name: "ngc-pull"}))
+		}
+		Expect(dgd.Spec.Services["Frontend"].ExtraPodSpec.MainContainer).Should(BeNil())
+		worker := dgd.Spec.Services["VllmDecodeWorker"].ExtraPodSpec.MainContainer
+		Expect(worker.EnvFrom).Should(HaveLen(1))
+		Expect(worker.EnvFrom[0].SecretRef.Name).Should(Equal("model-bucket"))
+
+		// Applying again does not duplicate references
+		Expect(applySecrets(dgdr, dgd)).Should(Succeed())
+		Expect(dgd.Spec.Services["Frontend"].ExtraPodSpec.ImagePullSecrets).Should(HaveLen(1))
+		Expect(worker.EnvFrom).Should(HaveLen(1))
+	})
+
+	It("Should fail secrets referencing services not in the deployment", func() {
+		dgdr := newDGDR(nvidiacomv1alpha1.DeploymentSecret{Purpose: nvidiacomv1alpha1.SecretPurposeS3, Name: "model-bucket", Services: []string{"Planner"}})
+
+		err := applySecrets(dgdr, newDGD())
+		Expect(err).To(MatchError(`secrets[0] references service "Planner" which is not in the generated deployment`))
+		Expect(failureReasonFromError(err, "")).Should(Equal(nvidiacomv1alpha1.FailureReasonValidationError))
+	})
+
+	It("Should read the Hugging Face token from the hfToken secret", func() {
+		Expect(hfTokenSecretName(newDGDR())).Should(Equal(DefaultHFTokenSecretName))
+		Expect(hfTokenSecretName(newDGDR(
+			nvidiacomv1alpha1.DeploymentSecret{Purpose: nvidiacomv1alpha1.SecretPurposeRegistry, Name: "ngc-pull"},
+			nvidiacomv1alpha1.DeploymentSecret{Purpose: nvidiacomv1alpha1.SecretPurposeHFToken, Name: "my-hf-token"},
+		))).Should(Equal("my-hf-token"))
+	})
+
+	It("Should validate the secrets", func() {
+		Expect(ValidateSecrets(newDGDR(
+			nvidiacomv1alpha1.DeploymentSecret{Purpose: nvidiacomv1alpha1.SecretPurposeHFToken, Name: "my-hf-token"},
+			nvidiacomv1alpha1.DeploymentSecret{Purpose: nvidiacomv1alpha1.SecretPurposeRegistry, Name: "ngc-pull", Services: []string{"Frontend"}},
+		))).Should(BeEmpty())
+
+		errs := ValidateSecrets(newDGDR(
+			nvidiacomv1alpha1.DeploymentSecret{Purpose: nvidiacomv1alpha1.SecretPurposeHFToken, Name: "my-hf-token"},
+			nvidiacomv1alpha1.DeploymentSecret{Purpose: nvidiacomv1alpha1.SecretPurposeHFToken, Name: "Other_Token"},
+			nvidiacomv1alpha1.DeploymentSecret{Purpose: nvidiacomv1alpha1.SecretPurposeS3, Name: "model-bucket", Services: []string{""}},
+		))
+		fields := make([]string, 0, len(errs))
+		for _, err := range errs {
+			fields = append(fields, err.Field)
+		}
+		Expect(fields).Should(ConsistOf("spec.secrets[1].name", "spec.secrets[1].purpose", "spec.secrets[2].services[0]"))
+	})
+})
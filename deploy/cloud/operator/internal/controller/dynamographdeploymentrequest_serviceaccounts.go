@@ -0,0 +1,133 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	dynamoCommon "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/dynamo/common"
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/rbac"
+)
+
+const (
+	// Condition reasons of RBAC setup failures
+	ReasonClusterRoleMissing       = "ClusterRoleMissing"
+	ReasonClusterRoleInsufficient  = "ClusterRoleInsufficient"
+	ReasonClusterRoleNotAggregated = "ClusterRoleNotAggregated"
+	ReasonRBACForbidden            = "RBACForbidden"
+
+	// Validation messages
+	ValidationErrorCreateServiceAccountsNoRole = "deploymentOverrides.createServiceAccounts requires the operator to be configured with --dgdr-worker-cluster-role-name"
+)
+
+// ProfilingJobRequiredRules are the permissions the profiling job ClusterRole must grant: the output
+// sidecar applies the results ConfigMap and watches its own pod, and online profiling deploys test DGDs
+var ProfilingJobRequiredRules = []rbacv1.PolicyRule{
+	{APIGroups: []string{""}, Resources: []string{"configmaps"}, Verbs: []string{"get", "create", "patch"}},
+	{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"get", "list"}},
+	{APIGroups: []string{nvidiacomv1alpha1.GroupVersion.Group}, Resources: []string{"dynamographdeployments"}, Verbs: []string{"get", "create", "delete"}},
+}
+
+// rbacConditionReason returns the condition reason of an RBAC setup error, or fallback if the
+// error is not one the user can act on
+func rbacConditionReason(err error, fallback string) string {
+	switch {
+	case errors.Is(err, rbac.ErrClusterRoleNotFound):
+		return ReasonClusterRoleMissing
+	case errors.Is(err, rbac.ErrClusterRoleInsufficient):
+		return ReasonClusterRoleInsufficient
+	case errors.Is(err, rbac.ErrClusterRoleAggregationEmpty):
+		return ReasonClusterRoleNotAggregated
+	case errors.Is(err, rbac.ErrForbidden):
+		return ReasonRBACForbidden
+	}
+	return fallback
+}
+
+// getServiceAccountOverrides returns the per-service ServiceAccount overrides, if any
+func getServiceAccountOverrides(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) map[string]string {
+	if dgdr.Spec.DeploymentOverrides == nil {
+		return nil
+	}
+	return dgdr.Spec.DeploymentOverrides.ServiceAccountName
+}
+
+// validateServiceAccounts validates the ServiceAccount overrides against the operator configuration
+func (r *DynamoGraphDeploymentRequestReconciler) validateServiceAccounts(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	if dgdr.Spec.DeploymentOverrides == nil || !dgdr.Spec.DeploymentOverrides.CreateServiceAccounts {
+		return nil
+	}
+	if r.Config.RBAC.DGDRWorkerClusterRoleName == "" {
+		return errors.New(ValidationErrorCreateServiceAccountsNoRole)
+	}
+	return nil
+}
+
+// applyServiceAccountOverrides sets the ServiceAccount of the overridden services in the generated DGD
+func applyServiceAccountOverrides(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, dgd *nvidiacomv1alpha1.DynamoGraphDeployment) error {
+	for service, serviceAccount := range getServiceAccountOverrides(dgdr) {
+		spec, exists := dgd.Spec.Services[service]
+		if !exists || spec == nil {
+			return withFailureReason(nvidiacomv1alpha1.FailureReasonValidationError,
+				fmt.Errorf("deploymentOverrides.serviceAccountName references service %q which is not in the generated deployment", service))
+		}
+		if spec.ExtraPodSpec == nil {
+			spec.ExtraPodSpec = &dynamoCommon.ExtraPodSpec{}
+		}
+		if spec.ExtraPodSpec.PodSpec == nil {
+			spec.ExtraPodSpec.PodSpec = &corev1.PodSpec{}
+		}
+		spec.ExtraPodSpec.ServiceAccountName = serviceAccount
+	}
+	return nil
+}
+
+// ensureServiceAccounts provisions the overridden ServiceAccounts in the DGD namespace when requested
+func (r *DynamoGraphDeploymentRequestReconciler) ensureServiceAccounts(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, namespace string) error {
+	if dgdr.Spec.DeploymentOverrides == nil || !dgdr.Spec.DeploymentOverrides.CreateServiceAccounts {
+		return nil
+	}
+	logger := log.FromContext(ctx)
+
+	// Several services may share a ServiceAccount
+	names := map[string]struct{}{}
+	for _, serviceAccount := range getServiceAccountOverrides(dgdr) {
+		names[serviceAccount] = struct{}{}
+	}
+	sorted := make([]string, 0, len(names))
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+
+	for _, name := range sorted {
+		if err := r.RBACManager.EnsureServiceAccountWithRBAC(ctx, namespace, name, r.Config.RBAC.DGDRWorkerClusterRoleName); err != nil {
+			return fmt.Errorf("failed to provision service account %s: %w", name, err)
+		}
+	}
+	logger.Info("Provisioned deployment service accounts", "namespace", namespace, "serviceAccounts", sorted)
+	return nil
+}
@@ -0,0 +1,165 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	commonController "github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/controller_common"
+	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/rbac"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var _ = Describe("DGDR Deployment ServiceAccounts", func() {
+	var (
+		reconciler  *DynamoGraphDeploymentRequestReconciler
+		provisioned []string
+	)
+
+	BeforeEach(func() {
+		provisioned = nil
+		reconciler = &DynamoGraphDeploymentRequestReconciler{
+			Client:   k8sClient,
+			Recorder: record.NewFakeRecorder(100),
+			Config: commonController.Config{
+				RBAC: commonController.RBACConfig{
+					DGDRWorkerClusterRoleName: "test-worker-role",
+				},
+			},
+			RBACManager: &MockRBACManager{
+				EnsureServiceAccountWithRBACFunc: func(_ context.Context, namespace, serviceAccountName, clusterRoleName string) error {
+					Expect(namespace).To(Equal("serving"))
+					Expect(clusterRoleName).To(Equal("test-worker-role"))
+					provisioned = append(provisioned, serviceAccountName)
+					return nil
+				},
+			},
+		}
+	})
+
+	newDGDR := func(create bool) *nvidiacomv1alpha1.DynamoGraphDeploymentRequest {
+		return &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				DeploymentOverrides: &nvidiacomv1alpha1.DeploymentOverridesSpec{
+					ServiceAccountName: map[string]string{
+						"VllmPrefillWorker": "model-reader",
+						"VllmDecodeWorker":  "model-reader",
+					},
+					CreateServiceAccounts: create,
+				},
+			},
+		}
+	}
+
+	It("Should set the ServiceAccount of overridden services", func() {
+		dgd := &nvidiacomv1alpha1.DynamoGraphDeployment{
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentSpec{
+				Services: map[string]*nvidiacomv1alpha1.DynamoComponentDeploymentSharedSpec{
+					"Frontend":          {},
+					"VllmPrefillWorker": {},
+					"VllmDecodeWorker":  {},
+				},
+			},
+		}
+
+		Expect(applyServiceAccountOverrides(newDGDR(false), dgd)).Should(Succeed())
+		Expect(dgd.Spec.Services["VllmPrefillWorker"].ExtraPodSpec.ServiceAccountName).To(Equal("model-reader"))
+		Expect(dgd.Spec.Services["VllmDecodeWorker"].ExtraPodSpec.ServiceAccountName).To(Equal("model-reader"))
+		Expect(dgd.Spec.Services["Frontend"].ExtraPodSpec).To(BeNil())
+	})
+
+	It("Should reject overrides for unknown services", func() {
+		dgd := &nvidiacomv1alpha1.DynamoGraphDeployment{
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentSpec{
+				Services: map[string]*nvidiacomv1alpha1.DynamoComponentDeploymentSharedSpec{
+					"VllmPrefillWorker": {},
+				},
+			},
+		}
+
+		err := applyServiceAccountOverrides(newDGDR(false), dgd)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(`"VllmDecodeWorker"`))
+		Expect(failureReasonFromError(err, nvidiacomv1alpha1.FailureReasonSpecParseError)).To(Equal(nvidiacomv1alpha1.FailureReasonValidationError))
+	})
+
+	It("Should provision each ServiceAccount once when requested", func() {
+		Expect(reconciler.ensureServiceAccounts(context.Background(), newDGDR(true), "serving")).Should(Succeed())
+		Expect(provisioned).To(Equal([]string{"model-reader"}))
+
+		provisioned = nil
+		Expect(reconciler.ensureServiceAccounts(context.Background(), newDGDR(false), "serving")).Should(Succeed())
+		Expect(provisioned).To(BeEmpty())
+	})
+
+	It("Should require a worker ClusterRole to provision ServiceAccounts", func() {
+		reconciler.Config.RBAC.DGDRWorkerClusterRoleName = ""
+		Expect(reconciler.validateServiceAccounts(newDGDR(true))).To(MatchError(ValidationErrorCreateServiceAccountsNoRole))
+		Expect(reconciler.validateServiceAccounts(newDGDR(false))).Should(Succeed())
+	})
+})
+
+var _ = Describe("DGDR RBAC Failures", func() {
+	It("Should report a missing ClusterRole in the Profiling condition", func() {
+		ctx := context.Background()
+		reconciler := &DynamoGraphDeploymentRequestReconciler{
+			Client:   k8sClient,
+			Recorder: record.NewFakeRecorder(100),
+			RBACManager: &MockRBACManager{
+				EnsureServiceAccountWithRBACFunc: func(_ context.Context, _, _, clusterRoleName string) error {
+					return fmt.Errorf("%w: cluster role %q does not exist", rbac.ErrClusterRoleNotFound, clusterRoleName)
+				},
+			},
+		}
+
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-dgdr-rbac-missing", Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model:   "test-model",
+				Backend: BackendVLLM,
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					ProfilerImage: "test-profiler:latest",
+					Config:        createTestConfig(map[string]interface{}{"sla": map[string]interface{}{"ttft": 200.0}}),
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, dgdr) }()
+		dgdr.Status.State = StatePending
+		Expect(k8sClient.Status().Update(ctx, dgdr)).Should(Succeed())
+
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: dgdr.Name, Namespace: defaultNamespace}})
+		Expect(err).NotTo(HaveOccurred())
+
+		updated := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: dgdr.Name, Namespace: defaultNamespace}, updated)).Should(Succeed())
+		Expect(updated.Status.State).Should(Equal(StateFailed))
+		condition := meta.FindStatusCondition(updated.Status.Conditions, ConditionTypeProfiling)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Reason).Should(Equal(ReasonClusterRoleMissing))
+		Expect(condition.Message).Should(ContainSubstring("does not exist"))
+	})
+})
@@ -0,0 +1,158 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	dynamoCommon "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/dynamo/common"
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+)
+
+// ServiceMesh is the service mesh whose sidecar the operator injects into generated deployments
+type ServiceMesh string
+
+const (
+	// ServiceMeshNone leaves sidecar injection to the namespace and the DGDR's pod annotations
+	ServiceMeshNone ServiceMesh = "none"
+	// ServiceMeshIstio injects the Istio sidecar into generated deployments
+	ServiceMeshIstio ServiceMesh = "istio"
+	// ServiceMeshLinkerd injects the Linkerd proxy into generated deployments
+	ServiceMeshLinkerd ServiceMesh = "linkerd"
+
+	// Sidecar injection annotations of the supported meshes
+	AnnotationIstioInject   = "sidecar.istio.io/inject"
+	AnnotationLinkerdInject = "linkerd.io/inject"
+
+	// Validation messages
+	ValidationErrorMeshInjectionValue    = "must be one of %q"
+	ValidationErrorMeshInjectionConflict = "enables the injection of both Istio and Linkerd sidecars"
+)
+
+// meshInjection describes the injection annotation of a mesh and its values
+type meshInjection struct {
+	annotation string
+	enabled    string
+	disabled   string
+}
+
+// meshInjections are the injection annotations of the supported meshes
+var meshInjections = map[ServiceMesh]meshInjection{
+	ServiceMeshIstio:   {annotation: AnnotationIstioInject, enabled: "true", disabled: "false"},
+	ServiceMeshLinkerd: {annotation: AnnotationLinkerdInject, enabled: "enabled", disabled: "disabled"},
+}
+
+// ParseServiceMesh validates the value of the service mesh flag
+func ParseServiceMesh(value string) (ServiceMesh, error) {
+	switch mesh := ServiceMesh(value); mesh {
+	case ServiceMeshNone, ServiceMeshIstio, ServiceMeshLinkerd:
+		return mesh, nil
+	default:
+		return "", fmt.Errorf("unknown service mesh %q, must be none, istio or linkerd", value)
+	}
+}
+
+// getPodAnnotations returns the pod annotations of the generated deployment, if any
+func getPodAnnotations(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) map[string]string {
+	if dgdr.Spec.DeploymentOverrides == nil {
+		return nil
+	}
+	return dgdr.Spec.DeploymentOverrides.PodAnnotations
+}
+
+// deploymentPodAnnotations returns the annotations set on the pods of the generated deployment: the
+// injection default of the operator's mesh overlaid with the DGDR's pod annotations
+func (r *DynamoGraphDeploymentRequestReconciler) deploymentPodAnnotations(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) map[string]string {
+	annotations := map[string]string{}
+	if injection, exists := meshInjections[r.ServiceMesh]; exists {
+		annotations[injection.annotation] = injection.enabled
+	}
+	maps.Copy(annotations, getPodAnnotations(dgdr))
+	return annotations
+}
+
+// validatePodAnnotations validates the DGDR's pod annotations and rejects injection settings that
+// would put the generated deployment in two meshes
+func (r *DynamoGraphDeploymentRequestReconciler) validatePodAnnotations(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	podAnnotations := getPodAnnotations(dgdr)
+	if len(podAnnotations) == 0 {
+		return nil
+	}
+	path := field.NewPath("spec", "deploymentOverrides", "podAnnotations")
+	errs := apivalidation.ValidateAnnotations(podAnnotations, path)
+
+	annotations := r.deploymentPodAnnotations(dgdr)
+	var enabled []string
+	for _, mesh := range slices.Sorted(maps.Keys(meshInjections)) {
+		injection := meshInjections[mesh]
+		value, exists := annotations[injection.annotation]
+		if !exists {
+			continue
+		}
+		if value != injection.enabled && value != injection.disabled {
+			errs = append(errs, field.NotSupported(path.Key(injection.annotation), value, []string{injection.enabled, injection.disabled}))
+			continue
+		}
+		if value == injection.enabled {
+			enabled = append(enabled, injection.annotation)
+		}
+	}
+	if len(enabled) > 1 {
+		errs = append(errs, field.Invalid(path, enabled, ValidationErrorMeshInjectionConflict))
+	}
+	if len(errs) > 0 {
+		return errs.ToAggregate()
+	}
+	return nil
+}
+
+// applyDeploymentPodAnnotations sets the pod annotations on every service of the generated
+// deployment, replacing the values of the profiled services
+func (r *DynamoGraphDeploymentRequestReconciler) applyDeploymentPodAnnotations(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, dgd *nvidiacomv1alpha1.DynamoGraphDeployment) {
+	annotations := r.deploymentPodAnnotations(dgdr)
+	if len(annotations) == 0 {
+		return
+	}
+	for _, spec := range dgd.Spec.Services {
+		if spec == nil {
+			continue
+		}
+		if spec.ExtraPodMetadata == nil {
+			spec.ExtraPodMetadata = &dynamoCommon.ExtraPodMetadata{}
+		}
+		if spec.ExtraPodMetadata.Annotations == nil {
+			spec.ExtraPodMetadata.Annotations = map[string]string{}
+		}
+		maps.Copy(spec.ExtraPodMetadata.Annotations, annotations)
+	}
+}
+
+// jobPodAnnotations returns the annotations of profiling job pods, which opt out of the operator's
+// mesh: a sidecar that never exits keeps the job from completing
+func (r *DynamoGraphDeploymentRequestReconciler) jobPodAnnotations() map[string]string {
+	injection, exists := meshInjections[r.ServiceMesh]
+	if !exists {
+		return nil
+	}
+	return map[string]string{injection.annotation: injection.disabled}
+}
@@ -0,0 +1,132 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+
+	dynamoCommon "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/dynamo/common"
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+)
+
+var _ = Describe("DGDR Service Mesh", func() {
+	var reconciler *DynamoGraphDeploymentRequestReconciler
+
+	BeforeEach(func() {
+		reconciler = &DynamoGraphDeploymentRequestReconciler{
+			Client:      k8sClient,
+			Recorder:    record.NewFakeRecorder(100),
+			RBACManager: &MockRBACManager{},
+			ServiceMesh: ServiceMeshIstio,
+		}
+	})
+
+	newDGDR := func(name string, podAnnotations map[string]string) *nvidiacomv1alpha1.DynamoGraphDeploymentRequest {
+		return &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model:   "Qwen/Qwen3-0.6B",
+				Backend: BackendVLLM,
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					ProfilerImage: "test-profiler:latest",
+					Config: createTestConfig(map[string]interface{}{
+						"sla": map[string]interface{}{"ttft": 200.0, "itl": 20.0},
+					}),
+				},
+				DeploymentOverrides: &nvidiacomv1alpha1.DeploymentOverridesSpec{PodAnnotations: podAnnotations},
+			},
+		}
+	}
+
+	It("Should parse the service mesh flag", func() {
+		mesh, err := ParseServiceMesh("linkerd")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(mesh).Should(Equal(ServiceMeshLinkerd))
+		_, err = ParseServiceMesh("consul")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("Should annotate the pods of the generated deployment", func() {
+		dgdr := newDGDR("test-dgdr-mesh-apply", map[string]string{"prometheus.io/scrape": "true"})
+		dgd := &nvidiacomv1alpha1.DynamoGraphDeployment{
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentSpec{
+				Services: map[string]*nvidiacomv1alpha1.DynamoComponentDeploymentSharedSpec{
+					"Frontend": {},
+					"VllmDecodeWorker": {
+						ExtraPodMetadata: &dynamoCommon.ExtraPodMetadata{Annotations: map[string]string{"profiled": "kept"}},
+					},
+				},
+			},
+		}
+
+		Expect(reconciler.validatePodAnnotations(dgdr)).Should(Succeed())
+		reconciler.applyDeploymentPodAnnotations(dgdr, dgd)
+		Expect(dgd.Spec.Services["Frontend"].ExtraPodMetadata.Annotations).Should(Equal(map[string]string{
+			AnnotationIstioInject:  "true",
+			"prometheus.io/scrape": "true",
+		}))
+		Expect(dgd.Spec.Services["VllmDecodeWorker"].ExtraPodMetadata.Annotations).Should(Equal(map[string]string{
+			AnnotationIstioInject:  "true",
+			"prometheus.io/scrape": "true",
+			"profiled":             "kept",
+		}))
+
+		// The DGDR wins over the operator default
+		dgdr.Spec.DeploymentOverrides.PodAnnotations[AnnotationIstioInject] = "false"
+		reconciler.applyDeploymentPodAnnotations(dgdr, dgd)
+		Expect(dgd.Spec.Services["Frontend"].ExtraPodMetadata.Annotations[AnnotationIstioInject]).Should(Equal("false"))
+	})
+
+	It("Should reject conflicting pod annotations", func() {
+		err := reconciler.validatePodAnnotations(newDGDR("test-dgdr-mesh-conflict", map[string]string{AnnotationLinkerdInject: "enabled"}))
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).Should(ContainSubstring(ValidationErrorMeshInjectionConflict))
+
+		// Opting out of the default mesh makes room for another one
+		Expect(reconciler.validatePodAnnotations(newDGDR("test-dgdr-mesh-conflict", map[string]string{
+			AnnotationIstioInject:   "false",
+			AnnotationLinkerdInject: "enabled",
+		}))).Should(Succeed())
+
+		err = reconciler.validatePodAnnotations(newDGDR("test-dgdr-mesh-conflict", map[string]string{AnnotationIstioInject: "yes"}))
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).Should(ContainSubstring(AnnotationIstioInject))
+
+		err = reconciler.validatePodAnnotations(newDGDR("test-dgdr-mesh-conflict", map[string]string{"not a key": "value"}))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("Should keep profiling jobs out of the mesh", func() {
+		ctx := context.Background()
+		dgdr := newDGDR("test-dgdr-mesh-job", map[string]string{"prometheus.io/scrape": "true"})
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, dgdr) }()
+
+		Expect(reconciler.createProfilingJob(ctx, dgdr)).Should(Succeed())
+		job := &batchv1.Job{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: GetProfilingJobName(dgdr), Namespace: defaultNamespace}, job)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, job) }()
+		Expect(job.Spec.Template.Annotations).Should(Equal(map[string]string{AnnotationIstioInject: "false"}))
+	})
+})
@@ -0,0 +1,300 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	dynamoCommon "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/dynamo/common"
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/registry"
+)
+
+const (
+	// ConditionTypeOverridesApplied reports whether the service overrides agree with the profiled deployment
+	ConditionTypeOverridesApplied = "OverridesApplied"
+
+	// Condition reasons of the OverridesApplied condition
+	ReasonOverridesApplied = "OverridesApplied"
+	ReasonOverrideConflict = "OverrideConflict"
+
+	// EventReasonOverrideConflict is emitted when a service override contradicts the profiled deployment
+	EventReasonOverrideConflict = "OverrideConflict"
+
+	// Conflict messages
+	MessageOverrideFewerGPUs = "services[%s] requests %s GPUs but the profiled configuration of the service needs %s"
+)
+
+// ValidateServiceOverrides checks the typed service overrides of a DGDR. Whether the overridden
+// services exist is only known once the deployment has been generated.
+func ValidateServiceOverrides(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) field.ErrorList {
+	if dgdr.Spec.DeploymentOverrides == nil {
+		return nil
+	}
+	var allErrs field.ErrorList
+	servicesPath := field.NewPath("spec", "deploymentOverrides", "services")
+	for _, service := range sortedServiceOverrides(dgdr) {
+		override := dgdr.Spec.DeploymentOverrides.Services[service]
+		path := servicesPath.Key(service)
+		if service == "" {
+			allErrs = append(allErrs, field.Invalid(path, service, "service name must not be empty"))
+		}
+		if override.Replicas != nil && *override.Replicas < 0 {
+			allErrs = append(allErrs, field.Invalid(path.Child("replicas"), *override.Replicas, "must be greater than or equal to 0"))
+		}
+		if override.Resources != nil {
+			allErrs = append(allErrs, validateResourceItem(path.Child("resources", "requests"), override.Resources.Requests)...)
+			allErrs = append(allErrs, validateResourceItem(path.Child("resources", "limits"), override.Resources.Limits)...)
+		}
+		names := map[string]bool{}
+		for i, env := range override.Env {
+			envPath := path.Child("env").Index(i).Child("name")
+			for _, msg := range validation.IsEnvVarName(env.Name) {
+				allErrs = append(allErrs, field.Invalid(envPath, env.Name, msg))
+			}
+			if names[env.Name] {
+				allErrs = append(allErrs, field.Duplicate(envPath, env.Name))
+			}
+			names[env.Name] = true
+		}
+		if override.Image != "" {
+			if strings.ContainsAny(override.Image, " \t\n") {
+				allErrs = append(allErrs, field.Invalid(path.Child("image"), override.Image, "image reference must not contain whitespace"))
+			} else if _, err := registry.ParseReference(override.Image); err != nil {
+				allErrs = append(allErrs, field.Invalid(path.Child("image"), override.Image, err.Error()))
+			}
+		}
+		for i, arg := range override.ExtraArgs {
+			if strings.TrimSpace(arg) == "" {
+				allErrs = append(allErrs, field.Invalid(path.Child("extraArgs").Index(i), arg, "must not be empty"))
+			}
+		}
+	}
+	return allErrs
+}
+
+// validateResourceItem checks that every quantity of a resource override parses
+func validateResourceItem(path *field.Path, item *dynamoCommon.ResourceItem) field.ErrorList {
+	if item == nil {
+		return nil
+	}
+	var allErrs field.ErrorList
+	check := func(name, value string) {
+		if value == "" {
+			return
+		}
+		if _, err := resource.ParseQuantity(value); err != nil {
+			allErrs = append(allErrs, field.Invalid(path.Child(name), value, err.Error()))
+		}
+	}
+	check("cpu", item.CPU)
+	check("memory", item.Memory)
+	check("gpu", item.GPU)
+	keys := make([]string, 0, len(item.Custom))
+	for key := range item.Custom {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if _, err := resource.ParseQuantity(item.Custom[key]); err != nil {
+			allErrs = append(allErrs, field.Invalid(path.Child("custom").Key(key), item.Custom[key], err.Error()))
+		}
+	}
+	return allErrs
+}
+
+// sortedServiceOverrides returns the names of the overridden services in a stable order
+func sortedServiceOverrides(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) []string {
+	if dgdr.Spec.DeploymentOverrides == nil {
+		return nil
+	}
+	services := make([]string, 0, len(dgdr.Spec.DeploymentOverrides.Services))
+	for service := range dgdr.Spec.DeploymentOverrides.Services {
+		services = append(services, service)
+	}
+	sort.Strings(services)
+	return services
+}
+
+// applyServiceOverrides deep merges the typed service overrides into the generated DGD and emits
+// an event for every override that contradicts the profiled requirements
+func (r *DynamoGraphDeploymentRequestReconciler) applyServiceOverrides(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, dgd *nvidiacomv1alpha1.DynamoGraphDeployment) error {
+	conflicts, err := mergeServiceOverrides(dgdr, dgd)
+	if err != nil {
+		return err
+	}
+	for _, conflict := range conflicts {
+		r.Recorder.Event(dgdr, corev1.EventTypeWarning, EventReasonOverrideConflict, conflict)
+	}
+	return nil
+}
+
+// mergeServiceOverrides merges the service overrides into the DGD and reports overrides that
+// contradict the profiled requirements in the OverridesApplied condition
+func mergeServiceOverrides(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, dgd *nvidiacomv1alpha1.DynamoGraphDeployment) ([]string, error) {
+	services := sortedServiceOverrides(dgdr)
+	if len(services) == 0 {
+		meta.RemoveStatusCondition(&dgdr.Status.Conditions, ConditionTypeOverridesApplied)
+		return nil, nil
+	}
+
+	var conflicts []string
+	for _, service := range services {
+		spec, exists := dgd.Spec.Services[service]
+		if !exists || spec == nil {
+			return nil, withFailureReason(nvidiacomv1alpha1.FailureReasonValidationError,
+				fmt.Errorf("deploymentOverrides.services references service %q which is not in the generated deployment", service))
+		}
+		override := dgdr.Spec.DeploymentOverrides.Services[service]
+		if conflict := gpuOverrideConflict(service, spec.Resources, override.Resources); conflict != "" {
+			conflicts = append(conflicts, conflict)
+		}
+		mergeServiceOverride(spec, override)
+	}
+
+	condition := metav1.Condition{
+		Type:    ConditionTypeOverridesApplied,
+		Status:  metav1.ConditionTrue,
+		Reason:  ReasonOverridesApplied,
+		Message: fmt.Sprintf("Applied overrides to services %s", strings.Join(services, ", ")),
+	}
+	if len(conflicts) > 0 {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = ReasonOverrideConflict
+		condition.Message = strings.Join(conflicts, "; ")
+	}
+	meta.SetStatusCondition(&dgdr.Status.Conditions, condition)
+	return conflicts, nil
+}
+
+// mergeServiceOverride merges one service override into the service spec
+func mergeServiceOverride(spec *nvidiacomv1alpha1.DynamoComponentDeploymentSharedSpec, override nvidiacomv1alpha1.ServiceOverride) {
+	if override.Replicas != nil {
+		replicas := *override.Replicas
+		spec.Replicas = &replicas
+	}
+	if override.Resources != nil {
+		if spec.Resources == nil {
+			spec.Resources = &dynamoCommon.Resources{}
+		}
+		spec.Resources.Requests = mergeResourceItem(spec.Resources.Requests, override.Resources.Requests)
+		spec.Resources.Limits = mergeResourceItem(spec.Resources.Limits, override.Resources.Limits)
+		if len(override.Resources.Claims) > 0 {
+			spec.Resources.Claims = append([]corev1.ResourceClaim{}, override.Resources.Claims...)
+		}
+	}
+	for _, env := range override.Env {
+		replaced := false
+		for i := range spec.Envs {
+			if spec.Envs[i].Name == env.Name {
+				spec.Envs[i] = env
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			spec.Envs = append(spec.Envs, env)
+		}
+	}
+	if override.Image == "" && len(override.ExtraArgs) == 0 {
+		return
+	}
+	if spec.ExtraPodSpec == nil {
+		spec.ExtraPodSpec = &dynamoCommon.ExtraPodSpec{}
+	}
+	if spec.ExtraPodSpec.MainContainer == nil {
+		spec.ExtraPodSpec.MainContainer = &corev1.Container{}
+	}
+	if override.Image != "" {
+		spec.ExtraPodSpec.MainContainer.Image = override.Image
+	}
+	spec.ExtraPodSpec.MainContainer.Args = append(spec.ExtraPodSpec.MainContainer.Args, override.ExtraArgs...)
+}
+
+// mergeResourceItem returns base with every quantity set in override replaced
+func mergeResourceItem(base, override *dynamoCommon.ResourceItem) *dynamoCommon.ResourceItem {
+	if override == nil {
+		return base
+	}
+	merged := &dynamoCommon.ResourceItem{}
+	if base != nil {
+		*merged = *base
+		merged.Custom = nil
+		for key, value := range base.Custom {
+			if merged.Custom == nil {
+				merged.Custom = map[string]string{}
+			}
+			merged.Custom[key] = value
+		}
+	}
+	if override.CPU != "" {
+		merged.CPU = override.CPU
+	}
+	if override.Memory != "" {
+		merged.Memory = override.Memory
+	}
+	if override.GPU != "" {
+		merged.GPU = override.GPU
+	}
+	for key, value := range override.Custom {
+		if merged.Custom == nil {
+			merged.Custom = map[string]string{}
+		}
+		merged.Custom[key] = value
+	}
+	return merged
+}
+
+// gpuOverrideConflict returns a conflict message if the override gives the service fewer GPUs than
+// the profiled configuration, whose parallelism is sized to the profiled GPU count
+func gpuOverrideConflict(service string, profiled, override *dynamoCommon.Resources) string {
+	if override == nil {
+		return ""
+	}
+	profiledGPUs := serviceGPUs(profiled)
+	overrideGPUs := serviceGPUs(override)
+	if profiledGPUs == nil || overrideGPUs == nil || overrideGPUs.Cmp(*profiledGPUs) >= 0 {
+		return ""
+	}
+	return fmt.Sprintf(MessageOverrideFewerGPUs, service, overrideGPUs.String(), profiledGPUs.String())
+}
+
+// serviceGPUs returns the GPU count of a service, preferring limits over requests
+func serviceGPUs(resources *dynamoCommon.Resources) *resource.Quantity {
+	if resources == nil {
+		return nil
+	}
+	for _, item := range []*dynamoCommon.ResourceItem{resources.Limits, resources.Requests} {
+		if item == nil || item.GPU == "" {
+			continue
+		}
+		if quantity, err := resource.ParseQuantity(item.GPU); err == nil {
+			return &quantity
+		}
+	}
+	return nil
+}
@@ -0,0 +1,170 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+
+	dynamoCommon "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/dynamo/common"
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/yaml"
+)
+
+var _ = Describe("DGDR Service Overrides", func() {
+	var reconciler *DynamoGraphDeploymentRequestReconciler
+
+	BeforeEach(func() {
+		reconciler = &DynamoGraphDeploymentRequestReconciler{
+			Client:       k8sClient,
+			Recorder:     record.NewFakeRecorder(100),
+			RBACManager:  &MockRBACManager{},
+			ProfilerMode: ProfilerModeMock,
+		}
+	})
+
+	newDGDR := func(name string, services map[string]nvidiacomv1alpha1.ServiceOverride) *nvidiacomv1alpha1.DynamoGraphDeploymentRequest {
+		return &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model:   "Qwen/Qwen3-0.6B",
+				Backend: BackendVLLM,
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					ProfilerImage: "test-profiler:latest",
+					Config: createTestConfig(map[string]interface{}{
+						"sla":   map[string]interface{}{"ttft": 200.0, "itl": 20.0},
+						"sweep": map[string]interface{}{"use_ai_configurator": true},
+					}),
+				},
+				DeploymentOverrides: &nvidiacomv1alpha1.DeploymentOverridesSpec{Services: services},
+			},
+		}
+	}
+
+	newDGD := func() *nvidiacomv1alpha1.DynamoGraphDeployment {
+		return &nvidiacomv1alpha1.DynamoGraphDeployment{
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentSpec{
+				Services: map[string]*nvidiacomv1alpha1.DynamoComponentDeploymentSharedSpec{
+					"VllmDecodeWorker": {
+						Replicas: ptr.To(int32(1)),
+						Resources: &dynamoCommon.Resources{
+							Limits: &dynamoCommon.ResourceItem{GPU: "4", Memory: "64Gi"},
+						},
+						Envs: []corev1.EnvVar{{Name: "DYN_LOG", Value: "info"}, {Name: "HF_HOME", Value: "/cache"}},
+						ExtraPodSpec: &dynamoCommon.ExtraPodSpec{
+							MainContainer: &corev1.Container{Image: "vllm-runtime:0.6.0", Args: []string{"--model", "Qwen/Qwen3-0.6B"}},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	It("Should deep merge the overrides into the generated services", func() {
+		dgdr := newDGDR("test-dgdr-overrides", map[string]nvidiacomv1alpha1.ServiceOverride{
+			"VllmDecodeWorker": {
+				Replicas:  ptr.To(int32(3)),
+				Resources: &dynamoCommon.Resources{Limits: &dynamoCommon.ResourceItem{Memory: "80Gi"}},
+				Env:       []corev1.EnvVar{{Name: "DYN_LOG", Value: "debug"}, {Name: "VLLM_ATTENTION_BACKEND", Value: "FLASHINFER"}},
+				Image:     "vllm-runtime:0.6.1",
+				ExtraArgs: []string{"--enforce-eager"},
+			},
+		})
+		dgd := newDGD()
+
+		conflicts, err := mergeServiceOverrides(dgdr, dgd)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(conflicts).To(BeEmpty())
+
+		worker := dgd.Spec.Services["VllmDecodeWorker"]
+		Expect(*worker.Replicas).Should(Equal(int32(3)))
+		Expect(worker.Resources.Limits).Should(Equal(&dynamoCommon.ResourceItem{GPU: "4", Memory: "80Gi"}))
+		Expect(worker.Envs).Should(Equal([]corev1.EnvVar{
+			{Name: "DYN_LOG", Value: "debug"},
+			{Name: "HF_HOME", Value: "/cache"},
+			{Name: "VLLM_ATTENTION_BACKEND", Value: "FLASHINFER"},
+		}))
+		Expect(worker.ExtraPodSpec.MainContainer.Image).Should(Equal("vllm-runtime:0.6.1"))
+		Expect(worker.ExtraPodSpec.MainContainer.Args).Should(Equal([]string{"--model", "Qwen/Qwen3-0.6B", "--enforce-eager"}))
+
+		condition := meta.FindStatusCondition(dgdr.Status.Conditions, ConditionTypeOverridesApplied)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).Should(Equal(metav1.ConditionTrue))
+	})
+
+	It("Should report overrides that give a service fewer GPUs than profiled", func() {
+		dgdr := newDGDR("test-dgdr-overrides-conflict", map[string]nvidiacomv1alpha1.ServiceOverride{
+			"VllmDecodeWorker": {Resources: &dynamoCommon.Resources{Limits: &dynamoCommon.ResourceItem{GPU: "2"}}},
+		})
+		dgd := newDGD()
+
+		Expect(reconciler.applyServiceOverrides(dgdr, dgd)).Should(Succeed())
+		Expect(dgd.Spec.Services["VllmDecodeWorker"].Resources.Limits.GPU).Should(Equal("2"))
+
+		condition := meta.FindStatusCondition(dgdr.Status.Conditions, ConditionTypeOverridesApplied)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).Should(Equal(metav1.ConditionFalse))
+		Expect(condition.Reason).Should(Equal(ReasonOverrideConflict))
+		Expect(condition.Message).Should(ContainSubstring("services[VllmDecodeWorker] requests 2 GPUs but the profiled configuration of the service needs 4"))
+		Expect(reconciler.Recorder.(*record.FakeRecorder).Events).Should(Receive(ContainSubstring(EventReasonOverrideConflict)))
+	})
+
+	It("Should reject overrides of services that are not in the generated deployment", func() {
+		dgdr := newDGDR("test-dgdr-overrides-missing", map[string]nvidiacomv1alpha1.ServiceOverride{
+			"decode": {Replicas: ptr.To(int32(2))},
+		})
+		_, err := mergeServiceOverrides(dgdr, newDGD())
+		Expect(err).To(MatchError(ContainSubstring(`deploymentOverrides.services references service "decode"`)))
+		Expect(failureReasonFromError(err, "")).Should(Equal(nvidiacomv1alpha1.FailureReasonValidationError))
+	})
+
+	It("Should apply the overrides to the generated deployment", func() {
+		ctx := context.Background()
+		dgdr := newDGDR("test-dgdr-overrides-mock", map[string]nvidiacomv1alpha1.ServiceOverride{
+			"VllmDecodeWorker": {Replicas: ptr.To(int32(2)), ExtraArgs: []string{"--enforce-eager"}},
+		})
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, dgdr) }()
+
+		request := reconcile.Request{NamespacedName: types.NamespacedName{Name: dgdr.Name, Namespace: defaultNamespace}}
+		for range 3 {
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		updated := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{}
+		Expect(k8sClient.Get(ctx, request.NamespacedName, updated)).Should(Succeed())
+		Expect(updated.Status.State).Should(Equal(StateReady))
+		Expect(meta.IsStatusConditionTrue(updated.Status.Conditions, ConditionTypeOverridesApplied)).To(BeTrue())
+
+		dgd := &nvidiacomv1alpha1.DynamoGraphDeployment{}
+		Expect(yaml.Unmarshal(updated.Status.GeneratedDeployment.Raw, dgd)).Should(Succeed())
+		worker := dgd.Spec.Services["VllmDecodeWorker"]
+		Expect(*worker.Replicas).Should(Equal(int32(2)))
+		Expect(worker.ExtraPodSpec.MainContainer.Args).Should(Equal([]string{"--model", "Qwen/Qwen3-0.6B", "--enforce-eager"}))
+		_ = k8sClient.Delete(ctx, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: GetOutputConfigMapName(dgdr), Namespace: defaultNamespace}})
+	})
+})
@@ -0,0 +1,337 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+)
+
+const (
+	// ShardAssignmentOrdinal takes the shard of a replica from the ordinal suffix of its pod name,
+	// as given to StatefulSet pods
+	ShardAssignmentOrdinal = "ordinal"
+	// ShardAssignmentLease has replicas claim a free shard by acquiring its Lease, replicas beyond
+	// the shard count stand by until a shard is released
+	ShardAssignmentLease = "lease"
+
+	// DefaultShardLeaseDuration is how long a shard Lease is held without being renewed
+	DefaultShardLeaseDuration = 15 * time.Second
+)
+
+var (
+	// shardLeaseRetryPeriod is how often shard Leases are renewed or, while none is held, claimed
+	shardLeaseRetryPeriod = 5 * time.Second
+	// shardLeaseClockSkew is the margin left for clock skew between replicas and the time a
+	// renewal spends in flight
+	shardLeaseClockSkew = 2 * time.Second
+)
+
+// ShardFor returns the shard of the DGDR namespace/name among count shards
+func ShardFor(namespace, name string, count int) int {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(namespace + "/" + name))
+	return int(h.Sum32() % uint32(count))
+}
+
+// ParseShardAssignment validates the value of the shard assignment flag
+func ParseShardAssignment(value string) (string, error) {
+	switch value {
+	case ShardAssignmentOrdinal, ShardAssignmentLease:
+		return value, nil
+	default:
+		return "", fmt.Errorf("unknown shard assignment %q, must be one of ordinal, lease", value)
+	}
+}
+
+// Sharder splits DGDRs into disjoint shards by the hash of their namespace/name, so several
+// active operator replicas can process them side by side. Every replica processes the DGDRs of the
+// shard it is assigned, either fixed by its pod ordinal or claimed with a Lease; a replica
+// without a shard processes none. Resources shared by all DGDRs are still managed by the leader.
+type Sharder struct {
+	Client client.Client
+
+	// APIReader reads the shard Leases uncached
+	APIReader client.Reader
+
+	// Count is the number of shards
+	Count int
+
+	// Assignment is how the replica gets its shard, ShardAssignmentOrdinal or ShardAssignmentLease
+	Assignment string
+
+	// Identity is the pod name of the replica, holder of its shard Lease
+	Identity string
+
+	// LeaseNamespace and LeasePrefix name the Leases of the shards, <LeasePrefix>-<shard>
+	LeaseNamespace string
+	LeasePrefix    string
+
+	// LeaseDuration is how long a shard Lease is held without being renewed
+	LeaseDuration time.Duration
+
+	mu    sync.RWMutex
+	shard int
+	// renewed is when the last successful renewal of the held shard Lease was started
+	renewed time.Time
+
+	// assigned receives the DGDRs to reconcile once the replica is assigned a shard
+	assigned chan event.GenericEvent
+}
+
+// NewSharder returns the Sharder of a replica. With ordinal assignment the shard is parsed from
+// the identity right away, with lease assignment it is claimed once the Sharder is started.
+func NewSharder(c client.Client, apiReader client.Reader, count int, assignment, identity string) (*Sharder, error) {
+	if count < 1 {
+		return nil, fmt.Errorf("shard count must be positive, got %d", count)
+	}
+	s := &Sharder{
+		Client:        c,
+		APIReader:     apiReader,
+		Count:         count,
+		Assignment:    assignment,
+		Identity:      identity,
+		LeasePrefix:   "dgdr-shard",
+		LeaseDuration: DefaultShardLeaseDuration,
+		shard:         -1,
+		assigned:      make(chan event.GenericEvent),
+	}
+	if assignment == ShardAssignmentOrdinal {
+		separator := strings.LastIndex(identity, "-")
+		ordinal, err := strconv.Atoi(identity[separator+1:])
+		if separator < 0 || err != nil {
+			return nil, fmt.Errorf("pod name %q has no ordinal suffix to take the shard from", identity)
+		}
+		if ordinal >= count {
+			return nil, fmt.Errorf("pod ordinal %d is out of range for %d shards", ordinal, count)
+		}
+		s.shard = ordinal
+	}
+	return s, nil
+}
+
+// Shard returns the shard of the replica, -1 while it has none
+func (s *Sharder) Shard() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.shard
+}
+
+// Owns reports whether the DGDR namespace/name belongs to the shard of the replica. A nil
+// Sharder owns every DGDR.
+func (s *Sharder) Owns(namespace, name string) bool {
+	if s == nil {
+		return true
+	}
+	shard := s.Shard()
+	return shard >= 0 && ShardFor(namespace, name, s.Count) == shard
+}
+
+// Source enqueues the DGDRs of the shard the replica is assigned after it started
+func (s *Sharder) Source() source.Source {
+	return source.Channel(s.assigned, &handler.EnqueueRequestForObject{})
+}
+
+// NeedLeaderElection lets every replica claim a shard
+func (s *Sharder) NeedLeaderElection() bool {
+	return false
+}
+
+// Start claims and renews a shard Lease until the context is done, then releases it
+func (s *Sharder) Start(ctx context.Context) error {
+	if s.Assignment != ShardAssignmentLease {
+		return nil
+	}
+	logger := log.FromContext(ctx).WithValues("identity", s.Identity)
+	ticker := time.NewTicker(shardLeaseRetryPeriod)
+	defer ticker.Stop()
+	for {
+		s.reconcileLease(ctx)
+		select {
+		case <-ctx.Done():
+			if shard := s.Shard(); shard >= 0 {
+				// Released so a standby replica takes over without waiting for the Lease to expire
+				releaseCtx, cancel := context.WithTimeout(context.Background(), shardLeaseRetryPeriod)
+				if err := s.release(releaseCtx, shard); err != nil {
+					logger.Error(err, "Failed to release shard lease", "shard", shard)
+				}
+				cancel()
+			}
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// reconcileLease renews the held shard Lease or claims the first free one
+func (s *Sharder) reconcileLease(ctx context.Context) {
+	logger := log.FromContext(ctx).WithValues("identity", s.Identity)
+	if shard := s.Shard(); shard >= 0 {
+		attempted := time.Now()
+		held, err := s.tryAcquire(ctx, shard)
+		if err != nil {
+			logger.Error(err, "Failed to renew shard lease", "shard", shard)
+		}
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if held {
+			s.renewed = attempted
+			return
+		}
+		// Stop processing before the Lease can be taken over, the next renewal attempt is up to a
+		// retry period away
+		if err == nil || time.Since(s.renewed) >= s.renewDeadline() {
+			logger.Info("Lost shard lease", "shard", shard)
+			s.shard = -1
+		}
+		return
+	}
+
+	for shard := 0; shard < s.Count; shard++ {
+		attempted := time.Now()
+		acquired, err := s.tryAcquire(ctx, shard)
+		if err != nil {
+			logger.Error(err, "Failed to claim shard lease", "shard", shard)
+			continue
+		}
+		if !acquired {
+			continue
+		}
+		s.mu.Lock()
+		s.shard = shard
+		s.renewed = attempted
+		s.mu.Unlock()
+		logger.Info("Claimed shard lease", "shard", shard, "shards", s.Count)
+		go s.enqueueShard(ctx)
+		return
+	}
+}
+
+// renewDeadline is how long the shard is kept after the last successful renewal. It is shorter
+// than LeaseDuration by a retry period and the clock skew margin, so the replica stops processing
+// the shard before another replica can take over its Lease.
+func (s *Sharder) renewDeadline() time.Duration {
+	return s.LeaseDuration - shardLeaseRetryPeriod - shardLeaseClockSkew
+}
+
+// tryAcquire creates, takes over or renews the Lease of the shard. It reports false if the Lease
+// is held by another replica.
+func (s *Sharder) tryAcquire(ctx context.Context, shard int) (bool, error) {
+	now := metav1.NewMicroTime(time.Now())
+	key := types.NamespacedName{Namespace: s.LeaseNamespace, Name: fmt.Sprintf("%s-%d", s.LeasePrefix, shard)}
+	lease := &coordinationv1.Lease{}
+	if err := s.APIReader.Get(ctx, key, lease); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return false, err
+		}
+		lease = &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace},
+			Spec: coordinationv1.LeaseSpec{
+				HolderIdentity:       ptr.To(s.Identity),
+				LeaseDurationSeconds: ptr.To(int32(s.LeaseDuration.Seconds())),
+				AcquireTime:          &now,
+				RenewTime:            &now,
+			},
+		}
+		if err := s.Client.Create(ctx, lease); err != nil {
+			if apierrors.IsAlreadyExists(err) {
+				return false, nil
+			}
+			return false, err
+		}
+		return true, nil
+	}
+
+	holder := ptr.Deref(lease.Spec.HolderIdentity, "")
+	if holder != s.Identity {
+		expired := lease.Spec.RenewTime == nil ||
+			time.Since(lease.Spec.RenewTime.Time) >= time.Duration(ptr.Deref(lease.Spec.LeaseDurationSeconds, 0))*time.Second
+		if holder != "" && !expired {
+			return false, nil
+		}
+		lease.Spec.HolderIdentity = ptr.To(s.Identity)
+		lease.Spec.AcquireTime = &now
+		lease.Spec.LeaseTransitions = ptr.To(ptr.Deref(lease.Spec.LeaseTransitions, 0) + 1)
+	}
+	lease.Spec.LeaseDurationSeconds = ptr.To(int32(s.LeaseDuration.Seconds()))
+	lease.Spec.RenewTime = &now
+	if err := s.Client.Update(ctx, lease); err != nil {
+		if apierrors.IsConflict(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// release gives up the Lease of the shard
+func (s *Sharder) release(ctx context.Context, shard int) error {
+	lease := &coordinationv1.Lease{}
+	key := types.NamespacedName{Namespace: s.LeaseNamespace, Name: fmt.Sprintf("%s-%d", s.LeasePrefix, shard)}
+	if err := s.APIReader.Get(ctx, key, lease); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	if ptr.Deref(lease.Spec.HolderIdentity, "") != s.Identity {
+		return nil
+	}
+	lease.Spec.HolderIdentity = nil
+	lease.Spec.RenewTime = nil
+	s.mu.Lock()
+	s.shard = -1
+	s.mu.Unlock()
+	return s.Client.Update(ctx, lease)
+}
+
+// enqueueShard reconciles the DGDRs of a newly claimed shard, their events were dropped while
+// the replica had none
+func (s *Sharder) enqueueShard(ctx context.Context) {
+	dgdrs := &nvidiacomv1alpha1.DynamoGraphDeploymentRequestList{}
+	if err := s.Client.List(ctx, dgdrs); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to list DGDRs of claimed shard")
+		return
+	}
+	for i := range dgdrs.Items {
+		dgdr := &dgdrs.Items[i]
+		if !s.Owns(dgdr.Namespace, dgdr.Name) {
+			continue
+		}
+		select {
+		case s.assigned <- event.GenericEvent{Object: dgdr}:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,172 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// unavailableReader fails every read as if the API server could not be reached
+type unavailableReader struct{}
+
+func (unavailableReader) Get(context.Context, client.ObjectKey, client.Object, ...client.GetOption) error {
+	return fmt.Errorf("connection refused")
+}
+
+func (unavailableReader) List(context.Context, client.ObjectList, ...client.ListOption) error {
+	return fmt.Errorf("connection refused")
+}
+
+var _ = Describe("DGDR Sharding", func() {
+	It("Should split DGDRs into disjoint shards", func() {
+		counts := make([]int, 4)
+		for i := 0; i < 400; i++ {
+			shard := ShardFor(defaultNamespace, fmt.Sprintf("dgdr-%d", i), len(counts))
+			Expect(ShardFor(defaultNamespace, fmt.Sprintf("dgdr-%d", i), len(counts))).Should(Equal(shard))
+			counts[shard]++
+		}
+		for _, count := range counts {
+			Expect(count).Should(BeNumerically(">", 50))
+		}
+	})
+
+	It("Should take the shard from the pod ordinal", func() {
+		sharder, err := NewSharder(k8sClient, k8sClient, 3, ShardAssignmentOrdinal, "dynamo-operator-2")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sharder.Shard()).Should(Equal(2))
+
+		_, err = NewSharder(k8sClient, k8sClient, 2, ShardAssignmentOrdinal, "dynamo-operator-2")
+		Expect(err).Should(MatchError(ContainSubstring("out of range")))
+		_, err = NewSharder(k8sClient, k8sClient, 2, ShardAssignmentOrdinal, "dynamo-operator-7d9f8-abcde")
+		Expect(err).Should(MatchError(ContainSubstring("no ordinal suffix")))
+
+		var unsharded *Sharder
+		Expect(unsharded.Owns(defaultNamespace, "any")).To(BeTrue())
+	})
+
+	It("Should claim a free shard lease and hand it over once released", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		newSharder := func(identity string) *Sharder {
+			sharder, err := NewSharder(k8sClient, k8sClient, 1, ShardAssignmentLease, identity)
+			Expect(err).NotTo(HaveOccurred())
+			sharder.LeaseNamespace = defaultNamespace
+			sharder.LeasePrefix = "test-dgdr-shard"
+			return sharder
+		}
+		first, second := newSharder("operator-a"), newSharder("operator-b")
+		DeferCleanup(func() {
+			_ = k8sClient.Delete(context.Background(), &coordinationv1.Lease{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-dgdr-shard-0", Namespace: defaultNamespace},
+			})
+		})
+
+		first.reconcileLease(ctx)
+		Expect(first.Shard()).Should(Equal(0))
+		Expect(first.Owns(defaultNamespace, "any")).To(BeTrue())
+		second.reconcileLease(ctx)
+		Expect(second.Shard()).Should(Equal(-1))
+		Expect(second.Owns(defaultNamespace, "any")).To(BeFalse())
+
+		// Renewing keeps the shard
+		first.reconcileLease(ctx)
+		Expect(first.Shard()).Should(Equal(0))
+
+		Expect(first.release(ctx, 0)).Should(Succeed())
+		Expect(first.Shard()).Should(Equal(-1))
+		second.reconcileLease(ctx)
+		Expect(second.Shard()).Should(Equal(0))
+		lease := &coordinationv1.Lease{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Namespace: defaultNamespace, Name: "test-dgdr-shard-0"}, lease)).Should(Succeed())
+		Expect(*lease.Spec.HolderIdentity).Should(Equal("operator-b"))
+		Expect(*lease.Spec.LeaseTransitions).Should(Equal(int32(1)))
+	})
+
+	It("Should drop the shard once renewals fail past the renew deadline", func() {
+		ctx := context.Background()
+		sharder, err := NewSharder(k8sClient, k8sClient, 1, ShardAssignmentLease, "operator-c")
+		Expect(err).NotTo(HaveOccurred())
+		sharder.LeaseNamespace = defaultNamespace
+		sharder.LeasePrefix = "test-dgdr-shard-deadline"
+		DeferCleanup(func() {
+			_ = k8sClient.Delete(context.Background(), &coordinationv1.Lease{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-dgdr-shard-deadline-0", Namespace: defaultNamespace},
+			})
+		})
+
+		sharder.reconcileLease(ctx)
+		Expect(sharder.Shard()).Should(Equal(0))
+
+		// A failed renewal within the deadline keeps the shard
+		sharder.APIReader = unavailableReader{}
+		sharder.reconcileLease(ctx)
+		Expect(sharder.Shard()).Should(Equal(0))
+
+		// Past the deadline the shard is dropped although the Lease has not expired yet
+		sharder.mu.Lock()
+		sharder.renewed = time.Now().Add(-sharder.renewDeadline())
+		sharder.mu.Unlock()
+		Expect(sharder.renewDeadline()).Should(BeNumerically("<", sharder.LeaseDuration))
+		sharder.reconcileLease(ctx)
+		Expect(sharder.Shard()).Should(Equal(-1))
+	})
+
+	It("Should not reconcile DGDRs of other shards", func() {
+		ctx := context.Background()
+		name := "test-dgdr-other-shard"
+		sharder, err := NewSharder(k8sClient, k8sClient, 2, ShardAssignmentOrdinal, fmt.Sprintf("operator-%d", 1-ShardFor(defaultNamespace, name, 2)))
+		Expect(err).NotTo(HaveOccurred())
+		reconciler := &DynamoGraphDeploymentRequestReconciler{
+			Client:      k8sClient,
+			Recorder:    record.NewFakeRecorder(100),
+			RBACManager: &MockRBACManager{},
+			Sharder:     sharder,
+		}
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model:   "test-model",
+				Backend: BackendVLLM,
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					ProfilerImage: "test-profiler:latest",
+					Config:        createTestConfig(map[string]interface{}{"sla": map[string]interface{}{"ttft": 100.0}}),
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, dgdr) }()
+
+		_, err = reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(dgdr)})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(dgdr), dgdr)).Should(Succeed())
+		Expect(dgdr.Finalizers).Should(BeEmpty())
+		Expect(dgdr.Status.State).Should(BeEmpty())
+	})
+})
@@ -0,0 +1,309 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/yaml"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+)
+
+const (
+	// AnnotationExport triggers a one-shot export of the DGDR state to a snapshot ConfigMap
+	AnnotationExport = "nvidia.com/dgdr-export"
+
+	// ConfigMapExportPrefix is the name prefix of snapshot ConfigMaps
+	ConfigMapExportPrefix = "dgdr-export-"
+
+	// SnapshotKey is the default ConfigMap key holding the snapshot
+	SnapshotKey = "snapshot.yaml"
+
+	// Event reasons
+	EventReasonSnapshotExported     = "SnapshotExported"
+	EventReasonSnapshotExportFailed = "SnapshotExportFailed"
+	EventReasonSnapshotImported     = "SnapshotImported"
+
+	// Condition reasons
+	ReasonImportedFromSnapshot = "ImportedFromSnapshot"
+)
+
+// dgdrSnapshot is the self-contained, serialized state needed to rehydrate a DGDR on another
+// cluster without re-profiling.
+type dgdrSnapshot struct {
+	// SourceName and SourceNamespace identify the DGDR the snapshot was taken from
+	SourceName      string `json:"sourceName"`
+	SourceNamespace string `json:"sourceNamespace"`
+	// ExportedAt is when the snapshot was taken
+	ExportedAt metav1.Time `json:"exportedAt"`
+	// Spec is the spec of the source DGDR
+	Spec nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec `json:"spec"`
+	// Backend mirrors status.backend of the source DGDR
+	Backend string `json:"backend,omitempty"`
+	// GeneratedDeployment is the generated DGD of the source DGDR
+	GeneratedDeployment *runtime.RawExtension `json:"generatedDeployment"`
+	// ProfilingResults mirrors status.profilingResults of the source DGDR
+	ProfilingResults string `json:"profilingResults,omitempty"`
+	// Conditions holds the profiling-related conditions of the source DGDR
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// getExportConfigMapName returns the ConfigMap name a DGDR snapshot is exported to
+func getExportConfigMapName(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) string {
+	return fmt.Sprintf("%s%s", ConfigMapExportPrefix, dgdr.Name)
+}
+
+// exportRequested reports whether the export annotation is set on the DGDR
+func exportRequested(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) bool {
+	return dgdr.Annotations[AnnotationExport] == "true"
+}
+
+// newSnapshot builds a snapshot from the current DGDR state
+func newSnapshot(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) *dgdrSnapshot {
+	snapshot := &dgdrSnapshot{
+		SourceName:          dgdr.Name,
+		SourceNamespace:     dgdr.Namespace,
+		ExportedAt:          metav1.Now(),
+		Spec:                *dgdr.Spec.DeepCopy(),
+		Backend:             dgdr.Status.Backend,
+		GeneratedDeployment: dgdr.Status.GeneratedDeployment.DeepCopy(),
+		ProfilingResults:    dgdr.Status.ProfilingResults,
+	}
+	// The snapshot is a restore point, not an import instruction
+	snapshot.Spec.ImportFrom = nil
+
+	for _, conditionType := range []string{ConditionTypeProfiling, ConditionTypeSpecGenerated} {
+		if condition := meta.FindStatusCondition(dgdr.Status.Conditions, conditionType); condition != nil {
+			snapshot.Conditions = append(snapshot.Conditions, *condition)
+		}
+	}
+	return snapshot
+}
+
+// handleExport writes the DGDR snapshot to its export ConfigMap and clears the export annotation.
+// Export is deferred until a generated deployment is available.
+func (r *DynamoGraphDeploymentRequestReconciler) handleExport(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	logger := log.FromContext(ctx)
+
+	if dgdr.Status.GeneratedDeployment == nil {
+		logger.Info("Export requested but no generated deployment yet, deferring", "name", dgdr.Name)
+		return nil
+	}
+
+	content, err := yaml.Marshal(newSnapshot(dgdr))
+	if err != nil {
+		return fmt.Errorf("failed to marshal snapshot: %w", err)
+	}
+
+	// The snapshot is owned by the DGDR, copy it to the target cluster before deleting the DGDR
+	cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: getExportConfigMapName(dgdr), Namespace: dgdr.Namespace}}
+	if _, err := controllerutil.CreateOrUpdate(ctx, r.Client, cm, func() error {
+		cm.Labels = map[string]string{
+			LabelDGDRName:  dgdr.Name,
+			LabelManagedBy: LabelValueDynamoOperator,
+		}
+		cm.Data = map[string]string{SnapshotKey: string(content)}
+		return controllerutil.SetControllerReference(dgdr, cm, r.Client.Scheme())
+	}); err != nil {
+		return fmt.Errorf("failed to write snapshot ConfigMap: %w", err)
+	}
+
+	// Clear the trigger so the export runs once per request
+	delete(dgdr.Annotations, AnnotationExport)
+	if err := r.Update(ctx, dgdr); err != nil {
+		return fmt.Errorf("failed to clear export annotation: %w", err)
+	}
+
+	logger.Info("Exported DGDR snapshot", "configMap", cm.Name)
+	r.Recorder.Event(dgdr, corev1.EventTypeNormal, EventReasonSnapshotExported,
+		fmt.Sprintf("Snapshot exported to ConfigMap %s", cm.Name))
+	r.trackOwnedResource(ctx, dgdr, nvidiacomv1alpha1.OwnedResourceRoleSnapshot, cm)
+	return r.updateStatus(ctx, dgdr)
+}
+
+// loadSnapshot reads and parses the snapshot referenced by spec.importFrom
+func (r *DynamoGraphDeploymentRequestReconciler) loadSnapshot(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) (*dgdrSnapshot, error) {
+	ref := dgdr.Spec.ImportFrom
+	cm := &corev1.ConfigMap{}
+	if err := r.Get(ctx, types.NamespacedName{Name: ref.ConfigMapName, Namespace: dgdr.Namespace}, cm); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf(MessageConfigMapNotFound, ref.ConfigMapName, dgdr.Namespace)
+		}
+		return nil, err
+	}
+
+	key := ref.Key
+	if key == "" {
+		key = SnapshotKey
+	}
+	content, exists := cm.Data[key]
+	if !exists {
+		return nil, fmt.Errorf(MessageConfigMapKeyNotFound, key, cm.Name)
+	}
+
+	snapshot := &dgdrSnapshot{}
+	if err := yaml.Unmarshal([]byte(content), snapshot); err != nil {
+		return nil, withFailureReason(nvidiacomv1alpha1.FailureReasonSpecParseError,
+			fmt.Errorf("failed to parse snapshot %s/%s: %w", cm.Name, key, err))
+	}
+	if snapshot.GeneratedDeployment == nil || len(snapshot.GeneratedDeployment.Raw) == 0 {
+		return nil, withFailureReason(nvidiacomv1alpha1.FailureReasonResultsMissing,
+			fmt.Errorf("snapshot %s/%s has no generatedDeployment", cm.Name, key))
+	}
+	return snapshot, nil
+}
+
+// validateSnapshotImages checks the images of the generated deployment of a snapshot against the allowlist
+func (r *DynamoGraphDeploymentRequestReconciler) validateSnapshotImages(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, snapshot *dgdrSnapshot) error {
+	if r.ImageAllowlist == nil {
+		return nil
+	}
+	dgd := &nvidiacomv1alpha1.DynamoGraphDeployment{}
+	if err := yaml.Unmarshal(snapshot.GeneratedDeployment.Raw, dgd); err != nil {
+		return withFailureReason(nvidiacomv1alpha1.FailureReasonSpecParseError,
+			fmt.Errorf("failed to parse generated deployment of snapshot: %w", err))
+	}
+	return r.validateDeploymentImages(ctx, dgdr, dgd)
+}
+
+// applySnapshotSpec fills the fields of the DGDR spec that are not set with those of the snapshot
+// spec, so the DGDR describes the deployment it imports. Fields set on the DGDR are kept, as they
+// may adapt the deployment to the target cluster. It reports whether the spec changed.
+func applySnapshotSpec(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, snapshot *dgdrSnapshot) (bool, error) {
+	base, err := json.Marshal(snapshot.Spec)
+	if err != nil {
+		return false, err
+	}
+	own, err := json.Marshal(dgdr.Spec)
+	if err != nil {
+		return false, err
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal(own, &fields); err != nil {
+		return false, err
+	}
+	// Empty values of required fields are not set by the user and must not clear the snapshot's
+	patch, err := json.Marshal(pruneEmptyValues(fields))
+	if err != nil {
+		return false, err
+	}
+	merged, err := jsonpatch.MergePatch(base, patch)
+	if err != nil {
+		return false, err
+	}
+
+	spec := nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{}
+	if err := json.Unmarshal(merged, &spec); err != nil {
+		return false, err
+	}
+	spec.ImportFrom = dgdr.Spec.ImportFrom
+	if equality.Semantic.DeepEqual(spec, dgdr.Spec) {
+		return false, nil
+	}
+	dgdr.Spec = spec
+	return true, nil
+}
+
+// pruneEmptyValues removes empty strings and objects left empty by their removal from fields
+func pruneEmptyValues(fields map[string]interface{}) map[string]interface{} {
+	for key, value := range fields {
+		switch v := value.(type) {
+		case string:
+			if v == "" {
+				delete(fields, key)
+			}
+		case map[string]interface{}:
+			if len(pruneEmptyValues(v)) == 0 {
+				delete(fields, key)
+			}
+		}
+	}
+	return fields
+}
+
+// handleImport rehydrates a DGDR from a snapshot, skipping profiling entirely. The DGDR takes the
+// spec fields it leaves unset from the snapshot and is validated like any other DGDR.
+func (r *DynamoGraphDeploymentRequestReconciler) handleImport(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+	logger.Info("Importing DGDR from snapshot", "configMap", dgdr.Spec.ImportFrom.ConfigMapName)
+
+	snapshot, err := r.loadSnapshot(ctx, dgdr)
+	if err == nil {
+		var changed bool
+		if changed, err = applySnapshotSpec(dgdr, snapshot); err != nil {
+			err = withFailureReason(nvidiacomv1alpha1.FailureReasonSpecParseError,
+				fmt.Errorf("failed to apply the spec of the snapshot: %w", err))
+		} else if changed {
+			if err := r.Update(ctx, dgdr); err != nil {
+				return ctrl.Result{}, fmt.Errorf("failed to apply the spec of the snapshot: %w", err)
+			}
+		}
+	}
+	if err == nil {
+		err = r.validateSpec(ctx, dgdr)
+	}
+	if err == nil {
+		// Snapshots may come from clusters with other allowlists
+		err = r.validateSnapshotImages(ctx, dgdr, snapshot)
+	}
+	if err != nil {
+		r.Recorder.Event(dgdr, corev1.EventTypeWarning, EventReasonValidationFailed, err.Error())
+		return r.updateStateToFailed(ctx, dgdr, failureReasonFromError(err, nvidiacomv1alpha1.FailureReasonValidationError),
+			ConditionTypeValidation, validationFailureReason(err), err.Error())
+	}
+
+	dgdr.Status.ObservedGeneration = dgdr.Generation
+	dgdr.Status.Backend = snapshot.Backend
+	if dgdr.Status.Backend == "" {
+		dgdr.Status.Backend = dgdr.Spec.Backend
+	}
+	dgdr.Status.ProfilingMode = getProfilingMode(dgdr)
+	dgdr.Status.GeneratedDeployment = snapshot.GeneratedDeployment
+	dgdr.Status.ProfilingResults = snapshot.ProfilingResults
+
+	message := fmt.Sprintf("Imported from snapshot of %s/%s exported at %s",
+		snapshot.SourceNamespace, snapshot.SourceName, snapshot.ExportedAt.UTC().Format("2006-01-02T15:04:05Z"))
+	meta.SetStatusCondition(&dgdr.Status.Conditions, metav1.Condition{
+		Type:               ConditionTypeProfiling,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: dgdr.Generation,
+		Reason:             ReasonImportedFromSnapshot,
+		Message:            message,
+	})
+
+	r.Recorder.Event(dgdr, corev1.EventTypeNormal, EventReasonSnapshotImported, message)
+
+	if dgdr.Spec.AutoApply {
+		return r.updateStateWithCondition(ctx, dgdr, autoApplyState(dgdr), ConditionTypeSpecGenerated, metav1.ConditionTrue, ReasonImportedFromSnapshot, message)
+	}
+	return r.updateStateWithCondition(ctx, dgdr, StateReady, ConditionTypeSpecGenerated, metav1.ConditionTrue, ReasonImportedFromSnapshot, MessageSpecAvailable)
+}
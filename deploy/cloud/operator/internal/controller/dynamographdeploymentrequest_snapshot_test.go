@@ -0,0 +1,206 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/yaml"
+)
+
+var _ = Describe("DGDR Snapshot Export and Import", func() {
+	var reconciler *DynamoGraphDeploymentRequestReconciler
+
+	BeforeEach(func() {
+		reconciler = &DynamoGraphDeploymentRequestReconciler{
+			Client:      k8sClient,
+			Recorder:    record.NewFakeRecorder(100),
+			RBACManager: &MockRBACManager{},
+		}
+	})
+
+	newSpec := func() nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec {
+		return nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+			Model:   "test-model",
+			Backend: "vllm",
+			ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+				ProfilerImage: "test-profiler:latest",
+				Config: createTestConfig(map[string]interface{}{
+					"sla": map[string]interface{}{"ttft": 100.0, "itl": 1500.0},
+				}),
+			},
+		}
+	}
+
+	It("Should export a Ready DGDR and rehydrate a new DGDR from the snapshot", func() {
+		ctx := context.Background()
+		namespace := defaultNamespace
+
+		source := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "test-snapshot-source",
+				Namespace:   namespace,
+				Annotations: map[string]string{AnnotationExport: "true"},
+			},
+			Spec: newSpec(),
+		}
+		Expect(k8sClient.Create(ctx, source)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, source) }()
+
+		source.Status.State = StateReady
+		source.Status.ObservedGeneration = source.Generation
+		source.Status.Backend = BackendVLLM
+		source.Status.GeneratedDeployment = &runtime.RawExtension{
+			Object: &nvidiacomv1alpha1.DynamoGraphDeployment{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "nvidia.com/v1alpha1", Kind: "DynamoGraphDeployment"},
+				ObjectMeta: metav1.ObjectMeta{Name: "snapshot-dgd"},
+			},
+		}
+		Expect(k8sClient.Status().Update(ctx, source)).Should(Succeed())
+
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{
+			NamespacedName: types.NamespacedName{Name: source.Name, Namespace: namespace},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		// Snapshot ConfigMap is written and the trigger annotation is cleared
+		exported := &corev1.ConfigMap{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: getExportConfigMapName(source), Namespace: namespace}, exported)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, exported) }()
+		Expect(exported.Data).Should(HaveKey(SnapshotKey))
+		Expect(exported.OwnerReferences).Should(ConsistOf(HaveField("UID", source.UID)))
+
+		var updatedSource nvidiacomv1alpha1.DynamoGraphDeploymentRequest
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: source.Name, Namespace: namespace}, &updatedSource)).Should(Succeed())
+		Expect(updatedSource.Annotations).ShouldNot(HaveKey(AnnotationExport))
+
+		// Import into a fresh DGDR, which takes the fields it leaves unset from the snapshot
+		target := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-snapshot-target",
+				Namespace: namespace,
+			},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model:   "test-model",
+				Backend: "vllm",
+			},
+		}
+		target.Spec.ImportFrom = &nvidiacomv1alpha1.SnapshotReference{ConfigMapName: exported.Name}
+		Expect(k8sClient.Create(ctx, target)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, target) }()
+
+		_, err = reconciler.Reconcile(ctx, reconcile.Request{
+			NamespacedName: types.NamespacedName{Name: target.Name, Namespace: namespace},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		var updatedTarget nvidiacomv1alpha1.DynamoGraphDeploymentRequest
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: target.Name, Namespace: namespace}, &updatedTarget)).Should(Succeed())
+		Expect(updatedTarget.Status.State).Should(Equal(StateReady))
+		Expect(updatedTarget.Status.GeneratedDeployment).NotTo(BeNil())
+		Expect(string(updatedTarget.Status.GeneratedDeployment.Raw)).Should(ContainSubstring("snapshot-dgd"))
+		Expect(updatedTarget.Status.Backend).Should(Equal(BackendVLLM))
+		Expect(updatedTarget.Spec.ProfilingConfig.ProfilerImage).Should(Equal("test-profiler:latest"))
+		Expect(updatedTarget.Spec.ProfilingConfig.Config).Should(Equal(source.Spec.ProfilingConfig.Config))
+		Expect(updatedTarget.Spec.ImportFrom.ConfigMapName).Should(Equal(exported.Name))
+
+		condition := meta.FindStatusCondition(updatedTarget.Status.Conditions, ConditionTypeProfiling)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Reason).Should(Equal(ReasonImportedFromSnapshot))
+	})
+
+	It("Should fail when the snapshot ConfigMap does not exist", func() {
+		ctx := context.Background()
+		namespace := defaultNamespace
+
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-snapshot-missing",
+				Namespace: namespace,
+			},
+			Spec: newSpec(),
+		}
+		dgdr.Spec.ImportFrom = &nvidiacomv1alpha1.SnapshotReference{ConfigMapName: "does-not-exist"}
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, dgdr) }()
+
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{
+			NamespacedName: types.NamespacedName{Name: dgdr.Name, Namespace: namespace},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		var updated nvidiacomv1alpha1.DynamoGraphDeploymentRequest
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: dgdr.Name, Namespace: namespace}, &updated)).Should(Succeed())
+		Expect(updated.Status.State).Should(Equal(StateFailed))
+		Expect(updated.Status.FailureReason).Should(Equal(nvidiacomv1alpha1.FailureReasonValidationError))
+	})
+
+	It("Should fail when the imported spec is invalid", func() {
+		ctx := context.Background()
+		namespace := defaultNamespace
+
+		spec := newSpec()
+		spec.ProfilingConfig.ProfilerImage = ""
+		content, err := yaml.Marshal(&dgdrSnapshot{
+			SourceName:      "test-snapshot-invalid-source",
+			SourceNamespace: namespace,
+			Spec:            spec,
+			GeneratedDeployment: &runtime.RawExtension{
+				Raw: []byte(`{"apiVersion":"nvidia.com/v1alpha1","kind":"DynamoGraphDeployment","metadata":{"name":"snapshot-dgd"}}`),
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		snapshot := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-snapshot-invalid", Namespace: namespace},
+			Data:       map[string]string{SnapshotKey: string(content)},
+		}
+		Expect(k8sClient.Create(ctx, snapshot)).Should(Succeed())
+		DeferCleanup(func() { _ = k8sClient.Delete(ctx, snapshot) })
+
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-snapshot-invalid-target", Namespace: namespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model:      "test-model",
+				Backend:    "vllm",
+				ImportFrom: &nvidiacomv1alpha1.SnapshotReference{ConfigMapName: snapshot.Name},
+			},
+		}
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		DeferCleanup(func() { _ = k8sClient.Delete(ctx, dgdr) })
+
+		_, err = reconciler.Reconcile(ctx, reconcile.Request{
+			NamespacedName: types.NamespacedName{Name: dgdr.Name, Namespace: namespace},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		var updated nvidiacomv1alpha1.DynamoGraphDeploymentRequest
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: dgdr.Name, Namespace: namespace}, &updated)).Should(Succeed())
+		Expect(updated.Status.State).Should(Equal(StateFailed))
+		Expect(updated.Status.FailureReason).Should(Equal(nvidiacomv1alpha1.FailureReasonImageNotConfigured))
+	})
+})
@@ -0,0 +1,128 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+)
+
+const (
+	// ConfigKeyMaxGPUsPerEngine bounds the GPUs the profiler sweeps per engine, under hardware
+	ConfigKeyMaxGPUsPerEngine = "max_num_gpus_per_engine"
+
+	// Soft validation messages, the DGDR is admitted with them as warnings
+	WarningTightTTFT          = "sla ttft %dms looks unusually tight for model %s (about %gB parameters), profiling may find no configuration that meets it; %dms or more is typical"
+	WarningTightITL           = "sla itl %dms looks unusually tight for model %s (about %gB parameters), profiling may find no configuration that meets it; %dms or more is typical"
+	WarningDefaultCandidates  = "spec.backend is auto without spec.backendPreference, the default candidates %s, %s and %s are evaluated"
+	WarningNoMaxGPUsPerEngine = "profilingConfig.config.hardware.max_num_gpus_per_engine is not set, the profiler may sweep engines up to all GPUs of a node"
+)
+
+// tightSLAThresholds are the latencies below which an SLA is unusually tight for models of at
+// least minParamsB billion parameters, ordered from the largest models down
+var tightSLAThresholds = []struct {
+	minParamsB float64
+	ttft       int
+	itl        int
+}{
+	{minParamsB: 100, ttft: 300, itl: 20},
+	{minParamsB: 30, ttft: 150, itl: 10},
+	{minParamsB: 7, ttft: 50, itl: 5},
+}
+
+var (
+	// moeParamsPattern matches the size of mixture of experts model names such as Mixtral-8x7B
+	moeParamsPattern = regexp.MustCompile(`(?i)(?:^|[^a-z0-9.])(\d+)x(\d+(?:\.\d+)?)b(?:$|[^a-z0-9])`)
+	// paramsPattern matches the size of model names such as Llama-3.1-70B-Instruct or Qwen3-0.6B
+	paramsPattern = regexp.MustCompile(`(?i)(?:^|[^a-z0-9.])(\d+(?:\.\d+)?)b(?:$|[^a-z0-9])`)
+)
+
+// modelParamsB estimates the billions of parameters of a model from its name, 0 if it names none
+func modelParamsB(model string) float64 {
+	if match := moeParamsPattern.FindStringSubmatch(model); match != nil {
+		experts, _ := strconv.ParseFloat(match[1], 64)
+		size, _ := strconv.ParseFloat(match[2], 64)
+		return experts * size
+	}
+	if match := paramsPattern.FindStringSubmatch(model); match != nil {
+		size, _ := strconv.ParseFloat(match[1], 64)
+		return size
+	}
+	return 0
+}
+
+// SoftValidationWarnings returns issues of the DGDR that do not make it invalid but likely are not
+// what the user intended: an SLA unusually tight for the model size, the default candidates of
+// backend auto, and no bound on the GPUs per engine. They are returned as admission warnings.
+func SoftValidationWarnings(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) []string {
+	// An unparsable config is rejected by the controller
+	config := parseProfilingConfig(dgdr)
+
+	var warnings []string
+	if isGenerative(dgdr) {
+		warnings = append(warnings, tightSLAWarnings(dgdr, config)...)
+	}
+	if dgdr.Spec.Backend == BackendAuto && len(dgdr.Spec.BackendPreference) == 0 {
+		warnings = append(warnings, fmt.Sprintf(WarningDefaultCandidates, BackendVLLM, BackendSGLang, BackendTRTLLM))
+	}
+	if !isCPUOnly(dgdr) {
+		hardware, _ := config["hardware"].(map[string]interface{})
+		if _, ok := hardware[ConfigKeyMaxGPUsPerEngine]; !ok {
+			warnings = append(warnings, WarningNoMaxGPUsPerEngine)
+		}
+	}
+	return warnings
+}
+
+// tightSLAWarnings warns about latency targets below what is typical for the size of the model.
+// spec.sla.tokenLatency replaces sla.ttft and sla.itl of the profiling config.
+func tightSLAWarnings(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, config map[string]interface{}) []string {
+	model := modelName(dgdr)
+	paramsB := modelParamsB(model)
+	if paramsB == 0 {
+		return nil
+	}
+	var ttft, itl int
+	if dgdr.Spec.SLA != nil && dgdr.Spec.SLA.TokenLatency != nil {
+		ttft = int(dgdr.Spec.SLA.TokenLatency.TTFTMilliseconds)
+		itl = int(dgdr.Spec.SLA.TokenLatency.ITLMilliseconds)
+	} else if sla, ok := config[ConfigKeySLA].(map[string]interface{}); ok {
+		value, _ := sla[SLAKeyTTFT].(float64)
+		ttft = int(value)
+		value, _ = sla[SLAKeyITL].(float64)
+		itl = int(value)
+	}
+
+	var warnings []string
+	for _, threshold := range tightSLAThresholds {
+		if paramsB < threshold.minParamsB {
+			continue
+		}
+		if ttft > 0 && ttft < threshold.ttft {
+			warnings = append(warnings, fmt.Sprintf(WarningTightTTFT, ttft, model, paramsB, threshold.ttft))
+		}
+		if itl > 0 && itl < threshold.itl {
+			warnings = append(warnings, fmt.Sprintf(WarningTightITL, itl, model, paramsB, threshold.itl))
+		}
+		break
+	}
+	return warnings
+}
@@ -0,0 +1,127 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+)
+
+const (
+	// DefaultGeneratedSpecValidity never expires generated specs unless the DGDR sets a validity
+	DefaultGeneratedSpecValidity = time.Duration(0)
+
+	// ConditionTypeSpecStale is True once the generated spec is past its validity period
+	ConditionTypeSpecStale = "SpecStale"
+
+	// Condition reasons of the SpecStale condition
+	ReasonSpecExpired = "SpecExpired"
+	ReasonSpecValid   = "SpecValid"
+
+	// ReasonStaleSpecBlocked is the DeploymentReady reason while blockApply holds back an expired spec
+	ReasonStaleSpecBlocked = "StaleSpecBlocked"
+
+	// Event reasons
+	EventReasonSpecExpired       = "SpecExpired"
+	EventReasonStaleApplyBlocked = "StaleSpecApplyBlocked"
+
+	// Messages
+	MessageSpecExpired                   = "The generated spec expired at %s, re-profile with the " + AnnotationAction + ": " + ActionReprofile + " annotation"
+	MessageSpecValid                     = "The generated spec is valid until %s"
+	MessageStaleApplyBlocked             = "Not applying the expired generated spec, re-profile with the " + AnnotationAction + ": " + ActionReprofile + " annotation"
+	MessageGeneratedSpecValidityNegative = "generatedSpecValidity.validFor must not be negative"
+)
+
+// getGeneratedSpecValidity returns how long a generated spec of the DGDR stays valid, zero if it never expires
+func (r *DynamoGraphDeploymentRequestReconciler) getGeneratedSpecValidity(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) time.Duration {
+	if validity := dgdr.Spec.GeneratedSpecValidity; validity != nil && validity.ValidFor != nil {
+		return validity.ValidFor.Duration
+	}
+	return r.GeneratedSpecValidity
+}
+
+// validateGeneratedSpecValidity checks that the validity period is not negative
+func validateGeneratedSpecValidity(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	if validity := dgdr.Spec.GeneratedSpecValidity; validity != nil && validity.ValidFor != nil && validity.ValidFor.Duration < 0 {
+		return errors.New(MessageGeneratedSpecValidityNegative)
+	}
+	return nil
+}
+
+// setGeneratedSpecExpiry records when a just generated spec expires. The status is persisted by
+// the caller's next status update.
+func (r *DynamoGraphDeploymentRequestReconciler) setGeneratedSpecExpiry(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) {
+	dgdr.Status.GeneratedSpecExpiry = nil
+	if validity := r.getGeneratedSpecValidity(dgdr); validity > 0 {
+		dgdr.Status.GeneratedSpecExpiry = &metav1.Time{Time: time.Now().Add(validity).Truncate(time.Second)}
+	}
+}
+
+// isSpecExpired reports whether the generated spec of the DGDR is past its expiry
+func isSpecExpired(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) bool {
+	return dgdr.Status.GeneratedSpecExpiry != nil && !time.Now().Before(dgdr.Status.GeneratedSpecExpiry.Time)
+}
+
+// isStaleApplyBlocked reports whether autoApply must not apply the DGDR's expired spec
+func isStaleApplyBlocked(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) bool {
+	return dgdr.Spec.GeneratedSpecValidity != nil && dgdr.Spec.GeneratedSpecValidity.BlockApply && isSpecExpired(dgdr)
+}
+
+// reconcileSpecExpiry keeps the SpecStale condition in line with the expiry of the generated spec
+// and returns how long until the spec expires, 0 if it never does or already has.
+func (r *DynamoGraphDeploymentRequestReconciler) reconcileSpecExpiry(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) (time.Duration, error) {
+	expiry := dgdr.Status.GeneratedSpecExpiry
+	if expiry == nil {
+		if meta.RemoveStatusCondition(&dgdr.Status.Conditions, ConditionTypeSpecStale) {
+			return 0, r.updateStatus(ctx, dgdr)
+		}
+		return 0, nil
+	}
+
+	condition := metav1.Condition{
+		Type:               ConditionTypeSpecStale,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: dgdr.Generation,
+		Reason:             ReasonSpecValid,
+		Message:            fmt.Sprintf(MessageSpecValid, expiry.UTC().Format(time.RFC3339)),
+	}
+	remaining := time.Until(expiry.Time)
+	if remaining <= 0 {
+		remaining = 0
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = ReasonSpecExpired
+		condition.Message = fmt.Sprintf(MessageSpecExpired, expiry.UTC().Format(time.RFC3339))
+	}
+	previous := meta.FindStatusCondition(dgdr.Status.Conditions, ConditionTypeSpecStale)
+	if previous != nil && previous.Status == condition.Status && previous.Message == condition.Message {
+		return remaining, nil
+	}
+	meta.SetStatusCondition(&dgdr.Status.Conditions, condition)
+	if condition.Status == metav1.ConditionTrue {
+		r.Recorder.Event(dgdr, corev1.EventTypeWarning, EventReasonSpecExpired, condition.Message)
+	}
+	return remaining, r.updateStatus(ctx, dgdr)
+}
@@ -0,0 +1,139 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("DGDR Generated Spec Expiry", func() {
+	var (
+		reconciler *DynamoGraphDeploymentRequestReconciler
+		recorder   *record.FakeRecorder
+	)
+
+	BeforeEach(func() {
+		recorder = record.NewFakeRecorder(100)
+		reconciler = &DynamoGraphDeploymentRequestReconciler{
+			Client:                k8sClient,
+			Recorder:              recorder,
+			RBACManager:           &MockRBACManager{},
+			GeneratedSpecValidity: time.Hour,
+		}
+	})
+
+	newDGDR := func(name string) *nvidiacomv1alpha1.DynamoGraphDeploymentRequest {
+		return &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model:   "test-model",
+				Backend: BackendVLLM,
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					ProfilerImage: "test-profiler:latest",
+					Config:        createTestConfig(map[string]interface{}{"sla": map[string]interface{}{"ttft": 100.0}}),
+				},
+				AutoApply: true,
+			},
+		}
+	}
+
+	It("Should set the expiry from the DGDR validity or the operator default", func() {
+		dgdr := newDGDR("test-dgdr-specexpiry-default")
+		reconciler.setGeneratedSpecExpiry(dgdr)
+		Expect(dgdr.Status.GeneratedSpecExpiry).NotTo(BeNil())
+		Expect(time.Until(dgdr.Status.GeneratedSpecExpiry.Time)).Should(BeNumerically("~", time.Hour, time.Minute))
+
+		dgdr.Spec.GeneratedSpecValidity = &nvidiacomv1alpha1.GeneratedSpecValiditySpec{ValidFor: &metav1.Duration{Duration: 24 * time.Hour}}
+		reconciler.setGeneratedSpecExpiry(dgdr)
+		Expect(time.Until(dgdr.Status.GeneratedSpecExpiry.Time)).Should(BeNumerically("~", 24*time.Hour, time.Minute))
+
+		// A zero validity never expires the spec
+		dgdr.Spec.GeneratedSpecValidity.ValidFor = &metav1.Duration{}
+		reconciler.setGeneratedSpecExpiry(dgdr)
+		Expect(dgdr.Status.GeneratedSpecExpiry).To(BeNil())
+
+		dgdr.Spec.GeneratedSpecValidity.ValidFor = &metav1.Duration{Duration: -time.Hour}
+		Expect(validateGeneratedSpecValidity(dgdr)).Should(MatchError(MessageGeneratedSpecValidityNegative))
+	})
+
+	It("Should flip SpecStale once the generated spec expired", func() {
+		ctx := context.Background()
+		dgdr := newDGDR("test-dgdr-specexpiry-stale")
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, dgdr) }()
+		dgdr.Status.GeneratedSpecExpiry = &metav1.Time{Time: time.Now().Add(time.Hour).Truncate(time.Second)}
+		Expect(k8sClient.Status().Update(ctx, dgdr)).Should(Succeed())
+
+		untilExpiry, err := reconciler.reconcileSpecExpiry(ctx, dgdr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(untilExpiry).Should(BeNumerically("~", time.Hour, time.Minute))
+		condition := meta.FindStatusCondition(dgdr.Status.Conditions, ConditionTypeSpecStale)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).Should(Equal(metav1.ConditionFalse))
+
+		dgdr.Status.GeneratedSpecExpiry = &metav1.Time{Time: time.Now().Add(-time.Minute).Truncate(time.Second)}
+		untilExpiry, err = reconciler.reconcileSpecExpiry(ctx, dgdr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(untilExpiry).Should(BeZero())
+		Expect(recorder.Events).Should(Receive(ContainSubstring(EventReasonSpecExpired)))
+
+		persisted := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{}
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(dgdr), persisted)).Should(Succeed())
+		condition = meta.FindStatusCondition(persisted.Status.Conditions, ConditionTypeSpecStale)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).Should(Equal(metav1.ConditionTrue))
+		Expect(condition.Reason).Should(Equal(ReasonSpecExpired))
+
+		// A stale condition is not reported again
+		_, err = reconciler.reconcileSpecExpiry(ctx, persisted)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(recorder.Events).ShouldNot(Receive())
+	})
+
+	It("Should not apply an expired spec with blockApply", func() {
+		ctx := context.Background()
+		dgdr := newDGDR("test-dgdr-specexpiry-blocked")
+		dgdr.Spec.GeneratedSpecValidity = &nvidiacomv1alpha1.GeneratedSpecValiditySpec{BlockApply: true}
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, dgdr) }()
+		dgdr.Status.State = StateDeploying
+		dgdr.Status.GeneratedDeployment = &runtime.RawExtension{Raw: []byte(
+			`{"apiVersion":"nvidia.com/v1alpha1","kind":"DynamoGraphDeployment","metadata":{"name":"test-dgd-specexpiry-blocked"},"spec":{"services":{"Frontend":{"replicas":1}}}}`)}
+		dgdr.Status.GeneratedSpecExpiry = &metav1.Time{Time: time.Now().Add(-time.Minute).Truncate(time.Second)}
+		Expect(k8sClient.Status().Update(ctx, dgdr)).Should(Succeed())
+
+		_, err := reconciler.createDGD(ctx, dgdr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(recorder.Events).Should(Receive(ContainSubstring(EventReasonStaleApplyBlocked)))
+		condition := meta.FindStatusCondition(dgdr.Status.Conditions, ConditionTypeDeploymentReady)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Reason).Should(Equal(ReasonStaleSpecBlocked))
+		err = k8sClient.Get(ctx, client.ObjectKey{Namespace: defaultNamespace, Name: "test-dgd-specexpiry-blocked"}, &nvidiacomv1alpha1.DynamoGraphDeployment{})
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,200 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
+
+	dynamoCommon "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/dynamo/common"
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	commonconsts "github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/consts"
+)
+
+const (
+	// SpeculativeDecodingResultsFile is where the profiler reports the cost and benefit of
+	// speculative decoding, measured against the same configuration without speculation
+	SpeculativeDecodingResultsFile = "speculative_decoding.yaml"
+
+	// DefaultNumSpeculativeTokens is how many tokens are speculated per step by default
+	DefaultNumSpeculativeTokens = 3
+
+	// SubComponentTypePrefill marks the prefill workers of a disaggregated deployment, which do not decode
+	SubComponentTypePrefill = "prefill"
+
+	// Worker arguments configuring speculative decoding
+	VLLMSpeculativeConfigFlag     = "--speculative-config"
+	SGLangSpeculativeAlgorithm    = "--speculative-algorithm"
+	SGLangSpeculativeDraftModel   = "--speculative-draft-model-path"
+	SGLangSpeculativeDraftTokens  = "--speculative-num-draft-tokens"
+	speculativeDecodingArgsPrefix = "--speculative-"
+
+	// MessageSpeculationUnmeasured warns that speculative decoding was requested but not applied
+	MessageSpeculationUnmeasured = "spec.features.speculativeDecoding was not applied, the profiler did not measure whether it pays off"
+)
+
+// sglangSpeculativeAlgorithms maps speculative decoding methods to SGLang's speculative algorithms
+var sglangSpeculativeAlgorithms = map[nvidiacomv1alpha1.SpeculativeDecodingMethod]string{
+	nvidiacomv1alpha1.SpeculativeDecodingMethodEAGLE:  "EAGLE",
+	nvidiacomv1alpha1.SpeculativeDecodingMethodEAGLE3: "EAGLE3",
+	nvidiacomv1alpha1.SpeculativeDecodingMethodDraft:  "STANDALONE",
+	nvidiacomv1alpha1.SpeculativeDecodingMethodNgram:  "NGRAM",
+}
+
+// speculativeDecodingResult is the content of SpeculativeDecodingResultsFile
+type speculativeDecodingResult struct {
+	Beneficial                  bool    `json:"beneficial"`
+	ITL                         float64 `json:"itl"`
+	SpeculativeITL              float64 `json:"speculative_itl"`
+	ThroughputPerGPU            float64 `json:"throughput_per_gpu"`
+	SpeculativeThroughputPerGPU float64 `json:"speculative_throughput_per_gpu"`
+	AcceptanceRate              float64 `json:"acceptance_rate"`
+}
+
+// getNumSpeculativeTokens returns the number of speculated tokens, defaulting to DefaultNumSpeculativeTokens
+func getNumSpeculativeTokens(spec *nvidiacomv1alpha1.SpeculativeDecodingSpec) int32 {
+	if spec.NumSpeculativeTokens <= 0 {
+		return DefaultNumSpeculativeTokens
+	}
+	return spec.NumSpeculativeTokens
+}
+
+// speculativeDecodingProfilingConfig returns the speculative decoding settings in the format of
+// the profiler's engine.speculative_decoding
+func speculativeDecodingProfilingConfig(spec *nvidiacomv1alpha1.SpeculativeDecodingSpec) map[string]interface{} {
+	config := map[string]interface{}{
+		"method":                 string(spec.Method),
+		"num_speculative_tokens": getNumSpeculativeTokens(spec),
+	}
+	if spec.DraftModel != "" {
+		config["draft_model"] = spec.DraftModel
+	}
+	return config
+}
+
+// applySpeculativeDecodingResults records whether the generated deployment should speculate, from
+// the measurements of the profiler. Results without measurements come from profilers that do not
+// compare speculation, in which case the deployment does not speculate and a warning is reported.
+func applySpeculativeDecodingResults(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, results map[string]string) error {
+	dgdr.Status.SpeculativeDecoding = nil
+	clearWarning(dgdr, WarningSpeculationUnmeasured)
+	if !featureEnabled(dgdr, FeatureSpeculativeDecoding) {
+		return nil
+	}
+	content, exists := results[SpeculativeDecodingResultsFile]
+	if !exists {
+		dgdr.Status.SpeculativeDecoding = &nvidiacomv1alpha1.SpeculativeDecodingStatus{}
+		setWarning(dgdr, WarningSpeculationUnmeasured, MessageSpeculationUnmeasured)
+		return nil
+	}
+
+	var result speculativeDecodingResult
+	if err := yaml.Unmarshal([]byte(content), &result); err != nil {
+		return withFailureReason(nvidiacomv1alpha1.FailureReasonSpecParseError,
+			fmt.Errorf("failed to parse %s: %w", SpeculativeDecodingResultsFile, err))
+	}
+	dgdr.Status.SpeculativeDecoding = &nvidiacomv1alpha1.SpeculativeDecodingStatus{
+		Applied:                     result.Beneficial,
+		Measured:                    true,
+		ITL:                         strconv.FormatFloat(result.ITL, 'f', -1, 64),
+		SpeculativeITL:              strconv.FormatFloat(result.SpeculativeITL, 'f', -1, 64),
+		ThroughputPerGPU:            strconv.FormatFloat(result.ThroughputPerGPU, 'f', -1, 64),
+		SpeculativeThroughputPerGPU: strconv.FormatFloat(result.SpeculativeThroughputPerGPU, 'f', -1, 64),
+		AcceptanceRate:              strconv.FormatFloat(result.AcceptanceRate, 'f', -1, 64),
+	}
+	return nil
+}
+
+// speculativeDecodingArgs returns the worker arguments that configure speculative decoding on a
+// backend. TensorRT-LLM configures it in the engine config generated by the profiler, so no
+// arguments are returned for it.
+func speculativeDecodingArgs(spec *nvidiacomv1alpha1.SpeculativeDecodingSpec, backend string) ([]string, error) {
+	tokens := getNumSpeculativeTokens(spec)
+	switch backend {
+	case BackendVLLM:
+		config := map[string]interface{}{"num_speculative_tokens": tokens}
+		if spec.Method != nvidiacomv1alpha1.SpeculativeDecodingMethodDraft {
+			config["method"] = string(spec.Method)
+		}
+		if spec.DraftModel != "" {
+			config["model"] = spec.DraftModel
+		}
+		encoded, err := json.Marshal(config)
+		if err != nil {
+			return nil, err
+		}
+		return []string{VLLMSpeculativeConfigFlag, string(encoded)}, nil
+	case BackendSGLang:
+		args := []string{SGLangSpeculativeAlgorithm, sglangSpeculativeAlgorithms[spec.Method]}
+		if spec.DraftModel != "" {
+			args = append(args, SGLangSpeculativeDraftModel, spec.DraftModel)
+		}
+		return append(args, SGLangSpeculativeDraftTokens, strconv.Itoa(int(tokens))), nil
+	default:
+		return nil, nil
+	}
+}
+
+// applySpeculativeDecoding adds the speculative decoding arguments to the decode workers of the
+// generated DGD when the profiler found speculation beneficial. Workers the profiler already
+// configured speculation for are left as they are. status.speculativeDecoding.applied is updated
+// to whether any decode worker speculates, which it does not on backends configured without
+// worker arguments.
+func applySpeculativeDecoding(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, dgd *nvidiacomv1alpha1.DynamoGraphDeployment) error {
+	status := dgdr.Status.SpeculativeDecoding
+	if status == nil || !status.Applied || dgdr.Spec.Features == nil || dgdr.Spec.Features.SpeculativeDecoding == nil {
+		return nil
+	}
+	args, err := speculativeDecodingArgs(dgdr.Spec.Features.SpeculativeDecoding, generatedBackend(dgdr))
+	if err != nil {
+		return fmt.Errorf("failed to render the speculative decoding arguments: %w", err)
+	}
+
+	speculating := false
+	for _, name := range slices.Sorted(maps.Keys(dgd.Spec.Services)) {
+		spec := dgd.Spec.Services[name]
+		if spec == nil || spec.ComponentType != commonconsts.ComponentTypeWorker || spec.SubComponentType == SubComponentTypePrefill {
+			continue
+		}
+		if spec.ExtraPodSpec != nil && spec.ExtraPodSpec.MainContainer != nil &&
+			strings.Contains(strings.Join(spec.ExtraPodSpec.MainContainer.Args, " "), speculativeDecodingArgsPrefix) {
+			speculating = true
+			continue
+		}
+		if len(args) == 0 {
+			continue
+		}
+		if spec.ExtraPodSpec == nil {
+			spec.ExtraPodSpec = &dynamoCommon.ExtraPodSpec{}
+		}
+		if spec.ExtraPodSpec.MainContainer == nil {
+			spec.ExtraPodSpec.MainContainer = &corev1.Container{}
+		}
+		spec.ExtraPodSpec.MainContainer.Args = append(spec.ExtraPodSpec.MainContainer.Args, args...)
+		speculating = true
+	}
+	status.Applied = speculating
+	return nil
+}
@@ -0,0 +1,188 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+
+	dynamoCommon "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/dynamo/common"
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	commonconsts "github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/consts"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("DGDR Speculative Decoding", func() {
+	newDGDR := func(backend string, spec *nvidiacomv1alpha1.SpeculativeDecodingSpec) *nvidiacomv1alpha1.DynamoGraphDeploymentRequest {
+		return &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-dgdr-speculative", Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model:    "meta-llama/Llama-3.1-8B-Instruct",
+				Backend:  backend,
+				Features: &nvidiacomv1alpha1.FeaturesSpec{SpeculativeDecoding: spec},
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					ProfilerImage: "test-profiler:latest",
+					Config: createTestConfig(map[string]interface{}{
+						"sweep": map[string]interface{}{"use_ai_configurator": true},
+					}),
+				},
+			},
+		}
+	}
+
+	eagle3 := &nvidiacomv1alpha1.SpeculativeDecodingSpec{
+		Method:     nvidiacomv1alpha1.SpeculativeDecodingMethodEAGLE3,
+		DraftModel: "yuhuili/EAGLE3-LLaMA3.1-Instruct-8B",
+	}
+
+	newDGD := func() *nvidiacomv1alpha1.DynamoGraphDeployment {
+		worker := func(subComponentType string) *nvidiacomv1alpha1.DynamoComponentDeploymentSharedSpec {
+			return &nvidiacomv1alpha1.DynamoComponentDeploymentSharedSpec{
+				ComponentType:    commonconsts.ComponentTypeWorker,
+				SubComponentType: subComponentType,
+				ExtraPodSpec: &dynamoCommon.ExtraPodSpec{
+					MainContainer: &corev1.Container{Args: []string{"--model", "meta-llama/Llama-3.1-8B-Instruct"}},
+				},
+			}
+		}
+		return &nvidiacomv1alpha1.DynamoGraphDeployment{
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentSpec{
+				Services: map[string]*nvidiacomv1alpha1.DynamoComponentDeploymentSharedSpec{
+					"Frontend":      {ComponentType: commonconsts.ComponentTypeFrontend},
+					"PrefillWorker": worker(SubComponentTypePrefill),
+					"DecodeWorker":  worker("decode"),
+				},
+			},
+		}
+	}
+
+	It("Should require a draft model unless the method is ngram", func() {
+		ctx := context.Background()
+		dgdr := newDGDR(BackendVLLM, &nvidiacomv1alpha1.SpeculativeDecodingSpec{Method: nvidiacomv1alpha1.SpeculativeDecodingMethodEAGLE})
+		Expect(k8sClient.Create(ctx, dgdr)).To(MatchError(ContainSubstring("draftModel is required unless method is ngram")))
+
+		dgdr = newDGDR(BackendVLLM, &nvidiacomv1alpha1.SpeculativeDecodingSpec{Method: nvidiacomv1alpha1.SpeculativeDecodingMethodNgram, DraftModel: "example/draft"})
+		Expect(k8sClient.Create(ctx, dgdr)).To(MatchError(ContainSubstring("draftModel is not used by method ngram")))
+
+		dgdr = newDGDR(BackendVLLM, &nvidiacomv1alpha1.SpeculativeDecodingSpec{Method: nvidiacomv1alpha1.SpeculativeDecodingMethodNgram})
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		DeferCleanup(func() { _ = k8sClient.Delete(ctx, dgdr) })
+		Expect(dgdr.Spec.Features.SpeculativeDecoding.NumSpeculativeTokens).Should(Equal(int32(3)))
+	})
+
+	It("Should add speculative decoding to the decode workers when the profiler measured a benefit", func() {
+		dgdr := newDGDR(BackendVLLM, eagle3)
+		Expect(applySpeculativeDecodingResults(dgdr, map[string]string{SpeculativeDecodingResultsFile: `
+beneficial: true
+itl: 12.5
+speculative_itl: 7.25
+throughput_per_gpu: 1800
+speculative_throughput_per_gpu: 2600
+acceptance_rate: 0.72
+`})).Should(Succeed())
+		Expect(dgdr.Status.SpeculativeDecoding).Should(Equal(&nvidiacomv1alpha1.SpeculativeDecodingStatus{
+			Applied:                     true,
+			Measured:                    true,
+			ITL:                         "12.5",
+			SpeculativeITL:              "7.25",
+			ThroughputPerGPU:            "1800",
+			SpeculativeThroughputPerGPU: "2600",
+			AcceptanceRate:              "0.72",
+		}))
+
+		dgd := newDGD()
+		Expect(applySpeculativeDecoding(dgdr, dgd)).Should(Succeed())
+		Expect(dgd.Spec.Services["DecodeWorker"].ExtraPodSpec.MainContainer.Args).Should(Equal([]string{
+			"--model", "meta-llama/Llama-3.1-8B-Instruct",
+			VLLMSpeculativeConfigFlag, `{"method":"eagle3","model":"yuhuili/EAGLE3-LLaMA3.1-Instruct-8B","num_speculative_tokens":3}`,
+		}))
+		Expect(dgd.Spec.Services["PrefillWorker"].ExtraPodSpec.MainContainer.Args).Should(HaveLen(2))
+		Expect(dgd.Spec.Services["Frontend"].ExtraPodSpec).Should(BeNil())
+
+		// Workers the profiler already configured are left as they are
+		Expect(applySpeculativeDecoding(dgdr, dgd)).Should(Succeed())
+		Expect(dgd.Spec.Services["DecodeWorker"].ExtraPodSpec.MainContainer.Args).Should(HaveLen(4))
+	})
+
+	It("Should render the arguments of the backend", func() {
+		args, err := speculativeDecodingArgs(eagle3, BackendSGLang)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(args).Should(Equal([]string{
+			SGLangSpeculativeAlgorithm, "EAGLE3",
+			SGLangSpeculativeDraftModel, "yuhuili/EAGLE3-LLaMA3.1-Instruct-8B",
+			SGLangSpeculativeDraftTokens, "3",
+		}))
+
+		args, err = speculativeDecodingArgs(&nvidiacomv1alpha1.SpeculativeDecodingSpec{
+			Method:               nvidiacomv1alpha1.SpeculativeDecodingMethodDraft,
+			DraftModel:           "meta-llama/Llama-3.2-1B-Instruct",
+			NumSpeculativeTokens: 5,
+		}, BackendVLLM)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(args).Should(Equal([]string{VLLMSpeculativeConfigFlag, `{"model":"meta-llama/Llama-3.2-1B-Instruct","num_speculative_tokens":5}`}))
+
+		// TensorRT-LLM speculates through the engine config generated by the profiler
+		Expect(speculativeDecodingArgs(eagle3, BackendTRTLLM)).Should(BeEmpty())
+	})
+
+	It("Should deploy without speculation when it does not pay off", func() {
+		dgdr := newDGDR(BackendVLLM, eagle3)
+		Expect(applySpeculativeDecodingResults(dgdr, map[string]string{SpeculativeDecodingResultsFile: "beneficial: false\nitl: 12.5\nspeculative_itl: 14\n"})).Should(Succeed())
+		Expect(dgdr.Status.SpeculativeDecoding.Applied).Should(BeFalse())
+		dgd := newDGD()
+		Expect(applySpeculativeDecoding(dgdr, dgd)).Should(Succeed())
+		Expect(dgd.Spec.Services["DecodeWorker"].ExtraPodSpec.MainContainer.Args).Should(HaveLen(2))
+
+		// Speculation profilers do not measure is not applied, with a warning
+		Expect(applySpeculativeDecodingResults(dgdr, map[string]string{})).Should(Succeed())
+		Expect(dgdr.Status.SpeculativeDecoding).Should(Equal(&nvidiacomv1alpha1.SpeculativeDecodingStatus{}))
+		Expect(dgdr.Status.Warnings).Should(ContainElement(HaveField("Type", WarningSpeculationUnmeasured)))
+		dgd = newDGD()
+		Expect(applySpeculativeDecoding(dgdr, dgd)).Should(Succeed())
+		Expect(dgd.Spec.Services["DecodeWorker"].ExtraPodSpec.MainContainer.Args).Should(HaveLen(2))
+
+		// Speculation disabled by validation is not applied
+		dgdr.Status.DisabledFeatures = []string{FeatureSpeculativeDecoding}
+		Expect(applySpeculativeDecodingResults(dgdr, map[string]string{})).Should(Succeed())
+		Expect(dgdr.Status.SpeculativeDecoding).Should(BeNil())
+		Expect(dgdr.Status.Warnings).ShouldNot(ContainElement(HaveField("Type", WarningSpeculationUnmeasured)))
+
+		Expect(applySpeculativeDecodingResults(newDGDR(BackendVLLM, eagle3), map[string]string{SpeculativeDecodingResultsFile: "beneficial: [yes"})).
+			To(MatchError(ContainSubstring("failed to parse " + SpeculativeDecodingResultsFile)))
+	})
+
+	It("Should report speculation as applied only when the workers speculate", func() {
+		// TensorRT-LLM takes no worker arguments, speculation is up to the profiled engine config
+		dgdr := newDGDR(BackendTRTLLM, eagle3)
+		Expect(applySpeculativeDecodingResults(dgdr, map[string]string{SpeculativeDecodingResultsFile: "beneficial: true\n"})).Should(Succeed())
+		Expect(dgdr.Status.SpeculativeDecoding.Applied).Should(BeTrue())
+		dgd := newDGD()
+		Expect(applySpeculativeDecoding(dgdr, dgd)).Should(Succeed())
+		Expect(dgd.Spec.Services["DecodeWorker"].ExtraPodSpec.MainContainer.Args).Should(HaveLen(2))
+		Expect(dgdr.Status.SpeculativeDecoding.Applied).Should(BeFalse())
+
+		// Workers the profiler configured for speculation do speculate
+		dgdr.Status.SpeculativeDecoding.Applied = true
+		dgd.Spec.Services["DecodeWorker"].ExtraPodSpec.MainContainer.Args = append(
+			dgd.Spec.Services["DecodeWorker"].ExtraPodSpec.MainContainer.Args, "--speculative-config", "{}")
+		Expect(applySpeculativeDecoding(dgdr, dgd)).Should(Succeed())
+		Expect(dgdr.Status.SpeculativeDecoding.Applied).Should(BeTrue())
+	})
+})
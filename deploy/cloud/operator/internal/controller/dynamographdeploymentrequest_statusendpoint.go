@@ -0,0 +1,325 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"cmp"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+)
+
+const (
+	// StatusEndpointPath is the path the summary of the managed DGDRs and DGDs is served at
+	StatusEndpointPath = "/status"
+)
+
+// OperatorStatusSummary is the answer of the status endpoint
+type OperatorStatusSummary struct {
+	GeneratedTime metav1.Time       `json:"generatedTime"`
+	DGDRs         DGDRStatusSummary `json:"dgdrs"`
+	DGDs          DGDStatusSummary  `json:"dgds"`
+}
+
+// DGDRStatusSummary summarizes the DynamoGraphDeploymentRequests the operator manages
+type DGDRStatusSummary struct {
+	Total int `json:"total"`
+	// States counts the DGDRs by state
+	States map[string]int `json:"states"`
+	// Failures counts the failed DGDRs by failure reason
+	Failures map[string]int `json:"failures"`
+	// GPUs is the total number of GPUs of the generated deployments
+	GPUs  int64             `json:"gpus"`
+	Items []DGDRStatusEntry `json:"items"`
+}
+
+// DGDRStatusEntry is the summary of one DynamoGraphDeploymentRequest
+type DGDRStatusEntry struct {
+	Namespace    string      `json:"namespace"`
+	Name         string      `json:"name"`
+	State        string      `json:"state"`
+	CreationTime metav1.Time `json:"creationTime"`
+	AgeSeconds   int64       `json:"ageSeconds"`
+	Backend      string      `json:"backend,omitempty"`
+	// GPUs is the number of GPUs of the generated deployment, zero before profiling completes
+	GPUs           int64  `json:"gpus"`
+	FailureReason  string `json:"failureReason,omitempty"`
+	FailureMessage string `json:"failureMessage,omitempty"`
+	// Deployment is the namespace/name of the DGD created for the DGDR
+	Deployment string `json:"deployment,omitempty"`
+}
+
+// DGDStatusSummary summarizes the DynamoGraphDeployments the operator manages
+type DGDStatusSummary struct {
+	Total int `json:"total"`
+	// States counts the DGDs by state
+	States map[string]int `json:"states"`
+	// GPUs is the total number of GPUs of the DGDs
+	GPUs  int64            `json:"gpus"`
+	Items []DGDStatusEntry `json:"items"`
+}
+
+// DGDStatusEntry is the summary of one DynamoGraphDeployment
+type DGDStatusEntry struct {
+	Namespace    string      `json:"namespace"`
+	Name         string      `json:"name"`
+	State        string      `json:"state"`
+	CreationTime metav1.Time `json:"creationTime"`
+	AgeSeconds   int64       `json:"ageSeconds"`
+	GPUs         int64       `json:"gpus"`
+	// Request is the namespace/name of the DGDR that created the DGD
+	Request string `json:"request,omitempty"`
+}
+
+// StatusServer is the read-only HTTPS endpoint dashboards poll for a JSON summary of the DGDRs and
+// DGDs the operator manages, without list permissions on them. Requests authenticate with a
+// Kubernetes bearer token of a user allowed to get the StatusEndpointPath non-resource URL. The
+// summary is built from the cache of the operator.
+type StatusServer struct {
+	Client client.Client
+
+	// OperatorInstance leaves out the DGDRs claimed by other operator instances
+	OperatorInstance string
+
+	// BindAddress is the address the endpoint listens on, e.g. ":8446"
+	BindAddress string
+
+	// TLSConfig holds the serving certificate
+	TLSConfig *tls.Config
+}
+
+// LoadStatusServingCertificate returns the serving certificate of the status endpoint. It reads
+// tls.crt and tls.key from certDir, or generates a self-signed certificate for host if certDir is empty.
+func LoadStatusServingCertificate(certDir, host string) (tls.Certificate, error) {
+	cert, _, err := loadServingCertificate(certDir, host)
+	if err != nil {
+		return tls.Certificate{}, fmt.Errorf("failed to load status endpoint certificate: %w", err)
+	}
+	return cert, nil
+}
+
+// NeedLeaderElection lets every replica serve the summary, it is read from the cache
+func (s *StatusServer) NeedLeaderElection() bool {
+	return false
+}
+
+// Start serves the endpoint until ctx is cancelled
+func (s *StatusServer) Start(ctx context.Context) error {
+	mux := http.NewServeMux()
+	mux.Handle(StatusEndpointPath, s)
+	server := &http.Server{
+		Addr:              s.BindAddress,
+		Handler:           mux,
+		TLSConfig:         s.TLSConfig,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+	}()
+
+	log.FromContext(ctx).Info("Serving status endpoint", "address", s.BindAddress)
+	if err := server.ListenAndServeTLS("", ""); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// ServeHTTP handles GET /status and answers with an OperatorStatusSummary
+func (s *StatusServer) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	logger := log.FromContext(req.Context())
+
+	summary, err := s.handle(req)
+	if err != nil {
+		status := http.StatusInternalServerError
+		var requestErr *resultsRequestError
+		if errors.As(err, &requestErr) {
+			status = requestErr.status
+		}
+		logger.Info("Rejected status request", "status", status, "reason", err.Error())
+		http.Error(w, err.Error(), status)
+		return
+	}
+
+	content, err := json.Marshal(summary)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(content)
+}
+
+func (s *StatusServer) handle(req *http.Request) (*OperatorStatusSummary, error) {
+	ctx := req.Context()
+
+	if req.Method != http.MethodGet {
+		return nil, requestError(http.StatusMethodNotAllowed, "method %s not allowed", req.Method)
+	}
+	if err := s.authorize(ctx, req); err != nil {
+		return nil, err
+	}
+
+	dgdrs := &nvidiacomv1alpha1.DynamoGraphDeploymentRequestList{}
+	if err := s.Client.List(ctx, dgdrs); err != nil {
+		return nil, fmt.Errorf("failed to list DGDRs: %w", err)
+	}
+	dgds := &nvidiacomv1alpha1.DynamoGraphDeploymentList{}
+	if err := s.Client.List(ctx, dgds); err != nil {
+		return nil, fmt.Errorf("failed to list DGDs: %w", err)
+	}
+	return s.summarize(time.Now(), dgdrs.Items, dgds.Items), nil
+}
+
+// authorize verifies that the bearer token of the request belongs to a user allowed to get the
+// status endpoint path
+func (s *StatusServer) authorize(ctx context.Context, req *http.Request) error {
+	user, err := authenticateRequest(ctx, s.Client, req)
+	if err != nil {
+		return err
+	}
+	allowed, err := reviewAccess(ctx, s.Client, user, authorizationv1.SubjectAccessReviewSpec{
+		NonResourceAttributes: &authorizationv1.NonResourceAttributes{Path: StatusEndpointPath, Verb: "get"},
+	})
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return requestError(http.StatusForbidden, "%s may not get %s", user.Username, StatusEndpointPath)
+	}
+	return nil
+}
+
+// summarize builds the summary of the DGDRs and DGDs, sorted by namespace and name
+func (s *StatusServer) summarize(now time.Time, dgdrs []nvidiacomv1alpha1.DynamoGraphDeploymentRequest, dgds []nvidiacomv1alpha1.DynamoGraphDeployment) *OperatorStatusSummary {
+	summary := &OperatorStatusSummary{
+		GeneratedTime: metav1.NewTime(now),
+		DGDRs: DGDRStatusSummary{
+			States:   map[string]int{},
+			Failures: map[string]int{},
+			Items:    []DGDRStatusEntry{},
+		},
+		DGDs: DGDStatusSummary{
+			States: map[string]int{},
+			Items:  []DGDStatusEntry{},
+		},
+	}
+
+	for i := range dgdrs {
+		dgdr := &dgdrs[i]
+		if owner := dgdr.Annotations[AnnotationOperatorInstance]; s.OperatorInstance != "" && owner != "" && owner != s.OperatorInstance {
+			continue
+		}
+		entry := DGDRStatusEntry{
+			Namespace:     dgdr.Namespace,
+			Name:          dgdr.Name,
+			State:         dgdr.Status.State,
+			CreationTime:  dgdr.CreationTimestamp,
+			AgeSeconds:    ageSeconds(now, dgdr.CreationTimestamp),
+			Backend:       cmp.Or(dgdr.Status.Backend, dgdr.Spec.Backend),
+			FailureReason: string(dgdr.Status.FailureReason),
+		}
+		if dgd, err := dgdr.BuildDeployment(); err == nil {
+			entry.GPUs = deploymentGPUs(&dgd.Spec)
+		}
+		if dgdr.Status.State == StateFailed {
+			entry.FailureMessage = redactMessage(dgdr, latestFailureMessage(dgdr.Status.Conditions))
+			summary.DGDRs.Failures[cmp.Or(entry.FailureReason, "Unknown")]++
+		}
+		if dgdr.Status.Deployment != nil && dgdr.Status.Deployment.Created {
+			entry.Deployment = dgdr.Status.Deployment.Namespace + "/" + dgdr.Status.Deployment.Name
+		}
+		summary.DGDRs.States[cmp.Or(entry.State, "Unknown")]++
+		summary.DGDRs.GPUs += entry.GPUs
+		summary.DGDRs.Items = append(summary.DGDRs.Items, entry)
+	}
+
+	for i := range dgds {
+		dgd := &dgds[i]
+		entry := DGDStatusEntry{
+			Namespace:    dgd.Namespace,
+			Name:         dgd.Name,
+			State:        dgd.Status.State,
+			CreationTime: dgd.CreationTimestamp,
+			AgeSeconds:   ageSeconds(now, dgd.CreationTimestamp),
+			GPUs:         deploymentGPUs(&dgd.Spec),
+		}
+		if name := dgd.Labels[LabelDGDRName]; name != "" {
+			entry.Request = cmp.Or(dgd.Labels[LabelDGDRNamespace], dgd.Namespace) + "/" + name
+		}
+		summary.DGDs.States[cmp.Or(entry.State, "Unknown")]++
+		summary.DGDs.GPUs += entry.GPUs
+		summary.DGDs.Items = append(summary.DGDs.Items, entry)
+	}
+
+	slices.SortFunc(summary.DGDRs.Items, func(a, b DGDRStatusEntry) int {
+		return cmp.Or(cmp.Compare(a.Namespace, b.Namespace), cmp.Compare(a.Name, b.Name))
+	})
+	slices.SortFunc(summary.DGDs.Items, func(a, b DGDStatusEntry) int {
+		return cmp.Or(cmp.Compare(a.Namespace, b.Namespace), cmp.Compare(a.Name, b.Name))
+	})
+	summary.DGDRs.Total = len(summary.DGDRs.Items)
+	summary.DGDs.Total = len(summary.DGDs.Items)
+	return summary
+}
+
+// deploymentGPUs returns the number of GPUs of every replica of every service of a DGD spec
+func deploymentGPUs(spec *nvidiacomv1alpha1.DynamoGraphDeploymentSpec) int64 {
+	var gpus int64
+	for _, service := range previewServices(spec) {
+		gpus += int64(service.replicas) * service.gpus
+	}
+	return gpus
+}
+
+// latestFailureMessage returns the message of the most recent False condition
+func latestFailureMessage(conditions []metav1.Condition) string {
+	var latest *metav1.Condition
+	for i := range conditions {
+		condition := &conditions[i]
+		if condition.Status != metav1.ConditionFalse {
+			continue
+		}
+		if latest == nil || condition.LastTransitionTime.After(latest.LastTransitionTime.Time) {
+			latest = condition
+		}
+	}
+	if latest == nil {
+		return ""
+	}
+	return latest.Message
+}
+
+func ageSeconds(now time.Time, created metav1.Time) int64 {
+	return int64(now.Sub(created.Time).Seconds())
+}
@@ -0,0 +1,188 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	dynamoCommon "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/dynamo/common"
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+)
+
+var _ = Describe("DGDR Status Endpoint", func() {
+	var server *StatusServer
+
+	BeforeEach(func() {
+		server = &StatusServer{Client: k8sClient, OperatorInstance: "instance-a"}
+	})
+
+	// userToken returns a token of a ServiceAccount, allowed to get the status endpoint if authorized
+	userToken := func(ctx context.Context, name string, authorized bool) string {
+		sa := &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: defaultNamespace}}
+		Expect(k8sClient.Create(ctx, sa)).Should(Succeed())
+		DeferCleanup(func() { _ = k8sClient.Delete(context.Background(), sa) })
+
+		if authorized {
+			role := &rbacv1.ClusterRole{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Rules: []rbacv1.PolicyRule{{
+					NonResourceURLs: []string{StatusEndpointPath},
+					Verbs:           []string{"get"},
+				}},
+			}
+			binding := &rbacv1.ClusterRoleBinding{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: name},
+				Subjects:   []rbacv1.Subject{{Kind: rbacv1.ServiceAccountKind, Name: name, Namespace: defaultNamespace}},
+			}
+			Expect(k8sClient.Create(ctx, role)).Should(Succeed())
+			Expect(k8sClient.Create(ctx, binding)).Should(Succeed())
+			DeferCleanup(func() {
+				_ = k8sClient.Delete(context.Background(), binding)
+				_ = k8sClient.Delete(context.Background(), role)
+			})
+		}
+
+		request := &authenticationv1.TokenRequest{}
+		Expect(k8sClient.SubResource("token").Create(ctx, sa, request)).Should(Succeed())
+		return request.Status.Token
+	}
+
+	get := func(method, token string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, StatusEndpointPath, nil)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		recorder := httptest.NewRecorder()
+		server.ServeHTTP(recorder, req)
+		return recorder
+	}
+
+	It("Should only answer users allowed to get the status endpoint", func() {
+		ctx := context.Background()
+
+		Expect(get(http.MethodGet, "").Code).Should(Equal(http.StatusUnauthorized))
+		Expect(get(http.MethodGet, "invalid").Code).Should(Equal(http.StatusUnauthorized))
+		Expect(get(http.MethodGet, userToken(ctx, "test-status-denied", false)).Code).Should(Equal(http.StatusForbidden))
+		Expect(get(http.MethodPost, userToken(ctx, "test-status-post", true)).Code).Should(Equal(http.StatusMethodNotAllowed))
+	})
+
+	It("Should summarize the DGDRs and DGDs of the operator instance", func() {
+		ctx := context.Background()
+		token := userToken(ctx, "test-status-user", true)
+
+		newDGDR := func(name, owner string) *nvidiacomv1alpha1.DynamoGraphDeploymentRequest {
+			dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        name,
+					Namespace:   defaultNamespace,
+					Annotations: map[string]string{AnnotationOperatorInstance: owner},
+				},
+				Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+					Model:   "test-model",
+					Backend: BackendVLLM,
+					ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+						ProfilerImage: "test-profiler:latest",
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+			DeferCleanup(func() { _ = k8sClient.Delete(context.Background(), dgdr) })
+			return dgdr
+		}
+
+		failed := newDGDR("test-dgdr-status-failed", "instance-a")
+		failed.Status.State = StateFailed
+		failed.Status.FailureReason = nvidiacomv1alpha1.FailureReasonProfilerCrash
+		failed.Status.GeneratedDeployment = &runtime.RawExtension{Raw: []byte(
+			`{"apiVersion":"nvidia.com/v1alpha1","kind":"DynamoGraphDeployment","metadata":{"name":"test-dgd-status"},` +
+				`"spec":{"services":{"Frontend":{},"VllmDecodeWorker":{"replicas":2,"resources":{"limits":{"gpu":"2"}}}}}}`)}
+		failed.Status.Conditions = []metav1.Condition{{
+			Type:               ConditionTypeProfiling,
+			Status:             metav1.ConditionFalse,
+			Reason:             "ProfilingFailed",
+			Message:            "profiler crashed",
+			LastTransitionTime: metav1.Now(),
+		}}
+		Expect(k8sClient.Status().Update(ctx, failed)).Should(Succeed())
+		newDGDR("test-dgdr-status-other", "instance-b")
+
+		dgd := &nvidiacomv1alpha1.DynamoGraphDeployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-dgd-status",
+				Namespace: defaultNamespace,
+				Labels:    map[string]string{LabelDGDRName: failed.Name, LabelDGDRNamespace: failed.Namespace},
+			},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentSpec{
+				Services: map[string]*nvidiacomv1alpha1.DynamoComponentDeploymentSharedSpec{
+					"VllmDecodeWorker": {
+						Replicas:  ptr.To(int32(3)),
+						Resources: &dynamoCommon.Resources{Limits: &dynamoCommon.ResourceItem{GPU: "1"}},
+					},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, dgd)).Should(Succeed())
+		DeferCleanup(func() { _ = k8sClient.Delete(context.Background(), dgd) })
+
+		recorder := get(http.MethodGet, token)
+		Expect(recorder.Code).Should(Equal(http.StatusOK), recorder.Body.String())
+		summary := &OperatorStatusSummary{}
+		Expect(json.Unmarshal(recorder.Body.Bytes(), summary)).Should(Succeed())
+
+		names := []string{}
+		var entry *DGDRStatusEntry
+		for i := range summary.DGDRs.Items {
+			names = append(names, summary.DGDRs.Items[i].Name)
+			if summary.DGDRs.Items[i].Name == failed.Name {
+				entry = &summary.DGDRs.Items[i]
+			}
+		}
+		Expect(names).NotTo(ContainElement("test-dgdr-status-other"))
+		Expect(entry).NotTo(BeNil())
+		Expect(entry.State).Should(Equal(StateFailed))
+		Expect(entry.Backend).Should(Equal(BackendVLLM))
+		Expect(entry.GPUs).Should(Equal(int64(4)))
+		Expect(entry.FailureReason).Should(Equal(string(nvidiacomv1alpha1.FailureReasonProfilerCrash)))
+		Expect(entry.FailureMessage).Should(Equal("profiler crashed"))
+		Expect(summary.DGDRs.Total).Should(Equal(len(summary.DGDRs.Items)))
+		Expect(summary.DGDRs.States[StateFailed]).Should(BeNumerically(">=", 1))
+		Expect(summary.DGDRs.Failures[string(nvidiacomv1alpha1.FailureReasonProfilerCrash)]).Should(BeNumerically(">=", 1))
+
+		var dgdEntry *DGDStatusEntry
+		for i := range summary.DGDs.Items {
+			if summary.DGDs.Items[i].Name == dgd.Name {
+				dgdEntry = &summary.DGDs.Items[i]
+			}
+		}
+		Expect(dgdEntry).NotTo(BeNil())
+		Expect(dgdEntry.GPUs).Should(Equal(int64(3)))
+		Expect(dgdEntry.Request).Should(Equal(defaultNamespace + "/" + failed.Name))
+	})
+})
@@ -0,0 +1,141 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+)
+
+// statusBaseKey is the context key of the DGDR status a reconcile started from
+type statusBaseKey struct{}
+
+// statusBase holds the last status of the DGDR known to be persisted during a reconcile
+type statusBase struct {
+	status nvidiacomv1alpha1.DynamoGraphDeploymentRequestStatus
+}
+
+// withStatusBase returns a context recording the status of the DGDR as read at the start of the
+// reconcile, which updateStatus diffs against to merge its changes into a newer DGDR on conflicts
+func withStatusBase(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) context.Context {
+	return context.WithValue(ctx, statusBaseKey{}, &statusBase{status: *dgdr.Status.DeepCopy()})
+}
+
+// updateStatus persists the status of the DGDR. If another writer updated the DGDR since it was
+// read, the DGDR is fetched again and the changes made during this reconcile are merged into it:
+// changed and removed conditions by type, other status fields as a JSON merge patch. On success
+// dgdr holds the persisted object. The failure reason only describes the Failed state, so it is
+// cleared whenever the DGDR is in any other state.
+func (r *DynamoGraphDeploymentRequestReconciler) updateStatus(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	if dgdr.Status.State != StateFailed {
+		dgdr.Status.FailureReason = ""
+	}
+	if err := r.FaultInjector.delayStatusUpdate(ctx); err != nil {
+		return err
+	}
+	base, _ := ctx.Value(statusBaseKey{}).(*statusBase)
+	err := r.Status().Update(ctx, dgdr)
+	if apierrors.IsConflict(err) && base != nil {
+		log.FromContext(ctx).V(1).Info("DGDR status update conflicted, merging into the latest DGDR", "name", dgdr.Name)
+		err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			latest := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{}
+			if err := r.apiReader().Get(ctx, client.ObjectKeyFromObject(dgdr), latest); err != nil {
+				return err
+			}
+			status, err := mergeStatus(base.status, dgdr.Status, latest.Status)
+			if err != nil {
+				return err
+			}
+			latest.Status = status
+			if err := r.Status().Update(ctx, latest); err != nil {
+				return err
+			}
+			latest.DeepCopyInto(dgdr)
+			return nil
+		})
+	}
+	if err == nil && base != nil {
+		base.status = *dgdr.Status.DeepCopy()
+	}
+	return err
+}
+
+// mergeStatus applies the changes from base to ours onto latest. Conditions are merged by type so
+// that conditions set concurrently by other writers are kept; the other fields changed in ours
+// overwrite latest.
+func mergeStatus(base, ours, latest nvidiacomv1alpha1.DynamoGraphDeploymentRequestStatus) (nvidiacomv1alpha1.DynamoGraphDeploymentRequestStatus, error) {
+	conditions := mergeConditions(base.Conditions, ours.Conditions, latest.Conditions)
+	base.Conditions, ours.Conditions, latest.Conditions = nil, nil, nil
+
+	baseJSON, err := json.Marshal(base)
+	if err != nil {
+		return latest, fmt.Errorf("failed to marshal DGDR status: %w", err)
+	}
+	oursJSON, err := json.Marshal(ours)
+	if err != nil {
+		return latest, fmt.Errorf("failed to marshal DGDR status: %w", err)
+	}
+	latestJSON, err := json.Marshal(latest)
+	if err != nil {
+		return latest, fmt.Errorf("failed to marshal DGDR status: %w", err)
+	}
+	patch, err := jsonpatch.CreateMergePatch(baseJSON, oursJSON)
+	if err != nil {
+		return latest, fmt.Errorf("failed to diff DGDR status: %w", err)
+	}
+	mergedJSON, err := jsonpatch.MergePatch(latestJSON, patch)
+	if err != nil {
+		return latest, fmt.Errorf("failed to merge DGDR status: %w", err)
+	}
+
+	merged := nvidiacomv1alpha1.DynamoGraphDeploymentRequestStatus{}
+	if err := json.Unmarshal(mergedJSON, &merged); err != nil {
+		return latest, fmt.Errorf("failed to unmarshal merged DGDR status: %w", err)
+	}
+	merged.Conditions = conditions
+	return merged, nil
+}
+
+// mergeConditions applies the conditions set or removed from base to ours onto latest
+func mergeConditions(base, ours, latest []metav1.Condition) []metav1.Condition {
+	merged := slices.Clone(latest)
+	for _, condition := range ours {
+		if previous := meta.FindStatusCondition(base, condition.Type); previous != nil && *previous == condition {
+			continue
+		}
+		meta.SetStatusCondition(&merged, condition)
+	}
+	for _, condition := range base {
+		if meta.FindStatusCondition(ours, condition.Type) == nil {
+			meta.RemoveStatusCondition(&merged, condition.Type)
+		}
+	}
+	return merged
+}
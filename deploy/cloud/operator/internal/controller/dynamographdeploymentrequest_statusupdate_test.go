@@ -0,0 +1,150 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"slices"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+)
+
+var _ = Describe("DGDR Status Updates", func() {
+	var reconciler *DynamoGraphDeploymentRequestReconciler
+
+	BeforeEach(func() {
+		reconciler = &DynamoGraphDeploymentRequestReconciler{
+			Client:      k8sClient,
+			Recorder:    record.NewFakeRecorder(100),
+			RBACManager: &MockRBACManager{},
+		}
+	})
+
+	condition := func(conditionType string, status metav1.ConditionStatus, reason string) metav1.Condition {
+		return metav1.Condition{Type: conditionType, Status: status, Reason: reason, Message: reason}
+	}
+
+	It("Should merge conditions by type", func() {
+		base := []metav1.Condition{
+			condition(ConditionTypeValidation, metav1.ConditionTrue, "Valid"),
+			condition(ConditionTypeDeploymentDegraded, metav1.ConditionTrue, "Degraded"),
+		}
+		ours := []metav1.Condition{
+			condition(ConditionTypeValidation, metav1.ConditionTrue, "Valid"),
+			condition(ConditionTypeSpecGenerated, metav1.ConditionTrue, "Generated"),
+		}
+		latest := append(slices.Clone(base), condition(ConditionTypeProfiling, metav1.ConditionTrue, "ResultsReceived"))
+		latest[0] = condition(ConditionTypeValidation, metav1.ConditionFalse, "Revalidated")
+
+		merged := mergeConditions(base, ours, latest)
+		Expect(merged).Should(HaveLen(3))
+		// Unchanged by this writer, so the concurrent update wins
+		Expect(meta.FindStatusCondition(merged, ConditionTypeValidation).Reason).Should(Equal("Revalidated"))
+		Expect(meta.FindStatusCondition(merged, ConditionTypeProfiling).Reason).Should(Equal("ResultsReceived"))
+		Expect(meta.FindStatusCondition(merged, ConditionTypeSpecGenerated).Reason).Should(Equal("Generated"))
+		Expect(meta.FindStatusCondition(merged, ConditionTypeDeploymentDegraded)).Should(BeNil())
+	})
+
+	It("Should merge its changes into a DGDR updated concurrently", func() {
+		ctx := context.Background()
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-dgdr-status-merge", Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model:   "test-model",
+				Backend: BackendVLLM,
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					ProfilerImage: "test-profiler:latest",
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, dgdr) }()
+		dgdr.Status.State = StateProfiling
+		dgdr.Status.ProfilingResults = "configmap/old"
+		Expect(k8sClient.Status().Update(ctx, dgdr)).Should(Succeed())
+
+		key := types.NamespacedName{Name: dgdr.Name, Namespace: defaultNamespace}
+		ours := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{}
+		Expect(k8sClient.Get(ctx, key, ours)).Should(Succeed())
+		reconcileCtx := withStatusBase(ctx, ours)
+
+		// Another writer records results while this reconcile runs
+		concurrent := ours.DeepCopy()
+		meta.SetStatusCondition(&concurrent.Status.Conditions, condition(ConditionTypeProfiling, metav1.ConditionTrue, EventReasonResultsReceived))
+		Expect(k8sClient.Status().Update(ctx, concurrent)).Should(Succeed())
+
+		// Without the status the reconcile started from the conflict is returned
+		stale := ours.DeepCopy()
+		stale.Status.State = StateReady
+		Expect(apierrors.IsConflict(reconciler.updateStatus(ctx, stale))).Should(BeTrue())
+
+		ours.Status.State = StateReady
+		ours.Status.ProfilingResults = ""
+		meta.SetStatusCondition(&ours.Status.Conditions, condition(ConditionTypeSpecGenerated, metav1.ConditionTrue, EventReasonSpecGenerated))
+		Expect(reconciler.updateStatus(reconcileCtx, ours)).Should(Succeed())
+		Expect(ours.ResourceVersion).ShouldNot(Equal(concurrent.ResourceVersion))
+
+		updated := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{}
+		Expect(k8sClient.Get(ctx, key, updated)).Should(Succeed())
+		Expect(updated.Status.State).Should(Equal(StateReady))
+		Expect(updated.Status.ProfilingResults).Should(BeEmpty())
+		Expect(meta.IsStatusConditionTrue(updated.Status.Conditions, ConditionTypeProfiling)).Should(BeTrue())
+		Expect(meta.IsStatusConditionTrue(updated.Status.Conditions, ConditionTypeSpecGenerated)).Should(BeTrue())
+
+		// Later updates of the same reconcile diff against what was persisted
+		ours.Status.State = StateDeploying
+		Expect(reconciler.updateStatus(reconcileCtx, ours)).Should(Succeed())
+		Expect(k8sClient.Get(ctx, key, updated)).Should(Succeed())
+		Expect(updated.Status.State).Should(Equal(StateDeploying))
+		Expect(meta.IsStatusConditionTrue(updated.Status.Conditions, ConditionTypeProfiling)).Should(BeTrue())
+	})
+
+	It("Should clear the failure reason when the DGDR leaves Failed", func() {
+		ctx := context.Background()
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-dgdr-status-recovered", Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model:   "test-model",
+				Backend: BackendVLLM,
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					ProfilerImage: "test-profiler:latest",
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		DeferCleanup(func() { _ = k8sClient.Delete(ctx, dgdr) })
+
+		dgdr.Status.State = StateFailed
+		dgdr.Status.FailureReason = nvidiacomv1alpha1.FailureReasonDeploymentTimeout
+		Expect(reconciler.updateStatus(ctx, dgdr)).Should(Succeed())
+		Expect(dgdr.Status.FailureReason).Should(Equal(nvidiacomv1alpha1.FailureReasonDeploymentTimeout))
+
+		dgdr.Status.State = StateReady
+		Expect(reconciler.updateStatus(ctx, dgdr)).Should(Succeed())
+		updated := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: dgdr.Name, Namespace: defaultNamespace}, updated)).Should(Succeed())
+		Expect(updated.Status.FailureReason).Should(BeEmpty())
+	})
+})
@@ -0,0 +1,187 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/consts"
+)
+
+const (
+	// DefaultStuckPodGracePeriod is how long a profiling pod may stay Terminating on an unavailable
+	// node, past its own termination grace period, before it is force deleted
+	DefaultStuckPodGracePeriod = 10 * time.Minute
+
+	// Event reasons
+	EventReasonStuckPodsForceDeleted = "StuckPodsForceDeleted"
+
+	// stuckPodRecheckInterval is how often overdue Terminating pods on available nodes are checked again
+	stuckPodRecheckInterval = time.Minute
+
+	// Messages
+	MessageStuckPodsForceDeleted = "Force deleted %d pods of %s stuck Terminating on unavailable node %s"
+)
+
+// profilingGang is a set of pods that are scheduled and replaced together: the pods of the
+// profiling job, or the pods of a deployment the profiler launched
+type profilingGang struct {
+	name string
+	pods []corev1.Pod
+}
+
+// profilingGangs returns the pods of the profiling job and of the DGDs the profiler created for the DGDR
+func (r *DynamoGraphDeploymentRequestReconciler) profilingGangs(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) ([]profilingGang, error) {
+	jobName := GetProfilingJobName(dgdr)
+	jobPods := &corev1.PodList{}
+	if err := r.List(ctx, jobPods, client.InNamespace(dgdr.Namespace), client.MatchingLabels{"job-name": jobName}); err != nil {
+		return nil, fmt.Errorf("failed to list pods of profiling job %s: %w", jobName, err)
+	}
+	gangs := []profilingGang{{name: "profiling job " + jobName, pods: jobPods.Items}}
+
+	dgds := &nvidiacomv1alpha1.DynamoGraphDeploymentList{}
+	if err := r.List(ctx, dgds, client.InNamespace(dgdr.Namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list profiling deployments: %w", err)
+	}
+	for _, dgd := range dgds.Items {
+		if !isOwnedBy(&dgd, dgdr) {
+			continue
+		}
+		pods := &corev1.PodList{}
+		if err := r.List(ctx, pods, client.InNamespace(dgdr.Namespace),
+			client.MatchingLabels{consts.KubeLabelDynamoGraphDeploymentName: dgd.Name}); err != nil {
+			return nil, fmt.Errorf("failed to list pods of DynamoGraphDeployment %s: %w", dgd.Name, err)
+		}
+		gangs = append(gangs, profilingGang{name: "DynamoGraphDeployment " + dgd.Name, pods: pods.Items})
+	}
+	return gangs, nil
+}
+
+// isOwnedBy reports whether obj has an owner reference to owner
+func isOwnedBy(obj metav1.Object, owner metav1.Object) bool {
+	for _, ref := range obj.GetOwnerReferences() {
+		if ref.UID == owner.GetUID() {
+			return true
+		}
+	}
+	return false
+}
+
+// cleanupStuckProfilingPods force deletes profiling pods that stay Terminating on cordoned,
+// NotReady or deleted nodes, where the kubelet will never confirm their deletion. A gang is only
+// useful as a whole, so once one of its pods is stuck all of its Terminating pods are force
+// deleted together and the gang is replaced at once. It returns how long to wait before pods
+// that are Terminating but not yet overdue must be checked again, 0 if none are.
+func (r *DynamoGraphDeploymentRequestReconciler) cleanupStuckProfilingPods(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) (time.Duration, error) {
+	if r.StuckPodGracePeriod <= 0 || r.isMockProfiling() {
+		return 0, nil
+	}
+	logger := log.FromContext(ctx)
+	gangs, err := r.profilingGangs(ctx, dgdr)
+	if err != nil {
+		return 0, err
+	}
+
+	var recheck time.Duration
+	for _, gang := range gangs {
+		sort.Slice(gang.pods, func(i, j int) bool { return gang.pods[i].Name < gang.pods[j].Name })
+		var terminating []*corev1.Pod
+		stuckNode := ""
+		for i := range gang.pods {
+			pod := &gang.pods[i]
+			if pod.DeletionTimestamp == nil {
+				continue
+			}
+			terminating = append(terminating, pod)
+			if stuckNode != "" {
+				continue
+			}
+			remaining := r.stuckPodRemaining(pod)
+			if remaining <= 0 {
+				unavailable, err := r.isNodeUnavailable(ctx, pod.Spec.NodeName)
+				if err != nil {
+					return 0, err
+				}
+				if unavailable {
+					stuckNode = pod.Spec.NodeName
+					continue
+				}
+				// The node may still become unavailable while the pod is terminating
+				remaining = stuckPodRecheckInterval
+			}
+			if recheck == 0 || remaining < recheck {
+				recheck = remaining
+			}
+		}
+		if stuckNode == "" {
+			continue
+		}
+
+		for _, pod := range terminating {
+			// Equivalent of kubectl delete --force --grace-period=0
+			if err := r.Delete(ctx, pod, client.GracePeriodSeconds(0)); client.IgnoreNotFound(err) != nil {
+				return 0, fmt.Errorf("failed to force delete pod %s: %w", pod.Name, err)
+			}
+		}
+		logger.Info("Force deleted pods stuck Terminating", "gang", gang.name, "node", stuckNode, "pods", len(terminating))
+		r.Recorder.Event(dgdr, corev1.EventTypeWarning, EventReasonStuckPodsForceDeleted,
+			fmt.Sprintf(MessageStuckPodsForceDeleted, len(terminating), gang.name, stuckNode))
+	}
+	return recheck, nil
+}
+
+// stuckPodRemaining returns how long a Terminating pod has left before it counts as stuck
+func (r *DynamoGraphDeploymentRequestReconciler) stuckPodRemaining(pod *corev1.Pod) time.Duration {
+	// The deletion timestamp already includes the pod's termination grace period
+	return time.Until(pod.DeletionTimestamp.Add(r.StuckPodGracePeriod))
+}
+
+// isNodeUnavailable reports whether pods on the node cannot finish terminating on their own: the
+// node is gone, cordoned or not Ready. Pods that were never scheduled are not stuck on a node.
+func (r *DynamoGraphDeploymentRequestReconciler) isNodeUnavailable(ctx context.Context, nodeName string) (bool, error) {
+	if nodeName == "" {
+		return false, nil
+	}
+	node := &corev1.Node{}
+	if err := r.Get(ctx, types.NamespacedName{Name: nodeName}, node); err != nil {
+		if apierrors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, fmt.Errorf("failed to get node %s: %w", nodeName, err)
+	}
+	if node.Spec.Unschedulable {
+		return true, nil
+	}
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == corev1.NodeReady {
+			return condition.Status != corev1.ConditionTrue, nil
+		}
+	}
+	return true, nil
+}
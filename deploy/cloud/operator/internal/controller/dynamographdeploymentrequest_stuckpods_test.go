@@ -0,0 +1,137 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/consts"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("DGDR Stuck Profiling Pods", func() {
+	var reconciler *DynamoGraphDeploymentRequestReconciler
+
+	BeforeEach(func() {
+		reconciler = &DynamoGraphDeploymentRequestReconciler{
+			Client:              k8sClient,
+			Recorder:            record.NewFakeRecorder(100),
+			RBACManager:         &MockRBACManager{},
+			StuckPodGracePeriod: time.Millisecond,
+		}
+	})
+
+	createNode := func(ctx context.Context, name string, ready, cordoned bool) {
+		node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name}, Spec: corev1.NodeSpec{Unschedulable: cordoned}}
+		Expect(k8sClient.Create(ctx, node)).Should(Succeed())
+		DeferCleanup(func() { _ = k8sClient.Delete(ctx, node) })
+		if ready {
+			node.Status.Conditions = []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}}
+			Expect(k8sClient.Status().Update(ctx, node)).Should(Succeed())
+		}
+	}
+
+	// createTerminatingPod creates a pod bound to a node and deletes it. Without a kubelet to
+	// confirm the deletion it stays Terminating, like a pod on a wedged node.
+	createTerminatingPod := func(ctx context.Context, name, node string, labels map[string]string) *corev1.Pod {
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: defaultNamespace, Labels: labels},
+			Spec: corev1.PodSpec{
+				NodeName:   node,
+				Containers: []corev1.Container{{Name: "main", Image: "busybox"}},
+			},
+		}
+		Expect(k8sClient.Create(ctx, pod)).Should(Succeed())
+		DeferCleanup(func() { _ = k8sClient.Delete(ctx, pod, client.GracePeriodSeconds(0)) })
+		Expect(k8sClient.Delete(ctx, pod, client.GracePeriodSeconds(1))).Should(Succeed())
+		return pod
+	}
+
+	exists := func(ctx context.Context, pod *corev1.Pod) bool {
+		err := k8sClient.Get(ctx, types.NamespacedName{Name: pod.Name, Namespace: pod.Namespace}, &corev1.Pod{})
+		if apierrors.IsNotFound(err) {
+			return false
+		}
+		Expect(err).NotTo(HaveOccurred())
+		return true
+	}
+
+	It("Should force delete gangs with pods stuck on unavailable nodes", func() {
+		ctx := context.Background()
+		createNode(ctx, "test-stuck-node-cordoned", true, true)
+		createNode(ctx, "test-stuck-node-healthy", true, false)
+
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-dgdr-stuck-pods", Namespace: defaultNamespace},
+		}
+		jobLabels := map[string]string{"job-name": GetProfilingJobName(dgdr)}
+		stuck := createTerminatingPod(ctx, "test-stuck-profiler-0", "test-stuck-node-cordoned", jobLabels)
+		sibling := createTerminatingPod(ctx, "test-stuck-profiler-1", "test-stuck-node-healthy", jobLabels)
+		// Pods of deployments the DGDR does not own are left alone
+		other := createTerminatingPod(ctx, "test-stuck-other-worker", "test-stuck-node-cordoned",
+			map[string]string{consts.KubeLabelDynamoGraphDeploymentName: "test-other-dgd"})
+
+		// Not overdue yet
+		reconciler.StuckPodGracePeriod = time.Hour
+		recheck, err := reconciler.cleanupStuckProfilingPods(ctx, dgdr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(recheck).Should(BeNumerically(">", 59*time.Minute))
+		Expect(exists(ctx, stuck)).To(BeTrue())
+
+		reconciler.StuckPodGracePeriod = time.Millisecond
+		Eventually(func(g Gomega) {
+			_, err := reconciler.cleanupStuckProfilingPods(ctx, dgdr)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(exists(ctx, stuck)).To(BeFalse())
+		}, 5*time.Second, 200*time.Millisecond).Should(Succeed())
+		Expect(exists(ctx, sibling)).To(BeFalse())
+		Expect(exists(ctx, other)).To(BeTrue())
+	})
+
+	It("Should keep checking overdue pods on available nodes", func() {
+		ctx := context.Background()
+		createNode(ctx, "test-stuck-node-ready", true, false)
+
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-dgdr-slow-pods", Namespace: defaultNamespace},
+		}
+		pod := createTerminatingPod(ctx, "test-slow-profiler-0", "test-stuck-node-ready",
+			map[string]string{"job-name": GetProfilingJobName(dgdr)})
+
+		Eventually(func(g Gomega) {
+			recheck, err := reconciler.cleanupStuckProfilingPods(ctx, dgdr)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(recheck).Should(Equal(stuckPodRecheckInterval))
+		}, 5*time.Second, 200*time.Millisecond).Should(Succeed())
+		Expect(exists(ctx, pod)).To(BeTrue())
+
+		reconciler.StuckPodGracePeriod = 0
+		recheck, err := reconciler.cleanupStuckProfilingPods(ctx, dgdr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(recheck).Should(BeZero())
+	})
+})
@@ -0,0 +1,485 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/yaml"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+)
+
+const (
+	// ConfigMapSupportBundlePrefix is the name prefix of support bundle ConfigMaps
+	ConfigMapSupportBundlePrefix = "dgdr-support-bundle-"
+
+	// SupportBundleKey is the binaryData key of the support bundle in its ConfigMap
+	SupportBundleKey = "support-bundle.tar.gz"
+
+	// SupportBundlePVCDir is the directory of the shared profiling output volume that holds support bundles
+	SupportBundlePVCDir = "support-bundles"
+
+	// ContainerNameOperator is the container of the operator pod whose log is excerpted
+	ContainerNameOperator = "manager"
+
+	// LabelGPUProduct is the GPU model label GPU feature discovery sets on nodes
+	LabelGPUProduct = "nvidia.com/gpu.product"
+
+	// DefaultSupportBundleLogTailLines is how many lines of the profiler log are collected by default
+	DefaultSupportBundleLogTailLines int64 = 500
+
+	// operatorLogScanLines is how many lines from the end of the operator log are searched for the DGDR
+	operatorLogScanLines int64 = 10000
+
+	// maxConfigMapSupportBundleSize keeps support bundle ConfigMaps below the 1MiB object limit
+	maxConfigMapSupportBundleSize = 900 * 1024
+
+	// Event reasons
+	EventReasonSupportBundleCollected = "SupportBundleCollected"
+	EventReasonSupportBundleFailed    = "SupportBundleFailed"
+
+	// Messages
+	MessageSupportBundleCollected = "Collected support bundle %s (%d bytes)"
+	MessageSupportBundleFailed    = "Failed to collect support bundle: %v"
+
+	ValidationErrorSupportBundlePVC = "supportBundle.storage PVC requires the shared profiling volume to be mounted into the operator (--results-pvc-path)"
+)
+
+// PodLogReader reads the end of the log of a pod container, which the controller-runtime client
+// cannot read
+type PodLogReader interface {
+	TailLog(ctx context.Context, namespace, pod, container string, lines int64) ([]byte, error)
+}
+
+// clientsetLogReader reads pod logs through the core API
+type clientsetLogReader struct {
+	clientset kubernetes.Interface
+}
+
+// NewPodLogReader returns a PodLogReader reading pod logs with the given clientset
+func NewPodLogReader(clientset kubernetes.Interface) PodLogReader {
+	return &clientsetLogReader{clientset: clientset}
+}
+
+func (r *clientsetLogReader) TailLog(ctx context.Context, namespace, pod, container string, lines int64) ([]byte, error) {
+	return r.clientset.CoreV1().Pods(namespace).GetLogs(pod, &corev1.PodLogOptions{
+		Container: container,
+		TailLines: &lines,
+	}).DoRaw(ctx)
+}
+
+// getSupportBundleConfigMapName returns the ConfigMap name the support bundle of a DGDR is stored in
+func getSupportBundleConfigMapName(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) string {
+	return ConfigMapSupportBundlePrefix + dgdr.Name
+}
+
+// supportBundlePVCFile returns the support bundle of a DGDR relative to the root of the volume
+func supportBundlePVCFile(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) string {
+	return path.Join(SupportBundlePVCDir, dgdr.Namespace, dgdr.Name+".tar.gz")
+}
+
+// getSupportBundleStorage returns where the support bundle of a DGDR is written, a ConfigMap by default
+func getSupportBundleStorage(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) nvidiacomv1alpha1.SupportBundleStorage {
+	if dgdr.Spec.SupportBundle == nil || dgdr.Spec.SupportBundle.Storage == "" {
+		return nvidiacomv1alpha1.SupportBundleStorageConfigMap
+	}
+	return dgdr.Spec.SupportBundle.Storage
+}
+
+// validateSupportBundle checks that the operator can write the support bundle where requested
+func (r *DynamoGraphDeploymentRequestReconciler) validateSupportBundle(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	if dgdr.Spec.SupportBundle != nil && getSupportBundleStorage(dgdr) == nvidiacomv1alpha1.SupportBundleStoragePVC && r.ResultsPVCPath == "" {
+		return errors.New(ValidationErrorSupportBundlePVC)
+	}
+	return nil
+}
+
+// supportBundle holds the files of a support bundle. Logs are kept apart so they can be truncated
+// to fit the storage.
+type supportBundle struct {
+	files    map[string][]byte
+	logs     map[string][]string
+	problems []string
+}
+
+// addYAML adds obj as a YAML file, recording why it is missing if it could not be marshalled
+func (b *supportBundle) addYAML(name string, obj interface{}) {
+	content, err := yaml.Marshal(obj)
+	if err != nil {
+		b.problem("failed to marshal %s: %v", name, err)
+		return
+	}
+	b.files[name] = content
+}
+
+// problem records a part of the bundle that could not be collected
+func (b *supportBundle) problem(format string, args ...interface{}) {
+	b.problems = append(b.problems, fmt.Sprintf(format, args...))
+}
+
+// archive writes the bundle as a gzipped tarball, keeping at most tail lines from the end of each log
+func (b *supportBundle) archive(dir string, tail int, modified time.Time) ([]byte, error) {
+	files := make(map[string][]byte, len(b.files)+len(b.logs)+1)
+	for name, content := range b.files {
+		files[name] = content
+	}
+	for name, lines := range b.logs {
+		files[name] = []byte(strings.Join(lines[max(len(lines)-tail, 0):], "\n"))
+	}
+	if len(b.problems) > 0 {
+		files["problems.txt"] = []byte(strings.Join(b.problems, "\n") + "\n")
+	}
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for _, name := range names {
+		if err := tw.WriteHeader(&tar.Header{
+			Name:    path.Join(dir, name),
+			Mode:    0o644,
+			Size:    int64(len(files[name])),
+			ModTime: modified,
+		}); err != nil {
+			return nil, err
+		}
+		if _, err := tw.Write(files[name]); err != nil {
+			return nil, err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+	if err := gz.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// logLines splits a log into lines, redacting the profiling config values of the DGDR
+func logLines(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, content []byte) []string {
+	return strings.Split(strings.TrimRight(redactMessage(dgdr, string(content)), "\n"), "\n")
+}
+
+// nodeGPUStatus is the GPU status of a node in the support bundle
+type nodeGPUStatus struct {
+	Name          string `json:"name"`
+	Product       string `json:"product,omitempty"`
+	Ready         bool   `json:"ready"`
+	Unschedulable bool   `json:"unschedulable,omitempty"`
+	Capacity      int64  `json:"capacity"`
+	Allocatable   int64  `json:"allocatable"`
+	Free          *int64 `json:"free,omitempty"`
+}
+
+// collectSupportBundle gathers the diagnostics of a failed DGDR. Parts that cannot be collected
+// are listed in problems.txt rather than failing the bundle.
+func (r *DynamoGraphDeploymentRequestReconciler) collectSupportBundle(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) *supportBundle {
+	bundle := &supportBundle{files: map[string][]byte{}, logs: map[string][]string{}}
+
+	// The DGDR itself, without the profiling config values that are not known to be safe
+	record := dgdr.DeepCopy()
+	record.ManagedFields = nil
+	if spec, err := redactedSpec(dgdr); err != nil {
+		bundle.problem("failed to redact DGDR spec: %v", err)
+		record.Spec.ProfilingConfig.Config = nil
+	} else {
+		record.Spec = *spec
+	}
+	bundle.addYAML("dgdr.yaml", record)
+
+	// The profiling job and its pods, which are gone if the DGDR failed before profiling or the
+	// job was cleaned up
+	involved := map[string]bool{dgdr.Name: true}
+	var pods []corev1.Pod
+	if jobName := GetProfilingJobName(dgdr); jobName != "" {
+		involved[jobName] = true
+		job := &batchv1.Job{}
+		if err := r.Get(ctx, types.NamespacedName{Name: jobName, Namespace: dgdr.Namespace}, job); err != nil {
+			bundle.problem("failed to get profiling job %s: %v", jobName, err)
+		} else {
+			job.ManagedFields = nil
+			bundle.addYAML("job.yaml", job)
+		}
+		podList := &corev1.PodList{}
+		if err := r.List(ctx, podList, client.InNamespace(dgdr.Namespace), client.MatchingLabels{"job-name": jobName}); err != nil {
+			bundle.problem("failed to list profiling pods: %v", err)
+		}
+		pods = podList.Items
+		for i := range pods {
+			pods[i].ManagedFields = nil
+			involved[pods[i].Name] = true
+		}
+		if len(pods) > 0 {
+			bundle.addYAML("pods.yaml", pods)
+		}
+	}
+
+	events := &corev1.EventList{}
+	if err := r.apiReader().List(ctx, events, client.InNamespace(dgdr.Namespace)); err != nil {
+		bundle.problem("failed to list events: %v", err)
+	}
+	var related []corev1.Event
+	for _, event := range events.Items {
+		if involved[event.InvolvedObject.Name] {
+			event.ManagedFields = nil
+			related = append(related, event)
+		}
+	}
+	sort.SliceStable(related, func(i, j int) bool { return eventTime(&related[i]).Before(eventTime(&related[j])) })
+	bundle.addYAML("events.yaml", related)
+
+	r.collectSupportBundleLogs(ctx, dgdr, pods, bundle)
+
+	nodes, err := r.nodeGPUStatuses(ctx, gpuResourceName(dgdr))
+	if err != nil {
+		bundle.problem("failed to collect node GPU status: %v", err)
+	}
+	bundle.addYAML("nodes.yaml", nodes)
+	return bundle
+}
+
+// collectSupportBundleLogs adds the end of the log of the latest profiling pod and the operator log
+// lines about the DGDR to the bundle
+func (r *DynamoGraphDeploymentRequestReconciler) collectSupportBundleLogs(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, pods []corev1.Pod, bundle *supportBundle) {
+	if r.LogReader == nil {
+		bundle.problem("logs are not collected, the operator has no pod log reader")
+		return
+	}
+
+	if len(pods) > 0 {
+		sort.Slice(pods, func(i, j int) bool { return pods[j].CreationTimestamp.Before(&pods[i].CreationTimestamp) })
+		lines := DefaultSupportBundleLogTailLines
+		if dgdr.Spec.SupportBundle.LogTailLines != nil {
+			lines = *dgdr.Spec.SupportBundle.LogTailLines
+		}
+		content, err := r.LogReader.TailLog(ctx, dgdr.Namespace, pods[0].Name, ContainerNameProfiler, lines)
+		if err != nil {
+			bundle.problem("failed to read the log of profiling pod %s: %v", pods[0].Name, err)
+		} else {
+			bundle.logs["profiler.log"] = logLines(dgdr, content)
+		}
+	}
+
+	if r.OperatorPod.Name == "" {
+		bundle.problem("operator logs are not collected, the operator pod is unknown")
+		return
+	}
+	content, err := r.LogReader.TailLog(ctx, r.OperatorPod.Namespace, r.OperatorPod.Name, ContainerNameOperator, operatorLogScanLines)
+	if err != nil {
+		bundle.problem("failed to read the operator log: %v", err)
+		return
+	}
+	var excerpt []string
+	for _, line := range logLines(dgdr, content) {
+		fields := logLineFields(line)
+		if fields["name"] == dgdr.Name && fields["namespace"] == dgdr.Namespace {
+			excerpt = append(excerpt, line)
+		}
+	}
+	bundle.logs["operator.log"] = excerpt
+}
+
+// logLineFields returns the structured fields of an operator log line, written either by the JSON
+// encoder or by the console encoder, which ends the line with the fields as a JSON object. Lines
+// without fields return nil.
+func logLineFields(line string) map[string]interface{} {
+	encoded := line[strings.LastIndex(line, "\t")+1:]
+	if !strings.HasPrefix(encoded, "{") {
+		return nil
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal([]byte(encoded), &fields); err != nil {
+		return nil
+	}
+	return fields
+}
+
+// nodeGPUStatuses returns the GPU status of the nodes with GPUs, sorted by name
+func (r *DynamoGraphDeploymentRequestReconciler) nodeGPUStatuses(ctx context.Context, resourceName corev1.ResourceName) ([]nodeGPUStatus, error) {
+	nodes := &corev1.NodeList{}
+	if err := r.List(ctx, nodes); err != nil {
+		return nil, fmt.Errorf("failed to list nodes: %w", err)
+	}
+	free, err := r.freeNodeGPUs(ctx, resourceName)
+	if err != nil {
+		return nil, err
+	}
+	freeByName := map[string]int64{}
+	for _, node := range free {
+		freeByName[node.name] = node.free
+	}
+
+	var statuses []nodeGPUStatus
+	for i := range nodes.Items {
+		node := &nodes.Items[i]
+		capacity := node.Status.Capacity[resourceName]
+		if capacity.Value() == 0 {
+			continue
+		}
+		allocatable := node.Status.Allocatable[resourceName]
+		status := nodeGPUStatus{
+			Name:          node.Name,
+			Product:       node.Labels[LabelGPUProduct],
+			Ready:         isNodeReady(node),
+			Unschedulable: node.Spec.Unschedulable,
+			Capacity:      capacity.Value(),
+			Allocatable:   allocatable.Value(),
+		}
+		if gpus, ok := freeByName[node.Name]; ok {
+			status.Free = &gpus
+		}
+		statuses = append(statuses, status)
+	}
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Name < statuses[j].Name })
+	return statuses, nil
+}
+
+// storeSupportBundle writes the bundle to the storage of the DGDR and returns its status. Logs are
+// shortened until the bundle fits a ConfigMap.
+func (r *DynamoGraphDeploymentRequestReconciler) storeSupportBundle(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, bundle *supportBundle) (*nvidiacomv1alpha1.SupportBundleStatus, error) {
+	now := metav1.Now()
+	dir := fmt.Sprintf("support-bundle-%s-%s", dgdr.Name, now.UTC().Format("20060102T150405Z"))
+	tail := 0
+	for _, lines := range bundle.logs {
+		tail = max(tail, len(lines))
+	}
+	content, err := bundle.archive(dir, tail, now.Time)
+	if err != nil {
+		return nil, fmt.Errorf("failed to archive support bundle: %w", err)
+	}
+	status := &nvidiacomv1alpha1.SupportBundleStatus{CollectedTime: now}
+
+	if getSupportBundleStorage(dgdr) == nvidiacomv1alpha1.SupportBundleStoragePVC {
+		file := filepath.Join(r.ResultsPVCPath, supportBundlePVCFile(dgdr))
+		if err := os.MkdirAll(filepath.Dir(file), 0o755); err != nil {
+			return nil, fmt.Errorf("failed to create support bundle directory: %w", err)
+		}
+		if err := os.WriteFile(file, content, 0o644); err != nil {
+			return nil, fmt.Errorf("failed to write support bundle: %w", err)
+		}
+		status.Reference = fmt.Sprintf("pvc/dynamo-pvc/%s", supportBundlePVCFile(dgdr))
+		status.Size = int64(len(content))
+		return status, nil
+	}
+
+	for len(content) > maxConfigMapSupportBundleSize && tail > 0 {
+		tail /= 2
+		status.Truncated = true
+		if content, err = bundle.archive(dir, tail, now.Time); err != nil {
+			return nil, fmt.Errorf("failed to archive support bundle: %w", err)
+		}
+	}
+	if len(content) > maxConfigMapSupportBundleSize {
+		return nil, fmt.Errorf("support bundle of %d bytes does not fit a ConfigMap without logs", len(content))
+	}
+
+	cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: getSupportBundleConfigMapName(dgdr), Namespace: dgdr.Namespace}}
+	if _, err := controllerutil.CreateOrUpdate(ctx, r.Client, cm, func() error {
+		cm.Labels = map[string]string{
+			LabelDGDRName:  dgdr.Name,
+			LabelManagedBy: LabelValueDynamoOperator,
+		}
+		cm.Data = nil
+		cm.BinaryData = map[string][]byte{SupportBundleKey: content}
+		return controllerutil.SetControllerReference(dgdr, cm, r.Client.Scheme())
+	}); err != nil {
+		return nil, fmt.Errorf("failed to write support bundle ConfigMap: %w", err)
+	}
+	r.trackOwnedResource(ctx, dgdr, nvidiacomv1alpha1.OwnedResourceRoleSupportBundle, cm)
+	status.Reference = fmt.Sprintf("configmap/%s", cm.Name)
+	status.Size = int64(len(content))
+	return status, nil
+}
+
+// handleSupportBundle collects the support bundle of a failed DGDR once per failure, when
+// spec.supportBundle is set. Only API errors that may not recur are retried.
+func (r *DynamoGraphDeploymentRequestReconciler) handleSupportBundle(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) (ctrl.Result, error) {
+	if dgdr.Spec.SupportBundle == nil || dgdr.Status.SupportBundle != nil {
+		return ctrl.Result{}, nil
+	}
+	logger := log.FromContext(ctx)
+
+	// The DGDR may have failed validation because the volume is not mounted
+	if err := r.validateSupportBundle(dgdr); err != nil {
+		return r.supportBundleFailed(ctx, dgdr, err)
+	}
+
+	status, err := r.storeSupportBundle(ctx, dgdr, r.collectSupportBundle(ctx, dgdr))
+	if apierrors.IsConflict(err) {
+		return ctrl.Result{Requeue: true}, nil
+	}
+	if isTransientAPIError(err) {
+		r.Recorder.Event(dgdr, corev1.EventTypeWarning, EventReasonSupportBundleFailed, fmt.Sprintf(MessageSupportBundleFailed, err))
+		return ctrl.Result{}, err
+	}
+	if err != nil {
+		return r.supportBundleFailed(ctx, dgdr, err)
+	}
+
+	logger.Info("Collected support bundle", "reference", status.Reference, "size", status.Size)
+	r.Recorder.Event(dgdr, corev1.EventTypeNormal, EventReasonSupportBundleCollected,
+		fmt.Sprintf(MessageSupportBundleCollected, status.Reference, status.Size))
+	dgdr.Status.SupportBundle = status
+	return ctrl.Result{}, r.updateStatus(ctx, dgdr)
+}
+
+// supportBundleFailed records in the status that the support bundle of the DGDR cannot be stored,
+// so that its collection is not retried
+func (r *DynamoGraphDeploymentRequestReconciler) supportBundleFailed(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, err error) (ctrl.Result, error) {
+	log.FromContext(ctx).Info("Support bundle not collected", "error", err.Error())
+	r.Recorder.Event(dgdr, corev1.EventTypeWarning, EventReasonSupportBundleFailed, fmt.Sprintf(MessageSupportBundleFailed, err))
+	dgdr.Status.SupportBundle = &nvidiacomv1alpha1.SupportBundleStatus{
+		CollectedTime: metav1.Now(),
+		Error:         err.Error(),
+	}
+	return ctrl.Result{}, r.updateStatus(ctx, dgdr)
+}
+
+// isTransientAPIError reports whether err is an API server error that may not recur when retried
+func isTransientAPIError(err error) bool {
+	return apierrors.IsServerTimeout(err) || apierrors.IsTimeout(err) || apierrors.IsTooManyRequests(err) ||
+		apierrors.IsServiceUnavailable(err) || apierrors.IsInternalError(err)
+}
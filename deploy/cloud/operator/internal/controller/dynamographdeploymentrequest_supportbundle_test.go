@@ -0,0 +1,246 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// fakeLogReader returns the log of a pod by its name
+type fakeLogReader map[string]string
+
+func (f fakeLogReader) TailLog(_ context.Context, _, pod, _ string, _ int64) ([]byte, error) {
+	content, ok := f[pod]
+	if !ok {
+		return nil, fmt.Errorf("pod %s not found", pod)
+	}
+	return []byte(content), nil
+}
+
+// untarBundle returns the files of a support bundle by their name within the bundle directory
+func untarBundle(content []byte) map[string]string {
+	gz, err := gzip.NewReader(bytes.NewReader(content))
+	Expect(err).NotTo(HaveOccurred())
+	tr := tar.NewReader(gz)
+	files := map[string]string{}
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return files
+		}
+		Expect(err).NotTo(HaveOccurred())
+		data, err := io.ReadAll(tr)
+		Expect(err).NotTo(HaveOccurred())
+		files[path.Base(header.Name)] = string(data)
+	}
+}
+
+var _ = Describe("DGDR Support Bundle", func() {
+	ctx := context.Background()
+	var reconciler *DynamoGraphDeploymentRequestReconciler
+
+	BeforeEach(func() {
+		reconciler = &DynamoGraphDeploymentRequestReconciler{
+			Client:      k8sClient,
+			Recorder:    record.NewFakeRecorder(100),
+			RBACManager: &MockRBACManager{},
+			OperatorPod: types.NamespacedName{Name: "dynamo-operator-0", Namespace: "dynamo-system"},
+		}
+	})
+
+	// setup creates a Failed DGDR whose profiling job and pod are still around
+	setup := func(name string, spec *nvidiacomv1alpha1.SupportBundleSpec) *nvidiacomv1alpha1.DynamoGraphDeploymentRequest {
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model:   "test-model",
+				Backend: "vllm",
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					ProfilerImage: "test-profiler:latest",
+				},
+				SupportBundle: spec,
+			},
+		}
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		DeferCleanup(func() { _ = k8sClient.Delete(ctx, dgdr) })
+
+		jobName := name + "-profile"
+		podSpec := corev1.PodSpec{
+			RestartPolicy: corev1.RestartPolicyNever,
+			Containers:    []corev1.Container{{Name: ContainerNameProfiler, Image: "test-profiler:latest"}},
+		}
+		job := &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{Name: jobName, Namespace: defaultNamespace},
+			Spec: batchv1.JobSpec{
+				Template: corev1.PodTemplateSpec{Spec: podSpec},
+			},
+		}
+		Expect(k8sClient.Create(ctx, job)).Should(Succeed())
+		DeferCleanup(func() { _ = k8sClient.Delete(ctx, job) })
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      jobName + "-abcde",
+				Namespace: defaultNamespace,
+				Labels:    map[string]string{"job-name": jobName},
+			},
+			Spec: podSpec,
+		}
+		Expect(k8sClient.Create(ctx, pod)).Should(Succeed())
+		DeferCleanup(func() { _ = k8sClient.Delete(ctx, pod) })
+
+		dgdr.Status.State = StateFailed
+		dgdr.Status.ObservedGeneration = dgdr.Generation
+		dgdr.Status.FailureReason = nvidiacomv1alpha1.FailureReasonProfilerCrash
+		dgdr.Status.Attempts = []nvidiacomv1alpha1.ProfilingAttempt{{Attempt: 1, JobName: jobName, StartTime: metav1.Now()}}
+		Expect(k8sClient.Status().Update(ctx, dgdr)).Should(Succeed())
+		return dgdr
+	}
+
+	reconcileDGDR := func(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) *nvidiacomv1alpha1.DynamoGraphDeploymentRequest {
+		request := reconcile.Request{NamespacedName: types.NamespacedName{Name: dgdr.Name, Namespace: dgdr.Namespace}}
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		updated := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{}
+		Expect(k8sClient.Get(ctx, request.NamespacedName, updated)).Should(Succeed())
+		return updated
+	}
+
+	bundleConfigMap := func(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) *corev1.ConfigMap {
+		cm := &corev1.ConfigMap{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: getSupportBundleConfigMapName(dgdr), Namespace: dgdr.Namespace}, cm)).Should(Succeed())
+		return cm
+	}
+
+	It("Should collect the diagnostics of a failed DGDR into a ConfigMap once", func() {
+		dgdr := setup("test-dgdr-bundle", &nvidiacomv1alpha1.SupportBundleSpec{})
+		reconciler.LogReader = fakeLogReader{
+			"test-dgdr-bundle-profile-abcde": "loading model\nCUDA out of memory\n",
+			"dynamo-operator-0": strings.Join([]string{
+				`{"msg":"Reconciling DGDR","name":"test-dgdr-bundle","namespace":"default"}`,
+				`{"msg":"Reconciling DGDR","name":"other-dgdr","namespace":"default"}`,
+				`{"msg":"Profiling job failed","name":"test-dgdr-bundle","namespace":"default"}`,
+				`{"msg":"Reconciling DGDR","name":"test-dgdr-bundle-copy","namespace":"default"}`,
+				"2025-01-01T00:00:00Z\tINFO\tJob deleted\t{\"name\":\"test-dgdr-bundle\",\"namespace\":\"default\"}",
+				"Listed test-dgdr-bundle in default",
+			}, "\n"),
+		}
+
+		updated := reconcileDGDR(dgdr)
+		Expect(updated.Status.SupportBundle).NotTo(BeNil())
+		Expect(updated.Status.SupportBundle.Reference).Should(Equal("configmap/" + getSupportBundleConfigMapName(dgdr)))
+		Expect(updated.Status.SupportBundle.Truncated).To(BeFalse())
+
+		cm := bundleConfigMap(dgdr)
+		Expect(cm.OwnerReferences).To(HaveLen(1))
+		Expect(int64(len(cm.BinaryData[SupportBundleKey]))).Should(Equal(updated.Status.SupportBundle.Size))
+		files := untarBundle(cm.BinaryData[SupportBundleKey])
+		Expect(files).To(HaveKey("dgdr.yaml"))
+		Expect(files).To(HaveKey("job.yaml"))
+		Expect(files).To(HaveKey("events.yaml"))
+		Expect(files).To(HaveKey("nodes.yaml"))
+		Expect(files["pods.yaml"]).To(ContainSubstring("test-dgdr-bundle-profile-abcde"))
+		Expect(files["profiler.log"]).To(ContainSubstring("CUDA out of memory"))
+		Expect(files["operator.log"]).To(ContainSubstring("Profiling job failed"))
+		Expect(files["operator.log"]).To(ContainSubstring("Job deleted"))
+		Expect(files["operator.log"]).NotTo(ContainSubstring("other-dgdr"))
+		Expect(files["operator.log"]).NotTo(ContainSubstring("test-dgdr-bundle-copy"))
+		Expect(files["operator.log"]).NotTo(ContainSubstring("Listed"))
+
+		// The bundle is not collected again for the same failure
+		Expect(reconcileDGDR(updated).Status.SupportBundle.CollectedTime).Should(Equal(updated.Status.SupportBundle.CollectedTime))
+	})
+
+	It("Should list what could not be collected", func() {
+		dgdr := setup("test-dgdr-bundle-nologs", &nvidiacomv1alpha1.SupportBundleSpec{})
+		reconciler.OperatorPod = types.NamespacedName{}
+
+		reconcileDGDR(dgdr)
+		files := untarBundle(bundleConfigMap(dgdr).BinaryData[SupportBundleKey])
+		Expect(files).NotTo(HaveKey("profiler.log"))
+		Expect(files["problems.txt"]).To(ContainSubstring("no pod log reader"))
+	})
+
+	It("Should truncate logs to fit the ConfigMap", func() {
+		dgdr := setup("test-dgdr-bundle-truncated", &nvidiacomv1alpha1.SupportBundleSpec{LogTailLines: ptr.To(int64(10000))})
+		// Random lines do not compress
+		lines := make([]string, 10000)
+		for i := range lines {
+			raw := make([]byte, 128)
+			_, _ = rand.Read(raw)
+			lines[i] = hex.EncodeToString(raw)
+		}
+		reconciler.LogReader = fakeLogReader{"test-dgdr-bundle-truncated-profile-abcde": strings.Join(lines, "\n")}
+
+		updated := reconcileDGDR(dgdr)
+		Expect(updated.Status.SupportBundle.Truncated).To(BeTrue())
+		Expect(updated.Status.SupportBundle.Size).Should(BeNumerically("<=", maxConfigMapSupportBundleSize))
+		files := untarBundle(bundleConfigMap(dgdr).BinaryData[SupportBundleKey])
+		Expect(files["profiler.log"]).To(HaveSuffix(lines[len(lines)-1]))
+	})
+
+	It("Should write the bundle to the profiling output volume", func() {
+		reconciler.ResultsPVCPath = GinkgoT().TempDir()
+		dgdr := setup("test-dgdr-bundle-pvc", &nvidiacomv1alpha1.SupportBundleSpec{Storage: nvidiacomv1alpha1.SupportBundleStoragePVC})
+
+		updated := reconcileDGDR(dgdr)
+		Expect(updated.Status.SupportBundle.Reference).Should(Equal("pvc/dynamo-pvc/" + supportBundlePVCFile(dgdr)))
+		content, err := os.ReadFile(filepath.Join(reconciler.ResultsPVCPath, supportBundlePVCFile(dgdr)))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(untarBundle(content)).To(HaveKey("dgdr.yaml"))
+	})
+
+	It("Should record a bundle that cannot be stored without retrying it", func() {
+		// The profiling output volume is not a directory
+		reconciler.ResultsPVCPath = filepath.Join(GinkgoT().TempDir(), "file")
+		Expect(os.WriteFile(reconciler.ResultsPVCPath, nil, 0o644)).Should(Succeed())
+		dgdr := setup("test-dgdr-bundle-unstored", &nvidiacomv1alpha1.SupportBundleSpec{Storage: nvidiacomv1alpha1.SupportBundleStoragePVC})
+
+		updated := reconcileDGDR(dgdr)
+		Expect(updated.Status.SupportBundle).NotTo(BeNil())
+		Expect(updated.Status.SupportBundle.Reference).To(BeEmpty())
+		Expect(updated.Status.SupportBundle.Error).To(ContainSubstring("failed to create support bundle directory"))
+		Expect(reconcileDGDR(updated).Status.SupportBundle.CollectedTime).Should(Equal(updated.Status.SupportBundle.CollectedTime))
+	})
+
+	It("Should not collect a bundle unless requested", func() {
+		dgdr := setup("test-dgdr-bundle-off", nil)
+		Expect(reconcileDGDR(dgdr).Status.SupportBundle).To(BeNil())
+	})
+})
@@ -0,0 +1,121 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	commonController "github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/controller_common"
+)
+
+const (
+	// DefaultJobTerminationTimeout is how long the finalizer of a deleted DGDR waits for the pods of
+	// its profiling job to terminate and release their GPUs
+	DefaultJobTerminationTimeout = 5 * time.Minute
+
+	// jobTerminationPollInterval is how often a deleted DGDR checks again for terminating profiling job pods
+	jobTerminationPollInterval = 5 * time.Second
+
+	// Event reasons
+	EventReasonJobTerminationTimedOut = "ProfilingJobTerminationTimedOut"
+
+	// Messages
+	MessageJobTerminationTimedOut = "Removing the finalizer although %d pods of profiling job %s are still terminating after %s"
+)
+
+// handleProfilingJobTermination keeps the finalizer of a deleted DGDR in place while its profiling
+// job pods terminate, checking them again every jobTerminationPollInterval
+func (r *DynamoGraphDeploymentRequestReconciler) handleProfilingJobTermination(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) (*ctrl.Result, error) {
+	if dgdr.DeletionTimestamp.IsZero() || !commonController.ContainsFinalizer(dgdr) {
+		return nil, nil
+	}
+	terminating, err := r.awaitProfilingJobTermination(ctx, dgdr)
+	if err != nil {
+		return &ctrl.Result{}, err
+	}
+	if terminating {
+		return &ctrl.Result{RequeueAfter: jobTerminationPollInterval}, nil
+	}
+	return nil, nil
+}
+
+// awaitProfilingJobTermination deletes the profiling job of a deleted DGDR and reports whether its
+// pods are still terminating, so that a DGDR recreated under the same name does not schedule
+// profiling onto GPUs the previous job still holds. Garbage collection would only delete the job
+// once the finalizer is removed. After JobTerminationTimeout past the DGDR's deletion the pods are
+// no longer waited for, with a warning event.
+func (r *DynamoGraphDeploymentRequestReconciler) awaitProfilingJobTermination(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) (bool, error) {
+	if r.JobTerminationTimeout <= 0 || r.isMockProfiling() {
+		return false, nil
+	}
+	logger := log.FromContext(ctx)
+	jobName := GetProfilingJobName(dgdr)
+
+	job := &batchv1.Job{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: dgdr.Namespace, Name: jobName}, job); client.IgnoreNotFound(err) != nil {
+		return false, fmt.Errorf("failed to get profiling job %s: %w", jobName, err)
+	} else if err == nil && job.DeletionTimestamp == nil {
+		if err := r.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground)); client.IgnoreNotFound(err) != nil {
+			return false, fmt.Errorf("failed to delete profiling job %s: %w", jobName, err)
+		}
+		logger.Info("Deleted profiling job of the deleted DGDR", "job", jobName)
+	}
+
+	pods := &corev1.PodList{}
+	if err := r.List(ctx, pods, client.InNamespace(dgdr.Namespace), client.MatchingLabels{"job-name": jobName}); err != nil {
+		return false, fmt.Errorf("failed to list pods of profiling job %s: %w", jobName, err)
+	}
+	remaining := 0
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		// Background propagation deletes the pods once the job is gone; delete them now so their
+		// termination grace period already runs
+		if pod.DeletionTimestamp == nil {
+			if err := r.Delete(ctx, pod); err != nil {
+				if apierrors.IsNotFound(err) {
+					continue
+				}
+				return false, fmt.Errorf("failed to delete pod %s of profiling job %s: %w", pod.Name, jobName, err)
+			}
+		}
+		remaining++
+	}
+	if remaining == 0 {
+		return false, nil
+	}
+
+	if dgdr.DeletionTimestamp != nil && time.Since(dgdr.DeletionTimestamp.Time) >= r.JobTerminationTimeout {
+		logger.Info("Profiling job pods did not terminate in time, removing the finalizer", "job", jobName, "pods", remaining)
+		r.Recorder.Event(dgdr, corev1.EventTypeWarning, EventReasonJobTerminationTimedOut,
+			fmt.Sprintf(MessageJobTerminationTimedOut, remaining, jobName, r.JobTerminationTimeout))
+		return false, nil
+	}
+	logger.Info("Waiting for profiling job pods to terminate", "job", jobName, "pods", remaining)
+	return true, nil
+}
@@ -0,0 +1,124 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	commonController "github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/controller_common"
+)
+
+var _ = Describe("DGDR Profiling Job Termination", func() {
+	var (
+		reconciler *DynamoGraphDeploymentRequestReconciler
+		recorder   *record.FakeRecorder
+	)
+
+	BeforeEach(func() {
+		recorder = record.NewFakeRecorder(100)
+		reconciler = &DynamoGraphDeploymentRequestReconciler{
+			Client:                k8sClient,
+			Recorder:              recorder,
+			RBACManager:           &MockRBACManager{},
+			JobTerminationTimeout: time.Minute,
+		}
+	})
+
+	// createJobPod creates a running pod of the profiling job. Bound to a node without a kubelet to
+	// confirm its deletion, it stays Terminating once deleted, like a pod still releasing its GPUs.
+	createJobPod := func(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, name string) *corev1.Pod {
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: dgdr.Namespace, Labels: map[string]string{"job-name": GetProfilingJobName(dgdr)}},
+			Spec: corev1.PodSpec{
+				NodeName:   "test-termination-node",
+				Containers: []corev1.Container{{Name: "main", Image: "busybox"}},
+			},
+		}
+		Expect(k8sClient.Create(ctx, pod)).Should(Succeed())
+		DeferCleanup(func() { _ = k8sClient.Delete(ctx, pod, client.GracePeriodSeconds(0)) })
+		return pod
+	}
+
+	It("Should delete the profiling job and wait for its pods to terminate", func() {
+		ctx := context.Background()
+		now := metav1.Now()
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-dgdr-termination", Namespace: defaultNamespace, DeletionTimestamp: &now},
+		}
+		job := &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{Name: GetProfilingJobName(dgdr), Namespace: dgdr.Namespace},
+			Spec: batchv1.JobSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						RestartPolicy: corev1.RestartPolicyNever,
+						Containers:    []corev1.Container{{Name: "profiler", Image: "test-profiler:latest"}},
+					},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, job)).Should(Succeed())
+		DeferCleanup(func() {
+			_ = k8sClient.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground))
+		})
+		pod := createJobPod(ctx, dgdr, "test-termination-profiler-0")
+
+		commonController.AddFinalizer(dgdr)
+		result, err := reconciler.handleProfilingJobTermination(ctx, dgdr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).Should(Equal(&ctrl.Result{RequeueAfter: jobTerminationPollInterval}))
+		err = k8sClient.Get(ctx, client.ObjectKeyFromObject(job), &batchv1.Job{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		terminating := &corev1.Pod{}
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(pod), terminating)).Should(Succeed())
+		Expect(terminating.DeletionTimestamp).NotTo(BeNil())
+
+		// Once the kubelet confirmed the termination the finalizer can be removed
+		Expect(k8sClient.Delete(ctx, pod, client.GracePeriodSeconds(0))).Should(Succeed())
+		Expect(reconciler.handleProfilingJobTermination(ctx, dgdr)).Should(BeNil())
+	})
+
+	It("Should stop waiting after the timeout", func() {
+		ctx := context.Background()
+		deleted := metav1.NewTime(time.Now().Add(-2 * time.Minute))
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-dgdr-termination-timeout", Namespace: defaultNamespace, DeletionTimestamp: &deleted},
+		}
+		commonController.AddFinalizer(dgdr)
+		createJobPod(ctx, dgdr, "test-termination-timeout-profiler-0")
+
+		Expect(reconciler.handleProfilingJobTermination(ctx, dgdr)).Should(BeNil())
+		Expect(recorder.Events).Should(Receive(ContainSubstring(EventReasonJobTerminationTimedOut)))
+
+		// Waiting can be disabled
+		reconciler.JobTerminationTimeout = 0
+		dgdr.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+		Expect(reconciler.handleProfilingJobTermination(ctx, dgdr)).Should(BeNil())
+	})
+})
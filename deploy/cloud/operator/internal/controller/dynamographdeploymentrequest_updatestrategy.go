@@ -0,0 +1,122 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+)
+
+const (
+	// ConditionTypeUpdatePending is True while a regenerated spec waits to be applied to the DGD
+	// with the Manual update strategy
+	ConditionTypeUpdatePending = "UpdatePending"
+
+	// Condition reasons
+	ReasonManualUpdateStrategy = "ManualUpdateStrategy"
+
+	// Event reasons
+	EventReasonDeploymentRecreating = "DeploymentRecreating"
+
+	// Messages
+	MessageUpdatePending        = "Generated spec %s is not applied to DynamoGraphDeployment %s, which runs spec %s; set the " + AnnotationAction + " annotation to " + ActionApplyUpdate + " to roll it out"
+	MessageDeploymentRecreating = "Deleting DynamoGraphDeployment %s to recreate it with the regenerated spec"
+
+	// recreateWaitInterval is how often the deletion of a DGD being recreated is checked
+	recreateWaitInterval = 5 * time.Second
+)
+
+// getUpdateStrategy returns how regenerated specs are applied to the DGD, RollingUpdate by default
+func getUpdateStrategy(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) nvidiacomv1alpha1.DGDUpdateStrategy {
+	if dgdr.Spec.UpdateStrategy == "" {
+		return nvidiacomv1alpha1.DGDUpdateStrategyRollingUpdate
+	}
+	return dgdr.Spec.UpdateStrategy
+}
+
+// setUpdatePending raises the UpdatePending condition for a regenerated spec that the Manual update
+// strategy keeps from the DGD. The condition is persisted by the next status update.
+func (r *DynamoGraphDeploymentRequestReconciler) setUpdatePending(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, dgd *nvidiacomv1alpha1.DynamoGraphDeployment, applied, hash string) {
+	message := fmt.Sprintf(MessageUpdatePending, hash, dgd.Name, applied)
+	if condition := meta.FindStatusCondition(dgdr.Status.Conditions, ConditionTypeUpdatePending); condition != nil &&
+		condition.Status == metav1.ConditionTrue && condition.Message == message {
+		return
+	}
+	r.Recorder.Event(dgdr, corev1.EventTypeNormal, ReasonManualUpdateStrategy, message)
+	meta.SetStatusCondition(&dgdr.Status.Conditions, metav1.Condition{
+		Type:               ConditionTypeUpdatePending,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: dgdr.Generation,
+		Reason:             ReasonManualUpdateStrategy,
+		Message:            message,
+	})
+}
+
+// recreateDGD deletes the DGD so that it is created again with the regenerated spec once its
+// deletion completed
+func (r *DynamoGraphDeploymentRequestReconciler) recreateDGD(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, dgd *nvidiacomv1alpha1.DynamoGraphDeployment) (ctrl.Result, error) {
+	log.FromContext(ctx).Info("Deleting DGD to recreate it with the regenerated spec", "name", dgd.Name)
+	if err := r.Delete(ctx, dgd, client.PropagationPolicy(metav1.DeletePropagationForeground)); client.IgnoreNotFound(err) != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to delete DynamoGraphDeployment %s: %w", dgd.Name, err)
+	}
+	r.Recorder.Event(dgdr, corev1.EventTypeNormal, EventReasonDeploymentRecreating, fmt.Sprintf(MessageDeploymentRecreating, dgd.Name))
+
+	// The DGD is created again once it is gone, rather than reported as deleted by the user
+	untrackOwnedResource(dgdr, "DynamoGraphDeployment", dgd.Namespace, dgd.Name)
+	dgdr.Status.Deployment.Created = false
+	dgdr.Status.Endpoint = nil
+	return ctrl.Result{RequeueAfter: recreateWaitInterval}, r.updateStatus(ctx, dgdr)
+}
+
+// deploymentTerminating reports whether the DGD of the DGDR is still being deleted, e.g. while it
+// is recreated
+func (r *DynamoGraphDeploymentRequestReconciler) deploymentTerminating(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) (bool, error) {
+	if dgdr.Status.Deployment == nil || dgdr.Status.Deployment.Name == "" {
+		return false, nil
+	}
+	dgd := &nvidiacomv1alpha1.DynamoGraphDeployment{}
+	err := r.Get(ctx, types.NamespacedName{Name: dgdr.Status.Deployment.Name, Namespace: dgdr.Status.Deployment.Namespace}, dgd)
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return !dgd.DeletionTimestamp.IsZero(), nil
+}
+
+// applyPendingUpdate rolls the regenerated spec that the Manual update strategy held back out to
+// the DGD in place, on request of the apply-update action
+func (r *DynamoGraphDeploymentRequestReconciler) applyPendingUpdate(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	meta.RemoveStatusCondition(&dgdr.Status.Conditions, ConditionTypeUpdatePending)
+	dgdr.Status.State = StateDeploying
+	_, err := r.createDGD(ctx, dgdr)
+	return err
+}
@@ -0,0 +1,184 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var _ = Describe("DGDR Update Strategy", func() {
+	ctx := context.Background()
+	var reconciler *DynamoGraphDeploymentRequestReconciler
+
+	BeforeEach(func() {
+		reconciler = &DynamoGraphDeploymentRequestReconciler{
+			Client:      k8sClient,
+			Recorder:    record.NewFakeRecorder(100),
+			RBACManager: &MockRBACManager{},
+		}
+	})
+
+	// setup creates a Deploying DGDR whose DGD runs a spec older than its generated spec
+	setup := func(name string, strategy nvidiacomv1alpha1.DGDUpdateStrategy) (*nvidiacomv1alpha1.DynamoGraphDeploymentRequest, types.NamespacedName) {
+		dgdKey := types.NamespacedName{Name: name + "-dgd", Namespace: defaultNamespace}
+		dgd := &nvidiacomv1alpha1.DynamoGraphDeployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        dgdKey.Name,
+				Namespace:   dgdKey.Namespace,
+				Labels:      map[string]string{LabelDGDRName: name, LabelDGDRNamespace: defaultNamespace},
+				Annotations: map[string]string{AnnotationGeneratedSpecHash: "previous"},
+			},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentSpec{
+				Services: map[string]*nvidiacomv1alpha1.DynamoComponentDeploymentSharedSpec{"Frontend": {}},
+			},
+		}
+		Expect(k8sClient.Create(ctx, dgd)).Should(Succeed())
+		DeferCleanup(func() {
+			_ = k8sClient.Get(ctx, dgdKey, dgd)
+			dgd.Finalizers = nil
+			_ = k8sClient.Update(ctx, dgd)
+			_ = k8sClient.Delete(ctx, dgd)
+		})
+
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model:   "test-model",
+				Backend: "vllm",
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					ProfilerImage: "test-profiler:latest",
+				},
+				AutoApply:      true,
+				UpdateStrategy: strategy,
+			},
+		}
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		DeferCleanup(func() { _ = k8sClient.Delete(ctx, dgdr) })
+		dgdr.Status.State = StateDeploying
+		dgdr.Status.ObservedGeneration = dgdr.Generation
+		dgdr.Status.GeneratedDeployment = &runtime.RawExtension{Raw: []byte(
+			`{"apiVersion":"nvidia.com/v1alpha1","kind":"DynamoGraphDeployment","metadata":{"name":"` + dgdKey.Name + `"},"spec":{"services":{"Frontend":{"replicas":2}}}}`)}
+		dgdr.Status.Deployment = &nvidiacomv1alpha1.DeploymentStatus{
+			Name:      dgdKey.Name,
+			Namespace: dgdKey.Namespace,
+			Created:   true,
+			State:     "Ready",
+		}
+		Expect(k8sClient.Status().Update(ctx, dgdr)).Should(Succeed())
+		return dgdr, dgdKey
+	}
+
+	reconcileDGDR := func(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) *nvidiacomv1alpha1.DynamoGraphDeploymentRequest {
+		request := reconcile.Request{NamespacedName: types.NamespacedName{Name: dgdr.Name, Namespace: dgdr.Namespace}}
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		updated := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{}
+		Expect(k8sClient.Get(ctx, request.NamespacedName, updated)).Should(Succeed())
+		return updated
+	}
+
+	appliedHash := func(key types.NamespacedName) string {
+		dgd := &nvidiacomv1alpha1.DynamoGraphDeployment{}
+		Expect(k8sClient.Get(ctx, key, dgd)).Should(Succeed())
+		return dgd.Annotations[AnnotationGeneratedSpecHash]
+	}
+
+	It("Should patch the DGD in place with RollingUpdate", func() {
+		dgdr, dgdKey := setup("test-dgdr-update-rolling", "")
+		before := &nvidiacomv1alpha1.DynamoGraphDeployment{}
+		Expect(k8sClient.Get(ctx, dgdKey, before)).Should(Succeed())
+
+		reconcileDGDR(dgdr)
+		after := &nvidiacomv1alpha1.DynamoGraphDeployment{}
+		Expect(k8sClient.Get(ctx, dgdKey, after)).Should(Succeed())
+		Expect(after.UID).Should(Equal(before.UID))
+		Expect(after.Annotations[AnnotationGeneratedSpecHash]).ShouldNot(Equal("previous"))
+	})
+
+	It("Should delete and recreate the DGD with Recreate", func() {
+		dgdr, dgdKey := setup("test-dgdr-update-recreate", nvidiacomv1alpha1.DGDUpdateStrategyRecreate)
+		before := &nvidiacomv1alpha1.DynamoGraphDeployment{}
+		Expect(k8sClient.Get(ctx, dgdKey, before)).Should(Succeed())
+
+		updated := reconcileDGDR(dgdr)
+		Expect(updated.Status.State).Should(Equal(StateDeploying))
+		Expect(updated.Status.Deployment.Created).To(BeFalse())
+
+		// Without a garbage collector in the test environment the foreground deletion never completes
+		terminating := &nvidiacomv1alpha1.DynamoGraphDeployment{}
+		Expect(k8sClient.Get(ctx, dgdKey, terminating)).Should(Succeed())
+		Expect(terminating.DeletionTimestamp).NotTo(BeNil())
+		Expect(reconcileDGDR(dgdr).Status.Deployment.Created).To(BeFalse())
+
+		terminating.Finalizers = nil
+		Expect(k8sClient.Update(ctx, terminating)).Should(Succeed())
+		Eventually(func() bool {
+			return apierrors.IsNotFound(k8sClient.Get(ctx, dgdKey, &nvidiacomv1alpha1.DynamoGraphDeployment{}))
+		}).Should(BeTrue())
+
+		updated = reconcileDGDR(dgdr)
+		Expect(updated.Status.Deployment.Created).To(BeTrue())
+		after := &nvidiacomv1alpha1.DynamoGraphDeployment{}
+		Expect(k8sClient.Get(ctx, dgdKey, after)).Should(Succeed())
+		Expect(after.UID).ShouldNot(Equal(before.UID))
+		Expect(after.Annotations[AnnotationGeneratedSpecHash]).ShouldNot(Equal("previous"))
+	})
+
+	It("Should only report the pending update with Manual until it is applied", func() {
+		dgdr, dgdKey := setup("test-dgdr-update-manual", nvidiacomv1alpha1.DGDUpdateStrategyManual)
+
+		updated := reconcileDGDR(dgdr)
+		Expect(appliedHash(dgdKey)).Should(Equal("previous"))
+		condition := meta.FindStatusCondition(updated.Status.Conditions, ConditionTypeUpdatePending)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).Should(Equal(metav1.ConditionTrue))
+		Expect(condition.Reason).Should(Equal(ReasonManualUpdateStrategy))
+
+		patch := client.MergeFrom(updated.DeepCopy())
+		updated.Annotations = map[string]string{AnnotationAction: ActionApplyUpdate}
+		Expect(k8sClient.Patch(ctx, updated, patch)).Should(Succeed())
+
+		updated = reconcileDGDR(dgdr)
+		Expect(updated.Annotations).NotTo(HaveKey(AnnotationAction))
+		Expect(meta.FindStatusCondition(updated.Status.Conditions, ConditionTypeUpdatePending)).To(BeNil())
+		Expect(appliedHash(dgdKey)).ShouldNot(Equal("previous"))
+	})
+
+	It("Should reject apply-update without a pending update", func() {
+		dgdr, _ := setup("test-dgdr-update-none", nvidiacomv1alpha1.DGDUpdateStrategyManual)
+		patch := client.MergeFrom(dgdr.DeepCopy())
+		dgdr.Annotations = map[string]string{AnnotationAction: ActionApplyUpdate}
+		Expect(k8sClient.Patch(ctx, dgdr, patch)).Should(Succeed())
+
+		updated := reconcileDGDR(dgdr)
+		Expect(updated.Annotations).NotTo(HaveKey(AnnotationAction))
+		Expect(meta.FindStatusCondition(updated.Status.Conditions, ConditionTypeUpdatePending)).To(BeNil())
+	})
+})
@@ -0,0 +1,224 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	promapi "github.com/prometheus/client_golang/api"
+	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/yaml"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/consts"
+)
+
+const (
+	// ProfilingWindowsFile is the profiler output listing the time window of each tested config
+	ProfilingWindowsFile = "profiling_windows.yaml"
+
+	// ProfilingWindowAll names the fallback window spanning the whole profiling job
+	ProfilingWindowAll = "all"
+
+	// Event reasons
+	EventReasonUtilizationRecordingFailed = "UtilizationRecordingFailed"
+
+	// Validation messages
+	ValidationErrorRecordUtilizationNoPrometheus = "profilingConfig.recordUtilization requires the operator to be configured with --prometheus-endpoint"
+
+	// DCGM exporter queries, aggregated over a window ending at the query time. DCGM metrics only
+	// carry the namespace and pod, they are joined with kube-state-metrics' kube_pod_labels to keep
+	// the pods of the DGDs deployed for the DGDR only.
+	// Arguments: namespace, pod label matchers, window length in seconds.
+	queryGPUUtilization  = `avg_over_time(avg(DCGM_FI_DEV_GPU_UTIL{namespace=%[1]q} * on(namespace, pod) group_left() kube_pod_labels{%[2]s})[%[3]ds:])`
+	queryGPUMemoryUsed   = `max_over_time(sum(DCGM_FI_DEV_FB_USED{namespace=%[1]q} * on(namespace, pod) group_left() kube_pod_labels{%[2]s})[%[3]ds:])`
+	queryNVLinkBandwidth = `avg_over_time(sum((DCGM_FI_PROF_NVLINK_TX_BYTES{namespace=%[1]q} + DCGM_FI_PROF_NVLINK_RX_BYTES{namespace=%[1]q}) * on(namespace, pod) group_left() kube_pod_labels{%[2]s})[%[3]ds:])`
+)
+
+// MetricsQuerier evaluates instant PromQL queries
+type MetricsQuerier interface {
+	// QueryScalar evaluates the query at ts and returns its single value, or false if the query
+	// returned no data
+	QueryScalar(ctx context.Context, query string, ts time.Time) (float64, bool, error)
+}
+
+// prometheusQuerier implements MetricsQuerier against the Prometheus HTTP API
+type prometheusQuerier struct {
+	api promv1.API
+}
+
+// NewPrometheusQuerier returns a MetricsQuerier for the Prometheus server at endpoint
+func NewPrometheusQuerier(endpoint string) (MetricsQuerier, error) {
+	client, err := promapi.NewClient(promapi.Config{Address: endpoint})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Prometheus client: %w", err)
+	}
+	return &prometheusQuerier{api: promv1.NewAPI(client)}, nil
+}
+
+// QueryScalar implements MetricsQuerier
+func (q *prometheusQuerier) QueryScalar(ctx context.Context, query string, ts time.Time) (float64, bool, error) {
+	result, _, err := q.api.Query(ctx, query, ts)
+	if err != nil {
+		return 0, false, err
+	}
+	switch value := result.(type) {
+	case model.Vector:
+		if len(value) == 0 {
+			return 0, false, nil
+		}
+		return float64(value[0].Value), true, nil
+	case *model.Scalar:
+		return float64(value.Value), true, nil
+	default:
+		return 0, false, fmt.Errorf("unexpected Prometheus result type %s", result.Type())
+	}
+}
+
+// promPodLabel returns the name kube-state-metrics exports a pod label under in kube_pod_labels
+func promPodLabel(label string) string {
+	return "label_" + strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+		return '_'
+	}, label)
+}
+
+// profilingPodMatchers returns the kube_pod_labels matchers of the pods of the DGDs the profiler
+// deployed for the DGDR in namespace
+func profilingPodMatchers(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, namespace string) string {
+	return fmt.Sprintf(`namespace=%q, %s!="", %s=%q, %s=%q`, namespace,
+		promPodLabel(consts.KubeLabelDynamoGraphDeploymentName),
+		promPodLabel(LabelDGDRName), dgdr.Name,
+		promPodLabel(LabelDGDRNamespace), dgdr.Namespace)
+}
+
+// profilingWindow is a single entry of the profiler's time windows output
+type profilingWindow struct {
+	Config string    `json:"config"`
+	Start  time.Time `json:"start"`
+	End    time.Time `json:"end"`
+}
+
+// validateRecordUtilization checks that utilization can be recorded if requested
+func (r *DynamoGraphDeploymentRequestReconciler) validateRecordUtilization(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	if dgdr.Spec.ProfilingConfig.RecordUtilization && r.MetricsQuerier == nil {
+		return errors.New(ValidationErrorRecordUtilizationNoPrometheus)
+	}
+	return nil
+}
+
+// getProfilingWindows returns the tested config windows written by the profiler, falling back to a
+// single window spanning the profiling job
+func (r *DynamoGraphDeploymentRequestReconciler) getProfilingWindows(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) ([]profilingWindow, error) {
+	results, err := r.resultTransport(dgdr).Fetch(ctx, dgdr)
+	if err != nil && !isResultsMissing(err) {
+		return nil, err
+	}
+	if content, exists := results[ProfilingWindowsFile]; exists {
+		var windows []profilingWindow
+		if err := yaml.Unmarshal([]byte(content), &windows); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", ProfilingWindowsFile, err)
+		}
+		return windows, nil
+	}
+
+	job := &batchv1.Job{}
+	if err := r.Get(ctx, types.NamespacedName{Name: GetProfilingJobName(dgdr), Namespace: dgdr.Namespace}, job); err != nil {
+		return nil, fmt.Errorf("failed to get profiling job: %w", err)
+	}
+	if job.Status.StartTime == nil || job.Status.CompletionTime == nil {
+		return nil, errors.New("profiling job has no start or completion time")
+	}
+	return []profilingWindow{{
+		Config: ProfilingWindowAll,
+		Start:  job.Status.StartTime.Time,
+		End:    job.Status.CompletionTime.Time,
+	}}, nil
+}
+
+// recordUtilization queries GPU statistics for each tested config window and stores them in
+// status.profiling.utilization. It is a no-op unless recordUtilization is set for online profiling.
+func (r *DynamoGraphDeploymentRequestReconciler) recordUtilization(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	if !dgdr.Spec.ProfilingConfig.RecordUtilization || !isOnlineProfiling(dgdr) {
+		return nil
+	}
+	if r.MetricsQuerier == nil {
+		return errors.New(ValidationErrorRecordUtilizationNoPrometheus)
+	}
+	logger := log.FromContext(ctx)
+
+	windows, err := r.getProfilingWindows(ctx, dgdr)
+	if err != nil {
+		return err
+	}
+
+	// Profiling deployments run in deployment.namespace, which defaults to the DGDR namespace
+	namespace := dgdr.Namespace
+	matchers := profilingPodMatchers(dgdr, namespace)
+	utilization := make([]nvidiacomv1alpha1.GPUUtilization, 0, len(windows))
+	for _, window := range windows {
+		seconds := int(window.End.Sub(window.Start).Seconds())
+		if seconds < 1 {
+			seconds = 1
+		}
+
+		entry := nvidiacomv1alpha1.GPUUtilization{
+			Config: window.Config,
+			Start:  metav1.NewTime(window.Start),
+			End:    metav1.NewTime(window.End),
+		}
+		queries := []struct {
+			query  string
+			target *string
+		}{
+			{fmt.Sprintf(queryGPUUtilization, namespace, matchers, seconds), &entry.GPUUtilizationPercent},
+			{fmt.Sprintf(queryGPUMemoryUsed, namespace, matchers, seconds), &entry.GPUMemoryUsedMiB},
+			{fmt.Sprintf(queryNVLinkBandwidth, namespace, matchers, seconds), &entry.NVLinkBytesPerSecond},
+		}
+		for _, q := range queries {
+			value, found, err := r.MetricsQuerier.QueryScalar(ctx, q.query, window.End)
+			if err != nil {
+				return fmt.Errorf("failed to query GPU statistics for %s: %w", window.Config, err)
+			}
+			if found {
+				*q.target = strconv.FormatFloat(value, 'f', 2, 64)
+			}
+		}
+		utilization = append(utilization, entry)
+	}
+
+	if dgdr.Status.Profiling == nil {
+		dgdr.Status.Profiling = &nvidiacomv1alpha1.ProfilingStatus{}
+	}
+	dgdr.Status.Profiling.Utilization = utilization
+	logger.Info("Recorded GPU utilization", "windows", len(utilization))
+	return nil
+}
@@ -0,0 +1,139 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+// fakeMetricsQuerier returns canned values keyed by DCGM metric name
+type fakeMetricsQuerier struct {
+	values  map[string]float64
+	queries []string
+}
+
+func (f *fakeMetricsQuerier) QueryScalar(_ context.Context, query string, _ time.Time) (float64, bool, error) {
+	f.queries = append(f.queries, query)
+	for metric, value := range f.values {
+		if strings.Contains(query, metric) {
+			return value, true, nil
+		}
+	}
+	return 0, false, nil
+}
+
+var _ = Describe("DGDR GPU Utilization Recording", func() {
+	var (
+		reconciler *DynamoGraphDeploymentRequestReconciler
+		querier    *fakeMetricsQuerier
+	)
+
+	BeforeEach(func() {
+		querier = &fakeMetricsQuerier{values: map[string]float64{
+			"DCGM_FI_DEV_GPU_UTIL": 87.5,
+			"DCGM_FI_DEV_FB_USED":  40960,
+		}}
+		reconciler = &DynamoGraphDeploymentRequestReconciler{
+			Client:         k8sClient,
+			Recorder:       record.NewFakeRecorder(100),
+			RBACManager:    &MockRBACManager{},
+			MetricsQuerier: querier,
+		}
+	})
+
+	It("Should record statistics for each profiled config window", func() {
+		ctx := context.Background()
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-dgdr-utilization", Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{RecordUtilization: true},
+			},
+		}
+
+		cm := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: GetOutputConfigMapName(dgdr), Namespace: defaultNamespace},
+			Data: map[string]string{
+				ProfilingWindowsFile: `- config: prefill_1gpus
+  start: "2025-01-01T10:00:00Z"
+  end: "2025-01-01T10:05:00Z"
+- config: decode_2gpus
+  start: "2025-01-01T10:10:00Z"
+  end: "2025-01-01T10:20:00Z"
+`,
+			},
+		}
+		Expect(k8sClient.Create(ctx, cm)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, cm) }()
+
+		Expect(reconciler.recordUtilization(ctx, dgdr)).Should(Succeed())
+
+		Expect(dgdr.Status.Profiling).NotTo(BeNil())
+		utilization := dgdr.Status.Profiling.Utilization
+		Expect(utilization).Should(HaveLen(2))
+		Expect(utilization[0].Config).Should(Equal("prefill_1gpus"))
+		Expect(utilization[0].GPUUtilizationPercent).Should(Equal("87.50"))
+		Expect(utilization[0].GPUMemoryUsedMiB).Should(Equal("40960.00"))
+		// No NVLink data reported
+		Expect(utilization[0].NVLinkBytesPerSecond).Should(BeEmpty())
+		Expect(utilization[1].Config).Should(Equal("decode_2gpus"))
+		Expect(utilization[1].End.Sub(utilization[1].Start.Time)).Should(Equal(10 * time.Minute))
+
+		// Only the pods of the profiling DGDs of the DGDR are aggregated
+		Expect(querier.queries).Should(ContainElement(
+			`avg_over_time(avg(DCGM_FI_DEV_GPU_UTIL{namespace="default"} * on(namespace, pod) group_left() ` +
+				`kube_pod_labels{namespace="default", label_nvidia_com_dynamo_graph_deployment_name!="", ` +
+				`label_dgdr_nvidia_com_name="test-dgdr-utilization", label_dgdr_nvidia_com_namespace="default"})[300s:])`))
+	})
+
+	It("Should skip recording for offline profiling", func() {
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-dgdr-utilization-aic", Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					RecordUtilization: true,
+					Config: createTestConfig(map[string]interface{}{
+						"sweep": map[string]interface{}{"use_ai_configurator": true},
+					}),
+				},
+			},
+		}
+
+		Expect(reconciler.recordUtilization(context.Background(), dgdr)).Should(Succeed())
+		Expect(dgdr.Status.Profiling).To(BeNil())
+		Expect(querier.queries).To(BeEmpty())
+	})
+
+	It("Should reject recordUtilization without a Prometheus endpoint", func() {
+		reconciler.MetricsQuerier = nil
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{RecordUtilization: true},
+			},
+		}
+		Expect(reconciler.validateRecordUtilization(dgdr)).To(MatchError(ValidationErrorRecordUtilizationNoPrometheus))
+	})
+})
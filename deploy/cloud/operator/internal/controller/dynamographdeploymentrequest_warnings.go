@@ -0,0 +1,96 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"slices"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+)
+
+// Warning types reported in status.warnings
+const (
+	// WarningSLAOverwritten is reported when profilingConfig.config.sla targets are overwritten by spec.sla
+	WarningSLAOverwritten = "SLAOverwritten"
+	// WarningProfilingModeOverwritten is reported when profilingConfig.config.sweep.use_ai_configurator is
+	// overwritten by spec.profilingMode
+	WarningProfilingModeOverwritten = "ProfilingModeOverwritten"
+	// WarningBackendOverwritten is reported when profilingConfig.config.engine.backend is overwritten by spec.backend
+	WarningBackendOverwritten = "BackendOverwritten"
+	// WarningModelOverwritten is reported when profilingConfig.config.deployment.model is overwritten by spec.model
+	WarningModelOverwritten = "ModelOverwritten"
+	// WarningGPUFeaturesIgnored is reported when GPU-specific settings are ignored for CPU-only profiling
+	WarningGPUFeaturesIgnored = "GPUFeaturesIgnored"
+	// WarningUtilizationUnavailable is reported when GPU utilization could not be recorded
+	WarningUtilizationUnavailable = "UtilizationUnavailable"
+	// WarningAuditRecordFailed is reported when the DynamoProfilingRun audit record could not be written
+	WarningAuditRecordFailed = "AuditRecordFailed"
+	// WarningPlaceholderSpec is reported when the generated deployment was rendered from a placeholder template
+	WarningPlaceholderSpec = "PlaceholderSpec"
+	// WarningUnknownFields is reported when a generated deployment has fields the operator does not know
+	WarningUnknownFields = "UnknownFields"
+	// WarningIncompatibleBackend is reported when the compatibility matrix flags a requested backend
+	WarningIncompatibleBackend = "IncompatibleBackend"
+	// WarningProvenanceIncomplete is reported when the profiling pods reported no digest of a profiling image
+	WarningProvenanceIncomplete = "ProvenanceIncomplete"
+	// WarningProfilerCapabilitiesUnknown is reported when the capabilities of the profiler image could not be discovered
+	WarningProfilerCapabilitiesUnknown = "ProfilerCapabilitiesUnknown"
+	// WarningImageArchitectures is reported when an image is not built for every CPU architecture of the target nodes
+	WarningImageArchitectures = "ImageArchitectures"
+	// WarningHookFailed is reported when a profiling hook with failurePolicy Ignore failed
+	WarningHookFailed = "HookFailed"
+	// WarningUnsupportedFeature is reported when the compatibility matrix flags a feature of spec.features
+	WarningUnsupportedFeature = "UnsupportedFeature"
+	// WarningPercentileUnreported is reported when the profiler did not report the latency percentile it sized for
+	WarningPercentileUnreported = "PercentileUnreported"
+	// WarningPodMonitorNotManaged is reported when PodMonitors of the generated deployment exist and are not managed by the DGDR
+	WarningPodMonitorNotManaged = "PodMonitorNotManaged"
+	// WarningSLANotMet is reported by a recheck when the deployment is not known to meet the requested SLA
+	WarningSLANotMet = "SLANotMet"
+	// WarningSpeculationUnmeasured is reported when speculative decoding is not applied because the profiler did not measure it
+	WarningSpeculationUnmeasured = "SpeculationUnmeasured"
+	// WarningApproverNotRecorded is reported while a generated spec awaits an approval whose user cannot be recorded
+	WarningApproverNotRecorded = "ApproverNotRecorded"
+)
+
+// setWarning records a warning in the DGDR status, replacing any previous warning of the same type.
+// The status is persisted by the next status update.
+func setWarning(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, warningType, message string) {
+	warning := nvidiacomv1alpha1.StatusWarning{
+		Type:             warningType,
+		Message:          redactMessage(dgdr, message),
+		LastObservedTime: metav1.Now(),
+	}
+	for i := range dgdr.Status.Warnings {
+		if dgdr.Status.Warnings[i].Type == warningType {
+			dgdr.Status.Warnings[i] = warning
+			return
+		}
+	}
+	dgdr.Status.Warnings = append(dgdr.Status.Warnings, warning)
+}
+
+// clearWarning removes the warning of the given type from the DGDR status once its cause is gone.
+// The status is persisted by the next status update.
+func clearWarning(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, warningType string) {
+	dgdr.Status.Warnings = slices.DeleteFunc(dgdr.Status.Warnings, func(warning nvidiacomv1alpha1.StatusWarning) bool {
+		return warning.Type == warningType
+	})
+}
@@ -0,0 +1,121 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var _ = Describe("DGDR Status Warnings", func() {
+	var reconciler *DynamoGraphDeploymentRequestReconciler
+
+	BeforeEach(func() {
+		reconciler = &DynamoGraphDeploymentRequestReconciler{
+			Client:      k8sClient,
+			Recorder:    record.NewFakeRecorder(100),
+			RBACManager: &MockRBACManager{},
+		}
+	})
+
+	It("Should keep one warning per type", func() {
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{}
+		setWarning(dgdr, WarningSLAOverwritten, "first")
+		setWarning(dgdr, WarningGPUFeaturesIgnored, "ignored")
+		setWarning(dgdr, WarningSLAOverwritten, "second")
+
+		Expect(dgdr.Status.Warnings).Should(HaveLen(2))
+		Expect(dgdr.Status.Warnings[0].Type).Should(Equal(WarningSLAOverwritten))
+		Expect(dgdr.Status.Warnings[0].Message).Should(Equal("second"))
+	})
+
+	It("Should report ignored and overwritten values in status", func() {
+		ctx := context.Background()
+
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-dgdr-warnings", Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model:   "test-model",
+				Backend: "vllm",
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					ProfilerImage: "test-profiler:latest",
+					Config: createTestConfig(map[string]interface{}{
+						"deployment": map[string]interface{}{"model": "other-model"},
+						"sweep":      map[string]interface{}{"use_ai_configurator": true},
+					}),
+					CPUOnly:           true,
+					RecordUtilization: true,
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, dgdr) }()
+
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: dgdr.Name, Namespace: defaultNamespace}})
+		Expect(err).NotTo(HaveOccurred())
+
+		updated := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: dgdr.Name, Namespace: defaultNamespace}, updated)).Should(Succeed())
+		Expect(updated.Status.State).Should(Equal(StatePending))
+
+		warningTypes := []string{}
+		for _, warning := range updated.Status.Warnings {
+			warningTypes = append(warningTypes, warning.Type)
+		}
+		Expect(warningTypes).Should(ConsistOf(WarningGPUFeaturesIgnored, WarningModelOverwritten))
+	})
+
+	It("Should clear overwrite warnings once the config no longer conflicts", func() {
+		ctx := context.Background()
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model:   "test-model",
+				Backend: "vllm",
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					ProfilerImage: "test-profiler:latest",
+					Config: createTestConfig(map[string]interface{}{
+						"engine":     map[string]interface{}{"backend": "sglang"},
+						"deployment": map[string]interface{}{"model": "other-model"},
+					}),
+				},
+			},
+		}
+		Expect(reconciler.validateSpec(ctx, dgdr)).Should(Succeed())
+		Expect(dgdr.Status.Warnings).Should(ConsistOf(
+			HaveField("Type", WarningBackendOverwritten),
+			HaveField("Type", WarningModelOverwritten),
+		))
+
+		dgdr.Spec.ProfilingConfig.Config = createTestConfig(map[string]interface{}{
+			"deployment": map[string]interface{}{"model": "test-model"},
+		})
+		Expect(reconciler.validateSpec(ctx, dgdr)).Should(Succeed())
+		Expect(dgdr.Status.Warnings).Should(BeEmpty())
+
+		setWarning(dgdr, WarningGPUFeaturesIgnored, "ignored")
+		clearWarning(dgdr, WarningBackendOverwritten)
+		Expect(dgdr.Status.Warnings).Should(HaveLen(1))
+	})
+})
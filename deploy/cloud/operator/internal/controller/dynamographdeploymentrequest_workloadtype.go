@@ -0,0 +1,112 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"errors"
+	"fmt"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	commonconsts "github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/consts"
+)
+
+const (
+	// ConfigKeyWorkloadType tells the profiler which kind of model it profiles, under engine
+	ConfigKeyWorkloadType = "workload_type"
+
+	// SLA keys of profilingConfig.config.sla
+	SLAKeyTTFT         = "ttft"
+	SLAKeyITL          = "itl"
+	SLAKeyBatchLatency = "batch_latency"
+
+	// AnnotationWorkloadType records the workload type on generated deployments of non-generative graphs
+	AnnotationWorkloadType = "nvidia.com/dgdr-workload-type"
+
+	// Validation messages
+	ValidationErrorBatchLatencyPositive       = "sla.batch_latency must be positive for workloadType %s"
+	ValidationErrorTokenSLA                   = "sla.%s is not valid for workloadType %s, which produces no tokens; use sla.batch_latency"
+	ValidationErrorBatchLatencyRequiresNonLLM = "sla.batch_latency is only valid for workloadType embedding or reranker, use sla.ttft and sla.itl"
+	ValidationErrorAutoRequiresLLM            = "backend auto is only supported for workloadType llm"
+)
+
+// getWorkloadType returns the workload type of the DGDR, defaulting to llm
+func getWorkloadType(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) nvidiacomv1alpha1.WorkloadType {
+	if dgdr.Spec.WorkloadType == "" {
+		return nvidiacomv1alpha1.WorkloadTypeLLM
+	}
+	return dgdr.Spec.WorkloadType
+}
+
+// isGenerative reports whether the DGDR serves a generative model with per-token latency targets
+func isGenerative(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) bool {
+	return getWorkloadType(dgdr) == nvidiacomv1alpha1.WorkloadTypeLLM
+}
+
+// validateWorkloadType checks that the SLA of profilingConfig.config, with spec.sla applied, matches
+// the workload type. Non-generative graphs are profiled against the latency of a batch instead of
+// TTFT and ITL.
+func validateWorkloadType(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	config := parseProfilingConfig(dgdr)
+	sla := map[string]interface{}{}
+	if configured, ok := config["sla"].(map[string]interface{}); ok {
+		sla = configured
+	}
+	for key, value := range slaConfig(dgdr) {
+		sla[key] = float64(value)
+	}
+	_, hasBatchLatency := sla[SLAKeyBatchLatency]
+
+	if isGenerative(dgdr) {
+		if hasBatchLatency {
+			return errors.New(ValidationErrorBatchLatencyRequiresNonLLM)
+		}
+		return nil
+	}
+
+	workloadType := getWorkloadType(dgdr)
+	if dgdr.Spec.Backend == BackendAuto {
+		return errors.New(ValidationErrorAutoRequiresLLM)
+	}
+	for _, key := range []string{SLAKeyTTFT, SLAKeyITL} {
+		if _, ok := sla[key]; ok {
+			return fmt.Errorf(ValidationErrorTokenSLA, key, workloadType)
+		}
+	}
+	if latency, ok := sla[SLAKeyBatchLatency].(float64); !ok || latency <= 0 {
+		return fmt.Errorf(ValidationErrorBatchLatencyPositive, workloadType)
+	}
+	return nil
+}
+
+// applyWorkloadType adapts a generated deployment to its workload type. Non-generative graphs
+// have no prefill/decode split and no token latencies for the SLA planner to scale on, so the
+// planner is removed and the graph is annotated with its workload type.
+func applyWorkloadType(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest, dgd *nvidiacomv1alpha1.DynamoGraphDeployment) {
+	if isGenerative(dgdr) {
+		return
+	}
+	for name, spec := range dgd.Spec.Services {
+		if spec != nil && spec.ComponentType == commonconsts.ComponentTypePlanner {
+			delete(dgd.Spec.Services, name)
+		}
+	}
+	if dgd.Annotations == nil {
+		dgd.Annotations = map[string]string{}
+	}
+	dgd.Annotations[AnnotationWorkloadType] = string(getWorkloadType(dgdr))
+}
@@ -0,0 +1,135 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	commonconsts "github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/consts"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/yaml"
+)
+
+var _ = Describe("DGDR Workload Types", func() {
+	var reconciler *DynamoGraphDeploymentRequestReconciler
+
+	BeforeEach(func() {
+		reconciler = &DynamoGraphDeploymentRequestReconciler{
+			Client:      k8sClient,
+			Recorder:    record.NewFakeRecorder(100),
+			RBACManager: &MockRBACManager{},
+		}
+	})
+
+	newDGDR := func(name string, workloadType nvidiacomv1alpha1.WorkloadType, sla map[string]interface{}) *nvidiacomv1alpha1.DynamoGraphDeploymentRequest {
+		return &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+				Model:        "BAAI/bge-large-en-v1.5",
+				Backend:      BackendVLLM,
+				WorkloadType: workloadType,
+				ProfilingConfig: nvidiacomv1alpha1.ProfilingConfigSpec{
+					ProfilerImage: "test-profiler:latest",
+					Config: createTestConfig(map[string]interface{}{
+						"sla":   sla,
+						"sweep": map[string]interface{}{"use_ai_configurator": true},
+					}),
+				},
+			},
+		}
+	}
+
+	It("Should match the SLA to the workload type", func() {
+		batchSLA := map[string]interface{}{SLAKeyBatchLatency: 50.0}
+		tokenSLA := map[string]interface{}{SLAKeyTTFT: 200.0, SLAKeyITL: 20.0}
+
+		Expect(validateWorkloadType(newDGDR("test-dgdr-llm", "", tokenSLA))).Should(Succeed())
+		Expect(validateWorkloadType(newDGDR("test-dgdr-embedding", nvidiacomv1alpha1.WorkloadTypeEmbedding, batchSLA))).Should(Succeed())
+
+		Expect(validateWorkloadType(newDGDR("test-dgdr-llm", nvidiacomv1alpha1.WorkloadTypeLLM, batchSLA))).
+			To(MatchError(ValidationErrorBatchLatencyRequiresNonLLM))
+		Expect(validateWorkloadType(newDGDR("test-dgdr-reranker", nvidiacomv1alpha1.WorkloadTypeReranker, tokenSLA))).
+			To(MatchError(fmt.Sprintf(ValidationErrorTokenSLA, SLAKeyTTFT, nvidiacomv1alpha1.WorkloadTypeReranker)))
+		Expect(validateWorkloadType(newDGDR("test-dgdr-reranker", nvidiacomv1alpha1.WorkloadTypeReranker, map[string]interface{}{SLAKeyBatchLatency: 0.0}))).
+			To(MatchError(fmt.Sprintf(ValidationErrorBatchLatencyPositive, nvidiacomv1alpha1.WorkloadTypeReranker)))
+
+		auto := newDGDR("test-dgdr-embedding-auto", nvidiacomv1alpha1.WorkloadTypeEmbedding, batchSLA)
+		auto.Spec.Backend = BackendAuto
+		Expect(validateWorkloadType(auto)).To(MatchError(ValidationErrorAutoRequiresLLM))
+	})
+
+	It("Should pass the workload type to the profiler", func() {
+		ctx := context.Background()
+
+		sa := &corev1.ServiceAccount{
+			ObjectMeta: metav1.ObjectMeta{Name: ServiceAccountProfilingJob, Namespace: defaultNamespace},
+		}
+		Expect(k8sClient.Create(ctx, sa)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, sa) }()
+
+		dgdr := newDGDR("test-dgdr-embedding-job", nvidiacomv1alpha1.WorkloadTypeEmbedding, map[string]interface{}{SLAKeyBatchLatency: 50.0})
+		Expect(k8sClient.Create(ctx, dgdr)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, dgdr) }()
+
+		Expect(reconciler.validateSpec(ctx, dgdr)).Should(Succeed())
+		Expect(reconciler.createProfilingJob(ctx, dgdr)).Should(Succeed())
+
+		job := &batchv1.Job{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: GetProfilingJobName(dgdr), Namespace: defaultNamespace}, job)).Should(Succeed())
+		defer func() { _ = k8sClient.Delete(ctx, job) }()
+
+		var config map[string]interface{}
+		Expect(yaml.Unmarshal([]byte(job.Spec.Template.Spec.Containers[0].Args[1]), &config)).Should(Succeed())
+		Expect(config["engine"]).Should(HaveKeyWithValue(ConfigKeyWorkloadType, "embedding"))
+		Expect(config["sla"]).Should(HaveKeyWithValue(SLAKeyBatchLatency, 50.0))
+	})
+
+	It("Should deploy non-generative graphs without the planner", func() {
+		dgdr := newDGDR("test-dgdr-reranker-spec", nvidiacomv1alpha1.WorkloadTypeReranker, nil)
+		dgd := &nvidiacomv1alpha1.DynamoGraphDeployment{
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentSpec{
+				Services: map[string]*nvidiacomv1alpha1.DynamoComponentDeploymentSharedSpec{
+					"Frontend": {ComponentType: commonconsts.ComponentTypeFrontend},
+					"Planner":  {ComponentType: commonconsts.ComponentTypePlanner},
+					"Worker":   {ComponentType: commonconsts.ComponentTypeWorker},
+				},
+			},
+		}
+		applyWorkloadType(dgdr, dgd)
+		Expect(dgd.Spec.Services).Should(HaveKey("Frontend"))
+		Expect(dgd.Spec.Services).Should(HaveKey("Worker"))
+		Expect(dgd.Spec.Services).ShouldNot(HaveKey("Planner"))
+		Expect(dgd.Annotations).Should(HaveKeyWithValue(AnnotationWorkloadType, "reranker"))
+
+		// Placeholder deployments have no prefill/decode split either
+		rendered, err := reconciler.renderPlaceholderDGD(dgdr, BackendVLLM)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rendered).ShouldNot(ContainSubstring("subComponentType"))
+		rendered, err = reconciler.renderPlaceholderDGD(newDGDR("test-dgdr-llm-spec", "", nil), BackendVLLM)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rendered).Should(ContainSubstring("subComponentType: decode"))
+	})
+})
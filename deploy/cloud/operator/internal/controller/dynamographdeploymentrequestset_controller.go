@@ -0,0 +1,372 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"time"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	commonController "github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/controller_common"
+)
+
+const (
+	// Labels linking child DGDRs to their DynamoGraphDeploymentRequestSet
+	LabelDGDRSetName       = "nvidia.com/dgdr-set-name"
+	LabelDGDRSetNamespace  = "nvidia.com/dgdr-set-namespace"
+	LabelDGDRSetParameters = "nvidia.com/dgdr-set-parameters"
+
+	// DGDRSetChildStateWaiting is the state of a child held back by the concurrency budget
+	DGDRSetChildStateWaiting = "Waiting"
+
+	// DefaultDGDRSetMaxInProgress is how many children of all sets may be in progress at the same time
+	DefaultDGDRSetMaxInProgress = 10
+
+	// dgdrSetWaitingRequeueInterval is how often a set with waiting children checks the budget again,
+	// which children of other sets free up
+	dgdrSetWaitingRequeueInterval = 30 * time.Second
+
+	// ConditionTypeDGDRSetReady is True once all children of the set are Ready
+	ConditionTypeDGDRSetReady = "Ready"
+
+	// Event reasons
+	EventReasonDGDRSetChildCreated      = "ChildCreated"
+	EventReasonDGDRSetChildCreateFailed = "ChildCreateFailed"
+	EventReasonDGDRSetChildDeleted      = "ChildDeleted"
+
+	// Messages
+	MessageDGDRSetReady           = "All %d DynamoGraphDeploymentRequests are Ready"
+	MessageDGDRSetProgress        = "%d of %d DynamoGraphDeploymentRequests are Ready, %d failed"
+	MessageDGDRSetChildNotOwned   = "DynamoGraphDeploymentRequest %s already exists and does not belong to the set"
+	MessageDGDRSetChildInvalidRaw = "invalid DynamoGraphDeploymentRequest spec: %v"
+)
+
+// DynamoGraphDeploymentRequestSetReconciler stamps out the child DGDRs of a
+// DynamoGraphDeploymentRequestSet, within its concurrency budget, and aggregates their state.
+type DynamoGraphDeploymentRequestSetReconciler struct {
+	client.Client
+	Recorder record.EventRecorder
+
+	// MaxInProgress is the concurrency budget of the operator: how many children of all sets may be
+	// in progress at the same time. Zero leaves the children to the budgets of their sets.
+	MaxInProgress int
+}
+
+// +kubebuilder:rbac:groups=nvidia.com,resources=dynamographdeploymentrequestsets,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=nvidia.com,resources=dynamographdeploymentrequestsets/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=nvidia.com,resources=dynamographdeploymentrequestsets/finalizers,verbs=update
+// +kubebuilder:rbac:groups=nvidia.com,resources=dynamographdeploymentrequests,verbs=get;list;watch;create;delete
+
+// Reconcile creates the children the budget allows, deletes those whose parameters were removed
+// and records the aggregate state of the set
+func (r *DynamoGraphDeploymentRequestSetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	set := &nvidiacomv1alpha1.DynamoGraphDeploymentRequestSet{}
+	if err := r.Get(ctx, req.NamespacedName, set); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	finalized, err := commonController.HandleFinalizer(ctx, set, r.Client, r)
+	if err != nil || finalized {
+		return ctrl.Result{}, err
+	}
+
+	children, err := r.listChildren(ctx, set)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Children whose parameters were removed from the set are deleted
+	parameters := map[string]bool{}
+	for _, params := range set.Spec.Parameters {
+		parameters[params.Name] = true
+	}
+	for name, child := range children {
+		if parameters[name] {
+			continue
+		}
+		if err := r.Delete(ctx, child); client.IgnoreNotFound(err) != nil {
+			return ctrl.Result{}, err
+		}
+		logger.Info("Deleted child of removed parameters", "dgdr", client.ObjectKeyFromObject(child))
+		r.Recorder.Eventf(set, corev1.EventTypeNormal, EventReasonDGDRSetChildDeleted, "Deleted %s/%s", child.Namespace, child.Name)
+		delete(children, name)
+	}
+
+	inProgress := 0
+	for _, child := range children {
+		if dgdrSetChildInProgress(child.Status.State) {
+			inProgress++
+		}
+	}
+	totalInProgress, err := r.countChildrenInProgress(ctx)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	previous := set.Status.DeepCopy()
+	set.Status.Children = make([]nvidiacomv1alpha1.DGDRSetChildStatus, 0, len(set.Spec.Parameters))
+	for _, params := range set.Spec.Parameters {
+		status := nvidiacomv1alpha1.DGDRSetChildStatus{Name: params.Name}
+		if child, exists := children[params.Name]; exists {
+			status.DGDR = child.Namespace + "/" + child.Name
+			status.State = child.Status.State
+			if status.State == StateEmpty {
+				status.State = StatePending
+			}
+			set.Status.Children = append(set.Status.Children, status)
+			continue
+		}
+		if (set.Spec.MaxInProgress != nil && inProgress >= int(*set.Spec.MaxInProgress)) ||
+			(r.MaxInProgress > 0 && totalInProgress >= r.MaxInProgress) {
+			status.State = DGDRSetChildStateWaiting
+			set.Status.Children = append(set.Status.Children, status)
+			continue
+		}
+
+		child, err := buildDGDRSetChild(set, params)
+		if err == nil {
+			err = r.Create(ctx, child)
+			if apierrors.IsAlreadyExists(err) {
+				err = apierrors.NewBadRequest(fmt.Sprintf(MessageDGDRSetChildNotOwned, client.ObjectKeyFromObject(child)))
+			}
+		}
+		switch {
+		case err == nil:
+			inProgress++
+			totalInProgress++
+			status.DGDR = child.Namespace + "/" + child.Name
+			status.State = StatePending
+			logger.Info("Created child", "dgdr", status.DGDR)
+			r.Recorder.Eventf(set, corev1.EventTypeNormal, EventReasonDGDRSetChildCreated, "Created %s", status.DGDR)
+		case apierrors.IsInvalid(err) || apierrors.IsBadRequest(err) || apierrors.IsForbidden(err) || isDGDRSetSpecError(err):
+			// Retrying does not help until the set is changed
+			status.State = StateFailed
+			status.Message = err.Error()
+			r.Recorder.Eventf(set, corev1.EventTypeWarning, EventReasonDGDRSetChildCreateFailed, "Failed to create the child %s: %v", params.Name, err)
+		default:
+			return ctrl.Result{}, err
+		}
+		set.Status.Children = append(set.Status.Children, status)
+	}
+
+	aggregateDGDRSetStatus(set)
+	result := ctrl.Result{}
+	if set.Status.Waiting > 0 {
+		result.RequeueAfter = dgdrSetWaitingRequeueInterval
+	}
+	if equality.Semantic.DeepEqual(previous, &set.Status) {
+		return result, nil
+	}
+	return result, r.Status().Update(ctx, set)
+}
+
+// FinalizeResource deletes the children of a deleted set
+func (r *DynamoGraphDeploymentRequestSetReconciler) FinalizeResource(ctx context.Context, set *nvidiacomv1alpha1.DynamoGraphDeploymentRequestSet) error {
+	children, err := r.listChildren(ctx, set)
+	if err != nil {
+		return err
+	}
+	for _, child := range children {
+		if err := r.Delete(ctx, child); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// listChildren returns the child DGDRs of the set by the name of their parameters
+func (r *DynamoGraphDeploymentRequestSetReconciler) listChildren(ctx context.Context, set *nvidiacomv1alpha1.DynamoGraphDeploymentRequestSet) (map[string]*nvidiacomv1alpha1.DynamoGraphDeploymentRequest, error) {
+	dgdrs := &nvidiacomv1alpha1.DynamoGraphDeploymentRequestList{}
+	if err := r.List(ctx, dgdrs, client.InNamespace(set.Namespace), client.MatchingLabels{
+		LabelDGDRSetName:      set.Name,
+		LabelDGDRSetNamespace: set.Namespace,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to list children of %s: %w", set.Name, err)
+	}
+	children := make(map[string]*nvidiacomv1alpha1.DynamoGraphDeploymentRequest, len(dgdrs.Items))
+	for i := range dgdrs.Items {
+		children[dgdrs.Items[i].Labels[LabelDGDRSetParameters]] = &dgdrs.Items[i]
+	}
+	return children, nil
+}
+
+// countChildrenInProgress counts the children of all sets that count against the concurrency budget
+func (r *DynamoGraphDeploymentRequestSetReconciler) countChildrenInProgress(ctx context.Context) (int, error) {
+	if r.MaxInProgress <= 0 {
+		return 0, nil
+	}
+	dgdrs := &nvidiacomv1alpha1.DynamoGraphDeploymentRequestList{}
+	if err := r.List(ctx, dgdrs, client.HasLabels{LabelDGDRSetName}); err != nil {
+		return 0, fmt.Errorf("failed to list children of all sets: %w", err)
+	}
+	count := 0
+	for i := range dgdrs.Items {
+		if dgdrSetChildInProgress(dgdrs.Items[i].Status.State) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// dgdrSetSpecError is a template or overrides that do not make a valid DGDR spec
+type dgdrSetSpecError struct{ err error }
+
+func (e *dgdrSetSpecError) Error() string {
+	return fmt.Sprintf(MessageDGDRSetChildInvalidRaw, e.err)
+}
+
+func isDGDRSetSpecError(err error) bool {
+	_, ok := err.(*dgdrSetSpecError)
+	return ok
+}
+
+// buildDGDRSetChild stamps out the child DGDR of the parameters: the overrides are merged into the
+// template spec, then the model and SLA parameters replace those of the template. Children are
+// created in the namespace of the set, whose creator may not create DGDRs anywhere else.
+func buildDGDRSetChild(set *nvidiacomv1alpha1.DynamoGraphDeploymentRequestSet, params nvidiacomv1alpha1.DGDRSetParameters) (*nvidiacomv1alpha1.DynamoGraphDeploymentRequest, error) {
+	raw := set.Spec.Template.Spec.Raw
+	if len(raw) == 0 {
+		raw = []byte("{}")
+	}
+	if params.Overrides != nil && len(params.Overrides.Raw) > 0 {
+		merged, err := jsonpatch.MergePatch(raw, params.Overrides.Raw)
+		if err != nil {
+			return nil, &dgdrSetSpecError{err: err}
+		}
+		raw = merged
+	}
+	spec := nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{}
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&spec); err != nil {
+		return nil, &dgdrSetSpecError{err: err}
+	}
+	if params.Model != "" {
+		spec.Model = params.Model
+		spec.ModelRef = nil
+	}
+	if params.SLA != nil {
+		spec.SLA = params.SLA.DeepCopy()
+	}
+
+	labels := maps.Clone(set.Spec.Template.Labels)
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	maps.Copy(labels, params.Labels)
+	labels[LabelDGDRSetName] = set.Name
+	labels[LabelDGDRSetNamespace] = set.Namespace
+	labels[LabelDGDRSetParameters] = params.Name
+	return &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        set.Name + "-" + params.Name,
+			Namespace:   set.Namespace,
+			Labels:      labels,
+			Annotations: maps.Clone(set.Spec.Template.Annotations),
+		},
+		Spec: spec,
+	}, nil
+}
+
+// dgdrSetChildInProgress reports whether a child in the state counts against the concurrency budget
+func dgdrSetChildInProgress(state string) bool {
+	switch state {
+	case StateEmpty, StatePending, StateProfiling, StateAwaitingApproval, StateDeploying:
+		return true
+	default:
+		return false
+	}
+}
+
+// aggregateDGDRSetStatus counts the children by state and sets the phase and Ready condition
+func aggregateDGDRSetStatus(set *nvidiacomv1alpha1.DynamoGraphDeploymentRequestSet) {
+	status := &set.Status
+	status.ObservedGeneration = set.Generation
+	status.Total = int32(len(status.Children))
+	status.Ready, status.Failed, status.InProgress, status.Waiting = 0, 0, 0, 0
+	for _, child := range status.Children {
+		switch {
+		case child.State == StateReady:
+			status.Ready++
+		case child.State == StateFailed:
+			status.Failed++
+		case child.State == DGDRSetChildStateWaiting:
+			status.Waiting++
+		case dgdrSetChildInProgress(child.State):
+			status.InProgress++
+		}
+	}
+
+	condition := metav1.Condition{
+		Type:               ConditionTypeDGDRSetReady,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: set.Generation,
+		Reason:             string(nvidiacomv1alpha1.DGDRSetPhaseProgressing),
+		Message:            fmt.Sprintf(MessageDGDRSetProgress, status.Ready, status.Total, status.Failed),
+	}
+	switch {
+	case status.Ready == status.Total:
+		status.Phase = nvidiacomv1alpha1.DGDRSetPhaseReady
+		condition.Status = metav1.ConditionTrue
+		condition.Message = fmt.Sprintf(MessageDGDRSetReady, status.Total)
+	case status.Failed > 0 && status.InProgress == 0 && status.Waiting == 0:
+		status.Phase = nvidiacomv1alpha1.DGDRSetPhaseFailed
+	default:
+		status.Phase = nvidiacomv1alpha1.DGDRSetPhaseProgressing
+	}
+	condition.Reason = string(status.Phase)
+	meta.SetStatusCondition(&status.Conditions, condition)
+}
+
+// requestsForChild enqueues the set of a child DGDR whose state changed
+func requestsForChild(_ context.Context, obj client.Object) []ctrl.Request {
+	name, hasName := obj.GetLabels()[LabelDGDRSetName]
+	namespace, hasNamespace := obj.GetLabels()[LabelDGDRSetNamespace]
+	if !hasName || !hasNamespace {
+		return nil
+	}
+	return []ctrl.Request{{NamespacedName: types.NamespacedName{Name: name, Namespace: namespace}}}
+}
+
+// SetupWithManager sets up the controller with the Manager
+func (r *DynamoGraphDeploymentRequestSetReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&nvidiacomv1alpha1.DynamoGraphDeploymentRequestSet{}).
+		// Children are tracked by label
+		Watches(&nvidiacomv1alpha1.DynamoGraphDeploymentRequest{}, handler.EnqueueRequestsFromMapFunc(requestsForChild)).
+		Named("dynamographdeploymentrequestset").
+		Complete(r)
+}
@@ -0,0 +1,186 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("DynamoGraphDeploymentRequestSet Controller", func() {
+	var reconciler *DynamoGraphDeploymentRequestSetReconciler
+
+	BeforeEach(func() {
+		reconciler = &DynamoGraphDeploymentRequestSetReconciler{
+			Client:   k8sClient,
+			Recorder: record.NewFakeRecorder(100),
+		}
+	})
+
+	newSet := func(name string, parameters ...nvidiacomv1alpha1.DGDRSetParameters) *nvidiacomv1alpha1.DynamoGraphDeploymentRequestSet {
+		return &nvidiacomv1alpha1.DynamoGraphDeploymentRequestSet{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: defaultNamespace},
+			Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSetSpec{
+				Template: nvidiacomv1alpha1.DGDRSetTemplate{
+					Labels: map[string]string{"team": "onboarding"},
+					Spec: runtime.RawExtension{Raw: []byte(`{
+						"model": "template-model",
+						"backend": "vllm",
+						"profilingConfig": {"profilerImage": "test-profiler:latest", "config": {"sla": {"ttft": 100}}}
+					}`)},
+				},
+				Parameters: parameters,
+			},
+		}
+	}
+	reconcile := func(ctx context.Context, set *nvidiacomv1alpha1.DynamoGraphDeploymentRequestSet) {
+		_, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(set)})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(set), set)).Should(Succeed())
+	}
+	setChildState := func(ctx context.Context, name, state string) {
+		child := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: name, Namespace: defaultNamespace}, child)).Should(Succeed())
+		child.Status.State = state
+		Expect(k8sClient.Status().Update(ctx, child)).Should(Succeed())
+	}
+	deleteSet := func(ctx context.Context, set *nvidiacomv1alpha1.DynamoGraphDeploymentRequestSet) {
+		_ = k8sClient.Delete(ctx, set)
+		_, _ = reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(set)})
+	}
+
+	It("Should stamp out children within the concurrency budget and aggregate their state", func() {
+		ctx := context.Background()
+		set := newSet("test-dgdrset-budget",
+			nvidiacomv1alpha1.DGDRSetParameters{Name: "llama", Model: "meta-llama/Llama-3.1-8B"},
+			nvidiacomv1alpha1.DGDRSetParameters{
+				Name:   "qwen",
+				Model:  "Qwen/Qwen3-0.6B",
+				SLA:    &nvidiacomv1alpha1.SLASpec{RequestsPerSecond: ptr.To(int32(20))},
+				Labels: map[string]string{"tier": "gold"},
+			},
+			nvidiacomv1alpha1.DGDRSetParameters{Name: "mistral", Model: "mistralai/Mistral-7B"},
+		)
+		set.Spec.MaxInProgress = ptr.To(int32(2))
+		Expect(k8sClient.Create(ctx, set)).Should(Succeed())
+		defer deleteSet(ctx, set)
+
+		reconcile(ctx, set)
+		Expect(set.Status.Total).Should(Equal(int32(3)))
+		Expect(set.Status.InProgress).Should(Equal(int32(2)))
+		Expect(set.Status.Waiting).Should(Equal(int32(1)))
+		Expect(set.Status.Phase).Should(Equal(nvidiacomv1alpha1.DGDRSetPhaseProgressing))
+		Expect(set.Status.Children[2].State).Should(Equal(DGDRSetChildStateWaiting))
+
+		child := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: "test-dgdrset-budget-qwen", Namespace: defaultNamespace}, child)).Should(Succeed())
+		Expect(child.Spec.Model).Should(Equal("Qwen/Qwen3-0.6B"))
+		Expect(child.Spec.Backend).Should(Equal(BackendVLLM))
+		Expect(*child.Spec.SLA.RequestsPerSecond).Should(Equal(int32(20)))
+		Expect(child.Labels).Should(HaveKeyWithValue("team", "onboarding"))
+		Expect(child.Labels).Should(HaveKeyWithValue("tier", "gold"))
+		Expect(child.Labels).Should(HaveKeyWithValue(LabelDGDRSetParameters, "qwen"))
+		Expect(apierrors.IsNotFound(k8sClient.Get(ctx, types.NamespacedName{Name: "test-dgdrset-budget-mistral", Namespace: defaultNamespace}, child))).To(BeTrue())
+
+		// A settled child frees the budget for the waiting one
+		setChildState(ctx, "test-dgdrset-budget-llama", StateReady)
+		setChildState(ctx, "test-dgdrset-budget-qwen", StateFailed)
+		reconcile(ctx, set)
+		Expect(set.Status.Ready).Should(Equal(int32(1)))
+		Expect(set.Status.Failed).Should(Equal(int32(1)))
+		Expect(set.Status.InProgress).Should(Equal(int32(1)))
+		Expect(set.Status.Waiting).Should(BeZero())
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: "test-dgdrset-budget-mistral", Namespace: defaultNamespace}, child)).Should(Succeed())
+
+		setChildState(ctx, "test-dgdrset-budget-mistral", StateReady)
+		reconcile(ctx, set)
+		Expect(set.Status.Phase).Should(Equal(nvidiacomv1alpha1.DGDRSetPhaseFailed))
+
+		// Removing the failed parameters deletes the child and the set becomes Ready
+		set.Spec.Parameters = []nvidiacomv1alpha1.DGDRSetParameters{set.Spec.Parameters[0], set.Spec.Parameters[2]}
+		Expect(k8sClient.Update(ctx, set)).Should(Succeed())
+		reconcile(ctx, set)
+		Expect(apierrors.IsNotFound(k8sClient.Get(ctx, types.NamespacedName{Name: "test-dgdrset-budget-qwen", Namespace: defaultNamespace}, child))).To(BeTrue())
+		Expect(set.Status.Phase).Should(Equal(nvidiacomv1alpha1.DGDRSetPhaseReady))
+		Expect(set.Status.Conditions[0].Status).Should(Equal(metav1.ConditionTrue))
+	})
+
+	It("Should share the concurrency budget of the operator across sets", func() {
+		ctx := context.Background()
+		reconciler.MaxInProgress = 2
+		first := newSet("test-dgdrset-shared-a",
+			nvidiacomv1alpha1.DGDRSetParameters{Name: "llama", Model: "meta-llama/Llama-3.1-8B"},
+			nvidiacomv1alpha1.DGDRSetParameters{Name: "qwen", Model: "Qwen/Qwen3-0.6B"},
+		)
+		second := newSet("test-dgdrset-shared-b",
+			nvidiacomv1alpha1.DGDRSetParameters{Name: "mistral", Model: "mistralai/Mistral-7B"},
+		)
+		Expect(k8sClient.Create(ctx, first)).Should(Succeed())
+		defer deleteSet(ctx, first)
+		Expect(k8sClient.Create(ctx, second)).Should(Succeed())
+		defer deleteSet(ctx, second)
+
+		reconcile(ctx, first)
+		Expect(first.Status.InProgress).Should(Equal(int32(2)))
+		result, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(second)})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).Should(Equal(dgdrSetWaitingRequeueInterval))
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(second), second)).Should(Succeed())
+		Expect(second.Status.Waiting).Should(Equal(int32(1)))
+
+		// A child of the other set settling frees the budget
+		setChildState(ctx, "test-dgdrset-shared-a-llama", StateReady)
+		reconcile(ctx, second)
+		Expect(second.Status.Waiting).Should(BeZero())
+		Expect(second.Status.InProgress).Should(Equal(int32(1)))
+	})
+
+	It("Should fail children whose spec is invalid and delete children with the set", func() {
+		ctx := context.Background()
+		set := newSet("test-dgdrset-invalid",
+			nvidiacomv1alpha1.DGDRSetParameters{Name: "valid"},
+			nvidiacomv1alpha1.DGDRSetParameters{Name: "typo", Overrides: &runtime.RawExtension{Raw: []byte(`{"backendd": "sglang"}`)}},
+		)
+		Expect(k8sClient.Create(ctx, set)).Should(Succeed())
+
+		reconcile(ctx, set)
+		Expect(set.Status.Children[1].State).Should(Equal(StateFailed))
+		Expect(set.Status.Children[1].Message).Should(ContainSubstring("backendd"))
+		Expect(set.Status.Failed).Should(Equal(int32(1)))
+		Expect(set.Status.InProgress).Should(Equal(int32(1)))
+
+		child := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: "test-dgdrset-invalid-valid", Namespace: defaultNamespace}, child)).Should(Succeed())
+		Expect(child.Spec.Model).Should(Equal("template-model"))
+
+		deleteSet(ctx, set)
+		Expect(apierrors.IsNotFound(k8sClient.Get(ctx, client.ObjectKeyFromObject(child), child))).To(BeTrue())
+		Expect(apierrors.IsNotFound(k8sClient.Get(ctx, client.ObjectKeyFromObject(set), set))).To(BeTrue())
+	})
+})
@@ -0,0 +1,75 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+)
+
+// DynamoProfilingRunReconciler deletes DynamoProfilingRun audit records once they exceed the
+// configured retention
+type DynamoProfilingRunReconciler struct {
+	client.Client
+
+	// Retention is how long records are kept. Zero keeps records forever.
+	Retention time.Duration
+}
+
+// +kubebuilder:rbac:groups=nvidia.com,resources=dynamoprofilingruns,verbs=get;list;watch;create;delete
+
+// Reconcile deletes the record if it is past retention, or requeues it for when it will be
+func (r *DynamoProfilingRunReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	run := &nvidiacomv1alpha1.DynamoProfilingRun{}
+	if err := r.Get(ctx, req.NamespacedName, run); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	expiry := run.CreationTimestamp.Add(r.Retention)
+	if remaining := time.Until(expiry); remaining > 0 {
+		return ctrl.Result{RequeueAfter: remaining}, nil
+	}
+
+	logger.Info("Deleting profiling run record past retention", "retention", r.Retention)
+	if err := r.Delete(ctx, run); err != nil && !apierrors.IsNotFound(err) {
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager. Nothing is watched when retention is unlimited.
+func (r *DynamoProfilingRunReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.Retention <= 0 {
+		return nil
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&nvidiacomv1alpha1.DynamoProfilingRun{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Named("dynamoprofilingrun").
+		Complete(r)
+}
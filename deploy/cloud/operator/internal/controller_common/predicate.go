@@ -76,6 +76,8 @@ type RBACConfig struct {
 	PlannerClusterRoleName string
 	// DGDRProfilingClusterRoleName is the name of the ClusterRole for DGDR profiling jobs (cluster-wide mode only)
 	DGDRProfilingClusterRoleName string
+	// DGDRWorkerClusterRoleName is the name of the ClusterRole bound to ServiceAccounts provisioned for DGDR-generated deployments
+	DGDRWorkerClusterRoleName string
 }
 
 type IngressConfig struct {
@@ -113,6 +115,11 @@ func DetectKaiSchedulerAvailability(ctx context.Context, mgr ctrl.Manager) bool
 	return detectAPIGroupAvailability(ctx, mgr, "scheduling.run.ai")
 }
 
+// DetectPrometheusOperatorAvailability checks if the Prometheus Operator is available by checking if the monitoring.coreos.com API group is registered
+func DetectPrometheusOperatorAvailability(ctx context.Context, mgr ctrl.Manager) bool {
+	return detectAPIGroupAvailability(ctx, mgr, "monitoring.coreos.com")
+}
+
 // detectAPIGroupAvailability checks if a specific API group is registered in the cluster
 func detectAPIGroupAvailability(ctx context.Context, mgr ctrl.Manager, groupName string) bool {
 	logger := log.FromContext(ctx)
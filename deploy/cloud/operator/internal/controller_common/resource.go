@@ -27,6 +27,7 @@ import (
 
 	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/dynamo/common"
 	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/consts"
+	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/metadata"
 	"github.com/google/go-cmp/cmp"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -56,9 +57,30 @@ type Reconciler interface {
 // if the resource should be deleted, the returned resource must contain the necessary information to delete it (name and namespace)
 type ResourceGenerator[T client.Object] func(ctx context.Context) (T, bool, error)
 
+// SyncOption changes how SyncResource syncs a resource
+type SyncOption func(*syncOptions)
+
+type syncOptions struct {
+	managedMetadata bool
+}
+
+// WithManagedMetadata makes SyncResource manage the labels and annotations of the resource with the
+// metadata package: metadata-only changes are updated, labels and annotations the operator no longer
+// sets are removed and those added by users are kept. Without it, only the spec of an existing
+// resource is updated.
+func WithManagedMetadata() SyncOption {
+	return func(o *syncOptions) {
+		o.managedMetadata = true
+	}
+}
+
 //nolint:nakedret
-func SyncResource[T client.Object](ctx context.Context, r Reconciler, parentResource client.Object, generateResource ResourceGenerator[T]) (modified bool, res T, err error) {
+func SyncResource[T client.Object](ctx context.Context, r Reconciler, parentResource client.Object, generateResource ResourceGenerator[T], opts ...SyncOption) (modified bool, res T, err error) {
 	logs := log.FromContext(ctx)
+	var options syncOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
 
 	resource, toDelete, err := generateResource(ctx)
 	if err != nil {
@@ -120,6 +142,9 @@ func SyncResource[T client.Object](ctx context.Context, r Reconciler, parentReso
 			return
 		}
 
+		if options.managedMetadata {
+			metadata.Apply(resource, metadata.Of(resource))
+		}
 		updateHashAnnotation(resource, hash)
 
 		r.GetRecorder().Eventf(parentResource, corev1.EventTypeNormal, fmt.Sprintf("Create%s", resourceType), "Creating a new %s %s", resourceType, resourceNamespace)
@@ -174,7 +199,11 @@ func SyncResource[T client.Object](ctx context.Context, r Reconciler, parentReso
 			}
 
 			updateHashAnnotation(oldResource, *newHash)
+		}
 
+		// Labels and annotations the operator set are updated without clobbering those of users
+		metadataChanged := options.managedMetadata && metadata.Apply(oldResource, metadata.Of(resource))
+		if newHash != nil || metadataChanged {
 			err = r.Update(ctx, oldResource)
 			if err != nil {
 				logs.Error(err, fmt.Sprintf("Failed to update %s.", resourceType))
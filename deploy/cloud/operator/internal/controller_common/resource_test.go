@@ -18,14 +18,19 @@
 package controller_common
 
 import (
+	"context"
 	"testing"
 
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/bsm/gomega"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 func TestIsSpecChanged2(t *testing.T) {
@@ -474,3 +479,60 @@ func TestCopySpec(t *testing.T) {
 	g := gomega.NewGomegaWithT(t)
 	g.Expect(dst).To(gomega.Equal(expected))
 }
+
+type testReconciler struct {
+	client.Client
+	recorder record.EventRecorder
+}
+
+func (r *testReconciler) GetRecorder() record.EventRecorder {
+	return r.recorder
+}
+
+func TestSyncResourceManagedMetadata(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	g.Expect(corev1.AddToScheme(scheme)).To(gomega.Succeed())
+	parent := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "parent", Namespace: "default", UID: "1234567890"}}
+
+	service := func(labels map[string]string) func(context.Context) (*corev1.Service, bool, error) {
+		return func(context.Context) (*corev1.Service, bool, error) {
+			return &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Name: "svc", Namespace: "default", Labels: labels},
+				Spec:       corev1.ServiceSpec{Ports: []corev1.ServicePort{{Port: 8000}}},
+			}, false, nil
+		}
+	}
+	// addUserLabel adds a label to the synced Service the way a user would
+	addUserLabel := func(r *testReconciler) {
+		live := &corev1.Service{}
+		g.Expect(r.Get(ctx, client.ObjectKey{Name: "svc", Namespace: "default"}, live)).To(gomega.Succeed())
+		live.Labels["team"] = "a"
+		g.Expect(r.Update(ctx, live)).To(gomega.Succeed())
+	}
+
+	// Without the option, only the spec of an existing resource is synced
+	r := &testReconciler{Client: fake.NewClientBuilder().WithScheme(scheme).Build(), recorder: record.NewFakeRecorder(100)}
+	_, _, err := SyncResource(ctx, r, parent, service(map[string]string{"app": "old"}))
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	addUserLabel(r)
+	modified, synced, err := SyncResource(ctx, r, parent, service(map[string]string{"app": "new"}))
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(modified).To(gomega.BeFalse())
+	g.Expect(synced.Labels).To(gomega.Equal(map[string]string{"app": "old", "team": "a"}))
+
+	// With it, the labels the operator set are updated, removed when no longer set, and those of users kept
+	r = &testReconciler{Client: fake.NewClientBuilder().WithScheme(scheme).Build(), recorder: record.NewFakeRecorder(100)}
+	_, _, err = SyncResource(ctx, r, parent, service(map[string]string{"app": "old", "tier": "web"}), WithManagedMetadata())
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	addUserLabel(r)
+	modified, synced, err = SyncResource(ctx, r, parent, service(map[string]string{"app": "new"}), WithManagedMetadata())
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(modified).To(gomega.BeTrue())
+	g.Expect(synced.Labels).To(gomega.Equal(map[string]string{"app": "new", "team": "a"}))
+
+	modified, _, err = SyncResource(ctx, r, parent, service(map[string]string{"app": "new"}), WithManagedMetadata())
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(modified).To(gomega.BeFalse())
+}
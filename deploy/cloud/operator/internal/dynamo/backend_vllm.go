@@ -168,7 +168,7 @@ func getContainerGPUs(resources corev1.ResourceRequirements) int64 {
 	var containerGPUs int64 = 1
 	// Requests defaults to Limits, doesn't make sense in case where Requests < Limits for gpus
 	for name, quantity := range resources.Limits {
-		if name.String() == consts.KubeResourceGPUNvidia {
+		if name.String() == consts.KubeResourceGPUNvidia || name.String() == consts.KubeResourceGPUAMD {
 			containerGPUs = quantity.Value()
 			break
 		}
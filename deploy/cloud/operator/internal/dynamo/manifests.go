@@ -0,0 +1,212 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dynamo
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"sort"
+
+	grovev1alpha1 "github.com/NVIDIA/grove/operator/api/core/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	leaderworkersetv1 "sigs.k8s.io/lws/api/leaderworkerset/v1"
+
+	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	commonconsts "github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/consts"
+	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/controller_common"
+)
+
+// GenerateRawManifests flattens a DynamoGraphDeployment into plain Kubernetes objects that can be
+// applied on clusters without the Dynamo CRDs: one Deployment per service, plus a Service for the
+// frontend. Objects are returned in a stable order (by service name, Deployment before Service).
+// Multinode services are rejected because they require LeaderWorkerSet or Grove, see
+// GenerateLWSManifests and GenerateGroveManifests.
+func GenerateRawManifests(ctx context.Context, dynamoDeployment *v1alpha1.DynamoGraphDeployment, controllerConfig controller_common.Config) ([]client.Object, error) {
+	return generateComponentManifests(ctx, dynamoDeployment, controllerConfig, nil)
+}
+
+// GenerateLWSManifests is GenerateRawManifests for clusters running LeaderWorkerSet: multinode services
+// are rendered as a LeaderWorkerSet with one group of leader and workers per replica instead of being
+// rejected. Workers of multinode services must request GPUs.
+func GenerateLWSManifests(ctx context.Context, dynamoDeployment *v1alpha1.DynamoGraphDeployment, controllerConfig controller_common.Config) ([]client.Object, error) {
+	return generateComponentManifests(ctx, dynamoDeployment, controllerConfig, generateLeaderWorkerSet)
+}
+
+// GenerateGroveManifests flattens a DynamoGraphDeployment into the Grove PodCliqueSet the Dynamo operator
+// would create for it, plus a Service for the frontend, for clusters running Grove without the Dynamo CRDs
+func GenerateGroveManifests(ctx context.Context, dynamoDeployment *v1alpha1.DynamoGraphDeployment, controllerConfig controller_common.Config) ([]client.Object, error) {
+	podCliqueSet, err := GenerateGrovePodCliqueSet(ctx, dynamoDeployment, controllerConfig, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate PodCliqueSet: %w", err)
+	}
+	podCliqueSet.TypeMeta = metav1.TypeMeta{APIVersion: grovev1alpha1.SchemeGroupVersion.String(), Kind: "PodCliqueSet"}
+	objects := []client.Object{podCliqueSet}
+
+	serviceNames := make([]string, 0, len(dynamoDeployment.Spec.Services))
+	for serviceName := range dynamoDeployment.Spec.Services {
+		serviceNames = append(serviceNames, serviceName)
+	}
+	sort.Strings(serviceNames)
+	for _, serviceName := range serviceNames {
+		if dynamoDeployment.Spec.Services[serviceName].ComponentType != commonconsts.ComponentTypeFrontend {
+			continue
+		}
+		componentName := GetDynamoComponentName(dynamoDeployment, serviceName)
+		service, err := GenerateComponentService(ctx, componentName, dynamoDeployment.Namespace)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate service for %s: %w", serviceName, err)
+		}
+		service.TypeMeta = metav1.TypeMeta{APIVersion: corev1.SchemeGroupVersion.String(), Kind: "Service"}
+		objects = append(objects, service)
+	}
+	return objects, nil
+}
+
+// multinodeGenerator renders a multinode component with its labels and pod metadata
+type multinodeGenerator func(component *v1alpha1.DynamoComponentDeployment, controllerConfig controller_common.Config, labels map[string]string, podMetadata metav1.ObjectMeta) (client.Object, error)
+
+// generateComponentManifests renders every service as a Deployment, or with multinode for multinode
+// services, plus a Service for the frontend
+func generateComponentManifests(ctx context.Context, dynamoDeployment *v1alpha1.DynamoGraphDeployment, controllerConfig controller_common.Config, multinode multinodeGenerator) ([]client.Object, error) {
+	components, err := GenerateDynamoComponentsDeployments(ctx, dynamoDeployment, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate component deployments: %w", err)
+	}
+
+	serviceNames := make([]string, 0, len(components))
+	for serviceName := range components {
+		serviceNames = append(serviceNames, serviceName)
+	}
+	sort.Strings(serviceNames)
+
+	objects := make([]client.Object, 0, len(components)+1)
+	for _, serviceName := range serviceNames {
+		component := components[serviceName]
+		if component.IsMultinode() && multinode == nil {
+			return nil, fmt.Errorf("service %s is multinode and cannot be rendered as a plain Deployment", serviceName)
+		}
+
+		labels := maps.Clone(component.Labels)
+		labels[commonconsts.KubeLabelDynamoSelector] = component.Name
+
+		podLabels := maps.Clone(labels)
+		podAnnotations := map[string]string{}
+		if component.Spec.ComponentType != "" {
+			podLabels[commonconsts.KubeLabelDynamoComponentType] = component.Spec.ComponentType
+		}
+		if component.Spec.ExtraPodMetadata != nil {
+			maps.Copy(podLabels, component.Spec.ExtraPodMetadata.Labels)
+			maps.Copy(podAnnotations, component.Spec.ExtraPodMetadata.Annotations)
+		}
+
+		podMetadata := metav1.ObjectMeta{Labels: podLabels, Annotations: podAnnotations}
+
+		if component.IsMultinode() {
+			obj, err := multinode(component, controllerConfig, labels, podMetadata)
+			if err != nil {
+				return nil, fmt.Errorf("failed to generate multinode workload for service %s: %w", serviceName, err)
+			}
+			objects = append(objects, obj)
+		} else {
+			podSpec, err := GenerateBasePodSpecForController(component, nil, controllerConfig, RoleMain, commonconsts.MultinodeDeploymentTypeLWS)
+			if err != nil {
+				return nil, fmt.Errorf("failed to generate pod spec for service %s: %w", serviceName, err)
+			}
+			objects = append(objects, &appsv1.Deployment{
+				TypeMeta: metav1.TypeMeta{APIVersion: appsv1.SchemeGroupVersion.String(), Kind: "Deployment"},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        component.Name,
+					Namespace:   component.Namespace,
+					Labels:      labels,
+					Annotations: component.Spec.Annotations,
+				},
+				Spec: appsv1.DeploymentSpec{
+					Replicas: component.Spec.Replicas,
+					Selector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{
+							commonconsts.KubeLabelDynamoSelector: component.Name,
+						},
+					},
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: podMetadata,
+						Spec:       *podSpec,
+					},
+				},
+			})
+		}
+
+		if component.IsFrontendComponent() {
+			service, err := GenerateComponentService(ctx, component.Name, component.Namespace)
+			if err != nil {
+				return nil, fmt.Errorf("failed to generate service for %s: %w", serviceName, err)
+			}
+			service.TypeMeta = metav1.TypeMeta{APIVersion: corev1.SchemeGroupVersion.String(), Kind: "Service"}
+			service.Labels = labels
+			objects = append(objects, service)
+		}
+	}
+
+	return objects, nil
+}
+
+// generateLeaderWorkerSet renders a multinode component as a LeaderWorkerSet. Unlike the Dynamo operator,
+// which creates a LeaderWorkerSet per replica to gang schedule it with Volcano, the replicas are the
+// LeaderWorkerSet's own, so the manifests do not depend on a particular scheduler.
+func generateLeaderWorkerSet(component *v1alpha1.DynamoComponentDeployment, controllerConfig controller_common.Config, labels map[string]string, podMetadata metav1.ObjectMeta) (client.Object, error) {
+	if component.Spec.Resources == nil || component.Spec.Resources.Limits == nil || component.Spec.Resources.Limits.GPU == "" {
+		return nil, fmt.Errorf("GPU limit is not set for the LeaderWorkerSet workers")
+	}
+
+	templates := make(map[Role]corev1.PodTemplateSpec, 2)
+	for _, role := range []Role{RoleLeader, RoleWorker} {
+		podSpec, err := GenerateBasePodSpecForController(component, nil, controllerConfig, role, commonconsts.MultinodeDeploymentTypeLWS)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate %s pod spec: %w", role, err)
+		}
+		metadata := *podMetadata.DeepCopy()
+		metadata.Labels["role"] = string(role)
+		// Like the Dynamo operator, keep component Services from selecting the group pods
+		delete(metadata.Labels, commonconsts.KubeLabelDynamoSelector)
+		templates[role] = corev1.PodTemplateSpec{ObjectMeta: metadata, Spec: *podSpec}
+	}
+	leaderTemplate := templates[RoleLeader]
+	groupSize := component.GetNumberOfNodes()
+
+	return &leaderworkersetv1.LeaderWorkerSet{
+		TypeMeta: metav1.TypeMeta{APIVersion: leaderworkersetv1.GroupVersion.String(), Kind: "LeaderWorkerSet"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        component.Name,
+			Namespace:   component.Namespace,
+			Labels:      labels,
+			Annotations: component.Spec.Annotations,
+		},
+		Spec: leaderworkersetv1.LeaderWorkerSetSpec{
+			Replicas:      component.Spec.Replicas,
+			StartupPolicy: leaderworkersetv1.LeaderCreatedStartupPolicy,
+			LeaderWorkerTemplate: leaderworkersetv1.LeaderWorkerTemplate{
+				LeaderTemplate: &leaderTemplate,
+				WorkerTemplate: templates[RoleWorker],
+				Size:           &groupSize,
+			},
+		},
+	}, nil
+}
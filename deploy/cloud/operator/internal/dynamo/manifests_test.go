@@ -0,0 +1,200 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dynamo
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	grovev1alpha1 "github.com/NVIDIA/grove/operator/api/core/v1alpha1"
+	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/dynamo/common"
+	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	commonconsts "github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/consts"
+	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/controller_common"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ptr "k8s.io/utils/ptr"
+	leaderworkersetv1 "sigs.k8s.io/lws/api/leaderworkerset/v1"
+)
+
+func newRawManifestsTestDGD() *v1alpha1.DynamoGraphDeployment {
+	return &v1alpha1.DynamoGraphDeployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-dgd",
+			Namespace: "default",
+		},
+		Spec: v1alpha1.DynamoGraphDeploymentSpec{
+			BackendFramework: string(BackendFrameworkVLLM),
+			Services: map[string]*v1alpha1.DynamoComponentDeploymentSharedSpec{
+				"Frontend": {
+					ComponentType: commonconsts.ComponentTypeFrontend,
+					Replicas:      ptr.To(int32(1)),
+					ExtraPodSpec: &common.ExtraPodSpec{
+						MainContainer: &corev1.Container{Image: "frontend-image"},
+					},
+				},
+				"VllmDecodeWorker": {
+					ComponentType: commonconsts.ComponentTypeWorker,
+					Replicas:      ptr.To(int32(2)),
+					ExtraPodSpec: &common.ExtraPodSpec{
+						MainContainer: &corev1.Container{
+							Image:   "worker-image",
+							Command: []string{"python3", "-m", "dynamo.vllm"},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestGenerateRawManifests(t *testing.T) {
+	objects, err := GenerateRawManifests(context.Background(), newRawManifestsTestDGD(), controller_common.Config{})
+	if err != nil {
+		t.Fatalf("GenerateRawManifests() error = %v", err)
+	}
+
+	// Frontend Deployment, Frontend Service, worker Deployment
+	if len(objects) != 3 {
+		t.Fatalf("expected 3 objects, got %d", len(objects))
+	}
+
+	frontend, ok := objects[0].(*appsv1.Deployment)
+	if !ok {
+		t.Fatalf("expected first object to be a Deployment, got %T", objects[0])
+	}
+	if frontend.Kind != "Deployment" || frontend.APIVersion != "apps/v1" {
+		t.Errorf("expected TypeMeta apps/v1 Deployment, got %s %s", frontend.APIVersion, frontend.Kind)
+	}
+	if frontend.Name != "test-dgd-frontend" {
+		t.Errorf("expected frontend Deployment name test-dgd-frontend, got %s", frontend.Name)
+	}
+	if frontend.Spec.Template.Labels[commonconsts.KubeLabelDynamoSelector] != frontend.Name {
+		t.Errorf("expected pod template to carry the selector label")
+	}
+
+	service, ok := objects[1].(*corev1.Service)
+	if !ok {
+		t.Fatalf("expected second object to be a Service, got %T", objects[1])
+	}
+	if service.Spec.Selector[commonconsts.KubeLabelDynamoSelector] != frontend.Name {
+		t.Errorf("expected Service to select the frontend Deployment pods, got %v", service.Spec.Selector)
+	}
+
+	worker, ok := objects[2].(*appsv1.Deployment)
+	if !ok {
+		t.Fatalf("expected third object to be a Deployment, got %T", objects[2])
+	}
+	if worker.Spec.Replicas == nil || *worker.Spec.Replicas != 2 {
+		t.Errorf("expected worker replicas 2, got %v", worker.Spec.Replicas)
+	}
+	if len(worker.Spec.Template.Spec.Containers) == 0 || worker.Spec.Template.Spec.Containers[0].Image != "worker-image" {
+		t.Errorf("expected worker container image worker-image")
+	}
+}
+
+func TestGenerateRawManifests_RejectsMultinode(t *testing.T) {
+	dgd := newRawManifestsTestDGD()
+	dgd.Spec.Services["VllmDecodeWorker"].Multinode = &v1alpha1.MultinodeSpec{NodeCount: 2}
+
+	_, err := GenerateRawManifests(context.Background(), dgd, controller_common.Config{})
+	if err == nil || !strings.Contains(err.Error(), "multinode") {
+		t.Fatalf("expected multinode error, got %v", err)
+	}
+}
+
+func TestGenerateLWSManifests(t *testing.T) {
+	dgd := newRawManifestsTestDGD()
+	worker := dgd.Spec.Services["VllmDecodeWorker"]
+	worker.Multinode = &v1alpha1.MultinodeSpec{NodeCount: 2}
+	worker.ExtraPodSpec.MainContainer.Args = []string{"--model", "test"}
+
+	if _, err := GenerateLWSManifests(context.Background(), dgd, controller_common.Config{}); err == nil || !strings.Contains(err.Error(), "GPU limit") {
+		t.Fatalf("expected GPU limit error, got %v", err)
+	}
+
+	worker.Resources = &common.Resources{Limits: &common.ResourceItem{GPU: "8"}}
+	objects, err := GenerateLWSManifests(context.Background(), dgd, controller_common.Config{})
+	if err != nil {
+		t.Fatalf("GenerateLWSManifests() error = %v", err)
+	}
+
+	// Frontend Deployment, Frontend Service, worker LeaderWorkerSet
+	if len(objects) != 3 {
+		t.Fatalf("expected 3 objects, got %d", len(objects))
+	}
+	if _, ok := objects[0].(*appsv1.Deployment); !ok {
+		t.Fatalf("expected first object to be a Deployment, got %T", objects[0])
+	}
+	lws, ok := objects[2].(*leaderworkersetv1.LeaderWorkerSet)
+	if !ok {
+		t.Fatalf("expected third object to be a LeaderWorkerSet, got %T", objects[2])
+	}
+	if lws.Kind != "LeaderWorkerSet" || lws.APIVersion != "leaderworkerset.x-k8s.io/v1" {
+		t.Errorf("expected TypeMeta leaderworkerset.x-k8s.io/v1 LeaderWorkerSet, got %s %s", lws.APIVersion, lws.Kind)
+	}
+	if lws.Spec.Replicas == nil || *lws.Spec.Replicas != 2 {
+		t.Errorf("expected LeaderWorkerSet replicas 2, got %v", lws.Spec.Replicas)
+	}
+	if size := lws.Spec.LeaderWorkerTemplate.Size; size == nil || *size != 2 {
+		t.Errorf("expected group size 2, got %v", size)
+	}
+	if role := lws.Spec.LeaderWorkerTemplate.LeaderTemplate.Labels["role"]; role != "leader" {
+		t.Errorf("expected leader role label, got %q", role)
+	}
+	if role := lws.Spec.LeaderWorkerTemplate.WorkerTemplate.Labels["role"]; role != "worker" {
+		t.Errorf("expected worker role label, got %q", role)
+	}
+	if _, ok := lws.Spec.LeaderWorkerTemplate.WorkerTemplate.Labels[commonconsts.KubeLabelDynamoSelector]; ok {
+		t.Errorf("expected worker pods not to carry the selector label")
+	}
+}
+
+func TestGenerateGroveManifests(t *testing.T) {
+	dgd := newRawManifestsTestDGD()
+	dgd.Spec.Services["VllmDecodeWorker"].Multinode = &v1alpha1.MultinodeSpec{NodeCount: 2}
+
+	objects, err := GenerateGroveManifests(context.Background(), dgd, controller_common.Config{})
+	if err != nil {
+		t.Fatalf("GenerateGroveManifests() error = %v", err)
+	}
+
+	// PodCliqueSet, Frontend Service
+	if len(objects) != 2 {
+		t.Fatalf("expected 2 objects, got %d", len(objects))
+	}
+	pcs, ok := objects[0].(*grovev1alpha1.PodCliqueSet)
+	if !ok {
+		t.Fatalf("expected first object to be a PodCliqueSet, got %T", objects[0])
+	}
+	if pcs.Kind != "PodCliqueSet" || pcs.Name != "test-dgd" {
+		t.Errorf("expected PodCliqueSet test-dgd, got %s %s", pcs.Kind, pcs.Name)
+	}
+	if len(pcs.Spec.Template.PodCliqueScalingGroupConfigs) != 1 {
+		t.Errorf("expected a scaling group for the multinode worker, got %d", len(pcs.Spec.Template.PodCliqueScalingGroupConfigs))
+	}
+	service, ok := objects[1].(*corev1.Service)
+	if !ok {
+		t.Fatalf("expected second object to be a Service, got %T", objects[1])
+	}
+	if service.Name != "test-dgd-frontend" {
+		t.Errorf("expected frontend Service test-dgd-frontend, got %s", service.Name)
+	}
+}
@@ -0,0 +1,147 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package metadata manages the labels and annotations the operator sets on the objects it creates.
+// The keys the operator set are recorded on each object, so that updates remove the keys the
+// operator no longer sets while labels and annotations added by users are left alone.
+package metadata
+
+import (
+	"encoding/json"
+	"maps"
+	"slices"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AnnotationManagedKeys records the label and annotation keys the operator manages on an object
+const AnnotationManagedKeys = "nvidia.com/managed-metadata"
+
+// Set is the labels and annotations the operator wants on an object
+type Set struct {
+	Labels      map[string]string
+	Annotations map[string]string
+}
+
+// Of returns the labels and annotations of obj, typically a generated object, as a Set
+func Of(obj metav1.Object) Set {
+	annotations := maps.Clone(obj.GetAnnotations())
+	delete(annotations, AnnotationManagedKeys)
+	return Set{Labels: maps.Clone(obj.GetLabels()), Annotations: annotations}
+}
+
+// managedKeys is the value of AnnotationManagedKeys
+type managedKeys struct {
+	Labels      []string `json:"labels,omitempty"`
+	Annotations []string `json:"annotations,omitempty"`
+}
+
+// managed returns the keys recorded on obj. A missing or unreadable record manages no keys.
+func managed(obj metav1.Object) managedKeys {
+	var keys managedKeys
+	if value, ok := obj.GetAnnotations()[AnnotationManagedKeys]; ok {
+		_ = json.Unmarshal([]byte(value), &keys)
+	}
+	return keys
+}
+
+// Apply makes the operator-managed labels and annotations of obj match desired: desired keys are
+// set, keys the operator set before but no longer desires are removed, and all other keys are kept.
+// It is idempotent and reports whether obj changed.
+func Apply(obj metav1.Object, desired Set) bool {
+	previous := managed(obj)
+	desiredAnnotations := maps.Clone(desired.Annotations)
+	delete(desiredAnnotations, AnnotationManagedKeys)
+
+	labels, labelsChanged := apply(obj.GetLabels(), desired.Labels, previous.Labels)
+	if labelsChanged {
+		obj.SetLabels(labels)
+	}
+	annotations, annotationsChanged := apply(obj.GetAnnotations(), desiredAnnotations, previous.Annotations)
+	recordChanged := record(&annotations, managedKeys{
+		Labels:      slices.Sorted(maps.Keys(desired.Labels)),
+		Annotations: slices.Sorted(maps.Keys(desiredAnnotations)),
+	})
+	if annotationsChanged || recordChanged {
+		obj.SetAnnotations(annotations)
+	}
+	return labelsChanged || annotationsChanged || recordChanged
+}
+
+// Strip removes the given operator-managed labels from obj and stops managing them, e.g. when the
+// object is released to its users. It reports whether obj changed.
+func Strip(obj metav1.Object, labels ...string) bool {
+	keys := managed(obj)
+	current, changed := apply(obj.GetLabels(), nil, labels)
+	if changed {
+		obj.SetLabels(current)
+	}
+	keys.Labels = slices.DeleteFunc(keys.Labels, func(key string) bool { return slices.Contains(labels, key) })
+	annotations := obj.GetAnnotations()
+	if record(&annotations, keys) {
+		obj.SetAnnotations(annotations)
+		changed = true
+	}
+	return changed
+}
+
+// apply sets the desired entries in current and removes the previously managed keys that are no
+// longer desired. It reports whether current changed.
+func apply(current, desired map[string]string, previous []string) (map[string]string, bool) {
+	changed := false
+	for _, key := range previous {
+		if _, keep := desired[key]; keep {
+			continue
+		}
+		if _, exists := current[key]; exists {
+			delete(current, key)
+			changed = true
+		}
+	}
+	for key, value := range desired {
+		if existing, exists := current[key]; exists && existing == value {
+			continue
+		}
+		if current == nil {
+			current = map[string]string{}
+		}
+		current[key] = value
+		changed = true
+	}
+	return current, changed
+}
+
+// record stores the managed keys in annotations, removing the record if no keys are managed. It
+// reports whether annotations changed.
+func record(annotations *map[string]string, keys managedKeys) bool {
+	if len(keys.Labels) == 0 && len(keys.Annotations) == 0 {
+		if _, exists := (*annotations)[AnnotationManagedKeys]; !exists {
+			return false
+		}
+		delete(*annotations, AnnotationManagedKeys)
+		return true
+	}
+	value, _ := json.Marshal(keys)
+	if (*annotations)[AnnotationManagedKeys] == string(value) {
+		return false
+	}
+	if *annotations == nil {
+		*annotations = map[string]string{}
+	}
+	(*annotations)[AnnotationManagedKeys] = string(value)
+	return true
+}
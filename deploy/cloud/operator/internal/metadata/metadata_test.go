@@ -0,0 +1,98 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package metadata
+
+import (
+	"maps"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestApply(t *testing.T) {
+	obj := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "test"}}
+	if !Apply(obj, Set{
+		Labels:      map[string]string{"app": "profiler", "tier": "gpu"},
+		Annotations: map[string]string{"nvidia.com/source": "profiler"},
+	}) {
+		t.Fatal("Apply() on a new object reported no change")
+	}
+	if got := obj.Annotations[AnnotationManagedKeys]; got != `{"labels":["app","tier"],"annotations":["nvidia.com/source"]}` {
+		t.Errorf("managed keys = %s", got)
+	}
+
+	// Users add their own metadata, the operator drops a label and changes an annotation
+	obj.Labels["team"] = "inference"
+	obj.Annotations["owner"] = "alice"
+	desired := Set{
+		Labels:      map[string]string{"app": "profiler"},
+		Annotations: map[string]string{"nvidia.com/source": "catalog"},
+	}
+	if !Apply(obj, desired) {
+		t.Fatal("Apply() with new metadata reported no change")
+	}
+	if want := map[string]string{"app": "profiler", "team": "inference"}; !maps.Equal(obj.Labels, want) {
+		t.Errorf("labels = %v, want %v", obj.Labels, want)
+	}
+	if obj.Annotations["owner"] != "alice" || obj.Annotations["nvidia.com/source"] != "catalog" {
+		t.Errorf("annotations = %v", obj.Annotations)
+	}
+	if Apply(obj, desired) {
+		t.Error("Apply() is not idempotent")
+	}
+}
+
+func TestApplyOf(t *testing.T) {
+	generated := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "profiler"}}}
+	Apply(generated, Of(generated))
+
+	// The record of the generated object is not managed as an annotation of its own
+	live := &corev1.ConfigMap{}
+	Apply(live, Of(generated))
+	if got := live.Annotations[AnnotationManagedKeys]; got != `{"labels":["app"]}` {
+		t.Errorf("managed keys = %s", got)
+	}
+}
+
+func TestStrip(t *testing.T) {
+	obj := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"team": "inference"}}}
+	Apply(obj, Set{Labels: map[string]string{"dgdr": "test", "app": "profiler"}})
+
+	if !Strip(obj, "dgdr") {
+		t.Fatal("Strip() reported no change")
+	}
+	if want := map[string]string{"app": "profiler", "team": "inference"}; !maps.Equal(obj.Labels, want) {
+		t.Errorf("labels = %v, want %v", obj.Labels, want)
+	}
+	if got := obj.Annotations[AnnotationManagedKeys]; got != `{"labels":["app"]}` {
+		t.Errorf("managed keys = %s", got)
+	}
+
+	// A released label set again by users is theirs
+	obj.Labels["dgdr"] = "kept"
+	Apply(obj, Set{Labels: map[string]string{"app": "profiler"}})
+	if obj.Labels["dgdr"] != "kept" {
+		t.Errorf("released label was removed")
+	}
+
+	Strip(obj, "app")
+	if _, exists := obj.Annotations[AnnotationManagedKeys]; exists {
+		t.Errorf("managed keys recorded without managed keys: %v", obj.Annotations)
+	}
+}
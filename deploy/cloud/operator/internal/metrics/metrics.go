@@ -0,0 +1,155 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package metrics defines the operator's own Prometheus metrics.
+// All collectors are registered with the controller-runtime registry so they are
+// served on the manager's metrics endpoint alongside the built-in controller metrics.
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	namespace = "dynamo_operator"
+
+	// LabelNamespace is the namespace of the object the metric refers to
+	LabelNamespace = "namespace"
+	// LabelReason is a machine-readable reason code
+	LabelReason = "reason"
+	// LabelOperation is the operation performed on an object
+	LabelOperation = "operation"
+	// LabelState is the state of the object when the metric was recorded
+	LabelState = "state"
+
+	// Operations recorded in LabelOperation
+	OperationCreated = "created"
+	OperationUpdated = "updated"
+	OperationFailed  = "failed"
+)
+
+var (
+	// DGDRFailuresTotal counts DGDR transitions into the Failed state, labeled by failure reason.
+	DGDRFailuresTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "dgdr",
+			Name:      "failures_total",
+			Help:      "Number of DynamoGraphDeploymentRequests that transitioned to Failed, by failure reason.",
+		},
+		[]string{LabelNamespace, LabelReason},
+	)
+
+	// RBACServiceAccountsCreatedTotal counts ServiceAccounts created by the RBAC manager, labeled by target namespace.
+	RBACServiceAccountsCreatedTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "rbac",
+			Name:      "service_accounts_created_total",
+			Help:      "Number of ServiceAccounts created by the operator, by target namespace.",
+		},
+		[]string{LabelNamespace},
+	)
+
+	// RBACRoleBindingOperationsTotal counts RoleBinding creations, updates and failures of the RBAC manager,
+	// labeled by target namespace and operation.
+	RBACRoleBindingOperationsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "rbac",
+			Name:      "role_binding_operations_total",
+			Help:      "Number of RoleBindings created, updated or failed to reconcile by the operator, by target namespace and operation.",
+		},
+		[]string{LabelNamespace, LabelOperation},
+	)
+
+	// DGDRSpecGenerationSeconds observes the time from profiling Job completion until the DGDR
+	// reports SpecGenerated, covering result delivery, parsing and post-processing.
+	DGDRSpecGenerationSeconds = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "dgdr",
+			Name:      "spec_generation_seconds",
+			Help:      "Time from profiling Job completion to the generated spec being recorded, by namespace.",
+			Buckets:   prometheus.ExponentialBuckets(0.1, 2, 12),
+		},
+		[]string{LabelNamespace},
+	)
+
+	// DGDRGeneratedSpecBytes observes the size of generated DynamoGraphDeployment specs.
+	DGDRGeneratedSpecBytes = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "dgdr",
+			Name:      "generated_spec_bytes",
+			Help:      "Size of the generated DynamoGraphDeployment spec in bytes, by namespace.",
+			Buckets:   prometheus.ExponentialBuckets(1024, 2, 12),
+		},
+		[]string{LabelNamespace},
+	)
+
+	// DGDRResultsFetchRetries observes how often fetching the profiling results had to be retried
+	// before they were found or given up on.
+	DGDRResultsFetchRetries = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "dgdr",
+			Name:      "results_fetch_retries",
+			Help:      "Number of retries needed to fetch the profiling results, by namespace.",
+			Buckets:   []float64{0, 1, 2, 3, 5},
+		},
+		[]string{LabelNamespace},
+	)
+
+	// DGDRResultsParsesSkippedTotal counts profiling results that were not parsed again because their
+	// checksum matched the results the generated deployment was rendered from.
+	DGDRResultsParsesSkippedTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "dgdr",
+			Name:      "results_parses_skipped_total",
+			Help:      "Number of unchanged profiling results that were not parsed again, by namespace.",
+		},
+		[]string{LabelNamespace},
+	)
+
+	// DGDRStatusUpdateConflictsTotal counts DGDR reconciles that failed on a conflicting update,
+	// labeled by the state the DGDR was in.
+	DGDRStatusUpdateConflictsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "dgdr",
+			Name:      "status_update_conflicts_total",
+			Help:      "Number of DynamoGraphDeploymentRequest reconciles that hit an update conflict, by namespace and state.",
+		},
+		[]string{LabelNamespace, LabelState},
+	)
+)
+
+func init() {
+	ctrlmetrics.Registry.MustRegister(
+		DGDRFailuresTotal,
+		RBACServiceAccountsCreatedTotal,
+		RBACRoleBindingOperationsTotal,
+		DGDRSpecGenerationSeconds,
+		DGDRGeneratedSpecBytes,
+		DGDRResultsFetchRetries,
+		DGDRResultsParsesSkippedTotal,
+		DGDRStatusUpdateConflictsTotal,
+	)
+}
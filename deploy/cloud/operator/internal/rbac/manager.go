@@ -7,14 +7,22 @@ package rbac
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/metrics"
 )
 
 const (
@@ -24,9 +32,50 @@ const (
 	apiGroupRBAC       = "rbac.authorization.k8s.io"
 )
 
+var (
+	// ErrClusterRoleNotFound is returned when the ClusterRole to bind does not exist.
+	// The ClusterRoles are created by the Helm chart, so this usually means the Helm values
+	// and the operator flags disagree.
+	ErrClusterRoleNotFound = errors.New("cluster role not found")
+
+	// ErrForbidden is returned when the operator is not allowed to manage the RBAC resources.
+	ErrForbidden = errors.New("forbidden")
+
+	// ErrClusterRoleInsufficient is returned when the ClusterRole exists but does not grant the
+	// permissions registered with RequireRules.
+	ErrClusterRoleInsufficient = errors.New("cluster role is missing required permissions")
+
+	// ErrClusterRoleAggregationEmpty is returned when no rules were aggregated into a ClusterRole
+	// registered with AggregateClusterRole, i.e. no ClusterRole carries its aggregation label.
+	ErrClusterRoleAggregationEmpty = errors.New("aggregated cluster role has no rules")
+)
+
+// aggregationPollInterval and aggregationTimeout bound the wait for the Kubernetes aggregation
+// controller to fill in the rules of a newly created aggregated ClusterRole
+var (
+	aggregationPollInterval = 250 * time.Millisecond
+	aggregationTimeout      = 10 * time.Second
+)
+
+// wrapError adds context to an API error, marking authorization failures with ErrForbidden.
+func wrapError(err error, format string, args ...any) error {
+	message := fmt.Sprintf(format, args...)
+	if apierrors.IsForbidden(err) {
+		return fmt.Errorf("%w: %s: %w", ErrForbidden, message, err)
+	}
+	return fmt.Errorf("%s: %w", message, err)
+}
+
 // Manager handles dynamic RBAC creation for cluster-wide operator installations.
 type Manager struct {
 	client client.Client
+
+	// requiredRules are the permissions a ClusterRole must grant before it is bound, by ClusterRole name
+	requiredRules map[string][]rbacv1.PolicyRule
+
+	// aggregationLabels are the label keys selecting the ClusterRoles aggregated into the bound role,
+	// by ClusterRole name
+	aggregationLabels map[string]string
 }
 
 // NewManager creates a new RBAC manager.
@@ -34,6 +83,128 @@ func NewManager(client client.Client) *Manager {
 	return &Manager{client: client}
 }
 
+// RequireRules registers the permissions the named ClusterRole must grant. EnsureServiceAccountWithRBAC
+// refuses to bind a ClusterRole that does not grant every verb on every resource of the rules.
+// ClusterRoles without registered rules are only checked for existence.
+func (m *Manager) RequireRules(clusterRoleName string, rules []rbacv1.PolicyRule) {
+	if m.requiredRules == nil {
+		m.requiredRules = map[string][]rbacv1.PolicyRule{}
+	}
+	m.requiredRules[clusterRoleName] = rules
+}
+
+// AggregateClusterRole binds the named ClusterRole as an aggregate of labeled roles instead of as a
+// fixed role. For each target namespace EnsureServiceAccountWithRBAC maintains a ClusterRole named
+// <clusterRoleName>-<namespace> whose rules the Kubernetes aggregation controller collects from
+// the ClusterRoles labeled <label>=true (the base rules of every namespace) and <label>=<namespace>
+// (extensions for that namespace only), and binds it once its rules are non-empty.
+func (m *Manager) AggregateClusterRole(clusterRoleName, label string) {
+	if m.aggregationLabels == nil {
+		m.aggregationLabels = map[string]string{}
+	}
+	m.aggregationLabels[clusterRoleName] = label
+}
+
+// AggregatedClusterRoleName returns the name of the ClusterRole aggregated for a namespace
+func AggregatedClusterRoleName(clusterRoleName, namespace string) string {
+	return fmt.Sprintf("%s-%s", clusterRoleName, namespace)
+}
+
+// ensureAggregatedClusterRole creates or updates the ClusterRole aggregating the base and namespace
+// roles labeled with label, and waits for the aggregation controller to fill in its rules
+func (m *Manager) ensureAggregatedClusterRole(ctx context.Context, targetNamespace, clusterRoleName, label string) (*rbacv1.ClusterRole, error) {
+	logger := log.FromContext(ctx)
+	aggregationRule := &rbacv1.AggregationRule{
+		ClusterRoleSelectors: []metav1.LabelSelector{
+			{MatchLabels: map[string]string{label: "true"}},
+			{MatchLabels: map[string]string{label: targetNamespace}},
+		},
+	}
+	name := AggregatedClusterRoleName(clusterRoleName, targetNamespace)
+	clusterRole := &rbacv1.ClusterRole{}
+	if err := m.client.Get(ctx, client.ObjectKey{Name: name}, clusterRole); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, wrapError(err, "failed to get aggregated cluster role %q", name)
+		}
+		clusterRole = &rbacv1.ClusterRole{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+				Labels: map[string]string{
+					"app.kubernetes.io/managed-by": "dynamo-operator",
+					"app.kubernetes.io/component":  "rbac",
+					"app.kubernetes.io/name":       clusterRoleName,
+				},
+			},
+			AggregationRule: aggregationRule,
+		}
+		if err := m.client.Create(ctx, clusterRole); err != nil {
+			return nil, wrapError(err, "failed to create aggregated cluster role %q", name)
+		}
+		logger.V(1).Info("Aggregated ClusterRole created", "clusterRole", name, "namespace", targetNamespace)
+	} else if !equality.Semantic.DeepEqual(clusterRole.AggregationRule, aggregationRule) {
+		clusterRole.AggregationRule = aggregationRule
+		if err := m.client.Update(ctx, clusterRole); err != nil {
+			return nil, wrapError(err, "failed to update aggregated cluster role %q", name)
+		}
+		logger.V(1).Info("Aggregated ClusterRole selectors updated", "clusterRole", name)
+	}
+
+	// The aggregation controller fills in the rules asynchronously
+	err := wait.PollUntilContextTimeout(ctx, aggregationPollInterval, aggregationTimeout, true, func(ctx context.Context) (bool, error) {
+		if len(clusterRole.Rules) > 0 {
+			return true, nil
+		}
+		if err := m.client.Get(ctx, client.ObjectKey{Name: name}, clusterRole); err != nil {
+			return false, wrapError(err, "failed to get aggregated cluster role %q", name)
+		}
+		return len(clusterRole.Rules) > 0, nil
+	})
+	if wait.Interrupted(err) {
+		return nil, fmt.Errorf("%w: cluster role %q aggregates no rules: label the base ClusterRoles %s=true or the namespace's ClusterRoles %s=%s",
+			ErrClusterRoleAggregationEmpty, name, label, label, targetNamespace)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return clusterRole, nil
+}
+
+// covers reports whether a single element is matched by a list that may contain the "*" wildcard
+func covers(values []string, value string) bool {
+	for _, v := range values {
+		if v == rbacv1.ResourceAll || v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// missingPermissions returns the "verb group/resource" permissions of required that granted does not cover.
+// Granted rules restricted to resource names never cover a required permission.
+func missingPermissions(granted, required []rbacv1.PolicyRule) []string {
+	var missing []string
+	for _, rule := range required {
+		for _, group := range rule.APIGroups {
+			for _, resource := range rule.Resources {
+				for _, verb := range rule.Verbs {
+					allowed := false
+					for _, grant := range granted {
+						if len(grant.ResourceNames) == 0 &&
+							covers(grant.APIGroups, group) && covers(grant.Resources, resource) && covers(grant.Verbs, verb) {
+							allowed = true
+							break
+						}
+					}
+					if !allowed {
+						missing = append(missing, fmt.Sprintf("%s %s", verb, schema.GroupResource{Group: group, Resource: resource}))
+					}
+				}
+			}
+		}
+	}
+	return missing
+}
+
 // needsRoleRefRecreate checks if the RoleRef has changed, which requires
 // deleting and recreating the RoleBinding since RoleRef is immutable.
 func needsRoleRefRecreate(existing *rbacv1.RoleBinding, clusterRoleName string) bool {
@@ -64,7 +235,8 @@ func needsSubjectUpdate(existing *rbacv1.RoleBinding, serviceAccountName, target
 //   - ctx: context
 //   - targetNamespace: namespace to create RBAC resources in
 //   - serviceAccountName: name of the ServiceAccount to create
-//   - clusterRoleName: name of the ClusterRole to bind to (must exist)
+//   - clusterRoleName: name of the ClusterRole to bind to (must exist), or of the roles aggregated
+//     for the namespace if registered with AggregateClusterRole
 func (m *Manager) EnsureServiceAccountWithRBAC(
 	ctx context.Context,
 	targetNamespace string,
@@ -84,12 +256,24 @@ func (m *Manager) EnsureServiceAccountWithRBAC(
 	}
 
 	// Verify ClusterRole exists before creating RoleBinding
+	requiredRules := m.requiredRules[clusterRoleName]
 	clusterRole := &rbacv1.ClusterRole{}
-	if err := m.client.Get(ctx, client.ObjectKey{Name: clusterRoleName}, clusterRole); err != nil {
+	if label, aggregated := m.aggregationLabels[clusterRoleName]; aggregated {
+		var err error
+		if clusterRole, err = m.ensureAggregatedClusterRole(ctx, targetNamespace, clusterRoleName, label); err != nil {
+			return err
+		}
+		clusterRoleName = clusterRole.Name
+	} else if err := m.client.Get(ctx, client.ObjectKey{Name: clusterRoleName}, clusterRole); err != nil {
 		if apierrors.IsNotFound(err) {
-			return fmt.Errorf("cluster role %q does not exist: ensure it is created by Helm before deploying components", clusterRoleName)
+			return fmt.Errorf("%w: cluster role %q does not exist: ensure it is created by Helm before deploying components, check the operator Helm values",
+				ErrClusterRoleNotFound, clusterRoleName)
 		}
-		return fmt.Errorf("failed to verify cluster role %q: %w", clusterRoleName, err)
+		return wrapError(err, "failed to verify cluster role %q", clusterRoleName)
+	}
+	if missing := missingPermissions(clusterRole.Rules, requiredRules); len(missing) > 0 {
+		return fmt.Errorf("%w: cluster role %q does not grant %s: update the ClusterRole or the operator Helm values",
+			ErrClusterRoleInsufficient, clusterRoleName, strings.Join(missing, ", "))
 	}
 	logger.V(1).Info("ClusterRole verified",
 		"clusterRole", clusterRoleName,
@@ -110,12 +294,13 @@ func (m *Manager) EnsureServiceAccountWithRBAC(
 
 	if err := m.client.Get(ctx, client.ObjectKeyFromObject(sa), sa); err != nil {
 		if !apierrors.IsNotFound(err) {
-			return fmt.Errorf("failed to get service account: %w", err)
+			return wrapError(err, "failed to get service account")
 		}
 		// ServiceAccount doesn't exist, create it
 		if err := m.client.Create(ctx, sa); err != nil {
-			return fmt.Errorf("failed to create service account: %w", err)
+			return wrapError(err, "failed to create service account")
 		}
+		metrics.RBACServiceAccountsCreatedTotal.WithLabelValues(targetNamespace).Inc()
 		logger.V(1).Info("ServiceAccount created",
 			"serviceAccount", serviceAccountName,
 			"namespace", targetNamespace)
@@ -149,15 +334,31 @@ func (m *Manager) EnsureServiceAccountWithRBAC(
 		},
 	}
 
+	if err := m.ensureRoleBinding(ctx, rb); err != nil {
+		metrics.RBACRoleBindingOperationsTotal.WithLabelValues(targetNamespace, metrics.OperationFailed).Inc()
+		return err
+	}
+	return nil
+}
+
+// ensureRoleBinding creates the RoleBinding or brings an existing one in line with it
+func (m *Manager) ensureRoleBinding(ctx context.Context, rb *rbacv1.RoleBinding) error {
+	logger := log.FromContext(ctx)
+	roleBindingName := rb.Name
+	targetNamespace := rb.Namespace
+	serviceAccountName := rb.Subjects[0].Name
+	clusterRoleName := rb.RoleRef.Name
+
 	existingRB := &rbacv1.RoleBinding{}
 	if err := m.client.Get(ctx, client.ObjectKeyFromObject(rb), existingRB); err != nil {
 		if !apierrors.IsNotFound(err) {
-			return fmt.Errorf("failed to get role binding: %w", err)
+			return wrapError(err, "failed to get role binding")
 		}
 		// RoleBinding doesn't exist, create it
 		if err := m.client.Create(ctx, rb); err != nil {
-			return fmt.Errorf("failed to create role binding: %w", err)
+			return wrapError(err, "failed to create role binding")
 		}
+		metrics.RBACRoleBindingOperationsTotal.WithLabelValues(targetNamespace, metrics.OperationCreated).Inc()
 		logger.V(1).Info("RoleBinding created",
 			"roleBinding", roleBindingName,
 			"clusterRole", clusterRoleName,
@@ -170,7 +371,7 @@ func (m *Manager) EnsureServiceAccountWithRBAC(
 		if needsRecreate {
 			// RoleRef is immutable, so delete and recreate the RoleBinding
 			if err := m.client.Delete(ctx, existingRB); err != nil {
-				return fmt.Errorf("failed to delete role binding for recreation: %w", err)
+				return wrapError(err, "failed to delete role binding for recreation")
 			}
 			logger.V(1).Info("RoleBinding deleted for recreation due to RoleRef change",
 				"roleBinding", roleBindingName,
@@ -180,8 +381,9 @@ func (m *Manager) EnsureServiceAccountWithRBAC(
 
 			// Recreate with new RoleRef
 			if err := m.client.Create(ctx, rb); err != nil {
-				return fmt.Errorf("failed to recreate role binding: %w", err)
+				return wrapError(err, "failed to recreate role binding")
 			}
+			metrics.RBACRoleBindingOperationsTotal.WithLabelValues(targetNamespace, metrics.OperationUpdated).Inc()
 			logger.V(1).Info("RoleBinding recreated",
 				"roleBinding", roleBindingName,
 				"clusterRole", clusterRoleName,
@@ -190,8 +392,9 @@ func (m *Manager) EnsureServiceAccountWithRBAC(
 			// Only Subjects changed, can update in-place
 			existingRB.Subjects = rb.Subjects
 			if err := m.client.Update(ctx, existingRB); err != nil {
-				return fmt.Errorf("failed to update role binding: %w", err)
+				return wrapError(err, "failed to update role binding")
 			}
+			metrics.RBACRoleBindingOperationsTotal.WithLabelValues(targetNamespace, metrics.OperationUpdated).Inc()
 			logger.V(1).Info("RoleBinding subjects updated",
 				"roleBinding", roleBindingName,
 				"namespace", targetNamespace)
@@ -7,8 +7,12 @@ package rbac
 
 import (
 	"context"
+	"errors"
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
 
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
@@ -17,6 +21,9 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/metrics"
 )
 
 const (
@@ -512,6 +519,9 @@ func TestEnsureServiceAccountWithRBAC_ClusterRoleNotFound(t *testing.T) {
 	if !strings.Contains(err.Error(), expectedMsg) {
 		t.Errorf("Expected error message to contain %q, got: %v", expectedMsg, err)
 	}
+	if !errors.Is(err, ErrClusterRoleNotFound) {
+		t.Errorf("Expected ErrClusterRoleNotFound, got: %v", err)
+	}
 
 	// Verify no ServiceAccount or RoleBinding was created
 	sa := &corev1.ServiceAccount{}
@@ -980,3 +990,215 @@ func TestEnsureServiceAccountWithRBAC_RoleRefKindChange(t *testing.T) {
 		t.Errorf("Expected RoleRef name test-cluster-role, got %s", rb.RoleRef.Name)
 	}
 }
+
+func TestEnsureServiceAccountWithRBAC_Forbidden(t *testing.T) {
+	// Setup - the operator may read the ClusterRole but not create RoleBindings
+	clusterRole := &rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: testClusterRoleName}}
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	_ = rbacv1.AddToScheme(scheme)
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(clusterRole).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+				if _, ok := obj.(*rbacv1.RoleBinding); ok {
+					return apierrors.NewForbidden(rbacv1.Resource("rolebindings"), obj.GetName(), errors.New("not allowed"))
+				}
+				return c.Create(ctx, obj, opts...)
+			},
+		}).
+		Build()
+	manager := NewManager(fakeClient)
+	namespace := "forbidden-namespace"
+	failedBefore := testutil.ToFloat64(metrics.RBACRoleBindingOperationsTotal.WithLabelValues(namespace, metrics.OperationFailed))
+
+	// Execute
+	err := manager.EnsureServiceAccountWithRBAC(context.Background(), namespace, testServiceAccountName, testClusterRoleName)
+
+	// Verify
+	if !errors.Is(err, ErrForbidden) {
+		t.Fatalf("Expected ErrForbidden, got: %v", err)
+	}
+	if !apierrors.IsForbidden(err) {
+		t.Errorf("Expected the API error to be preserved, got: %v", err)
+	}
+	if got := testutil.ToFloat64(metrics.RBACRoleBindingOperationsTotal.WithLabelValues(namespace, metrics.OperationFailed)); got != failedBefore+1 {
+		t.Errorf("Expected failed RoleBinding counter to be %v, got %v", failedBefore+1, got)
+	}
+}
+
+func TestEnsureServiceAccountWithRBAC_Metrics(t *testing.T) {
+	// Setup
+	fakeClient := setupTestWithClusterRole(testClusterRoleName)
+	manager := NewManager(fakeClient)
+	ctx := context.Background()
+	namespace := "metrics-namespace"
+
+	// Execute - create, then change the ClusterRole to force an update
+	if err := manager.EnsureServiceAccountWithRBAC(ctx, namespace, testServiceAccountName, testClusterRoleName); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if err := fakeClient.Create(ctx, &rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "other-cluster-role"}}); err != nil {
+		t.Fatalf("Failed to create ClusterRole: %v", err)
+	}
+	if err := manager.EnsureServiceAccountWithRBAC(ctx, namespace, testServiceAccountName, "other-cluster-role"); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	// Verify
+	if got := testutil.ToFloat64(metrics.RBACServiceAccountsCreatedTotal.WithLabelValues(namespace)); got != 1 {
+		t.Errorf("Expected 1 ServiceAccount created, got %v", got)
+	}
+	if got := testutil.ToFloat64(metrics.RBACRoleBindingOperationsTotal.WithLabelValues(namespace, metrics.OperationCreated)); got != 1 {
+		t.Errorf("Expected 1 RoleBinding created, got %v", got)
+	}
+	if got := testutil.ToFloat64(metrics.RBACRoleBindingOperationsTotal.WithLabelValues(namespace, metrics.OperationUpdated)); got != 1 {
+		t.Errorf("Expected 1 RoleBinding updated, got %v", got)
+	}
+}
+
+func TestEnsureServiceAccountWithRBAC_RequiredRules(t *testing.T) {
+	// Setup - the test ClusterRole grants no access to configmaps
+	fakeClient := setupTestWithClusterRole(testClusterRoleName)
+	manager := NewManager(fakeClient)
+	manager.RequireRules(testClusterRoleName, []rbacv1.PolicyRule{
+		{APIGroups: []string{"nvidia.com"}, Resources: []string{"dynamographdeployments"}, Verbs: []string{"get", "create"}},
+		{APIGroups: []string{""}, Resources: []string{"configmaps"}, Verbs: []string{"get"}},
+	})
+	ctx := context.Background()
+
+	// Execute
+	err := manager.EnsureServiceAccountWithRBAC(ctx, testNamespace, testServiceAccountName, testClusterRoleName)
+
+	// Verify
+	if !errors.Is(err, ErrClusterRoleInsufficient) {
+		t.Fatalf("Expected ErrClusterRoleInsufficient, got: %v", err)
+	}
+	if !strings.Contains(err.Error(), "get configmaps") {
+		t.Errorf("Expected error to name the missing permission, got: %v", err)
+	}
+	rb := &rbacv1.RoleBinding{}
+	if err := fakeClient.Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: testRoleBindingName}, rb); !apierrors.IsNotFound(err) {
+		t.Error("Expected RoleBinding not to be created for an insufficient ClusterRole")
+	}
+
+	// ClusterRoles without registered rules are only checked for existence
+	if err := manager.EnsureServiceAccountWithRBAC(ctx, testNamespace, testServiceAccountName, testClusterRoleName+"-unchecked"); !errors.Is(err, ErrClusterRoleNotFound) {
+		t.Errorf("Expected ErrClusterRoleNotFound, got: %v", err)
+	}
+}
+
+func TestMissingPermissions(t *testing.T) {
+	required := []rbacv1.PolicyRule{
+		{APIGroups: []string{""}, Resources: []string{"configmaps", "pods"}, Verbs: []string{"get", "create"}},
+	}
+	tests := []struct {
+		name    string
+		granted []rbacv1.PolicyRule
+		want    []string
+	}{
+		{
+			name:    "exact grant",
+			granted: []rbacv1.PolicyRule{{APIGroups: []string{""}, Resources: []string{"configmaps", "pods"}, Verbs: []string{"get", "create"}}},
+		},
+		{
+			name:    "wildcards",
+			granted: []rbacv1.PolicyRule{{APIGroups: []string{"*"}, Resources: []string{"*"}, Verbs: []string{"*"}}},
+		},
+		{
+			name: "split across rules",
+			granted: []rbacv1.PolicyRule{
+				{APIGroups: []string{""}, Resources: []string{"configmaps"}, Verbs: []string{"*"}},
+				{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"get", "create", "delete"}},
+			},
+		},
+		{
+			name:    "missing verb",
+			granted: []rbacv1.PolicyRule{{APIGroups: []string{""}, Resources: []string{"configmaps", "pods"}, Verbs: []string{"get"}}},
+			want:    []string{"create configmaps", "create pods"},
+		},
+		{
+			name:    "restricted to resource names",
+			granted: []rbacv1.PolicyRule{{APIGroups: []string{""}, Resources: []string{"*"}, Verbs: []string{"*"}, ResourceNames: []string{"only-this"}}},
+			want:    []string{"get configmaps", "create configmaps", "get pods", "create pods"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := missingPermissions(tt.granted, required)
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("missingPermissions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEnsureServiceAccountWithRBAC_AggregatedClusterRole(t *testing.T) {
+	// Setup - the interceptor stands in for the aggregation controller, which fake clients lack
+	const label = "nvidia.com/aggregate-to-test"
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	_ = rbacv1.AddToScheme(scheme)
+	aggregated := []rbacv1.PolicyRule{{APIGroups: []string{""}, Resources: []string{"configmaps"}, Verbs: []string{"get"}}}
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+				if role, ok := obj.(*rbacv1.ClusterRole); ok && role.AggregationRule != nil {
+					role.Rules = aggregated
+				}
+				return c.Create(ctx, obj, opts...)
+			},
+		}).
+		Build()
+	manager := NewManager(fakeClient)
+	manager.RequireRules(testClusterRoleName, aggregated)
+	manager.AggregateClusterRole(testClusterRoleName, label)
+	ctx := context.Background()
+
+	// Execute
+	if err := manager.EnsureServiceAccountWithRBAC(ctx, testNamespace, testServiceAccountName, testClusterRoleName); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	// Verify
+	name := AggregatedClusterRoleName(testClusterRoleName, testNamespace)
+	role := &rbacv1.ClusterRole{}
+	if err := fakeClient.Get(ctx, client.ObjectKey{Name: name}, role); err != nil {
+		t.Fatalf("Expected aggregated ClusterRole to be created: %v", err)
+	}
+	selectors := role.AggregationRule.ClusterRoleSelectors
+	if len(selectors) != 2 || selectors[0].MatchLabels[label] != "true" || selectors[1].MatchLabels[label] != testNamespace {
+		t.Errorf("Expected base and namespace selectors, got %v", selectors)
+	}
+	rb := &rbacv1.RoleBinding{}
+	if err := fakeClient.Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: testRoleBindingName}, rb); err != nil {
+		t.Fatalf("Expected RoleBinding to be created: %v", err)
+	}
+	if rb.RoleRef.Name != name {
+		t.Errorf("Expected RoleBinding to reference %s, got %s", name, rb.RoleRef.Name)
+	}
+}
+
+func TestEnsureServiceAccountWithRBAC_AggregatedClusterRoleEmpty(t *testing.T) {
+	// Setup - no ClusterRole carries the aggregation label, so no rules are aggregated
+	defer func(timeout time.Duration) { aggregationTimeout = timeout }(aggregationTimeout)
+	aggregationTimeout = 100 * time.Millisecond
+	fakeClient, _ := setupTest()
+	manager := NewManager(fakeClient)
+	manager.AggregateClusterRole(testClusterRoleName, "nvidia.com/aggregate-to-test")
+	ctx := context.Background()
+
+	// Execute
+	err := manager.EnsureServiceAccountWithRBAC(ctx, testNamespace, testServiceAccountName, testClusterRoleName)
+
+	// Verify
+	if !errors.Is(err, ErrClusterRoleAggregationEmpty) {
+		t.Fatalf("Expected ErrClusterRoleAggregationEmpty, got: %v", err)
+	}
+	rb := &rbacv1.RoleBinding{}
+	if err := fakeClient.Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: testRoleBindingName}, rb); !apierrors.IsNotFound(err) {
+		t.Error("Expected RoleBinding not to be created for an empty aggregated ClusterRole")
+	}
+}
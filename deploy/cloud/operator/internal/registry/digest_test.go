@@ -0,0 +1,140 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package registry
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseReference(t *testing.T) {
+	tests := []struct {
+		image string
+		want  Reference
+	}{
+		{"ubuntu", Reference{Registry: "docker.io", Repository: "library/ubuntu", Tag: "latest"}},
+		{"bitnami/kubectl:1.30", Reference{Registry: "docker.io", Repository: "bitnami/kubectl", Tag: "1.30"}},
+		{"nvcr.io/nvidia/ai-dynamo/vllm-runtime:0.6.1", Reference{Registry: "nvcr.io", Repository: "nvidia/ai-dynamo/vllm-runtime", Tag: "0.6.1"}},
+		{"localhost:5000/profiler", Reference{Registry: "localhost:5000", Repository: "profiler", Tag: "latest"}},
+		{"nvcr.io/nvidia/dynamo:1.0@sha256:abc", Reference{Registry: "nvcr.io", Repository: "nvidia/dynamo", Tag: "1.0", Digest: "sha256:abc"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.image, func(t *testing.T) {
+			got, err := ParseReference(tt.image)
+			if err != nil {
+				t.Fatalf("ParseReference() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseReference() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCredentialsFromDockerConfig(t *testing.T) {
+	auth := base64.StdEncoding.EncodeToString([]byte("user:secret"))
+	config := []byte(fmt.Sprintf(`{"auths":{"https://index.docker.io/v1/":{"auth":%q},"nvcr.io":{"username":"$oauthtoken","password":"key"}}}`, auth))
+
+	creds, err := CredentialsFromDockerConfig(config, "docker.io")
+	if err != nil || creds == nil || creds.Username != "user" || creds.Password != "secret" {
+		t.Errorf("docker.io credentials = %+v, %v", creds, err)
+	}
+	creds, err = CredentialsFromDockerConfig(config, "nvcr.io")
+	if err != nil || creds == nil || creds.Username != "$oauthtoken" || creds.Password != "key" {
+		t.Errorf("nvcr.io credentials = %+v, %v", creds, err)
+	}
+	creds, err = CredentialsFromDockerConfig(config, "ghcr.io")
+	if err != nil || creds != nil {
+		t.Errorf("ghcr.io credentials = %+v, %v", creds, err)
+	}
+}
+
+func TestParseChallenge(t *testing.T) {
+	scheme, params := parseChallenge(`Bearer realm="https://auth.example.com/token",service="registry",scope="repository:a:pull,push"`)
+	if scheme != "Bearer" {
+		t.Errorf("scheme = %q", scheme)
+	}
+	want := map[string]string{"realm": "https://auth.example.com/token", "service": "registry", "scope": "repository:a:pull,push"}
+	if !reflect.DeepEqual(params, want) {
+		t.Errorf("params = %v, want %v", params, want)
+	}
+}
+
+func TestResolveDigest(t *testing.T) {
+	const digest = "sha256:0123456789abcdef"
+	var server *httptest.Server
+	server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch {
+		case req.URL.Path == "/token":
+			username, password, ok := req.BasicAuth()
+			if !ok || username != "user" || password != "secret" || req.URL.Query().Get("scope") != "repository:team/model:pull" {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			_, _ = w.Write([]byte(`{"token":"pull-token"}`))
+		case req.URL.Path == "/v2/team/model/manifests/v1":
+			if req.Header.Get("Authorization") != "Bearer pull-token" {
+				w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="test"`, server.URL))
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			if !strings.Contains(req.Header.Get("Accept"), "application/vnd.oci.image.index.v1+json") {
+				w.WriteHeader(http.StatusNotAcceptable)
+				return
+			}
+			w.Header().Set("Docker-Content-Digest", digest)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	host := strings.TrimPrefix(server.URL, "https://")
+	auth := base64.StdEncoding.EncodeToString([]byte("user:secret"))
+	dockerConfigs := [][]byte{
+		[]byte(`{"auths":{"other.example.com":{"auth":"eDp5"}}}`),
+		[]byte(fmt.Sprintf(`{"auths":{%q:{"auth":%q}}}`, host, auth)),
+	}
+	resolver := &Resolver{Client: server.Client()}
+
+	got, err := resolver.ResolveDigest(context.Background(), host+"/team/model:v1", dockerConfigs)
+	if err != nil {
+		t.Fatalf("ResolveDigest() error = %v", err)
+	}
+	if got != digest {
+		t.Errorf("ResolveDigest() = %q, want %q", got, digest)
+	}
+
+	if _, err := resolver.ResolveDigest(context.Background(), host+"/team/model:v1", nil); err == nil {
+		t.Error("ResolveDigest() without credentials should fail")
+	}
+	if _, err := resolver.ResolveDigest(context.Background(), host+"/team/missing:v1", dockerConfigs); err == nil {
+		t.Error("ResolveDigest() of a missing image should fail")
+	}
+
+	pinned, err := resolver.ResolveDigest(context.Background(), host+"/team/model:v1@sha256:pinned", nil)
+	if err != nil || pinned != "sha256:pinned" {
+		t.Errorf("ResolveDigest() of a pinned image = %q, %v", pinned, err)
+	}
+}
@@ -0,0 +1,154 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+const (
+	// maxDocumentSize bounds the manifests and image configs read from a registry
+	maxDocumentSize = 4 << 20
+
+	// labelPlatformOS and labelPlatformArchitecture select the image of a multi-platform index
+	// whose labels are returned
+	labelPlatformOS           = "linux"
+	labelPlatformArchitecture = "amd64"
+)
+
+// manifest holds the fields of image manifests and indexes needed to find the image config
+type manifest struct {
+	Config struct {
+		Digest string `json:"digest"`
+	} `json:"config"`
+	Manifests []struct {
+		Digest   string `json:"digest"`
+		Platform struct {
+			OS           string `json:"os"`
+			Architecture string `json:"architecture"`
+		} `json:"platform"`
+	} `json:"manifests"`
+}
+
+// ImageLabels returns the labels of the image config, i.e. those set with LABEL when the image
+// was built. For multi-platform images the labels of the linux/amd64 image are returned.
+// Credentials are looked up in the given .dockerconfigjson documents.
+func (r *Resolver) ImageLabels(ctx context.Context, image string, dockerConfigs [][]byte) (map[string]string, error) {
+	ref, err := ParseReference(image)
+	if err != nil {
+		return nil, err
+	}
+	creds, err := credentialsFor(ref, dockerConfigs)
+	if err != nil {
+		return nil, err
+	}
+	session := &registrySession{resolver: r, ref: ref, creds: creds}
+
+	reference := ref.Digest
+	if reference == "" {
+		reference = ref.Tag
+	}
+	current := manifest{}
+	if err := session.getJSON(ctx, "manifests/"+reference, strings.Join(manifestMediaTypes, ", "), &current); err != nil {
+		return nil, fmt.Errorf("failed to get the manifest of %s: %w", image, err)
+	}
+	if len(current.Manifests) > 0 {
+		digest := current.Manifests[0].Digest
+		for _, platform := range current.Manifests {
+			if platform.Platform.OS == labelPlatformOS && platform.Platform.Architecture == labelPlatformArchitecture {
+				digest = platform.Digest
+				break
+			}
+		}
+		current = manifest{}
+		if err := session.getJSON(ctx, "manifests/"+digest, strings.Join(manifestMediaTypes, ", "), &current); err != nil {
+			return nil, fmt.Errorf("failed to get the platform manifest of %s: %w", image, err)
+		}
+	}
+	if current.Config.Digest == "" {
+		return nil, fmt.Errorf("the manifest of %s references no image config", image)
+	}
+
+	config := struct {
+		Config struct {
+			Labels map[string]string `json:"Labels"`
+		} `json:"config"`
+	}{}
+	if err := session.getJSON(ctx, "blobs/"+current.Config.Digest, "", &config); err != nil {
+		return nil, fmt.Errorf("failed to get the image config of %s: %w", image, err)
+	}
+	if config.Config.Labels == nil {
+		return map[string]string{}, nil
+	}
+	return config.Config.Labels, nil
+}
+
+// registrySession sends requests for one repository, authenticating on the first challenge
+type registrySession struct {
+	resolver      *Resolver
+	ref           Reference
+	creds         *Credentials
+	authorization string
+}
+
+// getJSON gets a document of the repository, e.g. "manifests/latest", and decodes it into v
+func (s *registrySession) getJSON(ctx context.Context, path, accept string, v interface{}) error {
+	documentURL := fmt.Sprintf("https://%s/v2/%s/%s", s.ref.apiHost(), s.ref.Repository, path)
+	resp, err := s.get(ctx, documentURL, accept)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode == http.StatusUnauthorized && s.authorization == "" {
+		challenge := resp.Header.Get("WWW-Authenticate")
+		_ = resp.Body.Close()
+		if s.authorization, err = s.resolver.authorize(ctx, challenge, s.ref, s.creds); err != nil {
+			return fmt.Errorf("failed to authenticate to %s: %w", s.ref.Registry, err)
+		}
+		if resp, err = s.get(ctx, documentURL, accept); err != nil {
+			return err
+		}
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("registry returned %s", resp.Status)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxDocumentSize))
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(body, v)
+}
+
+func (s *registrySession) get(ctx context.Context, documentURL, accept string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, documentURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+	if s.authorization != "" {
+		req.Header.Set("Authorization", s.authorization)
+	}
+	return s.resolver.Client.Do(req)
+}
@@ -0,0 +1,80 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package registry
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestImageLabels(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/token" {
+			_, _ = w.Write([]byte(`{"token":"pull-token"}`))
+			return
+		}
+		if req.Header.Get("Authorization") != "Bearer pull-token" {
+			w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="test"`, server.URL))
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch req.URL.Path {
+		case "/v2/team/profiler/manifests/v1":
+			_, _ = w.Write([]byte(`{"manifests":[` +
+				`{"digest":"sha256:arm","platform":{"os":"linux","architecture":"arm64"}},` +
+				`{"digest":"sha256:amd","platform":{"os":"linux","architecture":"amd64"}}]}`))
+		case "/v2/team/profiler/manifests/sha256:amd":
+			_, _ = w.Write([]byte(`{"config":{"digest":"sha256:config"}}`))
+		case "/v2/team/profiler/blobs/sha256:config":
+			_, _ = w.Write([]byte(`{"architecture":"amd64","config":{"Labels":{"com.example.version":"1"}}}`))
+		case "/v2/team/unlabeled/manifests/v1":
+			_, _ = w.Write([]byte(`{"config":{"digest":"sha256:empty"}}`))
+		case "/v2/team/unlabeled/blobs/sha256:empty":
+			_, _ = w.Write([]byte(`{"config":{}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	host := strings.TrimPrefix(server.URL, "https://")
+	resolver := &Resolver{Client: server.Client()}
+
+	labels, err := resolver.ImageLabels(context.Background(), host+"/team/profiler:v1", nil)
+	if err != nil {
+		t.Fatalf("ImageLabels() error = %v", err)
+	}
+	if want := map[string]string{"com.example.version": "1"}; !reflect.DeepEqual(labels, want) {
+		t.Errorf("ImageLabels() = %v, want %v", labels, want)
+	}
+
+	labels, err = resolver.ImageLabels(context.Background(), host+"/team/unlabeled:v1", nil)
+	if err != nil || len(labels) != 0 {
+		t.Errorf("ImageLabels() of an unlabeled image = %v, %v", labels, err)
+	}
+
+	if _, err := resolver.ImageLabels(context.Background(), host+"/team/missing:v1", nil); err == nil {
+		t.Error("ImageLabels() of a missing image should fail")
+	}
+}
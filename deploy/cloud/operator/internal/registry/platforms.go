@@ -0,0 +1,76 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package registry
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// ImageArchitectures returns the CPU architectures the linux image runs on, e.g. amd64 and arm64:
+// the platforms of a multi-platform index, or the architecture of the image config of a
+// single-platform image. Credentials are looked up in the given .dockerconfigjson documents.
+func (r *Resolver) ImageArchitectures(ctx context.Context, image string, dockerConfigs [][]byte) ([]string, error) {
+	ref, err := ParseReference(image)
+	if err != nil {
+		return nil, err
+	}
+	creds, err := credentialsFor(ref, dockerConfigs)
+	if err != nil {
+		return nil, err
+	}
+	session := &registrySession{resolver: r, ref: ref, creds: creds}
+
+	reference := ref.Digest
+	if reference == "" {
+		reference = ref.Tag
+	}
+	current := manifest{}
+	if err := session.getJSON(ctx, "manifests/"+reference, strings.Join(manifestMediaTypes, ", "), &current); err != nil {
+		return nil, fmt.Errorf("failed to get the manifest of %s: %w", image, err)
+	}
+
+	architectures := []string{}
+	if len(current.Manifests) > 0 {
+		for _, platform := range current.Manifests {
+			// Attestation manifests are listed with the unknown platform
+			if platform.Platform.OS == labelPlatformOS && !slices.Contains(architectures, platform.Platform.Architecture) {
+				architectures = append(architectures, platform.Platform.Architecture)
+			}
+		}
+		slices.Sort(architectures)
+		return architectures, nil
+	}
+	if current.Config.Digest == "" {
+		return nil, fmt.Errorf("the manifest of %s references no image config", image)
+	}
+
+	config := struct {
+		OS           string `json:"os"`
+		Architecture string `json:"architecture"`
+	}{}
+	if err := session.getJSON(ctx, "blobs/"+current.Config.Digest, "", &config); err != nil {
+		return nil, fmt.Errorf("failed to get the image config of %s: %w", image, err)
+	}
+	if config.Architecture != "" && (config.OS == "" || config.OS == labelPlatformOS) {
+		architectures = append(architectures, config.Architecture)
+	}
+	return architectures, nil
+}
@@ -0,0 +1,70 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package registry
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestImageArchitectures(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/v2/team/runtime/manifests/v1":
+			_, _ = w.Write([]byte(`{"manifests":[` +
+				`{"digest":"sha256:arm","platform":{"os":"linux","architecture":"arm64"}},` +
+				`{"digest":"sha256:amd","platform":{"os":"linux","architecture":"amd64"}},` +
+				`{"digest":"sha256:att","platform":{"os":"unknown","architecture":"unknown"}}]}`))
+		case "/v2/team/profiler/manifests/v1":
+			_, _ = w.Write([]byte(`{"config":{"digest":"sha256:config"}}`))
+		case "/v2/team/profiler/blobs/sha256:config":
+			_, _ = w.Write([]byte(`{"os":"linux","architecture":"amd64","config":{}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	host := strings.TrimPrefix(server.URL, "https://")
+	resolver := &Resolver{Client: server.Client()}
+
+	tests := []struct {
+		image string
+		want  []string
+	}{
+		{image: host + "/team/runtime:v1", want: []string{"amd64", "arm64"}},
+		{image: host + "/team/profiler:v1", want: []string{"amd64"}},
+	}
+	for _, tt := range tests {
+		got, err := resolver.ImageArchitectures(context.Background(), tt.image, nil)
+		if err != nil {
+			t.Fatalf("ImageArchitectures(%s) error = %v", tt.image, err)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ImageArchitectures(%s) = %v, want %v", tt.image, got, tt.want)
+		}
+	}
+
+	if _, err := resolver.ImageArchitectures(context.Background(), host+"/team/missing:v1", nil); err == nil {
+		t.Error("ImageArchitectures() of a missing image should fail")
+	}
+}
@@ -0,0 +1,314 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package v1alpha1 contains admission webhooks for the nvidia.com v1alpha1 API group.
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/audit"
+	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/controller"
+)
+
+// SetupDynamoGraphDeploymentRequestWebhookWithManager registers the DGDR validating webhook with the manager.
+// A nil imageAllowlist allows any image, a nil auditSink records no admissions. Admissions are
+// written to auditSink in the background, so that a slow sink does not delay the API server.
+func SetupDynamoGraphDeploymentRequestWebhookWithManager(mgr ctrl.Manager, imageAllowlist *controller.ImageAllowlist, auditSink audit.Sink) error {
+	if auditSink != nil {
+		asyncSink := audit.NewAsyncSink(auditSink, audit.DefaultQueueSize)
+		if err := mgr.Add(asyncSink); err != nil {
+			return err
+		}
+		auditSink = asyncSink
+	}
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&nvidiacomv1alpha1.DynamoGraphDeploymentRequest{}).
+		WithValidator(&DynamoGraphDeploymentRequestCustomValidator{Client: mgr.GetClient(), ImageAllowlist: imageAllowlist, AuditSink: auditSink}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-nvidia-com-v1alpha1-dynamographdeploymentrequest,mutating=false,failurePolicy=fail,sideEffects=None,groups=nvidia.com,resources=dynamographdeploymentrequests,verbs=create;update,versions=v1alpha1,name=vdynamographdeploymentrequest-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// DynamoGraphDeploymentRequestCustomValidator validates DGDRs at admission time.
+// It checks that the names the controller will derive from the DGDR are valid and
+// do not collide with existing objects that belong to something else, that its service
+// overrides are well-formed, and that the images it references are allowed in its namespace.
+// Soft issues, such as an SLA that looks too tight for the model, are returned as warnings.
+// Admitted creates and updates are recorded with the requesting user in the audit sink.
+type DynamoGraphDeploymentRequestCustomValidator struct {
+	Client         client.Reader
+	ImageAllowlist *controller.ImageAllowlist
+	AuditSink      audit.Sink
+}
+
+var _ admission.CustomValidator = &DynamoGraphDeploymentRequestCustomValidator{}
+
+// ValidateCreate implements admission.CustomValidator
+func (v *DynamoGraphDeploymentRequestCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	dgdr, ok := obj.(*nvidiacomv1alpha1.DynamoGraphDeploymentRequest)
+	if !ok {
+		return nil, fmt.Errorf("expected a DynamoGraphDeploymentRequest but got %T", obj)
+	}
+	if err := v.validate(ctx, dgdr); err != nil {
+		return warnings(dgdr), err
+	}
+	v.audit(ctx, dgdr, nil)
+	return warnings(dgdr), nil
+}
+
+// ValidateUpdate implements admission.CustomValidator
+func (v *DynamoGraphDeploymentRequestCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	dgdr, ok := newObj.(*nvidiacomv1alpha1.DynamoGraphDeploymentRequest)
+	if !ok {
+		return nil, fmt.Errorf("expected a DynamoGraphDeploymentRequest but got %T", newObj)
+	}
+	if err := v.validate(ctx, dgdr); err != nil {
+		return warnings(dgdr), err
+	}
+	if old, ok := oldObj.(*nvidiacomv1alpha1.DynamoGraphDeploymentRequest); ok {
+		// Status and metadata updates leave the spec, and the audit log, alone
+		if !equality.Semantic.DeepEqual(old.Spec, dgdr.Spec) {
+			v.audit(ctx, dgdr, old)
+		}
+		// The controller cannot tell who approved a generated spec, so the approval is audited here
+		if controller.IsApprovalGranted(dgdr, old) {
+			v.writeAuditRecord(ctx, controller.NewApprovalAuditRecord(dgdr))
+		}
+	}
+	return warnings(dgdr), nil
+}
+
+// audit records an admitted create, or an update from old, with the requesting user. Dry runs are
+// not recorded, and failures are logged without rejecting the request.
+func (v *DynamoGraphDeploymentRequestCustomValidator) audit(ctx context.Context, dgdr, old *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) {
+	if v.AuditSink == nil {
+		return
+	}
+	record, err := controller.NewRequestAuditRecord(dgdr, old)
+	if err != nil {
+		log.FromContext(ctx).Error(err, "Failed to build audit record", "action", record.Action)
+		return
+	}
+	v.writeAuditRecord(ctx, record)
+}
+
+// writeAuditRecord writes the record with the requesting user. Dry runs are not recorded, and
+// failures are logged without rejecting the request.
+func (v *DynamoGraphDeploymentRequestCustomValidator) writeAuditRecord(ctx context.Context, record audit.Record) {
+	if v.AuditSink == nil {
+		return
+	}
+	logger := log.FromContext(ctx)
+	req, err := admission.RequestFromContext(ctx)
+	if err != nil {
+		logger.Error(err, "Failed to read admission request for the audit record")
+		return
+	}
+	if req.DryRun != nil && *req.DryRun {
+		return
+	}
+	record.User = &audit.User{Username: req.UserInfo.Username, UID: req.UserInfo.UID, Groups: req.UserInfo.Groups}
+	if err := v.AuditSink.Write(ctx, record); err != nil {
+		logger.Error(err, "Failed to write audit record", "action", record.Action)
+	}
+}
+
+// ValidateDelete implements admission.CustomValidator
+func (v *DynamoGraphDeploymentRequestCustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *DynamoGraphDeploymentRequestCustomValidator) validate(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	allErrs := v.validateDerivedNames(ctx, dgdr)
+	allErrs = append(allErrs, controller.ValidateServiceOverrides(dgdr)...)
+	allErrs = append(allErrs, controller.ValidateDeploymentPatches(dgdr)...)
+	allErrs = append(allErrs, controller.ValidateSecrets(dgdr)...)
+	allErrs = append(allErrs, v.validateImages(ctx, dgdr)...)
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(
+		nvidiacomv1alpha1.GroupVersion.WithKind("DynamoGraphDeploymentRequest").GroupKind(),
+		dgdr.Name,
+		allErrs,
+	)
+}
+
+// warnings returns the soft issues of the DGDR, reported at admission without rejecting it
+func warnings(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) admission.Warnings {
+	return append(credentialWarnings(dgdr), controller.SoftValidationWarnings(dgdr)...)
+}
+
+// credentialWarnings warns about inline profiling config keys that look like credentials. They are
+// redacted from events and status, but remain readable by anyone who can read the DGDR.
+func credentialWarnings(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) admission.Warnings {
+	var warnings admission.Warnings
+	for _, path := range controller.CredentialConfigPaths(dgdr) {
+		warnings = append(warnings, fmt.Sprintf(
+			"spec.profilingConfig.config.%s looks like a credential; store it in a Secret and reference it with spec.profilingConfig.secretRef", path))
+	}
+	return warnings
+}
+
+// validateImages checks the images referenced by the DGDR spec against the image allowlist.
+// Images of the generated deployment are only known after profiling and are checked by the controller.
+func (v *DynamoGraphDeploymentRequestCustomValidator) validateImages(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) field.ErrorList {
+	if v.ImageAllowlist == nil {
+		return nil
+	}
+	specPath := field.NewPath("spec")
+	images, err := controller.DGDRSpecImages(dgdr)
+	if err != nil {
+		return field.ErrorList{field.Invalid(specPath.Child("precomputedDeployment", "deployment"), "", err.Error())}
+	}
+	if err := v.ImageAllowlist.CheckImages(ctx, v.Client, dgdr.Namespace, images); err != nil {
+		return field.ErrorList{field.Forbidden(specPath, err.Error())}
+	}
+	return nil
+}
+
+// validateDerivedNames checks the profiling Job, output ConfigMap and override DGD names
+// for DNS-1123 validity and for collisions with objects not owned by this DGDR.
+func (v *DynamoGraphDeploymentRequestCustomValidator) validateDerivedNames(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) field.ErrorList {
+	var allErrs field.ErrorList
+	namePath := field.NewPath("metadata", "name")
+
+	// Job names end up in the job-name pod label, so they must be valid label values (DNS-1123 label)
+	jobName := controller.GetProfilingJobName(dgdr)
+	if errs := validation.IsDNS1123Label(jobName); len(errs) > 0 {
+		allErrs = append(allErrs, field.Invalid(namePath, dgdr.Name,
+			fmt.Sprintf("derived profiling job name %q is invalid: %v", jobName, errs)))
+	} else if err := v.checkCollision(ctx, &batchv1.Job{}, "Job", dgdr.Namespace, jobName, dgdr, isOwnedByDGDR); err != nil {
+		allErrs = append(allErrs, field.Invalid(namePath, dgdr.Name, err.Error()))
+	}
+
+	configMapName := controller.GetOutputConfigMapName(dgdr)
+	if errs := validation.IsDNS1123Subdomain(configMapName); len(errs) > 0 {
+		allErrs = append(allErrs, field.Invalid(namePath, dgdr.Name,
+			fmt.Sprintf("derived output ConfigMap name %q is invalid: %v", configMapName, errs)))
+	} else if err := v.checkCollision(ctx, &corev1.ConfigMap{}, "ConfigMap", dgdr.Namespace, configMapName, dgdr, isOutputOfDGDR); err != nil {
+		allErrs = append(allErrs, field.Invalid(namePath, dgdr.Name, err.Error()))
+	}
+
+	if overrides := dgdr.Spec.DeploymentOverrides; overrides != nil && overrides.Name != "" {
+		overridesPath := field.NewPath("spec", "deploymentOverrides")
+		dgdNamespace := dgdr.Namespace
+		if overrides.Namespace != "" {
+			dgdNamespace = overrides.Namespace
+			if errs := validation.IsDNS1123Label(overrides.Namespace); len(errs) > 0 {
+				allErrs = append(allErrs, field.Invalid(overridesPath.Child("namespace"), overrides.Namespace, fmt.Sprintf("%v", errs)))
+			}
+		}
+		if errs := validation.IsDNS1123Subdomain(overrides.Name); len(errs) > 0 {
+			allErrs = append(allErrs, field.Invalid(overridesPath.Child("name"), overrides.Name, fmt.Sprintf("%v", errs)))
+		} else if overrides.ApplyToExisting {
+			// applyToExisting targets a DGD the user already runs, so it is expected to exist
+			// without DGDR labels; require it instead of treating it as a collision.
+			if err := v.checkExists(ctx, &nvidiacomv1alpha1.DynamoGraphDeployment{}, "DynamoGraphDeployment", dgdNamespace, overrides.Name); err != nil {
+				allErrs = append(allErrs, field.Invalid(overridesPath.Child("name"), overrides.Name, err.Error()))
+			}
+		} else if err := v.checkCollision(ctx, &nvidiacomv1alpha1.DynamoGraphDeployment{}, "DynamoGraphDeployment", dgdNamespace, overrides.Name, dgdr, isDeploymentOfDGDR); err != nil {
+			allErrs = append(allErrs, field.Invalid(overridesPath.Child("name"), overrides.Name, err.Error()))
+		}
+	}
+
+	return allErrs
+}
+
+// checkCollision returns an error naming the conflicting object if an object with the given
+// name exists and does not belong to the DGDR according to belongs.
+func (v *DynamoGraphDeploymentRequestCustomValidator) checkCollision(
+	ctx context.Context,
+	obj client.Object,
+	kind, namespace, name string,
+	dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest,
+	belongs func(obj metav1.Object, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) bool,
+) error {
+	if err := v.Client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to check for existing %s %s/%s: %w", kind, namespace, name, err)
+	}
+	if belongs(obj, dgdr) {
+		return nil
+	}
+	return fmt.Errorf("%s %s/%s already exists and is not owned by DynamoGraphDeploymentRequest %s/%s",
+		kind, namespace, name, dgdr.Namespace, dgdr.Name)
+}
+
+// checkExists returns an error if no object with the given name exists.
+func (v *DynamoGraphDeploymentRequestCustomValidator) checkExists(ctx context.Context, obj client.Object, kind, namespace, name string) error {
+	if err := v.Client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			return fmt.Errorf("%s %s/%s does not exist, applyToExisting requires an existing deployment", kind, namespace, name)
+		}
+		return fmt.Errorf("failed to check for existing %s %s/%s: %w", kind, namespace, name, err)
+	}
+	return nil
+}
+
+// isOwnedByDGDR reports whether obj has an ownerReference pointing at the DGDR. The UID is
+// compared so objects left behind by a deleted DGDR of the same name are not adopted.
+func isOwnedByDGDR(obj metav1.Object, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) bool {
+	for _, ref := range obj.GetOwnerReferences() {
+		if ref.Kind == "DynamoGraphDeploymentRequest" && ref.Name == dgdr.Name && ref.UID == dgdr.UID {
+			return true
+		}
+	}
+	return false
+}
+
+// isLabeledForDGDR reports whether obj carries the DGDR labels of the DGDR. Objects labeled with a
+// UID must carry the DGDR's UID, objects labeled before the UID was recorded are matched by name.
+func isLabeledForDGDR(obj metav1.Object, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) bool {
+	labels := obj.GetLabels()
+	if labels[controller.LabelDGDRName] != dgdr.Name {
+		return false
+	}
+	uid, exists := labels[controller.LabelDGDRUID]
+	return !exists || uid == string(dgdr.UID)
+}
+
+// isOutputOfDGDR reports whether obj is the output ConfigMap of the DGDR.
+// The output ConfigMap is written by the profiling sidecar, which labels it rather than setting an owner.
+func isOutputOfDGDR(obj metav1.Object, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) bool {
+	return isOwnedByDGDR(obj, dgdr) || isLabeledForDGDR(obj, dgdr)
+}
+
+// isDeploymentOfDGDR reports whether obj is a DGD created by the DGDR.
+// DGDs are tracked by label because they intentionally carry no owner reference.
+func isDeploymentOfDGDR(obj metav1.Object, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) bool {
+	return isLabeledForDGDR(obj, dgdr) && obj.GetLabels()[controller.LabelDGDRNamespace] == dgdr.Namespace
+}
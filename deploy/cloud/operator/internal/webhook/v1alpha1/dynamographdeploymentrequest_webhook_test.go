@@ -0,0 +1,519 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1alpha1
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	dynamoCommon "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/dynamo/common"
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/audit"
+	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/internal/controller"
+)
+
+const testNamespace = "test-namespace"
+
+func newValidator(objs ...client.Object) *DynamoGraphDeploymentRequestCustomValidator {
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	_ = batchv1.AddToScheme(scheme)
+	_ = nvidiacomv1alpha1.AddToScheme(scheme)
+	return &DynamoGraphDeploymentRequestCustomValidator{
+		Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build(),
+	}
+}
+
+func newDGDR(name string) *nvidiacomv1alpha1.DynamoGraphDeploymentRequest {
+	return &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace},
+		Spec: nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{
+			Model:   "test-model",
+			Backend: "vllm",
+		},
+	}
+}
+
+func TestValidateCreate_NoCollisions(t *testing.T) {
+	v := newValidator()
+	if _, err := v.ValidateCreate(context.Background(), newDGDR("my-dgdr")); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func TestValidateCreate_NameTooLongForJob(t *testing.T) {
+	v := newValidator()
+	dgdr := newDGDR(strings.Repeat("a", 60))
+	_, err := v.ValidateCreate(context.Background(), dgdr)
+	if err == nil || !strings.Contains(err.Error(), "derived profiling job name") {
+		t.Fatalf("expected derived job name error, got %v", err)
+	}
+}
+
+func TestValidateCreate_JobCollision(t *testing.T) {
+	dgdr := newDGDR("my-dgdr")
+	existing := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: controller.GetProfilingJobName(dgdr), Namespace: testNamespace},
+	}
+	v := newValidator(existing)
+	_, err := v.ValidateCreate(context.Background(), dgdr)
+	if err == nil {
+		t.Fatal("expected collision error")
+	}
+	if !strings.Contains(err.Error(), "Job "+testNamespace+"/"+existing.Name+" already exists") {
+		t.Errorf("expected error to name the conflicting Job, got %v", err)
+	}
+}
+
+func TestValidateUpdate_OwnedJobIsNotACollision(t *testing.T) {
+	dgdr := newDGDR("my-dgdr")
+	dgdr.UID = "uid-1"
+	existing := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      controller.GetProfilingJobName(dgdr),
+			Namespace: testNamespace,
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: nvidiacomv1alpha1.GroupVersion.String(),
+				Kind:       "DynamoGraphDeploymentRequest",
+				Name:       dgdr.Name,
+				UID:        dgdr.UID,
+			}},
+		},
+	}
+	v := newValidator(existing)
+	if _, err := v.ValidateUpdate(context.Background(), dgdr, dgdr); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func TestValidateUpdate_JobOfDeletedDGDRIsACollision(t *testing.T) {
+	dgdr := newDGDR("my-dgdr")
+	dgdr.UID = "uid-2"
+	existing := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      controller.GetProfilingJobName(dgdr),
+			Namespace: testNamespace,
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: nvidiacomv1alpha1.GroupVersion.String(),
+				Kind:       "DynamoGraphDeploymentRequest",
+				Name:       dgdr.Name,
+				UID:        "uid-1",
+			}},
+		},
+	}
+	v := newValidator(existing)
+	if _, err := v.ValidateUpdate(context.Background(), dgdr, dgdr); err == nil {
+		t.Fatal("expected the Job of the deleted DGDR to collide")
+	}
+}
+
+func TestValidateCreate_LabeledOutputConfigMapIsNotACollision(t *testing.T) {
+	dgdr := newDGDR("my-dgdr")
+	existing := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      controller.GetOutputConfigMapName(dgdr),
+			Namespace: testNamespace,
+			Labels:    map[string]string{controller.LabelDGDRName: dgdr.Name},
+		},
+	}
+	v := newValidator(existing)
+	if _, err := v.ValidateCreate(context.Background(), dgdr); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func TestValidateUpdate_OutputConfigMapOfDeletedDGDRIsACollision(t *testing.T) {
+	dgdr := newDGDR("my-dgdr")
+	dgdr.UID = "uid-2"
+	existing := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      controller.GetOutputConfigMapName(dgdr),
+			Namespace: testNamespace,
+			Labels:    map[string]string{controller.LabelDGDRName: dgdr.Name, controller.LabelDGDRUID: "uid-1"},
+		},
+	}
+	v := newValidator(existing)
+	if _, err := v.ValidateUpdate(context.Background(), dgdr, dgdr); err == nil {
+		t.Fatal("expected the output ConfigMap of the deleted DGDR to collide")
+	}
+
+	existing.Labels[controller.LabelDGDRUID] = string(dgdr.UID)
+	v = newValidator(existing)
+	if _, err := v.ValidateUpdate(context.Background(), dgdr, dgdr); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func TestValidateCreate_OverrideDGDNameCollision(t *testing.T) {
+	dgdr := newDGDR("my-dgdr")
+	dgdr.Spec.DeploymentOverrides = &nvidiacomv1alpha1.DeploymentOverridesSpec{Name: "serving"}
+	existing := &nvidiacomv1alpha1.DynamoGraphDeployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "serving",
+			Namespace: testNamespace,
+			Labels: map[string]string{
+				controller.LabelDGDRName:      "other-dgdr",
+				controller.LabelDGDRNamespace: testNamespace,
+			},
+		},
+	}
+	v := newValidator(existing)
+	_, err := v.ValidateCreate(context.Background(), dgdr)
+	if err == nil || !strings.Contains(err.Error(), "DynamoGraphDeployment "+testNamespace+"/serving already exists") {
+		t.Fatalf("expected DGD collision error, got %v", err)
+	}
+}
+
+func TestValidateCreate_ApplyToExistingUnlabeledDGD(t *testing.T) {
+	dgdr := newDGDR("my-dgdr")
+	dgdr.Spec.AutoApply = true
+	dgdr.Spec.DeploymentOverrides = &nvidiacomv1alpha1.DeploymentOverridesSpec{Name: "serving", ApplyToExisting: true}
+	existing := &nvidiacomv1alpha1.DynamoGraphDeployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "serving", Namespace: testNamespace},
+	}
+	if _, err := newValidator(existing).ValidateCreate(context.Background(), dgdr); err != nil {
+		t.Fatalf("expected applyToExisting DGDR to be admitted, got %v", err)
+	}
+
+	_, err := newValidator().ValidateCreate(context.Background(), dgdr)
+	if err == nil || !strings.Contains(err.Error(), "DynamoGraphDeployment "+testNamespace+"/serving does not exist") {
+		t.Fatalf("expected missing DGD error, got %v", err)
+	}
+}
+
+func TestValidateCreate_InvalidOverrideDGDName(t *testing.T) {
+	dgdr := newDGDR("my-dgdr")
+	dgdr.Spec.DeploymentOverrides = &nvidiacomv1alpha1.DeploymentOverridesSpec{Name: "Invalid_Name"}
+	v := newValidator()
+	_, err := v.ValidateCreate(context.Background(), dgdr)
+	if err == nil || !strings.Contains(err.Error(), "spec.deploymentOverrides.name") {
+		t.Fatalf("expected invalid override name error, got %v", err)
+	}
+}
+
+func TestValidateCreate_WarnsAboutInlineCredentials(t *testing.T) {
+	dgdr := newDGDR("my-dgdr")
+	dgdr.Spec.ProfilingConfig.Config = &apiextensionsv1.JSON{Raw: []byte(`{"deployment":{"hf_token":"hf_abc","model":"m"},"endpoints":[{"api_key":"k"}],"hardware":{"max_num_gpus_per_engine":8}}`)}
+	v := newValidator()
+	warnings, err := v.ValidateCreate(context.Background(), dgdr)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(warnings) != 2 {
+		t.Fatalf("expected 2 warnings, got %v", warnings)
+	}
+	if !strings.Contains(warnings[0], "spec.profilingConfig.config.deployment.hf_token") ||
+		!strings.Contains(warnings[1], "spec.profilingConfig.config.endpoints[0].api_key") {
+		t.Errorf("expected warnings to name the credential keys, got %v", warnings)
+	}
+}
+
+func TestValidateCreate_WarnsAboutSoftIssues(t *testing.T) {
+	tests := []struct {
+		name     string
+		mutate   func(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest)
+		expected []string
+	}{
+		{
+			name: "bounded GPUs and a typical SLA",
+			mutate: func(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) {
+				dgdr.Spec.Model = "meta-llama/Llama-3.1-70B-Instruct"
+				dgdr.Spec.ProfilingConfig.Config = &apiextensionsv1.JSON{Raw: []byte(`{"sla":{"ttft":500,"itl":30},"hardware":{"max_num_gpus_per_engine":8}}`)}
+			},
+		},
+		{
+			name: "no bound on the GPUs per engine",
+			mutate: func(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) {
+				dgdr.Spec.ProfilingConfig.Config = &apiextensionsv1.JSON{Raw: []byte(`{"sla":{"ttft":500,"itl":30}}`)}
+			},
+			expected: []string{"max_num_gpus_per_engine is not set"},
+		},
+		{
+			name: "tight config SLA for a large model",
+			mutate: func(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) {
+				dgdr.Spec.Model = "meta-llama/Llama-3.1-70B-Instruct"
+				dgdr.Spec.ProfilingConfig.Config = &apiextensionsv1.JSON{Raw: []byte(`{"sla":{"ttft":100,"itl":5},"hardware":{"max_num_gpus_per_engine":8}}`)}
+			},
+			expected: []string{"sla ttft 100ms looks unusually tight", "sla itl 5ms looks unusually tight"},
+		},
+		{
+			name: "tight token latency for a mixture of experts model",
+			mutate: func(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) {
+				dgdr.Spec.Model = "mistralai/Mixtral-8x7B-Instruct-v0.1"
+				dgdr.Spec.SLA = &nvidiacomv1alpha1.SLASpec{TokenLatency: &nvidiacomv1alpha1.TokenLatencySpec{TTFTMilliseconds: 500, ITLMilliseconds: 8}}
+				dgdr.Spec.ProfilingConfig.Config = &apiextensionsv1.JSON{Raw: []byte(`{"hardware":{"max_num_gpus_per_engine":8}}`)}
+			},
+			expected: []string{"sla itl 8ms looks unusually tight for model mistralai/Mixtral-8x7B-Instruct-v0.1 (about 56B parameters)"},
+		},
+		{
+			name: "tight SLA for a small model",
+			mutate: func(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) {
+				dgdr.Spec.Model = "Qwen/Qwen3-0.6B"
+				dgdr.Spec.ProfilingConfig.Config = &apiextensionsv1.JSON{Raw: []byte(`{"sla":{"ttft":20,"itl":2},"hardware":{"max_num_gpus_per_engine":1}}`)}
+			},
+		},
+		{
+			name: "auto backend with the default candidates",
+			mutate: func(dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) {
+				dgdr.Spec.Backend = "auto"
+				dgdr.Spec.ProfilingConfig.CPUOnly = true
+			},
+			expected: []string{"the default candidates vllm, sglang and trtllm are evaluated"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dgdr := newDGDR("my-dgdr")
+			tt.mutate(dgdr)
+			warnings, err := newValidator().ValidateCreate(context.Background(), dgdr)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if len(warnings) != len(tt.expected) {
+				t.Fatalf("expected %d warnings, got %v", len(tt.expected), warnings)
+			}
+			for i, expected := range tt.expected {
+				if !strings.Contains(warnings[i], expected) {
+					t.Errorf("expected warning %d to contain %q, got %q", i, expected, warnings[i])
+				}
+			}
+		})
+	}
+}
+
+func loadImageAllowlist(t *testing.T, content string) *controller.ImageAllowlist {
+	path := filepath.Join(t.TempDir(), "allowlist.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	allowlist, err := controller.LoadImageAllowlist(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return allowlist
+}
+
+func TestValidateCreate_ImageAllowlist(t *testing.T) {
+	namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: testNamespace, Labels: map[string]string{"tenant": "team-a"}}}
+	v := newValidator(namespace)
+	v.ImageAllowlist = loadImageAllowlist(t, `
+tenants:
+- namespaceSelector:
+    matchLabels:
+      tenant: team-a
+  registries: ["nvcr.io/nvidia/ai-dynamo"]
+`)
+
+	dgdr := newDGDR("my-dgdr")
+	dgdr.Spec.ProfilingConfig.ProfilerImage = "nvcr.io/nvidia/ai-dynamo/vllm-runtime:0.6.0"
+	if _, err := v.ValidateCreate(context.Background(), dgdr); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	dgdr.Spec.DeploymentOverrides = &nvidiacomv1alpha1.DeploymentOverridesSpec{WorkersImage: "docker.io/someone/vllm:latest"}
+	_, err := v.ValidateCreate(context.Background(), dgdr)
+	if err == nil || !strings.Contains(err.Error(), "docker.io/someone/vllm:latest") {
+		t.Fatalf("expected the workers image to be rejected, got %v", err)
+	}
+}
+
+func TestValidateCreate_InvalidServiceOverrides(t *testing.T) {
+	dgdr := newDGDR("my-dgdr")
+	dgdr.Spec.DeploymentOverrides = &nvidiacomv1alpha1.DeploymentOverridesSpec{
+		Services: map[string]nvidiacomv1alpha1.ServiceOverride{
+			"VllmDecodeWorker": {
+				Replicas:  ptr.To(int32(-1)),
+				Resources: &dynamoCommon.Resources{Limits: &dynamoCommon.ResourceItem{GPU: "two"}},
+				Env:       []corev1.EnvVar{{Name: "1BAD"}},
+				Image:     "Not A Reference",
+				ExtraArgs: []string{" "},
+			},
+		},
+	}
+	v := newValidator()
+	_, err := v.ValidateCreate(context.Background(), dgdr)
+	if err == nil {
+		t.Fatal("expected invalid service overrides to be rejected")
+	}
+	for _, path := range []string{"replicas", "resources.limits.gpu", "env[0].name", "image", "extraArgs[0]"} {
+		if !strings.Contains(err.Error(), "spec.deploymentOverrides.services[VllmDecodeWorker]."+path) {
+			t.Errorf("expected an error for %s, got %v", path, err)
+		}
+	}
+
+	dgdr.Spec.DeploymentOverrides.Services["VllmDecodeWorker"] = nvidiacomv1alpha1.ServiceOverride{
+		Replicas:  ptr.To(int32(2)),
+		Resources: &dynamoCommon.Resources{Limits: &dynamoCommon.ResourceItem{GPU: "2"}},
+		Env:       []corev1.EnvVar{{Name: "DYN_LOG", Value: "debug"}},
+		Image:     "nvcr.io/nvidia/ai-dynamo/vllm-runtime:0.6.1",
+		ExtraArgs: []string{"--enforce-eager"},
+	}
+	if _, err := v.ValidateCreate(context.Background(), dgdr); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func TestValidateCreate_InvalidDeploymentPatches(t *testing.T) {
+	dgdr := newDGDR("my-dgdr")
+	dgdr.Spec.DeploymentOverrides = &nvidiacomv1alpha1.DeploymentOverridesSpec{
+		Patches: []nvidiacomv1alpha1.DeploymentPatch{
+			{Type: nvidiacomv1alpha1.DeploymentPatchTypeJSON6902, Patch: `[{"op": "remove", "path": "/kind"}]`},
+		},
+	}
+	v := newValidator()
+	_, err := v.ValidateCreate(context.Background(), dgdr)
+	if err == nil || !strings.Contains(err.Error(), "spec.deploymentOverrides.patches[0].patch[0]") {
+		t.Fatalf("expected the patch of /kind to be rejected, got %v", err)
+	}
+
+	dgdr.Spec.DeploymentOverrides.Patches[0].Patch = `[{"op": "add", "path": "/spec/services/Frontend/replicas", "value": 2}]`
+	if _, err := v.ValidateCreate(context.Background(), dgdr); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func TestValidateCreate_DuplicateHFTokenSecrets(t *testing.T) {
+	dgdr := newDGDR("my-dgdr")
+	dgdr.Spec.Secrets = []nvidiacomv1alpha1.DeploymentSecret{
+		{Purpose: nvidiacomv1alpha1.SecretPurposeHFToken, Name: "hf-token-a"},
+		{Purpose: nvidiacomv1alpha1.SecretPurposeHFToken, Name: "hf-token-b"},
+	}
+	v := newValidator()
+	_, err := v.ValidateCreate(context.Background(), dgdr)
+	if err == nil || !strings.Contains(err.Error(), "spec.secrets[1].purpose") {
+		t.Fatalf("expected the second hfToken secret to be rejected, got %v", err)
+	}
+
+	dgdr.Spec.Secrets[1].Purpose = nvidiacomv1alpha1.SecretPurposeRegistry
+	if _, err := v.ValidateCreate(context.Background(), dgdr); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+// recordingSink collects the audit records written to it
+type recordingSink struct {
+	records []audit.Record
+}
+
+func (s *recordingSink) Write(_ context.Context, record audit.Record) error {
+	s.records = append(s.records, record)
+	return nil
+}
+
+func TestValidate_AuditsAdmittedRequests(t *testing.T) {
+	sink := &recordingSink{}
+	v := newValidator()
+	v.AuditSink = sink
+	admissionContext := func(dryRun bool) context.Context {
+		return admission.NewContextWithRequest(context.Background(), admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+			UserInfo: authenticationv1.UserInfo{Username: "alice", Groups: []string{"ml-team"}},
+			DryRun:   ptr.To(dryRun),
+		}})
+	}
+
+	dgdr := newDGDR("my-dgdr")
+	if _, err := v.ValidateCreate(admissionContext(true), dgdr); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(sink.records) != 0 {
+		t.Fatalf("dry runs must not be audited, got %+v", sink.records)
+	}
+	if _, err := v.ValidateCreate(admissionContext(false), dgdr); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	// Status updates leave the spec unchanged and are not audited
+	updated := dgdr.DeepCopy()
+	updated.Status.State = "Pending"
+	if _, err := v.ValidateUpdate(admissionContext(false), dgdr, updated); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	updated.Spec.Model = "other-model"
+	if _, err := v.ValidateUpdate(admissionContext(false), dgdr, updated); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if len(sink.records) != 2 {
+		t.Fatalf("expected 2 audit records, got %+v", sink.records)
+	}
+	created, changed := sink.records[0], sink.records[1]
+	if created.Action != audit.ActionRequestCreated || created.User.Username != "alice" || created.Request.Name != "my-dgdr" || len(created.Spec) == 0 {
+		t.Errorf("unexpected create record %+v", created)
+	}
+	if changed.Action != audit.ActionRequestUpdated || !strings.Contains(changed.Diff, "other-model") {
+		t.Errorf("unexpected update record %+v", changed)
+	}
+
+	// Rejected requests are not audited
+	if _, err := v.ValidateCreate(admissionContext(false), newDGDR(strings.Repeat("a", 60))); err == nil {
+		t.Fatal("expected the request to be rejected")
+	}
+	if len(sink.records) != 2 {
+		t.Errorf("rejected requests must not be audited, got %+v", sink.records)
+	}
+}
+
+func TestValidateUpdate_AuditsApprovalWithUser(t *testing.T) {
+	sink := &recordingSink{}
+	v := newValidator()
+	v.AuditSink = sink
+	ctx := admission.NewContextWithRequest(context.Background(), admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+		UserInfo: authenticationv1.UserInfo{Username: "bob", Groups: []string{"platform-admins"}},
+	}})
+
+	dgdr := newDGDR("my-dgdr")
+	dgdr.Status.Conditions = []metav1.Condition{{Type: controller.ConditionTypePreviewAvailable, Message: "DynamoGraphDeployment test-namespace/my-dgd: 2 services, 4 GPUs in total."}}
+	approved := dgdr.DeepCopy()
+	approved.Annotations = map[string]string{controller.AnnotationApproved: "true"}
+	// Approvals before the spec awaits approval are not audited, the controller ignores them
+	if _, err := v.ValidateUpdate(ctx, dgdr, approved); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	dgdr.Status.State = controller.StateAwaitingApproval
+	approved.Status.State = controller.StateAwaitingApproval
+	if _, err := v.ValidateUpdate(ctx, dgdr, approved); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	// Updates that keep the approval are not audited again
+	if _, err := v.ValidateUpdate(ctx, approved, approved); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if len(sink.records) != 1 {
+		t.Fatalf("expected 1 audit record, got %+v", sink.records)
+	}
+	record := sink.records[0]
+	if record.Action != audit.ActionRequestApproved || record.User.Username != "bob" || !strings.Contains(record.Message, "4 GPUs in total") {
+		t.Errorf("unexpected approval record %+v", record)
+	}
+}
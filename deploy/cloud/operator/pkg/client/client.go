@@ -0,0 +1,162 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package client provides typed helpers for DynamoGraphDeploymentRequests to CI pipelines and
+// tools: creating a request, waiting for one of its states, reading its generated deployment and
+// approving it, without reimplementing status polling and RawExtension decoding.
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	crclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+)
+
+const (
+	// DefaultPollInterval is how often WaitForState reads the request unless PollInterval is set
+	DefaultPollInterval = 5 * time.Second
+
+	// States of a request to wait for
+	StatePending           = nvidiacomv1alpha1.DGDRStatePending
+	StateProfiling         = nvidiacomv1alpha1.DGDRStateProfiling
+	StateAwaitingApproval  = nvidiacomv1alpha1.DGDRStateAwaitingApproval
+	StateDeploying         = nvidiacomv1alpha1.DGDRStateDeploying
+	StateReady             = nvidiacomv1alpha1.DGDRStateReady
+	StateDegraded          = nvidiacomv1alpha1.DGDRStateDegraded
+	StateDeploymentDeleted = nvidiacomv1alpha1.DGDRStateDeploymentDeleted
+	StateFailed            = nvidiacomv1alpha1.DGDRStateFailed
+)
+
+var (
+	// ErrRequestFailed is returned by WaitForState when the request failed before reaching a
+	// requested state
+	ErrRequestFailed = errors.New("DynamoGraphDeploymentRequest failed")
+	// ErrNotAwaitingApproval is returned by ApproveDeployment for a request whose generated spec
+	// does not await approval
+	ErrNotAwaitingApproval = errors.New("DynamoGraphDeploymentRequest is not awaiting approval")
+)
+
+// Client wraps a controller-runtime client with typed helpers for DynamoGraphDeploymentRequests
+type Client struct {
+	crclient.Client
+
+	// PollInterval is how often WaitForState reads the request, DefaultPollInterval if zero
+	PollInterval time.Duration
+}
+
+// New returns a Client for the cluster of the rest config, with the Dynamo API types registered
+func New(config *rest.Config) (*Client, error) {
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		return nil, err
+	}
+	if err := nvidiacomv1alpha1.AddToScheme(scheme); err != nil {
+		return nil, err
+	}
+	c, err := crclient.New(config, crclient.Options{Scheme: scheme})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+	return NewForClient(c), nil
+}
+
+// NewForClient returns a Client wrapping an existing controller-runtime client, whose scheme must
+// have the Dynamo API types registered
+func NewForClient(c crclient.Client) *Client {
+	return &Client{Client: c}
+}
+
+// CreateDGDR creates the request; its status is filled in from the created object
+func (c *Client) CreateDGDR(ctx context.Context, dgdr *nvidiacomv1alpha1.DynamoGraphDeploymentRequest) error {
+	if err := c.Create(ctx, dgdr); err != nil {
+		return fmt.Errorf("failed to create DynamoGraphDeploymentRequest %s/%s: %w", dgdr.Namespace, dgdr.Name, err)
+	}
+	return nil
+}
+
+// WaitForState polls the request until it is in one of the states, such as StateReady, and
+// returns it. It returns ErrRequestFailed as soon as the request fails unless StateFailed is one of
+// the states, and the context's error once it is done.
+func (c *Client) WaitForState(ctx context.Context, namespace, name string, states ...string) (*nvidiacomv1alpha1.DynamoGraphDeploymentRequest, error) {
+	interval := c.PollInterval
+	if interval == 0 {
+		interval = DefaultPollInterval
+	}
+	dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{}
+	err := wait.PollUntilContextCancel(ctx, interval, true, func(ctx context.Context) (bool, error) {
+		if err := c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, dgdr); err != nil {
+			return false, fmt.Errorf("failed to get DynamoGraphDeploymentRequest %s/%s: %w", namespace, name, err)
+		}
+		if slices.Contains(states, dgdr.Status.State) {
+			return true, nil
+		}
+		if dgdr.Status.State == StateFailed {
+			return false, fmt.Errorf("%w: %s/%s, failure reason %s", ErrRequestFailed, namespace, name, dgdr.Status.FailureReason)
+		}
+		return false, nil
+	})
+	return dgdr, err
+}
+
+// GetGeneratedSpec returns the DynamoGraphDeployment the operator applies for the generated spec
+// of the request, decoded from its status and with the name, namespace, labels and annotations it
+// is applied with
+func (c *Client) GetGeneratedSpec(ctx context.Context, namespace, name string) (*nvidiacomv1alpha1.DynamoGraphDeployment, error) {
+	dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{}
+	if err := c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, dgdr); err != nil {
+		return nil, fmt.Errorf("failed to get DynamoGraphDeploymentRequest %s/%s: %w", namespace, name, err)
+	}
+	dgd, err := dgdr.BuildDeployment()
+	if err != nil {
+		return nil, fmt.Errorf("DynamoGraphDeploymentRequest %s/%s: %w", namespace, name, err)
+	}
+	return dgd, nil
+}
+
+// ApproveDeployment approves the generated spec of a request that awaits approval, see
+// spec.approval. The approving user is recorded in the audit log when the admission webhook and
+// its audit log are enabled.
+func (c *Client) ApproveDeployment(ctx context.Context, namespace, name string) error {
+	dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{}
+	if err := c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, dgdr); err != nil {
+		return fmt.Errorf("failed to get DynamoGraphDeploymentRequest %s/%s: %w", namespace, name, err)
+	}
+	if dgdr.Status.State != StateAwaitingApproval {
+		return fmt.Errorf("%w: %s/%s is %q", ErrNotAwaitingApproval, namespace, name, dgdr.Status.State)
+	}
+
+	patch := crclient.MergeFrom(dgdr.DeepCopy())
+	if dgdr.Annotations == nil {
+		dgdr.Annotations = map[string]string{}
+	}
+	dgdr.Annotations[nvidiacomv1alpha1.AnnotationDGDRApproved] = "true"
+	if err := c.Patch(ctx, dgdr, patch); err != nil {
+		return fmt.Errorf("failed to approve DynamoGraphDeploymentRequest %s/%s: %w", namespace, name, err)
+	}
+	return nil
+}
@@ -0,0 +1,160 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2025 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	nvidiacomv1alpha1 "github.com/ai-dynamo/dynamo/deploy/cloud/operator/api/v1alpha1"
+)
+
+const testNamespace = "test-namespace"
+
+func newClient(t *testing.T, objs ...*nvidiacomv1alpha1.DynamoGraphDeploymentRequest) *Client {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := nvidiacomv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	builder := fake.NewClientBuilder().WithScheme(scheme).WithStatusSubresource(&nvidiacomv1alpha1.DynamoGraphDeploymentRequest{})
+	for _, obj := range objs {
+		builder = builder.WithObjects(obj)
+	}
+	c := NewForClient(builder.Build())
+	c.PollInterval = 10 * time.Millisecond
+	return c
+}
+
+func newDGDR(state string) *nvidiacomv1alpha1.DynamoGraphDeploymentRequest {
+	return &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-dgdr", Namespace: testNamespace},
+		Spec:       nvidiacomv1alpha1.DynamoGraphDeploymentRequestSpec{Model: "test-model", Backend: "vllm"},
+		Status: nvidiacomv1alpha1.DynamoGraphDeploymentRequestStatus{
+			State: state,
+			GeneratedDeployment: &runtime.RawExtension{
+				Raw: []byte(`{"apiVersion":"nvidia.com/v1alpha1","kind":"DynamoGraphDeployment","metadata":{"name":"my-dgd"},"spec":{"services":{"Frontend":{"replicas":1}}}}`),
+			},
+		},
+	}
+}
+
+func TestCreateDGDR(t *testing.T) {
+	c := newClient(t)
+	if err := c.CreateDGDR(context.Background(), newDGDR("")); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if err := c.CreateDGDR(context.Background(), newDGDR("")); err == nil {
+		t.Fatal("expected an error creating the request twice")
+	}
+}
+
+func TestWaitForState(t *testing.T) {
+	ctx := context.Background()
+	c := newClient(t, newDGDR(StateProfiling))
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{}
+		_ = c.Get(ctx, types.NamespacedName{Namespace: testNamespace, Name: "my-dgdr"}, dgdr)
+		dgdr.Status.State = StateReady
+		_ = c.Status().Update(ctx, dgdr)
+	}()
+
+	waitCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	dgdr, err := c.WaitForState(waitCtx, testNamespace, "my-dgdr", StateReady)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if dgdr.Status.State != StateReady {
+		t.Fatalf("expected state %s, got %s", StateReady, dgdr.Status.State)
+	}
+}
+
+func TestWaitForState_Failed(t *testing.T) {
+	dgdr := newDGDR(StateFailed)
+	dgdr.Status.FailureReason = nvidiacomv1alpha1.FailureReasonProfilerCrash
+	c := newClient(t, dgdr)
+
+	_, err := c.WaitForState(context.Background(), testNamespace, "my-dgdr", StateReady)
+	if !errors.Is(err, ErrRequestFailed) {
+		t.Fatalf("expected ErrRequestFailed, got %v", err)
+	}
+	if _, err := c.WaitForState(context.Background(), testNamespace, "my-dgdr", StateReady, StateFailed); err != nil {
+		t.Fatalf("expected no error waiting for Failed, got %v", err)
+	}
+}
+
+func TestWaitForState_Timeout(t *testing.T) {
+	c := newClient(t, newDGDR(StateProfiling))
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := c.WaitForState(ctx, testNamespace, "my-dgdr", StateReady); err == nil {
+		t.Fatal("expected an error once the context is done")
+	}
+}
+
+func TestGetGeneratedSpec(t *testing.T) {
+	c := newClient(t, newDGDR(StateReady))
+	dgd, err := c.GetGeneratedSpec(context.Background(), testNamespace, "my-dgdr")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if dgd.Name != "my-dgd" || dgd.Namespace != testNamespace {
+		t.Fatalf("expected %s/my-dgd, got %s/%s", testNamespace, dgd.Namespace, dgd.Name)
+	}
+	if _, ok := dgd.Spec.Services["Frontend"]; !ok {
+		t.Fatalf("expected the Frontend service, got %v", dgd.Spec.Services)
+	}
+
+	pending := newDGDR(StatePending)
+	pending.Name = "pending-dgdr"
+	pending.Status.GeneratedDeployment = nil
+	c = newClient(t, pending)
+	if _, err := c.GetGeneratedSpec(context.Background(), testNamespace, "pending-dgdr"); err == nil {
+		t.Fatal("expected an error without a generated deployment")
+	}
+}
+
+func TestApproveDeployment(t *testing.T) {
+	ctx := context.Background()
+	c := newClient(t, newDGDR(StateAwaitingApproval))
+	if err := c.ApproveDeployment(ctx, testNamespace, "my-dgdr"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	dgdr := &nvidiacomv1alpha1.DynamoGraphDeploymentRequest{}
+	if err := c.Get(ctx, types.NamespacedName{Namespace: testNamespace, Name: "my-dgdr"}, dgdr); err != nil {
+		t.Fatal(err)
+	}
+	if dgdr.Annotations["nvidia.com/dgdr-approved"] != "true" {
+		t.Fatalf("expected the approval annotation, got %v", dgdr.Annotations)
+	}
+
+	c = newClient(t, newDGDR(StateProfiling))
+	if err := c.ApproveDeployment(ctx, testNamespace, "my-dgdr"); !errors.Is(err, ErrNotAwaitingApproval) {
+		t.Fatalf("expected ErrNotAwaitingApproval, got %v", err)
+	}
+}
@@ -20,6 +20,7 @@ package e2e
 import (
 	"fmt"
 	"os/exec"
+	"strings"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -28,7 +29,15 @@ import (
 	"github.com/ai-dynamo/dynamo/deploy/cloud/operator/test/utils"
 )
 
-const namespace = "dynamo-kubernetes-operator-system"
+const (
+	namespace = "dynamo-kubernetes-operator-system"
+
+	// dgdrNamespace holds the DGDRs applied by the suite
+	dgdrNamespace = "dgdr-e2e"
+
+	// projectImage is the operator image built and loaded by the suite
+	projectImage = "example.com/dynamo-kubernetes-operator:v0.0.1"
+)
 
 var _ = Describe("controller", Ordered, func() {
 	BeforeAll(func() {
@@ -41,6 +50,10 @@ var _ = Describe("controller", Ordered, func() {
 		By("creating manager namespace")
 		cmd := exec.Command("kubectl", "create", "ns", namespace)
 		_, _ = utils.Run(cmd)
+
+		By("creating DGDR namespace")
+		cmd = exec.Command("kubectl", "create", "ns", dgdrNamespace)
+		_, _ = utils.Run(cmd)
 	})
 
 	AfterAll(func() {
@@ -50,8 +63,12 @@ var _ = Describe("controller", Ordered, func() {
 		By("uninstalling the cert-manager bundle")
 		utils.UninstallCertManager()
 
+		By("removing the DGDR namespace")
+		cmd := exec.Command("kubectl", "delete", "ns", dgdrNamespace, "--ignore-not-found")
+		_, _ = utils.Run(cmd)
+
 		By("removing manager namespace")
-		cmd := exec.Command("kubectl", "delete", "ns", namespace)
+		cmd = exec.Command("kubectl", "delete", "ns", namespace)
 		_, _ = utils.Run(cmd)
 	})
 
@@ -60,16 +77,13 @@ var _ = Describe("controller", Ordered, func() {
 			var controllerPodName string
 			var err error
 
-			// projectimage stores the name of the image used in the example
-			var projectimage = "example.com/dynamo-kubernetes-operator:v0.0.1"
-
 			By("building the manager(Operator) image")
-			cmd := exec.Command("make", "docker-build", fmt.Sprintf("IMG=%s", projectimage))
+			cmd := exec.Command("make", "docker-build", fmt.Sprintf("IMG=%s", projectImage))
 			_, err = utils.Run(cmd)
 			ExpectWithOffset(1, err).NotTo(HaveOccurred())
 
 			By("loading the the manager(Operator) image on Kind")
-			err = utils.LoadImageToKindClusterWithName(projectimage)
+			err = utils.LoadImageToKindClusterWithName(projectImage)
 			ExpectWithOffset(1, err).NotTo(HaveOccurred())
 
 			By("installing CRDs")
@@ -77,8 +91,8 @@ var _ = Describe("controller", Ordered, func() {
 			_, err = utils.Run(cmd)
 			ExpectWithOffset(1, err).NotTo(HaveOccurred())
 
-			By("deploying the controller-manager")
-			cmd = exec.Command("make", "deploy", fmt.Sprintf("IMG=%s", projectimage))
+			By("deploying the controller-manager with the mock profiler")
+			cmd = exec.Command("make", "deploy-e2e", fmt.Sprintf("IMG=%s", projectImage))
 			_, err = utils.Run(cmd)
 			ExpectWithOffset(1, err).NotTo(HaveOccurred())
 
@@ -120,4 +134,188 @@ var _ = Describe("controller", Ordered, func() {
 
 		})
 	})
+	Context("DynamoGraphDeploymentRequest", func() {
+		It("should profile and generate a deployment", func() {
+			Expect(utils.ApplyManifest(dgdrManifest("e2e-profile", false))).To(Succeed())
+
+			By("waiting for the DGDR to become Ready")
+			EventuallyWithOffset(1, func() (string, error) {
+				return utils.GetJSONPath(dgdrNamespace, "dgdr", "e2e-profile", "{.status.state}")
+			}, 2*time.Minute, time.Second).Should(Equal("Ready"))
+
+			for _, condition := range []string{"Validation", "Profiling", "SpecGenerated"} {
+				status, err := utils.GetJSONPath(dgdrNamespace, "dgdr", "e2e-profile",
+					fmt.Sprintf(`{.status.conditions[?(@.type=="%s")].status}`, condition))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(status).To(Equal("True"), "condition %s", condition)
+			}
+
+			By("checking the generated deployment and results")
+			generated, err := utils.GetJSONPath(dgdrNamespace, "dgdr", "e2e-profile", "{.status.generatedDeployment.kind}")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(generated).To(Equal("DynamoGraphDeployment"))
+
+			label, err := utils.GetJSONPath(dgdrNamespace, "configmap", "dgdr-output-e2e-profile",
+				`{.metadata.labels.dgdr\.nvidia\.com/name}`)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(label).To(Equal("e2e-profile"))
+
+			finalizers, err := utils.GetJSONPath(dgdrNamespace, "dgdr", "e2e-profile", "{.metadata.finalizers}")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(finalizers).NotTo(BeEmpty())
+		})
+
+		It("should reject spec changes once profiling has started", func() {
+			generation, err := utils.GetJSONPath(dgdrNamespace, "dgdr", "e2e-profile", "{.status.observedGeneration}")
+			Expect(err).NotTo(HaveOccurred())
+
+			cmd := exec.Command("kubectl", "patch", "dgdr", "e2e-profile", "-n", dgdrNamespace,
+				"--type=merge", "-p", `{"spec":{"model":"Qwen/Qwen3-8B"}}`)
+			_, err = utils.Run(cmd)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("waiting for the SpecChangeRejected event")
+			EventuallyWithOffset(1, func() ([]byte, error) {
+				cmd := exec.Command("kubectl", "get", "events", "-n", dgdrNamespace,
+					"--field-selector", "involvedObject.name=e2e-profile,reason=SpecChangeRejected", "-o", "name")
+				return utils.Run(cmd)
+			}, time.Minute, time.Second).ShouldNot(BeEmpty())
+
+			state, err := utils.GetJSONPath(dgdrNamespace, "dgdr", "e2e-profile", "{.status.state}")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(state).To(Equal("Ready"))
+			observed, err := utils.GetJSONPath(dgdrNamespace, "dgdr", "e2e-profile", "{.status.observedGeneration}")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(observed).To(Equal(generation))
+		})
+
+		It("should create the deployment with autoApply", func() {
+			Expect(utils.ApplyManifest(dgdrManifest("e2e-apply", true))).To(Succeed())
+
+			By("waiting for the DGD to be created")
+			EventuallyWithOffset(1, func() (string, error) {
+				return utils.GetJSONPath(dgdrNamespace, "dgd", "e2e-apply", `{.metadata.labels.dgdr\.nvidia\.com/name}`)
+			}, 2*time.Minute, time.Second).Should(Equal("e2e-apply"))
+
+			state, err := utils.GetJSONPath(dgdrNamespace, "dgdr", "e2e-apply", "{.status.state}")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(state).To(BeElementOf("Deploying", "Ready", "Degraded"))
+		})
+
+		It("should remove its finalizer on deletion", func() {
+			for _, name := range []string{"e2e-profile", "e2e-apply"} {
+				cmd := exec.Command("kubectl", "delete", "dgdr", name, "-n", dgdrNamespace, "--timeout=2m")
+				_, err := utils.Run(cmd)
+				Expect(err).NotTo(HaveOccurred())
+
+				cmd = exec.Command("kubectl", "get", "dgdr", name, "-n", dgdrNamespace, "--ignore-not-found", "-o", "name")
+				output, err := utils.Run(cmd)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(output).To(BeEmpty())
+			}
+
+			By("keeping the generated deployment, which outlives its DGDR")
+			_, err := utils.GetJSONPath(dgdrNamespace, "dgd", "e2e-apply", "{.metadata.name}")
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+	Context("DynamoGraphDeploymentRequest with injected faults", func() {
+		BeforeAll(func() {
+			By("redeploying the controller-manager with fault injection")
+			deployController("deploy-e2e-faults", true)
+		})
+
+		AfterAll(func() {
+			By("redeploying the controller-manager without fault injection")
+			deployController("deploy-e2e", false)
+
+			for _, name := range []string{"e2e-corrupted", "e2e-recovered"} {
+				cmd := exec.Command("kubectl", "delete", "dgdr", name, "-n", dgdrNamespace, "--ignore-not-found", "--timeout=2m")
+				_, _ = utils.Run(cmd)
+			}
+		})
+
+		It("should fail on corrupted profiling output", func() {
+			Expect(utils.ApplyManifest(dgdrManifest("e2e-corrupted", false))).To(Succeed())
+
+			By("waiting for the DGDR to fail")
+			EventuallyWithOffset(1, func() (string, error) {
+				return utils.GetJSONPath(dgdrNamespace, "dgdr", "e2e-corrupted", "{.status.state}")
+			}, 2*time.Minute, time.Second).Should(Equal("Failed"))
+
+			reason, err := utils.GetJSONPath(dgdrNamespace, "dgdr", "e2e-corrupted", "{.status.failureReason}")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reason).To(Equal("SpecParseError"))
+			status, err := utils.GetJSONPath(dgdrNamespace, "dgdr", "e2e-corrupted",
+				`{.status.conditions[?(@.type=="SpecGenerated")].status}`)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status).To(Equal("False"))
+		})
+
+		It("should profile once the faults are used up, despite delayed status updates", func() {
+			Expect(utils.ApplyManifest(dgdrManifest("e2e-recovered", false))).To(Succeed())
+
+			By("waiting for the DGDR to become Ready")
+			EventuallyWithOffset(1, func() (string, error) {
+				return utils.GetJSONPath(dgdrNamespace, "dgdr", "e2e-recovered", "{.status.state}")
+			}, 3*time.Minute, time.Second).Should(Equal("Ready"))
+
+			generated, err := utils.GetJSONPath(dgdrNamespace, "dgdr", "e2e-recovered", "{.status.generatedDeployment.kind}")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(generated).To(Equal("DynamoGraphDeployment"))
+		})
+	})
 })
+
+// deployController deploys the operator image with a deploy target of the Makefile and waits for a
+// single controller-manager pod that runs with fault injection, or without it
+func deployController(target string, faults bool) {
+	cmd := exec.Command("make", target, fmt.Sprintf("IMG=%s", projectImage))
+	_, err := utils.Run(cmd)
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+
+	EventuallyWithOffset(1, func() error {
+		cmd := exec.Command("kubectl", "get", "pods", "-l", "control-plane=controller-manager", "-n", namespace,
+			"-o", "go-template={{ range .items }}"+
+				"{{ if not .metadata.deletionTimestamp }}"+
+				"{{ .status.phase }} {{ range .spec.containers }}{{ .args }}{{ end }}"+
+				"{{ \"\\n\" }}{{ end }}{{ end }}")
+		output, err := utils.Run(cmd)
+		if err != nil {
+			return err
+		}
+		pods := utils.GetNonEmptyLines(string(output))
+		if len(pods) != 1 {
+			return fmt.Errorf("expect 1 controller pods running, but got %d", len(pods))
+		}
+		if !strings.HasPrefix(pods[0], "Running ") {
+			return fmt.Errorf("controller pod is not running: %s", pods[0])
+		}
+		if injecting := strings.Contains(pods[0], "--dgdr-fault-injection="); injecting != faults {
+			return fmt.Errorf("controller pod does not run with the expected arguments: %s", pods[0])
+		}
+		return nil
+	}, 2*time.Minute, time.Second).Should(Succeed())
+}
+
+// dgdrManifest returns a DGDR small enough for the mock profiler
+func dgdrManifest(name string, autoApply bool) string {
+	return fmt.Sprintf(`apiVersion: nvidia.com/v1alpha1
+kind: DynamoGraphDeploymentRequest
+metadata:
+  name: %s
+  namespace: %s
+spec:
+  model: Qwen/Qwen3-0.6B
+  backend: vllm
+  autoApply: %t
+  profilingConfig:
+    profilerImage: example.com/profiler:e2e
+    config:
+      sla:
+        ttft: 200.0
+        itl: 20.0
+      sweep:
+        use_ai_configurator: true
+`, name, dgdrNamespace, autoApply)
+}
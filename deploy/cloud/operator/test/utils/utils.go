@@ -116,6 +116,24 @@ func LoadImageToKindClusterWithName(name string) error {
 	return err
 }
 
+// ApplyManifest applies the given YAML manifest with kubectl
+func ApplyManifest(manifest string) error {
+	cmd := exec.Command("kubectl", "apply", "-f", "-")
+	cmd.Stdin = strings.NewReader(manifest)
+	_, err := Run(cmd)
+	return err
+}
+
+// GetJSONPath returns the result of evaluating the jsonpath expression against the named object
+func GetJSONPath(namespace, resource, name, jsonpath string) (string, error) {
+	cmd := exec.Command("kubectl", "get", resource, name,
+		"-n", namespace,
+		"-o", fmt.Sprintf("jsonpath=%s", jsonpath),
+	)
+	output, err := Run(cmd)
+	return string(output), err
+}
+
 // GetNonEmptyLines converts given command output string into individual objects
 // according to line breakers, and ignores the empty elements in it.
 func GetNonEmptyLines(output string) []string {